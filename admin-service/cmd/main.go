@@ -49,10 +49,13 @@ func main() {
 
 	sessionService := service.NewSessionService(redisClient, cfg.Session.TTL)
 	authService := service.NewAuthService(grpcClients.AuthClient, sessionService)
-	statsService := service.NewStatsService(grpcClients.AuthClient, grpcClients.AssetClient)
+	statsService := service.NewStatsService(grpcClients.AuthClient, grpcClients.AssetClient, grpcClients.DownloaderClient)
 	proxyService := service.NewProxyService(grpcClients.AssetClient)
 	cookieService := service.NewCookieService(grpcClients.AssetClient)
 	billingService := service.NewBillingService(grpcClients.AuthClient, grpcClients.AssetClient)
+	taskService := service.NewTaskService(grpcClients.DownloaderClient)
+	webhookService := service.NewWebhookService(grpcClients.AssetClient)
+	platformService := service.NewPlatformService(grpcClients.DownloaderClient)
 
 	lis, err := net.Listen("tcp", net.JoinHostPort("", formatPort(cfg.Server.Port)))
 	if err != nil {
@@ -62,7 +65,7 @@ func main() {
 	grpcSrv := grpc.NewServer(
 		grpc.UnaryInterceptor(observability.UnaryServerInterceptor("admin-service")),
 	)
-	pb.RegisterAdminServiceServer(grpcSrv, grpcserver.NewAdminServer(authService, statsService, proxyService, cookieService, billingService))
+	pb.RegisterAdminServiceServer(grpcSrv, grpcserver.NewAdminServer(authService, statsService, proxyService, cookieService, billingService, taskService, webhookService, platformService))
 
 	go func() {
 		log.Printf("admin-service gRPC listening on :%d", cfg.Server.Port)
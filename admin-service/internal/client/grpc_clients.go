@@ -12,11 +12,13 @@ import (
 )
 
 type GRPCClients struct {
-	AuthClient  pb.AuthServiceClient
-	AssetClient pb.AssetServiceClient
+	AuthClient       pb.AuthServiceClient
+	AssetClient      pb.AssetServiceClient
+	DownloaderClient pb.DownloaderServiceClient
 
 	authConn  *grpc.ClientConn
 	assetConn *grpc.ClientConn
+	mediaConn *grpc.ClientConn
 }
 
 func NewGRPCClients(cfg *config.GRPCConfig) (*GRPCClients, error) {
@@ -35,11 +37,20 @@ func NewGRPCClients(cfg *config.GRPCConfig) (*GRPCClients, error) {
 		return nil, err
 	}
 
+	mediaConn, err := grpc.NewClient(cfg.MediaService, opts...)
+	if err != nil {
+		authConn.Close()
+		assetConn.Close()
+		return nil, err
+	}
+
 	return &GRPCClients{
-		AuthClient:  pb.NewAuthServiceClient(authConn),
-		AssetClient: pb.NewAssetServiceClient(assetConn),
-		authConn:    authConn,
-		assetConn:   assetConn,
+		AuthClient:       pb.NewAuthServiceClient(authConn),
+		AssetClient:      pb.NewAssetServiceClient(assetConn),
+		DownloaderClient: pb.NewDownloaderServiceClient(mediaConn),
+		authConn:         authConn,
+		assetConn:        assetConn,
+		mediaConn:        mediaConn,
 	}, nil
 }
 
@@ -50,6 +61,9 @@ func (c *GRPCClients) Close() {
 	if c.assetConn != nil {
 		c.assetConn.Close()
 	}
+	if c.mediaConn != nil {
+		c.mediaConn.Close()
+	}
 }
 
 func WithTimeout(timeout time.Duration) (context.Context, context.CancelFunc) {
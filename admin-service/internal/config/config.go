@@ -28,6 +28,7 @@ type ServerConfig struct {
 type GRPCConfig struct {
 	AuthService  string        `yaml:"auth_service"`
 	AssetService string        `yaml:"asset_service"`
+	MediaService string        `yaml:"media_service"`
 	Timeout      time.Duration `yaml:"timeout"`
 }
 
@@ -71,6 +72,9 @@ func LoadConfig(configPath string) (*Config, error) {
 	if assetAddr := os.Getenv("ASSET_SERVICE_ADDR"); assetAddr != "" {
 		cfg.GRPC.AssetService = assetAddr
 	}
+	if mediaAddr := os.Getenv("MEDIA_SERVICE_ADDR"); mediaAddr != "" {
+		cfg.GRPC.MediaService = mediaAddr
+	}
 	if redisAddr := os.Getenv("REDIS_ADDR"); redisAddr != "" {
 		cfg.Redis.Addr = redisAddr
 	}
@@ -14,11 +14,14 @@ import (
 
 type AdminServer struct {
 	pb.UnimplementedAdminServiceServer
-	authService    *service.AuthService
-	statsService   *service.StatsService
-	proxyService   *service.ProxyService
-	cookieService  *service.CookieService
-	billingService *service.BillingService
+	authService     *service.AuthService
+	statsService    *service.StatsService
+	proxyService    *service.ProxyService
+	cookieService   *service.CookieService
+	billingService  *service.BillingService
+	taskService     *service.TaskService
+	webhookService  *service.WebhookService
+	platformService *service.PlatformService
 }
 
 func NewAdminServer(
@@ -27,13 +30,19 @@ func NewAdminServer(
 	proxyService *service.ProxyService,
 	cookieService *service.CookieService,
 	billingService *service.BillingService,
+	taskService *service.TaskService,
+	webhookService *service.WebhookService,
+	platformService *service.PlatformService,
 ) *AdminServer {
 	return &AdminServer{
-		authService:    authService,
-		statsService:   statsService,
-		proxyService:   proxyService,
-		cookieService:  cookieService,
-		billingService: billingService,
+		authService:     authService,
+		statsService:    statsService,
+		proxyService:    proxyService,
+		cookieService:   cookieService,
+		billingService:  billingService,
+		taskService:     taskService,
+		webhookService:  webhookService,
+		platformService: platformService,
 	}
 }
 
@@ -189,6 +198,16 @@ func dashboardHealthToProto(resp *models.DashboardHealthResponse) *pb.AdminDashb
 		Billing: &pb.AdminDashboardBilling{
 			ShortfallCount: resp.Billing.ShortfallCount,
 		},
+		Workers: &pb.AdminDashboardWorkers{
+			Available:       resp.Workers.Available,
+			Instances:       resp.Workers.Instances,
+			MaxConcurrent:   resp.Workers.MaxConcurrent,
+			Running:         resp.Workers.Running,
+			Buffered:        resp.Workers.Buffered,
+			Queued:          resp.Workers.Queued,
+			PausedInstances: resp.Workers.PausedInstances,
+			Saturation:      resp.Workers.Saturation,
+		},
 		Exceptions: adminDashboardExceptionsToProto(resp.Exceptions),
 	}
 }
@@ -758,6 +777,178 @@ func (s *AdminServer) UpdateWelcomeCreditSettings(ctx context.Context, req *pb.A
 	}, nil
 }
 
+func (s *AdminServer) ListTasks(ctx context.Context, req *pb.AdminListTasksRequest) (*pb.AdminListTasksResponse, error) {
+	resp, err := s.taskService.List(ctx, models.TaskFilter{
+		State:             req.GetState(),
+		Platform:          req.GetPlatform(),
+		ErrorCategory:     req.GetErrorCategory(),
+		UserID:            req.GetUserId(),
+		StartTimeUnix:     req.GetStartTimeUnix(),
+		EndTimeUnix:       req.GetEndTimeUnix(),
+		StuckAfterSeconds: req.GetStuckAfterSeconds(),
+		Page:              req.GetPage(),
+		PageSize:          req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, mapDownstreamError(err)
+	}
+
+	items := make([]*pb.AdminTaskItem, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		items = append(items, taskInfoToProto(item))
+	}
+
+	return &pb.AdminListTasksResponse{
+		Items:    items,
+		Total:    resp.Total,
+		Page:     resp.Page,
+		PageSize: resp.PageSize,
+	}, nil
+}
+
+func (s *AdminServer) ReplayTask(ctx context.Context, req *pb.AdminTaskActionRequest) (*pb.AdminTaskActionResponse, error) {
+	if req.GetTaskId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing task id")
+	}
+	resp, err := s.taskService.Replay(ctx, taskActionRequestFromProto(req))
+	if err != nil {
+		return nil, mapDownstreamError(err)
+	}
+	return &pb.AdminTaskActionResponse{Success: resp.Success, Message: resp.Message}, nil
+}
+
+func (s *AdminServer) ForceFailTask(ctx context.Context, req *pb.AdminTaskActionRequest) (*pb.AdminTaskActionResponse, error) {
+	if req.GetTaskId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing task id")
+	}
+	resp, err := s.taskService.ForceFail(ctx, taskActionRequestFromProto(req))
+	if err != nil {
+		return nil, mapDownstreamError(err)
+	}
+	return &pb.AdminTaskActionResponse{Success: resp.Success, Message: resp.Message}, nil
+}
+
+func (s *AdminServer) PurgeTask(ctx context.Context, req *pb.AdminTaskActionRequest) (*pb.AdminTaskActionResponse, error) {
+	if req.GetTaskId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing task id")
+	}
+	resp, err := s.taskService.Purge(ctx, taskActionRequestFromProto(req))
+	if err != nil {
+		return nil, mapDownstreamError(err)
+	}
+	return &pb.AdminTaskActionResponse{Success: resp.Success, Message: resp.Message}, nil
+}
+
+func (s *AdminServer) ListWorkerPools(ctx context.Context, _ *pb.AdminEmpty) (*pb.AdminListWorkerPoolsResponse, error) {
+	resp, err := s.taskService.ListWorkerPools(ctx)
+	if err != nil {
+		return nil, mapDownstreamError(err)
+	}
+
+	items := make([]*pb.AdminWorkerPool, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		items = append(items, workerPoolToProto(item))
+	}
+	return &pb.AdminListWorkerPoolsResponse{Items: items}, nil
+}
+
+func (s *AdminServer) UpdateWorkerPool(ctx context.Context, req *pb.AdminUpdateWorkerPoolRequest) (*pb.AdminTaskActionResponse, error) {
+	if req.GetAction() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing action")
+	}
+	resp, err := s.taskService.UpdateWorkerPool(ctx, models.WorkerPoolUpdateRequest{
+		InstanceID:     req.GetInstanceId(),
+		Action:         req.GetAction(),
+		MaxConcurrent:  req.GetMaxConcurrent(),
+		OperatorUserID: req.GetOperatorUserId(),
+	})
+	if err != nil {
+		return nil, mapDownstreamError(err)
+	}
+	return &pb.AdminTaskActionResponse{Success: resp.Success, Message: resp.Message}, nil
+}
+
+func workerPoolToProto(item models.WorkerPool) *pb.AdminWorkerPool {
+	queues := make([]*pb.AdminWorkerQueueDepth, 0, len(item.Queues))
+	for _, q := range item.Queues {
+		queues = append(queues, &pb.AdminWorkerQueueDepth{
+			Queue:     q.Queue,
+			Platform:  q.Platform,
+			Messages:  q.Messages,
+			Consumers: q.Consumers,
+			Paused:    q.Paused,
+		})
+	}
+
+	tasks := make([]*pb.AdminWorkerActiveTask, 0, len(item.ActiveTasks))
+	for _, t := range item.ActiveTasks {
+		tasks = append(tasks, &pb.AdminWorkerActiveTask{
+			TaskId:    t.TaskID,
+			UserId:    t.UserID,
+			Platform:  t.Platform,
+			Phase:     t.Phase,
+			Percent:   t.Percent,
+			StartedAt: t.StartedAt,
+		})
+	}
+
+	return &pb.AdminWorkerPool{
+		InstanceId:     item.InstanceID,
+		PoolSize:       item.PoolSize,
+		MaxConcurrent:  item.MaxConcurrent,
+		Running:        item.Running,
+		Buffered:       item.Buffered,
+		BufferCapacity: item.BufferCapacity,
+		Paused:         item.Paused,
+		Queues:         queues,
+		ActiveTasks:    tasks,
+		UpdatedAt:      item.UpdatedAt,
+	}
+}
+
+func (s *AdminServer) ListPlatforms(ctx context.Context, _ *pb.AdminEmpty) (*pb.AdminListPlatformsResponse, error) {
+	resp, err := s.platformService.List(ctx)
+	if err != nil {
+		return nil, mapDownstreamError(err)
+	}
+
+	items := make([]*pb.AdminPlatform, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		items = append(items, platformToProto(item))
+	}
+	return &pb.AdminListPlatformsResponse{Items: items}, nil
+}
+
+func (s *AdminServer) UpdatePlatform(ctx context.Context, req *pb.AdminUpdatePlatformRequest) (*pb.AdminPlatformResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing platform name")
+	}
+	platform, err := s.platformService.Update(ctx, models.PlatformUpdateRequest{
+		Name:           req.GetName(),
+		Enabled:        req.GetEnabled(),
+		OperatorUserID: req.GetOperatorUserId(),
+	})
+	if err != nil {
+		return nil, mapDownstreamError(err)
+	}
+	return &pb.AdminPlatformResponse{Platform: platformToProto(*platform)}, nil
+}
+
+func platformToProto(item models.Platform) *pb.AdminPlatform {
+	return &pb.AdminPlatform{
+		Name:              item.Name,
+		DisplayName:       item.DisplayName,
+		Enabled:           item.Enabled,
+		Patterns:          item.Patterns,
+		Adapter:           item.Adapter,
+		ExtraArgs:         item.ExtraArgs,
+		Cookies:           item.Cookies,
+		Proxy:             item.Proxy,
+		ParsePerMinute:    item.ParsePerMinute,
+		DownloadPerMinute: item.DownloadPerMinute,
+	}
+}
+
 func adminUserToProto(user models.AdminUser) *pb.AdminUser {
 	return &pb.AdminUser{
 		UserId:    user.UserID,
@@ -836,6 +1027,38 @@ func proxyUsageEventToProto(item models.ProxyUsageEventInfo) *pb.AdminProxyUsage
 	}
 }
 
+func taskInfoToProto(item models.TaskInfo) *pb.AdminTaskItem {
+	return &pb.AdminTaskItem{
+		TaskId:         item.TaskID,
+		UserId:         item.UserID,
+		Url:            item.URL,
+		Platform:       item.Platform,
+		Title:          item.Title,
+		Mode:           item.Mode,
+		Quality:        item.Quality,
+		Status:         item.Status,
+		StatusText:     item.StatusText,
+		ErrorMessage:   item.ErrorMessage,
+		ErrorCategory:  item.ErrorCategory,
+		RetryCount:     item.RetryCount,
+		CreatedAt:      item.CreatedAt,
+		StartedAt:      item.StartedAt,
+		DeadLetteredAt: item.DeadLetteredAt,
+		DeadLettered:   item.DeadLettered,
+		Stuck:          item.Stuck,
+		Replayable:     item.Replayable,
+	}
+}
+
+func taskActionRequestFromProto(req *pb.AdminTaskActionRequest) models.TaskActionRequest {
+	return models.TaskActionRequest{
+		TaskID:            req.GetTaskId(),
+		Reason:            req.GetReason(),
+		StuckAfterSeconds: req.GetStuckAfterSeconds(),
+		OperatorUserID:    req.GetOperatorUserId(),
+	}
+}
+
 func proxyUsageSummaryToProto(summary models.ProxyUsageEventSummary) *pb.AdminProxyUsageEventSummary {
 	return &pb.AdminProxyUsageEventSummary{
 		SuccessCount:   summary.SuccessCount,
@@ -890,3 +1113,118 @@ func mapDownstreamError(err error) error {
 	}
 	return status.Error(codes.Internal, err.Error())
 }
+
+func (s *AdminServer) ListWebhooks(ctx context.Context, _ *pb.AdminEmpty) (*pb.AdminListWebhooksResponse, error) {
+	resp, err := s.webhookService.List(ctx)
+	if err != nil {
+		return nil, mapDownstreamError(err)
+	}
+
+	items := make([]*pb.AdminWebhookEndpoint, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		items = append(items, webhookEndpointToProto(item))
+	}
+	return &pb.AdminListWebhooksResponse{Items: items, AvailableEvents: resp.AvailableEvents}, nil
+}
+
+func (s *AdminServer) CreateWebhook(ctx context.Context, req *pb.AdminCreateWebhookRequest) (*pb.AdminCreateWebhookResponse, error) {
+	if req.GetUrl() == "" {
+		return nil, status.Error(codes.InvalidArgument, "url is required")
+	}
+
+	resp, err := s.webhookService.Create(ctx, models.CreateWebhookRequest{
+		URL:            req.GetUrl(),
+		Events:         req.GetEvents(),
+		Description:    req.GetDescription(),
+		OperatorUserID: req.GetOperatorUserId(),
+	})
+	if err != nil {
+		return nil, mapDownstreamError(err)
+	}
+	return &pb.AdminCreateWebhookResponse{
+		Endpoint: webhookEndpointToProto(resp.Endpoint),
+		Secret:   resp.Secret,
+	}, nil
+}
+
+func (s *AdminServer) UpdateWebhook(ctx context.Context, req *pb.AdminUpdateWebhookRequest) (*pb.AdminWebhookResponse, error) {
+	endpoint, err := s.webhookService.Update(ctx, req.GetId(), models.UpdateWebhookRequest{
+		URL:               req.GetUrl(),
+		UpdateEvents:      req.GetUpdateEvents(),
+		Events:            req.GetEvents(),
+		UpdateDescription: req.GetUpdateDescription(),
+		Description:       req.GetDescription(),
+		Status:            req.GetStatus(),
+	})
+	if err != nil {
+		return nil, mapDownstreamError(err)
+	}
+	return &pb.AdminWebhookResponse{Endpoint: webhookEndpointToProto(*endpoint)}, nil
+}
+
+func (s *AdminServer) DeleteWebhook(ctx context.Context, req *pb.AdminDeleteRequest) (*pb.AdminOperationResponse, error) {
+	if err := s.webhookService.Delete(ctx, req.GetId()); err != nil {
+		return nil, mapDownstreamError(err)
+	}
+	return &pb.AdminOperationResponse{Success: true}, nil
+}
+
+func (s *AdminServer) ListWebhookDeliveries(ctx context.Context, req *pb.AdminListWebhookDeliveriesRequest) (*pb.AdminListWebhookDeliveriesResponse, error) {
+	resp, err := s.webhookService.ListDeliveries(ctx, models.ListWebhookDeliveriesRequest{
+		EndpointID: req.GetEndpointId(),
+		Status:     req.GetStatus(),
+		EventType:  req.GetEventType(),
+		Page:       int(req.GetPage()),
+		PageSize:   int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, mapDownstreamError(err)
+	}
+
+	items := make([]*pb.AdminWebhookDelivery, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		items = append(items, &pb.AdminWebhookDelivery{
+			Id:             item.ID,
+			EndpointId:     item.EndpointID,
+			EventId:        item.EventID,
+			EventType:      item.EventType,
+			UserId:         item.UserID,
+			PayloadJson:    item.PayloadJSON,
+			Status:         item.Status,
+			Attempts:       item.Attempts,
+			NextAttemptAt:  item.NextAttemptAt,
+			LastAttemptAt:  item.LastAttemptAt,
+			ResponseStatus: item.ResponseStatus,
+			ResponseBody:   item.ResponseBody,
+			LastError:      item.LastError,
+			DurationMs:     item.DurationMS,
+			CreatedAt:      item.CreatedAt,
+		})
+	}
+	return &pb.AdminListWebhookDeliveriesResponse{
+		Items:    items,
+		Total:    resp.Total,
+		Page:     int32(resp.Page),
+		PageSize: int32(resp.PageSize),
+	}, nil
+}
+
+func (s *AdminServer) RedeliverWebhook(ctx context.Context, req *pb.AdminRedeliverWebhookRequest) (*pb.AdminOperationResponse, error) {
+	if err := s.webhookService.Redeliver(ctx, req.GetDeliveryId()); err != nil {
+		return nil, mapDownstreamError(err)
+	}
+	return &pb.AdminOperationResponse{Success: true}, nil
+}
+
+func webhookEndpointToProto(item models.WebhookEndpoint) *pb.AdminWebhookEndpoint {
+	return &pb.AdminWebhookEndpoint{
+		Id:          item.ID,
+		Url:         item.URL,
+		Events:      item.Events,
+		Description: item.Description,
+		Enabled:     item.Enabled,
+		CreatedBy:   item.CreatedBy,
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+	}
+}
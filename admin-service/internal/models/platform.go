@@ -0,0 +1,24 @@
+package models
+
+type Platform struct {
+	Name              string   `json:"name"`
+	DisplayName       string   `json:"display_name"`
+	Enabled           bool     `json:"enabled"`
+	Patterns          []string `json:"patterns"`
+	Adapter           string   `json:"adapter"`
+	ExtraArgs         []string `json:"extra_args"`
+	Cookies           string   `json:"cookies"`
+	Proxy             string   `json:"proxy"`
+	ParsePerMinute    int64    `json:"parse_per_minute"`
+	DownloadPerMinute int64    `json:"download_per_minute"`
+}
+
+type PlatformListResponse struct {
+	Items []Platform `json:"items"`
+}
+
+type PlatformUpdateRequest struct {
+	Name           string
+	Enabled        bool
+	OperatorUserID string
+}
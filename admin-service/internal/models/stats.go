@@ -93,6 +93,17 @@ type DashboardBilling struct {
 	ShortfallCount int64 `json:"shortfall_count"`
 }
 
+type DashboardWorkers struct {
+	Available       bool    `json:"available"`
+	Instances       int32   `json:"instances"`
+	MaxConcurrent   int32   `json:"max_concurrent"`
+	Running         int32   `json:"running"`
+	Buffered        int32   `json:"buffered"`
+	Queued          int64   `json:"queued"`
+	PausedInstances int32   `json:"paused_instances"`
+	Saturation      float64 `json:"saturation"`
+}
+
 type DashboardException struct {
 	Area        string `json:"area"`
 	Severity    string `json:"severity"`
@@ -110,5 +121,6 @@ type DashboardHealthResponse struct {
 	ProxyPolicy DashboardProxyPolicy `json:"proxy_policy"`
 	Cookies     DashboardCookies     `json:"cookies"`
 	Billing     DashboardBilling     `json:"billing"`
+	Workers     DashboardWorkers     `json:"workers"`
 	Exceptions  []DashboardException `json:"exceptions"`
 }
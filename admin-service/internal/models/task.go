@@ -0,0 +1,94 @@
+package models
+
+type TaskFilter struct {
+	State             string
+	Platform          string
+	ErrorCategory     string
+	UserID            string
+	StartTimeUnix     int64
+	EndTimeUnix       int64
+	StuckAfterSeconds int64
+	Page              int32
+	PageSize          int32
+}
+
+type TaskInfo struct {
+	TaskID         string `json:"task_id"`
+	UserID         string `json:"user_id"`
+	URL            string `json:"url"`
+	Platform       string `json:"platform"`
+	Title          string `json:"title,omitempty"`
+	Mode           string `json:"mode"`
+	Quality        string `json:"quality,omitempty"`
+	Status         int32  `json:"status"`
+	StatusText     string `json:"status_text"`
+	ErrorMessage   string `json:"error_message,omitempty"`
+	ErrorCategory  string `json:"error_category,omitempty"`
+	RetryCount     int32  `json:"retry_count"`
+	CreatedAt      string `json:"created_at"`
+	StartedAt      string `json:"started_at,omitempty"`
+	DeadLetteredAt string `json:"dead_lettered_at,omitempty"`
+	DeadLettered   bool   `json:"dead_lettered"`
+	Stuck          bool   `json:"stuck"`
+	Replayable     bool   `json:"replayable"`
+}
+
+type TaskListResponse struct {
+	Items    []TaskInfo `json:"items"`
+	Total    int64      `json:"total"`
+	Page     int32      `json:"page"`
+	PageSize int32      `json:"page_size"`
+}
+
+type TaskActionRequest struct {
+	TaskID            string
+	Reason            string
+	StuckAfterSeconds int64
+	OperatorUserID    string
+}
+
+type TaskActionResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+type WorkerActiveTask struct {
+	TaskID    string  `json:"task_id"`
+	UserID    string  `json:"user_id"`
+	Platform  string  `json:"platform"`
+	Phase     string  `json:"phase,omitempty"`
+	Percent   float64 `json:"percent"`
+	StartedAt string  `json:"started_at"`
+}
+
+type WorkerQueueDepth struct {
+	Queue     string `json:"queue"`
+	Platform  string `json:"platform,omitempty"`
+	Messages  int64  `json:"messages"`
+	Consumers int32  `json:"consumers"`
+	Paused    bool   `json:"paused"`
+}
+
+type WorkerPool struct {
+	InstanceID     string             `json:"instance_id"`
+	PoolSize       int32              `json:"pool_size"`
+	MaxConcurrent  int32              `json:"max_concurrent"`
+	Running        int32              `json:"running"`
+	Buffered       int32              `json:"buffered"`
+	BufferCapacity int32              `json:"buffer_capacity"`
+	Paused         bool               `json:"paused"`
+	Queues         []WorkerQueueDepth `json:"queues"`
+	ActiveTasks    []WorkerActiveTask `json:"active_tasks"`
+	UpdatedAt      string             `json:"updated_at"`
+}
+
+type WorkerPoolListResponse struct {
+	Items []WorkerPool `json:"items"`
+}
+
+type WorkerPoolUpdateRequest struct {
+	InstanceID     string
+	Action         string
+	MaxConcurrent  int32
+	OperatorUserID string
+}
@@ -0,0 +1,71 @@
+package models
+
+type WebhookEndpoint struct {
+	ID          int64    `json:"id"`
+	URL         string   `json:"url"`
+	Events      []string `json:"events"`
+	Description string   `json:"description"`
+	Enabled     bool     `json:"enabled"`
+	CreatedBy   string   `json:"created_by"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+type WebhookListResponse struct {
+	Items           []WebhookEndpoint `json:"items"`
+	AvailableEvents []string          `json:"available_events"`
+}
+
+type CreateWebhookRequest struct {
+	URL            string
+	Events         []string
+	Description    string
+	OperatorUserID string
+}
+
+type CreateWebhookResponse struct {
+	Endpoint WebhookEndpoint `json:"endpoint"`
+	Secret   string          `json:"secret"`
+}
+
+type UpdateWebhookRequest struct {
+	URL               string
+	UpdateEvents      bool
+	Events            []string
+	UpdateDescription bool
+	Description       string
+	Status            string
+}
+
+type WebhookDelivery struct {
+	ID             int64  `json:"id"`
+	EndpointID     int64  `json:"endpoint_id"`
+	EventID        string `json:"event_id"`
+	EventType      string `json:"event_type"`
+	UserID         string `json:"user_id"`
+	PayloadJSON    string `json:"payload_json"`
+	Status         string `json:"status"`
+	Attempts       int32  `json:"attempts"`
+	NextAttemptAt  string `json:"next_attempt_at,omitempty"`
+	LastAttemptAt  string `json:"last_attempt_at,omitempty"`
+	ResponseStatus int32  `json:"response_status"`
+	ResponseBody   string `json:"response_body"`
+	LastError      string `json:"last_error"`
+	DurationMS     int64  `json:"duration_ms"`
+	CreatedAt      string `json:"created_at"`
+}
+
+type ListWebhookDeliveriesRequest struct {
+	EndpointID int64
+	Status     string
+	EventType  string
+	Page       int
+	PageSize   int
+}
+
+type WebhookDeliveryListResponse struct {
+	Total    int64             `json:"total"`
+	Page     int               `json:"page"`
+	PageSize int               `json:"page_size"`
+	Items    []WebhookDelivery `json:"items"`
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"youdlp/admin-service/internal/models"
+	pb "youdlp/admin-service/proto"
+)
+
+// PlatformService 管理 media-service 平台注册表的启用状态
+type PlatformService struct {
+	downloaderClient pb.DownloaderServiceClient
+}
+
+func NewPlatformService(downloaderClient pb.DownloaderServiceClient) *PlatformService {
+	return &PlatformService{downloaderClient: downloaderClient}
+}
+
+func (s *PlatformService) List(ctx context.Context) (*models.PlatformListResponse, error) {
+	resp, err := s.downloaderClient.ListPlatforms(ctx, &pb.ListPlatformsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]models.Platform, 0, len(resp.GetPlatforms()))
+	for _, item := range resp.GetPlatforms() {
+		items = append(items, platformFromProto(item))
+	}
+	return &models.PlatformListResponse{Items: items}, nil
+}
+
+func (s *PlatformService) Update(ctx context.Context, req models.PlatformUpdateRequest) (*models.Platform, error) {
+	resp, err := s.downloaderClient.UpdatePlatform(ctx, &pb.UpdatePlatformRequest{
+		Name:           strings.ToLower(strings.TrimSpace(req.Name)),
+		Enabled:        req.Enabled,
+		OperatorUserId: req.OperatorUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	platform := platformFromProto(resp.GetPlatform())
+	return &platform, nil
+}
+
+func platformFromProto(item *pb.PlatformInfo) models.Platform {
+	return models.Platform{
+		Name:              item.GetName(),
+		DisplayName:       item.GetDisplayName(),
+		Enabled:           item.GetEnabled(),
+		Patterns:          item.GetPatterns(),
+		Adapter:           item.GetAdapter(),
+		ExtraArgs:         item.GetExtraArgs(),
+		Cookies:           item.GetCookies(),
+		Proxy:             item.GetProxy(),
+		ParsePerMinute:    item.GetParsePerMinute(),
+		DownloadPerMinute: item.GetDownloadPerMinute(),
+	}
+}
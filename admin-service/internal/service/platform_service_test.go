@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"youdlp/admin-service/internal/models"
+	pb "youdlp/admin-service/proto"
+)
+
+type stubPlatformDownloaderClient struct {
+	pb.DownloaderServiceClient
+
+	updateReq *pb.UpdatePlatformRequest
+}
+
+func (s *stubPlatformDownloaderClient) ListPlatforms(context.Context, *pb.ListPlatformsRequest, ...grpc.CallOption) (*pb.ListPlatformsResponse, error) {
+	return &pb.ListPlatformsResponse{Platforms: []*pb.PlatformInfo{
+		{Name: "douyin", DisplayName: "抖音", Enabled: true, Cookies: "required", DownloadPerMinute: 6},
+		{Name: "generic", Enabled: true},
+	}}, nil
+}
+
+func (s *stubPlatformDownloaderClient) UpdatePlatform(_ context.Context, in *pb.UpdatePlatformRequest, _ ...grpc.CallOption) (*pb.UpdatePlatformResponse, error) {
+	s.updateReq = in
+	return &pb.UpdatePlatformResponse{Platform: &pb.PlatformInfo{Name: in.GetName(), Enabled: in.GetEnabled()}}, nil
+}
+
+func TestPlatformServiceListsAndTogglesPlatforms(t *testing.T) {
+	t.Parallel()
+
+	downloader := &stubPlatformDownloaderClient{}
+	svc := NewPlatformService(downloader)
+
+	list, err := svc.List(context.Background())
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(list.Items) != 2 || list.Items[0].Cookies != "required" || list.Items[0].DownloadPerMinute != 6 {
+		t.Fatalf("unexpected platforms: %+v", list.Items)
+	}
+
+	platform, err := svc.Update(context.Background(), models.PlatformUpdateRequest{Name: " Weibo ", Enabled: false, OperatorUserID: "admin-1"})
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if downloader.updateReq.GetName() != "weibo" || downloader.updateReq.GetOperatorUserId() != "admin-1" {
+		t.Fatalf("unexpected update request: %+v", downloader.updateReq)
+	}
+	if platform.Name != "weibo" || platform.Enabled {
+		t.Fatalf("unexpected platform: %+v", platform)
+	}
+}
@@ -8,14 +8,16 @@ import (
 )
 
 type StatsService struct {
-	authClient  pb.AuthServiceClient
-	assetClient pb.AssetServiceClient
+	authClient       pb.AuthServiceClient
+	assetClient      pb.AssetServiceClient
+	downloaderClient pb.DownloaderServiceClient
 }
 
-func NewStatsService(authClient pb.AuthServiceClient, assetClient pb.AssetServiceClient) *StatsService {
+func NewStatsService(authClient pb.AuthServiceClient, assetClient pb.AssetServiceClient, downloaderClient pb.DownloaderServiceClient) *StatsService {
 	return &StatsService{
-		authClient:  authClient,
-		assetClient: assetClient,
+		authClient:       authClient,
+		assetClient:      assetClient,
+		downloaderClient: downloaderClient,
 	}
 }
 
@@ -105,6 +107,7 @@ func (s *StatsService) GetDashboardHealth(ctx context.Context) (*models.Dashboar
 	proxyPolicy := dashboardProxyPolicyFromProto(assetResp.GetProxyPolicy())
 	cookies := dashboardCookiesFromProto(assetResp.GetCookies())
 	billing := dashboardBillingFromProto(assetResp.GetBilling())
+	workers := s.dashboardWorkers(ctx)
 
 	return &models.DashboardHealthResponse{
 		GeneratedAt: assetResp.GetGeneratedAt(),
@@ -115,7 +118,8 @@ func (s *StatsService) GetDashboardHealth(ctx context.Context) (*models.Dashboar
 		ProxyPolicy: proxyPolicy,
 		Cookies:     cookies,
 		Billing:     billing,
-		Exceptions:  buildDashboardExceptions(downloads, proxies, proxySource, cookies, billing),
+		Workers:     workers,
+		Exceptions:  buildDashboardExceptions(downloads, proxies, proxySource, cookies, billing, workers),
 	}, nil
 }
 
@@ -203,6 +207,43 @@ func dashboardBillingFromProto(item *pb.AssetDashboardBilling) models.DashboardB
 	return models.DashboardBilling{ShortfallCount: item.GetShortfallCount()}
 }
 
+// dashboardWorkers 汇总 Worker 池容量；media-service 不可用时不影响看板其余部分
+func (s *StatsService) dashboardWorkers(ctx context.Context) models.DashboardWorkers {
+	if s.downloaderClient == nil {
+		return models.DashboardWorkers{}
+	}
+	resp, err := s.downloaderClient.GetWorkerPools(ctx, &pb.GetWorkerPoolsRequest{})
+	if err != nil {
+		return models.DashboardWorkers{}
+	}
+	return summarizeWorkerPools(resp.GetInstances())
+}
+
+func summarizeWorkerPools(instances []*pb.WorkerPoolStatus) models.DashboardWorkers {
+	workers := models.DashboardWorkers{Available: true}
+	// 各实例消费同一批队列，积压按队列去重取最大值
+	queued := make(map[string]int64)
+	for _, instance := range instances {
+		workers.Instances++
+		workers.MaxConcurrent += instance.GetMaxConcurrent()
+		workers.Running += instance.GetRunning()
+		workers.Buffered += instance.GetBuffered()
+		if instance.GetPaused() {
+			workers.PausedInstances++
+		}
+		for _, q := range instance.GetQueues() {
+			if q.GetMessages() > queued[q.GetQueue()] {
+				queued[q.GetQueue()] = q.GetMessages()
+			}
+		}
+	}
+	for _, messages := range queued {
+		workers.Queued += messages
+	}
+	workers.Saturation = safeRate(int64(workers.Running), int64(workers.MaxConcurrent))
+	return workers
+}
+
 func safeRate(numerator, denominator int64) float64 {
 	if denominator <= 0 {
 		return 0
@@ -216,6 +257,7 @@ func buildDashboardExceptions(
 	proxySource models.DashboardProxySource,
 	cookies models.DashboardCookies,
 	billing models.DashboardBilling,
+	workers models.DashboardWorkers,
 ) []models.DashboardException {
 	exceptions := make([]models.DashboardException, 0)
 
@@ -288,6 +330,39 @@ func buildDashboardExceptions(
 			ActionHref:  "/cookies",
 		})
 	}
+	if !workers.Available {
+		exceptions = append(exceptions, models.DashboardException{
+			Area:        "Workers",
+			Severity:    "warning",
+			Message:     "Worker pool status is currently unavailable.",
+			ActionLabel: "Review workers",
+			ActionHref:  "/workers",
+		})
+	} else if workers.Instances == 0 {
+		exceptions = append(exceptions, models.DashboardException{
+			Area:        "Workers",
+			Severity:    "critical",
+			Message:     "No download worker instances are reporting.",
+			ActionLabel: "Review workers",
+			ActionHref:  "/workers",
+		})
+	} else if workers.PausedInstances > 0 {
+		exceptions = append(exceptions, models.DashboardException{
+			Area:        "Workers",
+			Severity:    "warning",
+			Message:     "Some worker instances have paused consuming.",
+			ActionLabel: "Review workers",
+			ActionHref:  "/workers",
+		})
+	} else if workers.Saturation >= 0.9 && workers.Queued > 0 {
+		exceptions = append(exceptions, models.DashboardException{
+			Area:        "Workers",
+			Severity:    "warning",
+			Message:     "Worker pool is saturated while tasks are queued.",
+			ActionLabel: "Review workers",
+			ActionHref:  "/workers",
+		})
+	}
 
 	return exceptions
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"youdlp/admin-service/internal/models"
+	pb "youdlp/admin-service/proto"
 )
 
 func TestBuildDashboardExceptionsCoversHealthRules(t *testing.T) {
@@ -15,6 +16,7 @@ func TestBuildDashboardExceptionsCoversHealthRules(t *testing.T) {
 		models.DashboardProxySource{Healthy: false},
 		models.DashboardCookies{Total: 3, Active: 0},
 		models.DashboardBilling{ShortfallCount: 2},
+		models.DashboardWorkers{Available: true, Instances: 0},
 	)
 
 	want := map[string]string{
@@ -24,6 +26,7 @@ func TestBuildDashboardExceptionsCoversHealthRules(t *testing.T) {
 		"Proxy Risk":   "warning",
 		"Billing":      "warning",
 		"Cookies":      "warning",
+		"Workers":      "critical",
 	}
 	if len(exceptions) != len(want) {
 		t.Fatalf("expected %d exceptions, got %d: %#v", len(want), len(exceptions), exceptions)
@@ -51,8 +54,82 @@ func TestBuildDashboardExceptionsSkipsHealthyState(t *testing.T) {
 		models.DashboardProxySource{Healthy: true},
 		models.DashboardCookies{Total: 2, Active: 1},
 		models.DashboardBilling{},
+		models.DashboardWorkers{Available: true, Instances: 2, MaxConcurrent: 10, Running: 3},
 	)
 	if len(exceptions) != 0 {
 		t.Fatalf("expected no exceptions, got %#v", exceptions)
 	}
 }
+
+func TestSummarizeWorkerPoolsDeduplicatesSharedQueues(t *testing.T) {
+	t.Parallel()
+
+	workers := summarizeWorkerPools([]*pb.WorkerPoolStatus{
+		{
+			InstanceId:    "media-1",
+			MaxConcurrent: 5,
+			Running:       5,
+			Buffered:      2,
+			Queues: []*pb.WorkerQueueDepth{
+				{Queue: "download", Messages: 12},
+				{Queue: "download.youtube", Messages: 3},
+			},
+		},
+		{
+			InstanceId:    "media-2",
+			MaxConcurrent: 5,
+			Running:       4,
+			Paused:        true,
+			Queues: []*pb.WorkerQueueDepth{
+				{Queue: "download", Messages: 10},
+			},
+		},
+	})
+
+	if !workers.Available || workers.Instances != 2 || workers.PausedInstances != 1 {
+		t.Fatalf("unexpected instance summary: %#v", workers)
+	}
+	if workers.MaxConcurrent != 10 || workers.Running != 9 || workers.Buffered != 2 {
+		t.Fatalf("unexpected capacity summary: %#v", workers)
+	}
+	if workers.Queued != 15 {
+		t.Fatalf("expected queued 15, got %d", workers.Queued)
+	}
+	if workers.Saturation != 0.9 {
+		t.Fatalf("expected saturation 0.9, got %v", workers.Saturation)
+	}
+}
+
+func TestBuildDashboardExceptionsFlagsWorkerPressure(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		workers  models.DashboardWorkers
+		severity string
+	}{
+		{"unavailable", models.DashboardWorkers{}, "warning"},
+		{"paused", models.DashboardWorkers{Available: true, Instances: 1, MaxConcurrent: 5, PausedInstances: 1}, "warning"},
+		{"saturated", models.DashboardWorkers{Available: true, Instances: 1, MaxConcurrent: 5, Running: 5, Queued: 8, Saturation: 1}, "warning"},
+		{"busy without backlog", models.DashboardWorkers{Available: true, Instances: 1, MaxConcurrent: 5, Running: 5, Saturation: 1}, ""},
+	}
+	for _, tc := range cases {
+		exceptions := buildDashboardExceptions(
+			models.DashboardDownloads{},
+			models.DashboardProxies{Total: 1, Available: 1},
+			models.DashboardProxySource{Healthy: true},
+			models.DashboardCookies{},
+			models.DashboardBilling{},
+			tc.workers,
+		)
+		if tc.severity == "" {
+			if len(exceptions) != 0 {
+				t.Fatalf("%s: expected no exceptions, got %#v", tc.name, exceptions)
+			}
+			continue
+		}
+		if len(exceptions) != 1 || exceptions[0].Area != "Workers" || exceptions[0].Severity != tc.severity {
+			t.Fatalf("%s: expected one %s worker exception, got %#v", tc.name, tc.severity, exceptions)
+		}
+	}
+}
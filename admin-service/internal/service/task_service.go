@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+
+	"youdlp/admin-service/internal/models"
+	pb "youdlp/admin-service/proto"
+)
+
+type TaskService struct {
+	downloaderClient pb.DownloaderServiceClient
+}
+
+func NewTaskService(downloaderClient pb.DownloaderServiceClient) *TaskService {
+	return &TaskService{downloaderClient: downloaderClient}
+}
+
+func (s *TaskService) List(ctx context.Context, req models.TaskFilter) (*models.TaskListResponse, error) {
+	resp, err := s.downloaderClient.ListTasks(ctx, &pb.ListTasksRequest{
+		State:             req.State,
+		Platform:          req.Platform,
+		ErrorCategory:     req.ErrorCategory,
+		UserId:            req.UserID,
+		StartTimeUnix:     req.StartTimeUnix,
+		EndTimeUnix:       req.EndTimeUnix,
+		StuckAfterSeconds: req.StuckAfterSeconds,
+		Page:              req.Page,
+		PageSize:          req.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]models.TaskInfo, 0, len(resp.Tasks))
+	for _, task := range resp.Tasks {
+		items = append(items, models.TaskInfo{
+			TaskID:         task.TaskId,
+			UserID:         task.UserId,
+			URL:            task.Url,
+			Platform:       task.Platform,
+			Title:          task.Title,
+			Mode:           task.Mode,
+			Quality:        task.Quality,
+			Status:         task.Status,
+			StatusText:     task.StatusText,
+			ErrorMessage:   task.ErrorMessage,
+			ErrorCategory:  task.ErrorCategory,
+			RetryCount:     task.RetryCount,
+			CreatedAt:      task.CreatedAt,
+			StartedAt:      task.StartedAt,
+			DeadLetteredAt: task.DeadLetteredAt,
+			DeadLettered:   task.DeadLettered,
+			Stuck:          task.Stuck,
+			Replayable:     task.Replayable,
+		})
+	}
+
+	return &models.TaskListResponse{
+		Items:    items,
+		Total:    resp.Total,
+		Page:     resp.Page,
+		PageSize: resp.PageSize,
+	}, nil
+}
+
+func (s *TaskService) Replay(ctx context.Context, req models.TaskActionRequest) (*models.TaskActionResponse, error) {
+	resp, err := s.downloaderClient.ReplayTask(ctx, &pb.ReplayTaskRequest{
+		TaskId:            req.TaskID,
+		OperatorUserId:    req.OperatorUserID,
+		StuckAfterSeconds: req.StuckAfterSeconds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return taskActionFromProto(resp), nil
+}
+
+func (s *TaskService) ForceFail(ctx context.Context, req models.TaskActionRequest) (*models.TaskActionResponse, error) {
+	resp, err := s.downloaderClient.ForceFailTask(ctx, &pb.ForceFailTaskRequest{
+		TaskId:         req.TaskID,
+		Reason:         req.Reason,
+		OperatorUserId: req.OperatorUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return taskActionFromProto(resp), nil
+}
+
+func (s *TaskService) Purge(ctx context.Context, req models.TaskActionRequest) (*models.TaskActionResponse, error) {
+	resp, err := s.downloaderClient.PurgeTask(ctx, &pb.PurgeTaskRequest{
+		TaskId:         req.TaskID,
+		OperatorUserId: req.OperatorUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return taskActionFromProto(resp), nil
+}
+
+func (s *TaskService) ListWorkerPools(ctx context.Context) (*models.WorkerPoolListResponse, error) {
+	resp, err := s.downloaderClient.GetWorkerPools(ctx, &pb.GetWorkerPoolsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]models.WorkerPool, 0, len(resp.Instances))
+	for _, instance := range resp.Instances {
+		items = append(items, workerPoolFromProto(instance))
+	}
+	return &models.WorkerPoolListResponse{Items: items}, nil
+}
+
+func (s *TaskService) UpdateWorkerPool(ctx context.Context, req models.WorkerPoolUpdateRequest) (*models.TaskActionResponse, error) {
+	resp, err := s.downloaderClient.UpdateWorkerPool(ctx, &pb.UpdateWorkerPoolRequest{
+		InstanceId:     req.InstanceID,
+		Action:         req.Action,
+		MaxConcurrent:  req.MaxConcurrent,
+		OperatorUserId: req.OperatorUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return taskActionFromProto(resp), nil
+}
+
+func workerPoolFromProto(item *pb.WorkerPoolStatus) models.WorkerPool {
+	queues := make([]models.WorkerQueueDepth, 0, len(item.GetQueues()))
+	for _, q := range item.GetQueues() {
+		queues = append(queues, models.WorkerQueueDepth{
+			Queue:     q.GetQueue(),
+			Platform:  q.GetPlatform(),
+			Messages:  q.GetMessages(),
+			Consumers: q.GetConsumers(),
+			Paused:    q.GetPaused(),
+		})
+	}
+
+	tasks := make([]models.WorkerActiveTask, 0, len(item.GetActiveTasks()))
+	for _, t := range item.GetActiveTasks() {
+		tasks = append(tasks, models.WorkerActiveTask{
+			TaskID:    t.GetTaskId(),
+			UserID:    t.GetUserId(),
+			Platform:  t.GetPlatform(),
+			Phase:     t.GetPhase(),
+			Percent:   t.GetPercent(),
+			StartedAt: t.GetStartedAt(),
+		})
+	}
+
+	return models.WorkerPool{
+		InstanceID:     item.GetInstanceId(),
+		PoolSize:       item.GetPoolSize(),
+		MaxConcurrent:  item.GetMaxConcurrent(),
+		Running:        item.GetRunning(),
+		Buffered:       item.GetBuffered(),
+		BufferCapacity: item.GetBufferCapacity(),
+		Paused:         item.GetPaused(),
+		Queues:         queues,
+		ActiveTasks:    tasks,
+		UpdatedAt:      item.GetUpdatedAt(),
+	}
+}
+
+func taskActionFromProto(resp *pb.TaskActionResponse) *models.TaskActionResponse {
+	return &models.TaskActionResponse{
+		Success: resp.GetSuccess(),
+		Message: resp.GetMessage(),
+	}
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+
+	"youdlp/admin-service/internal/models"
+	pb "youdlp/admin-service/proto"
+)
+
+// WebhookService 管理全局 Webhook 端点（接收所有用户的事件）
+type WebhookService struct {
+	assetClient pb.AssetServiceClient
+}
+
+func NewWebhookService(assetClient pb.AssetServiceClient) *WebhookService {
+	return &WebhookService{assetClient: assetClient}
+}
+
+func (s *WebhookService) List(ctx context.Context) (*models.WebhookListResponse, error) {
+	resp, err := s.assetClient.ListWebhooks(ctx, &pb.ListWebhooksRequest{Global: true})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]models.WebhookEndpoint, 0, len(resp.GetItems()))
+	for _, item := range resp.GetItems() {
+		items = append(items, webhookEndpointFromProto(item))
+	}
+	return &models.WebhookListResponse{
+		Items:           items,
+		AvailableEvents: resp.GetAvailableEvents(),
+	}, nil
+}
+
+func (s *WebhookService) Create(ctx context.Context, req models.CreateWebhookRequest) (*models.CreateWebhookResponse, error) {
+	resp, err := s.assetClient.CreateWebhook(ctx, &pb.CreateWebhookRequest{
+		Global:         true,
+		Url:            req.URL,
+		Events:         req.Events,
+		Description:    req.Description,
+		OperatorUserId: req.OperatorUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &models.CreateWebhookResponse{
+		Endpoint: webhookEndpointFromProto(resp.GetEndpoint()),
+		Secret:   resp.GetSecret(),
+	}, nil
+}
+
+func (s *WebhookService) Update(ctx context.Context, id int64, req models.UpdateWebhookRequest) (*models.WebhookEndpoint, error) {
+	resp, err := s.assetClient.UpdateWebhook(ctx, &pb.UpdateWebhookRequest{
+		Id:                id,
+		Global:            true,
+		Url:               req.URL,
+		UpdateEvents:      req.UpdateEvents,
+		Events:            req.Events,
+		UpdateDescription: req.UpdateDescription,
+		Description:       req.Description,
+		Status:            req.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+	endpoint := webhookEndpointFromProto(resp.GetEndpoint())
+	return &endpoint, nil
+}
+
+func (s *WebhookService) Delete(ctx context.Context, id int64) error {
+	_, err := s.assetClient.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{Id: id, Global: true})
+	return err
+}
+
+func (s *WebhookService) ListDeliveries(ctx context.Context, req models.ListWebhookDeliveriesRequest) (*models.WebhookDeliveryListResponse, error) {
+	resp, err := s.assetClient.ListWebhookDeliveries(ctx, &pb.ListWebhookDeliveriesRequest{
+		Global:     true,
+		EndpointId: req.EndpointID,
+		Status:     req.Status,
+		EventType:  req.EventType,
+		Page:       int32(req.Page),
+		PageSize:   int32(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]models.WebhookDelivery, 0, len(resp.GetItems()))
+	for _, item := range resp.GetItems() {
+		items = append(items, models.WebhookDelivery{
+			ID:             item.GetId(),
+			EndpointID:     item.GetEndpointId(),
+			EventID:        item.GetEventId(),
+			EventType:      item.GetEventType(),
+			UserID:         item.GetUserId(),
+			PayloadJSON:    item.GetPayloadJson(),
+			Status:         item.GetStatus(),
+			Attempts:       item.GetAttempts(),
+			NextAttemptAt:  item.GetNextAttemptAt(),
+			LastAttemptAt:  item.GetLastAttemptAt(),
+			ResponseStatus: item.GetResponseStatus(),
+			ResponseBody:   item.GetResponseBody(),
+			LastError:      item.GetLastError(),
+			DurationMS:     item.GetDurationMs(),
+			CreatedAt:      item.GetCreatedAt(),
+		})
+	}
+	return &models.WebhookDeliveryListResponse{
+		Total:    resp.GetTotal(),
+		Page:     int(resp.GetPage()),
+		PageSize: int(resp.GetPageSize()),
+		Items:    items,
+	}, nil
+}
+
+func (s *WebhookService) Redeliver(ctx context.Context, deliveryID int64) error {
+	_, err := s.assetClient.RedeliverWebhook(ctx, &pb.RedeliverWebhookRequest{DeliveryId: deliveryID, Global: true})
+	return err
+}
+
+func webhookEndpointFromProto(item *pb.WebhookEndpointInfo) models.WebhookEndpoint {
+	return models.WebhookEndpoint{
+		ID:          item.GetId(),
+		URL:         item.GetUrl(),
+		Events:      item.GetEvents(),
+		Description: item.GetDescription(),
+		Enabled:     item.GetEnabled(),
+		CreatedBy:   item.GetCreatedBy(),
+		CreatedAt:   item.GetCreatedAt(),
+		UpdatedAt:   item.GetUpdatedAt(),
+	}
+}
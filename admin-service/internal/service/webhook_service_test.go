@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"youdlp/admin-service/internal/models"
+	pb "youdlp/admin-service/proto"
+)
+
+type stubWebhookAssetClient struct {
+	pb.AssetServiceClient
+
+	createReq    *pb.CreateWebhookRequest
+	redeliverReq *pb.RedeliverWebhookRequest
+}
+
+func (s *stubWebhookAssetClient) CreateWebhook(_ context.Context, in *pb.CreateWebhookRequest, _ ...grpc.CallOption) (*pb.CreateWebhookResponse, error) {
+	s.createReq = in
+	return &pb.CreateWebhookResponse{
+		Endpoint: &pb.WebhookEndpointInfo{Id: 3, Url: in.GetUrl(), Events: in.GetEvents(), Enabled: true},
+		Secret:   "whsec_test",
+	}, nil
+}
+
+func (s *stubWebhookAssetClient) RedeliverWebhook(_ context.Context, in *pb.RedeliverWebhookRequest, _ ...grpc.CallOption) (*pb.RedeliverWebhookResponse, error) {
+	s.redeliverReq = in
+	return &pb.RedeliverWebhookResponse{Success: true}, nil
+}
+
+func TestWebhookServiceManagesGlobalEndpoints(t *testing.T) {
+	t.Parallel()
+
+	asset := &stubWebhookAssetClient{}
+	svc := NewWebhookService(asset)
+
+	resp, err := svc.Create(context.Background(), models.CreateWebhookRequest{
+		URL:            "https://hooks.example.com/youdlp",
+		Events:         []string{"task.failed"},
+		OperatorUserID: "admin-1",
+	})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if !asset.createReq.GetGlobal() || asset.createReq.GetUserId() != "" || asset.createReq.GetOperatorUserId() != "admin-1" {
+		t.Fatalf("expected global endpoint request, got %+v", asset.createReq)
+	}
+	if resp.Secret != "whsec_test" || resp.Endpoint.ID != 3 {
+		t.Fatalf("unexpected create response: %+v", resp)
+	}
+
+	if err := svc.Redeliver(context.Background(), 9); err != nil {
+		t.Fatalf("Redeliver returned error: %v", err)
+	}
+	if !asset.redeliverReq.GetGlobal() || asset.redeliverReq.GetDeliveryId() != 9 {
+		t.Fatalf("expected global redeliver request, got %+v", asset.redeliverReq)
+	}
+}
@@ -1273,6 +1273,106 @@ func (x *AdminDashboardBilling) GetShortfallCount() int64 {
 	return 0
 }
 
+type AdminDashboardWorkers struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Available       bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	Instances       int32                  `protobuf:"varint,2,opt,name=instances,proto3" json:"instances,omitempty"`
+	MaxConcurrent   int32                  `protobuf:"varint,3,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
+	Running         int32                  `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`
+	Buffered        int32                  `protobuf:"varint,5,opt,name=buffered,proto3" json:"buffered,omitempty"`
+	Queued          int64                  `protobuf:"varint,6,opt,name=queued,proto3" json:"queued,omitempty"`
+	PausedInstances int32                  `protobuf:"varint,7,opt,name=paused_instances,json=pausedInstances,proto3" json:"paused_instances,omitempty"`
+	Saturation      float64                `protobuf:"fixed64,8,opt,name=saturation,proto3" json:"saturation,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AdminDashboardWorkers) Reset() {
+	*x = AdminDashboardWorkers{}
+	mi := &file_proto_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDashboardWorkers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDashboardWorkers) ProtoMessage() {}
+
+func (x *AdminDashboardWorkers) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDashboardWorkers.ProtoReflect.Descriptor instead.
+func (*AdminDashboardWorkers) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AdminDashboardWorkers) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *AdminDashboardWorkers) GetInstances() int32 {
+	if x != nil {
+		return x.Instances
+	}
+	return 0
+}
+
+func (x *AdminDashboardWorkers) GetMaxConcurrent() int32 {
+	if x != nil {
+		return x.MaxConcurrent
+	}
+	return 0
+}
+
+func (x *AdminDashboardWorkers) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *AdminDashboardWorkers) GetBuffered() int32 {
+	if x != nil {
+		return x.Buffered
+	}
+	return 0
+}
+
+func (x *AdminDashboardWorkers) GetQueued() int64 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *AdminDashboardWorkers) GetPausedInstances() int32 {
+	if x != nil {
+		return x.PausedInstances
+	}
+	return 0
+}
+
+func (x *AdminDashboardWorkers) GetSaturation() float64 {
+	if x != nil {
+		return x.Saturation
+	}
+	return 0
+}
+
 type AdminDashboardException struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Area          string                 `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
@@ -1286,7 +1386,7 @@ type AdminDashboardException struct {
 
 func (x *AdminDashboardException) Reset() {
 	*x = AdminDashboardException{}
-	mi := &file_proto_admin_proto_msgTypes[19]
+	mi := &file_proto_admin_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1298,7 +1398,7 @@ func (x *AdminDashboardException) String() string {
 func (*AdminDashboardException) ProtoMessage() {}
 
 func (x *AdminDashboardException) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[19]
+	mi := &file_proto_admin_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,7 +1411,7 @@ func (x *AdminDashboardException) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminDashboardException.ProtoReflect.Descriptor instead.
 func (*AdminDashboardException) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{19}
+	return file_proto_admin_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *AdminDashboardException) GetArea() string {
@@ -1360,13 +1460,14 @@ type AdminDashboardHealthResponse struct {
 	Cookies       *AdminDashboardCookies     `protobuf:"bytes,7,opt,name=cookies,proto3" json:"cookies,omitempty"`
 	Billing       *AdminDashboardBilling     `protobuf:"bytes,8,opt,name=billing,proto3" json:"billing,omitempty"`
 	Exceptions    []*AdminDashboardException `protobuf:"bytes,9,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	Workers       *AdminDashboardWorkers     `protobuf:"bytes,10,opt,name=workers,proto3" json:"workers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AdminDashboardHealthResponse) Reset() {
 	*x = AdminDashboardHealthResponse{}
-	mi := &file_proto_admin_proto_msgTypes[20]
+	mi := &file_proto_admin_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1378,7 +1479,7 @@ func (x *AdminDashboardHealthResponse) String() string {
 func (*AdminDashboardHealthResponse) ProtoMessage() {}
 
 func (x *AdminDashboardHealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[20]
+	mi := &file_proto_admin_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1391,7 +1492,7 @@ func (x *AdminDashboardHealthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminDashboardHealthResponse.ProtoReflect.Descriptor instead.
 func (*AdminDashboardHealthResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{20}
+	return file_proto_admin_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *AdminDashboardHealthResponse) GetGeneratedAt() string {
@@ -1457,6 +1558,13 @@ func (x *AdminDashboardHealthResponse) GetExceptions() []*AdminDashboardExceptio
 	return nil
 }
 
+func (x *AdminDashboardHealthResponse) GetWorkers() *AdminDashboardWorkers {
+	if x != nil {
+		return x.Workers
+	}
+	return nil
+}
+
 type AdminUserStatsResponse struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	TotalUsers        int64                  `protobuf:"varint,1,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
@@ -1468,7 +1576,7 @@ type AdminUserStatsResponse struct {
 
 func (x *AdminUserStatsResponse) Reset() {
 	*x = AdminUserStatsResponse{}
-	mi := &file_proto_admin_proto_msgTypes[21]
+	mi := &file_proto_admin_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1480,7 +1588,7 @@ func (x *AdminUserStatsResponse) String() string {
 func (*AdminUserStatsResponse) ProtoMessage() {}
 
 func (x *AdminUserStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[21]
+	mi := &file_proto_admin_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1493,7 +1601,7 @@ func (x *AdminUserStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUserStatsResponse.ProtoReflect.Descriptor instead.
 func (*AdminUserStatsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{21}
+	return file_proto_admin_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *AdminUserStatsResponse) GetTotalUsers() int64 {
@@ -1534,7 +1642,7 @@ type AdminProxySourceStatusResponse struct {
 
 func (x *AdminProxySourceStatusResponse) Reset() {
 	*x = AdminProxySourceStatusResponse{}
-	mi := &file_proto_admin_proto_msgTypes[22]
+	mi := &file_proto_admin_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1546,7 +1654,7 @@ func (x *AdminProxySourceStatusResponse) String() string {
 func (*AdminProxySourceStatusResponse) ProtoMessage() {}
 
 func (x *AdminProxySourceStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[22]
+	mi := &file_proto_admin_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1559,7 +1667,7 @@ func (x *AdminProxySourceStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminProxySourceStatusResponse.ProtoReflect.Descriptor instead.
 func (*AdminProxySourceStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{22}
+	return file_proto_admin_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *AdminProxySourceStatusResponse) GetHealthy() bool {
@@ -1644,7 +1752,7 @@ type AdminProxySourcePolicyResponse struct {
 
 func (x *AdminProxySourcePolicyResponse) Reset() {
 	*x = AdminProxySourcePolicyResponse{}
-	mi := &file_proto_admin_proto_msgTypes[23]
+	mi := &file_proto_admin_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1656,7 +1764,7 @@ func (x *AdminProxySourcePolicyResponse) String() string {
 func (*AdminProxySourcePolicyResponse) ProtoMessage() {}
 
 func (x *AdminProxySourcePolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[23]
+	mi := &file_proto_admin_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1669,7 +1777,7 @@ func (x *AdminProxySourcePolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminProxySourcePolicyResponse.ProtoReflect.Descriptor instead.
 func (*AdminProxySourcePolicyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{23}
+	return file_proto_admin_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *AdminProxySourcePolicyResponse) GetId() int64 {
@@ -1766,7 +1874,7 @@ type AdminUpdateProxySourcePolicyRequest struct {
 
 func (x *AdminUpdateProxySourcePolicyRequest) Reset() {
 	*x = AdminUpdateProxySourcePolicyRequest{}
-	mi := &file_proto_admin_proto_msgTypes[24]
+	mi := &file_proto_admin_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1778,7 +1886,7 @@ func (x *AdminUpdateProxySourcePolicyRequest) String() string {
 func (*AdminUpdateProxySourcePolicyRequest) ProtoMessage() {}
 
 func (x *AdminUpdateProxySourcePolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[24]
+	mi := &file_proto_admin_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1791,7 +1899,7 @@ func (x *AdminUpdateProxySourcePolicyRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use AdminUpdateProxySourcePolicyRequest.ProtoReflect.Descriptor instead.
 func (*AdminUpdateProxySourcePolicyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{24}
+	return file_proto_admin_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *AdminUpdateProxySourcePolicyRequest) GetId() int64 {
@@ -1887,7 +1995,7 @@ type AdminProxyInfo struct {
 
 func (x *AdminProxyInfo) Reset() {
 	*x = AdminProxyInfo{}
-	mi := &file_proto_admin_proto_msgTypes[25]
+	mi := &file_proto_admin_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1899,7 +2007,7 @@ func (x *AdminProxyInfo) String() string {
 func (*AdminProxyInfo) ProtoMessage() {}
 
 func (x *AdminProxyInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[25]
+	mi := &file_proto_admin_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1912,7 +2020,7 @@ func (x *AdminProxyInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminProxyInfo.ProtoReflect.Descriptor instead.
 func (*AdminProxyInfo) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{25}
+	return file_proto_admin_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *AdminProxyInfo) GetId() int64 {
@@ -2086,7 +2194,7 @@ type AdminListProxiesRequest struct {
 
 func (x *AdminListProxiesRequest) Reset() {
 	*x = AdminListProxiesRequest{}
-	mi := &file_proto_admin_proto_msgTypes[26]
+	mi := &file_proto_admin_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2098,7 +2206,7 @@ func (x *AdminListProxiesRequest) String() string {
 func (*AdminListProxiesRequest) ProtoMessage() {}
 
 func (x *AdminListProxiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[26]
+	mi := &file_proto_admin_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2111,7 +2219,7 @@ func (x *AdminListProxiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListProxiesRequest.ProtoReflect.Descriptor instead.
 func (*AdminListProxiesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{26}
+	return file_proto_admin_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *AdminListProxiesRequest) GetSearch() string {
@@ -2189,7 +2297,7 @@ type AdminListProxiesResponse struct {
 
 func (x *AdminListProxiesResponse) Reset() {
 	*x = AdminListProxiesResponse{}
-	mi := &file_proto_admin_proto_msgTypes[27]
+	mi := &file_proto_admin_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2201,7 +2309,7 @@ func (x *AdminListProxiesResponse) String() string {
 func (*AdminListProxiesResponse) ProtoMessage() {}
 
 func (x *AdminListProxiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[27]
+	mi := &file_proto_admin_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2214,7 +2322,7 @@ func (x *AdminListProxiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListProxiesResponse.ProtoReflect.Descriptor instead.
 func (*AdminListProxiesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{27}
+	return file_proto_admin_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *AdminListProxiesResponse) GetItems() []*AdminProxyInfo {
@@ -2266,7 +2374,7 @@ type AdminListProxyUsageEventsRequest struct {
 
 func (x *AdminListProxyUsageEventsRequest) Reset() {
 	*x = AdminListProxyUsageEventsRequest{}
-	mi := &file_proto_admin_proto_msgTypes[28]
+	mi := &file_proto_admin_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2278,7 +2386,7 @@ func (x *AdminListProxyUsageEventsRequest) String() string {
 func (*AdminListProxyUsageEventsRequest) ProtoMessage() {}
 
 func (x *AdminListProxyUsageEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[28]
+	mi := &file_proto_admin_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2291,7 +2399,7 @@ func (x *AdminListProxyUsageEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListProxyUsageEventsRequest.ProtoReflect.Descriptor instead.
 func (*AdminListProxyUsageEventsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{28}
+	return file_proto_admin_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AdminListProxyUsageEventsRequest) GetTaskId() string {
@@ -2412,7 +2520,7 @@ type AdminProxyUsageEventItem struct {
 
 func (x *AdminProxyUsageEventItem) Reset() {
 	*x = AdminProxyUsageEventItem{}
-	mi := &file_proto_admin_proto_msgTypes[29]
+	mi := &file_proto_admin_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2424,7 +2532,7 @@ func (x *AdminProxyUsageEventItem) String() string {
 func (*AdminProxyUsageEventItem) ProtoMessage() {}
 
 func (x *AdminProxyUsageEventItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[29]
+	mi := &file_proto_admin_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2437,7 +2545,7 @@ func (x *AdminProxyUsageEventItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminProxyUsageEventItem.ProtoReflect.Descriptor instead.
 func (*AdminProxyUsageEventItem) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{29}
+	return file_proto_admin_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *AdminProxyUsageEventItem) GetId() int64 {
@@ -2583,7 +2691,7 @@ type AdminProxyUsageEventCount struct {
 
 func (x *AdminProxyUsageEventCount) Reset() {
 	*x = AdminProxyUsageEventCount{}
-	mi := &file_proto_admin_proto_msgTypes[30]
+	mi := &file_proto_admin_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2595,7 +2703,7 @@ func (x *AdminProxyUsageEventCount) String() string {
 func (*AdminProxyUsageEventCount) ProtoMessage() {}
 
 func (x *AdminProxyUsageEventCount) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[30]
+	mi := &file_proto_admin_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2608,7 +2716,7 @@ func (x *AdminProxyUsageEventCount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminProxyUsageEventCount.ProtoReflect.Descriptor instead.
 func (*AdminProxyUsageEventCount) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{30}
+	return file_proto_admin_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *AdminProxyUsageEventCount) GetKey() string {
@@ -2639,7 +2747,7 @@ type AdminProxyUsageEventSummary struct {
 
 func (x *AdminProxyUsageEventSummary) Reset() {
 	*x = AdminProxyUsageEventSummary{}
-	mi := &file_proto_admin_proto_msgTypes[31]
+	mi := &file_proto_admin_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2651,7 +2759,7 @@ func (x *AdminProxyUsageEventSummary) String() string {
 func (*AdminProxyUsageEventSummary) ProtoMessage() {}
 
 func (x *AdminProxyUsageEventSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[31]
+	mi := &file_proto_admin_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2664,7 +2772,7 @@ func (x *AdminProxyUsageEventSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminProxyUsageEventSummary.ProtoReflect.Descriptor instead.
 func (*AdminProxyUsageEventSummary) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{31}
+	return file_proto_admin_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *AdminProxyUsageEventSummary) GetSuccessCount() int64 {
@@ -2722,7 +2830,7 @@ type AdminListProxyUsageEventsResponse struct {
 
 func (x *AdminListProxyUsageEventsResponse) Reset() {
 	*x = AdminListProxyUsageEventsResponse{}
-	mi := &file_proto_admin_proto_msgTypes[32]
+	mi := &file_proto_admin_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2734,7 +2842,7 @@ func (x *AdminListProxyUsageEventsResponse) String() string {
 func (*AdminListProxyUsageEventsResponse) ProtoMessage() {}
 
 func (x *AdminListProxyUsageEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[32]
+	mi := &file_proto_admin_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2747,7 +2855,7 @@ func (x *AdminListProxyUsageEventsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AdminListProxyUsageEventsResponse.ProtoReflect.Descriptor instead.
 func (*AdminListProxyUsageEventsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{32}
+	return file_proto_admin_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *AdminListProxyUsageEventsResponse) GetEvents() []*AdminProxyUsageEventItem {
@@ -2803,7 +2911,7 @@ type AdminCreateProxyRequest struct {
 
 func (x *AdminCreateProxyRequest) Reset() {
 	*x = AdminCreateProxyRequest{}
-	mi := &file_proto_admin_proto_msgTypes[33]
+	mi := &file_proto_admin_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2815,7 +2923,7 @@ func (x *AdminCreateProxyRequest) String() string {
 func (*AdminCreateProxyRequest) ProtoMessage() {}
 
 func (x *AdminCreateProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[33]
+	mi := &file_proto_admin_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2828,7 +2936,7 @@ func (x *AdminCreateProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminCreateProxyRequest.ProtoReflect.Descriptor instead.
 func (*AdminCreateProxyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{33}
+	return file_proto_admin_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *AdminCreateProxyRequest) GetHost() string {
@@ -2919,7 +3027,7 @@ type AdminUpdateProxyRequest struct {
 
 func (x *AdminUpdateProxyRequest) Reset() {
 	*x = AdminUpdateProxyRequest{}
-	mi := &file_proto_admin_proto_msgTypes[34]
+	mi := &file_proto_admin_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2931,7 +3039,7 @@ func (x *AdminUpdateProxyRequest) String() string {
 func (*AdminUpdateProxyRequest) ProtoMessage() {}
 
 func (x *AdminUpdateProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[34]
+	mi := &file_proto_admin_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2944,7 +3052,7 @@ func (x *AdminUpdateProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUpdateProxyRequest.ProtoReflect.Descriptor instead.
 func (*AdminUpdateProxyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{34}
+	return file_proto_admin_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *AdminUpdateProxyRequest) GetId() int64 {
@@ -3027,7 +3135,7 @@ type AdminUpdateProxyStatusRequest struct {
 
 func (x *AdminUpdateProxyStatusRequest) Reset() {
 	*x = AdminUpdateProxyStatusRequest{}
-	mi := &file_proto_admin_proto_msgTypes[35]
+	mi := &file_proto_admin_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3039,7 +3147,7 @@ func (x *AdminUpdateProxyStatusRequest) String() string {
 func (*AdminUpdateProxyStatusRequest) ProtoMessage() {}
 
 func (x *AdminUpdateProxyStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[35]
+	mi := &file_proto_admin_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3052,7 +3160,7 @@ func (x *AdminUpdateProxyStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUpdateProxyStatusRequest.ProtoReflect.Descriptor instead.
 func (*AdminUpdateProxyStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{35}
+	return file_proto_admin_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *AdminUpdateProxyStatusRequest) GetId() int64 {
@@ -3078,7 +3186,7 @@ type AdminDeleteRequest struct {
 
 func (x *AdminDeleteRequest) Reset() {
 	*x = AdminDeleteRequest{}
-	mi := &file_proto_admin_proto_msgTypes[36]
+	mi := &file_proto_admin_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3090,7 +3198,7 @@ func (x *AdminDeleteRequest) String() string {
 func (*AdminDeleteRequest) ProtoMessage() {}
 
 func (x *AdminDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[36]
+	mi := &file_proto_admin_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3103,7 +3211,7 @@ func (x *AdminDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminDeleteRequest.ProtoReflect.Descriptor instead.
 func (*AdminDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{36}
+	return file_proto_admin_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *AdminDeleteRequest) GetId() int64 {
@@ -3135,7 +3243,7 @@ type AdminCookieInfo struct {
 
 func (x *AdminCookieInfo) Reset() {
 	*x = AdminCookieInfo{}
-	mi := &file_proto_admin_proto_msgTypes[37]
+	mi := &file_proto_admin_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3147,7 +3255,7 @@ func (x *AdminCookieInfo) String() string {
 func (*AdminCookieInfo) ProtoMessage() {}
 
 func (x *AdminCookieInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[37]
+	mi := &file_proto_admin_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3160,7 +3268,7 @@ func (x *AdminCookieInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminCookieInfo.ProtoReflect.Descriptor instead.
 func (*AdminCookieInfo) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{37}
+	return file_proto_admin_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *AdminCookieInfo) GetId() int64 {
@@ -3273,7 +3381,7 @@ type AdminListCookiesRequest struct {
 
 func (x *AdminListCookiesRequest) Reset() {
 	*x = AdminListCookiesRequest{}
-	mi := &file_proto_admin_proto_msgTypes[38]
+	mi := &file_proto_admin_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3285,7 +3393,7 @@ func (x *AdminListCookiesRequest) String() string {
 func (*AdminListCookiesRequest) ProtoMessage() {}
 
 func (x *AdminListCookiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[38]
+	mi := &file_proto_admin_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3298,7 +3406,7 @@ func (x *AdminListCookiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListCookiesRequest.ProtoReflect.Descriptor instead.
 func (*AdminListCookiesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{38}
+	return file_proto_admin_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *AdminListCookiesRequest) GetPlatform() string {
@@ -3341,7 +3449,7 @@ type AdminListCookiesResponse struct {
 
 func (x *AdminListCookiesResponse) Reset() {
 	*x = AdminListCookiesResponse{}
-	mi := &file_proto_admin_proto_msgTypes[39]
+	mi := &file_proto_admin_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3353,7 +3461,7 @@ func (x *AdminListCookiesResponse) String() string {
 func (*AdminListCookiesResponse) ProtoMessage() {}
 
 func (x *AdminListCookiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[39]
+	mi := &file_proto_admin_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3366,7 +3474,7 @@ func (x *AdminListCookiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListCookiesResponse.ProtoReflect.Descriptor instead.
 func (*AdminListCookiesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{39}
+	return file_proto_admin_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *AdminListCookiesResponse) GetTotal() int64 {
@@ -3406,7 +3514,7 @@ type AdminGetCookieRequest struct {
 
 func (x *AdminGetCookieRequest) Reset() {
 	*x = AdminGetCookieRequest{}
-	mi := &file_proto_admin_proto_msgTypes[40]
+	mi := &file_proto_admin_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3418,7 +3526,7 @@ func (x *AdminGetCookieRequest) String() string {
 func (*AdminGetCookieRequest) ProtoMessage() {}
 
 func (x *AdminGetCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[40]
+	mi := &file_proto_admin_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3431,7 +3539,7 @@ func (x *AdminGetCookieRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminGetCookieRequest.ProtoReflect.Descriptor instead.
 func (*AdminGetCookieRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{40}
+	return file_proto_admin_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *AdminGetCookieRequest) GetId() int64 {
@@ -3450,7 +3558,7 @@ type AdminGetCookieResponse struct {
 
 func (x *AdminGetCookieResponse) Reset() {
 	*x = AdminGetCookieResponse{}
-	mi := &file_proto_admin_proto_msgTypes[41]
+	mi := &file_proto_admin_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3462,7 +3570,7 @@ func (x *AdminGetCookieResponse) String() string {
 func (*AdminGetCookieResponse) ProtoMessage() {}
 
 func (x *AdminGetCookieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[41]
+	mi := &file_proto_admin_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3475,7 +3583,7 @@ func (x *AdminGetCookieResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminGetCookieResponse.ProtoReflect.Descriptor instead.
 func (*AdminGetCookieResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{41}
+	return file_proto_admin_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *AdminGetCookieResponse) GetCookie() *AdminCookieInfo {
@@ -3498,7 +3606,7 @@ type AdminCreateCookieRequest struct {
 
 func (x *AdminCreateCookieRequest) Reset() {
 	*x = AdminCreateCookieRequest{}
-	mi := &file_proto_admin_proto_msgTypes[42]
+	mi := &file_proto_admin_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3510,7 +3618,7 @@ func (x *AdminCreateCookieRequest) String() string {
 func (*AdminCreateCookieRequest) ProtoMessage() {}
 
 func (x *AdminCreateCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[42]
+	mi := &file_proto_admin_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3523,7 +3631,7 @@ func (x *AdminCreateCookieRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminCreateCookieRequest.ProtoReflect.Descriptor instead.
 func (*AdminCreateCookieRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{42}
+	return file_proto_admin_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *AdminCreateCookieRequest) GetPlatform() string {
@@ -3574,7 +3682,7 @@ type AdminUpdateCookieRequest struct {
 
 func (x *AdminUpdateCookieRequest) Reset() {
 	*x = AdminUpdateCookieRequest{}
-	mi := &file_proto_admin_proto_msgTypes[43]
+	mi := &file_proto_admin_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3586,7 +3694,7 @@ func (x *AdminUpdateCookieRequest) String() string {
 func (*AdminUpdateCookieRequest) ProtoMessage() {}
 
 func (x *AdminUpdateCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[43]
+	mi := &file_proto_admin_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3599,7 +3707,7 @@ func (x *AdminUpdateCookieRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUpdateCookieRequest.ProtoReflect.Descriptor instead.
 func (*AdminUpdateCookieRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{43}
+	return file_proto_admin_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *AdminUpdateCookieRequest) GetId() int64 {
@@ -3647,7 +3755,7 @@ type AdminFreezeCookieRequest struct {
 
 func (x *AdminFreezeCookieRequest) Reset() {
 	*x = AdminFreezeCookieRequest{}
-	mi := &file_proto_admin_proto_msgTypes[44]
+	mi := &file_proto_admin_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3659,7 +3767,7 @@ func (x *AdminFreezeCookieRequest) String() string {
 func (*AdminFreezeCookieRequest) ProtoMessage() {}
 
 func (x *AdminFreezeCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[44]
+	mi := &file_proto_admin_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3672,7 +3780,7 @@ func (x *AdminFreezeCookieRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminFreezeCookieRequest.ProtoReflect.Descriptor instead.
 func (*AdminFreezeCookieRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{44}
+	return file_proto_admin_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *AdminFreezeCookieRequest) GetId() int64 {
@@ -3699,7 +3807,7 @@ type AdminFreezeCookieResponse struct {
 
 func (x *AdminFreezeCookieResponse) Reset() {
 	*x = AdminFreezeCookieResponse{}
-	mi := &file_proto_admin_proto_msgTypes[45]
+	mi := &file_proto_admin_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3711,7 +3819,7 @@ func (x *AdminFreezeCookieResponse) String() string {
 func (*AdminFreezeCookieResponse) ProtoMessage() {}
 
 func (x *AdminFreezeCookieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[45]
+	mi := &file_proto_admin_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3724,7 +3832,7 @@ func (x *AdminFreezeCookieResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminFreezeCookieResponse.ProtoReflect.Descriptor instead.
 func (*AdminFreezeCookieResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{45}
+	return file_proto_admin_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *AdminFreezeCookieResponse) GetSuccess() bool {
@@ -3750,7 +3858,7 @@ type AdminCreateResourceResponse struct {
 
 func (x *AdminCreateResourceResponse) Reset() {
 	*x = AdminCreateResourceResponse{}
-	mi := &file_proto_admin_proto_msgTypes[46]
+	mi := &file_proto_admin_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3762,7 +3870,7 @@ func (x *AdminCreateResourceResponse) String() string {
 func (*AdminCreateResourceResponse) ProtoMessage() {}
 
 func (x *AdminCreateResourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[46]
+	mi := &file_proto_admin_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3775,7 +3883,7 @@ func (x *AdminCreateResourceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminCreateResourceResponse.ProtoReflect.Descriptor instead.
 func (*AdminCreateResourceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{46}
+	return file_proto_admin_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *AdminCreateResourceResponse) GetId() int64 {
@@ -3794,7 +3902,7 @@ type AdminOperationResponse struct {
 
 func (x *AdminOperationResponse) Reset() {
 	*x = AdminOperationResponse{}
-	mi := &file_proto_admin_proto_msgTypes[47]
+	mi := &file_proto_admin_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3806,7 +3914,7 @@ func (x *AdminOperationResponse) String() string {
 func (*AdminOperationResponse) ProtoMessage() {}
 
 func (x *AdminOperationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[47]
+	mi := &file_proto_admin_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3819,7 +3927,7 @@ func (x *AdminOperationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminOperationResponse.ProtoReflect.Descriptor instead.
 func (*AdminOperationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{47}
+	return file_proto_admin_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *AdminOperationResponse) GetSuccess() bool {
@@ -3848,7 +3956,7 @@ type AdminBillingAccount struct {
 
 func (x *AdminBillingAccount) Reset() {
 	*x = AdminBillingAccount{}
-	mi := &file_proto_admin_proto_msgTypes[48]
+	mi := &file_proto_admin_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3860,7 +3968,7 @@ func (x *AdminBillingAccount) String() string {
 func (*AdminBillingAccount) ProtoMessage() {}
 
 func (x *AdminBillingAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[48]
+	mi := &file_proto_admin_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3873,7 +3981,7 @@ func (x *AdminBillingAccount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminBillingAccount.ProtoReflect.Descriptor instead.
 func (*AdminBillingAccount) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{48}
+	return file_proto_admin_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *AdminBillingAccount) GetUserId() string {
@@ -3965,7 +4073,7 @@ type AdminListBillingAccountsRequest struct {
 
 func (x *AdminListBillingAccountsRequest) Reset() {
 	*x = AdminListBillingAccountsRequest{}
-	mi := &file_proto_admin_proto_msgTypes[49]
+	mi := &file_proto_admin_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3977,7 +4085,7 @@ func (x *AdminListBillingAccountsRequest) String() string {
 func (*AdminListBillingAccountsRequest) ProtoMessage() {}
 
 func (x *AdminListBillingAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[49]
+	mi := &file_proto_admin_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3990,7 +4098,7 @@ func (x *AdminListBillingAccountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListBillingAccountsRequest.ProtoReflect.Descriptor instead.
 func (*AdminListBillingAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{49}
+	return file_proto_admin_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *AdminListBillingAccountsRequest) GetQuery() string {
@@ -4033,7 +4141,7 @@ type AdminListBillingAccountsResponse struct {
 
 func (x *AdminListBillingAccountsResponse) Reset() {
 	*x = AdminListBillingAccountsResponse{}
-	mi := &file_proto_admin_proto_msgTypes[50]
+	mi := &file_proto_admin_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4045,7 +4153,7 @@ func (x *AdminListBillingAccountsResponse) String() string {
 func (*AdminListBillingAccountsResponse) ProtoMessage() {}
 
 func (x *AdminListBillingAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[50]
+	mi := &file_proto_admin_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4058,7 +4166,7 @@ func (x *AdminListBillingAccountsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListBillingAccountsResponse.ProtoReflect.Descriptor instead.
 func (*AdminListBillingAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{50}
+	return file_proto_admin_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *AdminListBillingAccountsResponse) GetTotal() int64 {
@@ -4098,7 +4206,7 @@ type AdminGetBillingAccountDetailRequest struct {
 
 func (x *AdminGetBillingAccountDetailRequest) Reset() {
 	*x = AdminGetBillingAccountDetailRequest{}
-	mi := &file_proto_admin_proto_msgTypes[51]
+	mi := &file_proto_admin_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4110,7 +4218,7 @@ func (x *AdminGetBillingAccountDetailRequest) String() string {
 func (*AdminGetBillingAccountDetailRequest) ProtoMessage() {}
 
 func (x *AdminGetBillingAccountDetailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[51]
+	mi := &file_proto_admin_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4123,7 +4231,7 @@ func (x *AdminGetBillingAccountDetailRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use AdminGetBillingAccountDetailRequest.ProtoReflect.Descriptor instead.
 func (*AdminGetBillingAccountDetailRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{51}
+	return file_proto_admin_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *AdminGetBillingAccountDetailRequest) GetUserId() string {
@@ -4142,7 +4250,7 @@ type AdminGetBillingAccountDetailResponse struct {
 
 func (x *AdminGetBillingAccountDetailResponse) Reset() {
 	*x = AdminGetBillingAccountDetailResponse{}
-	mi := &file_proto_admin_proto_msgTypes[52]
+	mi := &file_proto_admin_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4154,7 +4262,7 @@ func (x *AdminGetBillingAccountDetailResponse) String() string {
 func (*AdminGetBillingAccountDetailResponse) ProtoMessage() {}
 
 func (x *AdminGetBillingAccountDetailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[52]
+	mi := &file_proto_admin_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4167,7 +4275,7 @@ func (x *AdminGetBillingAccountDetailResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use AdminGetBillingAccountDetailResponse.ProtoReflect.Descriptor instead.
 func (*AdminGetBillingAccountDetailResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{52}
+	return file_proto_admin_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *AdminGetBillingAccountDetailResponse) GetAccount() *AdminBillingAccount {
@@ -4190,7 +4298,7 @@ type AdminAdjustBillingBalanceRequest struct {
 
 func (x *AdminAdjustBillingBalanceRequest) Reset() {
 	*x = AdminAdjustBillingBalanceRequest{}
-	mi := &file_proto_admin_proto_msgTypes[53]
+	mi := &file_proto_admin_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4202,7 +4310,7 @@ func (x *AdminAdjustBillingBalanceRequest) String() string {
 func (*AdminAdjustBillingBalanceRequest) ProtoMessage() {}
 
 func (x *AdminAdjustBillingBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[53]
+	mi := &file_proto_admin_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4215,7 +4323,7 @@ func (x *AdminAdjustBillingBalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminAdjustBillingBalanceRequest.ProtoReflect.Descriptor instead.
 func (*AdminAdjustBillingBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{53}
+	return file_proto_admin_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *AdminAdjustBillingBalanceRequest) GetUserId() string {
@@ -4264,7 +4372,7 @@ type AdminAdjustBillingBalanceResponse struct {
 
 func (x *AdminAdjustBillingBalanceResponse) Reset() {
 	*x = AdminAdjustBillingBalanceResponse{}
-	mi := &file_proto_admin_proto_msgTypes[54]
+	mi := &file_proto_admin_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4276,7 +4384,7 @@ func (x *AdminAdjustBillingBalanceResponse) String() string {
 func (*AdminAdjustBillingBalanceResponse) ProtoMessage() {}
 
 func (x *AdminAdjustBillingBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[54]
+	mi := &file_proto_admin_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4289,7 +4397,7 @@ func (x *AdminAdjustBillingBalanceResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AdminAdjustBillingBalanceResponse.ProtoReflect.Descriptor instead.
 func (*AdminAdjustBillingBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{54}
+	return file_proto_admin_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *AdminAdjustBillingBalanceResponse) GetSuccess() bool {
@@ -4340,7 +4448,7 @@ type AdminBillingShortfallOrder struct {
 
 func (x *AdminBillingShortfallOrder) Reset() {
 	*x = AdminBillingShortfallOrder{}
-	mi := &file_proto_admin_proto_msgTypes[55]
+	mi := &file_proto_admin_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4352,7 +4460,7 @@ func (x *AdminBillingShortfallOrder) String() string {
 func (*AdminBillingShortfallOrder) ProtoMessage() {}
 
 func (x *AdminBillingShortfallOrder) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[55]
+	mi := &file_proto_admin_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4365,7 +4473,7 @@ func (x *AdminBillingShortfallOrder) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminBillingShortfallOrder.ProtoReflect.Descriptor instead.
 func (*AdminBillingShortfallOrder) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{55}
+	return file_proto_admin_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *AdminBillingShortfallOrder) GetOrderNo() string {
@@ -4512,7 +4620,7 @@ type AdminListBillingShortfallsRequest struct {
 
 func (x *AdminListBillingShortfallsRequest) Reset() {
 	*x = AdminListBillingShortfallsRequest{}
-	mi := &file_proto_admin_proto_msgTypes[56]
+	mi := &file_proto_admin_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4524,7 +4632,7 @@ func (x *AdminListBillingShortfallsRequest) String() string {
 func (*AdminListBillingShortfallsRequest) ProtoMessage() {}
 
 func (x *AdminListBillingShortfallsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[56]
+	mi := &file_proto_admin_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4537,7 +4645,7 @@ func (x *AdminListBillingShortfallsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AdminListBillingShortfallsRequest.ProtoReflect.Descriptor instead.
 func (*AdminListBillingShortfallsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{56}
+	return file_proto_admin_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *AdminListBillingShortfallsRequest) GetUserId() string {
@@ -4573,7 +4681,7 @@ type AdminListBillingShortfallsResponse struct {
 
 func (x *AdminListBillingShortfallsResponse) Reset() {
 	*x = AdminListBillingShortfallsResponse{}
-	mi := &file_proto_admin_proto_msgTypes[57]
+	mi := &file_proto_admin_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4585,7 +4693,7 @@ func (x *AdminListBillingShortfallsResponse) String() string {
 func (*AdminListBillingShortfallsResponse) ProtoMessage() {}
 
 func (x *AdminListBillingShortfallsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[57]
+	mi := &file_proto_admin_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4598,7 +4706,7 @@ func (x *AdminListBillingShortfallsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AdminListBillingShortfallsResponse.ProtoReflect.Descriptor instead.
 func (*AdminListBillingShortfallsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{57}
+	return file_proto_admin_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *AdminListBillingShortfallsResponse) GetTotal() int64 {
@@ -4640,7 +4748,7 @@ type AdminReconcileBillingShortfallRequest struct {
 
 func (x *AdminReconcileBillingShortfallRequest) Reset() {
 	*x = AdminReconcileBillingShortfallRequest{}
-	mi := &file_proto_admin_proto_msgTypes[58]
+	mi := &file_proto_admin_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4652,7 +4760,7 @@ func (x *AdminReconcileBillingShortfallRequest) String() string {
 func (*AdminReconcileBillingShortfallRequest) ProtoMessage() {}
 
 func (x *AdminReconcileBillingShortfallRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[58]
+	mi := &file_proto_admin_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4665,7 +4773,7 @@ func (x *AdminReconcileBillingShortfallRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use AdminReconcileBillingShortfallRequest.ProtoReflect.Descriptor instead.
 func (*AdminReconcileBillingShortfallRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{58}
+	return file_proto_admin_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *AdminReconcileBillingShortfallRequest) GetOrderNo() string {
@@ -4701,7 +4809,7 @@ type AdminReconcileBillingShortfallResponse struct {
 
 func (x *AdminReconcileBillingShortfallResponse) Reset() {
 	*x = AdminReconcileBillingShortfallResponse{}
-	mi := &file_proto_admin_proto_msgTypes[59]
+	mi := &file_proto_admin_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4713,7 +4821,7 @@ func (x *AdminReconcileBillingShortfallResponse) String() string {
 func (*AdminReconcileBillingShortfallResponse) ProtoMessage() {}
 
 func (x *AdminReconcileBillingShortfallResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[59]
+	mi := &file_proto_admin_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4726,7 +4834,7 @@ func (x *AdminReconcileBillingShortfallResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use AdminReconcileBillingShortfallResponse.ProtoReflect.Descriptor instead.
 func (*AdminReconcileBillingShortfallResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{59}
+	return file_proto_admin_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *AdminReconcileBillingShortfallResponse) GetSuccess() bool {
@@ -4785,7 +4893,7 @@ type AdminBillingLedgerEntry struct {
 
 func (x *AdminBillingLedgerEntry) Reset() {
 	*x = AdminBillingLedgerEntry{}
-	mi := &file_proto_admin_proto_msgTypes[60]
+	mi := &file_proto_admin_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4797,7 +4905,7 @@ func (x *AdminBillingLedgerEntry) String() string {
 func (*AdminBillingLedgerEntry) ProtoMessage() {}
 
 func (x *AdminBillingLedgerEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[60]
+	mi := &file_proto_admin_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4810,7 +4918,7 @@ func (x *AdminBillingLedgerEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminBillingLedgerEntry.ProtoReflect.Descriptor instead.
 func (*AdminBillingLedgerEntry) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{60}
+	return file_proto_admin_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *AdminBillingLedgerEntry) GetEntryNo() string {
@@ -4965,7 +5073,7 @@ type AdminListBillingLedgerRequest struct {
 
 func (x *AdminListBillingLedgerRequest) Reset() {
 	*x = AdminListBillingLedgerRequest{}
-	mi := &file_proto_admin_proto_msgTypes[61]
+	mi := &file_proto_admin_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4977,7 +5085,7 @@ func (x *AdminListBillingLedgerRequest) String() string {
 func (*AdminListBillingLedgerRequest) ProtoMessage() {}
 
 func (x *AdminListBillingLedgerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[61]
+	mi := &file_proto_admin_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4990,7 +5098,7 @@ func (x *AdminListBillingLedgerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListBillingLedgerRequest.ProtoReflect.Descriptor instead.
 func (*AdminListBillingLedgerRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{61}
+	return file_proto_admin_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *AdminListBillingLedgerRequest) GetUserId() string {
@@ -5033,7 +5141,7 @@ type AdminListBillingLedgerResponse struct {
 
 func (x *AdminListBillingLedgerResponse) Reset() {
 	*x = AdminListBillingLedgerResponse{}
-	mi := &file_proto_admin_proto_msgTypes[62]
+	mi := &file_proto_admin_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5045,7 +5153,7 @@ func (x *AdminListBillingLedgerResponse) String() string {
 func (*AdminListBillingLedgerResponse) ProtoMessage() {}
 
 func (x *AdminListBillingLedgerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[62]
+	mi := &file_proto_admin_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5058,7 +5166,7 @@ func (x *AdminListBillingLedgerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminListBillingLedgerResponse.ProtoReflect.Descriptor instead.
 func (*AdminListBillingLedgerResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{62}
+	return file_proto_admin_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *AdminListBillingLedgerResponse) GetTotal() int64 {
@@ -5114,7 +5222,7 @@ type AdminBillingUsageRecord struct {
 
 func (x *AdminBillingUsageRecord) Reset() {
 	*x = AdminBillingUsageRecord{}
-	mi := &file_proto_admin_proto_msgTypes[63]
+	mi := &file_proto_admin_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5126,7 +5234,7 @@ func (x *AdminBillingUsageRecord) String() string {
 func (*AdminBillingUsageRecord) ProtoMessage() {}
 
 func (x *AdminBillingUsageRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[63]
+	mi := &file_proto_admin_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5139,7 +5247,7 @@ func (x *AdminBillingUsageRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminBillingUsageRecord.ProtoReflect.Descriptor instead.
 func (*AdminBillingUsageRecord) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{63}
+	return file_proto_admin_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *AdminBillingUsageRecord) GetUsageNo() string {
@@ -5273,7 +5381,7 @@ type AdminListBillingUsageRecordsRequest struct {
 
 func (x *AdminListBillingUsageRecordsRequest) Reset() {
 	*x = AdminListBillingUsageRecordsRequest{}
-	mi := &file_proto_admin_proto_msgTypes[64]
+	mi := &file_proto_admin_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5285,7 +5393,7 @@ func (x *AdminListBillingUsageRecordsRequest) String() string {
 func (*AdminListBillingUsageRecordsRequest) ProtoMessage() {}
 
 func (x *AdminListBillingUsageRecordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[64]
+	mi := &file_proto_admin_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5298,7 +5406,7 @@ func (x *AdminListBillingUsageRecordsRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use AdminListBillingUsageRecordsRequest.ProtoReflect.Descriptor instead.
 func (*AdminListBillingUsageRecordsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{64}
+	return file_proto_admin_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *AdminListBillingUsageRecordsRequest) GetUserId() string {
@@ -5341,7 +5449,7 @@ type AdminListBillingUsageRecordsResponse struct {
 
 func (x *AdminListBillingUsageRecordsResponse) Reset() {
 	*x = AdminListBillingUsageRecordsResponse{}
-	mi := &file_proto_admin_proto_msgTypes[65]
+	mi := &file_proto_admin_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5353,7 +5461,7 @@ func (x *AdminListBillingUsageRecordsResponse) String() string {
 func (*AdminListBillingUsageRecordsResponse) ProtoMessage() {}
 
 func (x *AdminListBillingUsageRecordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[65]
+	mi := &file_proto_admin_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5366,7 +5474,7 @@ func (x *AdminListBillingUsageRecordsResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use AdminListBillingUsageRecordsResponse.ProtoReflect.Descriptor instead.
 func (*AdminListBillingUsageRecordsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{65}
+	return file_proto_admin_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *AdminListBillingUsageRecordsResponse) GetTotal() int64 {
@@ -5413,7 +5521,7 @@ type AdminBillingPricingResponse struct {
 
 func (x *AdminBillingPricingResponse) Reset() {
 	*x = AdminBillingPricingResponse{}
-	mi := &file_proto_admin_proto_msgTypes[66]
+	mi := &file_proto_admin_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5425,7 +5533,7 @@ func (x *AdminBillingPricingResponse) String() string {
 func (*AdminBillingPricingResponse) ProtoMessage() {}
 
 func (x *AdminBillingPricingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[66]
+	mi := &file_proto_admin_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5438,7 +5546,7 @@ func (x *AdminBillingPricingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminBillingPricingResponse.ProtoReflect.Descriptor instead.
 func (*AdminBillingPricingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{66}
+	return file_proto_admin_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *AdminBillingPricingResponse) GetVersion() int32 {
@@ -5509,7 +5617,7 @@ type AdminUpdateBillingPricingRequest struct {
 
 func (x *AdminUpdateBillingPricingRequest) Reset() {
 	*x = AdminUpdateBillingPricingRequest{}
-	mi := &file_proto_admin_proto_msgTypes[67]
+	mi := &file_proto_admin_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5521,7 +5629,7 @@ func (x *AdminUpdateBillingPricingRequest) String() string {
 func (*AdminUpdateBillingPricingRequest) ProtoMessage() {}
 
 func (x *AdminUpdateBillingPricingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[67]
+	mi := &file_proto_admin_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5534,7 +5642,7 @@ func (x *AdminUpdateBillingPricingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUpdateBillingPricingRequest.ProtoReflect.Descriptor instead.
 func (*AdminUpdateBillingPricingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{67}
+	return file_proto_admin_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *AdminUpdateBillingPricingRequest) GetIngressPriceYuanPerGb() string {
@@ -5578,7 +5686,7 @@ type AdminWelcomeCreditSettingsResponse struct {
 
 func (x *AdminWelcomeCreditSettingsResponse) Reset() {
 	*x = AdminWelcomeCreditSettingsResponse{}
-	mi := &file_proto_admin_proto_msgTypes[68]
+	mi := &file_proto_admin_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5590,7 +5698,7 @@ func (x *AdminWelcomeCreditSettingsResponse) String() string {
 func (*AdminWelcomeCreditSettingsResponse) ProtoMessage() {}
 
 func (x *AdminWelcomeCreditSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[68]
+	mi := &file_proto_admin_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5603,7 +5711,7 @@ func (x *AdminWelcomeCreditSettingsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AdminWelcomeCreditSettingsResponse.ProtoReflect.Descriptor instead.
 func (*AdminWelcomeCreditSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{68}
+	return file_proto_admin_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *AdminWelcomeCreditSettingsResponse) GetEnabled() bool {
@@ -5653,7 +5761,7 @@ type AdminUpdateWelcomeCreditSettingsRequest struct {
 
 func (x *AdminUpdateWelcomeCreditSettingsRequest) Reset() {
 	*x = AdminUpdateWelcomeCreditSettingsRequest{}
-	mi := &file_proto_admin_proto_msgTypes[69]
+	mi := &file_proto_admin_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5665,7 +5773,7 @@ func (x *AdminUpdateWelcomeCreditSettingsRequest) String() string {
 func (*AdminUpdateWelcomeCreditSettingsRequest) ProtoMessage() {}
 
 func (x *AdminUpdateWelcomeCreditSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[69]
+	mi := &file_proto_admin_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5678,7 +5786,7 @@ func (x *AdminUpdateWelcomeCreditSettingsRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use AdminUpdateWelcomeCreditSettingsRequest.ProtoReflect.Descriptor instead.
 func (*AdminUpdateWelcomeCreditSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{69}
+	return file_proto_admin_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *AdminUpdateWelcomeCreditSettingsRequest) GetEnabled() bool {
@@ -5709,140 +5817,2033 @@ func (x *AdminUpdateWelcomeCreditSettingsRequest) GetOperatorUserId() string {
 	return ""
 }
 
-var File_proto_admin_proto protoreflect.FileDescriptor
+type AdminListTasksRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	State             string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Platform          string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	ErrorCategory     string                 `protobuf:"bytes,3,opt,name=error_category,json=errorCategory,proto3" json:"error_category,omitempty"`
+	UserId            string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartTimeUnix     int64                  `protobuf:"varint,5,opt,name=start_time_unix,json=startTimeUnix,proto3" json:"start_time_unix,omitempty"`
+	EndTimeUnix       int64                  `protobuf:"varint,6,opt,name=end_time_unix,json=endTimeUnix,proto3" json:"end_time_unix,omitempty"`
+	StuckAfterSeconds int64                  `protobuf:"varint,7,opt,name=stuck_after_seconds,json=stuckAfterSeconds,proto3" json:"stuck_after_seconds,omitempty"`
+	Page              int32                  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize          int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
 
-const file_proto_admin_proto_rawDesc = "" +
-	"\n" +
-	"\x11proto/admin.proto\x12\x05admin\"\f\n" +
-	"\n" +
-	"AdminEmpty\"\xa8\x01\n" +
-	"\tAdminUser\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
-	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x1d\n" +
-	"\n" +
-	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12\x12\n" +
-	"\x04role\x18\x05 \x01(\x05R\x04role\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\x83\x01\n" +
-	"\x11AdminLoginRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1d\n" +
-	"\n" +
-	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12\x1d\n" +
-	"\n" +
-	"ip_address\x18\x04 \x01(\tR\tipAddress\"Y\n" +
-	"\x12AdminLoginResponse\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\x12$\n" +
-	"\x04user\x18\x02 \x01(\v2\x10.admin.AdminUserR\x04user\"3\n" +
-	"\x12AdminLogoutRequest\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"4\n" +
-	"\x13AdminSessionRequest\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"@\n" +
-	"\x18AdminCurrentUserResponse\x12$\n" +
-	"\x04user\x18\x01 \x01(\v2\x10.admin.AdminUserR\x04user\"\xa6\x03\n" +
-	"\x15AdminOverviewResponse\x12\x1f\n" +
-	"\vtotal_users\x18\x01 \x01(\x03R\n" +
-	"totalUsers\x12,\n" +
-	"\x12daily_active_users\x18\x02 \x01(\x03R\x10dailyActiveUsers\x12.\n" +
-	"\x13weekly_active_users\x18\x03 \x01(\x03R\x11weeklyActiveUsers\x12'\n" +
-	"\x0ftotal_downloads\x18\x04 \x01(\x03R\x0etotalDownloads\x12'\n" +
-	"\x0fdownloads_today\x18\x05 \x01(\x03R\x0edownloadsToday\x12+\n" +
-	"\x11success_downloads\x18\x06 \x01(\x03R\x10successDownloads\x12)\n" +
-	"\x10failed_downloads\x18\a \x01(\x03R\x0ffailedDownloads\x122\n" +
-	"\x15active_manual_proxies\x18\b \x01(\x03R\x13activeManualProxies\x120\n" +
-	"\x14total_manual_proxies\x18\t \x01(\x03R\x12totalManualProxies\"\xc9\x01\n" +
-	"\x0fAdminTrendPoint\x12\x14\n" +
-	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x1f\n" +
-	"\vtotal_count\x18\x03 \x01(\x03R\n" +
-	"totalCount\x12#\n" +
-	"\rsuccess_count\x18\x04 \x01(\x03R\fsuccessCount\x12!\n" +
-	"\ffailed_count\x18\x05 \x01(\x03R\vfailedCount\x12!\n" +
-	"\fsuccess_rate\x18\x06 \x01(\x01R\vsuccessRate\"R\n" +
-	"\x18AdminRequestTrendRequest\x12 \n" +
-	"\vgranularity\x18\x01 \x01(\tR\vgranularity\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"m\n" +
-	"\x19AdminRequestTrendResponse\x12 \n" +
-	"\vgranularity\x18\x01 \x01(\tR\vgranularity\x12.\n" +
-	"\x06points\x18\x02 \x03(\v2\x16.admin.AdminTrendPointR\x06points\"\xde\x01\n" +
-	"\x17AdminDashboardDownloads\x12\x14\n" +
-	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x1f\n" +
-	"\vtoday_total\x18\x02 \x01(\x03R\n" +
-	"todayTotal\x12#\n" +
-	"\rsuccess_total\x18\x03 \x01(\x03R\fsuccessTotal\x12!\n" +
-	"\ffailed_total\x18\x04 \x01(\x03R\vfailedTotal\x12!\n" +
-	"\fsuccess_rate\x18\x05 \x01(\x01R\vsuccessRate\x12!\n" +
-	"\ffailure_rate\x18\x06 \x01(\x01R\vfailureRate\"\xbb\x01\n" +
-	"\x13AdminDashboardUsers\x12\x14\n" +
-	"\x05total\x18\x01 \x01(\x03R\x05total\x12!\n" +
-	"\fdaily_active\x18\x02 \x01(\x03R\vdailyActive\x12#\n" +
-	"\rweekly_active\x18\x03 \x01(\x03R\fweeklyActive\x12 \n" +
-	"\fdau_wau_rate\x18\x04 \x01(\x01R\n" +
-	"dauWauRate\x12$\n" +
-	"\x0ewau_total_rate\x18\x05 \x01(\x01R\fwauTotalRate\"J\n" +
-	" AdminDashboardProxyErrorCategory\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x03R\x05count\"\x91\x03\n" +
-	"\x15AdminDashboardProxies\x12\x14\n" +
-	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x16\n" +
-	"\x06active\x18\x02 \x01(\x03R\x06active\x12\x1c\n" +
-	"\tavailable\x18\x03 \x01(\x03R\tavailable\x12\x18\n" +
-	"\acooling\x18\x04 \x01(\x03R\acooling\x12\x1c\n" +
-	"\tsaturated\x18\x05 \x01(\x03R\tsaturated\x12\x1b\n" +
-	"\thigh_risk\x18\x06 \x01(\x03R\bhighRisk\x12%\n" +
-	"\x0erecent_success\x18\a \x01(\x03R\rrecentSuccess\x12%\n" +
-	"\x0erecent_failure\x18\b \x01(\x03R\rrecentFailure\x12.\n" +
-	"\x13recent_failure_rate\x18\t \x01(\x01R\x11recentFailureRate\x12Y\n" +
-	"\x14top_error_categories\x18\n" +
-	" \x03(\v2'.admin.AdminDashboardProxyErrorCategoryR\x12topErrorCategories\"\xe0\x01\n" +
-	"\x19AdminDashboardProxySource\x12\x18\n" +
-	"\ahealthy\x18\x01 \x01(\bR\ahealthy\x12\x12\n" +
-	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\x12-\n" +
-	"\x12dynamic_configured\x18\x04 \x01(\bR\x11dynamicConfigured\x12$\n" +
-	"\x0eproxy_lease_id\x18\x05 \x01(\tR\fproxyLeaseId\x12&\n" +
-	"\x0fproxy_expire_at\x18\x06 \x01(\tR\rproxyExpireAt\"\x96\x01\n" +
-	"\x19AdminDashboardProxyPolicy\x12%\n" +
-	"\x0eprimary_source\x18\x01 \x01(\tR\rprimarySource\x12'\n" +
-	"\x0ffallback_source\x18\x02 \x01(\tR\x0efallbackSource\x12)\n" +
-	"\x10fallback_enabled\x18\x03 \x01(\bR\x0ffallbackEnabled\"w\n" +
-	"\x15AdminDashboardCookies\x12\x14\n" +
-	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x16\n" +
-	"\x06active\x18\x02 \x01(\x03R\x06active\x12\x18\n" +
-	"\aexpired\x18\x03 \x01(\x03R\aexpired\x12\x16\n" +
-	"\x06frozen\x18\x04 \x01(\x03R\x06frozen\"@\n" +
-	"\x15AdminDashboardBilling\x12'\n" +
-	"\x0fshortfall_count\x18\x01 \x01(\x03R\x0eshortfallCount\"\xa7\x01\n" +
-	"\x17AdminDashboardException\x12\x12\n" +
-	"\x04area\x18\x01 \x01(\tR\x04area\x12\x1a\n" +
-	"\bseverity\x18\x02 \x01(\tR\bseverity\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\x12!\n" +
-	"\faction_label\x18\x04 \x01(\tR\vactionLabel\x12\x1f\n" +
-	"\vaction_href\x18\x05 \x01(\tR\n" +
-	"actionHref\"\xa3\x04\n" +
-	"\x1cAdminDashboardHealthResponse\x12!\n" +
-	"\fgenerated_at\x18\x01 \x01(\tR\vgeneratedAt\x12<\n" +
-	"\tdownloads\x18\x02 \x01(\v2\x1e.admin.AdminDashboardDownloadsR\tdownloads\x120\n" +
-	"\x05users\x18\x03 \x01(\v2\x1a.admin.AdminDashboardUsersR\x05users\x126\n" +
-	"\aproxies\x18\x04 \x01(\v2\x1c.admin.AdminDashboardProxiesR\aproxies\x12C\n" +
-	"\fproxy_source\x18\x05 \x01(\v2 .admin.AdminDashboardProxySourceR\vproxySource\x12C\n" +
-	"\fproxy_policy\x18\x06 \x01(\v2 .admin.AdminDashboardProxyPolicyR\vproxyPolicy\x126\n" +
-	"\acookies\x18\a \x01(\v2\x1c.admin.AdminDashboardCookiesR\acookies\x126\n" +
-	"\abilling\x18\b \x01(\v2\x1c.admin.AdminDashboardBillingR\abilling\x12>\n" +
-	"\n" +
-	"exceptions\x18\t \x03(\v2\x1e.admin.AdminDashboardExceptionR\n" +
-	"exceptions\"\x97\x01\n" +
-	"\x16AdminUserStatsResponse\x12\x1f\n" +
-	"\vtotal_users\x18\x01 \x01(\x03R\n" +
-	"totalUsers\x12,\n" +
-	"\x12daily_active_users\x18\x02 \x01(\x03R\x10dailyActiveUsers\x12.\n" +
-	"\x13weekly_active_users\x18\x03 \x01(\x03R\x11weeklyActiveUsers\"\xe2\x02\n" +
-	"\x1eAdminProxySourceStatusResponse\x12\x18\n" +
-	"\ahealthy\x18\x01 \x01(\bR\ahealthy\x12\x12\n" +
+func (x *AdminListTasksRequest) Reset() {
+	*x = AdminListTasksRequest{}
+	mi := &file_proto_admin_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListTasksRequest) ProtoMessage() {}
+
+func (x *AdminListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListTasksRequest.ProtoReflect.Descriptor instead.
+func (*AdminListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AdminListTasksRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AdminListTasksRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *AdminListTasksRequest) GetErrorCategory() string {
+	if x != nil {
+		return x.ErrorCategory
+	}
+	return ""
+}
+
+func (x *AdminListTasksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AdminListTasksRequest) GetStartTimeUnix() int64 {
+	if x != nil {
+		return x.StartTimeUnix
+	}
+	return 0
+}
+
+func (x *AdminListTasksRequest) GetEndTimeUnix() int64 {
+	if x != nil {
+		return x.EndTimeUnix
+	}
+	return 0
+}
+
+func (x *AdminListTasksRequest) GetStuckAfterSeconds() int64 {
+	if x != nil {
+		return x.StuckAfterSeconds
+	}
+	return 0
+}
+
+func (x *AdminListTasksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminListTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AdminTaskItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TaskId         string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Url            string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Platform       string                 `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
+	Title          string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Mode           string                 `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	Quality        string                 `protobuf:"bytes,7,opt,name=quality,proto3" json:"quality,omitempty"`
+	Status         int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	StatusText     string                 `protobuf:"bytes,9,opt,name=status_text,json=statusText,proto3" json:"status_text,omitempty"`
+	ErrorMessage   string                 `protobuf:"bytes,10,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ErrorCategory  string                 `protobuf:"bytes,11,opt,name=error_category,json=errorCategory,proto3" json:"error_category,omitempty"`
+	RetryCount     int32                  `protobuf:"varint,12,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt      string                 `protobuf:"bytes,14,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	DeadLetteredAt string                 `protobuf:"bytes,15,opt,name=dead_lettered_at,json=deadLetteredAt,proto3" json:"dead_lettered_at,omitempty"`
+	DeadLettered   bool                   `protobuf:"varint,16,opt,name=dead_lettered,json=deadLettered,proto3" json:"dead_lettered,omitempty"`
+	Stuck          bool                   `protobuf:"varint,17,opt,name=stuck,proto3" json:"stuck,omitempty"`
+	Replayable     bool                   `protobuf:"varint,18,opt,name=replayable,proto3" json:"replayable,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminTaskItem) Reset() {
+	*x = AdminTaskItem{}
+	mi := &file_proto_admin_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminTaskItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminTaskItem) ProtoMessage() {}
+
+func (x *AdminTaskItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminTaskItem.ProtoReflect.Descriptor instead.
+func (*AdminTaskItem) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AdminTaskItem) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *AdminTaskItem) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AdminTaskItem) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AdminTaskItem) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *AdminTaskItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AdminTaskItem) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *AdminTaskItem) GetQuality() string {
+	if x != nil {
+		return x.Quality
+	}
+	return ""
+}
+
+func (x *AdminTaskItem) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AdminTaskItem) GetStatusText() string {
+	if x != nil {
+		return x.StatusText
+	}
+	return ""
+}
+
+func (x *AdminTaskItem) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *AdminTaskItem) GetErrorCategory() string {
+	if x != nil {
+		return x.ErrorCategory
+	}
+	return ""
+}
+
+func (x *AdminTaskItem) GetRetryCount() int32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *AdminTaskItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminTaskItem) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *AdminTaskItem) GetDeadLetteredAt() string {
+	if x != nil {
+		return x.DeadLetteredAt
+	}
+	return ""
+}
+
+func (x *AdminTaskItem) GetDeadLettered() bool {
+	if x != nil {
+		return x.DeadLettered
+	}
+	return false
+}
+
+func (x *AdminTaskItem) GetStuck() bool {
+	if x != nil {
+		return x.Stuck
+	}
+	return false
+}
+
+func (x *AdminTaskItem) GetReplayable() bool {
+	if x != nil {
+		return x.Replayable
+	}
+	return false
+}
+
+type AdminListTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AdminTaskItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListTasksResponse) Reset() {
+	*x = AdminListTasksResponse{}
+	mi := &file_proto_admin_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListTasksResponse) ProtoMessage() {}
+
+func (x *AdminListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListTasksResponse.ProtoReflect.Descriptor instead.
+func (*AdminListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AdminListTasksResponse) GetItems() []*AdminTaskItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AdminListTasksResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AdminListTasksResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminListTasksResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AdminTaskActionRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TaskId            string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Reason            string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	StuckAfterSeconds int64                  `protobuf:"varint,3,opt,name=stuck_after_seconds,json=stuckAfterSeconds,proto3" json:"stuck_after_seconds,omitempty"`
+	OperatorUserId    string                 `protobuf:"bytes,4,opt,name=operator_user_id,json=operatorUserId,proto3" json:"operator_user_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AdminTaskActionRequest) Reset() {
+	*x = AdminTaskActionRequest{}
+	mi := &file_proto_admin_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminTaskActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminTaskActionRequest) ProtoMessage() {}
+
+func (x *AdminTaskActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminTaskActionRequest.ProtoReflect.Descriptor instead.
+func (*AdminTaskActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AdminTaskActionRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *AdminTaskActionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminTaskActionRequest) GetStuckAfterSeconds() int64 {
+	if x != nil {
+		return x.StuckAfterSeconds
+	}
+	return 0
+}
+
+func (x *AdminTaskActionRequest) GetOperatorUserId() string {
+	if x != nil {
+		return x.OperatorUserId
+	}
+	return ""
+}
+
+type AdminTaskActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminTaskActionResponse) Reset() {
+	*x = AdminTaskActionResponse{}
+	mi := &file_proto_admin_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminTaskActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminTaskActionResponse) ProtoMessage() {}
+
+func (x *AdminTaskActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminTaskActionResponse.ProtoReflect.Descriptor instead.
+func (*AdminTaskActionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AdminTaskActionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AdminTaskActionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AdminWorkerActiveTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	Phase         string                 `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	Percent       float64                `protobuf:"fixed64,5,opt,name=percent,proto3" json:"percent,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminWorkerActiveTask) Reset() {
+	*x = AdminWorkerActiveTask{}
+	mi := &file_proto_admin_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminWorkerActiveTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWorkerActiveTask) ProtoMessage() {}
+
+func (x *AdminWorkerActiveTask) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWorkerActiveTask.ProtoReflect.Descriptor instead.
+func (*AdminWorkerActiveTask) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AdminWorkerActiveTask) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *AdminWorkerActiveTask) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AdminWorkerActiveTask) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *AdminWorkerActiveTask) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *AdminWorkerActiveTask) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *AdminWorkerActiveTask) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+type AdminWorkerQueueDepth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	Messages      int64                  `protobuf:"varint,3,opt,name=messages,proto3" json:"messages,omitempty"`
+	Consumers     int32                  `protobuf:"varint,4,opt,name=consumers,proto3" json:"consumers,omitempty"`
+	Paused        bool                   `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminWorkerQueueDepth) Reset() {
+	*x = AdminWorkerQueueDepth{}
+	mi := &file_proto_admin_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminWorkerQueueDepth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWorkerQueueDepth) ProtoMessage() {}
+
+func (x *AdminWorkerQueueDepth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWorkerQueueDepth.ProtoReflect.Descriptor instead.
+func (*AdminWorkerQueueDepth) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AdminWorkerQueueDepth) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *AdminWorkerQueueDepth) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *AdminWorkerQueueDepth) GetMessages() int64 {
+	if x != nil {
+		return x.Messages
+	}
+	return 0
+}
+
+func (x *AdminWorkerQueueDepth) GetConsumers() int32 {
+	if x != nil {
+		return x.Consumers
+	}
+	return 0
+}
+
+func (x *AdminWorkerQueueDepth) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type AdminWorkerPool struct {
+	state          protoimpl.MessageState   `protogen:"open.v1"`
+	InstanceId     string                   `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	PoolSize       int32                    `protobuf:"varint,2,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	MaxConcurrent  int32                    `protobuf:"varint,3,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
+	Running        int32                    `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`
+	Buffered       int32                    `protobuf:"varint,5,opt,name=buffered,proto3" json:"buffered,omitempty"`
+	BufferCapacity int32                    `protobuf:"varint,6,opt,name=buffer_capacity,json=bufferCapacity,proto3" json:"buffer_capacity,omitempty"`
+	Paused         bool                     `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"`
+	Queues         []*AdminWorkerQueueDepth `protobuf:"bytes,8,rep,name=queues,proto3" json:"queues,omitempty"`
+	ActiveTasks    []*AdminWorkerActiveTask `protobuf:"bytes,9,rep,name=active_tasks,json=activeTasks,proto3" json:"active_tasks,omitempty"`
+	UpdatedAt      string                   `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminWorkerPool) Reset() {
+	*x = AdminWorkerPool{}
+	mi := &file_proto_admin_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminWorkerPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWorkerPool) ProtoMessage() {}
+
+func (x *AdminWorkerPool) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWorkerPool.ProtoReflect.Descriptor instead.
+func (*AdminWorkerPool) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AdminWorkerPool) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *AdminWorkerPool) GetPoolSize() int32 {
+	if x != nil {
+		return x.PoolSize
+	}
+	return 0
+}
+
+func (x *AdminWorkerPool) GetMaxConcurrent() int32 {
+	if x != nil {
+		return x.MaxConcurrent
+	}
+	return 0
+}
+
+func (x *AdminWorkerPool) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *AdminWorkerPool) GetBuffered() int32 {
+	if x != nil {
+		return x.Buffered
+	}
+	return 0
+}
+
+func (x *AdminWorkerPool) GetBufferCapacity() int32 {
+	if x != nil {
+		return x.BufferCapacity
+	}
+	return 0
+}
+
+func (x *AdminWorkerPool) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *AdminWorkerPool) GetQueues() []*AdminWorkerQueueDepth {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+func (x *AdminWorkerPool) GetActiveTasks() []*AdminWorkerActiveTask {
+	if x != nil {
+		return x.ActiveTasks
+	}
+	return nil
+}
+
+func (x *AdminWorkerPool) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type AdminListWorkerPoolsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AdminWorkerPool     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListWorkerPoolsResponse) Reset() {
+	*x = AdminListWorkerPoolsResponse{}
+	mi := &file_proto_admin_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListWorkerPoolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListWorkerPoolsResponse) ProtoMessage() {}
+
+func (x *AdminListWorkerPoolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListWorkerPoolsResponse.ProtoReflect.Descriptor instead.
+func (*AdminListWorkerPoolsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AdminListWorkerPoolsResponse) GetItems() []*AdminWorkerPool {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AdminUpdateWorkerPoolRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId     string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Action         string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	MaxConcurrent  int32                  `protobuf:"varint,3,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
+	OperatorUserId string                 `protobuf:"bytes,4,opt,name=operator_user_id,json=operatorUserId,proto3" json:"operator_user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminUpdateWorkerPoolRequest) Reset() {
+	*x = AdminUpdateWorkerPoolRequest{}
+	mi := &file_proto_admin_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdateWorkerPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateWorkerPoolRequest) ProtoMessage() {}
+
+func (x *AdminUpdateWorkerPoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateWorkerPoolRequest.ProtoReflect.Descriptor instead.
+func (*AdminUpdateWorkerPoolRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AdminUpdateWorkerPoolRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *AdminUpdateWorkerPoolRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AdminUpdateWorkerPoolRequest) GetMaxConcurrent() int32 {
+	if x != nil {
+		return x.MaxConcurrent
+	}
+	return 0
+}
+
+func (x *AdminUpdateWorkerPoolRequest) GetOperatorUserId() string {
+	if x != nil {
+		return x.OperatorUserId
+	}
+	return ""
+}
+
+type AdminPlatform struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName       string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Enabled           bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Patterns          []string               `protobuf:"bytes,4,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	Adapter           string                 `protobuf:"bytes,5,opt,name=adapter,proto3" json:"adapter,omitempty"`
+	ExtraArgs         []string               `protobuf:"bytes,6,rep,name=extra_args,json=extraArgs,proto3" json:"extra_args,omitempty"`
+	Cookies           string                 `protobuf:"bytes,7,opt,name=cookies,proto3" json:"cookies,omitempty"`
+	Proxy             string                 `protobuf:"bytes,8,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	ParsePerMinute    int64                  `protobuf:"varint,9,opt,name=parse_per_minute,json=parsePerMinute,proto3" json:"parse_per_minute,omitempty"`
+	DownloadPerMinute int64                  `protobuf:"varint,10,opt,name=download_per_minute,json=downloadPerMinute,proto3" json:"download_per_minute,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AdminPlatform) Reset() {
+	*x = AdminPlatform{}
+	mi := &file_proto_admin_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminPlatform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminPlatform) ProtoMessage() {}
+
+func (x *AdminPlatform) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminPlatform.ProtoReflect.Descriptor instead.
+func (*AdminPlatform) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AdminPlatform) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminPlatform) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *AdminPlatform) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AdminPlatform) GetPatterns() []string {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+func (x *AdminPlatform) GetAdapter() string {
+	if x != nil {
+		return x.Adapter
+	}
+	return ""
+}
+
+func (x *AdminPlatform) GetExtraArgs() []string {
+	if x != nil {
+		return x.ExtraArgs
+	}
+	return nil
+}
+
+func (x *AdminPlatform) GetCookies() string {
+	if x != nil {
+		return x.Cookies
+	}
+	return ""
+}
+
+func (x *AdminPlatform) GetProxy() string {
+	if x != nil {
+		return x.Proxy
+	}
+	return ""
+}
+
+func (x *AdminPlatform) GetParsePerMinute() int64 {
+	if x != nil {
+		return x.ParsePerMinute
+	}
+	return 0
+}
+
+func (x *AdminPlatform) GetDownloadPerMinute() int64 {
+	if x != nil {
+		return x.DownloadPerMinute
+	}
+	return 0
+}
+
+type AdminListPlatformsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AdminPlatform       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListPlatformsResponse) Reset() {
+	*x = AdminListPlatformsResponse{}
+	mi := &file_proto_admin_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListPlatformsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListPlatformsResponse) ProtoMessage() {}
+
+func (x *AdminListPlatformsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListPlatformsResponse.ProtoReflect.Descriptor instead.
+func (*AdminListPlatformsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AdminListPlatformsResponse) GetItems() []*AdminPlatform {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AdminUpdatePlatformRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled        bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	OperatorUserId string                 `protobuf:"bytes,3,opt,name=operator_user_id,json=operatorUserId,proto3" json:"operator_user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminUpdatePlatformRequest) Reset() {
+	*x = AdminUpdatePlatformRequest{}
+	mi := &file_proto_admin_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdatePlatformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdatePlatformRequest) ProtoMessage() {}
+
+func (x *AdminUpdatePlatformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdatePlatformRequest.ProtoReflect.Descriptor instead.
+func (*AdminUpdatePlatformRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AdminUpdatePlatformRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminUpdatePlatformRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AdminUpdatePlatformRequest) GetOperatorUserId() string {
+	if x != nil {
+		return x.OperatorUserId
+	}
+	return ""
+}
+
+type AdminPlatformResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Platform      *AdminPlatform         `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminPlatformResponse) Reset() {
+	*x = AdminPlatformResponse{}
+	mi := &file_proto_admin_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminPlatformResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminPlatformResponse) ProtoMessage() {}
+
+func (x *AdminPlatformResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminPlatformResponse.ProtoReflect.Descriptor instead.
+func (*AdminPlatformResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *AdminPlatformResponse) GetPlatform() *AdminPlatform {
+	if x != nil {
+		return x.Platform
+	}
+	return nil
+}
+
+type AdminWebhookEndpoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events        []string               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Enabled       bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminWebhookEndpoint) Reset() {
+	*x = AdminWebhookEndpoint{}
+	mi := &file_proto_admin_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminWebhookEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWebhookEndpoint) ProtoMessage() {}
+
+func (x *AdminWebhookEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWebhookEndpoint.ProtoReflect.Descriptor instead.
+func (*AdminWebhookEndpoint) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AdminWebhookEndpoint) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWebhookEndpoint) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AdminWebhookEndpoint) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *AdminWebhookEndpoint) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AdminWebhookEndpoint) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AdminWebhookEndpoint) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *AdminWebhookEndpoint) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminWebhookEndpoint) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type AdminListWebhooksResponse struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Items           []*AdminWebhookEndpoint `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	AvailableEvents []string                `protobuf:"bytes,2,rep,name=available_events,json=availableEvents,proto3" json:"available_events,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AdminListWebhooksResponse) Reset() {
+	*x = AdminListWebhooksResponse{}
+	mi := &file_proto_admin_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListWebhooksResponse) ProtoMessage() {}
+
+func (x *AdminListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*AdminListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *AdminListWebhooksResponse) GetItems() []*AdminWebhookEndpoint {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AdminListWebhooksResponse) GetAvailableEvents() []string {
+	if x != nil {
+		return x.AvailableEvents
+	}
+	return nil
+}
+
+type AdminCreateWebhookRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Url            string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Events         []string               `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	OperatorUserId string                 `protobuf:"bytes,4,opt,name=operator_user_id,json=operatorUserId,proto3" json:"operator_user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminCreateWebhookRequest) Reset() {
+	*x = AdminCreateWebhookRequest{}
+	mi := &file_proto_admin_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreateWebhookRequest) ProtoMessage() {}
+
+func (x *AdminCreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*AdminCreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *AdminCreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AdminCreateWebhookRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *AdminCreateWebhookRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AdminCreateWebhookRequest) GetOperatorUserId() string {
+	if x != nil {
+		return x.OperatorUserId
+	}
+	return ""
+}
+
+type AdminCreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      *AdminWebhookEndpoint  `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminCreateWebhookResponse) Reset() {
+	*x = AdminCreateWebhookResponse{}
+	mi := &file_proto_admin_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreateWebhookResponse) ProtoMessage() {}
+
+func (x *AdminCreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*AdminCreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *AdminCreateWebhookResponse) GetEndpoint() *AdminWebhookEndpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+func (x *AdminCreateWebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type AdminUpdateWebhookRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url               string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	UpdateEvents      bool                   `protobuf:"varint,3,opt,name=update_events,json=updateEvents,proto3" json:"update_events,omitempty"`
+	Events            []string               `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	UpdateDescription bool                   `protobuf:"varint,5,opt,name=update_description,json=updateDescription,proto3" json:"update_description,omitempty"`
+	Description       string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Status            string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AdminUpdateWebhookRequest) Reset() {
+	*x = AdminUpdateWebhookRequest{}
+	mi := &file_proto_admin_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateWebhookRequest) ProtoMessage() {}
+
+func (x *AdminUpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*AdminUpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *AdminUpdateWebhookRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminUpdateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AdminUpdateWebhookRequest) GetUpdateEvents() bool {
+	if x != nil {
+		return x.UpdateEvents
+	}
+	return false
+}
+
+func (x *AdminUpdateWebhookRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *AdminUpdateWebhookRequest) GetUpdateDescription() bool {
+	if x != nil {
+		return x.UpdateDescription
+	}
+	return false
+}
+
+func (x *AdminUpdateWebhookRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AdminUpdateWebhookRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type AdminWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      *AdminWebhookEndpoint  `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminWebhookResponse) Reset() {
+	*x = AdminWebhookResponse{}
+	mi := &file_proto_admin_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWebhookResponse) ProtoMessage() {}
+
+func (x *AdminWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWebhookResponse.ProtoReflect.Descriptor instead.
+func (*AdminWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AdminWebhookResponse) GetEndpoint() *AdminWebhookEndpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+type AdminWebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EndpointId     int64                  `protobuf:"varint,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	UserId         string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PayloadJson    string                 `protobuf:"bytes,6,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"`
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts       int32                  `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt  string                 `protobuf:"bytes,9,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	LastAttemptAt  string                 `protobuf:"bytes,10,opt,name=last_attempt_at,json=lastAttemptAt,proto3" json:"last_attempt_at,omitempty"`
+	ResponseStatus int32                  `protobuf:"varint,11,opt,name=response_status,json=responseStatus,proto3" json:"response_status,omitempty"`
+	ResponseBody   string                 `protobuf:"bytes,12,opt,name=response_body,json=responseBody,proto3" json:"response_body,omitempty"`
+	LastError      string                 `protobuf:"bytes,13,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	DurationMs     int64                  `protobuf:"varint,14,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminWebhookDelivery) Reset() {
+	*x = AdminWebhookDelivery{}
+	mi := &file_proto_admin_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminWebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWebhookDelivery) ProtoMessage() {}
+
+func (x *AdminWebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWebhookDelivery.ProtoReflect.Descriptor instead.
+func (*AdminWebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AdminWebhookDelivery) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminWebhookDelivery) GetEndpointId() int64 {
+	if x != nil {
+		return x.EndpointId
+	}
+	return 0
+}
+
+func (x *AdminWebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AdminWebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *AdminWebhookDelivery) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AdminWebhookDelivery) GetPayloadJson() string {
+	if x != nil {
+		return x.PayloadJson
+	}
+	return ""
+}
+
+func (x *AdminWebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminWebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *AdminWebhookDelivery) GetNextAttemptAt() string {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return ""
+}
+
+func (x *AdminWebhookDelivery) GetLastAttemptAt() string {
+	if x != nil {
+		return x.LastAttemptAt
+	}
+	return ""
+}
+
+func (x *AdminWebhookDelivery) GetResponseStatus() int32 {
+	if x != nil {
+		return x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *AdminWebhookDelivery) GetResponseBody() string {
+	if x != nil {
+		return x.ResponseBody
+	}
+	return ""
+}
+
+func (x *AdminWebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *AdminWebhookDelivery) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *AdminWebhookDelivery) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EndpointId    int64                  `protobuf:"varint,1,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	EventType     string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListWebhookDeliveriesRequest) Reset() {
+	*x = AdminListWebhookDeliveriesRequest{}
+	mi := &file_proto_admin_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *AdminListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*AdminListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *AdminListWebhookDeliveriesRequest) GetEndpointId() int64 {
+	if x != nil {
+		return x.EndpointId
+	}
+	return 0
+}
+
+func (x *AdminListWebhookDeliveriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminListWebhookDeliveriesRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *AdminListWebhookDeliveriesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AdminListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Items         []*AdminWebhookDelivery `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int64                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                   `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListWebhookDeliveriesResponse) Reset() {
+	*x = AdminListWebhookDeliveriesResponse{}
+	mi := &file_proto_admin_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *AdminListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*AdminListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *AdminListWebhookDeliveriesResponse) GetItems() []*AdminWebhookDelivery {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AdminListWebhookDeliveriesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AdminListWebhookDeliveriesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminListWebhookDeliveriesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AdminRedeliverWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    int64                  `protobuf:"varint,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminRedeliverWebhookRequest) Reset() {
+	*x = AdminRedeliverWebhookRequest{}
+	mi := &file_proto_admin_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *AdminRedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*AdminRedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AdminRedeliverWebhookRequest) GetDeliveryId() int64 {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return 0
+}
+
+var File_proto_admin_proto protoreflect.FileDescriptor
+
+const file_proto_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x11proto/admin.proto\x12\x05admin\"\f\n" +
+	"\n" +
+	"AdminEmpty\"\xa8\x01\n" +
+	"\tAdminUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
+	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\x05R\x04role\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\x83\x01\n" +
+	"\x11AdminLoginRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x04 \x01(\tR\tipAddress\"Y\n" +
+	"\x12AdminLoginResponse\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12$\n" +
+	"\x04user\x18\x02 \x01(\v2\x10.admin.AdminUserR\x04user\"3\n" +
+	"\x12AdminLogoutRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"4\n" +
+	"\x13AdminSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"@\n" +
+	"\x18AdminCurrentUserResponse\x12$\n" +
+	"\x04user\x18\x01 \x01(\v2\x10.admin.AdminUserR\x04user\"\xa6\x03\n" +
+	"\x15AdminOverviewResponse\x12\x1f\n" +
+	"\vtotal_users\x18\x01 \x01(\x03R\n" +
+	"totalUsers\x12,\n" +
+	"\x12daily_active_users\x18\x02 \x01(\x03R\x10dailyActiveUsers\x12.\n" +
+	"\x13weekly_active_users\x18\x03 \x01(\x03R\x11weeklyActiveUsers\x12'\n" +
+	"\x0ftotal_downloads\x18\x04 \x01(\x03R\x0etotalDownloads\x12'\n" +
+	"\x0fdownloads_today\x18\x05 \x01(\x03R\x0edownloadsToday\x12+\n" +
+	"\x11success_downloads\x18\x06 \x01(\x03R\x10successDownloads\x12)\n" +
+	"\x10failed_downloads\x18\a \x01(\x03R\x0ffailedDownloads\x122\n" +
+	"\x15active_manual_proxies\x18\b \x01(\x03R\x13activeManualProxies\x120\n" +
+	"\x14total_manual_proxies\x18\t \x01(\x03R\x12totalManualProxies\"\xc9\x01\n" +
+	"\x0fAdminTrendPoint\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\x12#\n" +
+	"\rsuccess_count\x18\x04 \x01(\x03R\fsuccessCount\x12!\n" +
+	"\ffailed_count\x18\x05 \x01(\x03R\vfailedCount\x12!\n" +
+	"\fsuccess_rate\x18\x06 \x01(\x01R\vsuccessRate\"R\n" +
+	"\x18AdminRequestTrendRequest\x12 \n" +
+	"\vgranularity\x18\x01 \x01(\tR\vgranularity\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"m\n" +
+	"\x19AdminRequestTrendResponse\x12 \n" +
+	"\vgranularity\x18\x01 \x01(\tR\vgranularity\x12.\n" +
+	"\x06points\x18\x02 \x03(\v2\x16.admin.AdminTrendPointR\x06points\"\xde\x01\n" +
+	"\x17AdminDashboardDownloads\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x1f\n" +
+	"\vtoday_total\x18\x02 \x01(\x03R\n" +
+	"todayTotal\x12#\n" +
+	"\rsuccess_total\x18\x03 \x01(\x03R\fsuccessTotal\x12!\n" +
+	"\ffailed_total\x18\x04 \x01(\x03R\vfailedTotal\x12!\n" +
+	"\fsuccess_rate\x18\x05 \x01(\x01R\vsuccessRate\x12!\n" +
+	"\ffailure_rate\x18\x06 \x01(\x01R\vfailureRate\"\xbb\x01\n" +
+	"\x13AdminDashboardUsers\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12!\n" +
+	"\fdaily_active\x18\x02 \x01(\x03R\vdailyActive\x12#\n" +
+	"\rweekly_active\x18\x03 \x01(\x03R\fweeklyActive\x12 \n" +
+	"\fdau_wau_rate\x18\x04 \x01(\x01R\n" +
+	"dauWauRate\x12$\n" +
+	"\x0ewau_total_rate\x18\x05 \x01(\x01R\fwauTotalRate\"J\n" +
+	" AdminDashboardProxyErrorCategory\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\x91\x03\n" +
+	"\x15AdminDashboardProxies\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\x03R\x06active\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\x03R\tavailable\x12\x18\n" +
+	"\acooling\x18\x04 \x01(\x03R\acooling\x12\x1c\n" +
+	"\tsaturated\x18\x05 \x01(\x03R\tsaturated\x12\x1b\n" +
+	"\thigh_risk\x18\x06 \x01(\x03R\bhighRisk\x12%\n" +
+	"\x0erecent_success\x18\a \x01(\x03R\rrecentSuccess\x12%\n" +
+	"\x0erecent_failure\x18\b \x01(\x03R\rrecentFailure\x12.\n" +
+	"\x13recent_failure_rate\x18\t \x01(\x01R\x11recentFailureRate\x12Y\n" +
+	"\x14top_error_categories\x18\n" +
+	" \x03(\v2'.admin.AdminDashboardProxyErrorCategoryR\x12topErrorCategories\"\xe0\x01\n" +
+	"\x19AdminDashboardProxySource\x12\x18\n" +
+	"\ahealthy\x18\x01 \x01(\bR\ahealthy\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12-\n" +
+	"\x12dynamic_configured\x18\x04 \x01(\bR\x11dynamicConfigured\x12$\n" +
+	"\x0eproxy_lease_id\x18\x05 \x01(\tR\fproxyLeaseId\x12&\n" +
+	"\x0fproxy_expire_at\x18\x06 \x01(\tR\rproxyExpireAt\"\x96\x01\n" +
+	"\x19AdminDashboardProxyPolicy\x12%\n" +
+	"\x0eprimary_source\x18\x01 \x01(\tR\rprimarySource\x12'\n" +
+	"\x0ffallback_source\x18\x02 \x01(\tR\x0efallbackSource\x12)\n" +
+	"\x10fallback_enabled\x18\x03 \x01(\bR\x0ffallbackEnabled\"w\n" +
+	"\x15AdminDashboardCookies\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\x03R\x06active\x12\x18\n" +
+	"\aexpired\x18\x03 \x01(\x03R\aexpired\x12\x16\n" +
+	"\x06frozen\x18\x04 \x01(\x03R\x06frozen\"@\n" +
+	"\x15AdminDashboardBilling\x12'\n" +
+	"\x0fshortfall_count\x18\x01 \x01(\x03R\x0eshortfallCount\"\x93\x02\n" +
+	"\x15AdminDashboardWorkers\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\x12\x1c\n" +
+	"\tinstances\x18\x02 \x01(\x05R\tinstances\x12%\n" +
+	"\x0emax_concurrent\x18\x03 \x01(\x05R\rmaxConcurrent\x12\x18\n" +
+	"\arunning\x18\x04 \x01(\x05R\arunning\x12\x1a\n" +
+	"\bbuffered\x18\x05 \x01(\x05R\bbuffered\x12\x16\n" +
+	"\x06queued\x18\x06 \x01(\x03R\x06queued\x12)\n" +
+	"\x10paused_instances\x18\a \x01(\x05R\x0fpausedInstances\x12\x1e\n" +
+	"\n" +
+	"saturation\x18\b \x01(\x01R\n" +
+	"saturation\"\xa7\x01\n" +
+	"\x17AdminDashboardException\x12\x12\n" +
+	"\x04area\x18\x01 \x01(\tR\x04area\x12\x1a\n" +
+	"\bseverity\x18\x02 \x01(\tR\bseverity\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12!\n" +
+	"\faction_label\x18\x04 \x01(\tR\vactionLabel\x12\x1f\n" +
+	"\vaction_href\x18\x05 \x01(\tR\n" +
+	"actionHref\"\xdb\x04\n" +
+	"\x1cAdminDashboardHealthResponse\x12!\n" +
+	"\fgenerated_at\x18\x01 \x01(\tR\vgeneratedAt\x12<\n" +
+	"\tdownloads\x18\x02 \x01(\v2\x1e.admin.AdminDashboardDownloadsR\tdownloads\x120\n" +
+	"\x05users\x18\x03 \x01(\v2\x1a.admin.AdminDashboardUsersR\x05users\x126\n" +
+	"\aproxies\x18\x04 \x01(\v2\x1c.admin.AdminDashboardProxiesR\aproxies\x12C\n" +
+	"\fproxy_source\x18\x05 \x01(\v2 .admin.AdminDashboardProxySourceR\vproxySource\x12C\n" +
+	"\fproxy_policy\x18\x06 \x01(\v2 .admin.AdminDashboardProxyPolicyR\vproxyPolicy\x126\n" +
+	"\acookies\x18\a \x01(\v2\x1c.admin.AdminDashboardCookiesR\acookies\x126\n" +
+	"\abilling\x18\b \x01(\v2\x1c.admin.AdminDashboardBillingR\abilling\x12>\n" +
+	"\n" +
+	"exceptions\x18\t \x03(\v2\x1e.admin.AdminDashboardExceptionR\n" +
+	"exceptions\x126\n" +
+	"\aworkers\x18\n" +
+	" \x01(\v2\x1c.admin.AdminDashboardWorkersR\aworkers\"\x97\x01\n" +
+	"\x16AdminUserStatsResponse\x12\x1f\n" +
+	"\vtotal_users\x18\x01 \x01(\x03R\n" +
+	"totalUsers\x12,\n" +
+	"\x12daily_active_users\x18\x02 \x01(\x03R\x10dailyActiveUsers\x12.\n" +
+	"\x13weekly_active_users\x18\x03 \x01(\x03R\x11weeklyActiveUsers\"\xe2\x02\n" +
+	"\x1eAdminProxySourceStatusResponse\x12\x18\n" +
+	"\ahealthy\x18\x01 \x01(\bR\ahealthy\x12\x12\n" +
 	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1b\n" +
 	"\tproxy_url\x18\x04 \x01(\tR\bproxyUrl\x12$\n" +
@@ -6247,7 +8248,184 @@ const file_proto_admin_proto_rawDesc = "" +
 	"\vamount_yuan\x18\x02 \x01(\tR\n" +
 	"amountYuan\x12#\n" +
 	"\rcurrency_code\x18\x03 \x01(\tR\fcurrencyCode\x12(\n" +
-	"\x10operator_user_id\x18\x04 \x01(\tR\x0eoperatorUserId2\xf4\x16\n" +
+	"\x10operator_user_id\x18\x04 \x01(\tR\x0eoperatorUserId\"\xb6\x02\n" +
+	"\x15AdminListTasksRequest\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12%\n" +
+	"\x0eerror_category\x18\x03 \x01(\tR\rerrorCategory\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12&\n" +
+	"\x0fstart_time_unix\x18\x05 \x01(\x03R\rstartTimeUnix\x12\"\n" +
+	"\rend_time_unix\x18\x06 \x01(\x03R\vendTimeUnix\x12.\n" +
+	"\x13stuck_after_seconds\x18\a \x01(\x03R\x11stuckAfterSeconds\x12\x12\n" +
+	"\x04page\x18\b \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\t \x01(\x05R\bpageSize\"\x9c\x04\n" +
+	"\rAdminTaskItem\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1a\n" +
+	"\bplatform\x18\x04 \x01(\tR\bplatform\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x12\n" +
+	"\x04mode\x18\x06 \x01(\tR\x04mode\x12\x18\n" +
+	"\aquality\x18\a \x01(\tR\aquality\x12\x16\n" +
+	"\x06status\x18\b \x01(\x05R\x06status\x12\x1f\n" +
+	"\vstatus_text\x18\t \x01(\tR\n" +
+	"statusText\x12#\n" +
+	"\rerror_message\x18\n" +
+	" \x01(\tR\ferrorMessage\x12%\n" +
+	"\x0eerror_category\x18\v \x01(\tR\rerrorCategory\x12\x1f\n" +
+	"\vretry_count\x18\f \x01(\x05R\n" +
+	"retryCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x0e \x01(\tR\tstartedAt\x12(\n" +
+	"\x10dead_lettered_at\x18\x0f \x01(\tR\x0edeadLetteredAt\x12#\n" +
+	"\rdead_lettered\x18\x10 \x01(\bR\fdeadLettered\x12\x14\n" +
+	"\x05stuck\x18\x11 \x01(\bR\x05stuck\x12\x1e\n" +
+	"\n" +
+	"replayable\x18\x12 \x01(\bR\n" +
+	"replayable\"\x8b\x01\n" +
+	"\x16AdminListTasksResponse\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.admin.AdminTaskItemR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xa3\x01\n" +
+	"\x16AdminTaskActionRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12.\n" +
+	"\x13stuck_after_seconds\x18\x03 \x01(\x03R\x11stuckAfterSeconds\x12(\n" +
+	"\x10operator_user_id\x18\x04 \x01(\tR\x0eoperatorUserId\"M\n" +
+	"\x17AdminTaskActionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb4\x01\n" +
+	"\x15AdminWorkerActiveTask\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bplatform\x18\x03 \x01(\tR\bplatform\x12\x14\n" +
+	"\x05phase\x18\x04 \x01(\tR\x05phase\x12\x18\n" +
+	"\apercent\x18\x05 \x01(\x01R\apercent\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\tR\tstartedAt\"\x9b\x01\n" +
+	"\x15AdminWorkerQueueDepth\x12\x14\n" +
+	"\x05queue\x18\x01 \x01(\tR\x05queue\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x1a\n" +
+	"\bmessages\x18\x03 \x01(\x03R\bmessages\x12\x1c\n" +
+	"\tconsumers\x18\x04 \x01(\x05R\tconsumers\x12\x16\n" +
+	"\x06paused\x18\x05 \x01(\bR\x06paused\"\x83\x03\n" +
+	"\x0fAdminWorkerPool\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x1b\n" +
+	"\tpool_size\x18\x02 \x01(\x05R\bpoolSize\x12%\n" +
+	"\x0emax_concurrent\x18\x03 \x01(\x05R\rmaxConcurrent\x12\x18\n" +
+	"\arunning\x18\x04 \x01(\x05R\arunning\x12\x1a\n" +
+	"\bbuffered\x18\x05 \x01(\x05R\bbuffered\x12'\n" +
+	"\x0fbuffer_capacity\x18\x06 \x01(\x05R\x0ebufferCapacity\x12\x16\n" +
+	"\x06paused\x18\a \x01(\bR\x06paused\x124\n" +
+	"\x06queues\x18\b \x03(\v2\x1c.admin.AdminWorkerQueueDepthR\x06queues\x12?\n" +
+	"\factive_tasks\x18\t \x03(\v2\x1c.admin.AdminWorkerActiveTaskR\vactiveTasks\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\"L\n" +
+	"\x1cAdminListWorkerPoolsResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.admin.AdminWorkerPoolR\x05items\"\xa8\x01\n" +
+	"\x1cAdminUpdateWorkerPoolRequest\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12%\n" +
+	"\x0emax_concurrent\x18\x03 \x01(\x05R\rmaxConcurrent\x12(\n" +
+	"\x10operator_user_id\x18\x04 \x01(\tR\x0eoperatorUserId\"\xbf\x02\n" +
+	"\rAdminPlatform\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12\x1a\n" +
+	"\bpatterns\x18\x04 \x03(\tR\bpatterns\x12\x18\n" +
+	"\aadapter\x18\x05 \x01(\tR\aadapter\x12\x1d\n" +
+	"\n" +
+	"extra_args\x18\x06 \x03(\tR\textraArgs\x12\x18\n" +
+	"\acookies\x18\a \x01(\tR\acookies\x12\x14\n" +
+	"\x05proxy\x18\b \x01(\tR\x05proxy\x12(\n" +
+	"\x10parse_per_minute\x18\t \x01(\x03R\x0eparsePerMinute\x12.\n" +
+	"\x13download_per_minute\x18\n" +
+	" \x01(\x03R\x11downloadPerMinute\"H\n" +
+	"\x1aAdminListPlatformsResponse\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.admin.AdminPlatformR\x05items\"t\n" +
+	"\x1aAdminUpdatePlatformRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12(\n" +
+	"\x10operator_user_id\x18\x03 \x01(\tR\x0eoperatorUserId\"I\n" +
+	"\x15AdminPlatformResponse\x120\n" +
+	"\bplatform\x18\x01 \x01(\v2\x14.admin.AdminPlatformR\bplatform\"\xe9\x01\n" +
+	"\x14AdminWebhookEndpoint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x03 \x03(\tR\x06events\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"y\n" +
+	"\x19AdminListWebhooksResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.admin.AdminWebhookEndpointR\x05items\x12)\n" +
+	"\x10available_events\x18\x02 \x03(\tR\x0favailableEvents\"\x91\x01\n" +
+	"\x19AdminCreateWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x02 \x03(\tR\x06events\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12(\n" +
+	"\x10operator_user_id\x18\x04 \x01(\tR\x0eoperatorUserId\"m\n" +
+	"\x1aAdminCreateWebhookResponse\x127\n" +
+	"\bendpoint\x18\x01 \x01(\v2\x1b.admin.AdminWebhookEndpointR\bendpoint\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"\xe3\x01\n" +
+	"\x19AdminUpdateWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12#\n" +
+	"\rupdate_events\x18\x03 \x01(\bR\fupdateEvents\x12\x16\n" +
+	"\x06events\x18\x04 \x03(\tR\x06events\x12-\n" +
+	"\x12update_description\x18\x05 \x01(\bR\x11updateDescription\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\"O\n" +
+	"\x14AdminWebhookResponse\x127\n" +
+	"\bendpoint\x18\x01 \x01(\v2\x1b.admin.AdminWebhookEndpointR\bendpoint\"\xee\x03\n" +
+	"\x14AdminWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vendpoint_id\x18\x02 \x01(\x03R\n" +
+	"endpointId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12!\n" +
+	"\fpayload_json\x18\x06 \x01(\tR\vpayloadJson\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\b \x01(\x05R\battempts\x12&\n" +
+	"\x0fnext_attempt_at\x18\t \x01(\tR\rnextAttemptAt\x12&\n" +
+	"\x0flast_attempt_at\x18\n" +
+	" \x01(\tR\rlastAttemptAt\x12'\n" +
+	"\x0fresponse_status\x18\v \x01(\x05R\x0eresponseStatus\x12#\n" +
+	"\rresponse_body\x18\f \x01(\tR\fresponseBody\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\r \x01(\tR\tlastError\x12\x1f\n" +
+	"\vduration_ms\x18\x0e \x01(\x03R\n" +
+	"durationMs\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\tR\tcreatedAt\"\xac\x01\n" +
+	"!AdminListWebhookDeliveriesRequest\x12\x1f\n" +
+	"\vendpoint_id\x18\x01 \x01(\x03R\n" +
+	"endpointId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\tR\teventType\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\"\x9e\x01\n" +
+	"\"AdminListWebhookDeliveriesResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.admin.AdminWebhookDeliveryR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"?\n" +
+	"\x1cAdminRedeliverWebhookRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\x03R\n" +
+	"deliveryId2\xe1\x1f\n" +
 	"\fAdminService\x12<\n" +
 	"\x05Login\x12\x18.admin.AdminLoginRequest\x1a\x19.admin.AdminLoginResponse\x12B\n" +
 	"\x06Logout\x12\x19.admin.AdminLogoutRequest\x1a\x1d.admin.AdminOperationResponse\x12M\n" +
@@ -6281,7 +8459,22 @@ const file_proto_admin_proto_rawDesc = "" +
 	"\x11GetBillingPricing\x12\x11.admin.AdminEmpty\x1a\".admin.AdminBillingPricingResponse\x12c\n" +
 	"\x14UpdateBillingPricing\x12'.admin.AdminUpdateBillingPricingRequest\x1a\".admin.AdminBillingPricingResponse\x12X\n" +
 	"\x18GetWelcomeCreditSettings\x12\x11.admin.AdminEmpty\x1a).admin.AdminWelcomeCreditSettingsResponse\x12x\n" +
-	"\x1bUpdateWelcomeCreditSettings\x12..admin.AdminUpdateWelcomeCreditSettingsRequest\x1a).admin.AdminWelcomeCreditSettingsResponseB\x1fZ\x1dyoudlp/admin-service/proto;pbb\x06proto3"
+	"\x1bUpdateWelcomeCreditSettings\x12..admin.AdminUpdateWelcomeCreditSettingsRequest\x1a).admin.AdminWelcomeCreditSettingsResponse\x12H\n" +
+	"\tListTasks\x12\x1c.admin.AdminListTasksRequest\x1a\x1d.admin.AdminListTasksResponse\x12K\n" +
+	"\n" +
+	"ReplayTask\x12\x1d.admin.AdminTaskActionRequest\x1a\x1e.admin.AdminTaskActionResponse\x12N\n" +
+	"\rForceFailTask\x12\x1d.admin.AdminTaskActionRequest\x1a\x1e.admin.AdminTaskActionResponse\x12J\n" +
+	"\tPurgeTask\x12\x1d.admin.AdminTaskActionRequest\x1a\x1e.admin.AdminTaskActionResponse\x12I\n" +
+	"\x0fListWorkerPools\x12\x11.admin.AdminEmpty\x1a#.admin.AdminListWorkerPoolsResponse\x12W\n" +
+	"\x10UpdateWorkerPool\x12#.admin.AdminUpdateWorkerPoolRequest\x1a\x1e.admin.AdminTaskActionResponse\x12E\n" +
+	"\rListPlatforms\x12\x11.admin.AdminEmpty\x1a!.admin.AdminListPlatformsResponse\x12Q\n" +
+	"\x0eUpdatePlatform\x12!.admin.AdminUpdatePlatformRequest\x1a\x1c.admin.AdminPlatformResponse\x12C\n" +
+	"\fListWebhooks\x12\x11.admin.AdminEmpty\x1a .admin.AdminListWebhooksResponse\x12T\n" +
+	"\rCreateWebhook\x12 .admin.AdminCreateWebhookRequest\x1a!.admin.AdminCreateWebhookResponse\x12N\n" +
+	"\rUpdateWebhook\x12 .admin.AdminUpdateWebhookRequest\x1a\x1b.admin.AdminWebhookResponse\x12I\n" +
+	"\rDeleteWebhook\x12\x19.admin.AdminDeleteRequest\x1a\x1d.admin.AdminOperationResponse\x12l\n" +
+	"\x15ListWebhookDeliveries\x12(.admin.AdminListWebhookDeliveriesRequest\x1a).admin.AdminListWebhookDeliveriesResponse\x12V\n" +
+	"\x10RedeliverWebhook\x12#.admin.AdminRedeliverWebhookRequest\x1a\x1d.admin.AdminOperationResponseB\x1fZ\x1dyoudlp/admin-service/proto;pbb\x06proto3"
 
 var (
 	file_proto_admin_proto_rawDescOnce sync.Once
@@ -6295,7 +8488,7 @@ func file_proto_admin_proto_rawDescGZIP() []byte {
 	return file_proto_admin_proto_rawDescData
 }
 
-var file_proto_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
+var file_proto_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 95)
 var file_proto_admin_proto_goTypes = []any{
 	(*AdminEmpty)(nil),                              // 0: admin.AdminEmpty
 	(*AdminUser)(nil),                               // 1: admin.AdminUser
@@ -6316,57 +8509,82 @@ var file_proto_admin_proto_goTypes = []any{
 	(*AdminDashboardProxyPolicy)(nil),               // 16: admin.AdminDashboardProxyPolicy
 	(*AdminDashboardCookies)(nil),                   // 17: admin.AdminDashboardCookies
 	(*AdminDashboardBilling)(nil),                   // 18: admin.AdminDashboardBilling
-	(*AdminDashboardException)(nil),                 // 19: admin.AdminDashboardException
-	(*AdminDashboardHealthResponse)(nil),            // 20: admin.AdminDashboardHealthResponse
-	(*AdminUserStatsResponse)(nil),                  // 21: admin.AdminUserStatsResponse
-	(*AdminProxySourceStatusResponse)(nil),          // 22: admin.AdminProxySourceStatusResponse
-	(*AdminProxySourcePolicyResponse)(nil),          // 23: admin.AdminProxySourcePolicyResponse
-	(*AdminUpdateProxySourcePolicyRequest)(nil),     // 24: admin.AdminUpdateProxySourcePolicyRequest
-	(*AdminProxyInfo)(nil),                          // 25: admin.AdminProxyInfo
-	(*AdminListProxiesRequest)(nil),                 // 26: admin.AdminListProxiesRequest
-	(*AdminListProxiesResponse)(nil),                // 27: admin.AdminListProxiesResponse
-	(*AdminListProxyUsageEventsRequest)(nil),        // 28: admin.AdminListProxyUsageEventsRequest
-	(*AdminProxyUsageEventItem)(nil),                // 29: admin.AdminProxyUsageEventItem
-	(*AdminProxyUsageEventCount)(nil),               // 30: admin.AdminProxyUsageEventCount
-	(*AdminProxyUsageEventSummary)(nil),             // 31: admin.AdminProxyUsageEventSummary
-	(*AdminListProxyUsageEventsResponse)(nil),       // 32: admin.AdminListProxyUsageEventsResponse
-	(*AdminCreateProxyRequest)(nil),                 // 33: admin.AdminCreateProxyRequest
-	(*AdminUpdateProxyRequest)(nil),                 // 34: admin.AdminUpdateProxyRequest
-	(*AdminUpdateProxyStatusRequest)(nil),           // 35: admin.AdminUpdateProxyStatusRequest
-	(*AdminDeleteRequest)(nil),                      // 36: admin.AdminDeleteRequest
-	(*AdminCookieInfo)(nil),                         // 37: admin.AdminCookieInfo
-	(*AdminListCookiesRequest)(nil),                 // 38: admin.AdminListCookiesRequest
-	(*AdminListCookiesResponse)(nil),                // 39: admin.AdminListCookiesResponse
-	(*AdminGetCookieRequest)(nil),                   // 40: admin.AdminGetCookieRequest
-	(*AdminGetCookieResponse)(nil),                  // 41: admin.AdminGetCookieResponse
-	(*AdminCreateCookieRequest)(nil),                // 42: admin.AdminCreateCookieRequest
-	(*AdminUpdateCookieRequest)(nil),                // 43: admin.AdminUpdateCookieRequest
-	(*AdminFreezeCookieRequest)(nil),                // 44: admin.AdminFreezeCookieRequest
-	(*AdminFreezeCookieResponse)(nil),               // 45: admin.AdminFreezeCookieResponse
-	(*AdminCreateResourceResponse)(nil),             // 46: admin.AdminCreateResourceResponse
-	(*AdminOperationResponse)(nil),                  // 47: admin.AdminOperationResponse
-	(*AdminBillingAccount)(nil),                     // 48: admin.AdminBillingAccount
-	(*AdminListBillingAccountsRequest)(nil),         // 49: admin.AdminListBillingAccountsRequest
-	(*AdminListBillingAccountsResponse)(nil),        // 50: admin.AdminListBillingAccountsResponse
-	(*AdminGetBillingAccountDetailRequest)(nil),     // 51: admin.AdminGetBillingAccountDetailRequest
-	(*AdminGetBillingAccountDetailResponse)(nil),    // 52: admin.AdminGetBillingAccountDetailResponse
-	(*AdminAdjustBillingBalanceRequest)(nil),        // 53: admin.AdminAdjustBillingBalanceRequest
-	(*AdminAdjustBillingBalanceResponse)(nil),       // 54: admin.AdminAdjustBillingBalanceResponse
-	(*AdminBillingShortfallOrder)(nil),              // 55: admin.AdminBillingShortfallOrder
-	(*AdminListBillingShortfallsRequest)(nil),       // 56: admin.AdminListBillingShortfallsRequest
-	(*AdminListBillingShortfallsResponse)(nil),      // 57: admin.AdminListBillingShortfallsResponse
-	(*AdminReconcileBillingShortfallRequest)(nil),   // 58: admin.AdminReconcileBillingShortfallRequest
-	(*AdminReconcileBillingShortfallResponse)(nil),  // 59: admin.AdminReconcileBillingShortfallResponse
-	(*AdminBillingLedgerEntry)(nil),                 // 60: admin.AdminBillingLedgerEntry
-	(*AdminListBillingLedgerRequest)(nil),           // 61: admin.AdminListBillingLedgerRequest
-	(*AdminListBillingLedgerResponse)(nil),          // 62: admin.AdminListBillingLedgerResponse
-	(*AdminBillingUsageRecord)(nil),                 // 63: admin.AdminBillingUsageRecord
-	(*AdminListBillingUsageRecordsRequest)(nil),     // 64: admin.AdminListBillingUsageRecordsRequest
-	(*AdminListBillingUsageRecordsResponse)(nil),    // 65: admin.AdminListBillingUsageRecordsResponse
-	(*AdminBillingPricingResponse)(nil),             // 66: admin.AdminBillingPricingResponse
-	(*AdminUpdateBillingPricingRequest)(nil),        // 67: admin.AdminUpdateBillingPricingRequest
-	(*AdminWelcomeCreditSettingsResponse)(nil),      // 68: admin.AdminWelcomeCreditSettingsResponse
-	(*AdminUpdateWelcomeCreditSettingsRequest)(nil), // 69: admin.AdminUpdateWelcomeCreditSettingsRequest
+	(*AdminDashboardWorkers)(nil),                   // 19: admin.AdminDashboardWorkers
+	(*AdminDashboardException)(nil),                 // 20: admin.AdminDashboardException
+	(*AdminDashboardHealthResponse)(nil),            // 21: admin.AdminDashboardHealthResponse
+	(*AdminUserStatsResponse)(nil),                  // 22: admin.AdminUserStatsResponse
+	(*AdminProxySourceStatusResponse)(nil),          // 23: admin.AdminProxySourceStatusResponse
+	(*AdminProxySourcePolicyResponse)(nil),          // 24: admin.AdminProxySourcePolicyResponse
+	(*AdminUpdateProxySourcePolicyRequest)(nil),     // 25: admin.AdminUpdateProxySourcePolicyRequest
+	(*AdminProxyInfo)(nil),                          // 26: admin.AdminProxyInfo
+	(*AdminListProxiesRequest)(nil),                 // 27: admin.AdminListProxiesRequest
+	(*AdminListProxiesResponse)(nil),                // 28: admin.AdminListProxiesResponse
+	(*AdminListProxyUsageEventsRequest)(nil),        // 29: admin.AdminListProxyUsageEventsRequest
+	(*AdminProxyUsageEventItem)(nil),                // 30: admin.AdminProxyUsageEventItem
+	(*AdminProxyUsageEventCount)(nil),               // 31: admin.AdminProxyUsageEventCount
+	(*AdminProxyUsageEventSummary)(nil),             // 32: admin.AdminProxyUsageEventSummary
+	(*AdminListProxyUsageEventsResponse)(nil),       // 33: admin.AdminListProxyUsageEventsResponse
+	(*AdminCreateProxyRequest)(nil),                 // 34: admin.AdminCreateProxyRequest
+	(*AdminUpdateProxyRequest)(nil),                 // 35: admin.AdminUpdateProxyRequest
+	(*AdminUpdateProxyStatusRequest)(nil),           // 36: admin.AdminUpdateProxyStatusRequest
+	(*AdminDeleteRequest)(nil),                      // 37: admin.AdminDeleteRequest
+	(*AdminCookieInfo)(nil),                         // 38: admin.AdminCookieInfo
+	(*AdminListCookiesRequest)(nil),                 // 39: admin.AdminListCookiesRequest
+	(*AdminListCookiesResponse)(nil),                // 40: admin.AdminListCookiesResponse
+	(*AdminGetCookieRequest)(nil),                   // 41: admin.AdminGetCookieRequest
+	(*AdminGetCookieResponse)(nil),                  // 42: admin.AdminGetCookieResponse
+	(*AdminCreateCookieRequest)(nil),                // 43: admin.AdminCreateCookieRequest
+	(*AdminUpdateCookieRequest)(nil),                // 44: admin.AdminUpdateCookieRequest
+	(*AdminFreezeCookieRequest)(nil),                // 45: admin.AdminFreezeCookieRequest
+	(*AdminFreezeCookieResponse)(nil),               // 46: admin.AdminFreezeCookieResponse
+	(*AdminCreateResourceResponse)(nil),             // 47: admin.AdminCreateResourceResponse
+	(*AdminOperationResponse)(nil),                  // 48: admin.AdminOperationResponse
+	(*AdminBillingAccount)(nil),                     // 49: admin.AdminBillingAccount
+	(*AdminListBillingAccountsRequest)(nil),         // 50: admin.AdminListBillingAccountsRequest
+	(*AdminListBillingAccountsResponse)(nil),        // 51: admin.AdminListBillingAccountsResponse
+	(*AdminGetBillingAccountDetailRequest)(nil),     // 52: admin.AdminGetBillingAccountDetailRequest
+	(*AdminGetBillingAccountDetailResponse)(nil),    // 53: admin.AdminGetBillingAccountDetailResponse
+	(*AdminAdjustBillingBalanceRequest)(nil),        // 54: admin.AdminAdjustBillingBalanceRequest
+	(*AdminAdjustBillingBalanceResponse)(nil),       // 55: admin.AdminAdjustBillingBalanceResponse
+	(*AdminBillingShortfallOrder)(nil),              // 56: admin.AdminBillingShortfallOrder
+	(*AdminListBillingShortfallsRequest)(nil),       // 57: admin.AdminListBillingShortfallsRequest
+	(*AdminListBillingShortfallsResponse)(nil),      // 58: admin.AdminListBillingShortfallsResponse
+	(*AdminReconcileBillingShortfallRequest)(nil),   // 59: admin.AdminReconcileBillingShortfallRequest
+	(*AdminReconcileBillingShortfallResponse)(nil),  // 60: admin.AdminReconcileBillingShortfallResponse
+	(*AdminBillingLedgerEntry)(nil),                 // 61: admin.AdminBillingLedgerEntry
+	(*AdminListBillingLedgerRequest)(nil),           // 62: admin.AdminListBillingLedgerRequest
+	(*AdminListBillingLedgerResponse)(nil),          // 63: admin.AdminListBillingLedgerResponse
+	(*AdminBillingUsageRecord)(nil),                 // 64: admin.AdminBillingUsageRecord
+	(*AdminListBillingUsageRecordsRequest)(nil),     // 65: admin.AdminListBillingUsageRecordsRequest
+	(*AdminListBillingUsageRecordsResponse)(nil),    // 66: admin.AdminListBillingUsageRecordsResponse
+	(*AdminBillingPricingResponse)(nil),             // 67: admin.AdminBillingPricingResponse
+	(*AdminUpdateBillingPricingRequest)(nil),        // 68: admin.AdminUpdateBillingPricingRequest
+	(*AdminWelcomeCreditSettingsResponse)(nil),      // 69: admin.AdminWelcomeCreditSettingsResponse
+	(*AdminUpdateWelcomeCreditSettingsRequest)(nil), // 70: admin.AdminUpdateWelcomeCreditSettingsRequest
+	(*AdminListTasksRequest)(nil),                   // 71: admin.AdminListTasksRequest
+	(*AdminTaskItem)(nil),                           // 72: admin.AdminTaskItem
+	(*AdminListTasksResponse)(nil),                  // 73: admin.AdminListTasksResponse
+	(*AdminTaskActionRequest)(nil),                  // 74: admin.AdminTaskActionRequest
+	(*AdminTaskActionResponse)(nil),                 // 75: admin.AdminTaskActionResponse
+	(*AdminWorkerActiveTask)(nil),                   // 76: admin.AdminWorkerActiveTask
+	(*AdminWorkerQueueDepth)(nil),                   // 77: admin.AdminWorkerQueueDepth
+	(*AdminWorkerPool)(nil),                         // 78: admin.AdminWorkerPool
+	(*AdminListWorkerPoolsResponse)(nil),            // 79: admin.AdminListWorkerPoolsResponse
+	(*AdminUpdateWorkerPoolRequest)(nil),            // 80: admin.AdminUpdateWorkerPoolRequest
+	(*AdminPlatform)(nil),                           // 81: admin.AdminPlatform
+	(*AdminListPlatformsResponse)(nil),              // 82: admin.AdminListPlatformsResponse
+	(*AdminUpdatePlatformRequest)(nil),              // 83: admin.AdminUpdatePlatformRequest
+	(*AdminPlatformResponse)(nil),                   // 84: admin.AdminPlatformResponse
+	(*AdminWebhookEndpoint)(nil),                    // 85: admin.AdminWebhookEndpoint
+	(*AdminListWebhooksResponse)(nil),               // 86: admin.AdminListWebhooksResponse
+	(*AdminCreateWebhookRequest)(nil),               // 87: admin.AdminCreateWebhookRequest
+	(*AdminCreateWebhookResponse)(nil),              // 88: admin.AdminCreateWebhookResponse
+	(*AdminUpdateWebhookRequest)(nil),               // 89: admin.AdminUpdateWebhookRequest
+	(*AdminWebhookResponse)(nil),                    // 90: admin.AdminWebhookResponse
+	(*AdminWebhookDelivery)(nil),                    // 91: admin.AdminWebhookDelivery
+	(*AdminListWebhookDeliveriesRequest)(nil),       // 92: admin.AdminListWebhookDeliveriesRequest
+	(*AdminListWebhookDeliveriesResponse)(nil),      // 93: admin.AdminListWebhookDeliveriesResponse
+	(*AdminRedeliverWebhookRequest)(nil),            // 94: admin.AdminRedeliverWebhookRequest
 }
 var file_proto_admin_proto_depIdxs = []int32{
 	1,  // 0: admin.AdminLoginResponse.user:type_name -> admin.AdminUser
@@ -6380,94 +8598,133 @@ var file_proto_admin_proto_depIdxs = []int32{
 	16, // 8: admin.AdminDashboardHealthResponse.proxy_policy:type_name -> admin.AdminDashboardProxyPolicy
 	17, // 9: admin.AdminDashboardHealthResponse.cookies:type_name -> admin.AdminDashboardCookies
 	18, // 10: admin.AdminDashboardHealthResponse.billing:type_name -> admin.AdminDashboardBilling
-	19, // 11: admin.AdminDashboardHealthResponse.exceptions:type_name -> admin.AdminDashboardException
-	25, // 12: admin.AdminListProxiesResponse.items:type_name -> admin.AdminProxyInfo
-	30, // 13: admin.AdminProxyUsageEventSummary.category_counts:type_name -> admin.AdminProxyUsageEventCount
-	30, // 14: admin.AdminProxyUsageEventSummary.stage_counts:type_name -> admin.AdminProxyUsageEventCount
-	30, // 15: admin.AdminProxyUsageEventSummary.platform_counts:type_name -> admin.AdminProxyUsageEventCount
-	29, // 16: admin.AdminListProxyUsageEventsResponse.events:type_name -> admin.AdminProxyUsageEventItem
-	31, // 17: admin.AdminListProxyUsageEventsResponse.summary:type_name -> admin.AdminProxyUsageEventSummary
-	37, // 18: admin.AdminListCookiesResponse.items:type_name -> admin.AdminCookieInfo
-	37, // 19: admin.AdminGetCookieResponse.cookie:type_name -> admin.AdminCookieInfo
-	48, // 20: admin.AdminListBillingAccountsResponse.items:type_name -> admin.AdminBillingAccount
-	48, // 21: admin.AdminGetBillingAccountDetailResponse.account:type_name -> admin.AdminBillingAccount
-	48, // 22: admin.AdminAdjustBillingBalanceResponse.account:type_name -> admin.AdminBillingAccount
-	55, // 23: admin.AdminListBillingShortfallsResponse.items:type_name -> admin.AdminBillingShortfallOrder
-	55, // 24: admin.AdminReconcileBillingShortfallResponse.order:type_name -> admin.AdminBillingShortfallOrder
-	48, // 25: admin.AdminReconcileBillingShortfallResponse.account:type_name -> admin.AdminBillingAccount
-	60, // 26: admin.AdminListBillingLedgerResponse.items:type_name -> admin.AdminBillingLedgerEntry
-	63, // 27: admin.AdminListBillingUsageRecordsResponse.items:type_name -> admin.AdminBillingUsageRecord
-	2,  // 28: admin.AdminService.Login:input_type -> admin.AdminLoginRequest
-	4,  // 29: admin.AdminService.Logout:input_type -> admin.AdminLogoutRequest
-	5,  // 30: admin.AdminService.GetCurrentUser:input_type -> admin.AdminSessionRequest
-	0,  // 31: admin.AdminService.GetOverview:input_type -> admin.AdminEmpty
-	9,  // 32: admin.AdminService.GetRequestTrend:input_type -> admin.AdminRequestTrendRequest
-	0,  // 33: admin.AdminService.GetDashboardHealth:input_type -> admin.AdminEmpty
-	0,  // 34: admin.AdminService.GetUserStats:input_type -> admin.AdminEmpty
-	0,  // 35: admin.AdminService.GetProxySourceStatus:input_type -> admin.AdminEmpty
-	0,  // 36: admin.AdminService.GetProxySourcePolicy:input_type -> admin.AdminEmpty
-	24, // 37: admin.AdminService.UpdateProxySourcePolicy:input_type -> admin.AdminUpdateProxySourcePolicyRequest
-	26, // 38: admin.AdminService.ListProxies:input_type -> admin.AdminListProxiesRequest
-	28, // 39: admin.AdminService.ListProxyUsageEvents:input_type -> admin.AdminListProxyUsageEventsRequest
-	33, // 40: admin.AdminService.CreateProxy:input_type -> admin.AdminCreateProxyRequest
-	34, // 41: admin.AdminService.UpdateProxy:input_type -> admin.AdminUpdateProxyRequest
-	35, // 42: admin.AdminService.UpdateProxyStatus:input_type -> admin.AdminUpdateProxyStatusRequest
-	36, // 43: admin.AdminService.DeleteProxy:input_type -> admin.AdminDeleteRequest
-	38, // 44: admin.AdminService.ListCookies:input_type -> admin.AdminListCookiesRequest
-	40, // 45: admin.AdminService.GetCookie:input_type -> admin.AdminGetCookieRequest
-	42, // 46: admin.AdminService.CreateCookie:input_type -> admin.AdminCreateCookieRequest
-	43, // 47: admin.AdminService.UpdateCookie:input_type -> admin.AdminUpdateCookieRequest
-	36, // 48: admin.AdminService.DeleteCookie:input_type -> admin.AdminDeleteRequest
-	44, // 49: admin.AdminService.FreezeCookie:input_type -> admin.AdminFreezeCookieRequest
-	49, // 50: admin.AdminService.ListBillingAccounts:input_type -> admin.AdminListBillingAccountsRequest
-	51, // 51: admin.AdminService.GetBillingAccountDetail:input_type -> admin.AdminGetBillingAccountDetailRequest
-	53, // 52: admin.AdminService.AdjustBillingBalance:input_type -> admin.AdminAdjustBillingBalanceRequest
-	56, // 53: admin.AdminService.ListBillingShortfalls:input_type -> admin.AdminListBillingShortfallsRequest
-	58, // 54: admin.AdminService.ReconcileBillingShortfall:input_type -> admin.AdminReconcileBillingShortfallRequest
-	61, // 55: admin.AdminService.ListBillingLedger:input_type -> admin.AdminListBillingLedgerRequest
-	64, // 56: admin.AdminService.ListBillingUsageRecords:input_type -> admin.AdminListBillingUsageRecordsRequest
-	0,  // 57: admin.AdminService.GetBillingPricing:input_type -> admin.AdminEmpty
-	67, // 58: admin.AdminService.UpdateBillingPricing:input_type -> admin.AdminUpdateBillingPricingRequest
-	0,  // 59: admin.AdminService.GetWelcomeCreditSettings:input_type -> admin.AdminEmpty
-	69, // 60: admin.AdminService.UpdateWelcomeCreditSettings:input_type -> admin.AdminUpdateWelcomeCreditSettingsRequest
-	3,  // 61: admin.AdminService.Login:output_type -> admin.AdminLoginResponse
-	47, // 62: admin.AdminService.Logout:output_type -> admin.AdminOperationResponse
-	6,  // 63: admin.AdminService.GetCurrentUser:output_type -> admin.AdminCurrentUserResponse
-	7,  // 64: admin.AdminService.GetOverview:output_type -> admin.AdminOverviewResponse
-	10, // 65: admin.AdminService.GetRequestTrend:output_type -> admin.AdminRequestTrendResponse
-	20, // 66: admin.AdminService.GetDashboardHealth:output_type -> admin.AdminDashboardHealthResponse
-	21, // 67: admin.AdminService.GetUserStats:output_type -> admin.AdminUserStatsResponse
-	22, // 68: admin.AdminService.GetProxySourceStatus:output_type -> admin.AdminProxySourceStatusResponse
-	23, // 69: admin.AdminService.GetProxySourcePolicy:output_type -> admin.AdminProxySourcePolicyResponse
-	47, // 70: admin.AdminService.UpdateProxySourcePolicy:output_type -> admin.AdminOperationResponse
-	27, // 71: admin.AdminService.ListProxies:output_type -> admin.AdminListProxiesResponse
-	32, // 72: admin.AdminService.ListProxyUsageEvents:output_type -> admin.AdminListProxyUsageEventsResponse
-	46, // 73: admin.AdminService.CreateProxy:output_type -> admin.AdminCreateResourceResponse
-	47, // 74: admin.AdminService.UpdateProxy:output_type -> admin.AdminOperationResponse
-	47, // 75: admin.AdminService.UpdateProxyStatus:output_type -> admin.AdminOperationResponse
-	47, // 76: admin.AdminService.DeleteProxy:output_type -> admin.AdminOperationResponse
-	39, // 77: admin.AdminService.ListCookies:output_type -> admin.AdminListCookiesResponse
-	41, // 78: admin.AdminService.GetCookie:output_type -> admin.AdminGetCookieResponse
-	46, // 79: admin.AdminService.CreateCookie:output_type -> admin.AdminCreateResourceResponse
-	47, // 80: admin.AdminService.UpdateCookie:output_type -> admin.AdminOperationResponse
-	47, // 81: admin.AdminService.DeleteCookie:output_type -> admin.AdminOperationResponse
-	45, // 82: admin.AdminService.FreezeCookie:output_type -> admin.AdminFreezeCookieResponse
-	50, // 83: admin.AdminService.ListBillingAccounts:output_type -> admin.AdminListBillingAccountsResponse
-	52, // 84: admin.AdminService.GetBillingAccountDetail:output_type -> admin.AdminGetBillingAccountDetailResponse
-	54, // 85: admin.AdminService.AdjustBillingBalance:output_type -> admin.AdminAdjustBillingBalanceResponse
-	57, // 86: admin.AdminService.ListBillingShortfalls:output_type -> admin.AdminListBillingShortfallsResponse
-	59, // 87: admin.AdminService.ReconcileBillingShortfall:output_type -> admin.AdminReconcileBillingShortfallResponse
-	62, // 88: admin.AdminService.ListBillingLedger:output_type -> admin.AdminListBillingLedgerResponse
-	65, // 89: admin.AdminService.ListBillingUsageRecords:output_type -> admin.AdminListBillingUsageRecordsResponse
-	66, // 90: admin.AdminService.GetBillingPricing:output_type -> admin.AdminBillingPricingResponse
-	66, // 91: admin.AdminService.UpdateBillingPricing:output_type -> admin.AdminBillingPricingResponse
-	68, // 92: admin.AdminService.GetWelcomeCreditSettings:output_type -> admin.AdminWelcomeCreditSettingsResponse
-	68, // 93: admin.AdminService.UpdateWelcomeCreditSettings:output_type -> admin.AdminWelcomeCreditSettingsResponse
-	61, // [61:94] is the sub-list for method output_type
-	28, // [28:61] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	20, // 11: admin.AdminDashboardHealthResponse.exceptions:type_name -> admin.AdminDashboardException
+	19, // 12: admin.AdminDashboardHealthResponse.workers:type_name -> admin.AdminDashboardWorkers
+	26, // 13: admin.AdminListProxiesResponse.items:type_name -> admin.AdminProxyInfo
+	31, // 14: admin.AdminProxyUsageEventSummary.category_counts:type_name -> admin.AdminProxyUsageEventCount
+	31, // 15: admin.AdminProxyUsageEventSummary.stage_counts:type_name -> admin.AdminProxyUsageEventCount
+	31, // 16: admin.AdminProxyUsageEventSummary.platform_counts:type_name -> admin.AdminProxyUsageEventCount
+	30, // 17: admin.AdminListProxyUsageEventsResponse.events:type_name -> admin.AdminProxyUsageEventItem
+	32, // 18: admin.AdminListProxyUsageEventsResponse.summary:type_name -> admin.AdminProxyUsageEventSummary
+	38, // 19: admin.AdminListCookiesResponse.items:type_name -> admin.AdminCookieInfo
+	38, // 20: admin.AdminGetCookieResponse.cookie:type_name -> admin.AdminCookieInfo
+	49, // 21: admin.AdminListBillingAccountsResponse.items:type_name -> admin.AdminBillingAccount
+	49, // 22: admin.AdminGetBillingAccountDetailResponse.account:type_name -> admin.AdminBillingAccount
+	49, // 23: admin.AdminAdjustBillingBalanceResponse.account:type_name -> admin.AdminBillingAccount
+	56, // 24: admin.AdminListBillingShortfallsResponse.items:type_name -> admin.AdminBillingShortfallOrder
+	56, // 25: admin.AdminReconcileBillingShortfallResponse.order:type_name -> admin.AdminBillingShortfallOrder
+	49, // 26: admin.AdminReconcileBillingShortfallResponse.account:type_name -> admin.AdminBillingAccount
+	61, // 27: admin.AdminListBillingLedgerResponse.items:type_name -> admin.AdminBillingLedgerEntry
+	64, // 28: admin.AdminListBillingUsageRecordsResponse.items:type_name -> admin.AdminBillingUsageRecord
+	72, // 29: admin.AdminListTasksResponse.items:type_name -> admin.AdminTaskItem
+	77, // 30: admin.AdminWorkerPool.queues:type_name -> admin.AdminWorkerQueueDepth
+	76, // 31: admin.AdminWorkerPool.active_tasks:type_name -> admin.AdminWorkerActiveTask
+	78, // 32: admin.AdminListWorkerPoolsResponse.items:type_name -> admin.AdminWorkerPool
+	81, // 33: admin.AdminListPlatformsResponse.items:type_name -> admin.AdminPlatform
+	81, // 34: admin.AdminPlatformResponse.platform:type_name -> admin.AdminPlatform
+	85, // 35: admin.AdminListWebhooksResponse.items:type_name -> admin.AdminWebhookEndpoint
+	85, // 36: admin.AdminCreateWebhookResponse.endpoint:type_name -> admin.AdminWebhookEndpoint
+	85, // 37: admin.AdminWebhookResponse.endpoint:type_name -> admin.AdminWebhookEndpoint
+	91, // 38: admin.AdminListWebhookDeliveriesResponse.items:type_name -> admin.AdminWebhookDelivery
+	2,  // 39: admin.AdminService.Login:input_type -> admin.AdminLoginRequest
+	4,  // 40: admin.AdminService.Logout:input_type -> admin.AdminLogoutRequest
+	5,  // 41: admin.AdminService.GetCurrentUser:input_type -> admin.AdminSessionRequest
+	0,  // 42: admin.AdminService.GetOverview:input_type -> admin.AdminEmpty
+	9,  // 43: admin.AdminService.GetRequestTrend:input_type -> admin.AdminRequestTrendRequest
+	0,  // 44: admin.AdminService.GetDashboardHealth:input_type -> admin.AdminEmpty
+	0,  // 45: admin.AdminService.GetUserStats:input_type -> admin.AdminEmpty
+	0,  // 46: admin.AdminService.GetProxySourceStatus:input_type -> admin.AdminEmpty
+	0,  // 47: admin.AdminService.GetProxySourcePolicy:input_type -> admin.AdminEmpty
+	25, // 48: admin.AdminService.UpdateProxySourcePolicy:input_type -> admin.AdminUpdateProxySourcePolicyRequest
+	27, // 49: admin.AdminService.ListProxies:input_type -> admin.AdminListProxiesRequest
+	29, // 50: admin.AdminService.ListProxyUsageEvents:input_type -> admin.AdminListProxyUsageEventsRequest
+	34, // 51: admin.AdminService.CreateProxy:input_type -> admin.AdminCreateProxyRequest
+	35, // 52: admin.AdminService.UpdateProxy:input_type -> admin.AdminUpdateProxyRequest
+	36, // 53: admin.AdminService.UpdateProxyStatus:input_type -> admin.AdminUpdateProxyStatusRequest
+	37, // 54: admin.AdminService.DeleteProxy:input_type -> admin.AdminDeleteRequest
+	39, // 55: admin.AdminService.ListCookies:input_type -> admin.AdminListCookiesRequest
+	41, // 56: admin.AdminService.GetCookie:input_type -> admin.AdminGetCookieRequest
+	43, // 57: admin.AdminService.CreateCookie:input_type -> admin.AdminCreateCookieRequest
+	44, // 58: admin.AdminService.UpdateCookie:input_type -> admin.AdminUpdateCookieRequest
+	37, // 59: admin.AdminService.DeleteCookie:input_type -> admin.AdminDeleteRequest
+	45, // 60: admin.AdminService.FreezeCookie:input_type -> admin.AdminFreezeCookieRequest
+	50, // 61: admin.AdminService.ListBillingAccounts:input_type -> admin.AdminListBillingAccountsRequest
+	52, // 62: admin.AdminService.GetBillingAccountDetail:input_type -> admin.AdminGetBillingAccountDetailRequest
+	54, // 63: admin.AdminService.AdjustBillingBalance:input_type -> admin.AdminAdjustBillingBalanceRequest
+	57, // 64: admin.AdminService.ListBillingShortfalls:input_type -> admin.AdminListBillingShortfallsRequest
+	59, // 65: admin.AdminService.ReconcileBillingShortfall:input_type -> admin.AdminReconcileBillingShortfallRequest
+	62, // 66: admin.AdminService.ListBillingLedger:input_type -> admin.AdminListBillingLedgerRequest
+	65, // 67: admin.AdminService.ListBillingUsageRecords:input_type -> admin.AdminListBillingUsageRecordsRequest
+	0,  // 68: admin.AdminService.GetBillingPricing:input_type -> admin.AdminEmpty
+	68, // 69: admin.AdminService.UpdateBillingPricing:input_type -> admin.AdminUpdateBillingPricingRequest
+	0,  // 70: admin.AdminService.GetWelcomeCreditSettings:input_type -> admin.AdminEmpty
+	70, // 71: admin.AdminService.UpdateWelcomeCreditSettings:input_type -> admin.AdminUpdateWelcomeCreditSettingsRequest
+	71, // 72: admin.AdminService.ListTasks:input_type -> admin.AdminListTasksRequest
+	74, // 73: admin.AdminService.ReplayTask:input_type -> admin.AdminTaskActionRequest
+	74, // 74: admin.AdminService.ForceFailTask:input_type -> admin.AdminTaskActionRequest
+	74, // 75: admin.AdminService.PurgeTask:input_type -> admin.AdminTaskActionRequest
+	0,  // 76: admin.AdminService.ListWorkerPools:input_type -> admin.AdminEmpty
+	80, // 77: admin.AdminService.UpdateWorkerPool:input_type -> admin.AdminUpdateWorkerPoolRequest
+	0,  // 78: admin.AdminService.ListPlatforms:input_type -> admin.AdminEmpty
+	83, // 79: admin.AdminService.UpdatePlatform:input_type -> admin.AdminUpdatePlatformRequest
+	0,  // 80: admin.AdminService.ListWebhooks:input_type -> admin.AdminEmpty
+	87, // 81: admin.AdminService.CreateWebhook:input_type -> admin.AdminCreateWebhookRequest
+	89, // 82: admin.AdminService.UpdateWebhook:input_type -> admin.AdminUpdateWebhookRequest
+	37, // 83: admin.AdminService.DeleteWebhook:input_type -> admin.AdminDeleteRequest
+	92, // 84: admin.AdminService.ListWebhookDeliveries:input_type -> admin.AdminListWebhookDeliveriesRequest
+	94, // 85: admin.AdminService.RedeliverWebhook:input_type -> admin.AdminRedeliverWebhookRequest
+	3,  // 86: admin.AdminService.Login:output_type -> admin.AdminLoginResponse
+	48, // 87: admin.AdminService.Logout:output_type -> admin.AdminOperationResponse
+	6,  // 88: admin.AdminService.GetCurrentUser:output_type -> admin.AdminCurrentUserResponse
+	7,  // 89: admin.AdminService.GetOverview:output_type -> admin.AdminOverviewResponse
+	10, // 90: admin.AdminService.GetRequestTrend:output_type -> admin.AdminRequestTrendResponse
+	21, // 91: admin.AdminService.GetDashboardHealth:output_type -> admin.AdminDashboardHealthResponse
+	22, // 92: admin.AdminService.GetUserStats:output_type -> admin.AdminUserStatsResponse
+	23, // 93: admin.AdminService.GetProxySourceStatus:output_type -> admin.AdminProxySourceStatusResponse
+	24, // 94: admin.AdminService.GetProxySourcePolicy:output_type -> admin.AdminProxySourcePolicyResponse
+	48, // 95: admin.AdminService.UpdateProxySourcePolicy:output_type -> admin.AdminOperationResponse
+	28, // 96: admin.AdminService.ListProxies:output_type -> admin.AdminListProxiesResponse
+	33, // 97: admin.AdminService.ListProxyUsageEvents:output_type -> admin.AdminListProxyUsageEventsResponse
+	47, // 98: admin.AdminService.CreateProxy:output_type -> admin.AdminCreateResourceResponse
+	48, // 99: admin.AdminService.UpdateProxy:output_type -> admin.AdminOperationResponse
+	48, // 100: admin.AdminService.UpdateProxyStatus:output_type -> admin.AdminOperationResponse
+	48, // 101: admin.AdminService.DeleteProxy:output_type -> admin.AdminOperationResponse
+	40, // 102: admin.AdminService.ListCookies:output_type -> admin.AdminListCookiesResponse
+	42, // 103: admin.AdminService.GetCookie:output_type -> admin.AdminGetCookieResponse
+	47, // 104: admin.AdminService.CreateCookie:output_type -> admin.AdminCreateResourceResponse
+	48, // 105: admin.AdminService.UpdateCookie:output_type -> admin.AdminOperationResponse
+	48, // 106: admin.AdminService.DeleteCookie:output_type -> admin.AdminOperationResponse
+	46, // 107: admin.AdminService.FreezeCookie:output_type -> admin.AdminFreezeCookieResponse
+	51, // 108: admin.AdminService.ListBillingAccounts:output_type -> admin.AdminListBillingAccountsResponse
+	53, // 109: admin.AdminService.GetBillingAccountDetail:output_type -> admin.AdminGetBillingAccountDetailResponse
+	55, // 110: admin.AdminService.AdjustBillingBalance:output_type -> admin.AdminAdjustBillingBalanceResponse
+	58, // 111: admin.AdminService.ListBillingShortfalls:output_type -> admin.AdminListBillingShortfallsResponse
+	60, // 112: admin.AdminService.ReconcileBillingShortfall:output_type -> admin.AdminReconcileBillingShortfallResponse
+	63, // 113: admin.AdminService.ListBillingLedger:output_type -> admin.AdminListBillingLedgerResponse
+	66, // 114: admin.AdminService.ListBillingUsageRecords:output_type -> admin.AdminListBillingUsageRecordsResponse
+	67, // 115: admin.AdminService.GetBillingPricing:output_type -> admin.AdminBillingPricingResponse
+	67, // 116: admin.AdminService.UpdateBillingPricing:output_type -> admin.AdminBillingPricingResponse
+	69, // 117: admin.AdminService.GetWelcomeCreditSettings:output_type -> admin.AdminWelcomeCreditSettingsResponse
+	69, // 118: admin.AdminService.UpdateWelcomeCreditSettings:output_type -> admin.AdminWelcomeCreditSettingsResponse
+	73, // 119: admin.AdminService.ListTasks:output_type -> admin.AdminListTasksResponse
+	75, // 120: admin.AdminService.ReplayTask:output_type -> admin.AdminTaskActionResponse
+	75, // 121: admin.AdminService.ForceFailTask:output_type -> admin.AdminTaskActionResponse
+	75, // 122: admin.AdminService.PurgeTask:output_type -> admin.AdminTaskActionResponse
+	79, // 123: admin.AdminService.ListWorkerPools:output_type -> admin.AdminListWorkerPoolsResponse
+	75, // 124: admin.AdminService.UpdateWorkerPool:output_type -> admin.AdminTaskActionResponse
+	82, // 125: admin.AdminService.ListPlatforms:output_type -> admin.AdminListPlatformsResponse
+	84, // 126: admin.AdminService.UpdatePlatform:output_type -> admin.AdminPlatformResponse
+	86, // 127: admin.AdminService.ListWebhooks:output_type -> admin.AdminListWebhooksResponse
+	88, // 128: admin.AdminService.CreateWebhook:output_type -> admin.AdminCreateWebhookResponse
+	90, // 129: admin.AdminService.UpdateWebhook:output_type -> admin.AdminWebhookResponse
+	48, // 130: admin.AdminService.DeleteWebhook:output_type -> admin.AdminOperationResponse
+	93, // 131: admin.AdminService.ListWebhookDeliveries:output_type -> admin.AdminListWebhookDeliveriesResponse
+	48, // 132: admin.AdminService.RedeliverWebhook:output_type -> admin.AdminOperationResponse
+	86, // [86:133] is the sub-list for method output_type
+	39, // [39:86] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_proto_admin_proto_init() }
@@ -6481,7 +8738,7 @@ func file_proto_admin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_admin_proto_rawDesc), len(file_proto_admin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   70,
+			NumMessages:   95,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
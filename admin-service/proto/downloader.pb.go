@@ -0,0 +1,2246 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: proto/downloader.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 获取任务状态请求
+type GetTaskStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 可选，指定时校验任务归属
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskStatusRequest) Reset() {
+	*x = GetTaskStatusRequest{}
+	mi := &file_proto_downloader_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskStatusRequest) ProtoMessage() {}
+
+func (x *GetTaskStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetTaskStatusRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *GetTaskStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetTaskStatusResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	TaskId                string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Status                int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"` // 0:待处理, 1:下载中, 2:完成, 3:失败, 4:待清理, 5:已过期
+	StatusText            string                 `protobuf:"bytes,3,opt,name=status_text,json=statusText,proto3" json:"status_text,omitempty"`
+	Percent               float64                `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent,omitempty"`
+	DownloadedBytes       int64                  `protobuf:"varint,5,opt,name=downloaded_bytes,json=downloadedBytes,proto3" json:"downloaded_bytes,omitempty"`
+	TotalBytes            int64                  `protobuf:"varint,6,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	Speed                 string                 `protobuf:"bytes,7,opt,name=speed,proto3" json:"speed,omitempty"`
+	Eta                   string                 `protobuf:"bytes,8,opt,name=eta,proto3" json:"eta,omitempty"`
+	FilePath              string                 `protobuf:"bytes,9,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	ErrorMessage          string                 `protobuf:"bytes,10,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedAt             string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt           string                 `protobuf:"bytes,12,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	QueuePosition         int64                  `protobuf:"varint,13,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`                           // 待处理任务的排队位置，从 1 开始；非待处理时为 0
+	EstimatedStartSeconds int64                  `protobuf:"varint,14,opt,name=estimated_start_seconds,json=estimatedStartSeconds,proto3" json:"estimated_start_seconds,omitempty"` // 按近期出队速率估算的开始等待秒数，无法估算时为 0
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetTaskStatusResponse) Reset() {
+	*x = GetTaskStatusResponse{}
+	mi := &file_proto_downloader_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskStatusResponse) ProtoMessage() {}
+
+func (x *GetTaskStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetTaskStatusResponse) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *GetTaskStatusResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetTaskStatusResponse) GetStatusText() string {
+	if x != nil {
+		return x.StatusText
+	}
+	return ""
+}
+
+func (x *GetTaskStatusResponse) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *GetTaskStatusResponse) GetDownloadedBytes() int64 {
+	if x != nil {
+		return x.DownloadedBytes
+	}
+	return 0
+}
+
+func (x *GetTaskStatusResponse) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *GetTaskStatusResponse) GetSpeed() string {
+	if x != nil {
+		return x.Speed
+	}
+	return ""
+}
+
+func (x *GetTaskStatusResponse) GetEta() string {
+	if x != nil {
+		return x.Eta
+	}
+	return ""
+}
+
+func (x *GetTaskStatusResponse) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *GetTaskStatusResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *GetTaskStatusResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GetTaskStatusResponse) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+func (x *GetTaskStatusResponse) GetQueuePosition() int64 {
+	if x != nil {
+		return x.QueuePosition
+	}
+	return 0
+}
+
+func (x *GetTaskStatusResponse) GetEstimatedStartSeconds() int64 {
+	if x != nil {
+		return x.EstimatedStartSeconds
+	}
+	return 0
+}
+
+// 获取下载历史请求
+type GetDownloadHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"` // 可选,筛选状态
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDownloadHistoryRequest) Reset() {
+	*x = GetDownloadHistoryRequest{}
+	mi := &file_proto_downloader_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadHistoryRequest) ProtoMessage() {}
+
+func (x *GetDownloadHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetDownloadHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDownloadHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDownloadHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetDownloadHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetDownloadHistoryRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type GetDownloadHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*DownloadRecord      `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDownloadHistoryResponse) Reset() {
+	*x = GetDownloadHistoryResponse{}
+	mi := &file_proto_downloader_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadHistoryResponse) ProtoMessage() {}
+
+func (x *GetDownloadHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDownloadHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDownloadHistoryResponse) GetRecords() []*DownloadRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *GetDownloadHistoryResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetDownloadHistoryResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetDownloadHistoryResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type DownloadRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Platform      string                 `protobuf:"bytes,5,opt,name=platform,proto3" json:"platform,omitempty"`
+	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Mode          string                 `protobuf:"bytes,7,opt,name=mode,proto3" json:"mode,omitempty"` // quick_download, archive
+	Quality       string                 `protobuf:"bytes,8,opt,name=quality,proto3" json:"quality,omitempty"`
+	FilePath      string                 `protobuf:"bytes,9,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	FileName      string                 `protobuf:"bytes,10,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileSize      int64                  `protobuf:"varint,11,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	FileHash      string                 `protobuf:"bytes,12,opt,name=file_hash,json=fileHash,proto3" json:"file_hash,omitempty"`
+	Status        int32                  `protobuf:"varint,13,opt,name=status,proto3" json:"status,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,14,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt   string                 `protobuf:"bytes,16,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ExpireAt      string                 `protobuf:"bytes,17,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadRecord) Reset() {
+	*x = DownloadRecord{}
+	mi := &file_proto_downloader_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadRecord) ProtoMessage() {}
+
+func (x *DownloadRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadRecord.ProtoReflect.Descriptor instead.
+func (*DownloadRecord) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DownloadRecord) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DownloadRecord) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *DownloadRecord) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DownloadRecord) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DownloadRecord) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *DownloadRecord) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DownloadRecord) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *DownloadRecord) GetQuality() string {
+	if x != nil {
+		return x.Quality
+	}
+	return ""
+}
+
+func (x *DownloadRecord) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *DownloadRecord) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *DownloadRecord) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *DownloadRecord) GetFileHash() string {
+	if x != nil {
+		return x.FileHash
+	}
+	return ""
+}
+
+func (x *DownloadRecord) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *DownloadRecord) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *DownloadRecord) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DownloadRecord) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+func (x *DownloadRecord) GetExpireAt() string {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return ""
+}
+
+// 取消任务请求
+type CancelTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用于验证权限
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTaskRequest) Reset() {
+	*x = CancelTaskRequest{}
+	mi := &file_proto_downloader_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTaskRequest) ProtoMessage() {}
+
+func (x *CancelTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTaskRequest.ProtoReflect.Descriptor instead.
+func (*CancelTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CancelTaskRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CancelTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTaskResponse) Reset() {
+	*x = CancelTaskResponse{}
+	mi := &file_proto_downloader_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTaskResponse) ProtoMessage() {}
+
+func (x *CancelTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTaskResponse.ProtoReflect.Descriptor instead.
+func (*CancelTaskResponse) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CancelTaskResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CancelTaskResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 管理端任务查询请求
+type ListTasksRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	State             string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"` // failed, dead_lettered, stuck, 为空表示全部
+	Platform          string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	ErrorCategory     string                 `protobuf:"bytes,3,opt,name=error_category,json=errorCategory,proto3" json:"error_category,omitempty"`
+	UserId            string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartTimeUnix     int64                  `protobuf:"varint,5,opt,name=start_time_unix,json=startTimeUnix,proto3" json:"start_time_unix,omitempty"`             // 创建时间下限, 0 表示不限
+	EndTimeUnix       int64                  `protobuf:"varint,6,opt,name=end_time_unix,json=endTimeUnix,proto3" json:"end_time_unix,omitempty"`                   // 创建时间上限, 0 表示不限
+	StuckAfterSeconds int64                  `protobuf:"varint,7,opt,name=stuck_after_seconds,json=stuckAfterSeconds,proto3" json:"stuck_after_seconds,omitempty"` // 下载中超过该时长视为卡住, 0 使用默认值
+	Page              int32                  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize          int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_proto_downloader_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListTasksRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetErrorCategory() string {
+	if x != nil {
+		return x.ErrorCategory
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetStartTimeUnix() int64 {
+	if x != nil {
+		return x.StartTimeUnix
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetEndTimeUnix() int64 {
+	if x != nil {
+		return x.EndTimeUnix
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetStuckAfterSeconds() int64 {
+	if x != nil {
+		return x.StuckAfterSeconds
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*AdminTaskRecord     `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_proto_downloader_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListTasksResponse) GetTasks() []*AdminTaskRecord {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTasksResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListTasksResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTasksResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AdminTaskRecord struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TaskId         string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Url            string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Platform       string                 `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
+	Title          string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Mode           string                 `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	Quality        string                 `protobuf:"bytes,7,opt,name=quality,proto3" json:"quality,omitempty"`
+	Status         int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	StatusText     string                 `protobuf:"bytes,9,opt,name=status_text,json=statusText,proto3" json:"status_text,omitempty"`
+	ErrorMessage   string                 `protobuf:"bytes,10,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ErrorCategory  string                 `protobuf:"bytes,11,opt,name=error_category,json=errorCategory,proto3" json:"error_category,omitempty"`
+	RetryCount     int32                  `protobuf:"varint,12,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt      string                 `protobuf:"bytes,14,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	DeadLetteredAt string                 `protobuf:"bytes,15,opt,name=dead_lettered_at,json=deadLetteredAt,proto3" json:"dead_lettered_at,omitempty"`
+	DeadLettered   bool                   `protobuf:"varint,16,opt,name=dead_lettered,json=deadLettered,proto3" json:"dead_lettered,omitempty"`
+	Stuck          bool                   `protobuf:"varint,17,opt,name=stuck,proto3" json:"stuck,omitempty"`
+	Replayable     bool                   `protobuf:"varint,18,opt,name=replayable,proto3" json:"replayable,omitempty"` // 是否保存了任务消息, 可重放
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminTaskRecord) Reset() {
+	*x = AdminTaskRecord{}
+	mi := &file_proto_downloader_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminTaskRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminTaskRecord) ProtoMessage() {}
+
+func (x *AdminTaskRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminTaskRecord.ProtoReflect.Descriptor instead.
+func (*AdminTaskRecord) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AdminTaskRecord) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *AdminTaskRecord) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AdminTaskRecord) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AdminTaskRecord) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *AdminTaskRecord) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AdminTaskRecord) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *AdminTaskRecord) GetQuality() string {
+	if x != nil {
+		return x.Quality
+	}
+	return ""
+}
+
+func (x *AdminTaskRecord) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AdminTaskRecord) GetStatusText() string {
+	if x != nil {
+		return x.StatusText
+	}
+	return ""
+}
+
+func (x *AdminTaskRecord) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *AdminTaskRecord) GetErrorCategory() string {
+	if x != nil {
+		return x.ErrorCategory
+	}
+	return ""
+}
+
+func (x *AdminTaskRecord) GetRetryCount() int32 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *AdminTaskRecord) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AdminTaskRecord) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *AdminTaskRecord) GetDeadLetteredAt() string {
+	if x != nil {
+		return x.DeadLetteredAt
+	}
+	return ""
+}
+
+func (x *AdminTaskRecord) GetDeadLettered() bool {
+	if x != nil {
+		return x.DeadLettered
+	}
+	return false
+}
+
+func (x *AdminTaskRecord) GetStuck() bool {
+	if x != nil {
+		return x.Stuck
+	}
+	return false
+}
+
+func (x *AdminTaskRecord) GetReplayable() bool {
+	if x != nil {
+		return x.Replayable
+	}
+	return false
+}
+
+// 重放任务请求
+type ReplayTaskRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TaskId            string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	OperatorUserId    string                 `protobuf:"bytes,2,opt,name=operator_user_id,json=operatorUserId,proto3" json:"operator_user_id,omitempty"`
+	StuckAfterSeconds int64                  `protobuf:"varint,3,opt,name=stuck_after_seconds,json=stuckAfterSeconds,proto3" json:"stuck_after_seconds,omitempty"` // 下载中任务需超过该时长才允许重放, 0 使用默认值
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReplayTaskRequest) Reset() {
+	*x = ReplayTaskRequest{}
+	mi := &file_proto_downloader_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayTaskRequest) ProtoMessage() {}
+
+func (x *ReplayTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayTaskRequest.ProtoReflect.Descriptor instead.
+func (*ReplayTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReplayTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ReplayTaskRequest) GetOperatorUserId() string {
+	if x != nil {
+		return x.OperatorUserId
+	}
+	return ""
+}
+
+func (x *ReplayTaskRequest) GetStuckAfterSeconds() int64 {
+	if x != nil {
+		return x.StuckAfterSeconds
+	}
+	return 0
+}
+
+// 强制失败请求
+type ForceFailTaskRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TaskId         string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	OperatorUserId string                 `protobuf:"bytes,3,opt,name=operator_user_id,json=operatorUserId,proto3" json:"operator_user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ForceFailTaskRequest) Reset() {
+	*x = ForceFailTaskRequest{}
+	mi := &file_proto_downloader_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceFailTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceFailTaskRequest) ProtoMessage() {}
+
+func (x *ForceFailTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceFailTaskRequest.ProtoReflect.Descriptor instead.
+func (*ForceFailTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ForceFailTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ForceFailTaskRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ForceFailTaskRequest) GetOperatorUserId() string {
+	if x != nil {
+		return x.OperatorUserId
+	}
+	return ""
+}
+
+// 删除任务请求
+type PurgeTaskRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TaskId         string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	OperatorUserId string                 `protobuf:"bytes,2,opt,name=operator_user_id,json=operatorUserId,proto3" json:"operator_user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PurgeTaskRequest) Reset() {
+	*x = PurgeTaskRequest{}
+	mi := &file_proto_downloader_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTaskRequest) ProtoMessage() {}
+
+func (x *PurgeTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTaskRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PurgeTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *PurgeTaskRequest) GetOperatorUserId() string {
+	if x != nil {
+		return x.OperatorUserId
+	}
+	return ""
+}
+
+type TaskActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskActionResponse) Reset() {
+	*x = TaskActionResponse{}
+	mi := &file_proto_downloader_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskActionResponse) ProtoMessage() {}
+
+func (x *TaskActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskActionResponse.ProtoReflect.Descriptor instead.
+func (*TaskActionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TaskActionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TaskActionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 查询 Worker 池状态请求
+type GetWorkerPoolsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkerPoolsRequest) Reset() {
+	*x = GetWorkerPoolsRequest{}
+	mi := &file_proto_downloader_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkerPoolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkerPoolsRequest) ProtoMessage() {}
+
+func (x *GetWorkerPoolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkerPoolsRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkerPoolsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{14}
+}
+
+// 运行中的任务
+type WorkerActiveTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	Phase         string                 `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	Percent       float64                `protobuf:"fixed64,5,opt,name=percent,proto3" json:"percent,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerActiveTask) Reset() {
+	*x = WorkerActiveTask{}
+	mi := &file_proto_downloader_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerActiveTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerActiveTask) ProtoMessage() {}
+
+func (x *WorkerActiveTask) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerActiveTask.ProtoReflect.Descriptor instead.
+func (*WorkerActiveTask) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WorkerActiveTask) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *WorkerActiveTask) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WorkerActiveTask) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *WorkerActiveTask) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *WorkerActiveTask) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *WorkerActiveTask) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+// 下载队列积压
+type WorkerQueueDepth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`  // 为空表示默认队列
+	Messages      int64                  `protobuf:"varint,3,opt,name=messages,proto3" json:"messages,omitempty"` // 待投递的消息数
+	Consumers     int32                  `protobuf:"varint,4,opt,name=consumers,proto3" json:"consumers,omitempty"`
+	Paused        bool                   `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"` // 本实例是否暂停消费该队列
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerQueueDepth) Reset() {
+	*x = WorkerQueueDepth{}
+	mi := &file_proto_downloader_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerQueueDepth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerQueueDepth) ProtoMessage() {}
+
+func (x *WorkerQueueDepth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerQueueDepth.ProtoReflect.Descriptor instead.
+func (*WorkerQueueDepth) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WorkerQueueDepth) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *WorkerQueueDepth) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *WorkerQueueDepth) GetMessages() int64 {
+	if x != nil {
+		return x.Messages
+	}
+	return 0
+}
+
+func (x *WorkerQueueDepth) GetConsumers() int32 {
+	if x != nil {
+		return x.Consumers
+	}
+	return 0
+}
+
+func (x *WorkerQueueDepth) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+// 单个实例的 Worker 池状态
+type WorkerPoolStatus struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId     string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	PoolSize       int32                  `protobuf:"varint,2,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`                // Worker 协程数
+	MaxConcurrent  int32                  `protobuf:"varint,3,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"` // 并发上限
+	Running        int32                  `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`                                  // 已占用的并发名额
+	Buffered       int32                  `protobuf:"varint,5,opt,name=buffered,proto3" json:"buffered,omitempty"`                                // 本地缓冲区中等待的任务数
+	BufferCapacity int32                  `protobuf:"varint,6,opt,name=buffer_capacity,json=bufferCapacity,proto3" json:"buffer_capacity,omitempty"`
+	Paused         bool                   `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"` // 管理端暂停了消费
+	Queues         []*WorkerQueueDepth    `protobuf:"bytes,8,rep,name=queues,proto3" json:"queues,omitempty"`
+	ActiveTasks    []*WorkerActiveTask    `protobuf:"bytes,9,rep,name=active_tasks,json=activeTasks,proto3" json:"active_tasks,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkerPoolStatus) Reset() {
+	*x = WorkerPoolStatus{}
+	mi := &file_proto_downloader_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerPoolStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerPoolStatus) ProtoMessage() {}
+
+func (x *WorkerPoolStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerPoolStatus.ProtoReflect.Descriptor instead.
+func (*WorkerPoolStatus) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WorkerPoolStatus) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *WorkerPoolStatus) GetPoolSize() int32 {
+	if x != nil {
+		return x.PoolSize
+	}
+	return 0
+}
+
+func (x *WorkerPoolStatus) GetMaxConcurrent() int32 {
+	if x != nil {
+		return x.MaxConcurrent
+	}
+	return 0
+}
+
+func (x *WorkerPoolStatus) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *WorkerPoolStatus) GetBuffered() int32 {
+	if x != nil {
+		return x.Buffered
+	}
+	return 0
+}
+
+func (x *WorkerPoolStatus) GetBufferCapacity() int32 {
+	if x != nil {
+		return x.BufferCapacity
+	}
+	return 0
+}
+
+func (x *WorkerPoolStatus) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *WorkerPoolStatus) GetQueues() []*WorkerQueueDepth {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+func (x *WorkerPoolStatus) GetActiveTasks() []*WorkerActiveTask {
+	if x != nil {
+		return x.ActiveTasks
+	}
+	return nil
+}
+
+func (x *WorkerPoolStatus) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetWorkerPoolsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instances     []*WorkerPoolStatus    `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkerPoolsResponse) Reset() {
+	*x = GetWorkerPoolsResponse{}
+	mi := &file_proto_downloader_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkerPoolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkerPoolsResponse) ProtoMessage() {}
+
+func (x *GetWorkerPoolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkerPoolsResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkerPoolsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetWorkerPoolsResponse) GetInstances() []*WorkerPoolStatus {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+// 调整 Worker 池请求
+type UpdateWorkerPoolRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId     string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`           // 为空时作用于所有实例
+	Action         string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                                     // set_concurrency, pause, resume
+	MaxConcurrent  int32                  `protobuf:"varint,3,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"` // action 为 set_concurrency 时必填
+	OperatorUserId string                 `protobuf:"bytes,4,opt,name=operator_user_id,json=operatorUserId,proto3" json:"operator_user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateWorkerPoolRequest) Reset() {
+	*x = UpdateWorkerPoolRequest{}
+	mi := &file_proto_downloader_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkerPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkerPoolRequest) ProtoMessage() {}
+
+func (x *UpdateWorkerPoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkerPoolRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkerPoolRequest) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateWorkerPoolRequest) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *UpdateWorkerPoolRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *UpdateWorkerPoolRequest) GetMaxConcurrent() int32 {
+	if x != nil {
+		return x.MaxConcurrent
+	}
+	return 0
+}
+
+func (x *UpdateWorkerPoolRequest) GetOperatorUserId() string {
+	if x != nil {
+		return x.OperatorUserId
+	}
+	return ""
+}
+
+// 平台注册表条目
+type PlatformInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName       string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Enabled           bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Patterns          []string               `protobuf:"bytes,4,rep,name=patterns,proto3" json:"patterns,omitempty"` // URL 匹配规则，按列表顺序匹配
+	Adapter           string                 `protobuf:"bytes,5,opt,name=adapter,proto3" json:"adapter,omitempty"`
+	ExtraArgs         []string               `protobuf:"bytes,6,rep,name=extra_args,json=extraArgs,proto3" json:"extra_args,omitempty"`                   // 额外的 yt-dlp 参数
+	Cookies           string                 `protobuf:"bytes,7,opt,name=cookies,proto3" json:"cookies,omitempty"`                                        // optional, required, disabled
+	Proxy             string                 `protobuf:"bytes,8,opt,name=proxy,proto3" json:"proxy,omitempty"`                                            // optional, required, direct
+	ParsePerMinute    int64                  `protobuf:"varint,9,opt,name=parse_per_minute,json=parsePerMinute,proto3" json:"parse_per_minute,omitempty"` // 0 表示使用全局默认值
+	DownloadPerMinute int64                  `protobuf:"varint,10,opt,name=download_per_minute,json=downloadPerMinute,proto3" json:"download_per_minute,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PlatformInfo) Reset() {
+	*x = PlatformInfo{}
+	mi := &file_proto_downloader_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlatformInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformInfo) ProtoMessage() {}
+
+func (x *PlatformInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformInfo.ProtoReflect.Descriptor instead.
+func (*PlatformInfo) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PlatformInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlatformInfo) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *PlatformInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *PlatformInfo) GetPatterns() []string {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+func (x *PlatformInfo) GetAdapter() string {
+	if x != nil {
+		return x.Adapter
+	}
+	return ""
+}
+
+func (x *PlatformInfo) GetExtraArgs() []string {
+	if x != nil {
+		return x.ExtraArgs
+	}
+	return nil
+}
+
+func (x *PlatformInfo) GetCookies() string {
+	if x != nil {
+		return x.Cookies
+	}
+	return ""
+}
+
+func (x *PlatformInfo) GetProxy() string {
+	if x != nil {
+		return x.Proxy
+	}
+	return ""
+}
+
+func (x *PlatformInfo) GetParsePerMinute() int64 {
+	if x != nil {
+		return x.ParsePerMinute
+	}
+	return 0
+}
+
+func (x *PlatformInfo) GetDownloadPerMinute() int64 {
+	if x != nil {
+		return x.DownloadPerMinute
+	}
+	return 0
+}
+
+type ListPlatformsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlatformsRequest) Reset() {
+	*x = ListPlatformsRequest{}
+	mi := &file_proto_downloader_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlatformsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlatformsRequest) ProtoMessage() {}
+
+func (x *ListPlatformsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlatformsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlatformsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{21}
+}
+
+type ListPlatformsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Platforms     []*PlatformInfo        `protobuf:"bytes,1,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlatformsResponse) Reset() {
+	*x = ListPlatformsResponse{}
+	mi := &file_proto_downloader_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlatformsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlatformsResponse) ProtoMessage() {}
+
+func (x *ListPlatformsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlatformsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlatformsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListPlatformsResponse) GetPlatforms() []*PlatformInfo {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+// 切换平台启用状态请求
+type UpdatePlatformRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled        bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	OperatorUserId string                 `protobuf:"bytes,3,opt,name=operator_user_id,json=operatorUserId,proto3" json:"operator_user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdatePlatformRequest) Reset() {
+	*x = UpdatePlatformRequest{}
+	mi := &file_proto_downloader_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlatformRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlatformRequest) ProtoMessage() {}
+
+func (x *UpdatePlatformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlatformRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlatformRequest) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdatePlatformRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePlatformRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UpdatePlatformRequest) GetOperatorUserId() string {
+	if x != nil {
+		return x.OperatorUserId
+	}
+	return ""
+}
+
+type UpdatePlatformResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Platform      *PlatformInfo          `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlatformResponse) Reset() {
+	*x = UpdatePlatformResponse{}
+	mi := &file_proto_downloader_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlatformResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlatformResponse) ProtoMessage() {}
+
+func (x *UpdatePlatformResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_downloader_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlatformResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePlatformResponse) Descriptor() ([]byte, []int) {
+	return file_proto_downloader_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdatePlatformResponse) GetPlatform() *PlatformInfo {
+	if x != nil {
+		return x.Platform
+	}
+	return nil
+}
+
+var File_proto_downloader_proto protoreflect.FileDescriptor
+
+const file_proto_downloader_proto_rawDesc = "" +
+	"\n" +
+	"\x16proto/downloader.proto\x12\n" +
+	"downloader\"H\n" +
+	"\x14GetTaskStatusRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xda\x03\n" +
+	"\x15GetTaskStatusResponse\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x1f\n" +
+	"\vstatus_text\x18\x03 \x01(\tR\n" +
+	"statusText\x12\x18\n" +
+	"\apercent\x18\x04 \x01(\x01R\apercent\x12)\n" +
+	"\x10downloaded_bytes\x18\x05 \x01(\x03R\x0fdownloadedBytes\x12\x1f\n" +
+	"\vtotal_bytes\x18\x06 \x01(\x03R\n" +
+	"totalBytes\x12\x14\n" +
+	"\x05speed\x18\a \x01(\tR\x05speed\x12\x10\n" +
+	"\x03eta\x18\b \x01(\tR\x03eta\x12\x1b\n" +
+	"\tfile_path\x18\t \x01(\tR\bfilePath\x12#\n" +
+	"\rerror_message\x18\n" +
+	" \x01(\tR\ferrorMessage\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12!\n" +
+	"\fcompleted_at\x18\f \x01(\tR\vcompletedAt\x12%\n" +
+	"\x0equeue_position\x18\r \x01(\x03R\rqueuePosition\x126\n" +
+	"\x17estimated_start_seconds\x18\x0e \x01(\x03R\x15estimatedStartSeconds\"}\n" +
+	"\x19GetDownloadHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\"\x99\x01\n" +
+	"\x1aGetDownloadHistoryResponse\x124\n" +
+	"\arecords\x18\x01 \x03(\v2\x1a.downloader.DownloadRecordR\arecords\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xd4\x03\n" +
+	"\x0eDownloadRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x1a\n" +
+	"\bplatform\x18\x05 \x01(\tR\bplatform\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12\x12\n" +
+	"\x04mode\x18\a \x01(\tR\x04mode\x12\x18\n" +
+	"\aquality\x18\b \x01(\tR\aquality\x12\x1b\n" +
+	"\tfile_path\x18\t \x01(\tR\bfilePath\x12\x1b\n" +
+	"\tfile_name\x18\n" +
+	" \x01(\tR\bfileName\x12\x1b\n" +
+	"\tfile_size\x18\v \x01(\x03R\bfileSize\x12\x1b\n" +
+	"\tfile_hash\x18\f \x01(\tR\bfileHash\x12\x16\n" +
+	"\x06status\x18\r \x01(\x05R\x06status\x12#\n" +
+	"\rerror_message\x18\x0e \x01(\tR\ferrorMessage\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\tR\tcreatedAt\x12!\n" +
+	"\fcompleted_at\x18\x10 \x01(\tR\vcompletedAt\x12\x1b\n" +
+	"\texpire_at\x18\x11 \x01(\tR\bexpireAt\"E\n" +
+	"\x11CancelTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"H\n" +
+	"\x12CancelTaskResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb1\x02\n" +
+	"\x10ListTasksRequest\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12%\n" +
+	"\x0eerror_category\x18\x03 \x01(\tR\rerrorCategory\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12&\n" +
+	"\x0fstart_time_unix\x18\x05 \x01(\x03R\rstartTimeUnix\x12\"\n" +
+	"\rend_time_unix\x18\x06 \x01(\x03R\vendTimeUnix\x12.\n" +
+	"\x13stuck_after_seconds\x18\a \x01(\x03R\x11stuckAfterSeconds\x12\x12\n" +
+	"\x04page\x18\b \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\t \x01(\x05R\bpageSize\"\x8d\x01\n" +
+	"\x11ListTasksResponse\x121\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x1b.downloader.AdminTaskRecordR\x05tasks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x9e\x04\n" +
+	"\x0fAdminTaskRecord\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1a\n" +
+	"\bplatform\x18\x04 \x01(\tR\bplatform\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x12\n" +
+	"\x04mode\x18\x06 \x01(\tR\x04mode\x12\x18\n" +
+	"\aquality\x18\a \x01(\tR\aquality\x12\x16\n" +
+	"\x06status\x18\b \x01(\x05R\x06status\x12\x1f\n" +
+	"\vstatus_text\x18\t \x01(\tR\n" +
+	"statusText\x12#\n" +
+	"\rerror_message\x18\n" +
+	" \x01(\tR\ferrorMessage\x12%\n" +
+	"\x0eerror_category\x18\v \x01(\tR\rerrorCategory\x12\x1f\n" +
+	"\vretry_count\x18\f \x01(\x05R\n" +
+	"retryCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x0e \x01(\tR\tstartedAt\x12(\n" +
+	"\x10dead_lettered_at\x18\x0f \x01(\tR\x0edeadLetteredAt\x12#\n" +
+	"\rdead_lettered\x18\x10 \x01(\bR\fdeadLettered\x12\x14\n" +
+	"\x05stuck\x18\x11 \x01(\bR\x05stuck\x12\x1e\n" +
+	"\n" +
+	"replayable\x18\x12 \x01(\bR\n" +
+	"replayable\"\x86\x01\n" +
+	"\x11ReplayTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12(\n" +
+	"\x10operator_user_id\x18\x02 \x01(\tR\x0eoperatorUserId\x12.\n" +
+	"\x13stuck_after_seconds\x18\x03 \x01(\x03R\x11stuckAfterSeconds\"q\n" +
+	"\x14ForceFailTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12(\n" +
+	"\x10operator_user_id\x18\x03 \x01(\tR\x0eoperatorUserId\"U\n" +
+	"\x10PurgeTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12(\n" +
+	"\x10operator_user_id\x18\x02 \x01(\tR\x0eoperatorUserId\"H\n" +
+	"\x12TaskActionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x17\n" +
+	"\x15GetWorkerPoolsRequest\"\xaf\x01\n" +
+	"\x10WorkerActiveTask\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bplatform\x18\x03 \x01(\tR\bplatform\x12\x14\n" +
+	"\x05phase\x18\x04 \x01(\tR\x05phase\x12\x18\n" +
+	"\apercent\x18\x05 \x01(\x01R\apercent\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\tR\tstartedAt\"\x96\x01\n" +
+	"\x10WorkerQueueDepth\x12\x14\n" +
+	"\x05queue\x18\x01 \x01(\tR\x05queue\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x1a\n" +
+	"\bmessages\x18\x03 \x01(\x03R\bmessages\x12\x1c\n" +
+	"\tconsumers\x18\x04 \x01(\x05R\tconsumers\x12\x16\n" +
+	"\x06paused\x18\x05 \x01(\bR\x06paused\"\x84\x03\n" +
+	"\x10WorkerPoolStatus\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x1b\n" +
+	"\tpool_size\x18\x02 \x01(\x05R\bpoolSize\x12%\n" +
+	"\x0emax_concurrent\x18\x03 \x01(\x05R\rmaxConcurrent\x12\x18\n" +
+	"\arunning\x18\x04 \x01(\x05R\arunning\x12\x1a\n" +
+	"\bbuffered\x18\x05 \x01(\x05R\bbuffered\x12'\n" +
+	"\x0fbuffer_capacity\x18\x06 \x01(\x05R\x0ebufferCapacity\x12\x16\n" +
+	"\x06paused\x18\a \x01(\bR\x06paused\x124\n" +
+	"\x06queues\x18\b \x03(\v2\x1c.downloader.WorkerQueueDepthR\x06queues\x12?\n" +
+	"\factive_tasks\x18\t \x03(\v2\x1c.downloader.WorkerActiveTaskR\vactiveTasks\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\"T\n" +
+	"\x16GetWorkerPoolsResponse\x12:\n" +
+	"\tinstances\x18\x01 \x03(\v2\x1c.downloader.WorkerPoolStatusR\tinstances\"\xa3\x01\n" +
+	"\x17UpdateWorkerPoolRequest\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12%\n" +
+	"\x0emax_concurrent\x18\x03 \x01(\x05R\rmaxConcurrent\x12(\n" +
+	"\x10operator_user_id\x18\x04 \x01(\tR\x0eoperatorUserId\"\xbe\x02\n" +
+	"\fPlatformInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12\x1a\n" +
+	"\bpatterns\x18\x04 \x03(\tR\bpatterns\x12\x18\n" +
+	"\aadapter\x18\x05 \x01(\tR\aadapter\x12\x1d\n" +
+	"\n" +
+	"extra_args\x18\x06 \x03(\tR\textraArgs\x12\x18\n" +
+	"\acookies\x18\a \x01(\tR\acookies\x12\x14\n" +
+	"\x05proxy\x18\b \x01(\tR\x05proxy\x12(\n" +
+	"\x10parse_per_minute\x18\t \x01(\x03R\x0eparsePerMinute\x12.\n" +
+	"\x13download_per_minute\x18\n" +
+	" \x01(\x03R\x11downloadPerMinute\"\x16\n" +
+	"\x14ListPlatformsRequest\"O\n" +
+	"\x15ListPlatformsResponse\x126\n" +
+	"\tplatforms\x18\x01 \x03(\v2\x18.downloader.PlatformInfoR\tplatforms\"o\n" +
+	"\x15UpdatePlatformRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12(\n" +
+	"\x10operator_user_id\x18\x03 \x01(\tR\x0eoperatorUserId\"N\n" +
+	"\x16UpdatePlatformResponse\x124\n" +
+	"\bplatform\x18\x01 \x01(\v2\x18.downloader.PlatformInfoR\bplatform2\xb1\a\n" +
+	"\x11DownloaderService\x12T\n" +
+	"\rGetTaskStatus\x12 .downloader.GetTaskStatusRequest\x1a!.downloader.GetTaskStatusResponse\x12c\n" +
+	"\x12GetDownloadHistory\x12%.downloader.GetDownloadHistoryRequest\x1a&.downloader.GetDownloadHistoryResponse\x12K\n" +
+	"\n" +
+	"CancelTask\x12\x1d.downloader.CancelTaskRequest\x1a\x1e.downloader.CancelTaskResponse\x12H\n" +
+	"\tListTasks\x12\x1c.downloader.ListTasksRequest\x1a\x1d.downloader.ListTasksResponse\x12K\n" +
+	"\n" +
+	"ReplayTask\x12\x1d.downloader.ReplayTaskRequest\x1a\x1e.downloader.TaskActionResponse\x12Q\n" +
+	"\rForceFailTask\x12 .downloader.ForceFailTaskRequest\x1a\x1e.downloader.TaskActionResponse\x12I\n" +
+	"\tPurgeTask\x12\x1c.downloader.PurgeTaskRequest\x1a\x1e.downloader.TaskActionResponse\x12W\n" +
+	"\x0eGetWorkerPools\x12!.downloader.GetWorkerPoolsRequest\x1a\".downloader.GetWorkerPoolsResponse\x12W\n" +
+	"\x10UpdateWorkerPool\x12#.downloader.UpdateWorkerPoolRequest\x1a\x1e.downloader.TaskActionResponse\x12T\n" +
+	"\rListPlatforms\x12 .downloader.ListPlatformsRequest\x1a!.downloader.ListPlatformsResponse\x12W\n" +
+	"\x0eUpdatePlatform\x12!.downloader.UpdatePlatformRequest\x1a\".downloader.UpdatePlatformResponseB\x1fZ\x1dyoudlp/admin-service/proto;pbb\x06proto3"
+
+var (
+	file_proto_downloader_proto_rawDescOnce sync.Once
+	file_proto_downloader_proto_rawDescData []byte
+)
+
+func file_proto_downloader_proto_rawDescGZIP() []byte {
+	file_proto_downloader_proto_rawDescOnce.Do(func() {
+		file_proto_downloader_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_downloader_proto_rawDesc), len(file_proto_downloader_proto_rawDesc)))
+	})
+	return file_proto_downloader_proto_rawDescData
+}
+
+var file_proto_downloader_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_proto_downloader_proto_goTypes = []any{
+	(*GetTaskStatusRequest)(nil),       // 0: downloader.GetTaskStatusRequest
+	(*GetTaskStatusResponse)(nil),      // 1: downloader.GetTaskStatusResponse
+	(*GetDownloadHistoryRequest)(nil),  // 2: downloader.GetDownloadHistoryRequest
+	(*GetDownloadHistoryResponse)(nil), // 3: downloader.GetDownloadHistoryResponse
+	(*DownloadRecord)(nil),             // 4: downloader.DownloadRecord
+	(*CancelTaskRequest)(nil),          // 5: downloader.CancelTaskRequest
+	(*CancelTaskResponse)(nil),         // 6: downloader.CancelTaskResponse
+	(*ListTasksRequest)(nil),           // 7: downloader.ListTasksRequest
+	(*ListTasksResponse)(nil),          // 8: downloader.ListTasksResponse
+	(*AdminTaskRecord)(nil),            // 9: downloader.AdminTaskRecord
+	(*ReplayTaskRequest)(nil),          // 10: downloader.ReplayTaskRequest
+	(*ForceFailTaskRequest)(nil),       // 11: downloader.ForceFailTaskRequest
+	(*PurgeTaskRequest)(nil),           // 12: downloader.PurgeTaskRequest
+	(*TaskActionResponse)(nil),         // 13: downloader.TaskActionResponse
+	(*GetWorkerPoolsRequest)(nil),      // 14: downloader.GetWorkerPoolsRequest
+	(*WorkerActiveTask)(nil),           // 15: downloader.WorkerActiveTask
+	(*WorkerQueueDepth)(nil),           // 16: downloader.WorkerQueueDepth
+	(*WorkerPoolStatus)(nil),           // 17: downloader.WorkerPoolStatus
+	(*GetWorkerPoolsResponse)(nil),     // 18: downloader.GetWorkerPoolsResponse
+	(*UpdateWorkerPoolRequest)(nil),    // 19: downloader.UpdateWorkerPoolRequest
+	(*PlatformInfo)(nil),               // 20: downloader.PlatformInfo
+	(*ListPlatformsRequest)(nil),       // 21: downloader.ListPlatformsRequest
+	(*ListPlatformsResponse)(nil),      // 22: downloader.ListPlatformsResponse
+	(*UpdatePlatformRequest)(nil),      // 23: downloader.UpdatePlatformRequest
+	(*UpdatePlatformResponse)(nil),     // 24: downloader.UpdatePlatformResponse
+}
+var file_proto_downloader_proto_depIdxs = []int32{
+	4,  // 0: downloader.GetDownloadHistoryResponse.records:type_name -> downloader.DownloadRecord
+	9,  // 1: downloader.ListTasksResponse.tasks:type_name -> downloader.AdminTaskRecord
+	16, // 2: downloader.WorkerPoolStatus.queues:type_name -> downloader.WorkerQueueDepth
+	15, // 3: downloader.WorkerPoolStatus.active_tasks:type_name -> downloader.WorkerActiveTask
+	17, // 4: downloader.GetWorkerPoolsResponse.instances:type_name -> downloader.WorkerPoolStatus
+	20, // 5: downloader.ListPlatformsResponse.platforms:type_name -> downloader.PlatformInfo
+	20, // 6: downloader.UpdatePlatformResponse.platform:type_name -> downloader.PlatformInfo
+	0,  // 7: downloader.DownloaderService.GetTaskStatus:input_type -> downloader.GetTaskStatusRequest
+	2,  // 8: downloader.DownloaderService.GetDownloadHistory:input_type -> downloader.GetDownloadHistoryRequest
+	5,  // 9: downloader.DownloaderService.CancelTask:input_type -> downloader.CancelTaskRequest
+	7,  // 10: downloader.DownloaderService.ListTasks:input_type -> downloader.ListTasksRequest
+	10, // 11: downloader.DownloaderService.ReplayTask:input_type -> downloader.ReplayTaskRequest
+	11, // 12: downloader.DownloaderService.ForceFailTask:input_type -> downloader.ForceFailTaskRequest
+	12, // 13: downloader.DownloaderService.PurgeTask:input_type -> downloader.PurgeTaskRequest
+	14, // 14: downloader.DownloaderService.GetWorkerPools:input_type -> downloader.GetWorkerPoolsRequest
+	19, // 15: downloader.DownloaderService.UpdateWorkerPool:input_type -> downloader.UpdateWorkerPoolRequest
+	21, // 16: downloader.DownloaderService.ListPlatforms:input_type -> downloader.ListPlatformsRequest
+	23, // 17: downloader.DownloaderService.UpdatePlatform:input_type -> downloader.UpdatePlatformRequest
+	1,  // 18: downloader.DownloaderService.GetTaskStatus:output_type -> downloader.GetTaskStatusResponse
+	3,  // 19: downloader.DownloaderService.GetDownloadHistory:output_type -> downloader.GetDownloadHistoryResponse
+	6,  // 20: downloader.DownloaderService.CancelTask:output_type -> downloader.CancelTaskResponse
+	8,  // 21: downloader.DownloaderService.ListTasks:output_type -> downloader.ListTasksResponse
+	13, // 22: downloader.DownloaderService.ReplayTask:output_type -> downloader.TaskActionResponse
+	13, // 23: downloader.DownloaderService.ForceFailTask:output_type -> downloader.TaskActionResponse
+	13, // 24: downloader.DownloaderService.PurgeTask:output_type -> downloader.TaskActionResponse
+	18, // 25: downloader.DownloaderService.GetWorkerPools:output_type -> downloader.GetWorkerPoolsResponse
+	13, // 26: downloader.DownloaderService.UpdateWorkerPool:output_type -> downloader.TaskActionResponse
+	22, // 27: downloader.DownloaderService.ListPlatforms:output_type -> downloader.ListPlatformsResponse
+	24, // 28: downloader.DownloaderService.UpdatePlatform:output_type -> downloader.UpdatePlatformResponse
+	18, // [18:29] is the sub-list for method output_type
+	7,  // [7:18] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_proto_downloader_proto_init() }
+func file_proto_downloader_proto_init() {
+	if File_proto_downloader_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_downloader_proto_rawDesc), len(file_proto_downloader_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_downloader_proto_goTypes,
+		DependencyIndexes: file_proto_downloader_proto_depIdxs,
+		MessageInfos:      file_proto_downloader_proto_msgTypes,
+	}.Build()
+	File_proto_downloader_proto = out.File
+	file_proto_downloader_proto_goTypes = nil
+	file_proto_downloader_proto_depIdxs = nil
+}
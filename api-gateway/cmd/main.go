@@ -16,6 +16,7 @@ import (
 	"youdlp/api-gateway/internal/config"
 	"youdlp/api-gateway/internal/mq"
 	"youdlp/api-gateway/internal/router"
+	"youdlp/api-gateway/internal/storage"
 	"youdlp/api-gateway/internal/ws"
 )
 
@@ -38,6 +39,15 @@ func main() {
 	})
 	defer redisClient.Close()
 
+	// SSE 每个连接都会阻塞读取进度日志，使用独立连接池，避免耗尽共享连接
+	streamRedisClient := redis.NewClient(&redis.Options{
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+		PoolSize: cfg.Redis.StreamPoolSize,
+	})
+	defer streamRedisClient.Close()
+
 	ctx := context.Background()
 	if err := redisClient.Ping(ctx).Err(); err != nil {
 		log.Printf("Warning: Failed to connect to Redis: %v", err)
@@ -65,19 +75,31 @@ func main() {
 	// 5. 创建 WebSocket 管理器
 	wsManager := ws.NewManager(
 		redisClient,
+		streamRedisClient,
 		grpcClients.AuthClient,
 		grpcClients.AssetClient,
 		cfg.WebSocket.AllowedOrigins,
 	)
 	log.Println("✓ WebSocket manager initialized")
 
+	// 文件存储后端（与 media-service 共用）
+	fileStore, err := storage.NewStorage(&cfg.Storage)
+	if err != nil {
+		log.Fatalf("Failed to initialize storage backend: %v", err)
+	}
+	log.Printf("✓ Storage backend initialized (%s)", cfg.Storage.Backend)
+
 	// 6. 设置路由
+	appCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
 	deps := &router.Dependencies{
 		Config:      cfg,
 		GRPCClients: grpcClients,
 		RedisClient: redisClient,
 		MQPublisher: mqPublisher,
 		WSManager:   wsManager,
+		FileStore:   fileStore,
+		Background:  appCtx,
 	}
 	r := router.SetupRouter(deps)
 
@@ -104,6 +126,7 @@ func main() {
 	<-quit
 
 	log.Println("Shutting down server...")
+	stopBackground()
 
 	// 10. 优雅关闭
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
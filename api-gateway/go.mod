@@ -10,7 +10,9 @@ require (
 	github.com/redis/go-redis/v9 v9.4.0
 	golang.org/x/time v0.8.0
 	google.golang.org/grpc v1.77.0
+	google.golang.org/protobuf v1.36.10
 	gopkg.in/yaml.v3 v3.0.1
+	youdlp/objectstore v0.0.0
 )
 
 require (
@@ -41,5 +43,6 @@ require (
 	golang.org/x/sys v0.37.0 // indirect
 	golang.org/x/text v0.30.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20251022142026-3a174f9686a8 // indirect
-	google.golang.org/protobuf v1.36.10 // indirect
 )
+
+replace youdlp/objectstore => ../objectstore
@@ -17,10 +17,11 @@ import (
 
 // GRPCClients gRPC 客户端集合
 type GRPCClients struct {
-	AuthClient  pb.AuthServiceClient
-	MediaClient pb.MediaServiceClient
-	AssetClient pb.AssetServiceClient
-	AdminClient pb.AdminServiceClient
+	AuthClient       pb.AuthServiceClient
+	MediaClient      pb.MediaServiceClient
+	DownloaderClient pb.DownloaderServiceClient
+	AssetClient      pb.AssetServiceClient
+	AdminClient      pb.AdminServiceClient
 
 	authConn  *grpc.ClientConn
 	mediaConn *grpc.ClientConn
@@ -74,14 +75,15 @@ func NewGRPCClients(cfg *config.GRPCConfig) (*GRPCClients, error) {
 	log.Printf("✓ Connected to Admin Service: %s", cfg.AdminService)
 
 	return &GRPCClients{
-		AuthClient:  pb.NewAuthServiceClient(authConn),
-		MediaClient: pb.NewMediaServiceClient(mediaConn),
-		AssetClient: pb.NewAssetServiceClient(assetConn),
-		AdminClient: pb.NewAdminServiceClient(adminConn),
-		authConn:    authConn,
-		mediaConn:   mediaConn,
-		assetConn:   assetConn,
-		adminConn:   adminConn,
+		AuthClient:       pb.NewAuthServiceClient(authConn),
+		MediaClient:      pb.NewMediaServiceClient(mediaConn),
+		DownloaderClient: pb.NewDownloaderServiceClient(mediaConn),
+		AssetClient:      pb.NewAssetServiceClient(assetConn),
+		AdminClient:      pb.NewAdminServiceClient(adminConn),
+		authConn:         authConn,
+		mediaConn:        mediaConn,
+		assetConn:        assetConn,
+		adminConn:        adminConn,
 	}, nil
 }
 
@@ -20,7 +20,9 @@ type Config struct {
 	WebSocket    WebSocketConfig    `yaml:"websocket"`
 	RateLimit    RateLimitConfig    `yaml:"rate_limit"`
 	FileDownload FileDownloadConfig `yaml:"file_download"`
+	Storage      StorageConfig      `yaml:"storage"`
 	Billing      BillingConfig      `yaml:"billing"`
+	Scheduling   SchedulingConfig   `yaml:"scheduling"`
 	Logging      LoggingConfig      `yaml:"logging"`
 }
 
@@ -40,6 +42,8 @@ type GRPCConfig struct {
 	AssetService string        `yaml:"asset_service"`
 	AdminService string        `yaml:"admin_service"`
 	Timeout      time.Duration `yaml:"timeout"`
+	// 批量解析按 ceil(去重后链接数 / 并发数) 倍的 timeout 计算截止时间，需与 media-service ytdlp.batch_concurrency 一致
+	ParseBatchConcurrency int `yaml:"parse_batch_concurrency"`
 }
 
 type AdminSessionConfig struct {
@@ -56,14 +60,19 @@ type RedisConfig struct {
 	Password string `yaml:"password"`
 	DB       int    `yaml:"db"`
 	PoolSize int    `yaml:"pool_size"`
+	// SSE 阻塞读取进度日志的独立连接池大小，即单实例可同时保持的 SSE 连接数上限
+	StreamPoolSize int `yaml:"stream_pool_size"`
 }
 
 // RabbitMQConfig RabbitMQ 配置
 type RabbitMQConfig struct {
-	URL        string `yaml:"url"`
-	Exchange   string `yaml:"exchange"`
-	Queue      string `yaml:"queue"`
-	RoutingKey string `yaml:"routing_key"`
+	URL         string `yaml:"url"`
+	Exchange    string `yaml:"exchange"`
+	Queue       string `yaml:"queue"`
+	RoutingKey  string `yaml:"routing_key"`
+	MaxPriority int    `yaml:"max_priority"` // 下载队列的 x-max-priority，需与 media-service 一致，0 表示不启用
+	// 按平台路由的 topic 交换机，路由键为 download.<platform>，需与 media-service 一致；为空时投递到 exchange
+	PlatformExchange string `yaml:"platform_exchange"`
 }
 
 // CORSConfig CORS 配置
@@ -91,10 +100,57 @@ type FileDownloadConfig struct {
 	BufferSize    int `yaml:"buffer_size"`
 }
 
+// StorageConfig 文件存储配置，需与 media-service 指向同一存储
+type StorageConfig struct {
+	BasePath string   `yaml:"base_path"` // 本地存储根目录
+	Backend  string   `yaml:"backend"`   // local 或 s3，默认 local
+	S3       S3Config `yaml:"s3"`
+}
+
+// S3Config S3 兼容对象存储配置（AWS S3、MinIO 等）
+type S3Config struct {
+	Endpoint     string `yaml:"endpoint"`
+	Region       string `yaml:"region"`
+	Bucket       string `yaml:"bucket"`
+	AccessKey    string `yaml:"access_key"`
+	SecretKey    string `yaml:"secret_key"`
+	Prefix       string `yaml:"prefix"`
+	UsePathStyle bool   `yaml:"use_path_style"`
+}
+
 type BillingConfig struct {
 	Enabled bool `yaml:"enabled"`
 }
 
+// SchedulingConfig 下载任务优先级类别：按用户角色决定消息优先级，media-service 按类别分配单用户并发上限
+type SchedulingConfig struct {
+	DefaultClass    string                `yaml:"default_class"`
+	DefaultPriority int                   `yaml:"default_priority"`
+	Classes         []PriorityClassConfig `yaml:"classes"`
+}
+
+// PriorityClassConfig 优先级类别
+type PriorityClassConfig struct {
+	Name     string   `yaml:"name"`
+	Priority int      `yaml:"priority"` // RabbitMQ 消息优先级，不超过 rabbitmq.max_priority
+	Roles    []string `yaml:"roles"`    // 命中的用户角色，如 "2"（VIP）
+}
+
+// ClassForRole 返回用户角色对应的优先级类别与消息优先级，未命中时使用默认类别
+func (c *SchedulingConfig) ClassForRole(role string) (string, int) {
+	if c == nil {
+		return "", 0
+	}
+	for _, class := range c.Classes {
+		for _, r := range class.Roles {
+			if r == role {
+				return class.Name, class.Priority
+			}
+		}
+	}
+	return c.DefaultClass, c.DefaultPriority
+}
+
 // LoggingConfig 日志配置
 type LoggingConfig struct {
 	Level  string `yaml:"level"`
@@ -167,6 +223,21 @@ func LoadConfig(configPath string) (*Config, error) {
 	if billingEnabled := os.Getenv("BILLING_ENABLED"); billingEnabled != "" {
 		cfg.Billing.Enabled = parseBoolEnv(billingEnabled)
 	}
+	if backend := os.Getenv("STORAGE_BACKEND"); backend != "" {
+		cfg.Storage.Backend = backend
+	}
+	if endpoint := os.Getenv("S3_ENDPOINT"); endpoint != "" {
+		cfg.Storage.S3.Endpoint = endpoint
+	}
+	if bucket := os.Getenv("S3_BUCKET"); bucket != "" {
+		cfg.Storage.S3.Bucket = bucket
+	}
+	if accessKey := os.Getenv("S3_ACCESS_KEY"); accessKey != "" {
+		cfg.Storage.S3.AccessKey = accessKey
+	}
+	if secretKey := os.Getenv("S3_SECRET_KEY"); secretKey != "" {
+		cfg.Storage.S3.SecretKey = secretKey
+	}
 
 	// 设置默认值
 	if cfg.Server.Port == 0 {
@@ -184,6 +255,12 @@ func LoadConfig(configPath string) (*Config, error) {
 	if cfg.GRPC.Timeout == 0 {
 		cfg.GRPC.Timeout = 5 * time.Second
 	}
+	if cfg.Redis.StreamPoolSize <= 0 {
+		cfg.Redis.StreamPoolSize = 200
+	}
+	if cfg.GRPC.ParseBatchConcurrency <= 0 {
+		cfg.GRPC.ParseBatchConcurrency = 4
+	}
 	if cfg.GRPC.AdminService == "" {
 		cfg.GRPC.AdminService = "localhost:9005"
 	}
@@ -208,6 +285,9 @@ func LoadConfig(configPath string) (*Config, error) {
 	if cfg.FileDownload.BufferSize == 0 {
 		cfg.FileDownload.BufferSize = 32768 // 32KB
 	}
+	if cfg.Storage.BasePath == "" {
+		cfg.Storage.BasePath = "/data/youdlp"
+	}
 
 	return &cfg, nil
 }
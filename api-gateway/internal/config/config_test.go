@@ -103,3 +103,22 @@ func unsetEnv(t *testing.T, key string) {
 		}
 	})
 }
+
+func TestSchedulingClassForRole(t *testing.T) {
+	cfg := &SchedulingConfig{
+		DefaultClass:    "standard",
+		DefaultPriority: 1,
+		Classes:         []PriorityClassConfig{{Name: "vip", Priority: 5, Roles: []string{"2"}}},
+	}
+
+	if class, priority := cfg.ClassForRole("2"); class != "vip" || priority != 5 {
+		t.Fatalf("expected vip class for role 2, got %s/%d", class, priority)
+	}
+	if class, priority := cfg.ClassForRole("1"); class != "standard" || priority != 1 {
+		t.Fatalf("expected default class for role 1, got %s/%d", class, priority)
+	}
+	var empty *SchedulingConfig
+	if class, priority := empty.ClassForRole("2"); class != "" || priority != 0 {
+		t.Fatalf("expected no class without config, got %s/%d", class, priority)
+	}
+}
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"youdlp/api-gateway/internal/models"
+	pb "youdlp/api-gateway/proto"
+)
+
+// ListPlatforms 按匹配顺序返回平台注册表及各平台启用状态
+func (h *AdminTaskHandler) ListPlatforms(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := h.adminClient.ListPlatforms(ctx, &pb.AdminEmpty{})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	items := make([]models.AdminPlatform, 0, len(resp.GetItems()))
+	for _, item := range resp.GetItems() {
+		items = append(items, adminPlatformResponse(item))
+	}
+	models.Success(c, gin.H{"items": items})
+}
+
+// UpdatePlatform 启用或禁用平台，禁用后该平台的解析与下载请求会被拒绝
+func (h *AdminTaskHandler) UpdatePlatform(c *gin.Context) {
+	name := strings.TrimSpace(c.Param("name"))
+	if name == "" {
+		models.BadRequest(c, "missing platform name")
+		return
+	}
+
+	var req models.AdminUpdatePlatformRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		models.BadRequest(c, "invalid request: "+err.Error())
+		return
+	}
+	if req.Enabled == nil {
+		models.BadRequest(c, "enabled is required")
+		return
+	}
+
+	adminUser, ok := getAdminUserFromContext(c)
+	if !ok {
+		models.Unauthorized(c, "invalid admin user")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := h.adminClient.UpdatePlatform(ctx, &pb.AdminUpdatePlatformRequest{
+		Name:           name,
+		Enabled:        *req.Enabled,
+		OperatorUserId: adminUser.GetUserId(),
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	models.Success(c, adminPlatformResponse(resp.GetPlatform()))
+}
+
+func adminPlatformResponse(item *pb.AdminPlatform) models.AdminPlatform {
+	return models.AdminPlatform{
+		Name:              item.GetName(),
+		DisplayName:       item.GetDisplayName(),
+		Enabled:           item.GetEnabled(),
+		Patterns:          item.GetPatterns(),
+		Adapter:           item.GetAdapter(),
+		ExtraArgs:         item.GetExtraArgs(),
+		Cookies:           item.GetCookies(),
+		Proxy:             item.GetProxy(),
+		ParsePerMinute:    item.GetParsePerMinute(),
+		DownloadPerMinute: item.GetDownloadPerMinute(),
+	}
+}
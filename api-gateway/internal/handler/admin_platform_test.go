@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+
+	pb "youdlp/api-gateway/proto"
+)
+
+type fakeAdminPlatformClient struct {
+	pb.AdminServiceClient
+	updated *pb.AdminUpdatePlatformRequest
+}
+
+func (f *fakeAdminPlatformClient) UpdatePlatform(_ context.Context, in *pb.AdminUpdatePlatformRequest, _ ...grpc.CallOption) (*pb.AdminPlatformResponse, error) {
+	f.updated = in
+	return &pb.AdminPlatformResponse{Platform: &pb.AdminPlatform{Name: in.GetName(), Enabled: in.GetEnabled()}}, nil
+}
+
+func newPlatformUpdateContext(name, body string) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/admin/platforms/"+name, strings.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = gin.Params{{Key: "name", Value: name}}
+	c.Set("admin_user", &pb.AdminUser{UserId: "admin-1"})
+	return c, recorder
+}
+
+func TestAdminPlatformUpdateRequiresEnabled(t *testing.T) {
+	for _, body := range []string{`{}`, `{"enabled":"yes"}`} {
+		c, recorder := newPlatformUpdateContext("weibo", body)
+		NewAdminTaskHandler(nil, time.Second).UpdatePlatform(c)
+		if recorder.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected 400, got %d", body, recorder.Code)
+		}
+	}
+}
+
+func TestAdminPlatformUpdatePassesDisableAndOperator(t *testing.T) {
+	c, recorder := newPlatformUpdateContext("weibo", `{"enabled":false}`)
+
+	client := &fakeAdminPlatformClient{}
+	NewAdminTaskHandler(client, time.Second).UpdatePlatform(c)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+	got := client.updated
+	if got == nil || got.GetName() != "weibo" || got.GetEnabled() || got.GetOperatorUserId() != "admin-1" {
+		t.Fatalf("unexpected update request: %+v", got)
+	}
+
+	data := decodeResponseDataAsMap(t, recorder)
+	assertHasKeys(t, data, "name", "enabled", "patterns", "cookies", "proxy", "parse_per_minute")
+}
@@ -27,7 +27,6 @@ const (
 	proxyUsageDefaultPageSize = 20
 	proxyUsageMaxPage         = 10000
 	proxyUsageMaxPageSize     = 100
-	proxyUsageMaxTimeRange    = 31 * 24 * time.Hour
 )
 
 var allowedProxyListSortFields = map[string]struct{}{
@@ -39,11 +38,6 @@ var allowedProxyListSortFields = map[string]struct{}{
 	"last_used_at":      {},
 }
 
-var (
-	errInvalidProxyUsageTimeRange  = errors.New("start_time must be before end_time")
-	errProxyUsageTimeRangeTooLarge = errors.New("time range must not exceed 31 days")
-)
-
 func NewAdminProxyHandler(adminClient pb.AdminServiceClient, timeout time.Duration) *AdminProxyHandler {
 	return &AdminProxyHandler{adminClient: adminClient, timeout: timeout}
 }
@@ -235,27 +229,27 @@ func (h *AdminProxyHandler) ListUsageEvents(c *gin.Context) {
 	}
 
 	success := strings.ToLower(c.DefaultQuery("success", "all"))
-	if !isAllowedProxyUsageValue(success, "all", "success", "failed") {
+	if !isAllowedFilterValue(success, "all", "success", "failed") {
 		models.BadRequest(c, "invalid success")
 		return
 	}
 	stage := strings.ToLower(c.Query("stage"))
-	if stage != "" && !isAllowedProxyUsageValue(stage, "parse", "download") {
+	if stage != "" && !isAllowedFilterValue(stage, "parse", "download") {
 		models.BadRequest(c, "invalid stage")
 		return
 	}
 	sourceType := strings.ToLower(c.Query("source_type"))
-	if sourceType != "" && !isAllowedProxyUsageValue(sourceType, "manual", "dynamic", "manual_pool", "dynamic_api") {
+	if sourceType != "" && !isAllowedFilterValue(sourceType, "manual", "dynamic", "manual_pool", "dynamic_api") {
 		models.BadRequest(c, "invalid source_type")
 		return
 	}
 	sortOrder := strings.ToLower(c.DefaultQuery("sort_order", "desc"))
-	if !isAllowedProxyUsageValue(sortOrder, "asc", "desc") {
+	if !isAllowedFilterValue(sortOrder, "asc", "desc") {
 		models.BadRequest(c, "invalid sort_order")
 		return
 	}
 
-	startTime, endTime, err := parseProxyUsageTimeRange(c.Query("start_time"), c.Query("end_time"))
+	startTime, endTime, err := parseFilterTimeRange(c.Query("start_time"), c.Query("end_time"))
 	if err != nil {
 		models.BadRequest(c, err.Error())
 		return
@@ -435,41 +429,6 @@ func parseProxyListSortQuery(sortByValue, sortOrderValue string) (string, string
 	return sortBy, sortOrder, nil
 }
 
-func parseProxyUsageTimeRange(startValue, endValue string) (time.Time, time.Time, error) {
-	endTime := time.Now()
-	var err error
-	if endValue != "" {
-		endTime, err = time.Parse(time.RFC3339, endValue)
-		if err != nil {
-			return time.Time{}, time.Time{}, err
-		}
-	}
-
-	startTime := endTime.Add(-24 * time.Hour)
-	if startValue != "" {
-		startTime, err = time.Parse(time.RFC3339, startValue)
-		if err != nil {
-			return time.Time{}, time.Time{}, err
-		}
-	}
-	if startTime.After(endTime) {
-		return time.Time{}, time.Time{}, errInvalidProxyUsageTimeRange
-	}
-	if endTime.Sub(startTime) > proxyUsageMaxTimeRange {
-		return time.Time{}, time.Time{}, errProxyUsageTimeRangeTooLarge
-	}
-	return startTime, endTime, nil
-}
-
-func isAllowedProxyUsageValue(value string, allowed ...string) bool {
-	for _, item := range allowed {
-		if value == item {
-			return true
-		}
-	}
-	return false
-}
-
 func publicProxyUsageSourceType(value string) string {
 	switch value {
 	case "manual_pool":
@@ -162,6 +162,16 @@ func (h *AdminStatsHandler) DashboardHealth(c *gin.Context) {
 		Billing: models.AdminDashboardBilling{
 			ShortfallCount: resp.GetBilling().GetShortfallCount(),
 		},
+		Workers: models.AdminDashboardWorkers{
+			Available:       resp.GetWorkers().GetAvailable(),
+			Instances:       resp.GetWorkers().GetInstances(),
+			MaxConcurrent:   resp.GetWorkers().GetMaxConcurrent(),
+			Running:         resp.GetWorkers().GetRunning(),
+			Buffered:        resp.GetWorkers().GetBuffered(),
+			Queued:          resp.GetWorkers().GetQueued(),
+			PausedInstances: resp.GetWorkers().GetPausedInstances(),
+			Saturation:      resp.GetWorkers().GetSaturation(),
+		},
 		Exceptions: adminDashboardExceptionsResponse(resp.GetExceptions()),
 	})
 }
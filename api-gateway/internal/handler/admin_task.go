@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+
+	"youdlp/api-gateway/internal/models"
+	pb "youdlp/api-gateway/proto"
+)
+
+type AdminTaskHandler struct {
+	adminClient pb.AdminServiceClient
+	timeout     time.Duration
+}
+
+const (
+	taskListDefaultPage     = 1
+	taskListDefaultPageSize = 20
+	taskListMaxPage         = 10000
+	taskListMaxPageSize     = 100
+)
+
+func NewAdminTaskHandler(adminClient pb.AdminServiceClient, timeout time.Duration) *AdminTaskHandler {
+	return &AdminTaskHandler{adminClient: adminClient, timeout: timeout}
+}
+
+func (h *AdminTaskHandler) List(c *gin.Context) {
+	page, err := parsePositiveInt32Query(c, "page", taskListDefaultPage, taskListMaxPage)
+	if err != nil {
+		models.BadRequest(c, "invalid page")
+		return
+	}
+	pageSize, err := parsePositiveInt32Query(c, "page_size", taskListDefaultPageSize, taskListMaxPageSize)
+	if err != nil {
+		models.BadRequest(c, "invalid page_size")
+		return
+	}
+	stuckAfter, err := parseOptionalInt64Query(c, "stuck_after_seconds")
+	if err != nil || stuckAfter < 0 {
+		models.BadRequest(c, "invalid stuck_after_seconds")
+		return
+	}
+
+	state := strings.ToLower(c.Query("state"))
+	if state != "" && !isAllowedFilterValue(state, "failed", "dead_lettered", "stuck") {
+		models.BadRequest(c, "invalid state")
+		return
+	}
+
+	var startTimeUnix, endTimeUnix int64
+	if c.Query("start_time") != "" || c.Query("end_time") != "" {
+		startTime, endTime, err := parseFilterTimeRange(c.Query("start_time"), c.Query("end_time"))
+		if err != nil {
+			models.BadRequest(c, err.Error())
+			return
+		}
+		startTimeUnix, endTimeUnix = startTime.Unix(), endTime.Unix()
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := h.adminClient.ListTasks(ctx, &pb.AdminListTasksRequest{
+		State:             state,
+		Platform:          c.Query("platform"),
+		ErrorCategory:     c.Query("error_category"),
+		UserId:            c.Query("user_id"),
+		StartTimeUnix:     startTimeUnix,
+		EndTimeUnix:       endTimeUnix,
+		StuckAfterSeconds: stuckAfter,
+		Page:              page,
+		PageSize:          pageSize,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	items := make([]models.AdminTask, 0, len(resp.GetItems()))
+	for _, item := range resp.GetItems() {
+		items = append(items, models.AdminTask{
+			TaskID:         item.GetTaskId(),
+			UserID:         item.GetUserId(),
+			URL:            item.GetUrl(),
+			Platform:       item.GetPlatform(),
+			Title:          item.GetTitle(),
+			Mode:           item.GetMode(),
+			Quality:        item.GetQuality(),
+			Status:         item.GetStatus(),
+			StatusText:     item.GetStatusText(),
+			ErrorMessage:   item.GetErrorMessage(),
+			ErrorCategory:  item.GetErrorCategory(),
+			RetryCount:     item.GetRetryCount(),
+			CreatedAt:      item.GetCreatedAt(),
+			StartedAt:      item.GetStartedAt(),
+			DeadLetteredAt: item.GetDeadLetteredAt(),
+			DeadLettered:   item.GetDeadLettered(),
+			Stuck:          item.GetStuck(),
+			Replayable:     item.GetReplayable(),
+		})
+	}
+
+	models.Success(c, models.PagedResponse{
+		Total:    resp.GetTotal(),
+		Page:     int(resp.GetPage()),
+		PageSize: int(resp.GetPageSize()),
+		Items:    items,
+	})
+}
+
+func (h *AdminTaskHandler) Replay(c *gin.Context) {
+	h.runAction(c, h.adminClient.ReplayTask)
+}
+
+func (h *AdminTaskHandler) ForceFail(c *gin.Context) {
+	h.runAction(c, h.adminClient.ForceFailTask)
+}
+
+func (h *AdminTaskHandler) Purge(c *gin.Context) {
+	h.runAction(c, h.adminClient.PurgeTask)
+}
+
+type adminTaskAction func(ctx context.Context, in *pb.AdminTaskActionRequest, opts ...grpc.CallOption) (*pb.AdminTaskActionResponse, error)
+
+func (h *AdminTaskHandler) runAction(c *gin.Context, action adminTaskAction) {
+	taskID := c.Param("taskId")
+	if taskID == "" {
+		models.BadRequest(c, "taskId is required")
+		return
+	}
+
+	// 请求体可选
+	var req models.AdminTaskActionRequest
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		models.BadRequest(c, "invalid request: "+err.Error())
+		return
+	}
+	if req.StuckAfterSeconds < 0 {
+		models.BadRequest(c, "invalid stuck_after_seconds")
+		return
+	}
+
+	adminUser, ok := getAdminUserFromContext(c)
+	if !ok {
+		models.Unauthorized(c, "invalid admin user")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := action(ctx, &pb.AdminTaskActionRequest{
+		TaskId:            taskID,
+		Reason:            req.Reason,
+		StuckAfterSeconds: req.StuckAfterSeconds,
+		OperatorUserId:    adminUser.GetUserId(),
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	models.Success(c, models.AdminTaskActionResponse{
+		Success: resp.GetSuccess(),
+		Message: resp.GetMessage(),
+	})
+}
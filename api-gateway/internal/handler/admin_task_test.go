@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+
+	pb "youdlp/api-gateway/proto"
+)
+
+type fakeAdminTaskClient struct {
+	pb.AdminServiceClient
+	replayed *pb.AdminTaskActionRequest
+}
+
+func (f *fakeAdminTaskClient) ReplayTask(_ context.Context, in *pb.AdminTaskActionRequest, _ ...grpc.CallOption) (*pb.AdminTaskActionResponse, error) {
+	f.replayed = in
+	return &pb.AdminTaskActionResponse{Success: true}, nil
+}
+
+func TestAdminTaskListRejectsUnknownState(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodGet, "/?state=completed", nil)
+
+	NewAdminTaskHandler(nil, time.Second).List(c)
+
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", recorder.Code)
+	}
+}
+
+func TestAdminTaskReplayAcceptsEmptyBodyAndPassesOperator(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/admin/tasks/task-1/replay", nil)
+	c.Params = gin.Params{{Key: "taskId", Value: "task-1"}}
+	c.Set("admin_user", &pb.AdminUser{UserId: "admin-1"})
+
+	client := &fakeAdminTaskClient{}
+	NewAdminTaskHandler(client, time.Second).Replay(c)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+	if client.replayed == nil || client.replayed.GetTaskId() != "task-1" || client.replayed.GetOperatorUserId() != "admin-1" {
+		t.Fatalf("unexpected replay request: %+v", client.replayed)
+	}
+}
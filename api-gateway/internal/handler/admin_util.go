@@ -1,8 +1,10 @@
 package handler
 
 import (
+	"errors"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc/codes"
@@ -11,6 +13,51 @@ import (
 	"youdlp/api-gateway/internal/models"
 )
 
+// adminFilterMaxTimeRange 管理端列表按时间筛选的最大跨度
+const adminFilterMaxTimeRange = 31 * 24 * time.Hour
+
+var (
+	errInvalidFilterTimeRange  = errors.New("start_time must be before end_time")
+	errFilterTimeRangeTooLarge = errors.New("time range must not exceed 31 days")
+)
+
+// parseFilterTimeRange 解析管理端列表的 RFC3339 时间筛选，缺省为截至当前的最近 24 小时
+func parseFilterTimeRange(startValue, endValue string) (time.Time, time.Time, error) {
+	endTime := time.Now()
+	var err error
+	if endValue != "" {
+		endTime, err = time.Parse(time.RFC3339, endValue)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	startTime := endTime.Add(-24 * time.Hour)
+	if startValue != "" {
+		startTime, err = time.Parse(time.RFC3339, startValue)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+	if startTime.After(endTime) {
+		return time.Time{}, time.Time{}, errInvalidFilterTimeRange
+	}
+	if endTime.Sub(startTime) > adminFilterMaxTimeRange {
+		return time.Time{}, time.Time{}, errFilterTimeRangeTooLarge
+	}
+	return startTime, endTime, nil
+}
+
+// isAllowedFilterValue 判断查询或操作参数是否为允许的取值之一
+func isAllowedFilterValue(value string, allowed ...string) bool {
+	for _, item := range allowed {
+		if value == item {
+			return true
+		}
+	}
+	return false
+}
+
 func grpcErrorMessage(err error) string {
 	if err == nil {
 		return "request failed, please try again later"
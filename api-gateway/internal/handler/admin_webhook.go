@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"youdlp/api-gateway/internal/models"
+	pb "youdlp/api-gateway/proto"
+)
+
+// AdminWebhookHandler 管理全局 Webhook 端点，全局端点接收所有用户的事件
+type AdminWebhookHandler struct {
+	adminClient pb.AdminServiceClient
+	timeout     time.Duration
+}
+
+func NewAdminWebhookHandler(adminClient pb.AdminServiceClient, timeout time.Duration) *AdminWebhookHandler {
+	return &AdminWebhookHandler{adminClient: adminClient, timeout: timeout}
+}
+
+func (h *AdminWebhookHandler) List(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := h.adminClient.ListWebhooks(ctx, &pb.AdminEmpty{})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	items := make([]models.WebhookEndpointResponse, 0, len(resp.GetItems()))
+	for _, item := range resp.GetItems() {
+		items = append(items, webhookEndpointResponse(item))
+	}
+	models.Success(c, models.WebhookListResponse{Items: items, AvailableEvents: resp.GetAvailableEvents()})
+}
+
+func (h *AdminWebhookHandler) Create(c *gin.Context) {
+	var req models.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		models.BadRequest(c, "invalid request: "+err.Error())
+		return
+	}
+
+	adminUser, ok := getAdminUserFromContext(c)
+	if !ok {
+		models.Unauthorized(c, "invalid admin user")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := h.adminClient.CreateWebhook(ctx, &pb.AdminCreateWebhookRequest{
+		Url:            req.URL,
+		Events:         req.Events,
+		Description:    req.Description,
+		OperatorUserId: adminUser.GetUserId(),
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	endpoint := webhookEndpointResponse(resp.GetEndpoint())
+	endpoint.Secret = resp.GetSecret()
+	models.Success(c, endpoint)
+}
+
+func (h *AdminWebhookHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		models.BadRequest(c, "invalid webhook id")
+		return
+	}
+
+	var req models.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		models.BadRequest(c, "invalid request: "+err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	fields := webhookUpdateFromRequest(&req)
+	resp, err := h.adminClient.UpdateWebhook(ctx, &pb.AdminUpdateWebhookRequest{
+		Id:                id,
+		Url:               fields.url,
+		UpdateEvents:      fields.updateEvents,
+		Events:            fields.events,
+		UpdateDescription: fields.updateDescription,
+		Description:       fields.description,
+		Status:            fields.status,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	models.Success(c, webhookEndpointResponse(resp.GetEndpoint()))
+}
+
+func (h *AdminWebhookHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		models.BadRequest(c, "invalid webhook id")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	if _, err := h.adminClient.DeleteWebhook(ctx, &pb.AdminDeleteRequest{Id: id}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	models.Success(c, gin.H{"success": true})
+}
+
+func (h *AdminWebhookHandler) ListDeliveries(c *gin.Context) {
+	var req models.WebhookDeliveryListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		models.BadRequest(c, "invalid request: "+err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := h.adminClient.ListWebhookDeliveries(ctx, &pb.AdminListWebhookDeliveriesRequest{
+		EndpointId: req.EndpointID,
+		Status:     req.Status,
+		EventType:  req.EventType,
+		Page:       int32(req.Page),
+		PageSize:   int32(req.PageSize),
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	items := make([]models.WebhookDeliveryResponse, 0, len(resp.GetItems()))
+	for _, item := range resp.GetItems() {
+		items = append(items, webhookDeliveryResponse(item))
+	}
+	models.Success(c, models.WebhookDeliveryListResponse{
+		Total:    resp.GetTotal(),
+		Page:     int(resp.GetPage()),
+		PageSize: int(resp.GetPageSize()),
+		Items:    items,
+	})
+}
+
+func (h *AdminWebhookHandler) Redeliver(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		models.BadRequest(c, "invalid delivery id")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	if _, err := h.adminClient.RedeliverWebhook(ctx, &pb.AdminRedeliverWebhookRequest{DeliveryId: id}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	models.Success(c, gin.H{"success": true})
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"youdlp/api-gateway/internal/models"
+	pb "youdlp/api-gateway/proto"
+)
+
+// Worker 池控制命令
+const (
+	workerActionSetConcurrency = "set_concurrency"
+	workerActionPause          = "pause"
+	workerActionResume         = "resume"
+)
+
+func (h *AdminTaskHandler) ListWorkerPools(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := h.adminClient.ListWorkerPools(ctx, &pb.AdminEmpty{})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	items := make([]models.AdminWorkerPool, 0, len(resp.GetItems()))
+	for _, item := range resp.GetItems() {
+		items = append(items, adminWorkerPoolResponse(item))
+	}
+	models.Success(c, gin.H{"items": items})
+}
+
+// UpdateWorkerPool 调整 Worker 并发或暂停、恢复消费，instance_id 为空时作用于所有实例
+func (h *AdminTaskHandler) UpdateWorkerPool(c *gin.Context) {
+	var req models.AdminUpdateWorkerPoolRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		models.BadRequest(c, "invalid request: "+err.Error())
+		return
+	}
+	if !isAllowedFilterValue(req.Action, workerActionSetConcurrency, workerActionPause, workerActionResume) {
+		models.BadRequest(c, "invalid action")
+		return
+	}
+	if req.Action == workerActionSetConcurrency && req.MaxConcurrent <= 0 {
+		models.BadRequest(c, "max_concurrent must be positive")
+		return
+	}
+
+	adminUser, ok := getAdminUserFromContext(c)
+	if !ok {
+		models.Unauthorized(c, "invalid admin user")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := h.adminClient.UpdateWorkerPool(ctx, &pb.AdminUpdateWorkerPoolRequest{
+		InstanceId:     req.InstanceID,
+		Action:         req.Action,
+		MaxConcurrent:  req.MaxConcurrent,
+		OperatorUserId: adminUser.GetUserId(),
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	models.Success(c, models.AdminTaskActionResponse{
+		Success: resp.GetSuccess(),
+		Message: resp.GetMessage(),
+	})
+}
+
+func adminWorkerPoolResponse(item *pb.AdminWorkerPool) models.AdminWorkerPool {
+	queues := make([]models.AdminWorkerQueueDepth, 0, len(item.GetQueues()))
+	for _, q := range item.GetQueues() {
+		queues = append(queues, models.AdminWorkerQueueDepth{
+			Queue:     q.GetQueue(),
+			Platform:  q.GetPlatform(),
+			Messages:  q.GetMessages(),
+			Consumers: q.GetConsumers(),
+			Paused:    q.GetPaused(),
+		})
+	}
+
+	tasks := make([]models.AdminWorkerActiveTask, 0, len(item.GetActiveTasks()))
+	for _, t := range item.GetActiveTasks() {
+		tasks = append(tasks, models.AdminWorkerActiveTask{
+			TaskID:    t.GetTaskId(),
+			UserID:    t.GetUserId(),
+			Platform:  t.GetPlatform(),
+			Phase:     t.GetPhase(),
+			Percent:   t.GetPercent(),
+			StartedAt: t.GetStartedAt(),
+		})
+	}
+
+	return models.AdminWorkerPool{
+		InstanceID:     item.GetInstanceId(),
+		PoolSize:       item.GetPoolSize(),
+		MaxConcurrent:  item.GetMaxConcurrent(),
+		Running:        item.GetRunning(),
+		Buffered:       item.GetBuffered(),
+		BufferCapacity: item.GetBufferCapacity(),
+		Paused:         item.GetPaused(),
+		Queues:         queues,
+		ActiveTasks:    tasks,
+		UpdatedAt:      item.GetUpdatedAt(),
+	}
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+
+	pb "youdlp/api-gateway/proto"
+)
+
+type fakeAdminWorkerClient struct {
+	pb.AdminServiceClient
+	updated *pb.AdminUpdateWorkerPoolRequest
+}
+
+func (f *fakeAdminWorkerClient) UpdateWorkerPool(_ context.Context, in *pb.AdminUpdateWorkerPoolRequest, _ ...grpc.CallOption) (*pb.AdminTaskActionResponse, error) {
+	f.updated = in
+	return &pb.AdminTaskActionResponse{Success: true}, nil
+}
+
+func newWorkerUpdateContext(body string) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/admin/workers", strings.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set("admin_user", &pb.AdminUser{UserId: "admin-1"})
+	return c, recorder
+}
+
+func TestAdminWorkerUpdateRejectsInvalidCommands(t *testing.T) {
+	for _, body := range []string{
+		`{"action":"restart"}`,
+		`{"action":"set_concurrency"}`,
+		`{"action":"set_concurrency","max_concurrent":-1}`,
+	} {
+		c, recorder := newWorkerUpdateContext(body)
+		NewAdminTaskHandler(nil, time.Second).UpdateWorkerPool(c)
+		if recorder.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected 400, got %d", body, recorder.Code)
+		}
+	}
+}
+
+func TestAdminWorkerUpdatePassesOperator(t *testing.T) {
+	c, recorder := newWorkerUpdateContext(`{"instance_id":"media-1","action":"set_concurrency","max_concurrent":8}`)
+
+	client := &fakeAdminWorkerClient{}
+	NewAdminTaskHandler(client, time.Second).UpdateWorkerPool(c)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+	got := client.updated
+	if got == nil || got.GetInstanceId() != "media-1" || got.GetMaxConcurrent() != 8 || got.GetOperatorUserId() != "admin-1" {
+		t.Fatalf("unexpected update request: %+v", got)
+	}
+}
@@ -2,10 +2,15 @@ package handler
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"log"
+	"math"
 	"net/http"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -14,6 +19,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"youdlp/api-gateway/internal/config"
 	"youdlp/api-gateway/internal/middleware"
 	"youdlp/api-gateway/internal/models"
 	"youdlp/api-gateway/internal/mq"
@@ -27,6 +33,7 @@ type DownloadHandler struct {
 	publisher      downloadPublisher
 	timeout        time.Duration
 	billingEnabled bool
+	scheduling     *config.SchedulingConfig
 }
 
 type assetDownloadClient interface {
@@ -68,6 +75,7 @@ func NewDownloadHandler(
 	publisher downloadPublisher,
 	timeout time.Duration,
 	billingEnabled bool,
+	scheduling *config.SchedulingConfig,
 ) *DownloadHandler {
 	if isNilDownloadPublisher(publisher) {
 		log.Printf("[Download] ⚠ MQ publisher is unavailable, download submissions will return 503 until RabbitMQ recovers")
@@ -80,6 +88,7 @@ func NewDownloadHandler(
 		publisher:      publisher,
 		timeout:        timeout,
 		billingEnabled: billingEnabled,
+		scheduling:     scheduling,
 	}
 }
 
@@ -107,19 +116,60 @@ func (h *DownloadHandler) SubmitDownload(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
 	defer cancel()
 
+	resp, failure := h.submit(ctx, userID, middleware.GetUserRole(c), &req, "")
+	if failure != nil {
+		failure.write(c)
+		return
+	}
+
+	log.Printf("[Download] ✅ Download request completed successfully - TaskID: %s, EstimatedTime: %ds", resp.TaskID, resp.EstimatedTime)
+	models.Accepted(c, *resp)
+}
+
+// submitFailure 提交流程失败原因：httpStatus 非 0 时直接返回该状态码，否则按 gRPC 错误映射
+type submitFailure struct {
+	httpStatus int
+	message    string
+	err        error
+	duplicate  *models.DownloadResponse // 重复提交时为仍在进行的原任务
+}
+
+func (f *submitFailure) write(c *gin.Context) {
+	if f.duplicate != nil {
+		c.JSON(http.StatusConflict, models.Response{Code: http.StatusConflict, Message: f.message, Data: f.duplicate})
+		return
+	}
+	if f.httpStatus != 0 {
+		models.Error(c, f.httpStatus, f.message)
+		return
+	}
+	writeGRPCError(c, f.err)
+}
+
+// userMessage 面向用户的失败描述
+func (f *submitFailure) userMessage() string {
+	if f.httpStatus != 0 {
+		return f.message
+	}
+	return grpcErrorMessage(f.err)
+}
+
+// submit 执行单个下载任务的提交流程（校验、解析、建档、计费、入队），失败时已完成补偿。
+// userRole 决定任务的优先级类别，batchID 为空表示单独提交。
+func (h *DownloadHandler) submit(ctx context.Context, userID, userRole string, req *models.DownloadRequest, batchID string) (*models.DownloadResponse, *submitFailure) {
+	priorityClass, priority := h.priorityForRole(userRole)
+
 	if !h.billingEnabled {
 		log.Printf("[Download] Step 1/8: Checking quota for user %s...", userID)
 		quotaResp, err := h.assetClient.CheckQuota(ctx, &pb.CheckQuotaRequest{UserId: userID})
 		if err != nil {
 			log.Printf("[Download] ❌ Failed to check quota: %v", err)
-			writeGRPCError(c, err)
-			return
+			return nil, &submitFailure{err: err}
 		}
 		log.Printf("[Download] ✓ Quota check passed - Remaining: %d", quotaResp.Remaining)
 		if quotaResp.Remaining <= 0 {
 			log.Printf("[Download] ❌ Quota exceeded for user %s", userID)
-			models.Forbidden(c, "quota exceeded, please try again tomorrow")
-			return
+			return nil, &submitFailure{httpStatus: http.StatusForbidden, message: "quota exceeded, please try again tomorrow"}
 		}
 	}
 
@@ -127,15 +177,17 @@ func (h *DownloadHandler) SubmitDownload(c *gin.Context) {
 	validateResp, err := h.mediaClient.ValidateURL(ctx, &pb.ValidateURLRequest{Url: req.URL})
 	if err != nil {
 		log.Printf("[Download] ❌ Failed to validate URL: %v", err)
-		writeGRPCError(c, err)
-		return
+		return nil, &submitFailure{err: err}
 	}
 	if !validateResp.Valid {
 		log.Printf("[Download] ❌ Invalid URL: %s", validateResp.Message)
-		models.BadRequest(c, "invalid URL: "+validateResp.Message)
-		return
+		return nil, &submitFailure{httpStatus: http.StatusBadRequest, message: "invalid URL: " + validateResp.Message}
+	}
+	canonicalURL := validateResp.GetCanonicalUrl()
+	if canonicalURL == "" {
+		canonicalURL = req.URL
 	}
-	log.Printf("[Download] ✓ URL validated - Platform: %s", validateResp.Platform)
+	log.Printf("[Download] ✓ URL validated - Platform: %s, Canonical: %s", validateResp.Platform, canonicalURL)
 
 	log.Printf("[Download] Step 3/8: Generating task ID...")
 	taskID := uuid.New().String()
@@ -148,16 +200,21 @@ func (h *DownloadHandler) SubmitDownload(c *gin.Context) {
 	})
 	if err != nil {
 		log.Printf("[Download] ❌ Failed to parse URL: %v", err)
-		writeGRPCError(c, err)
-		return
+		return nil, &submitFailure{err: err}
 	}
 	log.Printf("[Download] ✓ URL parsed - Title: %s, Duration: %ds", parseResp.Title, parseResp.Duration)
 
+	if message := validateClip(req.Clip, parseResp); message != "" {
+		log.Printf("[Download] ❌ Invalid clip options: %s", message)
+		h.releaseProxyBinding(ctx, taskID, "invalid clip options")
+		return nil, &submitFailure{httpStatus: http.StatusBadRequest, message: message}
+	}
+
 	log.Printf("[Download] Step 5/8: Creating download history for task %s...", taskID)
 	historyResp, err := h.assetClient.CreateHistory(ctx, &pb.CreateHistoryRequest{
 		UserId:    userID,
 		TaskId:    taskID,
-		Url:       req.URL,
+		Url:       req.URL, // 保存原始地址，重放时仍需其中的访问参数；规范地址只用于去重
 		Platform:  validateResp.Platform,
 		Title:     parseResp.Title,
 		Mode:      req.Mode,
@@ -165,29 +222,41 @@ func (h *DownloadHandler) SubmitDownload(c *gin.Context) {
 		Thumbnail: parseResp.Thumbnail,
 		Duration:  parseResp.Duration,
 		Author:    parseResp.Author,
+		Priority:  int32(priority),
+		DedupKey:  submissionDedupKey(canonicalURL, req),
 	})
 	if err != nil {
 		log.Printf("[Download] ❌ Failed to create history: %v", err)
 		h.releaseProxyBinding(ctx, taskID, "create history failed")
-		writeGRPCError(c, err)
-		return
+		return nil, &submitFailure{err: err}
+	}
+	if historyResp.GetDuplicate() {
+		log.Printf("[Download] ❌ Duplicate submission of task %s", historyResp.GetTaskId())
+		h.releaseProxyBinding(ctx, taskID, "duplicate submission")
+		return nil, &submitFailure{
+			httpStatus: http.StatusConflict,
+			message:    "duplicate submission: task " + historyResp.GetTaskId() + " is still in progress",
+			duplicate:  &models.DownloadResponse{TaskID: historyResp.GetTaskId(), HistoryID: historyResp.GetHistoryId()},
+		}
 	}
 	log.Printf("[Download] ✓ History created - HistoryID: %d", historyResp.HistoryId)
 
 	if h.billingEnabled {
 		log.Printf("[Download] Step 6/8: Estimating billing for task %s...", taskID)
 		estimateResp, err := h.assetClient.EstimateDownloadBilling(ctx, &pb.EstimateDownloadBillingRequest{
-			UserId:         userID,
-			Url:            req.URL,
-			Platform:       validateResp.Platform,
-			Mode:           req.Mode,
-			SelectedFormat: toBillingSelectedFormat(req.SelectedFormat),
+			UserId:           userID,
+			Url:              req.URL,
+			Platform:         validateResp.Platform,
+			Mode:             req.Mode,
+			SelectedFormat:   toBillingSelectedFormat(req.SelectedFormat),
+			DurationSeconds:  parseResp.Duration,
+			ClipStartSeconds: clipStart(req.Clip),
+			ClipEndSeconds:   clipEnd(req.Clip),
 		})
 		if err != nil {
 			log.Printf("[Download] ❌ Failed to estimate billing: %v", err)
 			h.cleanupFailedSubmission(ctx, userID, historyResp.HistoryId, taskID, false, false)
-			writeGRPCError(c, err)
-			return
+			return nil, &submitFailure{err: err}
 		}
 
 		log.Printf("[Download] Step 7/8: Holding initial billing for task %s...", taskID)
@@ -204,8 +273,7 @@ func (h *DownloadHandler) SubmitDownload(c *gin.Context) {
 		if err != nil {
 			log.Printf("[Download] ❌ Failed to hold initial billing: %v", err)
 			h.cleanupFailedSubmission(ctx, userID, historyResp.HistoryId, taskID, false, false)
-			writeGRPCError(c, err)
-			return
+			return nil, &submitFailure{err: err}
 		}
 		log.Printf("[Download] ✓ Billing hold created")
 	} else {
@@ -214,8 +282,7 @@ func (h *DownloadHandler) SubmitDownload(c *gin.Context) {
 		if err != nil {
 			log.Printf("[Download] ❌ Failed to consume quota: %v", err)
 			h.cleanupFailedSubmission(ctx, userID, historyResp.HistoryId, taskID, false, false)
-			writeGRPCError(c, err)
-			return
+			return nil, &submitFailure{err: err}
 		}
 		log.Printf("[Download] ✓ Quota consumed")
 	}
@@ -237,27 +304,55 @@ func (h *DownloadHandler) SubmitDownload(c *gin.Context) {
 		ProxyURL:       parseResp.ProxyUrl,
 		ProxyLeaseID:   parseResp.ProxyLeaseId,
 		ProxyExpireAt:  parseResp.ProxyExpireAt,
+		BatchID:        batchID,
+		VideoID:        parseResp.VideoId,
+		Subtitles:      toSubtitleOptionsMessage(req.Subtitles),
+		PostProcess:    toPostProcessOptionsMessage(req.PostProcess),
+		Clip:           toClipOptionsMessage(req.Clip),
+		AudioLanguage:  req.AudioLanguage,
+		Priority:       priority,
+		PriorityClass:  priorityClass,
 	}
 
 	if err := h.publisher.Publish(ctx, task); err != nil {
 		log.Printf("[Download] ❌ Failed to publish task to RabbitMQ: %v", err)
 		h.cleanupFailedSubmission(ctx, userID, historyResp.HistoryId, taskID, !h.billingEnabled, h.billingEnabled)
 		if status.Code(err) == codes.Unavailable || errors.Is(err, mq.ErrUnavailable) {
-			models.Error(c, http.StatusServiceUnavailable, "service temporarily unavailable")
-			return
+			return nil, &submitFailure{httpStatus: http.StatusServiceUnavailable, message: "service temporarily unavailable"}
 		}
-		writeGRPCError(c, err)
-		return
+		return nil, &submitFailure{err: err}
 	}
 	log.Printf("[Download] ✓ Task %s published to RabbitMQ", taskID)
 
-	estimatedTime := estimateDownloadTime(parseResp.Duration, req.Quality)
-	log.Printf("[Download] ✅ Download request completed successfully - TaskID: %s, EstimatedTime: %ds", taskID, estimatedTime)
-	models.Accepted(c, models.DownloadResponse{
+	return &models.DownloadResponse{
 		TaskID:        taskID,
 		HistoryID:     historyResp.HistoryId,
-		EstimatedTime: estimatedTime,
-	})
+		EstimatedTime: estimateDownloadTime(clipDuration(req.Clip, parseResp.Duration), req.Quality),
+	}, nil
+}
+
+// submissionDedupKey 按规范地址与下载选项计算重复提交检测键，同一视频的不同 URL 写法得到相同的键
+func submissionDedupKey(canonicalURL string, req *models.DownloadRequest) string {
+	options := *req
+	options.URL = ""
+	encoded, _ := json.Marshal(&options)
+
+	hash := sha256.New()
+	hash.Write([]byte(canonicalURL))
+	hash.Write([]byte{0})
+	hash.Write(encoded)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// priorityForRole 按用户角色解析优先级类别与消息优先级（0-255）
+func (h *DownloadHandler) priorityForRole(role string) (string, uint8) {
+	class, priority := h.scheduling.ClassForRole(role)
+	if priority < 0 {
+		priority = 0
+	} else if priority > math.MaxUint8 {
+		priority = math.MaxUint8
+	}
+	return class, uint8(priority)
 }
 
 func normalizeDownloadRequest(req *models.DownloadRequest) {
@@ -283,6 +378,7 @@ func normalizeDownloadRequest(req *models.DownloadRequest) {
 	if req.Quality == "" {
 		req.Quality = "best"
 	}
+	req.AudioLanguage = strings.TrimSpace(req.AudioLanguage)
 }
 
 func toSelectedFormatMessage(selected *models.SelectedFormat) *mq.SelectedFormatMessage {
@@ -306,6 +402,99 @@ func toSelectedFormatMessage(selected *models.SelectedFormat) *mq.SelectedFormat
 	}
 }
 
+func toSubtitleOptionsMessage(opts *models.SubtitleOptions) *mq.SubtitleOptionsMessage {
+	if opts == nil || len(opts.Languages) == 0 {
+		return nil
+	}
+
+	mode := opts.Mode
+	if mode == "" {
+		mode = "sidecar"
+	}
+	format := opts.Format
+	if format == "" {
+		format = "srt"
+	}
+
+	return &mq.SubtitleOptionsMessage{
+		Languages:   append([]string(nil), opts.Languages...),
+		IncludeAuto: opts.IncludeAuto,
+		Mode:        mode,
+		Format:      format,
+	}
+}
+
+func toPostProcessOptionsMessage(opts *models.PostProcessOptions) *mq.PostProcessOptionsMessage {
+	if opts == nil || opts.Profile == "" {
+		return nil
+	}
+
+	return &mq.PostProcessOptionsMessage{
+		Profile:      opts.Profile,
+		AudioBitrate: opts.AudioBitrate,
+	}
+}
+
+// clipDurationTolerance 片段结束时间可超出整秒时长的上限（秒）
+const clipDurationTolerance = 1
+
+// validateClip 根据解析结果校验片段选项，返回空字符串表示通过
+func validateClip(clip *models.ClipOptions, parseResp *pb.ParseURLResponse) string {
+	if clip == nil {
+		return ""
+	}
+	// 解析返回的时长为取整后的整秒，结束时间允许超出 1 秒以容纳小数部分
+	duration := float64(parseResp.GetDuration())
+	if duration > 0 && (clip.StartTime >= duration || clip.EndTime > duration+clipDurationTolerance) {
+		return "clip range exceeds video duration"
+	}
+	if clip.SplitChapters && len(parseResp.GetChapters()) == 0 {
+		return "video has no chapters to split"
+	}
+	return ""
+}
+
+func clipStart(clip *models.ClipOptions) float64 {
+	if clip == nil {
+		return 0
+	}
+	return clip.StartTime
+}
+
+func clipEnd(clip *models.ClipOptions) float64 {
+	if clip == nil {
+		return 0
+	}
+	return clip.EndTime
+}
+
+// clipDuration 返回实际需要下载的时长（秒）
+func clipDuration(clip *models.ClipOptions, duration int64) int64 {
+	if clip == nil || (clip.StartTime <= 0 && clip.EndTime <= 0) {
+		return duration
+	}
+	end := float64(duration)
+	if clip.EndTime > 0 {
+		end = clip.EndTime
+	}
+	if end <= clip.StartTime {
+		return duration
+	}
+	return min(int64(math.Ceil(end-clip.StartTime)), duration)
+}
+
+func toClipOptionsMessage(clip *models.ClipOptions) *mq.ClipOptionsMessage {
+	if clip == nil || (clip.StartTime <= 0 && clip.EndTime <= 0 && !clip.SplitChapters) {
+		return nil
+	}
+
+	return &mq.ClipOptionsMessage{
+		StartTime:     clip.StartTime,
+		EndTime:       clip.EndTime,
+		SplitChapters: clip.SplitChapters,
+	}
+}
+
 func toBillingSelectedFormat(selected *models.SelectedFormat) *pb.BillingSelectedFormat {
 	if selected == nil {
 		return nil
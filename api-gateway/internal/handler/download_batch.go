@@ -0,0 +1,424 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	"youdlp/api-gateway/internal/middleware"
+	"youdlp/api-gateway/internal/models"
+	pb "youdlp/api-gateway/proto"
+)
+
+const (
+	downloadBatchTTL             = 7 * 24 * time.Hour
+	defaultBatchSubmitWorkers    = 3
+	downloadBatchTimeLayout      = "2006-01-02 15:04:05"
+	downloadBatchPersistTimeout  = 5 * time.Second
+	downloadBatchCompletePercent = 100
+	// downloadBatchLease 提交租约时长，持有实例按 1/3 周期续期，过期后由其他实例接管
+	downloadBatchLease = 2 * time.Minute
+	// DownloadBatchSweepInterval 扫描租约过期批次的间隔
+	DownloadBatchSweepInterval = 30 * time.Second
+	downloadBatchSweepLimit    = 20
+)
+
+// BatchDownloadHandler 批量下载处理器
+type BatchDownloadHandler struct {
+	downloads     *DownloadHandler
+	store         downloadBatchStore
+	statusClient  downloaderStatusClient
+	timeout       time.Duration
+	submitWorkers int
+}
+
+type downloaderStatusClient interface {
+	GetTaskStatus(ctx context.Context, in *pb.GetTaskStatusRequest, opts ...grpc.CallOption) (*pb.GetTaskStatusResponse, error)
+}
+
+// NewBatchDownloadHandler 创建批量下载处理器，条目复用单个下载的提交流程
+func NewBatchDownloadHandler(
+	downloads *DownloadHandler,
+	store downloadBatchStore,
+	statusClient downloaderStatusClient,
+	timeout time.Duration,
+) *BatchDownloadHandler {
+	return &BatchDownloadHandler{
+		downloads:     downloads,
+		store:         store,
+		statusClient:  statusClient,
+		timeout:       timeout,
+		submitWorkers: defaultBatchSubmitWorkers,
+	}
+}
+
+// SubmitBatch 提交批量下载：立即返回批次 ID，条目在后台逐个解析、建档、计费并入队
+func (h *BatchDownloadHandler) SubmitBatch(c *gin.Context) {
+	var req models.BatchDownloadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		models.BadRequest(c, "invalid request: "+err.Error())
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		models.Unauthorized(c, "user not authenticated")
+		return
+	}
+
+	if h.store == nil {
+		models.Error(c, http.StatusServiceUnavailable, "service temporarily unavailable")
+		return
+	}
+
+	batch := &downloadBatch{
+		BatchID:       uuid.New().String(),
+		UserID:        userID,
+		UserRole:      middleware.GetUserRole(c),
+		SourceURL:     strings.TrimSpace(req.SourceURL),
+		Title:         strings.TrimSpace(req.Title),
+		Mode:          req.Mode,
+		Quality:       req.Quality,
+		Format:        req.Format,
+		Subtitles:     req.Subtitles,
+		PostProcess:   req.PostProcess,
+		AudioLanguage: req.AudioLanguage,
+		CreatedAt:     time.Now(),
+		Items:         make([]downloadBatchItem, 0, len(req.Entries)),
+	}
+	for i, entry := range req.Entries {
+		batch.Items = append(batch.Items, downloadBatchItem{
+			Index:  i + 1,
+			URL:    strings.TrimSpace(entry.URL),
+			Title:  strings.TrimSpace(entry.Title),
+			Status: batchItemQueued,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+	if err := h.store.Save(ctx, batch, downloadBatchTTL); err != nil {
+		log.Printf("[Batch] ❌ Failed to save batch for user %s: %v", userID, err)
+		models.InternalError(c, "failed to create batch")
+		return
+	}
+	// 批次先落库再登记租约：网关重启后由 sweeper 按存储的批次继续提交未完成的条目
+	if _, err := h.store.Claim(ctx, batch.BatchID, downloadBatchLease); err != nil {
+		log.Printf("[Batch] ❌ Failed to claim batch %s: %v", batch.BatchID, err)
+		models.InternalError(c, "failed to create batch")
+		return
+	}
+
+	log.Printf("[Batch] ✓ Batch %s created - User: %s, Entries: %d", batch.BatchID, userID, len(batch.Items))
+	go h.runBatch(context.WithoutCancel(c.Request.Context()), batch)
+
+	models.Accepted(c, models.BatchDownloadResponse{
+		BatchID: batch.BatchID,
+		Total:   len(batch.Items),
+		Status:  "submitting",
+	})
+}
+
+// runBatch 逐条提交批次中仍处于 queued 的条目，单条失败不影响其他条目；
+// 调用方需已持有批次的提交租约
+func (h *BatchDownloadHandler) runBatch(baseCtx context.Context, batch *downloadBatch) {
+	stopLease := h.keepLease(baseCtx, batch.BatchID)
+	defer stopLease()
+
+	workers := h.submitWorkers
+	if workers <= 0 {
+		workers = 1
+	}
+
+	var mu sync.Mutex
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indexes {
+				mu.Lock()
+				item := batch.Items[idx]
+				mu.Unlock()
+
+				req := models.DownloadRequest{
+					URL:           item.URL,
+					Mode:          batch.Mode,
+					Quality:       batch.Quality,
+					Format:        batch.Format,
+					Subtitles:     batch.Subtitles,
+					PostProcess:   batch.PostProcess,
+					AudioLanguage: batch.AudioLanguage,
+				}
+				normalizeDownloadRequest(&req)
+
+				ctx, cancel := context.WithTimeout(baseCtx, h.timeout)
+				resp, failure := h.downloads.submit(ctx, batch.UserID, batch.UserRole, &req, batch.BatchID)
+				cancel()
+
+				mu.Lock()
+				if failure != nil {
+					log.Printf("[Batch] [%s] ❌ Entry %d rejected: %s", batch.BatchID, item.Index, failure.userMessage())
+					batch.Items[idx].Status = batchItemRejected
+					batch.Items[idx].Error = failure.userMessage()
+				} else {
+					log.Printf("[Batch] [%s] ✓ Entry %d submitted as task %s", batch.BatchID, item.Index, resp.TaskID)
+					batch.Items[idx].Status = batchItemSubmitted
+					batch.Items[idx].TaskID = resp.TaskID
+					batch.Items[idx].HistoryID = resp.HistoryID
+				}
+				h.persistBatch(baseCtx, batch)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for idx := range batch.Items {
+		// 接管的批次中已提交或已拒绝的条目不再重复提交
+		if batch.Items[idx].Status != batchItemQueued {
+			continue
+		}
+		indexes <- idx
+	}
+	close(indexes)
+	wg.Wait()
+
+	ctx, cancel := context.WithTimeout(baseCtx, downloadBatchPersistTimeout)
+	defer cancel()
+	if err := h.store.Finish(ctx, batch.BatchID); err != nil {
+		log.Printf("[Batch] [%s] ⚠ Failed to release batch lease: %v", batch.BatchID, err)
+	}
+
+	log.Printf("[Batch] ✅ Batch %s submission finished", batch.BatchID)
+}
+
+// keepLease 在提交期间周期性续期租约，返回的函数停止续期
+func (h *BatchDownloadHandler) keepLease(baseCtx context.Context, batchID string) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(downloadBatchLease / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(baseCtx, downloadBatchPersistTimeout)
+				if err := h.store.Extend(ctx, batchID, downloadBatchLease); err != nil {
+					log.Printf("[Batch] [%s] ⚠ Failed to extend batch lease: %v", batchID, err)
+				}
+				cancel()
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// RunSweeper 定期接管租约过期的批次（提交实例重启或崩溃），从存储的批次继续提交 queued 条目，
+// ctx 取消后退出。中断时正在提交的条目可能被重新提交，由单任务的重复提交校验兜底
+func (h *BatchDownloadHandler) RunSweeper(ctx context.Context, interval time.Duration) {
+	if h.store == nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, batch := range h.claimExpired(ctx) {
+				go h.runBatch(context.WithoutCancel(ctx), batch)
+			}
+		}
+	}
+}
+
+// claimExpired 获取租约过期批次的提交权，已不存在的批次直接移出待提交集合
+func (h *BatchDownloadHandler) claimExpired(ctx context.Context) []*downloadBatch {
+	ids, err := h.store.Expired(ctx, downloadBatchSweepLimit)
+	if err != nil {
+		log.Printf("[Batch] ⚠ Failed to list expired batches: %v", err)
+		return nil
+	}
+
+	var batches []*downloadBatch
+	for _, batchID := range ids {
+		claimed, err := h.store.Claim(ctx, batchID, downloadBatchLease)
+		if err != nil {
+			log.Printf("[Batch] [%s] ⚠ Failed to claim expired batch: %v", batchID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		batch, err := h.store.Load(ctx, batchID)
+		if errors.Is(err, errDownloadBatchNotFound) {
+			if err := h.store.Finish(ctx, batchID); err != nil {
+				log.Printf("[Batch] [%s] ⚠ Failed to drop missing batch: %v", batchID, err)
+			}
+			continue
+		}
+		if err != nil {
+			log.Printf("[Batch] [%s] ⚠ Failed to load expired batch: %v", batchID, err)
+			continue
+		}
+
+		log.Printf("[Batch] [%s] ✓ Resuming interrupted batch submission", batchID)
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+func (h *BatchDownloadHandler) persistBatch(baseCtx context.Context, batch *downloadBatch) {
+	ctx, cancel := context.WithTimeout(baseCtx, downloadBatchPersistTimeout)
+	defer cancel()
+	if err := h.store.Save(ctx, batch, downloadBatchTTL); err != nil {
+		log.Printf("[Batch] [%s] ⚠ Failed to persist batch: %v", batch.BatchID, err)
+	}
+}
+
+// GetBatch 查询批量下载聚合进度
+func (h *BatchDownloadHandler) GetBatch(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		models.Unauthorized(c, "user not authenticated")
+		return
+	}
+
+	if h.store == nil {
+		models.Error(c, http.StatusServiceUnavailable, "service temporarily unavailable")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	batch, err := h.store.Load(ctx, c.Param("batchId"))
+	if err != nil {
+		if errors.Is(err, errDownloadBatchNotFound) {
+			models.NotFound(c, "batch not found")
+			return
+		}
+		log.Printf("[Batch] ❌ Failed to load batch: %v", err)
+		models.InternalError(c, "failed to load batch")
+		return
+	}
+	if batch.UserID != userID {
+		models.NotFound(c, "batch not found")
+		return
+	}
+
+	models.Success(c, h.aggregate(ctx, batch))
+}
+
+// aggregate 合并批次条目的提交状态与下载任务实时状态
+func (h *BatchDownloadHandler) aggregate(ctx context.Context, batch *downloadBatch) models.BatchStatusResponse {
+	resp := models.BatchStatusResponse{
+		BatchID:   batch.BatchID,
+		SourceURL: batch.SourceURL,
+		Title:     batch.Title,
+		Total:     len(batch.Items),
+		CreatedAt: batch.CreatedAt.Format(downloadBatchTimeLayout),
+		Items:     make([]models.BatchStatusItem, 0, len(batch.Items)),
+	}
+
+	var percentSum float64
+	for _, item := range batch.Items {
+		statusItem := models.BatchStatusItem{
+			Index:     item.Index,
+			URL:       item.URL,
+			Title:     item.Title,
+			TaskID:    item.TaskID,
+			HistoryID: item.HistoryID,
+			Error:     item.Error,
+		}
+
+		switch item.Status {
+		case batchItemQueued:
+			statusItem.Status = "queued"
+			resp.Queued++
+		case batchItemRejected:
+			statusItem.Status = "rejected"
+			statusItem.Percent = downloadBatchCompletePercent
+			resp.Rejected++
+		default:
+			h.fillTaskStatus(ctx, &statusItem)
+			switch statusItem.Status {
+			case "completed":
+				resp.Completed++
+			case "failed":
+				resp.Failed++
+			case "downloading":
+				resp.Downloading++
+			default:
+				resp.Pending++
+			}
+		}
+
+		percentSum += statusItem.Percent
+		resp.Items = append(resp.Items, statusItem)
+	}
+
+	if resp.Total > 0 {
+		resp.Percent = percentSum / float64(resp.Total)
+	}
+	resp.Status = batchOverallStatus(&resp)
+	return resp
+}
+
+func (h *BatchDownloadHandler) fillTaskStatus(ctx context.Context, item *models.BatchStatusItem) {
+	item.Status = "pending"
+	if h.statusClient == nil || item.TaskID == "" {
+		return
+	}
+
+	taskStatus, err := h.statusClient.GetTaskStatus(ctx, &pb.GetTaskStatusRequest{TaskId: item.TaskID})
+	if err != nil {
+		log.Printf("[Batch] ⚠ Failed to get status for task %s: %v", item.TaskID, err)
+		return
+	}
+
+	// 0:待处理, 1:下载中, 2:完成, 3:失败, 4:待清理, 5:已过期
+	switch taskStatus.Status {
+	case 1:
+		item.Status = "downloading"
+		item.Percent = taskStatus.Percent
+	case 2, 4, 5:
+		item.Status = "completed"
+		item.Percent = downloadBatchCompletePercent
+	case 3:
+		item.Status = "failed"
+		item.Percent = downloadBatchCompletePercent
+		item.Error = taskStatus.ErrorMessage
+	default:
+		item.Percent = taskStatus.Percent
+		item.QueuePosition = taskStatus.QueuePosition
+	}
+}
+
+func batchOverallStatus(resp *models.BatchStatusResponse) string {
+	switch {
+	case resp.Queued > 0:
+		return "submitting"
+	case resp.Pending+resp.Downloading > 0:
+		return "running"
+	case resp.Failed+resp.Rejected == 0:
+		return "completed"
+	case resp.Completed == 0:
+		return "failed"
+	default:
+		return "partial_failed"
+	}
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"youdlp/api-gateway/internal/models"
+)
+
+const (
+	downloadBatchPrefix = "download:batch:"
+	// downloadBatchPendingKey 尚未提交完毕的批次，score 为提交租约的到期时间（毫秒）
+	downloadBatchPendingKey = "download:batch:pending"
+)
+
+var errDownloadBatchNotFound = errors.New("download batch not found")
+
+// 批量条目提交状态
+const (
+	batchItemQueued    = "queued"    // 等待提交
+	batchItemSubmitted = "submitted" // 已生成下载任务
+	batchItemRejected  = "rejected"  // 提交失败（解析失败、余额不足等）
+)
+
+type downloadBatch struct {
+	BatchID       string                     `json:"batch_id"`
+	UserID        string                     `json:"user_id"`
+	UserRole      string                     `json:"user_role,omitempty"`
+	SourceURL     string                     `json:"source_url,omitempty"`
+	Title         string                     `json:"title,omitempty"`
+	Mode          string                     `json:"mode"`
+	Quality       string                     `json:"quality"`
+	Format        string                     `json:"format"`
+	Subtitles     *models.SubtitleOptions    `json:"subtitles,omitempty"`
+	PostProcess   *models.PostProcessOptions `json:"post_process,omitempty"`
+	AudioLanguage string                     `json:"audio_language,omitempty"`
+	CreatedAt     time.Time                  `json:"created_at"`
+	Items         []downloadBatchItem        `json:"items"`
+}
+
+type downloadBatchItem struct {
+	Index     int    `json:"index"`
+	URL       string `json:"url"`
+	Title     string `json:"title,omitempty"`
+	Status    string `json:"status"`
+	TaskID    string `json:"task_id,omitempty"`
+	HistoryID int64  `json:"history_id,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+type downloadBatchStore interface {
+	Save(ctx context.Context, batch *downloadBatch, ttl time.Duration) error
+	Load(ctx context.Context, batchID string) (*downloadBatch, error)
+	// Claim 获取批次的提交租约，其他实例持有未过期租约时返回 false
+	Claim(ctx context.Context, batchID string, lease time.Duration) (bool, error)
+	// Extend 续期提交租约
+	Extend(ctx context.Context, batchID string, lease time.Duration) error
+	// Finish 批次条目全部提交完毕，移出待提交集合
+	Finish(ctx context.Context, batchID string) error
+	// Expired 返回租约已过期（提交实例退出或崩溃）的批次
+	Expired(ctx context.Context, limit int64) ([]string, error)
+}
+
+// claimDownloadBatchScript 租约不存在或已过期时才写入新的到期时间
+var claimDownloadBatchScript = redis.NewScript(`
+local deadline = redis.call("ZSCORE", KEYS[1], ARGV[1])
+if deadline and tonumber(deadline) > tonumber(ARGV[2]) then
+	return 0
+end
+redis.call("ZADD", KEYS[1], ARGV[3], ARGV[1])
+return 1
+`)
+
+type redisDownloadBatchStore struct {
+	client *redis.Client
+}
+
+func NewRedisDownloadBatchStore(client *redis.Client) downloadBatchStore {
+	if client == nil {
+		return nil
+	}
+	return &redisDownloadBatchStore{client: client}
+}
+
+func (s *redisDownloadBatchStore) Save(ctx context.Context, batch *downloadBatch, ttl time.Duration) error {
+	if s == nil || s.client == nil {
+		return errors.New("download batch store unavailable")
+	}
+
+	data, err := json.Marshal(batch)
+	if err != nil {
+		return fmt.Errorf("marshal download batch: %w", err)
+	}
+
+	if err := s.client.Set(ctx, downloadBatchPrefix+batch.BatchID, data, ttl).Err(); err != nil {
+		return fmt.Errorf("save download batch: %w", err)
+	}
+
+	return nil
+}
+
+func (s *redisDownloadBatchStore) Load(ctx context.Context, batchID string) (*downloadBatch, error) {
+	if s == nil || s.client == nil {
+		return nil, errors.New("download batch store unavailable")
+	}
+
+	data, err := s.client.Get(ctx, downloadBatchPrefix+batchID).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, errDownloadBatchNotFound
+		}
+		return nil, fmt.Errorf("load download batch: %w", err)
+	}
+
+	var batch downloadBatch
+	if err := json.Unmarshal(data, &batch); err != nil {
+		return nil, fmt.Errorf("unmarshal download batch: %w", err)
+	}
+
+	return &batch, nil
+}
+
+func (s *redisDownloadBatchStore) Claim(ctx context.Context, batchID string, lease time.Duration) (bool, error) {
+	if s == nil || s.client == nil {
+		return false, errors.New("download batch store unavailable")
+	}
+
+	now := time.Now()
+	claimed, err := claimDownloadBatchScript.Run(ctx, s.client, []string{downloadBatchPendingKey},
+		batchID, now.UnixMilli(), now.Add(lease).UnixMilli()).Int()
+	if err != nil {
+		return false, fmt.Errorf("claim download batch: %w", err)
+	}
+	return claimed == 1, nil
+}
+
+func (s *redisDownloadBatchStore) Extend(ctx context.Context, batchID string, lease time.Duration) error {
+	if s == nil || s.client == nil {
+		return errors.New("download batch store unavailable")
+	}
+
+	err := s.client.ZAddXX(ctx, downloadBatchPendingKey, redis.Z{
+		Score:  float64(time.Now().Add(lease).UnixMilli()),
+		Member: batchID,
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("extend download batch lease: %w", err)
+	}
+	return nil
+}
+
+func (s *redisDownloadBatchStore) Finish(ctx context.Context, batchID string) error {
+	if s == nil || s.client == nil {
+		return errors.New("download batch store unavailable")
+	}
+
+	if err := s.client.ZRem(ctx, downloadBatchPendingKey, batchID).Err(); err != nil {
+		return fmt.Errorf("finish download batch: %w", err)
+	}
+	return nil
+}
+
+func (s *redisDownloadBatchStore) Expired(ctx context.Context, limit int64) ([]string, error) {
+	if s == nil || s.client == nil {
+		return nil, errors.New("download batch store unavailable")
+	}
+
+	ids, err := s.client.ZRangeByScore(ctx, downloadBatchPendingKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(time.Now().UnixMilli(), 10),
+		Count: limit,
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("list expired download batches: %w", err)
+	}
+	return ids, nil
+}
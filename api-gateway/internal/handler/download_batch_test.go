@@ -0,0 +1,244 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "youdlp/api-gateway/proto"
+)
+
+type memoryDownloadBatchStore struct {
+	batches map[string]downloadBatch
+	leases  map[string]time.Time
+}
+
+func (s *memoryDownloadBatchStore) Save(_ context.Context, batch *downloadBatch, _ time.Duration) error {
+	if s.batches == nil {
+		s.batches = make(map[string]downloadBatch)
+	}
+	cloned := *batch
+	cloned.Items = append([]downloadBatchItem(nil), batch.Items...)
+	s.batches[batch.BatchID] = cloned
+	return nil
+}
+
+func (s *memoryDownloadBatchStore) Load(_ context.Context, batchID string) (*downloadBatch, error) {
+	batch, ok := s.batches[batchID]
+	if !ok {
+		return nil, errDownloadBatchNotFound
+	}
+	return &batch, nil
+}
+
+func (s *memoryDownloadBatchStore) Claim(_ context.Context, batchID string, lease time.Duration) (bool, error) {
+	if s.leases == nil {
+		s.leases = make(map[string]time.Time)
+	}
+	if deadline, ok := s.leases[batchID]; ok && deadline.After(time.Now()) {
+		return false, nil
+	}
+	s.leases[batchID] = time.Now().Add(lease)
+	return true, nil
+}
+
+func (s *memoryDownloadBatchStore) Extend(_ context.Context, batchID string, lease time.Duration) error {
+	if _, ok := s.leases[batchID]; ok {
+		s.leases[batchID] = time.Now().Add(lease)
+	}
+	return nil
+}
+
+func (s *memoryDownloadBatchStore) Finish(_ context.Context, batchID string) error {
+	delete(s.leases, batchID)
+	return nil
+}
+
+func (s *memoryDownloadBatchStore) Expired(_ context.Context, _ int64) ([]string, error) {
+	var ids []string
+	for batchID, deadline := range s.leases {
+		if !deadline.After(time.Now()) {
+			ids = append(ids, batchID)
+		}
+	}
+	return ids, nil
+}
+
+type unavailableURLMediaClient struct {
+	fakeMediaDownloadClient
+	unavailableURL string
+}
+
+func (f *unavailableURLMediaClient) ParseURL(ctx context.Context, in *pb.ParseURLRequest, opts ...grpc.CallOption) (*pb.ParseURLResponse, error) {
+	if in.Url == f.unavailableURL {
+		return nil, status.Error(codes.NotFound, "video not found")
+	}
+	return f.fakeMediaDownloadClient.ParseURL(ctx, in, opts...)
+}
+
+type fakeDownloaderStatusClient struct {
+	statuses map[string]*pb.GetTaskStatusResponse
+}
+
+func (f *fakeDownloaderStatusClient) GetTaskStatus(_ context.Context, in *pb.GetTaskStatusRequest, _ ...grpc.CallOption) (*pb.GetTaskStatusResponse, error) {
+	if resp, ok := f.statuses[in.TaskId]; ok {
+		return resp, nil
+	}
+	return &pb.GetTaskStatusResponse{TaskId: in.TaskId}, nil
+}
+
+func TestRunBatchAllowsPartialFailure(t *testing.T) {
+	t.Parallel()
+
+	downloads, _, publisher := newTestDownloadHandler()
+	downloads.mediaClient = &unavailableURLMediaClient{
+		fakeMediaDownloadClient: *downloads.mediaClient.(*fakeMediaDownloadClient),
+		unavailableURL:          "https://example.com/missing",
+	}
+	store := &memoryDownloadBatchStore{}
+	handler := NewBatchDownloadHandler(downloads, store, nil, time.Second)
+	handler.submitWorkers = 1
+
+	batch := &downloadBatch{
+		BatchID: "batch-1",
+		UserID:  "user-1",
+		Mode:    "archive",
+		Quality: "720p",
+		Items: []downloadBatchItem{
+			{Index: 1, URL: "https://example.com/a", Status: batchItemQueued},
+			{Index: 2, URL: "https://example.com/missing", Status: batchItemQueued},
+			{Index: 3, URL: "https://example.com/c", Status: batchItemQueued},
+		},
+	}
+
+	handler.runBatch(context.Background(), batch)
+
+	if len(publisher.tasks) != 2 {
+		t.Fatalf("expected two published tasks, got %d", len(publisher.tasks))
+	}
+	for _, task := range publisher.tasks {
+		if task.BatchID != "batch-1" {
+			t.Fatalf("expected batch id on task, got %q", task.BatchID)
+		}
+		if task.Quality != "720p" || task.Format != "mp4" {
+			t.Fatalf("expected batch quality/format defaults, got %q/%q", task.Quality, task.Format)
+		}
+	}
+
+	saved, err := store.Load(context.Background(), "batch-1")
+	if err != nil {
+		t.Fatalf("expected batch to be persisted: %v", err)
+	}
+	if saved.Items[0].Status != batchItemSubmitted || saved.Items[0].TaskID == "" {
+		t.Fatalf("expected first entry submitted, got %+v", saved.Items[0])
+	}
+	if saved.Items[1].Status != batchItemRejected || saved.Items[1].Error != "video not found" {
+		t.Fatalf("expected second entry rejected, got %+v", saved.Items[1])
+	}
+	if saved.Items[2].Status != batchItemSubmitted {
+		t.Fatalf("expected third entry submitted, got %+v", saved.Items[2])
+	}
+}
+
+func TestSweeperResumesQueuedItemsOfExpiredBatch(t *testing.T) {
+	t.Parallel()
+
+	downloads, _, publisher := newTestDownloadHandler()
+	store := &memoryDownloadBatchStore{}
+	handler := NewBatchDownloadHandler(downloads, store, nil, time.Second)
+	handler.submitWorkers = 1
+
+	ctx := context.Background()
+	// 提交实例在第一条提交后退出：批次仍在存储中，租约已过期
+	_ = store.Save(ctx, &downloadBatch{
+		BatchID: "batch-1",
+		UserID:  "user-1",
+		Mode:    "archive",
+		Quality: "720p",
+		Items: []downloadBatchItem{
+			{Index: 1, URL: "https://example.com/a", Status: batchItemSubmitted, TaskID: "task-a"},
+			{Index: 2, URL: "https://example.com/b", Status: batchItemQueued},
+		},
+	}, downloadBatchTTL)
+	store.leases = map[string]time.Time{"batch-1": time.Now().Add(-time.Second)}
+	// 仍由其他实例持有租约的批次不能被接管
+	_ = store.Save(ctx, &downloadBatch{BatchID: "batch-2", UserID: "user-1"}, downloadBatchTTL)
+	store.leases["batch-2"] = time.Now().Add(time.Minute)
+
+	batches := handler.claimExpired(ctx)
+	if len(batches) != 1 || batches[0].BatchID != "batch-1" {
+		t.Fatalf("expected only batch-1 to be claimed, got %+v", batches)
+	}
+	handler.runBatch(ctx, batches[0])
+
+	if len(publisher.tasks) != 1 {
+		t.Fatalf("expected only the queued entry to be submitted, got %d tasks", len(publisher.tasks))
+	}
+	saved, _ := store.Load(ctx, "batch-1")
+	if saved.Items[0].TaskID != "task-a" {
+		t.Fatalf("expected submitted entry to be kept, got %+v", saved.Items[0])
+	}
+	if saved.Items[1].Status != batchItemSubmitted || saved.Items[1].TaskID == "" {
+		t.Fatalf("expected queued entry to be submitted, got %+v", saved.Items[1])
+	}
+	if _, pending := store.leases["batch-1"]; pending {
+		t.Fatal("expected finished batch to leave the pending set")
+	}
+}
+
+func TestAggregateBatchReportsProgress(t *testing.T) {
+	t.Parallel()
+
+	statusClient := &fakeDownloaderStatusClient{statuses: map[string]*pb.GetTaskStatusResponse{
+		"task-1": {TaskId: "task-1", Status: 2},
+		"task-2": {TaskId: "task-2", Status: 1, Percent: 50},
+	}}
+	handler := NewBatchDownloadHandler(nil, &memoryDownloadBatchStore{}, statusClient, time.Second)
+
+	resp := handler.aggregate(context.Background(), &downloadBatch{
+		BatchID: "batch-1",
+		Items: []downloadBatchItem{
+			{Index: 1, Status: batchItemSubmitted, TaskID: "task-1"},
+			{Index: 2, Status: batchItemSubmitted, TaskID: "task-2"},
+			{Index: 3, Status: batchItemRejected, Error: "video not found"},
+			{Index: 4, Status: batchItemSubmitted, TaskID: "task-4"},
+		},
+	})
+
+	if resp.Completed != 1 || resp.Downloading != 1 || resp.Rejected != 1 || resp.Pending != 1 {
+		t.Fatalf("unexpected counters: %+v", resp)
+	}
+	if resp.Status != "running" {
+		t.Fatalf("expected running batch, got %q", resp.Status)
+	}
+	if resp.Percent != 62.5 {
+		t.Fatalf("expected 62.5%% overall progress, got %v", resp.Percent)
+	}
+}
+
+func TestBatchOverallStatusPartialFailure(t *testing.T) {
+	t.Parallel()
+
+	handler := NewBatchDownloadHandler(nil, &memoryDownloadBatchStore{}, &fakeDownloaderStatusClient{statuses: map[string]*pb.GetTaskStatusResponse{
+		"task-1": {TaskId: "task-1", Status: 2},
+		"task-2": {TaskId: "task-2", Status: 3, ErrorMessage: "yt-dlp failed"},
+	}}, time.Second)
+
+	resp := handler.aggregate(context.Background(), &downloadBatch{
+		Items: []downloadBatchItem{
+			{Index: 1, Status: batchItemSubmitted, TaskID: "task-1"},
+			{Index: 2, Status: batchItemSubmitted, TaskID: "task-2"},
+		},
+	})
+
+	if resp.Status != "partial_failed" {
+		t.Fatalf("expected partial_failed, got %q", resp.Status)
+	}
+	if resp.Items[1].Error != "yt-dlp failed" {
+		t.Fatalf("expected task error to be surfaced, got %q", resp.Items[1].Error)
+	}
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+
+	"youdlp/api-gateway/internal/middleware"
+	"youdlp/api-gateway/internal/models"
+	pb "youdlp/api-gateway/proto"
+)
+
+// DownloadTaskHandler 下载任务管理处理器
+type DownloadTaskHandler struct {
+	downloaderClient downloaderTaskClient
+	timeout          time.Duration
+}
+
+type downloaderTaskClient interface {
+	CancelTask(ctx context.Context, in *pb.CancelTaskRequest, opts ...grpc.CallOption) (*pb.CancelTaskResponse, error)
+	GetTaskStatus(ctx context.Context, in *pb.GetTaskStatusRequest, opts ...grpc.CallOption) (*pb.GetTaskStatusResponse, error)
+}
+
+// NewDownloadTaskHandler 创建下载任务管理处理器
+func NewDownloadTaskHandler(downloaderClient downloaderTaskClient, timeout time.Duration) *DownloadTaskHandler {
+	return &DownloadTaskHandler{
+		downloaderClient: downloaderClient,
+		timeout:          timeout,
+	}
+}
+
+// CancelTask 取消下载任务
+func (h *DownloadTaskHandler) CancelTask(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		models.Unauthorized(c, "user not authenticated")
+		return
+	}
+
+	taskID := strings.TrimSpace(c.Param("taskId"))
+	if taskID == "" {
+		models.BadRequest(c, "task_id is required")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := h.downloaderClient.CancelTask(ctx, &pb.CancelTaskRequest{
+		TaskId: taskID,
+		UserId: userID,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	if !resp.Success {
+		models.Conflict(c, resp.Message)
+		return
+	}
+
+	models.Success(c, models.CancelDownloadResponse{
+		TaskID:    taskID,
+		Cancelled: true,
+		Message:   resp.Message,
+	})
+}
+
+// GetTaskStatus 查询下载任务状态，待处理任务附带排队位置与预计开始时间
+func (h *DownloadTaskHandler) GetTaskStatus(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		models.Unauthorized(c, "user not authenticated")
+		return
+	}
+
+	taskID := strings.TrimSpace(c.Param("taskId"))
+	if taskID == "" {
+		models.BadRequest(c, "task_id is required")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := h.downloaderClient.GetTaskStatus(ctx, &pb.GetTaskStatusRequest{
+		TaskId: taskID,
+		UserId: userID,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	result := models.TaskStatusResponse{
+		TaskID:                resp.TaskId,
+		Status:                resp.Status,
+		StatusText:            resp.StatusText,
+		Percent:               resp.Percent,
+		DownloadedBytes:       resp.DownloadedBytes,
+		TotalBytes:            resp.TotalBytes,
+		Speed:                 resp.Speed,
+		ETA:                   resp.Eta,
+		ErrorMessage:          resp.ErrorMessage,
+		CreatedAt:             resp.CreatedAt,
+		CompletedAt:           resp.CompletedAt,
+		QueuePosition:         resp.QueuePosition,
+		EstimatedStartSeconds: resp.EstimatedStartSeconds,
+	}
+	if resp.EstimatedStartSeconds > 0 {
+		result.EstimatedStartAt = time.Now().Add(time.Duration(resp.EstimatedStartSeconds) * time.Second).Format(time.RFC3339)
+	}
+	models.Success(c, result)
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "youdlp/api-gateway/proto"
+)
+
+type fakeDownloaderTaskClient struct {
+	resp       *pb.CancelTaskResponse
+	err        error
+	req        *pb.CancelTaskRequest
+	statusResp *pb.GetTaskStatusResponse
+	statusReq  *pb.GetTaskStatusRequest
+}
+
+func (f *fakeDownloaderTaskClient) CancelTask(_ context.Context, in *pb.CancelTaskRequest, _ ...grpc.CallOption) (*pb.CancelTaskResponse, error) {
+	f.req = in
+	return f.resp, f.err
+}
+
+func (f *fakeDownloaderTaskClient) GetTaskStatus(_ context.Context, in *pb.GetTaskStatusRequest, _ ...grpc.CallOption) (*pb.GetTaskStatusResponse, error) {
+	f.statusReq = in
+	return f.statusResp, f.err
+}
+
+func TestCancelTaskPassesUserAndTask(t *testing.T) {
+	client := &fakeDownloaderTaskClient{resp: &pb.CancelTaskResponse{Success: true, Message: "任务已取消"}}
+
+	w := performCancelTask(t, NewDownloadTaskHandler(client, time.Second), "task-1")
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if client.req == nil || client.req.TaskId != "task-1" || client.req.UserId != "user-1" {
+		t.Fatalf("unexpected cancel request: %+v", client.req)
+	}
+}
+
+func TestCancelTaskReturnsConflictForFinishedTask(t *testing.T) {
+	client := &fakeDownloaderTaskClient{resp: &pb.CancelTaskResponse{Success: false, Message: "任务已结束，无法取消"}}
+
+	w := performCancelTask(t, NewDownloadTaskHandler(client, time.Second), "task-1")
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected 409, got %d", w.Code)
+	}
+}
+
+func TestCancelTaskMapsPermissionDenied(t *testing.T) {
+	client := &fakeDownloaderTaskClient{err: status.Error(codes.PermissionDenied, "task does not belong to user")}
+
+	w := performCancelTask(t, NewDownloadTaskHandler(client, time.Second), "task-1")
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected 403, got %d", w.Code)
+	}
+}
+
+func performCancelTask(t *testing.T, handler *DownloadTaskHandler, taskID string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/download/"+taskID+"/cancel", nil)
+	w := httptest.NewRecorder()
+
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{{Key: "taskId", Value: taskID}}
+	c.Set("user_id", "user-1")
+
+	handler.CancelTask(c)
+	return w
+}
+
+func TestGetTaskStatusReturnsQueuePosition(t *testing.T) {
+	client := &fakeDownloaderTaskClient{statusResp: &pb.GetTaskStatusResponse{
+		TaskId:                "task-1",
+		StatusText:            "pending",
+		QueuePosition:         12,
+		EstimatedStartSeconds: 90,
+	}}
+	handler := NewDownloadTaskHandler(client, time.Second)
+
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/download/task-1/status", nil)
+	c.Params = gin.Params{{Key: "taskId", Value: "task-1"}}
+	c.Set("user_id", "user-1")
+
+	handler.GetTaskStatus(c)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if client.statusReq == nil || client.statusReq.UserId != "user-1" {
+		t.Fatalf("expected ownership check by user, got %+v", client.statusReq)
+	}
+	body := w.Body.String()
+	if !strings.Contains(body, `"queue_position":12`) || !strings.Contains(body, `"estimated_start_at"`) {
+		t.Fatalf("expected queue position and estimate in response, got %s", body)
+	}
+}
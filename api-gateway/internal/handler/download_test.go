@@ -13,6 +13,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc"
 
+	"youdlp/api-gateway/internal/config"
 	"youdlp/api-gateway/internal/models"
 	"youdlp/api-gateway/internal/mq"
 	pb "youdlp/api-gateway/proto"
@@ -38,8 +39,10 @@ type fakeAssetDownloadClient struct {
 	releaseProxyResp  *pb.ReleaseProxyForTaskResponse
 	releaseProxyErr   error
 
+	historyRequests   []*pb.CreateHistoryRequest
 	refundCalls       []string
 	deleteCalls       []int64
+	estimateCalls     []*pb.EstimateDownloadBillingRequest
 	releaseCalls      []string
 	releaseProxyCalls []string
 }
@@ -48,7 +51,8 @@ func (f *fakeAssetDownloadClient) CheckQuota(context.Context, *pb.CheckQuotaRequ
 	return f.checkQuotaResp, f.checkQuotaErr
 }
 
-func (f *fakeAssetDownloadClient) CreateHistory(_ context.Context, _ *pb.CreateHistoryRequest, _ ...grpc.CallOption) (*pb.CreateHistoryResponse, error) {
+func (f *fakeAssetDownloadClient) CreateHistory(_ context.Context, req *pb.CreateHistoryRequest, _ ...grpc.CallOption) (*pb.CreateHistoryResponse, error) {
+	f.historyRequests = append(f.historyRequests, req)
 	return f.createHistoryResp, f.createHistoryErr
 }
 
@@ -66,7 +70,8 @@ func (f *fakeAssetDownloadClient) DeleteHistory(_ context.Context, req *pb.Delet
 	return f.deleteHistoryResp, f.deleteHistoryErr
 }
 
-func (f *fakeAssetDownloadClient) EstimateDownloadBilling(context.Context, *pb.EstimateDownloadBillingRequest, ...grpc.CallOption) (*pb.EstimateDownloadBillingResponse, error) {
+func (f *fakeAssetDownloadClient) EstimateDownloadBilling(_ context.Context, req *pb.EstimateDownloadBillingRequest, _ ...grpc.CallOption) (*pb.EstimateDownloadBillingResponse, error) {
+	f.estimateCalls = append(f.estimateCalls, req)
 	return f.estimateResp, f.estimateErr
 }
 
@@ -193,7 +198,7 @@ func TestSubmitDownloadReturnsServiceUnavailableWhenPublisherIsTypedNil(t *testi
 	}
 
 	var publisher *mq.Publisher
-	handler := NewDownloadHandler(assetClient, mediaClient, publisher, time.Second, false)
+	handler := NewDownloadHandler(assetClient, mediaClient, publisher, time.Second, false, nil)
 
 	w := performSubmitDownload(t, handler)
 
@@ -254,6 +259,236 @@ func TestSubmitDownloadAcceptedWithoutCompensation(t *testing.T) {
 	}
 }
 
+func TestSubmitDownloadDedupsOnCanonicalURLAndRejectsDuplicates(t *testing.T) {
+	t.Parallel()
+
+	handler, assetClient, publisher := newTestDownloadHandler()
+	handler.mediaClient.(*fakeMediaDownloadClient).validateResp = &pb.ValidateURLResponse{
+		Valid:        true,
+		Platform:     "youtube",
+		CanonicalUrl: "https://www.youtube.com/watch?v=dQw4w9WgXcQ",
+	}
+
+	if w := performSubmitDownload(t, handler); w.Code != http.StatusAccepted {
+		t.Fatalf("expected status 202, got %d", w.Code)
+	}
+	first := assetClient.historyRequests[0]
+	if first.GetUrl() != "https://example.com/video" || first.GetDedupKey() == "" {
+		t.Fatalf("expected history to keep the submitted url and set a dedup key, got %+v", first)
+	}
+
+	assetClient.createHistoryResp = &pb.CreateHistoryResponse{HistoryId: 55, Duplicate: true, TaskId: "task-existing"}
+	w := performSubmitDownload(t, handler)
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected status 409, got %d", w.Code)
+	}
+	if assetClient.historyRequests[1].GetDedupKey() != first.GetDedupKey() {
+		t.Fatal("expected identical submissions to share a dedup key")
+	}
+	if len(publisher.tasks) != 1 {
+		t.Fatalf("expected duplicate not to be published, got %d tasks", len(publisher.tasks))
+	}
+	if len(assetClient.releaseProxyCalls) != 1 {
+		t.Fatalf("expected proxy binding of the rejected task to be released, got %v", assetClient.releaseProxyCalls)
+	}
+
+	data := decodeResponseDataAsMap(t, w)
+	if data["task_id"] != "task-existing" {
+		t.Fatalf("expected existing task in response, got %v", data)
+	}
+}
+
+func TestSubmissionDedupKeyDependsOnOptions(t *testing.T) {
+	t.Parallel()
+
+	canonical := "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+	base := models.DownloadRequest{URL: "https://youtu.be/dQw4w9WgXcQ", Mode: "archive", Quality: "1080p"}
+	variant := base
+	variant.URL = "https://m.youtube.com/watch?v=dQw4w9WgXcQ&t=30"
+	audio := base
+	audio.Quality = "160kbps"
+
+	if submissionDedupKey(canonical, &base) != submissionDedupKey(canonical, &variant) {
+		t.Fatal("expected URL variants of the same video to share a dedup key")
+	}
+	if submissionDedupKey(canonical, &base) == submissionDedupKey(canonical, &audio) {
+		t.Fatal("expected different download options to produce different keys")
+	}
+}
+
+func TestSubmitDownloadForwardsSubtitleOptions(t *testing.T) {
+	t.Parallel()
+
+	handler, _, publisher := newTestDownloadHandler()
+
+	req := &models.DownloadRequest{
+		URL:       "https://example.com/video",
+		Mode:      "archive",
+		Subtitles: &models.SubtitleOptions{Languages: []string{"en", "zh-Hans"}, IncludeAuto: true},
+	}
+	normalizeDownloadRequest(req)
+	if _, failure := handler.submit(context.Background(), "user-1", "1", req, ""); failure != nil {
+		t.Fatalf("unexpected failure: %s", failure.userMessage())
+	}
+
+	if len(publisher.tasks) != 1 || publisher.tasks[0].Subtitles == nil {
+		t.Fatalf("expected subtitle options to be forwarded, got %+v", publisher.tasks)
+	}
+	subtitles := publisher.tasks[0].Subtitles
+	if len(subtitles.Languages) != 2 || !subtitles.IncludeAuto {
+		t.Fatalf("unexpected subtitle options: %+v", subtitles)
+	}
+	if subtitles.Mode != "sidecar" || subtitles.Format != "srt" {
+		t.Fatalf("expected sidecar srt defaults, got %q/%q", subtitles.Mode, subtitles.Format)
+	}
+}
+
+func TestSubmitDownloadForwardsAudioLanguage(t *testing.T) {
+	t.Parallel()
+
+	handler, _, publisher := newTestDownloadHandler()
+
+	req := &models.DownloadRequest{
+		URL:           "https://example.com/video",
+		Mode:          "archive",
+		AudioLanguage: " es ",
+	}
+	normalizeDownloadRequest(req)
+	if _, failure := handler.submit(context.Background(), "user-1", "1", req, ""); failure != nil {
+		t.Fatalf("unexpected failure: %s", failure.userMessage())
+	}
+
+	if len(publisher.tasks) != 1 || publisher.tasks[0].AudioLanguage != "es" {
+		t.Fatalf("expected audio language to be forwarded, got %+v", publisher.tasks)
+	}
+}
+
+func TestSubmitDownloadForwardsPostProcessOptions(t *testing.T) {
+	t.Parallel()
+
+	handler, _, publisher := newTestDownloadHandler()
+
+	req := &models.DownloadRequest{
+		URL:         "https://example.com/video",
+		Mode:        "archive",
+		PostProcess: &models.PostProcessOptions{Profile: "audio_mp3", AudioBitrate: 256},
+	}
+	normalizeDownloadRequest(req)
+	if _, failure := handler.submit(context.Background(), "user-1", "1", req, ""); failure != nil {
+		t.Fatalf("unexpected failure: %s", failure.userMessage())
+	}
+
+	if len(publisher.tasks) != 1 || publisher.tasks[0].PostProcess == nil {
+		t.Fatalf("expected post-process options to be forwarded, got %+v", publisher.tasks)
+	}
+	if got := publisher.tasks[0].PostProcess; got.Profile != "audio_mp3" || got.AudioBitrate != 256 {
+		t.Fatalf("unexpected post-process options: %+v", got)
+	}
+}
+
+func TestSubmitDownloadForwardsClipAndProratesEstimate(t *testing.T) {
+	t.Parallel()
+
+	handler, assetClient, publisher := newTestDownloadHandler()
+	handler.billingEnabled = true
+
+	req := &models.DownloadRequest{
+		URL:  "https://example.com/video",
+		Mode: "quick_download",
+		Clip: &models.ClipOptions{StartTime: 30, EndTime: 90},
+	}
+	normalizeDownloadRequest(req)
+	if _, failure := handler.submit(context.Background(), "user-1", "1", req, ""); failure != nil {
+		t.Fatalf("unexpected failure: %s", failure.userMessage())
+	}
+
+	if len(assetClient.estimateCalls) != 1 {
+		t.Fatalf("expected one estimate call, got %d", len(assetClient.estimateCalls))
+	}
+	estimate := assetClient.estimateCalls[0]
+	if estimate.DurationSeconds != 120 || estimate.ClipStartSeconds != 30 || estimate.ClipEndSeconds != 90 {
+		t.Fatalf("expected clip range in estimate request, got %+v", estimate)
+	}
+	if len(publisher.tasks) != 1 || publisher.tasks[0].Clip == nil || publisher.tasks[0].Clip.EndTime != 90 {
+		t.Fatalf("expected clip options to be forwarded, got %+v", publisher.tasks)
+	}
+}
+
+func TestSubmitDownloadAssignsPriorityClassByRole(t *testing.T) {
+	t.Parallel()
+
+	handler, _, publisher := newTestDownloadHandler()
+	handler.scheduling = &config.SchedulingConfig{
+		DefaultClass:    "standard",
+		DefaultPriority: 1,
+		Classes:         []config.PriorityClassConfig{{Name: "vip", Priority: 5, Roles: []string{"2"}}},
+	}
+
+	for _, role := range []string{"1", "2"} {
+		req := &models.DownloadRequest{URL: "https://example.com/video", Mode: "archive"}
+		normalizeDownloadRequest(req)
+		if _, failure := handler.submit(context.Background(), "user-1", role, req, ""); failure != nil {
+			t.Fatalf("unexpected failure: %s", failure.userMessage())
+		}
+	}
+
+	if len(publisher.tasks) != 2 {
+		t.Fatalf("expected two published tasks, got %d", len(publisher.tasks))
+	}
+	if got := publisher.tasks[0]; got.PriorityClass != "standard" || got.Priority != 1 {
+		t.Fatalf("expected standard/1 for regular user, got %s/%d", got.PriorityClass, got.Priority)
+	}
+	if got := publisher.tasks[1]; got.PriorityClass != "vip" || got.Priority != 5 {
+		t.Fatalf("expected vip/5 for vip user, got %s/%d", got.PriorityClass, got.Priority)
+	}
+}
+
+func TestSubmitDownloadRejectsInvalidClip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		clip    *models.ClipOptions
+		message string
+	}{
+		{name: "beyond duration", clip: &models.ClipOptions{StartTime: 60, EndTime: 300}, message: "clip range exceeds video duration"},
+		{name: "beyond rounding tolerance", clip: &models.ClipOptions{StartTime: 60, EndTime: 121.5}, message: "clip range exceeds video duration"},
+		{name: "no chapters", clip: &models.ClipOptions{SplitChapters: true}, message: "video has no chapters to split"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler, assetClient, publisher := newTestDownloadHandler()
+			req := &models.DownloadRequest{URL: "https://example.com/video", Mode: "archive", Clip: tt.clip}
+			normalizeDownloadRequest(req)
+
+			_, failure := handler.submit(context.Background(), "user-1", "1", req, "")
+			if failure == nil || failure.httpStatus != http.StatusBadRequest || failure.message != tt.message {
+				t.Fatalf("expected bad request %q, got %+v", tt.message, failure)
+			}
+			if len(publisher.tasks) != 0 || len(assetClient.releaseProxyCalls) != 1 {
+				t.Fatalf("expected no task and released proxy binding, got tasks=%d releases=%v", len(publisher.tasks), assetClient.releaseProxyCalls)
+			}
+		})
+	}
+}
+
+func TestValidateClipAllowsFractionalEndTime(t *testing.T) {
+	t.Parallel()
+
+	// 120.6 秒的视频解析时长取整为 120，结束时间 120.6 仍属于有效片段
+	parseResp := &pb.ParseURLResponse{Duration: 120}
+	if message := validateClip(&models.ClipOptions{StartTime: 100, EndTime: 120.6}, parseResp); message != "" {
+		t.Fatalf("expected fractional end time to pass, got %q", message)
+	}
+	if got := clipDuration(&models.ClipOptions{StartTime: 0, EndTime: 120.6}, 120); got != 120 {
+		t.Fatalf("expected clip duration capped at video duration, got %d", got)
+	}
+}
+
 func newTestDownloadHandler() (*DownloadHandler, *fakeAssetDownloadClient, *fakeDownloadPublisher) {
 	assetClient := &fakeAssetDownloadClient{
 		checkQuotaResp: &pb.CheckQuotaResponse{Remaining: 3},
@@ -295,7 +530,7 @@ func newTestDownloadHandler() (*DownloadHandler, *fakeAssetDownloadClient, *fake
 	}
 	publisher := &fakeDownloadPublisher{}
 
-	return NewDownloadHandler(assetClient, mediaClient, publisher, time.Second, false), assetClient, publisher
+	return NewDownloadHandler(assetClient, mediaClient, publisher, time.Second, false, nil), assetClient, publisher
 }
 
 func performSubmitDownload(t *testing.T, handler *DownloadHandler) *httptest.ResponseRecorder {
@@ -17,6 +17,7 @@ var errDownloadTicketNotFound = errors.New("download ticket not found")
 type downloadTicketPayload struct {
 	UserID    string `json:"user_id"`
 	HistoryID int64  `json:"history_id"`
+	FileID    int64  `json:"file_id,omitempty"` // 附加产物 ID，为 0 表示主文件
 }
 
 type downloadTicketStore interface {
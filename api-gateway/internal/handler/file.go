@@ -2,11 +2,11 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
-	"os"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -17,6 +17,7 @@ import (
 
 	"youdlp/api-gateway/internal/middleware"
 	"youdlp/api-gateway/internal/models"
+	"youdlp/api-gateway/internal/storage"
 	pb "youdlp/api-gateway/proto"
 )
 
@@ -33,16 +34,18 @@ type fileAssetClient interface {
 type FileHandler struct {
 	assetClient    fileAssetClient
 	ticketStore    downloadTicketStore
+	store          storage.Storage
 	timeout        time.Duration
 	bufferSize     int
 	billingEnabled bool
 }
 
 // NewFileHandler 创建文件下载处理器
-func NewFileHandler(assetClient fileAssetClient, ticketStore downloadTicketStore, timeout time.Duration, bufferSize int, billingEnabled bool) *FileHandler {
+func NewFileHandler(assetClient fileAssetClient, ticketStore downloadTicketStore, store storage.Storage, timeout time.Duration, bufferSize int, billingEnabled bool) *FileHandler {
 	return &FileHandler{
 		assetClient:    assetClient,
 		ticketStore:    ticketStore,
+		store:          store,
 		timeout:        timeout,
 		bufferSize:     bufferSize,
 		billingEnabled: billingEnabled,
@@ -71,18 +74,24 @@ func (h *FileHandler) CreateDownloadTicket(c *gin.Context) {
 	defer cancel()
 
 	// 预先校验权限，避免发放无效票据。
-	if _, err := h.assetClient.GetFileInfo(ctx, &pb.GetFileInfoRequest{
+	fileInfo, err := h.assetClient.GetFileInfo(ctx, &pb.GetFileInfoRequest{
 		HistoryId: req.HistoryID,
 		UserId:    userID,
-	}); err != nil {
+	})
+	if err != nil {
 		models.Forbidden(c, "permission denied or file not found")
 		return
 	}
+	if _, _, ok := resolveDownloadTarget(fileInfo, req.FileID); !ok {
+		models.NotFound(c, "file not found")
+		return
+	}
 
 	ticket := uuid.NewString()
 	if err := h.ticketStore.Save(ctx, ticket, &downloadTicketPayload{
 		UserID:    userID,
 		HistoryID: req.HistoryID,
+		FileID:    req.FileID,
 	}, downloadTicketTTL); err != nil {
 		models.InternalError(c, "failed to create download ticket")
 		return
@@ -108,13 +117,64 @@ func (h *FileHandler) DownloadFile(c *gin.Context) {
 		return
 	}
 
+	var fileID int64
+	if fileIDStr := c.Query("file_id"); fileIDStr != "" {
+		if _, err := fmt.Sscanf(fileIDStr, "%d", &fileID); err != nil || fileID <= 0 {
+			models.BadRequest(c, "invalid file_id")
+			return
+		}
+	}
+
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		models.Unauthorized(c, "user not authenticated")
+		return
+	}
+
+	h.streamFile(c, userID, historyID, fileID)
+}
+
+// ListAttachments 列出下载记录的附加产物（字幕等），可通过 file_id 单独下载
+func (h *FileHandler) ListAttachments(c *gin.Context) {
+	var historyID int64
+	if _, err := fmt.Sscanf(c.Query("history_id"), "%d", &historyID); err != nil || historyID <= 0 {
+		models.BadRequest(c, "invalid history_id")
+		return
+	}
+
 	userID := middleware.GetUserID(c)
 	if userID == "" {
 		models.Unauthorized(c, "user not authenticated")
 		return
 	}
 
-	h.streamFile(c, userID, historyID)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := h.assetClient.GetFileInfo(ctx, &pb.GetFileInfoRequest{
+		HistoryId: historyID,
+		UserId:    userID,
+	})
+	if err != nil {
+		models.Forbidden(c, "permission denied or file not found")
+		return
+	}
+
+	attachments := make([]models.FileAttachment, 0, len(resp.Attachments))
+	for _, attachment := range resp.Attachments {
+		attachments = append(attachments, models.FileAttachment{
+			FileID:   attachment.Id,
+			Kind:     attachment.Kind,
+			Language: attachment.Language,
+			FileName: attachment.FileName,
+			FileSize: attachment.FileSize,
+		})
+	}
+
+	models.Success(c, models.FileAttachmentsResponse{
+		HistoryID:   historyID,
+		Attachments: attachments,
+	})
 }
 
 // DownloadFileByTicket 使用短期票据触发浏览器原生下载。
@@ -147,10 +207,23 @@ func (h *FileHandler) DownloadFileByTicket(c *gin.Context) {
 		return
 	}
 
-	h.streamFile(c, payload.UserID, payload.HistoryID)
+	h.streamFile(c, payload.UserID, payload.HistoryID, payload.FileID)
+}
+
+// resolveDownloadTarget 返回要下载文件的存储键和名称，fileID 为 0 表示主文件
+func resolveDownloadTarget(resp *pb.GetFileInfoResponse, fileID int64) (string, string, bool) {
+	if fileID == 0 {
+		return resp.FilePath, resp.FileName, resp.FilePath != ""
+	}
+	for _, attachment := range resp.Attachments {
+		if attachment.Id == fileID {
+			return attachment.FilePath, attachment.FileName, attachment.FilePath != ""
+		}
+	}
+	return "", "", false
 }
 
-func (h *FileHandler) streamFile(c *gin.Context, userID string, historyID int64) {
+func (h *FileHandler) streamFile(c *gin.Context, userID string, historyID, fileID int64) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
 	defer cancel()
 
@@ -163,30 +236,65 @@ func (h *FileHandler) streamFile(c *gin.Context, userID string, historyID int64)
 		return
 	}
 
-	if resp.FilePath == "" {
+	storageKey, fileName, ok := resolveDownloadTarget(resp, fileID)
+	if !ok {
 		models.NotFound(c, "file not found")
 		return
 	}
 
-	file, err := os.Open(resp.FilePath)
+	fileInfo, err := h.store.Stat(ctx, storageKey)
 	if err != nil {
-		models.NotFound(c, "file not found on disk")
+		if errors.Is(err, storage.ErrNotExist) {
+			models.NotFound(c, "file not found in storage")
+			return
+		}
+		models.InternalError(c, "failed to get file info")
 		return
 	}
-	defer file.Close()
 
-	fileInfo, err := file.Stat()
+	// 主文件以内容哈希作为强校验器，供断点续传的 If-Range 比对
+	etag := ""
+	if fileID == 0 && resp.FileHash != "" {
+		etag = `"` + resp.FileHash + `"`
+	}
+
+	// 解析单区间 Range；If-Range 不匹配说明文件已变化，退回完整下载
+	status := http.StatusOK
+	offset, length := int64(0), fileInfo.Size
+	if rangeHeader := c.GetHeader("Range"); rangeHeader != "" && ifRangeMatches(c.GetHeader("If-Range"), etag, fileInfo.ModTime) {
+		r, err := parseRange(rangeHeader, fileInfo.Size)
+		switch {
+		case errors.Is(err, errRangeNotSatisfiable):
+			c.Header("Content-Range", fmt.Sprintf("bytes */%d", fileInfo.Size))
+			c.AbortWithStatus(http.StatusRequestedRangeNotSatisfiable)
+			return
+		case err == nil:
+			status = http.StatusPartialContent
+			offset, length = r.start, r.length
+		}
+	}
+
+	// 传输时长不受 gRPC 超时限制，跟随客户端连接
+	file, err := h.store.Open(c.Request.Context(), storageKey, offset, length)
 	if err != nil {
-		models.InternalError(c, "failed to get file info")
+		if errors.Is(err, storage.ErrNotExist) {
+			models.NotFound(c, "file not found in storage")
+			return
+		}
+		models.InternalError(c, "failed to open file")
 		return
 	}
+	defer file.Close()
 
 	transferID := ""
 	if h.billingEnabled {
 		billingResp, err := h.assetClient.PrepareFileTransferBilling(ctx, &pb.PrepareFileTransferBillingRequest{
 			UserId:        userID,
 			HistoryId:     historyID,
-			FileSizeBytes: fileInfo.Size(),
+			FileSizeBytes: fileInfo.Size,
+			FileId:        fileID,
+			RangeStart:    offset,
+			RangeEnd:      offset + length,
 		})
 		if err != nil {
 			writeGRPCError(c, err)
@@ -195,55 +303,75 @@ func (h *FileHandler) streamFile(c *gin.Context, userID string, historyID int64)
 		transferID = billingResp.GetTransferId()
 	}
 
-	dispositionFilename := buildContentDispositionFilename(resp.FileName)
+	dispositionFilename := buildContentDispositionFilename(fileName)
 	c.Header("Content-Description", "File Transfer")
 	c.Header("Content-Transfer-Encoding", "binary")
 	c.Header("Content-Disposition", dispositionFilename)
 	c.Header("Content-Type", "application/octet-stream")
-	c.Header("Content-Length", fmt.Sprintf("%d", fileInfo.Size()))
+	c.Header("Content-Length", fmt.Sprintf("%d", length))
 	c.Header("Cache-Control", "no-cache, no-store, must-revalidate")
 	c.Header("Pragma", "no-cache")
 	c.Header("Expires", "0")
 	c.Header("Accept-Ranges", "bytes")
+	if etag != "" {
+		c.Header("ETag", etag)
+	}
+	if !fileInfo.ModTime.IsZero() {
+		c.Header("Last-Modified", fileInfo.ModTime.UTC().Format(http.TimeFormat))
+	}
+	if status == http.StatusPartialContent {
+		c.Header("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, offset+length-1, fileInfo.Size))
+	}
 
 	rc := http.NewResponseController(c.Writer)
 	_ = rc.SetWriteDeadline(time.Time{})
 
-	c.Status(http.StatusOK)
+	c.Status(status)
 
 	buffer := make([]byte, h.bufferSize)
 	var bytesSent int64
 	for {
 		n, err := file.Read(buffer)
+		if n > 0 {
+			written, writeErr := c.Writer.Write(buffer[:n])
+			bytesSent += int64(written)
+			if writeErr != nil {
+				// 已交付部分照常计费，客户端可携带 Range 续传剩余部分
+				h.finishTransferBilling(c.Request.Context(), transferID, bytesSent, "client disconnected")
+				return
+			}
+			c.Writer.Flush()
+		}
 		if err != nil {
 			if err == io.EOF {
 				break
 			}
-			h.abortTransferBilling(c.Request.Context(), transferID, "read file failed")
-			return
-		}
-		written, writeErr := c.Writer.Write(buffer[:n])
-		bytesSent += int64(written)
-		if writeErr != nil {
-			h.abortTransferBilling(c.Request.Context(), transferID, "client disconnected")
+			h.finishTransferBilling(c.Request.Context(), transferID, bytesSent, "read file failed")
 			return
 		}
-		c.Writer.Flush()
-	}
-
-	if h.billingEnabled && transferID != "" {
-		if bytesSent == fileInfo.Size() {
-			completeCtx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
-			defer cancel()
-			if _, err := h.assetClient.CompleteFileTransferBilling(completeCtx, &pb.CompleteFileTransferBillingRequest{
-				TransferId:        transferID,
-				ActualEgressBytes: bytesSent,
-			}); err != nil {
-				fmt.Printf("[File] failed to complete transfer billing %s: %v\n", transferID, err)
-			}
-		} else {
-			h.abortTransferBilling(c.Request.Context(), transferID, "incomplete transfer")
-		}
+	}
+
+	h.finishTransferBilling(c.Request.Context(), transferID, bytesSent, "incomplete transfer")
+}
+
+// finishTransferBilling 按实际交付字节结算传输计费，未交付任何字节时释放预留
+func (h *FileHandler) finishTransferBilling(parentCtx context.Context, transferID string, bytesSent int64, abortReason string) {
+	if !h.billingEnabled || transferID == "" {
+		return
+	}
+	if bytesSent == 0 {
+		h.abortTransferBilling(parentCtx, transferID, abortReason)
+		return
+	}
+
+	// 客户端断开后请求上下文已取消，结算不能依赖它
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(parentCtx), h.timeout)
+	defer cancel()
+	if _, err := h.assetClient.CompleteFileTransferBilling(ctx, &pb.CompleteFileTransferBillingRequest{
+		TransferId:        transferID,
+		ActualEgressBytes: bytesSent,
+	}); err != nil {
+		fmt.Printf("[File] failed to complete transfer billing %s: %v\n", transferID, err)
 	}
 }
 
@@ -256,7 +384,7 @@ func (h *FileHandler) abortTransferBilling(parentCtx context.Context, transferID
 		parentCtx = context.Background()
 	}
 
-	ctx, cancel := context.WithTimeout(parentCtx, h.timeout)
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(parentCtx), h.timeout)
 	defer cancel()
 	if _, err := h.assetClient.AbortFileTransferBilling(ctx, &pb.AbortFileTransferBillingRequest{
 		TransferId: transferID,
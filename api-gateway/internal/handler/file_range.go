@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// errRangeIgnored Range 头无法解析或为多区间请求，按完整文件响应
+	errRangeIgnored = errors.New("range ignored")
+	// errRangeNotSatisfiable 请求区间超出文件范围，返回 416
+	errRangeNotSatisfiable = errors.New("range not satisfiable")
+)
+
+// byteRange 单个字节区间 [start, start+length)
+type byteRange struct {
+	start  int64
+	length int64
+}
+
+// parseRange 解析单区间 Range 头（bytes=a-b、bytes=a-、bytes=-n）
+func parseRange(header string, size int64) (byteRange, error) {
+	spec, ok := strings.CutPrefix(strings.TrimSpace(header), "bytes=")
+	if !ok || strings.Contains(spec, ",") {
+		return byteRange{}, errRangeIgnored
+	}
+	startStr, endStr, ok := strings.Cut(strings.TrimSpace(spec), "-")
+	if !ok {
+		return byteRange{}, errRangeIgnored
+	}
+	startStr, endStr = strings.TrimSpace(startStr), strings.TrimSpace(endStr)
+
+	if startStr == "" {
+		// 后缀区间：最后 n 个字节
+		n, err := strconv.ParseInt(endStr, 10, 64)
+		if err != nil || n < 0 {
+			return byteRange{}, errRangeIgnored
+		}
+		if n == 0 || size == 0 {
+			return byteRange{}, errRangeNotSatisfiable
+		}
+		if n > size {
+			n = size
+		}
+		return byteRange{start: size - n, length: n}, nil
+	}
+
+	start, err := strconv.ParseInt(startStr, 10, 64)
+	if err != nil || start < 0 {
+		return byteRange{}, errRangeIgnored
+	}
+	end := size - 1
+	if endStr != "" {
+		end, err = strconv.ParseInt(endStr, 10, 64)
+		if err != nil || end < start {
+			return byteRange{}, errRangeIgnored
+		}
+	}
+	if start >= size {
+		return byteRange{}, errRangeNotSatisfiable
+	}
+	if end >= size {
+		end = size - 1
+	}
+	return byteRange{start: start, length: end - start + 1}, nil
+}
+
+// ifRangeMatches 判断 If-Range 条件是否成立：实体标签要求强匹配，日期要求与 Last-Modified 一致
+func ifRangeMatches(ifRange, etag string, modTime time.Time) bool {
+	ifRange = strings.TrimSpace(ifRange)
+	if ifRange == "" {
+		return true
+	}
+	if strings.HasPrefix(ifRange, `"`) || strings.HasPrefix(ifRange, "W/") {
+		return etag != "" && ifRange == etag
+	}
+	if modTime.IsZero() {
+		return false
+	}
+	t, err := http.ParseTime(ifRange)
+	if err != nil {
+		return false
+	}
+	return modTime.Truncate(time.Second).Equal(t)
+}
@@ -16,23 +16,28 @@ import (
 	"google.golang.org/grpc"
 
 	"youdlp/api-gateway/internal/models"
+	"youdlp/api-gateway/internal/storage"
 	pb "youdlp/api-gateway/proto"
 )
 
 type fakeFileAssetClient struct {
 	getFileInfoResp *pb.GetFileInfoResponse
 	getFileInfoErr  error
+	prepareReqs     []*pb.PrepareFileTransferBillingRequest
+	completeReqs    []*pb.CompleteFileTransferBillingRequest
 }
 
 func (f *fakeFileAssetClient) GetFileInfo(context.Context, *pb.GetFileInfoRequest, ...grpc.CallOption) (*pb.GetFileInfoResponse, error) {
 	return f.getFileInfoResp, f.getFileInfoErr
 }
 
-func (f *fakeFileAssetClient) PrepareFileTransferBilling(context.Context, *pb.PrepareFileTransferBillingRequest, ...grpc.CallOption) (*pb.PrepareFileTransferBillingResponse, error) {
-	return &pb.PrepareFileTransferBillingResponse{}, nil
+func (f *fakeFileAssetClient) PrepareFileTransferBilling(_ context.Context, in *pb.PrepareFileTransferBillingRequest, _ ...grpc.CallOption) (*pb.PrepareFileTransferBillingResponse, error) {
+	f.prepareReqs = append(f.prepareReqs, in)
+	return &pb.PrepareFileTransferBillingResponse{TransferId: "trf-1"}, nil
 }
 
-func (f *fakeFileAssetClient) CompleteFileTransferBilling(context.Context, *pb.CompleteFileTransferBillingRequest, ...grpc.CallOption) (*pb.CompleteFileTransferBillingResponse, error) {
+func (f *fakeFileAssetClient) CompleteFileTransferBilling(_ context.Context, in *pb.CompleteFileTransferBillingRequest, _ ...grpc.CallOption) (*pb.CompleteFileTransferBillingResponse, error) {
+	f.completeReqs = append(f.completeReqs, in)
 	return &pb.CompleteFileTransferBillingResponse{}, nil
 }
 
@@ -90,7 +95,7 @@ func TestCreateDownloadTicketReturnsTicket(t *testing.T) {
 		},
 	}
 	ticketStore := &fakeDownloadTicketStore{}
-	handler := NewFileHandler(assetClient, ticketStore, time.Second, 32*1024, false)
+	handler := NewFileHandler(assetClient, ticketStore, nil, time.Second, 32*1024, false)
 
 	body := bytes.NewBufferString(`{"history_id":42}`)
 	req := httptest.NewRequest(http.MethodPost, "/api/v1/download/file-ticket", body)
@@ -147,7 +152,7 @@ func TestDownloadFileByTicketStreamsFile(t *testing.T) {
 
 	assetClient := &fakeFileAssetClient{
 		getFileInfoResp: &pb.GetFileInfoResponse{
-			FilePath: filePath,
+			FilePath: "sample.txt",
 			FileName: "sample.txt",
 		},
 	}
@@ -156,7 +161,7 @@ func TestDownloadFileByTicketStreamsFile(t *testing.T) {
 			"ticket-1": {UserID: "user-1", HistoryID: 42},
 		},
 	}
-	handler := NewFileHandler(assetClient, ticketStore, time.Second, 8, false)
+	handler := NewFileHandler(assetClient, ticketStore, storage.NewLocalStorage(dir), time.Second, 8, false)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/download/file/browser?ticket=ticket-1", nil)
 	w := httptest.NewRecorder()
@@ -177,3 +182,208 @@ func TestDownloadFileByTicketStreamsFile(t *testing.T) {
 		t.Fatalf("expected attachment disposition, got %q", disposition)
 	}
 }
+
+func TestDownloadFileByTicketStreamsAttachment(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	dir := t.TempDir()
+	subtitlePath := filepath.Join(dir, "sample.en.srt")
+	content := []byte("1\n00:00:00,000 --> 00:00:01,000\nhello\n")
+	if err := os.WriteFile(subtitlePath, content, 0o600); err != nil {
+		t.Fatalf("failed to write temp file: %v", err)
+	}
+
+	assetClient := &fakeFileAssetClient{
+		getFileInfoResp: &pb.GetFileInfoResponse{
+			FilePath: "sample.mp4",
+			FileName: "sample.mp4",
+			Attachments: []*pb.AttachedFile{
+				{Id: 7, Kind: "subtitle", Language: "en", FilePath: "sample.en.srt", FileName: "sample.en.srt"},
+			},
+		},
+	}
+	ticketStore := &fakeDownloadTicketStore{
+		payloads: map[string]*downloadTicketPayload{
+			"ticket-1": {UserID: "user-1", HistoryID: 42, FileID: 7},
+			"ticket-2": {UserID: "user-1", HistoryID: 42, FileID: 8},
+		},
+	}
+	handler := NewFileHandler(assetClient, ticketStore, storage.NewLocalStorage(dir), time.Second, 8, false)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/download/file/browser?ticket=ticket-1", nil)
+	handler.DownloadFileByTicket(c)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+	if body := w.Body.Bytes(); !bytes.Equal(body, content) {
+		t.Fatalf("unexpected file body: %q", string(body))
+	}
+	if disposition := w.Header().Get("Content-Disposition"); !strings.Contains(disposition, ".srt") {
+		t.Fatalf("expected subtitle filename in disposition, got %q", disposition)
+	}
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/download/file/browser?ticket=ticket-2", nil)
+	handler.DownloadFileByTicket(c)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404 for unknown attachment, got %d", w.Code)
+	}
+}
+
+func newRangeTestHandler(t *testing.T, content []byte, billingEnabled bool) (*FileHandler, *fakeFileAssetClient) {
+	t.Helper()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "sample.bin"), content, 0o600); err != nil {
+		t.Fatalf("failed to write temp file: %v", err)
+	}
+
+	assetClient := &fakeFileAssetClient{
+		getFileInfoResp: &pb.GetFileInfoResponse{
+			FilePath: "sample.bin",
+			FileName: "sample.bin",
+			FileHash: "abc123",
+		},
+	}
+	ticketStore := &fakeDownloadTicketStore{
+		payloads: map[string]*downloadTicketPayload{
+			"ticket-1": {UserID: "user-1", HistoryID: 42},
+		},
+	}
+	return NewFileHandler(assetClient, ticketStore, storage.NewLocalStorage(dir), time.Second, 4, billingEnabled), assetClient
+}
+
+func serveRangeRequest(handler *FileHandler, headers map[string]string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/download/file/browser?ticket=ticket-1", nil)
+	for key, value := range headers {
+		c.Request.Header.Set(key, value)
+	}
+	handler.DownloadFileByTicket(c)
+	return w
+}
+
+func TestDownloadFileServesPartialContent(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	content := []byte("0123456789abcdef")
+	handler, assetClient := newRangeTestHandler(t, content, true)
+
+	w := serveRangeRequest(handler, map[string]string{"Range": "bytes=10-"})
+
+	if w.Code != http.StatusPartialContent {
+		t.Fatalf("expected status 206, got %d", w.Code)
+	}
+	if body := w.Body.String(); body != "abcdef" {
+		t.Fatalf("unexpected partial body: %q", body)
+	}
+	if got := w.Header().Get("Content-Range"); got != "bytes 10-15/16" {
+		t.Fatalf("unexpected Content-Range: %q", got)
+	}
+	if got := w.Header().Get("Content-Length"); got != "6" {
+		t.Fatalf("unexpected Content-Length: %q", got)
+	}
+	if got := w.Header().Get("ETag"); got != `"abc123"` {
+		t.Fatalf("unexpected ETag: %q", got)
+	}
+
+	if len(assetClient.prepareReqs) != 1 {
+		t.Fatalf("expected one billing prepare, got %d", len(assetClient.prepareReqs))
+	}
+	prepare := assetClient.prepareReqs[0]
+	if prepare.RangeStart != 10 || prepare.RangeEnd != 16 || prepare.FileSizeBytes != 16 {
+		t.Fatalf("unexpected billing range: %+v", prepare)
+	}
+	if len(assetClient.completeReqs) != 1 || assetClient.completeReqs[0].ActualEgressBytes != 6 {
+		t.Fatalf("expected completion with 6 bytes, got %+v", assetClient.completeReqs)
+	}
+}
+
+func TestDownloadFileRejectsUnsatisfiableRange(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	handler, assetClient := newRangeTestHandler(t, []byte("0123456789"), true)
+
+	w := serveRangeRequest(handler, map[string]string{"Range": "bytes=20-30"})
+
+	if w.Code != http.StatusRequestedRangeNotSatisfiable {
+		t.Fatalf("expected status 416, got %d", w.Code)
+	}
+	if got := w.Header().Get("Content-Range"); got != "bytes */10" {
+		t.Fatalf("unexpected Content-Range: %q", got)
+	}
+	if len(assetClient.prepareReqs) != 0 {
+		t.Fatal("expected no billing for unsatisfiable range")
+	}
+}
+
+func TestDownloadFileIfRangeMismatchServesFullFile(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+
+	content := []byte("0123456789")
+	handler, _ := newRangeTestHandler(t, content, false)
+
+	w := serveRangeRequest(handler, map[string]string{
+		"Range":    "bytes=5-",
+		"If-Range": `"stale"`,
+	})
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200 for stale If-Range, got %d", w.Code)
+	}
+	if !bytes.Equal(w.Body.Bytes(), content) {
+		t.Fatalf("expected full body, got %q", w.Body.String())
+	}
+
+	w = serveRangeRequest(handler, map[string]string{
+		"Range":    "bytes=5-",
+		"If-Range": `"abc123"`,
+	})
+	if w.Code != http.StatusPartialContent || w.Body.String() != "56789" {
+		t.Fatalf("expected 206 for matching If-Range, got %d %q", w.Code, w.Body.String())
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		header string
+		want   byteRange
+		err    error
+	}{
+		{header: "bytes=0-4", want: byteRange{start: 0, length: 5}},
+		{header: "bytes=5-", want: byteRange{start: 5, length: 5}},
+		{header: "bytes=-3", want: byteRange{start: 7, length: 3}},
+		{header: "bytes=-30", want: byteRange{start: 0, length: 10}},
+		{header: "bytes=8-100", want: byteRange{start: 8, length: 2}},
+		{header: "bytes=10-", err: errRangeNotSatisfiable},
+		{header: "bytes=-0", err: errRangeNotSatisfiable},
+		{header: "bytes=0-1,4-5", err: errRangeIgnored},
+		{header: "bytes=5-2", err: errRangeIgnored},
+		{header: "items=0-1", err: errRangeIgnored},
+	}
+
+	for _, tc := range cases {
+		got, err := parseRange(tc.header, 10)
+		if err != tc.err {
+			t.Fatalf("%s: expected error %v, got %v", tc.header, tc.err, err)
+		}
+		if err == nil && got != tc.want {
+			t.Fatalf("%s: expected %+v, got %+v", tc.header, tc.want, got)
+		}
+	}
+}
@@ -13,15 +13,20 @@ import (
 
 // ParseHandler 解析处理器
 type ParseHandler struct {
-	mediaClient pb.MediaServiceClient
-	timeout     time.Duration
+	mediaClient      pb.MediaServiceClient
+	timeout          time.Duration
+	batchConcurrency int // media-service 单个批量解析请求的并发数，用于估算批量超时
 }
 
 // NewParseHandler 创建解析处理器
-func NewParseHandler(mediaClient pb.MediaServiceClient, timeout time.Duration) *ParseHandler {
+func NewParseHandler(mediaClient pb.MediaServiceClient, timeout time.Duration, batchConcurrency int) *ParseHandler {
+	if batchConcurrency <= 0 {
+		batchConcurrency = 1
+	}
 	return &ParseHandler{
-		mediaClient: mediaClient,
-		timeout:     timeout,
+		mediaClient:      mediaClient,
+		timeout:          timeout,
+		batchConcurrency: batchConcurrency,
 	}
 }
 
@@ -48,12 +53,10 @@ func (h *ParseHandler) ParseURL(c *gin.Context) {
 	// 调试日志：从 gRPC 接收的格式
 	log.Printf("[DEBUG-ParseHandler] Received from gRPC: %d formats", len(resp.Formats))
 
-	// 转换格式列表
-	formats := make([]models.VideoFormat, 0, len(resp.Formats))
+	// 统计格式信息用于调试
 	var maxHeight int32
 	var videoCount, audioCount int
 	for i, f := range resp.Formats {
-		// 统计
 		if f.Height > maxHeight {
 			maxHeight = f.Height
 		}
@@ -69,36 +72,120 @@ func (h *ParseHandler) ParseURL(c *gin.Context) {
 			log.Printf("[DEBUG-ParseHandler] Format[%d]: id=%s, height=%d, video_codec=%s, audio_codec=%s, filesize=%d",
 				i, f.FormatId, f.Height, f.VideoCodec, f.AudioCodec, f.Filesize)
 		}
+	}
+
+	log.Printf("[DEBUG-ParseHandler] Sending to frontend: %d formats (video=%d, audio=%d, maxHeight=%d)",
+		len(resp.Formats), videoCount, audioCount, maxHeight)
 
+	models.Success(c, toParseResponse(resp))
+}
+
+// toParseResponse 将 media-service 的解析结果转换为前端响应
+func toParseResponse(resp *pb.ParseURLResponse) models.ParseResponse {
+	formats := make([]models.VideoFormat, 0, len(resp.Formats))
+	for _, f := range resp.Formats {
 		formats = append(formats, models.VideoFormat{
-			FormatID:   f.FormatId,
-			Quality:    f.Quality,
-			Extension:  f.Extension,
-			Filesize:   f.Filesize,
-			Height:     f.Height,
-			Width:      f.Width,
-			FPS:        f.Fps,
-			VideoCodec: f.VideoCodec,
-			AudioCodec: f.AudioCodec,
-			VBR:        f.Vbr,
-			ABR:        f.Abr,
-			ASR:        f.Asr,
+			FormatID:     f.FormatId,
+			Quality:      f.Quality,
+			Extension:    f.Extension,
+			Filesize:     f.Filesize,
+			Height:       f.Height,
+			Width:        f.Width,
+			FPS:          f.Fps,
+			VideoCodec:   f.VideoCodec,
+			AudioCodec:   f.AudioCodec,
+			VBR:          f.Vbr,
+			ABR:          f.Abr,
+			ASR:          f.Asr,
+			Language:     f.Language,
+			DynamicRange: f.DynamicRange,
 		})
 	}
 
-	log.Printf("[DEBUG-ParseHandler] Sending to frontend: %d formats (video=%d, audio=%d, maxHeight=%d)",
-		len(formats), videoCount, audioCount, maxHeight)
-
-	models.Success(c, models.ParseResponse{
-		VideoID:     resp.VideoId,
-		Platform:    resp.Platform,
-		Title:       resp.Title,
-		Description: resp.Description,
-		Duration:    resp.Duration,
-		Thumbnail:   resp.Thumbnail,
-		Author:      resp.Author,
-		UploadDate:  resp.UploadDate,
-		ViewCount:   resp.ViewCount,
-		Formats:     formats,
+	subtitles := make([]models.SubtitleTrack, 0, len(resp.Subtitles))
+	for _, st := range resp.Subtitles {
+		subtitles = append(subtitles, models.SubtitleTrack{
+			Language:  st.Language,
+			Name:      st.Name,
+			Automatic: st.Automatic,
+			Formats:   st.Formats,
+		})
+	}
+
+	chapters := make([]models.Chapter, 0, len(resp.Chapters))
+	for _, ch := range resp.Chapters {
+		chapters = append(chapters, models.Chapter{
+			Title:     ch.Title,
+			StartTime: ch.StartTime,
+			EndTime:   ch.EndTime,
+		})
+	}
+
+	return models.ParseResponse{
+		VideoID:        resp.VideoId,
+		Platform:       resp.Platform,
+		Title:          resp.Title,
+		Description:    resp.Description,
+		Duration:       resp.Duration,
+		Thumbnail:      resp.Thumbnail,
+		Author:         resp.Author,
+		UploadDate:     resp.UploadDate,
+		ViewCount:      resp.ViewCount,
+		Formats:        formats,
+		Subtitles:      subtitles,
+		Chapters:       chapters,
+		AudioLanguages: resp.AudioLanguages,
+		LiveStatus:     resp.LiveStatus,
+		AgeLimit:       resp.AgeLimit,
+		Tags:           resp.Tags,
+		Categories:     resp.Categories,
+		ChannelID:      resp.ChannelId,
+		ChannelURL:     resp.ChannelUrl,
+	}
+}
+
+// ParsePlaylist 分页解析播放列表/频道
+func (h *ParseHandler) ParsePlaylist(c *gin.Context) {
+	var req models.ParsePlaylistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		models.BadRequest(c, "invalid request: "+err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := h.mediaClient.ParsePlaylist(ctx, &pb.ParsePlaylistRequest{
+		Url:      req.URL,
+		Page:     int32(req.Page),
+		PageSize: int32(req.PageSize),
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	entries := make([]models.PlaylistEntry, 0, len(resp.Entries))
+	for _, e := range resp.Entries {
+		entries = append(entries, models.PlaylistEntry{
+			Index:     e.Index,
+			VideoID:   e.VideoId,
+			URL:       e.Url,
+			Title:     e.Title,
+			Duration:  e.Duration,
+			Thumbnail: e.Thumbnail,
+		})
+	}
+
+	models.Success(c, models.ParsePlaylistResponse{
+		PlaylistID: resp.PlaylistId,
+		Platform:   resp.Platform,
+		Title:      resp.Title,
+		Author:     resp.Author,
+		TotalCount: resp.TotalCount,
+		Page:       resp.Page,
+		PageSize:   resp.PageSize,
+		HasMore:    resp.HasMore,
+		Entries:    entries,
 	})
 }
@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"youdlp/api-gateway/internal/models"
+	pb "youdlp/api-gateway/proto"
+)
+
+const (
+	parseBatchItemSuccess = "success"
+	parseBatchItemFailed  = "failed"
+	parseBatchItemTimeout = "timeout" // 截止前未返回结果，可单独重试
+	// parseBatchWriteMargin 批量解析可能超过服务端写超时，按 gRPC 超时放宽本次响应的写截止时间
+	parseBatchWriteMargin = 10 * time.Second
+)
+
+// ParseBatch 批量解析视频 URL：media-service 按规范身份去重后并发解析，
+// 默认汇总后一次返回，stream 为 true 时以 SSE 逐条推送（item 事件，结束时 done 事件）
+func (h *ParseHandler) ParseBatch(c *gin.Context) {
+	var req models.ParseBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		models.BadRequest(c, "invalid request: "+err.Error())
+		return
+	}
+	urls := make([]string, len(req.URLs))
+	for i, url := range req.URLs {
+		urls[i] = strings.TrimSpace(url)
+	}
+
+	timeout := h.batchTimeout(urls)
+	ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+	defer cancel()
+
+	stream, err := h.mediaClient.ParseURLs(ctx, &pb.ParseURLsRequest{
+		Urls:      urls,
+		SkipCache: req.SkipCache,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	// 参数错误等在首条消息时返回，此时尚未写出响应
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		writeGRPCError(c, err)
+		return
+	}
+
+	rc := http.NewResponseController(c.Writer)
+	_ = rc.SetWriteDeadline(time.Now().Add(timeout + parseBatchWriteMargin))
+
+	summary := models.ParseBatchResponse{Total: len(urls)}
+	next := func() (*pb.ParseURLsResult, error) {
+		if first != nil {
+			result := first
+			first = nil
+			return result, nil
+		}
+		return stream.Recv()
+	}
+
+	if req.Stream {
+		h.streamParseBatch(c, rc, &summary, next)
+		return
+	}
+
+	for {
+		result, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// 已完成的条目照常返回，未返回的下标标记为超时或中断
+			log.Printf("[ParseBatch] ⚠ Stream failed after %d items: %v", summary.Unique, err)
+			for _, item := range unreturnedParseBatchItems(urls, summary.Items, err) {
+				countParseBatchItem(&summary, item)
+				summary.Items = append(summary.Items, item)
+			}
+			break
+		}
+		item := toParseBatchItem(result)
+		countParseBatchItem(&summary, item)
+		summary.Items = append(summary.Items, item)
+	}
+
+	sort.Slice(summary.Items, func(i, j int) bool {
+		return summary.Items[i].Indexes[0] < summary.Items[j].Indexes[0]
+	})
+	log.Printf("[ParseBatch] ✓ Parsed %d urls (%d unique, %d failed)", summary.Total, summary.Unique, summary.Failed)
+	models.Success(c, summary)
+}
+
+// streamParseBatch 以 SSE 推送解析完成的条目，连接中断时停止接收
+func (h *ParseHandler) streamParseBatch(c *gin.Context, rc *http.ResponseController, summary *models.ParseBatchResponse, next func() (*pb.ParseURLsResult, error)) {
+	header := c.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	for {
+		result, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Printf("[ParseBatch] ❌ Stream failed after %d items: %v", summary.Unique, err)
+			_ = writeParseBatchEvent(c.Writer, "error", gin.H{"message": grpcErrorMessage(err)})
+			rc.Flush()
+			return
+		}
+
+		item := toParseBatchItem(result)
+		countParseBatchItem(summary, item)
+		if err := writeParseBatchEvent(c.Writer, "item", item); err != nil {
+			return
+		}
+		rc.Flush()
+	}
+
+	// done 事件只携带计数，条目已逐条推送
+	_ = writeParseBatchEvent(c.Writer, "done", summary)
+	rc.Flush()
+	log.Printf("[ParseBatch] ✓ Streamed %d urls (%d unique, %d failed)", summary.Total, summary.Unique, summary.Failed)
+}
+
+// batchTimeout 按去重后的链接数和 media-service 批量并发数估算批量解析的截止时间，
+// 每一轮并发按单次解析的 timeout 计
+func (h *ParseHandler) batchTimeout(urls []string) time.Duration {
+	unique := make(map[string]struct{}, len(urls))
+	for _, url := range urls {
+		unique[url] = struct{}{}
+	}
+	rounds := (len(unique) + h.batchConcurrency - 1) / h.batchConcurrency
+	if rounds < 1 {
+		rounds = 1
+	}
+	return h.timeout * time.Duration(rounds)
+}
+
+// unreturnedParseBatchItems 为流中断前没有返回结果的下标生成条目，截止时间耗尽时标记为 timeout
+func unreturnedParseBatchItems(urls []string, returned []models.ParseBatchItem, err error) []models.ParseBatchItem {
+	seen := make(map[int32]bool, len(urls))
+	for _, item := range returned {
+		for _, index := range item.Indexes {
+			seen[index] = true
+		}
+	}
+
+	itemStatus, errorCode := parseBatchItemFailed, "interrupted"
+	if status.Code(err) == codes.DeadlineExceeded || errors.Is(err, context.DeadlineExceeded) {
+		itemStatus, errorCode = parseBatchItemTimeout, "timeout"
+	}
+	var items []models.ParseBatchItem
+	for i, url := range urls {
+		if seen[int32(i)] {
+			continue
+		}
+		items = append(items, models.ParseBatchItem{
+			Indexes:   []int32{int32(i)},
+			URL:       url,
+			Status:    itemStatus,
+			ErrorCode: errorCode,
+			Error:     grpcErrorMessage(err),
+		})
+	}
+	return items
+}
+
+func countParseBatchItem(summary *models.ParseBatchResponse, item models.ParseBatchItem) {
+	summary.Unique++
+	if item.Status == parseBatchItemSuccess {
+		summary.Succeeded++
+	} else {
+		summary.Failed++
+	}
+}
+
+func writeParseBatchEvent(w io.Writer, event string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	return err
+}
+
+func toParseBatchItem(result *pb.ParseURLsResult) models.ParseBatchItem {
+	item := models.ParseBatchItem{
+		Indexes: result.GetIndexes(),
+		URL:     result.GetUrl(),
+	}
+	if result.GetErrorCode() != "" {
+		item.Status = parseBatchItemFailed
+		item.ErrorCode = result.GetErrorCode()
+		item.Error = result.GetErrorMessage()
+		return item
+	}
+	data := toParseResponse(result.GetResult())
+	item.Status = parseBatchItemSuccess
+	item.Data = &data
+	return item
+}
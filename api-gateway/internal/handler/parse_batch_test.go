@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "youdlp/api-gateway/proto"
+)
+
+type fakeParseURLsStream struct {
+	grpc.ClientStream
+	results []*pb.ParseURLsResult
+	err     error
+}
+
+// Recv 先返回 results，耗尽后返回 err（为空时返回 io.EOF）
+func (f *fakeParseURLsStream) Recv() (*pb.ParseURLsResult, error) {
+	if len(f.results) == 0 {
+		if f.err != nil {
+			return nil, f.err
+		}
+		return nil, io.EOF
+	}
+	result := f.results[0]
+	f.results = f.results[1:]
+	return result, nil
+}
+
+type fakeBatchMediaClient struct {
+	pb.MediaServiceClient
+	stream  *fakeParseURLsStream
+	request *pb.ParseURLsRequest
+}
+
+func (f *fakeBatchMediaClient) ParseURLs(_ context.Context, in *pb.ParseURLsRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[pb.ParseURLsResult], error) {
+	f.request = in
+	return f.stream, nil
+}
+
+func newParseBatchContext(body string) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	recorder := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(recorder)
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/parse/batch", strings.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	return c, recorder
+}
+
+func batchResults() []*pb.ParseURLsResult {
+	return []*pb.ParseURLsResult{
+		{Indexes: []int32{2}, Url: "not a url", ErrorCode: "invalid_url", ErrorMessage: "invalid URL"},
+		{Indexes: []int32{0, 1}, Url: "https://youtu.be/abc", Result: &pb.ParseURLResponse{
+			VideoId:        "abc",
+			Title:          "Title",
+			Formats:        []*pb.VideoFormat{{FormatId: "337", DynamicRange: "HDR10"}, {FormatId: "140-1", Language: "es"}},
+			AudioLanguages: []string{"en", "es"},
+			LiveStatus:     "was_live",
+			ChannelId:      "UC123",
+		}},
+	}
+}
+
+func TestParseBatchCollectsResultsInRequestOrder(t *testing.T) {
+	c, recorder := newParseBatchContext(`{"urls":[" https://youtu.be/abc ","https://www.youtube.com/watch?v=abc","not a url"]}`)
+	client := &fakeBatchMediaClient{stream: &fakeParseURLsStream{results: batchResults()}}
+
+	NewParseHandler(client, time.Second, 4).ParseBatch(c)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+	if got := client.request.GetUrls()[0]; got != "https://youtu.be/abc" {
+		t.Fatalf("expected urls to be trimmed, got %q", got)
+	}
+
+	data := decodeResponseDataAsMap(t, recorder)
+	if data["total"] != float64(3) || data["unique"] != float64(2) || data["succeeded"] != float64(1) || data["failed"] != float64(1) {
+		t.Fatalf("unexpected summary: %#v", data)
+	}
+	items := data["items"].([]any)
+	first := items[0].(map[string]any)
+	if first["status"] != "success" || first["data"].(map[string]any)["video_id"] != "abc" {
+		t.Fatalf("expected items ordered by first index, got %#v", items)
+	}
+	parsed := first["data"].(map[string]any)
+	assertHasKeys(t, parsed, "audio_languages", "live_status", "channel_id")
+	formats := parsed["formats"].([]any)
+	if formats[0].(map[string]any)["dynamic_range"] != "HDR10" || formats[1].(map[string]any)["language"] != "es" {
+		t.Fatalf("expected per-format language and dynamic range, got %#v", formats)
+	}
+	if second := items[1].(map[string]any); second["error_code"] != "invalid_url" {
+		t.Fatalf("expected per-url error, got %#v", second)
+	}
+}
+
+func TestParseBatchStreamsItemsAsServerSentEvents(t *testing.T) {
+	c, recorder := newParseBatchContext(`{"urls":["https://youtu.be/abc","not a url"],"stream":true}`)
+	client := &fakeBatchMediaClient{stream: &fakeParseURLsStream{results: batchResults()}}
+
+	NewParseHandler(client, time.Second, 4).ParseBatch(c)
+
+	body := recorder.Body.String()
+	if ct := recorder.Header().Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("expected event stream, got %q", ct)
+	}
+	if strings.Count(body, "event: item\n") != 2 || !strings.Contains(body, "event: done\n") {
+		t.Fatalf("expected two item events and a done event, got %s", body)
+	}
+	if strings.Index(body, `"error_code":"invalid_url"`) > strings.Index(body, `"video_id":"abc"`) {
+		t.Fatalf("expected items in completion order, got %s", body)
+	}
+}
+
+func TestParseBatchRejectsInvalidRequests(t *testing.T) {
+	tooMany := `{"urls":[` + strings.TrimSuffix(strings.Repeat(`"https://example.com/v",`, 51), ",") + `]}`
+	for _, body := range []string{`{}`, `{"urls":[]}`, tooMany} {
+		c, recorder := newParseBatchContext(body)
+		NewParseHandler(&fakeBatchMediaClient{}, time.Second, 4).ParseBatch(c)
+		if recorder.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400, got %d", recorder.Code)
+		}
+	}
+
+	c, recorder := newParseBatchContext(`{"urls":["https://example.com/v"]}`)
+	client := &fakeBatchMediaClient{stream: &fakeParseURLsStream{err: status.Error(codes.InvalidArgument, "at most 50 urls per batch")}}
+	NewParseHandler(client, time.Second, 4).ParseBatch(c)
+	if recorder.Code != http.StatusBadRequest {
+		t.Fatalf("expected media-service rejection to map to 400, got %d", recorder.Code)
+	}
+}
+
+func TestParseBatchKeepsCompletedItemsWhenDeadlineExpires(t *testing.T) {
+	c, recorder := newParseBatchContext(`{"urls":["https://youtu.be/abc","https://youtu.be/abc?t=1","https://vimeo.com/1","https://vimeo.com/2"]}`)
+	client := &fakeBatchMediaClient{stream: &fakeParseURLsStream{
+		results: batchResults()[1:],
+		err:     status.Error(codes.DeadlineExceeded, "context deadline exceeded"),
+	}}
+
+	NewParseHandler(client, time.Second, 4).ParseBatch(c)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected partial results with 200, got %d: %s", recorder.Code, recorder.Body.String())
+	}
+	data := decodeResponseDataAsMap(t, recorder)
+	if data["total"] != float64(4) || data["unique"] != float64(3) || data["succeeded"] != float64(1) || data["failed"] != float64(2) {
+		t.Fatalf("unexpected summary: %#v", data)
+	}
+	items := data["items"].([]any)
+	if first := items[0].(map[string]any); first["status"] != "success" {
+		t.Fatalf("expected completed item to be kept, got %#v", first)
+	}
+	for i, index := range []float64{2, 3} {
+		item := items[i+1].(map[string]any)
+		if item["status"] != "timeout" || item["error_code"] != "timeout" || item["indexes"].([]any)[0] != index {
+			t.Fatalf("expected index %v marked as timeout, got %#v", index, item)
+		}
+	}
+}
+
+func TestParseBatchTimeoutScalesWithRounds(t *testing.T) {
+	handler := NewParseHandler(nil, time.Second, 2)
+
+	cases := []struct {
+		urls []string
+		want time.Duration
+	}{
+		{[]string{"a"}, time.Second},
+		{[]string{"a", "b"}, time.Second},
+		{[]string{"a", "b", "c"}, 2 * time.Second},
+		{[]string{"a", "a", "b", "b", "c"}, 2 * time.Second},
+		{[]string{"a", "b", "c", "d", "e"}, 3 * time.Second},
+	}
+	for _, tc := range cases {
+		if got := handler.batchTimeout(tc.urls); got != tc.want {
+			t.Errorf("batchTimeout(%v) = %s, want %s", tc.urls, got, tc.want)
+		}
+	}
+}
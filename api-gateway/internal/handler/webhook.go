@@ -0,0 +1,313 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"youdlp/api-gateway/internal/middleware"
+	"youdlp/api-gateway/internal/models"
+	pb "youdlp/api-gateway/proto"
+)
+
+// WebhookHandler 用户 Webhook 订阅管理
+type WebhookHandler struct {
+	assetClient pb.AssetServiceClient
+	timeout     time.Duration
+}
+
+func NewWebhookHandler(assetClient pb.AssetServiceClient, timeout time.Duration) *WebhookHandler {
+	return &WebhookHandler{
+		assetClient: assetClient,
+		timeout:     timeout,
+	}
+}
+
+func (h *WebhookHandler) List(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		models.Unauthorized(c, "user not authenticated")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := h.assetClient.ListWebhooks(ctx, &pb.ListWebhooksRequest{UserId: userID})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	items := make([]models.WebhookEndpointResponse, 0, len(resp.GetItems()))
+	for _, item := range resp.GetItems() {
+		items = append(items, webhookEndpointResponse(item))
+	}
+	models.Success(c, models.WebhookListResponse{Items: items, AvailableEvents: resp.GetAvailableEvents()})
+}
+
+// Create 创建端点，签名密钥只在本次响应中返回
+func (h *WebhookHandler) Create(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		models.Unauthorized(c, "user not authenticated")
+		return
+	}
+
+	var req models.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		models.BadRequest(c, "invalid request: "+err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := h.assetClient.CreateWebhook(ctx, &pb.CreateWebhookRequest{
+		UserId:         userID,
+		Url:            req.URL,
+		Events:         req.Events,
+		Description:    req.Description,
+		OperatorUserId: userID,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	endpoint := webhookEndpointResponse(resp.GetEndpoint())
+	endpoint.Secret = resp.GetSecret()
+	models.Success(c, endpoint)
+}
+
+func (h *WebhookHandler) Update(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		models.Unauthorized(c, "user not authenticated")
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		models.BadRequest(c, "invalid webhook id")
+		return
+	}
+
+	var req models.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		models.BadRequest(c, "invalid request: "+err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	fields := webhookUpdateFromRequest(&req)
+	resp, err := h.assetClient.UpdateWebhook(ctx, &pb.UpdateWebhookRequest{
+		Id:                id,
+		UserId:            userID,
+		Url:               fields.url,
+		UpdateEvents:      fields.updateEvents,
+		Events:            fields.events,
+		UpdateDescription: fields.updateDescription,
+		Description:       fields.description,
+		Status:            fields.status,
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	models.Success(c, webhookEndpointResponse(resp.GetEndpoint()))
+}
+
+func (h *WebhookHandler) Delete(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		models.Unauthorized(c, "user not authenticated")
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		models.BadRequest(c, "invalid webhook id")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	if _, err := h.assetClient.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{Id: id, UserId: userID}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	models.Success(c, gin.H{"success": true})
+}
+
+func (h *WebhookHandler) ListDeliveries(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		models.Unauthorized(c, "user not authenticated")
+		return
+	}
+
+	var req models.WebhookDeliveryListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		models.BadRequest(c, "invalid request: "+err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	resp, err := h.assetClient.ListWebhookDeliveries(ctx, &pb.ListWebhookDeliveriesRequest{
+		UserId:     userID,
+		EndpointId: req.EndpointID,
+		Status:     req.Status,
+		EventType:  req.EventType,
+		Page:       int32(req.Page),
+		PageSize:   int32(req.PageSize),
+	})
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	items := make([]models.WebhookDeliveryResponse, 0, len(resp.GetItems()))
+	for _, item := range resp.GetItems() {
+		items = append(items, webhookDeliveryResponse(item))
+	}
+	models.Success(c, models.WebhookDeliveryListResponse{
+		Total:    resp.GetTotal(),
+		Page:     int(resp.GetPage()),
+		PageSize: int(resp.GetPageSize()),
+		Items:    items,
+	})
+}
+
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		models.Unauthorized(c, "user not authenticated")
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		models.BadRequest(c, "invalid delivery id")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	if _, err := h.assetClient.RedeliverWebhook(ctx, &pb.RedeliverWebhookRequest{DeliveryId: id, UserId: userID}); err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+
+	models.Success(c, gin.H{"success": true})
+}
+
+// webhookUpdateFields 更新请求映射到 gRPC 的字段，用户与管理端共用
+type webhookUpdateFields struct {
+	url               string
+	updateEvents      bool
+	events            []string
+	updateDescription bool
+	description       string
+	status            string
+}
+
+func webhookUpdateFromRequest(req *models.UpdateWebhookRequest) webhookUpdateFields {
+	fields := webhookUpdateFields{url: req.URL}
+	if req.Events != nil {
+		fields.updateEvents = true
+		fields.events = *req.Events
+	}
+	if req.Description != nil {
+		fields.updateDescription = true
+		fields.description = *req.Description
+	}
+	if req.Enabled != nil {
+		fields.status = "disabled"
+		if *req.Enabled {
+			fields.status = "enabled"
+		}
+	}
+	return fields
+}
+
+// webhookEndpointView 用户端与管理端端点消息的公共读取接口
+type webhookEndpointView interface {
+	GetId() int64
+	GetUrl() string
+	GetEvents() []string
+	GetDescription() string
+	GetEnabled() bool
+	GetCreatedAt() string
+	GetUpdatedAt() string
+}
+
+func webhookEndpointResponse(item webhookEndpointView) models.WebhookEndpointResponse {
+	events := item.GetEvents()
+	if events == nil {
+		events = []string{}
+	}
+	return models.WebhookEndpointResponse{
+		ID:          item.GetId(),
+		URL:         item.GetUrl(),
+		Events:      events,
+		Description: item.GetDescription(),
+		Enabled:     item.GetEnabled(),
+		CreatedAt:   item.GetCreatedAt(),
+		UpdatedAt:   item.GetUpdatedAt(),
+	}
+}
+
+// webhookDeliveryView 用户端与管理端投递记录消息的公共读取接口
+type webhookDeliveryView interface {
+	GetId() int64
+	GetEndpointId() int64
+	GetEventId() string
+	GetEventType() string
+	GetUserId() string
+	GetPayloadJson() string
+	GetStatus() string
+	GetAttempts() int32
+	GetNextAttemptAt() string
+	GetLastAttemptAt() string
+	GetResponseStatus() int32
+	GetResponseBody() string
+	GetLastError() string
+	GetDurationMs() int64
+	GetCreatedAt() string
+}
+
+func webhookDeliveryResponse(item webhookDeliveryView) models.WebhookDeliveryResponse {
+	var payload json.RawMessage
+	if json.Valid([]byte(item.GetPayloadJson())) {
+		payload = json.RawMessage(item.GetPayloadJson())
+	}
+	return models.WebhookDeliveryResponse{
+		ID:             item.GetId(),
+		EndpointID:     item.GetEndpointId(),
+		EventID:        item.GetEventId(),
+		EventType:      item.GetEventType(),
+		UserID:         item.GetUserId(),
+		Payload:        payload,
+		Status:         item.GetStatus(),
+		Attempts:       item.GetAttempts(),
+		NextAttemptAt:  item.GetNextAttemptAt(),
+		LastAttemptAt:  item.GetLastAttemptAt(),
+		ResponseStatus: item.GetResponseStatus(),
+		ResponseBody:   item.GetResponseBody(),
+		LastError:      item.GetLastError(),
+		DurationMS:     item.GetDurationMs(),
+		CreatedAt:      item.GetCreatedAt(),
+	}
+}
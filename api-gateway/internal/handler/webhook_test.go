@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+
+	pb "youdlp/api-gateway/proto"
+)
+
+type fakeWebhookAssetClient struct {
+	pb.AssetServiceClient
+
+	createReq *pb.CreateWebhookRequest
+	updateReq *pb.UpdateWebhookRequest
+}
+
+func (f *fakeWebhookAssetClient) CreateWebhook(_ context.Context, in *pb.CreateWebhookRequest, _ ...grpc.CallOption) (*pb.CreateWebhookResponse, error) {
+	f.createReq = in
+	return &pb.CreateWebhookResponse{
+		Endpoint: &pb.WebhookEndpointInfo{Id: 1, UserId: in.GetUserId(), Url: in.GetUrl(), Enabled: true},
+		Secret:   "whsec_test",
+	}, nil
+}
+
+func (f *fakeWebhookAssetClient) UpdateWebhook(_ context.Context, in *pb.UpdateWebhookRequest, _ ...grpc.CallOption) (*pb.UpdateWebhookResponse, error) {
+	f.updateReq = in
+	return &pb.UpdateWebhookResponse{Endpoint: &pb.WebhookEndpointInfo{Id: in.GetId()}}, nil
+}
+
+func TestCreateWebhookScopesToUserAndReturnsSecret(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	client := &fakeWebhookAssetClient{}
+	handler := NewWebhookHandler(client, time.Second)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/user/webhooks", bytes.NewBufferString(`{"url":"https://hooks.example.com/a","events":["task.completed"]}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Set("user_id", "user-1")
+
+	handler.Create(c)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if client.createReq.GetUserId() != "user-1" || client.createReq.GetGlobal() {
+		t.Fatalf("expected user scoped request, got %+v", client.createReq)
+	}
+	data := decodeResponseDataAsMap(t, w)
+	if data["secret"] != "whsec_test" {
+		t.Fatalf("expected secret in create response, got %#v", data)
+	}
+	assertHasKeys(t, data, "id", "url", "events", "enabled")
+}
+
+func TestUpdateWebhookOnlySendsPresentFields(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	client := &fakeWebhookAssetClient{}
+	handler := NewWebhookHandler(client, time.Second)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/user/webhooks/5", bytes.NewBufferString(`{"enabled":false}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = gin.Params{{Key: "id", Value: "5"}}
+	c.Set("user_id", "user-1")
+
+	handler.Update(c)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	req := client.updateReq
+	if req.GetId() != 5 || req.GetUserId() != "user-1" || req.GetStatus() != "disabled" {
+		t.Fatalf("unexpected update request: %+v", req)
+	}
+	if req.GetUpdateEvents() || req.GetUpdateDescription() || req.GetUrl() != "" {
+		t.Fatalf("expected absent fields to stay unchanged, got %+v", req)
+	}
+}
@@ -1,11 +1,23 @@
 package handler
 
 import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
 	"github.com/gin-gonic/gin"
 
+	"youdlp/api-gateway/internal/middleware"
+	"youdlp/api-gateway/internal/models"
 	"youdlp/api-gateway/internal/ws"
 )
 
+// sseTicketIssueTimeout 签发 SSE 票据时校验任务归属与写入 Redis 的超时
+const sseTicketIssueTimeout = 5 * time.Second
+
 // WebSocketHandler WebSocket 处理器
 type WebSocketHandler struct {
 	wsManager *ws.Manager
@@ -22,3 +34,48 @@ func NewWebSocketHandler(wsManager *ws.Manager) *WebSocketHandler {
 func (h *WebSocketHandler) Progress(c *gin.Context) {
 	h.wsManager.HandleConnection(c)
 }
+
+// ProgressStream 以 Server-Sent Events 推送任务进度
+func (h *WebSocketHandler) ProgressStream(c *gin.Context) {
+	h.wsManager.HandleSSE(c)
+}
+
+// CreateSSETicket 为原生 EventSource 签发短期 SSE 票据，同时写入仅限 SSE 路径的 Cookie
+func (h *WebSocketHandler) CreateSSETicket(c *gin.Context) {
+	var req models.SSETicketRequest
+	if err := c.ShouldBindJSON(&req); err != nil || strings.TrimSpace(req.TaskID) == "" {
+		models.BadRequest(c, "task_id is required")
+		return
+	}
+
+	userID := middleware.GetUserID(c)
+	if userID == "" {
+		models.Unauthorized(c, "user not authenticated")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), sseTicketIssueTimeout)
+	defer cancel()
+
+	ticket, err := h.wsManager.IssueSSETicket(ctx, userID, strings.TrimSpace(req.TaskID))
+	if err != nil {
+		if errors.Is(err, ws.ErrSSETicketUnavailable) {
+			log.Printf("[SSE] Failed to issue ticket for user %s: %v", userID, err)
+			models.InternalError(c, "failed to create sse ticket")
+			return
+		}
+		models.Forbidden(c, err.Error())
+		return
+	}
+
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(ws.SSETicketCookie, ticket, int(ws.SSETicketTTL/time.Second), "/api/v1/sse", "", isSecureRequest(c), true)
+	models.Success(c, models.SSETicketResponse{
+		Ticket:    ticket,
+		ExpiresIn: int64(ws.SSETicketTTL / time.Second),
+	})
+}
+
+func isSecureRequest(c *gin.Context) bool {
+	return c.Request.TLS != nil || strings.EqualFold(c.GetHeader("X-Forwarded-Proto"), "https")
+}
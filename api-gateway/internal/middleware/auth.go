@@ -51,6 +51,16 @@ func GetUserID(c *gin.Context) string {
 	return ""
 }
 
+// GetUserRole 从上下文获取用户角色
+func GetUserRole(c *gin.Context) string {
+	if role, exists := c.Get("user_role"); exists {
+		if value, ok := role.(string); ok {
+			return value
+		}
+	}
+	return ""
+}
+
 // GetUserEmail 从上下文获取用户邮箱
 func GetUserEmail(c *gin.Context) string {
 	if email, exists := c.Get("user_email"); exists {
@@ -126,6 +126,7 @@ type AdminDashboardHealthResponse struct {
 	ProxyPolicy AdminDashboardProxyPolicy `json:"proxy_policy"`
 	Cookies     AdminDashboardCookies     `json:"cookies"`
 	Billing     AdminDashboardBilling     `json:"billing"`
+	Workers     AdminDashboardWorkers     `json:"workers"`
 	Exceptions  []AdminDashboardException `json:"exceptions"`
 }
 
@@ -275,3 +276,98 @@ type CookieListResponse struct {
 	PageSize int          `json:"page_size"`
 	Items    []CookieInfo `json:"items"`
 }
+
+type AdminTask struct {
+	TaskID         string `json:"task_id"`
+	UserID         string `json:"user_id"`
+	URL            string `json:"url"`
+	Platform       string `json:"platform"`
+	Title          string `json:"title,omitempty"`
+	Mode           string `json:"mode"`
+	Quality        string `json:"quality,omitempty"`
+	Status         int32  `json:"status"`
+	StatusText     string `json:"status_text"`
+	ErrorMessage   string `json:"error_message,omitempty"`
+	ErrorCategory  string `json:"error_category,omitempty"`
+	RetryCount     int32  `json:"retry_count"`
+	CreatedAt      string `json:"created_at"`
+	StartedAt      string `json:"started_at,omitempty"`
+	DeadLetteredAt string `json:"dead_lettered_at,omitempty"`
+	DeadLettered   bool   `json:"dead_lettered"`
+	Stuck          bool   `json:"stuck"`
+	Replayable     bool   `json:"replayable"`
+}
+
+type AdminTaskActionRequest struct {
+	Reason            string `json:"reason"`
+	StuckAfterSeconds int64  `json:"stuck_after_seconds"`
+}
+
+type AdminTaskActionResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+type AdminDashboardWorkers struct {
+	Available       bool    `json:"available"`
+	Instances       int32   `json:"instances"`
+	MaxConcurrent   int32   `json:"max_concurrent"`
+	Running         int32   `json:"running"`
+	Buffered        int32   `json:"buffered"`
+	Queued          int64   `json:"queued"`
+	PausedInstances int32   `json:"paused_instances"`
+	Saturation      float64 `json:"saturation"`
+}
+
+type AdminWorkerActiveTask struct {
+	TaskID    string  `json:"task_id"`
+	UserID    string  `json:"user_id"`
+	Platform  string  `json:"platform"`
+	Phase     string  `json:"phase,omitempty"`
+	Percent   float64 `json:"percent"`
+	StartedAt string  `json:"started_at"`
+}
+
+type AdminWorkerQueueDepth struct {
+	Queue     string `json:"queue"`
+	Platform  string `json:"platform,omitempty"`
+	Messages  int64  `json:"messages"`
+	Consumers int32  `json:"consumers"`
+	Paused    bool   `json:"paused"`
+}
+
+type AdminWorkerPool struct {
+	InstanceID     string                  `json:"instance_id"`
+	PoolSize       int32                   `json:"pool_size"`
+	MaxConcurrent  int32                   `json:"max_concurrent"`
+	Running        int32                   `json:"running"`
+	Buffered       int32                   `json:"buffered"`
+	BufferCapacity int32                   `json:"buffer_capacity"`
+	Paused         bool                    `json:"paused"`
+	Queues         []AdminWorkerQueueDepth `json:"queues"`
+	ActiveTasks    []AdminWorkerActiveTask `json:"active_tasks"`
+	UpdatedAt      string                  `json:"updated_at"`
+}
+
+type AdminUpdateWorkerPoolRequest struct {
+	InstanceID    string `json:"instance_id"`
+	Action        string `json:"action" binding:"required"`
+	MaxConcurrent int32  `json:"max_concurrent"`
+}
+
+type AdminPlatform struct {
+	Name              string   `json:"name"`
+	DisplayName       string   `json:"display_name"`
+	Enabled           bool     `json:"enabled"`
+	Patterns          []string `json:"patterns"`
+	Adapter           string   `json:"adapter"`
+	ExtraArgs         []string `json:"extra_args"`
+	Cookies           string   `json:"cookies"`
+	Proxy             string   `json:"proxy"`
+	ParsePerMinute    int64    `json:"parse_per_minute"`
+	DownloadPerMinute int64    `json:"download_per_minute"`
+}
+
+type AdminUpdatePlatformRequest struct {
+	Enabled *bool `json:"enabled"`
+}
@@ -50,32 +50,114 @@ type ParseRequest struct {
 
 // ParseResponse 解析响应
 type ParseResponse struct {
-	VideoID     string        `json:"video_id"`
-	Platform    string        `json:"platform"`
-	Title       string        `json:"title"`
-	Description string        `json:"description,omitempty"`
-	Duration    int64         `json:"duration"`
-	Thumbnail   string        `json:"thumbnail"`
-	Author      string        `json:"author"`
-	UploadDate  string        `json:"upload_date,omitempty"`
-	ViewCount   int64         `json:"view_count,omitempty"`
-	Formats     []VideoFormat `json:"formats"`
+	VideoID        string          `json:"video_id"`
+	Platform       string          `json:"platform"`
+	Title          string          `json:"title"`
+	Description    string          `json:"description,omitempty"`
+	Duration       int64           `json:"duration"`
+	Thumbnail      string          `json:"thumbnail"`
+	Author         string          `json:"author"`
+	UploadDate     string          `json:"upload_date,omitempty"`
+	ViewCount      int64           `json:"view_count,omitempty"`
+	Formats        []VideoFormat   `json:"formats"`
+	Subtitles      []SubtitleTrack `json:"subtitles"`
+	Chapters       []Chapter       `json:"chapters"`
+	AudioLanguages []string        `json:"audio_languages,omitempty"` // 可选的配音音轨语言，下载时通过 audio_language 指定
+	LiveStatus     string          `json:"live_status,omitempty"`     // not_live, is_live, was_live, is_upcoming, post_live
+	AgeLimit       int32           `json:"age_limit,omitempty"`
+	Tags           []string        `json:"tags,omitempty"`
+	Categories     []string        `json:"categories,omitempty"`
+	ChannelID      string          `json:"channel_id,omitempty"`
+	ChannelURL     string          `json:"channel_url,omitempty"`
+}
+
+// Chapter 视频章节（秒）
+type Chapter struct {
+	Title     string  `json:"title"`
+	StartTime float64 `json:"start_time"`
+	EndTime   float64 `json:"end_time"`
+}
+
+// SubtitleTrack 可选字幕轨道
+type SubtitleTrack struct {
+	Language  string   `json:"language"`
+	Name      string   `json:"name,omitempty"`
+	Automatic bool     `json:"automatic"` // 是否为自动生成字幕
+	Formats   []string `json:"formats"`
+}
+
+// ParseBatchRequest 批量解析请求
+type ParseBatchRequest struct {
+	URLs      []string `json:"urls" binding:"required,min=1,max=50"`
+	SkipCache bool     `json:"skip_cache"`
+	Stream    bool     `json:"stream"` // 为 true 时以 SSE 逐条推送解析完成的条目
+}
+
+// ParseBatchItem 批量解析条目，同一视频的多个写法合并为一个条目
+type ParseBatchItem struct {
+	Indexes   []int32        `json:"indexes"` // 请求 urls 中解析到该视频的下标（从 0 开始）
+	URL       string         `json:"url"`
+	Status    string         `json:"status"` // success / failed / timeout（截止前未返回）
+	Data      *ParseResponse `json:"data,omitempty"`
+	ErrorCode string         `json:"error_code,omitempty"` // 如 invalid_url、video_private、rate_limited
+	Error     string         `json:"error,omitempty"`
+}
+
+// ParseBatchResponse 批量解析响应，流式请求的 done 事件不含 items
+type ParseBatchResponse struct {
+	Total     int              `json:"total"`  // 请求的 URL 数
+	Unique    int              `json:"unique"` // 去重后的条目数
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Items     []ParseBatchItem `json:"items,omitempty"`
+}
+
+// ParsePlaylistRequest 播放列表/频道解析请求
+type ParsePlaylistRequest struct {
+	URL      string `json:"url" binding:"required"`
+	Page     int    `json:"page"`
+	PageSize int    `json:"page_size"`
+}
+
+// ParsePlaylistResponse 播放列表/频道解析响应
+type ParsePlaylistResponse struct {
+	PlaylistID string          `json:"playlist_id"`
+	Platform   string          `json:"platform"`
+	Title      string          `json:"title"`
+	Author     string          `json:"author,omitempty"`
+	TotalCount int64           `json:"total_count"` // 平台未返回总数时为 0
+	Page       int32           `json:"page"`
+	PageSize   int32           `json:"page_size"`
+	HasMore    bool            `json:"has_more"`
+	Entries    []PlaylistEntry `json:"entries"`
+}
+
+// PlaylistEntry 播放列表条目
+type PlaylistEntry struct {
+	Index     int32  `json:"index"`
+	VideoID   string `json:"video_id"`
+	URL       string `json:"url"`
+	Title     string `json:"title"`
+	Duration  int64  `json:"duration"`
+	Thumbnail string `json:"thumbnail,omitempty"`
 }
 
 // VideoFormat 视频格式
 type VideoFormat struct {
-	FormatID   string  `json:"format_id"`
-	Quality    string  `json:"quality"`
-	Extension  string  `json:"extension"`
-	Filesize   int64   `json:"filesize"`
-	Height     int32   `json:"height,omitempty"`
-	Width      int32   `json:"width,omitempty"`
-	FPS        float64 `json:"fps,omitempty"`
-	VideoCodec string  `json:"video_codec,omitempty"`
-	AudioCodec string  `json:"audio_codec,omitempty"`
-	VBR        float64 `json:"vbr,omitempty"`
-	ABR        float64 `json:"abr,omitempty"`
-	ASR        int32   `json:"asr,omitempty"`
+	FormatID     string  `json:"format_id"`
+	Quality      string  `json:"quality"`
+	Extension    string  `json:"extension"`
+	Filesize     int64   `json:"filesize"`
+	Height       int32   `json:"height,omitempty"`
+	Width        int32   `json:"width,omitempty"`
+	FPS          float64 `json:"fps,omitempty"`
+	VideoCodec   string  `json:"video_codec,omitempty"`
+	AudioCodec   string  `json:"audio_codec,omitempty"`
+	VBR          float64 `json:"vbr,omitempty"`
+	ABR          float64 `json:"abr,omitempty"`
+	ASR          int32   `json:"asr,omitempty"`
+	Language     string  `json:"language,omitempty"`      // 音轨语言
+	DynamicRange string  `json:"dynamic_range,omitempty"` // SDR, HDR10, HLG, DV 等
 }
 
 // SelectedFormat 选中的精确格式信息
@@ -96,12 +178,37 @@ type SelectedFormat struct {
 
 // DownloadRequest 下载请求
 type DownloadRequest struct {
-	URL            string          `json:"url" binding:"required"`
-	Mode           string          `json:"mode" binding:"required,oneof=quick_download archive"` // quick_download 或 archive
-	Quality        string          `json:"quality"`                                              // 1080p, 720p, 160kbps, etc.
-	Format         string          `json:"format"`                                               // mp4, webm, m4a
-	FormatID       string          `json:"format_id"`
-	SelectedFormat *SelectedFormat `json:"selected_format,omitempty"`
+	URL            string              `json:"url" binding:"required"`
+	Mode           string              `json:"mode" binding:"required,oneof=quick_download archive"` // quick_download 或 archive
+	Quality        string              `json:"quality"`                                              // 1080p, 720p, 160kbps, etc.
+	Format         string              `json:"format"`                                               // mp4, webm, m4a
+	FormatID       string              `json:"format_id"`
+	SelectedFormat *SelectedFormat     `json:"selected_format,omitempty"`
+	Subtitles      *SubtitleOptions    `json:"subtitles,omitempty"`                       // 为空表示不下载字幕
+	PostProcess    *PostProcessOptions `json:"post_process,omitempty"`                    // 为空表示保留原始文件
+	Clip           *ClipOptions        `json:"clip,omitempty"`                            // 为空表示下载完整视频
+	AudioLanguage  string              `json:"audio_language" binding:"omitempty,max=32"` // 配音音轨语言，如 en、es，平台无该音轨时回退默认音轨
+}
+
+// ClipOptions 片段下载选项（秒）
+type ClipOptions struct {
+	StartTime     float64 `json:"start_time" binding:"omitempty,min=0"`           // 0 表示从头开始
+	EndTime       float64 `json:"end_time" binding:"omitempty,gtfield=StartTime"` // 0 表示到结尾
+	SplitChapters bool    `json:"split_chapters"`                                 // 按解析得到的章节拆分输出
+}
+
+// PostProcessOptions 后处理（转码）选项
+type PostProcessOptions struct {
+	Profile      string `json:"profile" binding:"required,oneof=audio_mp3 audio_flac audio_opus remux_mp4 remux_mkv h264_mp4"`
+	AudioBitrate int    `json:"audio_bitrate" binding:"omitempty,min=32,max=512"` // kbps，仅 audio_mp3/audio_opus/h264_mp4 生效
+}
+
+// SubtitleOptions 字幕下载选项
+type SubtitleOptions struct {
+	Languages   []string `json:"languages" binding:"required,min=1,max=20,dive,max=32"` // 语言代码，如 en、zh-Hans
+	IncludeAuto bool     `json:"include_auto"`                                          // 是否允许使用自动生成字幕
+	Mode        string   `json:"mode" binding:"omitempty,oneof=embed sidecar"`          // embed 嵌入视频，sidecar 独立文件（默认）
+	Format      string   `json:"format" binding:"omitempty,oneof=srt vtt"`              // 独立字幕文件格式，默认 srt
 }
 
 // DownloadResponse 下载响应
@@ -111,6 +218,89 @@ type DownloadResponse struct {
 	EstimatedTime int    `json:"estimated_time"` // 预计耗时(秒)
 }
 
+// BatchDownloadRequest 批量下载请求（播放列表/频道中选中的条目）
+type BatchDownloadRequest struct {
+	SourceURL     string              `json:"source_url"` // 来源播放列表/频道地址，可选
+	Title         string              `json:"title"`
+	Mode          string              `json:"mode" binding:"required,oneof=quick_download archive"`
+	Quality       string              `json:"quality"` // 批量下载按清晰度档位选择格式
+	Format        string              `json:"format"`
+	Subtitles     *SubtitleOptions    `json:"subtitles,omitempty"`                       // 应用到所有条目
+	PostProcess   *PostProcessOptions `json:"post_process,omitempty"`                    // 应用到所有条目
+	AudioLanguage string              `json:"audio_language" binding:"omitempty,max=32"` // 应用到所有条目
+	Entries       []BatchDownloadItem `json:"entries" binding:"required,min=1,max=100,dive"`
+}
+
+// BatchDownloadItem 批量下载条目
+type BatchDownloadItem struct {
+	URL   string `json:"url" binding:"required"`
+	Title string `json:"title"`
+}
+
+// BatchDownloadResponse 批量下载提交响应
+type BatchDownloadResponse struct {
+	BatchID string `json:"batch_id"`
+	Total   int    `json:"total"`
+	Status  string `json:"status"`
+}
+
+// BatchStatusResponse 批量下载聚合进度
+type BatchStatusResponse struct {
+	BatchID     string            `json:"batch_id"`
+	SourceURL   string            `json:"source_url,omitempty"`
+	Title       string            `json:"title,omitempty"`
+	Status      string            `json:"status"` // submitting, running, completed, partial_failed, failed
+	Total       int               `json:"total"`
+	Queued      int               `json:"queued"`
+	Rejected    int               `json:"rejected"`
+	Pending     int               `json:"pending"`
+	Downloading int               `json:"downloading"`
+	Completed   int               `json:"completed"`
+	Failed      int               `json:"failed"`
+	Percent     float64           `json:"percent"` // 已结束条目（含失败）按 100 计
+	CreatedAt   string            `json:"created_at"`
+	Items       []BatchStatusItem `json:"items"`
+}
+
+// BatchStatusItem 批量下载条目状态
+type BatchStatusItem struct {
+	Index     int     `json:"index"`
+	URL       string  `json:"url"`
+	Title     string  `json:"title,omitempty"`
+	TaskID    string  `json:"task_id,omitempty"`
+	HistoryID int64   `json:"history_id,omitempty"`
+	Status    string  `json:"status"` // queued, rejected, pending, downloading, completed, failed
+	Percent   float64 `json:"percent"`
+	Error     string  `json:"error,omitempty"`
+	// 待处理任务的排队位置
+	QueuePosition int64 `json:"queue_position,omitempty"`
+}
+
+// TaskStatusResponse 下载任务状态，待处理任务附带排队位置与预计开始时间
+type TaskStatusResponse struct {
+	TaskID                string  `json:"task_id"`
+	Status                int32   `json:"status"` // 0:待处理, 1:下载中, 2:完成, 3:失败, 4:待清理, 5:已过期
+	StatusText            string  `json:"status_text"`
+	Percent               float64 `json:"percent"`
+	DownloadedBytes       int64   `json:"downloaded_bytes"`
+	TotalBytes            int64   `json:"total_bytes"`
+	Speed                 string  `json:"speed,omitempty"`
+	ETA                   string  `json:"eta,omitempty"`
+	ErrorMessage          string  `json:"error_message,omitempty"`
+	CreatedAt             string  `json:"created_at"`
+	CompletedAt           string  `json:"completed_at,omitempty"`
+	QueuePosition         int64   `json:"queue_position,omitempty"`          // 排队位置，从 1 开始
+	EstimatedStartSeconds int64   `json:"estimated_start_seconds,omitempty"` // 预计开始前的等待秒数，无法估算时省略
+	EstimatedStartAt      string  `json:"estimated_start_at,omitempty"`
+}
+
+// CancelDownloadResponse 取消下载响应
+type CancelDownloadResponse struct {
+	TaskID    string `json:"task_id"`
+	Cancelled bool   `json:"cancelled"`
+	Message   string `json:"message"`
+}
+
 // HistoryRequest 历史查询请求
 type HistoryRequest struct {
 	Status    int    `form:"status"`
@@ -158,6 +348,22 @@ type FileDownloadRequest struct {
 // FileDownloadTicketRequest 创建浏览器下载票据请求
 type FileDownloadTicketRequest struct {
 	HistoryID int64 `json:"history_id" binding:"required"`
+	FileID    int64 `json:"file_id"` // 附加产物 ID（字幕等），为空表示主文件
+}
+
+// FileAttachment 下载记录的附加产物
+type FileAttachment struct {
+	FileID   int64  `json:"file_id"`
+	Kind     string `json:"kind"` // subtitle
+	Language string `json:"language,omitempty"`
+	FileName string `json:"file_name"`
+	FileSize int64  `json:"file_size"`
+}
+
+// FileAttachmentsResponse 附加产物列表响应
+type FileAttachmentsResponse struct {
+	HistoryID   int64            `json:"history_id"`
+	Attachments []FileAttachment `json:"attachments"`
 }
 
 // FileDownloadTicketResponse 浏览器下载票据响应
@@ -166,6 +372,17 @@ type FileDownloadTicketResponse struct {
 	ExpiresIn int64  `json:"expires_in"`
 }
 
+// SSETicketRequest 创建 SSE 进度票据请求
+type SSETicketRequest struct {
+	TaskID string `json:"task_id" binding:"required"`
+}
+
+// SSETicketResponse SSE 进度票据响应，票据通过查询参数 ticket 或 Cookie sse_ticket 携带
+type SSETicketResponse struct {
+	Ticket    string `json:"ticket"`
+	ExpiresIn int64  `json:"expires_in"`
+}
+
 // StatsResponse 用户统计响应
 type StatsResponse struct {
 	TotalDownloads   int64           `json:"total_downloads"`
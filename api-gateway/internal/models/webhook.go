@@ -0,0 +1,66 @@
+package models
+
+import "encoding/json"
+
+type WebhookEndpointResponse struct {
+	ID          int64    `json:"id"`
+	URL         string   `json:"url"`
+	Events      []string `json:"events"`
+	Description string   `json:"description"`
+	Enabled     bool     `json:"enabled"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+	Secret      string   `json:"secret,omitempty"` // 仅创建时返回
+}
+
+type WebhookListResponse struct {
+	Items           []WebhookEndpointResponse `json:"items"`
+	AvailableEvents []string                  `json:"available_events"`
+}
+
+type CreateWebhookRequest struct {
+	URL         string   `json:"url" binding:"required"`
+	Events      []string `json:"events"` // 为空表示订阅全部事件
+	Description string   `json:"description"`
+}
+
+// UpdateWebhookRequest 未出现的字段保持不变
+type UpdateWebhookRequest struct {
+	URL         string    `json:"url"`
+	Events      *[]string `json:"events"`
+	Description *string   `json:"description"`
+	Enabled     *bool     `json:"enabled"`
+}
+
+type WebhookDeliveryListRequest struct {
+	EndpointID int64  `form:"endpoint_id"`
+	Status     string `form:"status"`
+	EventType  string `form:"event_type"`
+	Page       int    `form:"page,default=1"`
+	PageSize   int    `form:"page_size,default=20"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             int64           `json:"id"`
+	EndpointID     int64           `json:"endpoint_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	UserID         string          `json:"user_id"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int32           `json:"attempts"`
+	NextAttemptAt  string          `json:"next_attempt_at,omitempty"`
+	LastAttemptAt  string          `json:"last_attempt_at,omitempty"`
+	ResponseStatus int32           `json:"response_status"`
+	ResponseBody   string          `json:"response_body"`
+	LastError      string          `json:"last_error"`
+	DurationMS     int64           `json:"duration_ms"`
+	CreatedAt      string          `json:"created_at"`
+}
+
+type WebhookDeliveryListResponse struct {
+	Total    int64                     `json:"total"`
+	Page     int                       `json:"page"`
+	PageSize int                       `json:"page_size"`
+	Items    []WebhookDeliveryResponse `json:"items"`
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"sync"
 	"time"
 
@@ -18,21 +19,50 @@ var ErrUnavailable = errors.New("download queue unavailable")
 
 // DownloadTask 下载任务消息
 type DownloadTask struct {
-	TaskID         string                 `json:"task_id"`
-	UserID         string                 `json:"user_id"`
-	HistoryID      int64                  `json:"history_id"`
-	URL            string                 `json:"url"`
-	Mode           string                 `json:"mode"`    // quick_download, archive
-	Quality        string                 `json:"quality"` // 1080p, 720p, 160kbps, etc.
-	Format         string                 `json:"format"`  // mp4, webm, m4a
-	FormatID       string                 `json:"format_id"`
-	SelectedFormat *SelectedFormatMessage `json:"selected_format,omitempty"`
-	Platform       string                 `json:"platform"`
-	Title          string                 `json:"title"`
-	CookieID       int64                  `json:"cookie_id"`       // parser 使用的 cookie ID
-	ProxyURL       string                 `json:"proxy_url"`       // parser 使用的 proxy URL
-	ProxyLeaseID   string                 `json:"proxy_lease_id"`  // parser 使用的动态代理租约 ID
-	ProxyExpireAt  string                 `json:"proxy_expire_at"` // parser 获取到的代理过期时间
+	TaskID         string                     `json:"task_id"`
+	UserID         string                     `json:"user_id"`
+	HistoryID      int64                      `json:"history_id"`
+	URL            string                     `json:"url"`
+	Mode           string                     `json:"mode"`    // quick_download, archive
+	Quality        string                     `json:"quality"` // 1080p, 720p, 160kbps, etc.
+	Format         string                     `json:"format"`  // mp4, webm, m4a
+	FormatID       string                     `json:"format_id"`
+	SelectedFormat *SelectedFormatMessage     `json:"selected_format,omitempty"`
+	Platform       string                     `json:"platform"`
+	Title          string                     `json:"title"`
+	CookieID       int64                      `json:"cookie_id"`       // parser 使用的 cookie ID
+	ProxyURL       string                     `json:"proxy_url"`       // parser 使用的 proxy URL
+	ProxyLeaseID   string                     `json:"proxy_lease_id"`  // parser 使用的动态代理租约 ID
+	ProxyExpireAt  string                     `json:"proxy_expire_at"` // parser 获取到的代理过期时间
+	BatchID        string                     `json:"batch_id,omitempty"`
+	VideoID        string                     `json:"video_id,omitempty"` // 平台视频 ID，供 worker 去重存储
+	Subtitles      *SubtitleOptionsMessage    `json:"subtitles,omitempty"`
+	PostProcess    *PostProcessOptionsMessage `json:"post_process,omitempty"`
+	Clip           *ClipOptionsMessage        `json:"clip,omitempty"`
+	AudioLanguage  string                     `json:"audio_language,omitempty"` // 配音音轨语言，为空时使用平台默认音轨
+	Priority       uint8                      `json:"priority,omitempty"`       // 消息优先级
+	PriorityClass  string                     `json:"priority_class,omitempty"` // 优先级类别，决定单用户并发上限
+}
+
+// ClipOptionsMessage MQ 内透传的片段下载选项（秒）
+type ClipOptionsMessage struct {
+	StartTime     float64 `json:"start_time,omitempty"`
+	EndTime       float64 `json:"end_time,omitempty"`
+	SplitChapters bool    `json:"split_chapters,omitempty"`
+}
+
+// PostProcessOptionsMessage MQ 内透传的后处理选项
+type PostProcessOptionsMessage struct {
+	Profile      string `json:"profile"`
+	AudioBitrate int    `json:"audio_bitrate,omitempty"`
+}
+
+// SubtitleOptionsMessage MQ 内透传的字幕选项
+type SubtitleOptionsMessage struct {
+	Languages   []string `json:"languages"`
+	IncludeAuto bool     `json:"include_auto,omitempty"`
+	Mode        string   `json:"mode,omitempty"`
+	Format      string   `json:"format,omitempty"`
 }
 
 // SelectedFormatMessage MQ 内透传的精确格式信息
@@ -113,12 +143,12 @@ func (p *Publisher) connect() error {
 
 	// 声明队列
 	_, err = channel.QueueDeclare(
-		cfg.Queue, // 队列名称
-		true,      // 持久化
-		false,     // 自动删除
-		false,     // 独占
-		false,     // 不等待
-		nil,       // 参数
+		cfg.Queue,       // 队列名称
+		true,            // 持久化
+		false,           // 自动删除
+		false,           // 独占
+		false,           // 不等待
+		queueArgs(&cfg), // 参数
 	)
 	if err != nil {
 		channel.Close()
@@ -140,6 +170,15 @@ func (p *Publisher) connect() error {
 		return fmt.Errorf("failed to bind queue: %w", err)
 	}
 
+	// 声明按平台路由的交换机
+	if cfg.PlatformExchange != "" {
+		if err := declarePlatformExchange(channel, cfg.PlatformExchange, cfg.Queue); err != nil {
+			channel.Close()
+			conn.Close()
+			return fmt.Errorf("failed to declare platform exchange: %w", err)
+		}
+	}
+
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
@@ -208,15 +247,17 @@ func (p *Publisher) Publish(ctx context.Context, task *DownloadTask) error {
 	}
 
 	// 发布消息
+	exchange, routingKey := p.route(task)
 	err = p.channel.PublishWithContext(ctx,
-		p.cfg.Exchange,   // 交换机
-		p.cfg.RoutingKey, // 路由键
-		false,            // mandatory
-		false,            // immediate
+		exchange,   // 交换机
+		routingKey, // 路由键
+		false,      // mandatory
+		false,      // immediate
 		amqp.Publishing{
 			DeliveryMode: amqp.Persistent, // 持久化
 			ContentType:  "application/json",
 			Body:         body,
+			Priority:     task.Priority,
 			Timestamp:    time.Now(),
 		},
 	)
@@ -232,6 +273,44 @@ func (p *Publisher) Publish(ctx context.Context, task *DownloadTask) error {
 	return nil
 }
 
+// route 配置了平台交换机时按任务平台路由，media-service 为拆分的平台声明独立队列
+func (p *Publisher) route(task *DownloadTask) (string, string) {
+	if p.cfg.PlatformExchange == "" {
+		return p.cfg.Exchange, p.cfg.RoutingKey
+	}
+	return p.cfg.PlatformExchange, platformRoutingKey(task.Platform)
+}
+
+// declarePlatformExchange 声明按平台路由的 topic 交换机，没有平台队列绑定的消息经备用交换机进入默认队列。
+// 参数需与 media-service 的声明一致
+func declarePlatformExchange(ch *amqp.Channel, exchange, defaultQueue string) error {
+	unrouted := exchange + ".unrouted"
+	if err := ch.ExchangeDeclare(unrouted, "fanout", true, false, false, false, nil); err != nil {
+		return err
+	}
+	if err := ch.QueueBind(defaultQueue, "", unrouted, false, nil); err != nil {
+		return err
+	}
+	return ch.ExchangeDeclare(exchange, "topic", true, false, false, false, amqp.Table{"alternate-exchange": unrouted})
+}
+
+// platformRoutingKey 平台交换机的路由键
+func platformRoutingKey(platform string) string {
+	platform = strings.ToLower(strings.TrimSpace(platform))
+	if platform == "" {
+		platform = "generic"
+	}
+	return "download." + platform
+}
+
+// queueArgs 下载队列参数，配置了最大优先级时声明为优先级队列
+func queueArgs(cfg *config.RabbitMQConfig) amqp.Table {
+	if cfg.MaxPriority <= 0 {
+		return nil
+	}
+	return amqp.Table{"x-max-priority": int32(cfg.MaxPriority)}
+}
+
 func (p *Publisher) IsReady() bool {
 	p.mu.Lock()
 	defer p.mu.Unlock()
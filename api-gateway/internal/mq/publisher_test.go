@@ -39,3 +39,20 @@ func TestPublishReturnsUnavailableWhenDisconnected(t *testing.T) {
 		t.Fatalf("expected ErrUnavailable, got %v", err)
 	}
 }
+
+func TestPublisherRoutesByPlatform(t *testing.T) {
+	t.Parallel()
+
+	publisher := &Publisher{cfg: &config.RabbitMQConfig{Exchange: "test.exchange", RoutingKey: "test.routing"}}
+	if exchange, key := publisher.route(&DownloadTask{Platform: "youtube"}); exchange != "test.exchange" || key != "test.routing" {
+		t.Fatalf("expected default route without platform exchange, got %s/%s", exchange, key)
+	}
+
+	publisher.cfg.PlatformExchange = "test.platform"
+	if exchange, key := publisher.route(&DownloadTask{Platform: "YouTube"}); exchange != "test.platform" || key != "download.youtube" {
+		t.Fatalf("expected platform route, got %s/%s", exchange, key)
+	}
+	if _, key := publisher.route(&DownloadTask{}); key != "download.generic" {
+		t.Fatalf("expected generic routing key for unknown platform, got %s", key)
+	}
+}
@@ -1,6 +1,8 @@
 package router
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
 	"github.com/redis/go-redis/v9"
 
@@ -9,6 +11,7 @@ import (
 	"youdlp/api-gateway/internal/handler"
 	"youdlp/api-gateway/internal/middleware"
 	"youdlp/api-gateway/internal/mq"
+	"youdlp/api-gateway/internal/storage"
 	"youdlp/api-gateway/internal/ws"
 )
 
@@ -19,6 +22,9 @@ type Dependencies struct {
 	RedisClient *redis.Client
 	MQPublisher *mq.Publisher
 	WSManager   *ws.Manager
+	FileStore   storage.Storage
+	// Background 后台任务（如批量提交接管）的生命周期，服务关闭时取消
+	Background context.Context
 }
 
 // SetupRouter 设置路由
@@ -47,6 +53,7 @@ func SetupRouter(deps *Dependencies) *gin.Engine {
 	parseHandler := handler.NewParseHandler(
 		deps.GRPCClients.MediaClient,
 		deps.Config.GRPC.Timeout,
+		deps.Config.GRPC.ParseBatchConcurrency,
 	)
 	downloadHandler := handler.NewDownloadHandler(
 		deps.GRPCClients.AssetClient,
@@ -54,6 +61,20 @@ func SetupRouter(deps *Dependencies) *gin.Engine {
 		deps.MQPublisher,
 		deps.Config.GRPC.Timeout,
 		deps.Config.Billing.Enabled,
+		&deps.Config.Scheduling,
+	)
+	batchDownloadHandler := handler.NewBatchDownloadHandler(
+		downloadHandler,
+		handler.NewRedisDownloadBatchStore(deps.RedisClient),
+		deps.GRPCClients.DownloaderClient,
+		deps.Config.GRPC.Timeout,
+	)
+	if deps.Background != nil {
+		go batchDownloadHandler.RunSweeper(deps.Background, handler.DownloadBatchSweepInterval)
+	}
+	downloadTaskHandler := handler.NewDownloadTaskHandler(
+		deps.GRPCClients.DownloaderClient,
+		deps.Config.GRPC.Timeout,
 	)
 	historyHandler := handler.NewHistoryHandler(
 		deps.GRPCClients.AssetClient,
@@ -63,9 +84,14 @@ func SetupRouter(deps *Dependencies) *gin.Engine {
 		deps.GRPCClients.AssetClient,
 		deps.Config.GRPC.Timeout,
 	)
+	webhookHandler := handler.NewWebhookHandler(
+		deps.GRPCClients.AssetClient,
+		deps.Config.GRPC.Timeout,
+	)
 	fileHandler := handler.NewFileHandler(
 		deps.GRPCClients.AssetClient,
 		handler.NewRedisDownloadTicketStore(deps.RedisClient),
+		deps.FileStore,
 		deps.Config.GRPC.Timeout,
 		deps.Config.FileDownload.BufferSize,
 		deps.Config.Billing.Enabled,
@@ -99,6 +125,14 @@ func SetupRouter(deps *Dependencies) *gin.Engine {
 		deps.GRPCClients.AdminClient,
 		deps.Config.GRPC.Timeout,
 	)
+	adminTaskHandler := handler.NewAdminTaskHandler(
+		deps.GRPCClients.AdminClient,
+		deps.Config.GRPC.Timeout,
+	)
+	adminWebhookHandler := handler.NewAdminWebhookHandler(
+		deps.GRPCClients.AdminClient,
+		deps.Config.GRPC.Timeout,
+	)
 
 	// ==================== 公开路由 ====================
 	// 健康检查 (无需认证)
@@ -131,9 +165,15 @@ func SetupRouter(deps *Dependencies) *gin.Engine {
 
 		// 解析
 		protectedV1.POST("/parse", parseHandler.ParseURL)
+		protectedV1.POST("/parse/playlist", parseHandler.ParsePlaylist)
+		protectedV1.POST("/parse/batch", parseHandler.ParseBatch)
 
 		// 下载
 		protectedV1.POST("/download", downloadHandler.SubmitDownload)
+		protectedV1.POST("/download/:taskId/cancel", downloadTaskHandler.CancelTask)
+		protectedV1.GET("/download/:taskId/status", downloadTaskHandler.GetTaskStatus)
+		protectedV1.POST("/download/batch", batchDownloadHandler.SubmitBatch)
+		protectedV1.GET("/download/batch/:batchId", batchDownloadHandler.GetBatch)
 
 		// 用户数据
 		protectedV1.GET("/user/history", historyHandler.GetHistory)
@@ -144,9 +184,19 @@ func SetupRouter(deps *Dependencies) *gin.Engine {
 		protectedV1.POST("/user/billing/estimate", billingHandler.Estimate)
 		protectedV1.DELETE("/user/history/:id", historyHandler.DeleteHistory)
 
+		// Webhook 订阅
+		protectedV1.GET("/user/webhooks", webhookHandler.List)
+		protectedV1.POST("/user/webhooks", webhookHandler.Create)
+		protectedV1.PUT("/user/webhooks/:id", webhookHandler.Update)
+		protectedV1.DELETE("/user/webhooks/:id", webhookHandler.Delete)
+		protectedV1.GET("/user/webhooks/deliveries", webhookHandler.ListDeliveries)
+		protectedV1.POST("/user/webhooks/deliveries/:id/redeliver", webhookHandler.Redeliver)
+
 		// 文件下载
 		protectedV1.POST("/download/file-ticket", fileHandler.CreateDownloadTicket)
+		protectedV1.POST("/sse/ticket", wsHandler.CreateSSETicket)
 		protectedV1.GET("/download/file", fileHandler.DownloadFile)
+		protectedV1.GET("/download/file/attachments", fileHandler.ListAttachments)
 
 	}
 
@@ -190,11 +240,29 @@ func SetupRouter(deps *Dependencies) *gin.Engine {
 		adminV1.PUT("/billing/pricing", adminBillingHandler.UpdatePricing)
 		adminV1.GET("/billing/welcome-credit", adminBillingHandler.GetWelcomeCreditSettings)
 		adminV1.PUT("/billing/welcome-credit", adminBillingHandler.UpdateWelcomeCreditSettings)
+
+		adminV1.GET("/tasks", adminTaskHandler.List)
+		adminV1.POST("/tasks/:taskId/replay", adminTaskHandler.Replay)
+		adminV1.POST("/tasks/:taskId/force-fail", adminTaskHandler.ForceFail)
+		adminV1.DELETE("/tasks/:taskId", adminTaskHandler.Purge)
+		adminV1.GET("/workers", adminTaskHandler.ListWorkerPools)
+		adminV1.PUT("/workers", adminTaskHandler.UpdateWorkerPool)
+		adminV1.GET("/platforms", adminTaskHandler.ListPlatforms)
+		adminV1.PUT("/platforms/:name", adminTaskHandler.UpdatePlatform)
+
+		adminV1.GET("/webhooks", adminWebhookHandler.List)
+		adminV1.POST("/webhooks", adminWebhookHandler.Create)
+		adminV1.PUT("/webhooks/:id", adminWebhookHandler.Update)
+		adminV1.DELETE("/webhooks/:id", adminWebhookHandler.Delete)
+		adminV1.GET("/webhooks/deliveries", adminWebhookHandler.ListDeliveries)
+		adminV1.POST("/webhooks/deliveries/:id/redeliver", adminWebhookHandler.Redeliver)
 	}
 
 	// ==================== WebSocket 路由 ====================
 	// WebSocket 进度推送 (浏览器通过子协议传递 bearer token)
 	r.GET("/api/v1/ws/progress", wsHandler.Progress)
+	// SSE 进度推送 (无法建立 WebSocket 时的替代方式，通过 Authorization 头或 /sse/ticket 签发的短期票据认证)
+	r.GET("/api/v1/sse/progress", wsHandler.ProgressStream)
 
 	return r
 }
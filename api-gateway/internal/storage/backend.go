@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"fmt"
+
+	"youdlp/api-gateway/internal/config"
+	"youdlp/objectstore"
+)
+
+// 存储后端类型
+const (
+	BackendLocal = objectstore.BackendLocal
+	BackendS3    = objectstore.BackendS3
+)
+
+// ErrNotExist 对象不存在
+var ErrNotExist = objectstore.ErrNotExist
+
+// 存储实现位于共享模块 objectstore，与 media-service 共用
+type (
+	Storage      = objectstore.Storage
+	ObjectInfo   = objectstore.ObjectInfo
+	LocalStorage = objectstore.LocalStorage
+)
+
+// NewLocalStorage 创建本地文件系统存储
+func NewLocalStorage(root string) *LocalStorage {
+	return objectstore.NewLocalStorage(root)
+}
+
+// NewStorage 按配置创建存储后端
+func NewStorage(cfg *config.StorageConfig) (Storage, error) {
+	switch cfg.Backend {
+	case "", BackendLocal:
+		return NewLocalStorage(cfg.BasePath), nil
+	case BackendS3:
+		return objectstore.NewS3Storage(objectstore.S3Options{
+			Endpoint:     cfg.S3.Endpoint,
+			Region:       cfg.S3.Region,
+			Bucket:       cfg.S3.Bucket,
+			AccessKey:    cfg.S3.AccessKey,
+			SecretKey:    cfg.S3.SecretKey,
+			Prefix:       cfg.S3.Prefix,
+			UsePathStyle: cfg.S3.UsePathStyle,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported storage backend: %s", cfg.Backend)
+	}
+}
@@ -0,0 +1,27 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"youdlp/api-gateway/internal/config"
+)
+
+func TestNewStorageSelectsBackend(t *testing.T) {
+	store, err := NewStorage(&config.StorageConfig{BasePath: t.TempDir()})
+	if err != nil {
+		t.Fatalf("new local storage: %v", err)
+	}
+	if _, ok := store.(*LocalStorage); !ok {
+		t.Fatalf("expected local storage by default, got %T", store)
+	}
+
+	if _, err := NewStorage(&config.StorageConfig{Backend: BackendS3}); err == nil {
+		t.Fatal("expected s3 backend without endpoint to be rejected")
+	}
+
+	_, err = NewStorage(&config.StorageConfig{Backend: "ftp"})
+	if err == nil || !strings.Contains(err.Error(), "unsupported storage backend") {
+		t.Fatalf("expected unsupported backend error, got %v", err)
+	}
+}
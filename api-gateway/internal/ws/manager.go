@@ -8,6 +8,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -109,7 +110,7 @@ const (
 // ProgressMessage 进度消息
 type ProgressMessage struct {
 	TaskID          string  `json:"task_id"`
-	Status          string  `json:"status"` // pending, downloading, merging, completed, failed
+	Status          string  `json:"status"` // pending, downloading, merging, completed, failed, cancelled
 	Percent         float64 `json:"percent"`
 	DownloadedBytes int64   `json:"downloaded_bytes,omitempty"`
 	TotalBytes      int64   `json:"total_bytes,omitempty"`
@@ -125,6 +126,7 @@ type ProgressMessage struct {
 type Manager struct {
 	connections sync.Map // map[taskID]*websocket.Conn
 	rdb         *redis.Client
+	streamRdb   *redis.Client // SSE 阻塞读取进度日志专用，避免长时间占用共享连接池
 	authClient  authVerifier
 	assetClient taskAccessChecker
 	upgrader    websocket.Upgrader
@@ -138,10 +140,14 @@ type taskAccessChecker interface {
 	GetHistoryByTask(ctx context.Context, in *pb.GetHistoryByTaskRequest, opts ...grpc.CallOption) (*pb.GetHistoryByTaskResponse, error)
 }
 
-// NewManager 创建 WebSocket 管理器
-func NewManager(rdb *redis.Client, authClient authVerifier, assetClient taskAccessChecker, allowedOrigins []string) *Manager {
+// NewManager 创建 WebSocket 管理器，streamRdb 为空时 SSE 读取退回共享客户端
+func NewManager(rdb, streamRdb *redis.Client, authClient authVerifier, assetClient taskAccessChecker, allowedOrigins []string) *Manager {
+	if streamRdb == nil {
+		streamRdb = rdb
+	}
 	return &Manager{
 		rdb:         rdb,
+		streamRdb:   streamRdb,
 		authClient:  authClient,
 		assetClient: assetClient,
 		upgrader:    newWebSocketUpgrader(allowedOrigins),
@@ -163,7 +169,16 @@ func (m *Manager) HandleConnection(c *gin.Context) {
 	}
 
 	taskID := strings.TrimSpace(c.Query("task_id"))
-	if taskID == "" {
+	scope := strings.TrimSpace(c.Query("scope"))
+	if scope != "" && scope != scopeUser {
+		log.Printf("[WS] Rejecting connection from %s for user %s: invalid scope %q", c.ClientIP(), claims.UserID, scope)
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "invalid scope",
+		})
+		return
+	}
+	if taskID == "" && scope == "" {
 		log.Printf("[WS] Rejecting connection from %s for user %s: missing task_id", c.ClientIP(), claims.UserID)
 		c.JSON(http.StatusBadRequest, gin.H{
 			"code":    400,
@@ -172,13 +187,15 @@ func (m *Manager) HandleConnection(c *gin.Context) {
 		return
 	}
 
-	if err := m.validateTaskAccess(c.Request.Context(), claims.UserID, taskID); err != nil {
-		log.Printf("[WS] Rejecting connection from %s for user %s task %s: access denied (%v)", c.ClientIP(), claims.UserID, taskID, err)
-		c.JSON(http.StatusForbidden, gin.H{
-			"code":    403,
-			"message": err.Error(),
-		})
-		return
+	if taskID != "" {
+		if err := m.validateTaskAccess(c.Request.Context(), claims.UserID, taskID); err != nil {
+			log.Printf("[WS] Rejecting connection from %s for user %s task %s: access denied (%v)", c.ClientIP(), claims.UserID, taskID, err)
+			c.JSON(http.StatusForbidden, gin.H{
+				"code":    403,
+				"message": err.Error(),
+			})
+			return
+		}
 	}
 
 	// 升级到 WebSocket
@@ -210,7 +227,7 @@ func (m *Manager) HandleConnection(c *gin.Context) {
 
 	// 保存连接
 	m.connections.Store(connID, conn)
-	log.Printf("[WS] Connection established: %s (taskID: %s userID: %s)", connID, taskID, claims.UserID)
+	log.Printf("[WS] Connection established: %s (taskID: %s scope: %s userID: %s)", connID, taskID, scope, claims.UserID)
 
 	// 设置 Pong 处理
 	conn.SetReadDeadline(time.Now().Add(wsReadTimeout))
@@ -220,21 +237,44 @@ func (m *Manager) HandleConnection(c *gin.Context) {
 	})
 
 	// 启动心跳
+	controls := make(chan ControlFrame, 8)
 	go m.heartbeat(connID, conn, done, stop)
-	go m.readPump(connID, conn, stop)
+	go m.readPump(connID, conn, controls, done, stop)
 
 	ctx := c.Request.Context()
-	channelName := fmt.Sprintf("progress:%s", taskID)
-	pubsub := m.rdb.Subscribe(ctx, channelName)
+	pubsub := m.rdb.Subscribe(ctx)
 	defer pubsub.Close()
 
-	log.Printf("[WS] Subscribed to channel: %s", channelName)
+	session := &progressSession{
+		manager: m,
+		connID:  connID,
+		conn:    conn,
+		pubsub:  pubsub,
+		subs:    newSubscriptionSet(claims.UserID),
+	}
+
+	// 先订阅再补发快照，连接建立前已发布的进度不会丢失
+	if scope == scopeUser {
+		if !session.subscribeUser(ctx) {
+			return
+		}
+	}
+	if taskID != "" {
+		if !session.subscribeTask(ctx, taskID) || session.closed {
+			return
+		}
+	}
 
 	ch := pubsub.Channel()
 	for {
 		select {
 		case <-done:
 			return
+		case frame := <-controls:
+			if !session.handleControl(ctx, frame) {
+				stop()
+				return
+			}
 		case msg, ok := <-ch:
 			if !ok {
 				stop()
@@ -246,21 +286,186 @@ func (m *Manager) HandleConnection(c *gin.Context) {
 				log.Printf("[WS] Failed to parse message: %v", err)
 				continue
 			}
+			if !session.subs.accepts(msg.Channel, progress.TaskID) {
+				continue
+			}
 
-			conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
-			if err := conn.WriteJSON(progress); err != nil {
-				log.Printf("[WS] Failed to send message: %v", err)
+			if !session.forward(ctx, &progress) || session.closed {
 				stop()
 				return
 			}
-			log.Printf("[WS] Forwarded progress to %s: task=%s status=%s percent=%.2f", connID, progress.TaskID, progress.Status, progress.Percent)
+		}
+	}
+}
 
-			if progress.Status == "completed" || progress.Status == "failed" {
-				stop()
-				return
+// progressSession 单个 WebSocket 连接的订阅会话，只在连接的主循环中使用
+type progressSession struct {
+	manager *Manager
+	connID  string
+	conn    *websocket.Conn
+	pubsub  *redis.PubSub
+	subs    *subscriptionSet
+	// closed 单任务连接的任务已结束，应关闭连接
+	closed bool
+}
+
+// forward 发送任务进度，任务结束时移除订阅；发送失败时返回 false
+func (s *progressSession) forward(ctx context.Context, progress *ProgressMessage) bool {
+	if !s.write(progress) {
+		return false
+	}
+	log.Printf("[WS] Forwarded progress to %s: task=%s status=%s percent=%.2f", s.connID, progress.TaskID, progress.Status, progress.Percent)
+
+	if isTerminalStatus(progress.Status) {
+		unsubscribe, closeConn := s.subs.finish(progress.TaskID)
+		if unsubscribe {
+			if err := s.pubsub.Unsubscribe(ctx, taskProgressChannel(progress.TaskID)); err != nil {
+				log.Printf("[WS] Failed to unsubscribe finished task %s: %v", progress.TaskID, err)
 			}
 		}
+		s.closed = closeConn
 	}
+	return true
+}
+
+// subscribeTask 订阅单个任务并补发最新进度快照
+func (s *progressSession) subscribeTask(ctx context.Context, taskID string) bool {
+	channel := taskProgressChannel(taskID)
+	if err := s.pubsub.Subscribe(ctx, channel); err != nil {
+		log.Printf("[WS] Failed to subscribe to channel %s: %v", channel, err)
+		return false
+	}
+	s.subs.addTask(taskID)
+	log.Printf("[WS] Subscribed to channel: %s", channel)
+
+	snapshot := s.manager.latestProgress(ctx, taskID)
+	if snapshot == nil {
+		return true
+	}
+	return s.forward(ctx, snapshot)
+}
+
+// subscribeUser 订阅用户级频道并补发用户所有活跃任务的最新进度
+func (s *progressSession) subscribeUser(ctx context.Context) bool {
+	channel := userProgressChannel(s.subs.userID)
+	if err := s.pubsub.Subscribe(ctx, channel); err != nil {
+		log.Printf("[WS] Failed to subscribe to channel %s: %v", channel, err)
+		return false
+	}
+	s.subs.user = true
+	s.subs.multi = true
+	log.Printf("[WS] Subscribed to channel: %s", channel)
+
+	for _, snapshot := range s.manager.activeProgress(ctx, s.subs.userID) {
+		if !s.write(snapshot) {
+			return false
+		}
+	}
+	return true
+}
+
+// handleControl 处理客户端控制帧，发送失败时返回 false
+func (s *progressSession) handleControl(ctx context.Context, frame ControlFrame) bool {
+	s.subs.multi = true
+	reply := ControlReply{TaskID: frame.TaskID, Scope: frame.Scope}
+
+	switch {
+	case frame.Scope == scopeUser && frame.Action == actionSubscribe:
+		reply.Type = "subscribed"
+		if s.subs.user {
+			break
+		}
+		if !s.write(reply) {
+			return false
+		}
+		return s.subscribeUser(ctx)
+
+	case frame.Scope == scopeUser && frame.Action == actionUnsubscribe:
+		if s.subs.user {
+			if err := s.pubsub.Unsubscribe(ctx, userProgressChannel(s.subs.userID)); err != nil {
+				log.Printf("[WS] Failed to unsubscribe user channel for %s: %v", s.connID, err)
+			}
+			s.subs.user = false
+		}
+		reply.Type = "unsubscribed"
+
+	case frame.Scope == "" && frame.TaskID != "" && frame.Action == actionSubscribe:
+		if s.subs.hasTask(frame.TaskID) {
+			reply.Type = "subscribed"
+			break
+		}
+		if len(s.subs.tasks) >= maxTaskSubscriptions {
+			reply.Type, reply.Message = "error", fmt.Sprintf("at most %d task subscriptions per connection", maxTaskSubscriptions)
+			break
+		}
+		if err := s.manager.validateTaskAccess(ctx, s.subs.userID, frame.TaskID); err != nil {
+			reply.Type, reply.Message = "error", err.Error()
+			break
+		}
+		reply.Type = "subscribed"
+		if !s.write(reply) {
+			return false
+		}
+		return s.subscribeTask(ctx, frame.TaskID)
+
+	case frame.Scope == "" && frame.TaskID != "" && frame.Action == actionUnsubscribe:
+		if s.subs.removeTask(frame.TaskID) {
+			if err := s.pubsub.Unsubscribe(ctx, taskProgressChannel(frame.TaskID)); err != nil {
+				log.Printf("[WS] Failed to unsubscribe task %s for %s: %v", frame.TaskID, s.connID, err)
+			}
+		}
+		reply.Type = "unsubscribed"
+
+	default:
+		reply.Type, reply.Message = "error", "invalid control frame"
+	}
+
+	return s.write(reply)
+}
+
+func (s *progressSession) write(v interface{}) bool {
+	s.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	if err := s.conn.WriteJSON(v); err != nil {
+		log.Printf("[WS] Failed to send message: %v", err)
+		return false
+	}
+	return true
+}
+
+// latestProgress 读取任务最新的进度快照，不存在时返回 nil
+func (m *Manager) latestProgress(ctx context.Context, taskID string) *ProgressMessage {
+	data, err := m.rdb.Get(ctx, progressSnapshotKey(taskID)).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			log.Printf("[WS] Failed to load progress snapshot for %s: %v", taskID, err)
+		}
+		return nil
+	}
+
+	var progress ProgressMessage
+	if err := json.Unmarshal(data, &progress); err != nil {
+		log.Printf("[WS] Failed to parse progress snapshot for %s: %v", taskID, err)
+		return nil
+	}
+	return &progress
+}
+
+// activeProgress 读取用户所有活跃任务的最新进度快照
+func (m *Manager) activeProgress(ctx context.Context, userID string) []*ProgressMessage {
+	taskIDs, err := m.rdb.SMembers(ctx, userActiveTasksKey(userID)).Result()
+	if err != nil {
+		log.Printf("[WS] Failed to list active tasks for user %s: %v", userID, err)
+		return nil
+	}
+	sort.Strings(taskIDs)
+
+	snapshots := make([]*ProgressMessage, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		if snapshot := m.latestProgress(ctx, taskID); snapshot != nil {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	return snapshots
 }
 
 func extractWebSocketToken(r *http.Request) (string, string) {
@@ -385,15 +590,30 @@ func (m *Manager) heartbeat(taskID string, conn *websocket.Conn, done <-chan str
 	}
 }
 
-func (m *Manager) readPump(taskID string, conn *websocket.Conn, stop func()) {
+// readPump 读取客户端控制帧并交给连接主循环处理
+func (m *Manager) readPump(taskID string, conn *websocket.Conn, controls chan<- ControlFrame, done <-chan struct{}, stop func()) {
 	for {
-		if _, _, err := conn.ReadMessage(); err != nil {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
 			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
 				log.Printf("[WS] Read loop ended for %s: %v", taskID, err)
 			}
 			stop()
 			return
 		}
+
+		var frame ControlFrame
+		if err := json.Unmarshal(data, &frame); err != nil {
+			// 无法解析的帧交给主循环回复错误
+			frame = ControlFrame{}
+		}
+		frame.TaskID = strings.TrimSpace(frame.TaskID)
+
+		select {
+		case controls <- frame:
+		case <-done:
+			return
+		}
 	}
 }
 
@@ -37,7 +37,7 @@ func (f *fakeTaskAccessChecker) GetHistoryByTask(context.Context, *pb.GetHistory
 func TestValidateTaskAccessNotFoundMapsToForbidden(t *testing.T) {
 	t.Parallel()
 
-	manager := NewManager(nil, &fakeAuthVerifier{}, &fakeTaskAccessChecker{
+	manager := NewManager(nil, nil, &fakeAuthVerifier{}, &fakeTaskAccessChecker{
 		err: grpcstatus.Error(codes.NotFound, "missing"),
 	}, nil)
 
@@ -50,7 +50,7 @@ func TestValidateTaskAccessNotFoundMapsToForbidden(t *testing.T) {
 func TestValidateTaskAccessInternalFailureIsHidden(t *testing.T) {
 	t.Parallel()
 
-	manager := NewManager(nil, &fakeAuthVerifier{}, &fakeTaskAccessChecker{
+	manager := NewManager(nil, nil, &fakeAuthVerifier{}, &fakeTaskAccessChecker{
 		err: grpcstatus.Error(codes.Internal, "db broken"),
 	}, nil)
 
@@ -63,7 +63,7 @@ func TestValidateTaskAccessInternalFailureIsHidden(t *testing.T) {
 func TestHandleConnectionRequiresTaskID(t *testing.T) {
 	t.Parallel()
 
-	manager := NewManager(nil, &fakeAuthVerifier{
+	manager := NewManager(nil, nil, &fakeAuthVerifier{
 		verifyResp: &pb.VerifyTokenResponse{Valid: true, UserId: "user-1"},
 	}, &fakeTaskAccessChecker{}, nil)
 
@@ -84,7 +84,7 @@ func TestHandleConnectionRequiresTaskID(t *testing.T) {
 func TestHandleConnectionRejectsUnauthorizedTaskAccess(t *testing.T) {
 	t.Parallel()
 
-	manager := NewManager(nil, &fakeAuthVerifier{
+	manager := NewManager(nil, nil, &fakeAuthVerifier{
 		verifyResp: &pb.VerifyTokenResponse{Valid: true, UserId: "user-1"},
 	}, &fakeTaskAccessChecker{
 		err: grpcstatus.Error(codes.NotFound, "missing"),
@@ -220,3 +220,24 @@ func assertResponseMessage(t *testing.T, w *httptest.ResponseRecorder, want stri
 		t.Fatalf("expected message %q, got %q", want, resp.Message)
 	}
 }
+
+func TestHandleConnectionRejectsUnknownScope(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager(nil, nil, &fakeAuthVerifier{
+		verifyResp: &pb.VerifyTokenResponse{Valid: true, UserId: "user-1"},
+	}, &fakeTaskAccessChecker{}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/ws/progress?scope=all", nil)
+	c.Request.Header.Set("Sec-WebSocket-Protocol", "bearer, test-token")
+
+	manager.HandleConnection(c)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", w.Code)
+	}
+
+	assertResponseMessage(t, w, "invalid scope")
+}
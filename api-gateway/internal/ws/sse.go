@@ -0,0 +1,188 @@
+package ws
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+
+	"youdlp/api-gateway/internal/middleware"
+)
+
+const (
+	// sseKeepAliveInterval 无进度时发送注释行的间隔，防止代理因空闲断开连接
+	sseKeepAliveInterval = 15 * time.Second
+	// sseRetryMillis 建议客户端断线重连的等待时间
+	sseRetryMillis = 3000
+	// sseReadBatch 每次从进度日志读取的最大条数
+	sseReadBatch = 100
+)
+
+// streamIDPattern Redis Stream 消息 ID，即 SSE 的事件 ID
+var streamIDPattern = regexp.MustCompile(`^\d+-\d+$`)
+
+// HandleSSE 以 Server-Sent Events 推送任务进度，供无法建立 WebSocket 的客户端使用。
+// 事件 ID 为进度日志中的消息 ID，客户端重连时通过 Last-Event-ID 从断点续传
+func (m *Manager) HandleSSE(c *gin.Context) {
+	taskID := strings.TrimSpace(c.Query("task_id"))
+	if taskID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "task_id is required",
+		})
+		return
+	}
+
+	userID, err := m.authenticateSSE(c, taskID)
+	if err != nil {
+		log.Printf("[SSE] Rejecting stream from %s: auth failed (%v)", c.ClientIP(), err)
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"code":    401,
+			"message": err.Error(),
+		})
+		return
+	}
+
+	// 原生 EventSource 重连时自动携带 Last-Event-ID 头，手动重连可通过查询参数传入
+	lastEventID := strings.TrimSpace(c.GetHeader("Last-Event-ID"))
+	if lastEventID == "" {
+		lastEventID = strings.TrimSpace(c.Query("last_event_id"))
+	}
+	if lastEventID != "" && !streamIDPattern.MatchString(lastEventID) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "invalid Last-Event-ID",
+		})
+		return
+	}
+
+	if err := m.validateTaskAccess(c.Request.Context(), userID, taskID); err != nil {
+		log.Printf("[SSE] Rejecting stream from %s for user %s task %s: access denied (%v)", c.ClientIP(), userID, taskID, err)
+		c.JSON(http.StatusForbidden, gin.H{
+			"code":    403,
+			"message": err.Error(),
+		})
+		return
+	}
+
+	header := c.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	ctx := c.Request.Context()
+	rc := http.NewResponseController(c.Writer)
+	fmt.Fprintf(c.Writer, "retry: %d\n\n", sseRetryMillis)
+	rc.Flush()
+
+	log.Printf("[SSE] Stream opened for user %s task %s (last_event_id: %s)", userID, taskID, lastEventID)
+	defer log.Printf("[SSE] Stream closed for user %s task %s", userID, taskID)
+
+	logKey := progressLogKey(taskID)
+	cursor := lastEventID
+	if cursor == "" {
+		// 首次连接只补发最新一条进度，之后从该位置继续
+		latest, err := m.rdb.XRevRangeN(ctx, logKey, "+", "-", 1).Result()
+		if err != nil {
+			log.Printf("[SSE] Failed to load progress log for %s: %v", taskID, err)
+			return
+		}
+		cursor = "0-0"
+		if len(latest) == 1 {
+			terminal, err := writeSSEProgress(c.Writer, latest[0])
+			if err != nil || terminal {
+				return
+			}
+			rc.Flush()
+			cursor = latest[0].ID
+		}
+	}
+
+	for {
+		// 服务端写超时针对普通请求，流式响应每轮延长一次
+		_ = rc.SetWriteDeadline(time.Now().Add(sseKeepAliveInterval + wsWriteTimeout))
+
+		streams, err := m.streamRdb.XRead(ctx, &redis.XReadArgs{
+			Streams: []string{logKey, cursor},
+			Count:   sseReadBatch,
+			Block:   sseKeepAliveInterval,
+		}).Result()
+		if err == redis.Nil {
+			if _, err := io.WriteString(c.Writer, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			rc.Flush()
+			continue
+		}
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("[SSE] Failed to read progress log for %s: %v", taskID, err)
+			}
+			return
+		}
+
+		for _, stream := range streams {
+			for _, entry := range stream.Messages {
+				terminal, err := writeSSEProgress(c.Writer, entry)
+				if err != nil || terminal {
+					rc.Flush()
+					return
+				}
+				cursor = entry.ID
+			}
+		}
+		rc.Flush()
+	}
+}
+
+// authenticateSSE 优先使用 Authorization 头；原生 EventSource 无法设置请求头，
+// 改用查询参数 ticket 或 Cookie 携带 IssueSSETicket 签发的短期票据
+func (m *Manager) authenticateSSE(c *gin.Context, taskID string) (string, error) {
+	if authHeader := strings.TrimSpace(c.GetHeader("Authorization")); authHeader != "" {
+		claims, err := middleware.AuthenticateToken(c.Request.Context(), m.authClient, m.rdb, authHeader)
+		if err != nil {
+			return "", err
+		}
+		return claims.UserID, nil
+	}
+
+	ticket := strings.TrimSpace(c.Query("ticket"))
+	if ticket == "" {
+		ticket, _ = c.Cookie(SSETicketCookie)
+		ticket = strings.TrimSpace(ticket)
+	}
+	if ticket == "" {
+		return "", errors.New("missing authorization token or sse ticket")
+	}
+	return m.resolveSSETicket(c.Request.Context(), ticket, taskID)
+}
+
+// writeSSEProgress 将一条进度日志写为 SSE 事件，返回任务是否已结束
+func writeSSEProgress(w io.Writer, entry redis.XMessage) (bool, error) {
+	raw, _ := entry.Values["data"].(string)
+
+	var progress ProgressMessage
+	if err := json.Unmarshal([]byte(raw), &progress); err != nil {
+		log.Printf("[SSE] Failed to parse progress log entry %s: %v", entry.ID, err)
+		return false, nil
+	}
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := fmt.Fprintf(w, "id: %s\ndata: %s\n\n", entry.ID, data); err != nil {
+		return false, err
+	}
+	return isTerminalStatus(progress.Status), nil
+}
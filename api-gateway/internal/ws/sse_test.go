@@ -0,0 +1,131 @@
+package ws
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	pb "youdlp/api-gateway/proto"
+)
+
+func newSSETestContext(target string) (*gin.Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+	c.Request.Header.Set("Authorization", "Bearer test-token")
+	return c, w
+}
+
+func TestHandleSSERequiresTaskID(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager(nil, nil, &fakeAuthVerifier{
+		verifyResp: &pb.VerifyTokenResponse{Valid: true, UserId: "user-1"},
+	}, &fakeTaskAccessChecker{}, nil)
+
+	c, w := newSSETestContext("/api/v1/sse/progress")
+	manager.HandleSSE(c)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", w.Code)
+	}
+	assertResponseMessage(t, w, "task_id is required")
+}
+
+func TestHandleSSERejectsMalformedLastEventID(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager(nil, nil, &fakeAuthVerifier{
+		verifyResp: &pb.VerifyTokenResponse{Valid: true, UserId: "user-1"},
+	}, &fakeTaskAccessChecker{}, nil)
+
+	c, w := newSSETestContext("/api/v1/sse/progress?task_id=task-1")
+	c.Request.Header.Set("Last-Event-ID", "not-an-id")
+	manager.HandleSSE(c)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", w.Code)
+	}
+	assertResponseMessage(t, w, "invalid Last-Event-ID")
+}
+
+func TestHandleSSERejectsUnauthorizedTaskAccess(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager(nil, nil, &fakeAuthVerifier{
+		verifyResp: &pb.VerifyTokenResponse{Valid: true, UserId: "user-1"},
+	}, &fakeTaskAccessChecker{
+		err: grpcstatus.Error(codes.NotFound, "missing"),
+	}, nil)
+
+	c, w := newSSETestContext("/api/v1/sse/progress?task_id=task-1")
+	manager.HandleSSE(c)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected 403, got %d", w.Code)
+	}
+	assertResponseMessage(t, w, "task not found or access denied")
+}
+
+func TestHandleSSERequiresTokenOrTicket(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager(nil, nil, &fakeAuthVerifier{}, &fakeTaskAccessChecker{}, nil)
+
+	c, w := newSSETestContext("/api/v1/sse/progress?task_id=task-1")
+	c.Request.Header.Del("Authorization")
+	manager.HandleSSE(c)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", w.Code)
+	}
+	assertResponseMessage(t, w, "missing authorization token or sse ticket")
+}
+
+func TestHandleSSEAcceptsTicketFromQueryOrCookie(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager(nil, nil, &fakeAuthVerifier{}, &fakeTaskAccessChecker{}, nil)
+
+	query, w := newSSETestContext("/api/v1/sse/progress?task_id=task-1&ticket=abc")
+	query.Request.Header.Del("Authorization")
+	manager.HandleSSE(query)
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", w.Code)
+	}
+	// 未配置 Redis 时票据无法校验，说明已按票据而非 token 认证
+	assertResponseMessage(t, w, ErrSSETicketUnavailable.Error())
+
+	cookie, w := newSSETestContext("/api/v1/sse/progress?task_id=task-1")
+	cookie.Request.Header.Del("Authorization")
+	cookie.Request.AddCookie(&http.Cookie{Name: SSETicketCookie, Value: "abc"})
+	manager.HandleSSE(cookie)
+	assertResponseMessage(t, w, ErrSSETicketUnavailable.Error())
+}
+
+func TestWriteSSEProgressUsesLogEntryID(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	terminal, err := writeSSEProgress(&buf, redis.XMessage{
+		ID:     "1700000000000-0",
+		Values: map[string]interface{}{"data": `{"task_id":"task-1","status":"completed","percent":100}`},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !terminal {
+		t.Fatal("expected completed progress to end the stream")
+	}
+
+	want := "id: 1700000000000-0\ndata: {\"task_id\":\"task-1\",\"status\":\"completed\",\"percent\":100}\n\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected event:\n%q\nwant:\n%q", buf.String(), want)
+	}
+}
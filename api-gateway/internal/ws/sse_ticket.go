@@ -0,0 +1,74 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// SSETicketTTL SSE 票据有效期，有效期内可重复使用以支持 EventSource 自动重连
+	SSETicketTTL = 2 * time.Minute
+	// SSETicketCookie 携带 SSE 票据的 Cookie 名，与查询参数 ticket 二选一
+	SSETicketCookie = "sse_ticket"
+
+	sseTicketPrefix = "sse:ticket:"
+)
+
+var (
+	// ErrSSETicketUnavailable 票据存储不可用，区别于任务归属校验失败
+	ErrSSETicketUnavailable = errors.New("sse ticket service unavailable")
+	errSSETicketInvalid     = errors.New("sse ticket expired or invalid")
+)
+
+type sseTicketPayload struct {
+	UserID string `json:"user_id"`
+	TaskID string `json:"task_id"`
+}
+
+// IssueSSETicket 校验任务归属后签发绑定用户与任务的短期票据，
+// 供无法设置 Authorization 头的原生 EventSource 使用
+func (m *Manager) IssueSSETicket(ctx context.Context, userID, taskID string) (string, error) {
+	if m.rdb == nil {
+		return "", ErrSSETicketUnavailable
+	}
+	if err := m.validateTaskAccess(ctx, userID, taskID); err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(&sseTicketPayload{UserID: userID, TaskID: taskID})
+	if err != nil {
+		return "", fmt.Errorf("%w: marshal: %v", ErrSSETicketUnavailable, err)
+	}
+	ticket := uuid.NewString()
+	if err := m.rdb.Set(ctx, sseTicketPrefix+ticket, data, SSETicketTTL).Err(); err != nil {
+		return "", fmt.Errorf("%w: save: %v", ErrSSETicketUnavailable, err)
+	}
+	return ticket, nil
+}
+
+// resolveSSETicket 返回票据对应的用户，票据必须签发给同一任务
+func (m *Manager) resolveSSETicket(ctx context.Context, ticket, taskID string) (string, error) {
+	if m.rdb == nil {
+		return "", ErrSSETicketUnavailable
+	}
+
+	data, err := m.rdb.Get(ctx, sseTicketPrefix+ticket).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return "", errSSETicketInvalid
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w: load: %v", ErrSSETicketUnavailable, err)
+	}
+
+	var payload sseTicketPayload
+	if err := json.Unmarshal(data, &payload); err != nil || payload.UserID == "" || payload.TaskID != taskID {
+		return "", errSSETicketInvalid
+	}
+	return payload.UserID, nil
+}
@@ -0,0 +1,105 @@
+package ws
+
+import "fmt"
+
+// maxTaskSubscriptions 单个连接最多同时订阅的任务数
+const maxTaskSubscriptions = 50
+
+// 控制帧动作与订阅范围
+const (
+	actionSubscribe   = "subscribe"
+	actionUnsubscribe = "unsubscribe"
+	scopeUser         = "user"
+)
+
+// ControlFrame 客户端发送的订阅控制帧：
+// {"action":"subscribe","task_id":"..."} 订阅单个任务，{"action":"subscribe","scope":"user"} 订阅当前用户的全部任务
+type ControlFrame struct {
+	Action string `json:"action"`
+	TaskID string `json:"task_id,omitempty"`
+	Scope  string `json:"scope,omitempty"`
+}
+
+// ControlReply 控制帧应答，type 为 subscribed、unsubscribed 或 error
+type ControlReply struct {
+	Type    string `json:"type"`
+	TaskID  string `json:"task_id,omitempty"`
+	Scope   string `json:"scope,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// subscriptionSet 单个连接的订阅状态
+type subscriptionSet struct {
+	userID string
+	tasks  map[string]struct{}
+	// user 是否订阅了用户级频道，订阅后该用户所有任务的进度都经由用户级频道转发
+	user bool
+	// multi 收到过控制帧或订阅了用户级频道；单任务连接在任务结束后关闭，多任务连接保持
+	multi bool
+}
+
+func newSubscriptionSet(userID string) *subscriptionSet {
+	return &subscriptionSet{
+		userID: userID,
+		tasks:  make(map[string]struct{}),
+	}
+}
+
+func (s *subscriptionSet) hasTask(taskID string) bool {
+	_, ok := s.tasks[taskID]
+	return ok
+}
+
+func (s *subscriptionSet) addTask(taskID string) {
+	s.tasks[taskID] = struct{}{}
+}
+
+func (s *subscriptionSet) removeTask(taskID string) bool {
+	if !s.hasTask(taskID) {
+		return false
+	}
+	delete(s.tasks, taskID)
+	return true
+}
+
+// accepts 判断来自 channel 的任务进度是否需要转发。订阅用户级频道时任务频道的消息重复，直接丢弃
+func (s *subscriptionSet) accepts(channel, taskID string) bool {
+	if channel == userProgressChannel(s.userID) {
+		return s.user
+	}
+	return !s.user && s.hasTask(taskID)
+}
+
+// finish 任务结束后移除订阅，返回是否需要退订任务频道以及是否应关闭连接
+func (s *subscriptionSet) finish(taskID string) (unsubscribe, closeConn bool) {
+	unsubscribe = s.removeTask(taskID)
+	closeConn = !s.multi && !s.user && len(s.tasks) == 0
+	return unsubscribe, closeConn
+}
+
+// isTerminalStatus 任务是否已结束，结束后不会再有进度消息
+func isTerminalStatus(status string) bool {
+	return status == "completed" || status == "failed" || status == "cancelled"
+}
+
+// 以下键名与 media-service 的进度发布器保持一致
+
+func taskProgressChannel(taskID string) string {
+	return fmt.Sprintf("progress:%s", taskID)
+}
+
+func userProgressChannel(userID string) string {
+	return fmt.Sprintf("progress:user:%s", userID)
+}
+
+func progressSnapshotKey(taskID string) string {
+	return fmt.Sprintf("progress:snapshot:%s", taskID)
+}
+
+func progressLogKey(taskID string) string {
+	return fmt.Sprintf("progress:log:%s", taskID)
+}
+
+func userActiveTasksKey(userID string) string {
+	return fmt.Sprintf("progress:active:%s", userID)
+}
@@ -0,0 +1,61 @@
+package ws
+
+import "testing"
+
+func TestSubscriptionSetSingleTaskClosesAfterTerminal(t *testing.T) {
+	t.Parallel()
+
+	subs := newSubscriptionSet("user-1")
+	subs.addTask("task-1")
+
+	if !subs.accepts(taskProgressChannel("task-1"), "task-1") {
+		t.Fatal("expected subscribed task progress to be forwarded")
+	}
+	if subs.accepts(taskProgressChannel("task-2"), "task-2") {
+		t.Fatal("expected unsubscribed task progress to be dropped")
+	}
+
+	unsubscribe, closeConn := subs.finish("task-1")
+	if !unsubscribe || !closeConn {
+		t.Fatalf("expected single-task connection to close, got unsubscribe=%v close=%v", unsubscribe, closeConn)
+	}
+}
+
+func TestSubscriptionSetMultiTaskStaysOpen(t *testing.T) {
+	t.Parallel()
+
+	subs := newSubscriptionSet("user-1")
+	subs.multi = true
+	subs.addTask("task-1")
+
+	unsubscribe, closeConn := subs.finish("task-1")
+	if !unsubscribe || closeConn {
+		t.Fatalf("expected multi-task connection to stay open, got unsubscribe=%v close=%v", unsubscribe, closeConn)
+	}
+	if subs.hasTask("task-1") {
+		t.Fatal("expected finished task to be removed")
+	}
+}
+
+func TestSubscriptionSetUserScopeDropsDuplicateTaskMessages(t *testing.T) {
+	t.Parallel()
+
+	subs := newSubscriptionSet("user-1")
+	subs.addTask("task-1")
+	subs.user = true
+
+	if !subs.accepts(userProgressChannel("user-1"), "task-9") {
+		t.Fatal("expected user channel progress to be forwarded")
+	}
+	if subs.accepts(taskProgressChannel("task-1"), "task-1") {
+		t.Fatal("expected task channel duplicate to be dropped while user scope is active")
+	}
+
+	subs.user = false
+	if subs.accepts(userProgressChannel("user-1"), "task-9") {
+		t.Fatal("expected user channel progress to be dropped after unsubscribe")
+	}
+	if !subs.accepts(taskProgressChannel("task-1"), "task-1") {
+		t.Fatal("expected task channel progress to resume after user unsubscribe")
+	}
+}
@@ -52,6 +52,20 @@ const (
 	AdminService_UpdateBillingPricing_FullMethodName        = "/admin.AdminService/UpdateBillingPricing"
 	AdminService_GetWelcomeCreditSettings_FullMethodName    = "/admin.AdminService/GetWelcomeCreditSettings"
 	AdminService_UpdateWelcomeCreditSettings_FullMethodName = "/admin.AdminService/UpdateWelcomeCreditSettings"
+	AdminService_ListTasks_FullMethodName                   = "/admin.AdminService/ListTasks"
+	AdminService_ReplayTask_FullMethodName                  = "/admin.AdminService/ReplayTask"
+	AdminService_ForceFailTask_FullMethodName               = "/admin.AdminService/ForceFailTask"
+	AdminService_PurgeTask_FullMethodName                   = "/admin.AdminService/PurgeTask"
+	AdminService_ListWorkerPools_FullMethodName             = "/admin.AdminService/ListWorkerPools"
+	AdminService_UpdateWorkerPool_FullMethodName            = "/admin.AdminService/UpdateWorkerPool"
+	AdminService_ListPlatforms_FullMethodName               = "/admin.AdminService/ListPlatforms"
+	AdminService_UpdatePlatform_FullMethodName              = "/admin.AdminService/UpdatePlatform"
+	AdminService_ListWebhooks_FullMethodName                = "/admin.AdminService/ListWebhooks"
+	AdminService_CreateWebhook_FullMethodName               = "/admin.AdminService/CreateWebhook"
+	AdminService_UpdateWebhook_FullMethodName               = "/admin.AdminService/UpdateWebhook"
+	AdminService_DeleteWebhook_FullMethodName               = "/admin.AdminService/DeleteWebhook"
+	AdminService_ListWebhookDeliveries_FullMethodName       = "/admin.AdminService/ListWebhookDeliveries"
+	AdminService_RedeliverWebhook_FullMethodName            = "/admin.AdminService/RedeliverWebhook"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -91,6 +105,20 @@ type AdminServiceClient interface {
 	UpdateBillingPricing(ctx context.Context, in *AdminUpdateBillingPricingRequest, opts ...grpc.CallOption) (*AdminBillingPricingResponse, error)
 	GetWelcomeCreditSettings(ctx context.Context, in *AdminEmpty, opts ...grpc.CallOption) (*AdminWelcomeCreditSettingsResponse, error)
 	UpdateWelcomeCreditSettings(ctx context.Context, in *AdminUpdateWelcomeCreditSettingsRequest, opts ...grpc.CallOption) (*AdminWelcomeCreditSettingsResponse, error)
+	ListTasks(ctx context.Context, in *AdminListTasksRequest, opts ...grpc.CallOption) (*AdminListTasksResponse, error)
+	ReplayTask(ctx context.Context, in *AdminTaskActionRequest, opts ...grpc.CallOption) (*AdminTaskActionResponse, error)
+	ForceFailTask(ctx context.Context, in *AdminTaskActionRequest, opts ...grpc.CallOption) (*AdminTaskActionResponse, error)
+	PurgeTask(ctx context.Context, in *AdminTaskActionRequest, opts ...grpc.CallOption) (*AdminTaskActionResponse, error)
+	ListWorkerPools(ctx context.Context, in *AdminEmpty, opts ...grpc.CallOption) (*AdminListWorkerPoolsResponse, error)
+	UpdateWorkerPool(ctx context.Context, in *AdminUpdateWorkerPoolRequest, opts ...grpc.CallOption) (*AdminTaskActionResponse, error)
+	ListPlatforms(ctx context.Context, in *AdminEmpty, opts ...grpc.CallOption) (*AdminListPlatformsResponse, error)
+	UpdatePlatform(ctx context.Context, in *AdminUpdatePlatformRequest, opts ...grpc.CallOption) (*AdminPlatformResponse, error)
+	ListWebhooks(ctx context.Context, in *AdminEmpty, opts ...grpc.CallOption) (*AdminListWebhooksResponse, error)
+	CreateWebhook(ctx context.Context, in *AdminCreateWebhookRequest, opts ...grpc.CallOption) (*AdminCreateWebhookResponse, error)
+	UpdateWebhook(ctx context.Context, in *AdminUpdateWebhookRequest, opts ...grpc.CallOption) (*AdminWebhookResponse, error)
+	DeleteWebhook(ctx context.Context, in *AdminDeleteRequest, opts ...grpc.CallOption) (*AdminOperationResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *AdminListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*AdminListWebhookDeliveriesResponse, error)
+	RedeliverWebhook(ctx context.Context, in *AdminRedeliverWebhookRequest, opts ...grpc.CallOption) (*AdminOperationResponse, error)
 }
 
 type adminServiceClient struct {
@@ -431,6 +459,146 @@ func (c *adminServiceClient) UpdateWelcomeCreditSettings(ctx context.Context, in
 	return out, nil
 }
 
+func (c *adminServiceClient) ListTasks(ctx context.Context, in *AdminListTasksRequest, opts ...grpc.CallOption) (*AdminListTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListTasksResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReplayTask(ctx context.Context, in *AdminTaskActionRequest, opts ...grpc.CallOption) (*AdminTaskActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminTaskActionResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReplayTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ForceFailTask(ctx context.Context, in *AdminTaskActionRequest, opts ...grpc.CallOption) (*AdminTaskActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminTaskActionResponse)
+	err := c.cc.Invoke(ctx, AdminService_ForceFailTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PurgeTask(ctx context.Context, in *AdminTaskActionRequest, opts ...grpc.CallOption) (*AdminTaskActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminTaskActionResponse)
+	err := c.cc.Invoke(ctx, AdminService_PurgeTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListWorkerPools(ctx context.Context, in *AdminEmpty, opts ...grpc.CallOption) (*AdminListWorkerPoolsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListWorkerPoolsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListWorkerPools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateWorkerPool(ctx context.Context, in *AdminUpdateWorkerPoolRequest, opts ...grpc.CallOption) (*AdminTaskActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminTaskActionResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateWorkerPool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListPlatforms(ctx context.Context, in *AdminEmpty, opts ...grpc.CallOption) (*AdminListPlatformsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListPlatformsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListPlatforms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdatePlatform(ctx context.Context, in *AdminUpdatePlatformRequest, opts ...grpc.CallOption) (*AdminPlatformResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminPlatformResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdatePlatform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListWebhooks(ctx context.Context, in *AdminEmpty, opts ...grpc.CallOption) (*AdminListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListWebhooksResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateWebhook(ctx context.Context, in *AdminCreateWebhookRequest, opts ...grpc.CallOption) (*AdminCreateWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminCreateWebhookResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateWebhook(ctx context.Context, in *AdminUpdateWebhookRequest, opts ...grpc.CallOption) (*AdminWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminWebhookResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteWebhook(ctx context.Context, in *AdminDeleteRequest, opts ...grpc.CallOption) (*AdminOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminOperationResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListWebhookDeliveries(ctx context.Context, in *AdminListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*AdminListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RedeliverWebhook(ctx context.Context, in *AdminRedeliverWebhookRequest, opts ...grpc.CallOption) (*AdminOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminOperationResponse)
+	err := c.cc.Invoke(ctx, AdminService_RedeliverWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
@@ -468,6 +636,20 @@ type AdminServiceServer interface {
 	UpdateBillingPricing(context.Context, *AdminUpdateBillingPricingRequest) (*AdminBillingPricingResponse, error)
 	GetWelcomeCreditSettings(context.Context, *AdminEmpty) (*AdminWelcomeCreditSettingsResponse, error)
 	UpdateWelcomeCreditSettings(context.Context, *AdminUpdateWelcomeCreditSettingsRequest) (*AdminWelcomeCreditSettingsResponse, error)
+	ListTasks(context.Context, *AdminListTasksRequest) (*AdminListTasksResponse, error)
+	ReplayTask(context.Context, *AdminTaskActionRequest) (*AdminTaskActionResponse, error)
+	ForceFailTask(context.Context, *AdminTaskActionRequest) (*AdminTaskActionResponse, error)
+	PurgeTask(context.Context, *AdminTaskActionRequest) (*AdminTaskActionResponse, error)
+	ListWorkerPools(context.Context, *AdminEmpty) (*AdminListWorkerPoolsResponse, error)
+	UpdateWorkerPool(context.Context, *AdminUpdateWorkerPoolRequest) (*AdminTaskActionResponse, error)
+	ListPlatforms(context.Context, *AdminEmpty) (*AdminListPlatformsResponse, error)
+	UpdatePlatform(context.Context, *AdminUpdatePlatformRequest) (*AdminPlatformResponse, error)
+	ListWebhooks(context.Context, *AdminEmpty) (*AdminListWebhooksResponse, error)
+	CreateWebhook(context.Context, *AdminCreateWebhookRequest) (*AdminCreateWebhookResponse, error)
+	UpdateWebhook(context.Context, *AdminUpdateWebhookRequest) (*AdminWebhookResponse, error)
+	DeleteWebhook(context.Context, *AdminDeleteRequest) (*AdminOperationResponse, error)
+	ListWebhookDeliveries(context.Context, *AdminListWebhookDeliveriesRequest) (*AdminListWebhookDeliveriesResponse, error)
+	RedeliverWebhook(context.Context, *AdminRedeliverWebhookRequest) (*AdminOperationResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -577,6 +759,48 @@ func (UnimplementedAdminServiceServer) GetWelcomeCreditSettings(context.Context,
 func (UnimplementedAdminServiceServer) UpdateWelcomeCreditSettings(context.Context, *AdminUpdateWelcomeCreditSettingsRequest) (*AdminWelcomeCreditSettingsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateWelcomeCreditSettings not implemented")
 }
+func (UnimplementedAdminServiceServer) ListTasks(context.Context, *AdminListTasksRequest) (*AdminListTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedAdminServiceServer) ReplayTask(context.Context, *AdminTaskActionRequest) (*AdminTaskActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayTask not implemented")
+}
+func (UnimplementedAdminServiceServer) ForceFailTask(context.Context, *AdminTaskActionRequest) (*AdminTaskActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ForceFailTask not implemented")
+}
+func (UnimplementedAdminServiceServer) PurgeTask(context.Context, *AdminTaskActionRequest) (*AdminTaskActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeTask not implemented")
+}
+func (UnimplementedAdminServiceServer) ListWorkerPools(context.Context, *AdminEmpty) (*AdminListWorkerPoolsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWorkerPools not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateWorkerPool(context.Context, *AdminUpdateWorkerPoolRequest) (*AdminTaskActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateWorkerPool not implemented")
+}
+func (UnimplementedAdminServiceServer) ListPlatforms(context.Context, *AdminEmpty) (*AdminListPlatformsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPlatforms not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdatePlatform(context.Context, *AdminUpdatePlatformRequest) (*AdminPlatformResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePlatform not implemented")
+}
+func (UnimplementedAdminServiceServer) ListWebhooks(context.Context, *AdminEmpty) (*AdminListWebhooksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateWebhook(context.Context, *AdminCreateWebhookRequest) (*AdminCreateWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateWebhook(context.Context, *AdminUpdateWebhookRequest) (*AdminWebhookResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteWebhook(context.Context, *AdminDeleteRequest) (*AdminOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedAdminServiceServer) ListWebhookDeliveries(context.Context, *AdminListWebhookDeliveriesRequest) (*AdminListWebhookDeliveriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedAdminServiceServer) RedeliverWebhook(context.Context, *AdminRedeliverWebhookRequest) (*AdminOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
@@ -1192,6 +1416,258 @@ func _AdminService_UpdateWelcomeCreditSettings_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListTasks(ctx, req.(*AdminListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReplayTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminTaskActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReplayTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReplayTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReplayTask(ctx, req.(*AdminTaskActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ForceFailTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminTaskActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ForceFailTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ForceFailTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ForceFailTask(ctx, req.(*AdminTaskActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PurgeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminTaskActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PurgeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PurgeTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PurgeTask(ctx, req.(*AdminTaskActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListWorkerPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListWorkerPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListWorkerPools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListWorkerPools(ctx, req.(*AdminEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateWorkerPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdateWorkerPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateWorkerPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateWorkerPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateWorkerPool(ctx, req.(*AdminUpdateWorkerPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListPlatforms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListPlatforms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListPlatforms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListPlatforms(ctx, req.(*AdminEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdatePlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdatePlatformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdatePlatform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdatePlatform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdatePlatform(ctx, req.(*AdminUpdatePlatformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListWebhooks(ctx, req.(*AdminEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateWebhook(ctx, req.(*AdminCreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateWebhook(ctx, req.(*AdminUpdateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteWebhook(ctx, req.(*AdminDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListWebhookDeliveries(ctx, req.(*AdminListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRedeliverWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RedeliverWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RedeliverWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RedeliverWebhook(ctx, req.(*AdminRedeliverWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1331,6 +1807,62 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateWelcomeCreditSettings",
 			Handler:    _AdminService_UpdateWelcomeCreditSettings_Handler,
 		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _AdminService_ListTasks_Handler,
+		},
+		{
+			MethodName: "ReplayTask",
+			Handler:    _AdminService_ReplayTask_Handler,
+		},
+		{
+			MethodName: "ForceFailTask",
+			Handler:    _AdminService_ForceFailTask_Handler,
+		},
+		{
+			MethodName: "PurgeTask",
+			Handler:    _AdminService_PurgeTask_Handler,
+		},
+		{
+			MethodName: "ListWorkerPools",
+			Handler:    _AdminService_ListWorkerPools_Handler,
+		},
+		{
+			MethodName: "UpdateWorkerPool",
+			Handler:    _AdminService_UpdateWorkerPool_Handler,
+		},
+		{
+			MethodName: "ListPlatforms",
+			Handler:    _AdminService_ListPlatforms_Handler,
+		},
+		{
+			MethodName: "UpdatePlatform",
+			Handler:    _AdminService_UpdatePlatform_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _AdminService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _AdminService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _AdminService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _AdminService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _AdminService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhook",
+			Handler:    _AdminService_RedeliverWebhook_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/admin.proto",
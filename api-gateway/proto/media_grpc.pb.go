@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MediaService_ParseURL_FullMethodName    = "/media.MediaService/ParseURL"
-	MediaService_ValidateURL_FullMethodName = "/media.MediaService/ValidateURL"
+	MediaService_ParseURL_FullMethodName      = "/media.MediaService/ParseURL"
+	MediaService_ValidateURL_FullMethodName   = "/media.MediaService/ValidateURL"
+	MediaService_ParsePlaylist_FullMethodName = "/media.MediaService/ParsePlaylist"
+	MediaService_ParseURLs_FullMethodName     = "/media.MediaService/ParseURLs"
 )
 
 // MediaServiceClient is the client API for MediaService service.
@@ -29,6 +31,9 @@ const (
 type MediaServiceClient interface {
 	ParseURL(ctx context.Context, in *ParseURLRequest, opts ...grpc.CallOption) (*ParseURLResponse, error)
 	ValidateURL(ctx context.Context, in *ValidateURLRequest, opts ...grpc.CallOption) (*ValidateURLResponse, error)
+	ParsePlaylist(ctx context.Context, in *ParsePlaylistRequest, opts ...grpc.CallOption) (*ParsePlaylistResponse, error)
+	// 批量解析：按规范身份去重后并发解析，每个条目完成即推送
+	ParseURLs(ctx context.Context, in *ParseURLsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ParseURLsResult], error)
 }
 
 type mediaServiceClient struct {
@@ -59,12 +64,44 @@ func (c *mediaServiceClient) ValidateURL(ctx context.Context, in *ValidateURLReq
 	return out, nil
 }
 
+func (c *mediaServiceClient) ParsePlaylist(ctx context.Context, in *ParsePlaylistRequest, opts ...grpc.CallOption) (*ParsePlaylistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParsePlaylistResponse)
+	err := c.cc.Invoke(ctx, MediaService_ParsePlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediaServiceClient) ParseURLs(ctx context.Context, in *ParseURLsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ParseURLsResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MediaService_ServiceDesc.Streams[0], MediaService_ParseURLs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ParseURLsRequest, ParseURLsResult]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MediaService_ParseURLsClient = grpc.ServerStreamingClient[ParseURLsResult]
+
 // MediaServiceServer is the server API for MediaService service.
 // All implementations must embed UnimplementedMediaServiceServer
 // for forward compatibility.
 type MediaServiceServer interface {
 	ParseURL(context.Context, *ParseURLRequest) (*ParseURLResponse, error)
 	ValidateURL(context.Context, *ValidateURLRequest) (*ValidateURLResponse, error)
+	ParsePlaylist(context.Context, *ParsePlaylistRequest) (*ParsePlaylistResponse, error)
+	// 批量解析：按规范身份去重后并发解析，每个条目完成即推送
+	ParseURLs(*ParseURLsRequest, grpc.ServerStreamingServer[ParseURLsResult]) error
 	mustEmbedUnimplementedMediaServiceServer()
 }
 
@@ -81,6 +118,12 @@ func (UnimplementedMediaServiceServer) ParseURL(context.Context, *ParseURLReques
 func (UnimplementedMediaServiceServer) ValidateURL(context.Context, *ValidateURLRequest) (*ValidateURLResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ValidateURL not implemented")
 }
+func (UnimplementedMediaServiceServer) ParsePlaylist(context.Context, *ParsePlaylistRequest) (*ParsePlaylistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ParsePlaylist not implemented")
+}
+func (UnimplementedMediaServiceServer) ParseURLs(*ParseURLsRequest, grpc.ServerStreamingServer[ParseURLsResult]) error {
+	return status.Error(codes.Unimplemented, "method ParseURLs not implemented")
+}
 func (UnimplementedMediaServiceServer) mustEmbedUnimplementedMediaServiceServer() {}
 func (UnimplementedMediaServiceServer) testEmbeddedByValue()                      {}
 
@@ -138,6 +181,35 @@ func _MediaService_ValidateURL_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MediaService_ParsePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParsePlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediaServiceServer).ParsePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediaService_ParsePlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediaServiceServer).ParsePlaylist(ctx, req.(*ParsePlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediaService_ParseURLs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ParseURLsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MediaServiceServer).ParseURLs(m, &grpc.GenericServerStream[ParseURLsRequest, ParseURLsResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MediaService_ParseURLsServer = grpc.ServerStreamingServer[ParseURLsResult]
+
 // MediaService_ServiceDesc is the grpc.ServiceDesc for MediaService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,7 +225,17 @@ var MediaService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateURL",
 			Handler:    _MediaService_ValidateURL_Handler,
 		},
+		{
+			MethodName: "ParsePlaylist",
+			Handler:    _MediaService_ParsePlaylist_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ParseURLs",
+			Handler:       _MediaService_ParseURLs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/media.proto",
 }
@@ -16,6 +16,7 @@ import (
 	"youdlp/asset-service/internal/config"
 	"youdlp/asset-service/internal/database"
 	"youdlp/asset-service/internal/handler"
+	"youdlp/asset-service/internal/money"
 	"youdlp/asset-service/internal/observability"
 	"youdlp/asset-service/internal/repository"
 	"youdlp/asset-service/internal/service"
@@ -59,6 +60,7 @@ func main() {
 	cookieRepo := repository.NewCookieRepository(db)
 	billingRepo := repository.NewBillingRepository(db)
 	welcomeCreditRepo := repository.NewWelcomeCreditSettingsRepository(db)
+	webhookRepo := repository.NewWebhookRepository(db)
 
 	// 4. 初始化 Service
 	historyService := service.NewHistoryService(historyRepo)
@@ -69,6 +71,26 @@ func main() {
 	statsService := service.NewStatsService(historyRepo, proxyRepo, cookieRepo, billingRepo)
 	welcomeCreditService := service.NewWelcomeCreditService(welcomeCreditRepo)
 
+	lowBalanceThreshold, err := money.Parse(cfg.Webhooks.LowBalanceThresholdYuan)
+	if err != nil {
+		log.Fatalf("Invalid webhooks.low_balance_threshold_yuan: %v", err)
+	}
+	webhookService := service.NewWebhookService(webhookRepo, lowBalanceThreshold)
+	billingService.SetWebhooks(webhookService)
+
+	if cfg.Webhooks.PollIntervalSeconds > 0 {
+		dispatcher := service.NewWebhookDispatcher(webhookRepo, service.WebhookDispatcherOptions{
+			Interval:    time.Duration(cfg.Webhooks.PollIntervalSeconds) * time.Second,
+			BatchSize:   cfg.Webhooks.BatchSize,
+			Timeout:     time.Duration(cfg.Webhooks.TimeoutSeconds) * time.Second,
+			MaxAttempts: cfg.Webhooks.MaxAttempts,
+			BackoffBase: time.Duration(cfg.Webhooks.BackoffBaseSeconds) * time.Second,
+			BackoffMax:  time.Duration(cfg.Webhooks.BackoffMaxSeconds) * time.Second,
+		})
+		dispatcher.Start(ctx)
+		log.Printf("✓ Webhook dispatcher started with interval %ds", cfg.Webhooks.PollIntervalSeconds)
+	}
+
 	if cfg.Proxy.BindingReconcileIntervalSeconds > 0 {
 		reconciler := service.NewProxyBindingReconciler(
 			proxyService,
@@ -81,9 +103,10 @@ func main() {
 	// 5. 初始化 Handler
 	proxyHandler := handler.NewProxyHandler(proxyService)
 	cookieHandler := handler.NewCookieHandler(cookieService)
+	webhookHandler := handler.NewWebhookHandler(webhookService, cfg.Pagination)
 
 	// 6. 初始化 gRPC 服务器
-	grpcServer := handler.NewGRPCServer(historyService, quotaService, statsService, billingService, welcomeCreditService, proxyHandler, cookieHandler, cfg)
+	grpcServer := handler.NewGRPCServer(historyService, quotaService, statsService, billingService, welcomeCreditService, proxyHandler, cookieHandler, webhookHandler, cfg)
 
 	// 6. 启动 gRPC 服务
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.Port))
@@ -18,6 +18,7 @@ type Config struct {
 	Storage    StorageConfig    `yaml:"storage"`
 	Proxy      ProxyConfig      `yaml:"proxy"`
 	Cookie     CookieConfig     `yaml:"cookie"`
+	Webhooks   WebhooksConfig   `yaml:"webhooks"`
 }
 
 // ServerConfig 服务器配置
@@ -72,6 +73,17 @@ type CookieConfig struct {
 	DefaultFreezeSeconds int `yaml:"default_freeze_seconds"` // 默认冷冻时间（秒）
 }
 
+// WebhooksConfig Webhook 投递配置
+type WebhooksConfig struct {
+	PollIntervalSeconds     int    `yaml:"poll_interval_seconds"`      // 投递轮询间隔（秒），<=0 关闭投递
+	BatchSize               int    `yaml:"batch_size"`                 // 每轮最多领取的投递数
+	TimeoutSeconds          int    `yaml:"timeout_seconds"`            // 单次请求超时（秒）
+	MaxAttempts             int    `yaml:"max_attempts"`               // 最大尝试次数，超过后标记失败
+	BackoffBaseSeconds      int    `yaml:"backoff_base_seconds"`       // 指数退避基数（秒）
+	BackoffMaxSeconds       int    `yaml:"backoff_max_seconds"`        // 退避上限（秒）
+	LowBalanceThresholdYuan string `yaml:"low_balance_threshold_yuan"` // 可用余额跌破该值时触发 balance.low
+}
+
 // LoadConfig 加载配置文件
 func LoadConfig(configPath string) (*Config, error) {
 	data, err := os.ReadFile(configPath)
@@ -151,6 +163,29 @@ func LoadConfig(configPath string) (*Config, error) {
 		cfg.Cookie.DefaultFreezeSeconds = 0 // 不冷冻
 	}
 
+	// Webhook 默认值
+	if cfg.Webhooks.PollIntervalSeconds == 0 {
+		cfg.Webhooks.PollIntervalSeconds = 5
+	}
+	if cfg.Webhooks.BatchSize == 0 {
+		cfg.Webhooks.BatchSize = 20
+	}
+	if cfg.Webhooks.TimeoutSeconds == 0 {
+		cfg.Webhooks.TimeoutSeconds = 10
+	}
+	if cfg.Webhooks.MaxAttempts == 0 {
+		cfg.Webhooks.MaxAttempts = 8
+	}
+	if cfg.Webhooks.BackoffBaseSeconds == 0 {
+		cfg.Webhooks.BackoffBaseSeconds = 30
+	}
+	if cfg.Webhooks.BackoffMaxSeconds == 0 {
+		cfg.Webhooks.BackoffMaxSeconds = 3600
+	}
+	if cfg.Webhooks.LowBalanceThresholdYuan == "" {
+		cfg.Webhooks.LowBalanceThresholdYuan = "1.00"
+	}
+
 	return &cfg, nil
 }
 
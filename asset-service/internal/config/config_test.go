@@ -39,3 +39,15 @@ func writeTempConfig(t *testing.T, content string) string {
 	}
 	return path
 }
+
+func TestLoadConfigDefaultsWebhooks(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := LoadConfig(writeTempConfig(t, "server:\n  port: 9004\n"))
+	if err != nil {
+		t.Fatalf("LoadConfig returned error: %v", err)
+	}
+	if cfg.Webhooks.PollIntervalSeconds != 5 || cfg.Webhooks.MaxAttempts != 8 || cfg.Webhooks.LowBalanceThresholdYuan != "1.00" {
+		t.Fatalf("unexpected webhook defaults: %+v", cfg.Webhooks)
+	}
+}
@@ -27,6 +27,7 @@ type GRPCServer struct {
 	welcomeCreditService *service.WelcomeCreditService
 	proxyHandler         *ProxyHandler
 	cookieHandler        *CookieHandler
+	webhookHandler       *WebhookHandler
 	cfg                  *config.Config
 }
 
@@ -39,6 +40,7 @@ func NewGRPCServer(
 	welcomeCreditService *service.WelcomeCreditService,
 	proxyHandler *ProxyHandler,
 	cookieHandler *CookieHandler,
+	webhookHandler *WebhookHandler,
 	cfg *config.Config,
 ) *GRPCServer {
 	return &GRPCServer{
@@ -49,6 +51,7 @@ func NewGRPCServer(
 		welcomeCreditService: welcomeCreditService,
 		proxyHandler:         proxyHandler,
 		cookieHandler:        cookieHandler,
+		webhookHandler:       webhookHandler,
 		cfg:                  cfg,
 	}
 }
@@ -209,11 +212,22 @@ func (s *GRPCServer) CreateHistory(ctx context.Context, req *pb.CreateHistoryReq
 		Thumbnail: req.Thumbnail,
 		Duration:  req.Duration,
 		Author:    req.Author,
+		Priority:  int(req.Priority),
+		DedupKey:  req.DedupKey,
 		Status:    models.StatusPending, // 初始状态为待处理
 	}
 
 	// 调用服务层创建
 	historyID, err := s.historyService.CreateHistory(ctx, history)
+	var duplicate *models.DuplicateSubmissionError
+	if errors.As(err, &duplicate) {
+		log.Printf("[GRPCServer] CreateHistory duplicate of task %s for user %s", duplicate.TaskID, req.UserId)
+		return &pb.CreateHistoryResponse{
+			HistoryId: duplicate.HistoryID,
+			Duplicate: true,
+			TaskId:    duplicate.TaskID,
+		}, nil
+	}
 	if err != nil {
 		log.Printf("[GRPCServer] CreateHistory error: %v", err)
 		return nil, status.Error(codes.Internal, "创建历史记录失败")
@@ -327,7 +341,16 @@ func (s *GRPCServer) EstimateDownloadBilling(ctx context.Context, req *pb.Estima
 		filesize = req.GetSelectedFormat().GetFilesize()
 	}
 
-	estimate, _, err := s.billingService.EstimateDownloadBilling(ctx, filesize)
+	var clip *models.DownloadClip
+	if req.GetClipStartSeconds() > 0 || req.GetClipEndSeconds() > 0 {
+		clip = &models.DownloadClip{
+			DurationSeconds: req.GetDurationSeconds(),
+			StartSeconds:    req.GetClipStartSeconds(),
+			EndSeconds:      req.GetClipEndSeconds(),
+		}
+	}
+
+	estimate, _, err := s.billingService.EstimateDownloadBilling(ctx, filesize, clip)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "预估下载计费失败")
 	}
@@ -413,7 +436,12 @@ func (s *GRPCServer) ReleaseInitialDownload(ctx context.Context, req *pb.Release
 }
 
 func (s *GRPCServer) PrepareFileTransferBilling(ctx context.Context, req *pb.PrepareFileTransferBillingRequest) (*pb.PrepareFileTransferBillingResponse, error) {
-	order, hold, account, pricing, err := s.billingService.PrepareFileTransferBilling(ctx, req.GetUserId(), req.GetHistoryId(), req.GetFileSizeBytes())
+	order, hold, account, pricing, err := s.billingService.PrepareFileTransferBilling(ctx, req.GetUserId(), req.GetHistoryId(), models.FileTransferRange{
+		FileID:        req.GetFileId(),
+		FileSizeBytes: req.GetFileSizeBytes(),
+		Start:         req.GetRangeStart(),
+		End:           req.GetRangeEnd(),
+	})
 	if err != nil {
 		if errors.Is(err, service.ErrInsufficientBalance) {
 			return nil, status.Error(codes.ResourceExhausted, "余额不足")
@@ -1144,11 +1172,24 @@ func (s *GRPCServer) GetFileInfo(ctx context.Context, req *pb.GetFileInfoRequest
 		}
 	}
 
+	attachments := make([]*pb.AttachedFile, 0, len(fileInfo.Attachments))
+	for _, attachment := range fileInfo.Attachments {
+		attachments = append(attachments, &pb.AttachedFile{
+			Id:       attachment.ID,
+			Kind:     attachment.Kind,
+			Language: attachment.Language,
+			FilePath: attachment.FilePath,
+			FileName: attachment.FileName,
+			FileSize: attachment.FileSize,
+		})
+	}
+
 	return &pb.GetFileInfoResponse{
-		FilePath: fileInfo.FilePath,
-		FileName: fileInfo.FileName,
-		FileSize: fileInfo.FileSize,
-		FileHash: fileInfo.FileHash,
+		FilePath:    fileInfo.FilePath,
+		FileName:    fileInfo.FileName,
+		FileSize:    fileInfo.FileSize,
+		FileHash:    fileInfo.FileHash,
+		Attachments: attachments,
 	}, nil
 }
 
@@ -1239,3 +1280,33 @@ func (s *GRPCServer) ReportCookieUsage(ctx context.Context, req *pb.ReportCookie
 func (s *GRPCServer) FreezeCookie(ctx context.Context, req *pb.FreezeCookieRequest) (*pb.FreezeCookieResponse, error) {
 	return s.cookieHandler.FreezeCookie(ctx, req)
 }
+
+// ========== Webhook ==========
+
+func (s *GRPCServer) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	return s.webhookHandler.CreateWebhook(ctx, req)
+}
+
+func (s *GRPCServer) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	return s.webhookHandler.ListWebhooks(ctx, req)
+}
+
+func (s *GRPCServer) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.UpdateWebhookResponse, error) {
+	return s.webhookHandler.UpdateWebhook(ctx, req)
+}
+
+func (s *GRPCServer) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	return s.webhookHandler.DeleteWebhook(ctx, req)
+}
+
+func (s *GRPCServer) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	return s.webhookHandler.ListWebhookDeliveries(ctx, req)
+}
+
+func (s *GRPCServer) RedeliverWebhook(ctx context.Context, req *pb.RedeliverWebhookRequest) (*pb.RedeliverWebhookResponse, error) {
+	return s.webhookHandler.RedeliverWebhook(ctx, req)
+}
+
+func (s *GRPCServer) EmitWebhookEvent(ctx context.Context, req *pb.EmitWebhookEventRequest) (*pb.EmitWebhookEventResponse, error) {
+	return s.webhookHandler.EmitWebhookEvent(ctx, req)
+}
@@ -50,7 +50,7 @@ func TestWelcomeCreditSettingsRPC(t *testing.T) {
 
 	repo := &inMemoryWelcomeCreditRepo{}
 	welcomeSvc := service.NewWelcomeCreditService(repo)
-	server := NewGRPCServer(nil, nil, nil, nil, welcomeSvc, nil, nil, &config.Config{})
+	server := NewGRPCServer(nil, nil, nil, nil, welcomeSvc, nil, nil, nil, &config.Config{})
 
 	updateResp, err := server.UpdateWelcomeCreditSettings(context.Background(), &pb.UpdateWelcomeCreditSettingsRequest{
 		Enabled:      true,
@@ -0,0 +1,277 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"youdlp/asset-service/internal/config"
+	"youdlp/asset-service/internal/models"
+	"youdlp/asset-service/internal/service"
+	pb "youdlp/asset-service/proto"
+)
+
+// WebhookHandler Webhook gRPC 处理器
+type WebhookHandler struct {
+	webhookService *service.WebhookService
+	pagination     config.PaginationConfig
+}
+
+// NewWebhookHandler 创建 Webhook 处理器
+func NewWebhookHandler(webhookService *service.WebhookService, pagination config.PaginationConfig) *WebhookHandler {
+	return &WebhookHandler{webhookService: webhookService, pagination: pagination}
+}
+
+// webhookOwner 解析端点归属：全局端点归属为空字符串，用户端点必须携带用户ID
+func webhookOwner(userID string, global bool) (string, error) {
+	if global {
+		return "", nil
+	}
+	if strings.TrimSpace(userID) == "" {
+		return "", status.Error(codes.InvalidArgument, "用户ID不能为空")
+	}
+	return userID, nil
+}
+
+// CreateWebhook 创建端点
+func (h *WebhookHandler) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	ownerID, err := webhookOwner(req.UserId, req.Global)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.Url) == "" {
+		return nil, status.Error(codes.InvalidArgument, "Webhook 地址不能为空")
+	}
+
+	createdBy := req.OperatorUserId
+	if createdBy == "" {
+		createdBy = req.UserId
+	}
+	endpoint, err := h.webhookService.CreateEndpoint(ctx, ownerID, req.Url, req.Events, req.Description, createdBy)
+	if err != nil {
+		return nil, webhookError("CreateWebhook", err, "创建 Webhook 失败")
+	}
+
+	return &pb.CreateWebhookResponse{
+		Endpoint: toWebhookEndpointInfo(endpoint),
+		Secret:   endpoint.Secret,
+	}, nil
+}
+
+// ListWebhooks 列出端点
+func (h *WebhookHandler) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	ownerID, err := webhookOwner(req.UserId, req.Global)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints, err := h.webhookService.ListEndpoints(ctx, ownerID)
+	if err != nil {
+		return nil, webhookError("ListWebhooks", err, "获取 Webhook 列表失败")
+	}
+
+	items := make([]*pb.WebhookEndpointInfo, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		items = append(items, toWebhookEndpointInfo(endpoint))
+	}
+	return &pb.ListWebhooksResponse{
+		Items:           items,
+		AvailableEvents: models.WebhookEventTypes,
+	}, nil
+}
+
+// UpdateWebhook 更新端点
+func (h *WebhookHandler) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.UpdateWebhookResponse, error) {
+	ownerID, err := webhookOwner(req.UserId, req.Global)
+	if err != nil {
+		return nil, err
+	}
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Webhook ID 不能为空")
+	}
+
+	update := &models.WebhookEndpointUpdate{}
+	if req.Url != "" {
+		update.URL = &req.Url
+	}
+	if req.UpdateEvents {
+		update.Events = req.Events
+		update.EventsSet = true
+	}
+	if req.UpdateDescription {
+		update.Description = &req.Description
+	}
+	switch req.Status {
+	case "":
+	case "enabled", "disabled":
+		enabled := req.Status == "enabled"
+		update.Enabled = &enabled
+	default:
+		return nil, status.Error(codes.InvalidArgument, "状态只能为 enabled 或 disabled")
+	}
+
+	endpoint, err := h.webhookService.UpdateEndpoint(ctx, req.Id, ownerID, update)
+	if err != nil {
+		return nil, webhookError("UpdateWebhook", err, "更新 Webhook 失败")
+	}
+	return &pb.UpdateWebhookResponse{Endpoint: toWebhookEndpointInfo(endpoint)}, nil
+}
+
+// DeleteWebhook 删除端点
+func (h *WebhookHandler) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	ownerID, err := webhookOwner(req.UserId, req.Global)
+	if err != nil {
+		return nil, err
+	}
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Webhook ID 不能为空")
+	}
+
+	if err := h.webhookService.DeleteEndpoint(ctx, req.Id, ownerID); err != nil {
+		return nil, webhookError("DeleteWebhook", err, "删除 Webhook 失败")
+	}
+	return &pb.DeleteWebhookResponse{Success: true}, nil
+}
+
+// ListWebhookDeliveries 查询投递记录
+func (h *WebhookHandler) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	ownerID, err := webhookOwner(req.UserId, req.Global)
+	if err != nil {
+		return nil, err
+	}
+	switch req.Status {
+	case "", models.WebhookDeliveryStatusPending, models.WebhookDeliveryStatusSucceeded, models.WebhookDeliveryStatusFailed:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "投递状态无效")
+	}
+
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = h.pagination.DefaultPageSize
+	}
+	if pageSize > h.pagination.MaxPageSize {
+		pageSize = h.pagination.MaxPageSize
+	}
+
+	result, err := h.webhookService.ListDeliveries(ctx, &models.WebhookDeliveryFilter{
+		OwnerID:    ownerID,
+		EndpointID: req.EndpointId,
+		Status:     req.Status,
+		EventType:  req.EventType,
+		Page:       page,
+		PageSize:   pageSize,
+	})
+	if err != nil {
+		return nil, webhookError("ListWebhookDeliveries", err, "获取投递记录失败")
+	}
+
+	items := make([]*pb.WebhookDeliveryInfo, 0, len(result.Items))
+	for i := range result.Items {
+		items = append(items, toWebhookDeliveryInfo(&result.Items[i]))
+	}
+	return &pb.ListWebhookDeliveriesResponse{
+		Items:    items,
+		Total:    result.Total,
+		Page:     int32(result.Page),
+		PageSize: int32(result.PageSize),
+	}, nil
+}
+
+// RedeliverWebhook 人工重投
+func (h *WebhookHandler) RedeliverWebhook(ctx context.Context, req *pb.RedeliverWebhookRequest) (*pb.RedeliverWebhookResponse, error) {
+	ownerID, err := webhookOwner(req.UserId, req.Global)
+	if err != nil {
+		return nil, err
+	}
+	if req.DeliveryId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "投递记录 ID 不能为空")
+	}
+
+	if err := h.webhookService.Redeliver(ctx, req.DeliveryId, ownerID); err != nil {
+		return nil, webhookError("RedeliverWebhook", err, "重投 Webhook 失败")
+	}
+	return &pb.RedeliverWebhookResponse{Success: true}, nil
+}
+
+// EmitWebhookEvent 发布业务事件
+func (h *WebhookHandler) EmitWebhookEvent(ctx context.Context, req *pb.EmitWebhookEventRequest) (*pb.EmitWebhookEventResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "用户ID不能为空")
+	}
+
+	deliveries, err := h.webhookService.Emit(ctx, req.EventType, req.UserId, json.RawMessage(req.DataJson))
+	if err != nil {
+		return nil, webhookError("EmitWebhookEvent", err, "发布 Webhook 事件失败")
+	}
+	return &pb.EmitWebhookEventResponse{Deliveries: int32(deliveries)}, nil
+}
+
+func webhookError(method string, err error, message string) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidWebhookURL):
+		return status.Error(codes.InvalidArgument, "Webhook 地址无效，仅支持公网 http(s) 地址")
+	case errors.Is(err, service.ErrInvalidWebhookEvent):
+		return status.Error(codes.InvalidArgument, "不支持的事件类型")
+	case errors.Is(err, service.ErrInvalidWebhookEventData):
+		return status.Error(codes.InvalidArgument, "事件数据必须是 JSON 对象")
+	case errors.Is(err, service.ErrWebhookEndpointLimit):
+		return status.Error(codes.ResourceExhausted, "Webhook 数量已达上限")
+	case errors.Is(err, service.ErrWebhookNotFound):
+		return status.Error(codes.NotFound, "Webhook 不存在")
+	}
+	log.Printf("%s error: %v", method, err)
+	return status.Error(codes.Internal, message)
+}
+
+func toWebhookEndpointInfo(endpoint *models.WebhookEndpoint) *pb.WebhookEndpointInfo {
+	events := endpoint.Events
+	if events == nil {
+		events = []string{}
+	}
+	return &pb.WebhookEndpointInfo{
+		Id:          endpoint.ID,
+		UserId:      endpoint.UserID,
+		Url:         endpoint.URL,
+		Events:      events,
+		Description: endpoint.Description,
+		Enabled:     endpoint.Enabled,
+		CreatedBy:   endpoint.CreatedBy,
+		CreatedAt:   endpoint.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   endpoint.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func toWebhookDeliveryInfo(delivery *models.WebhookDelivery) *pb.WebhookDeliveryInfo {
+	info := &pb.WebhookDeliveryInfo{
+		Id:             delivery.ID,
+		EndpointId:     delivery.EndpointID,
+		EventId:        delivery.EventID,
+		EventType:      delivery.EventType,
+		UserId:         delivery.UserID,
+		PayloadJson:    string(delivery.Payload),
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		ResponseBody:   delivery.ResponseBody,
+		LastError:      delivery.LastError,
+		DurationMs:     delivery.DurationMS,
+		CreatedAt:      delivery.CreatedAt.Format(time.RFC3339),
+	}
+	if delivery.Status == models.WebhookDeliveryStatusPending {
+		info.NextAttemptAt = delivery.NextAttemptAt.Format(time.RFC3339)
+	}
+	if delivery.LastAttemptAt != nil {
+		info.LastAttemptAt = delivery.LastAttemptAt.Format(time.RFC3339)
+	}
+	return info
+}
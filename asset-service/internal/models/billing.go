@@ -276,6 +276,13 @@ type TrafficUsageResult struct {
 	Items    []TrafficUsageRecord
 }
 
+// DownloadClip 片段下载范围（秒），EndSeconds 为 0 表示到结尾
+type DownloadClip struct {
+	DurationSeconds int64
+	StartSeconds    float64
+	EndSeconds      float64
+}
+
 type BillingEstimate struct {
 	EstimatedIngressBytes int64
 	EstimatedEgressBytes  int64
@@ -285,3 +292,43 @@ type BillingEstimate struct {
 	IsEstimated           bool
 	EstimateReason        string
 }
+
+// ByteRange 半开字节区间 [Start, End)
+type ByteRange struct {
+	Start int64 `json:"start"`
+	End   int64 `json:"end"`
+}
+
+// FileTransferRange 单次文件传输请求的范围，End 为 0 表示到文件末尾
+type FileTransferRange struct {
+	FileID        int64
+	FileSizeBytes int64
+	Start         int64
+	End           int64
+}
+
+// FileTransferSession 续传会话：窗口期内同一文件的多次传输按已交付区间的并集计费
+type FileTransferSession struct {
+	ID              int64       `db:"id"`
+	UserID          string      `db:"user_id"`
+	HistoryID       int64       `db:"history_id"`
+	FileID          int64       `db:"file_id"`
+	FileSizeBytes   int64       `db:"file_size_bytes"`
+	DeliveredRanges []ByteRange `db:"delivered_ranges"`
+	BilledBytes     int64       `db:"billed_bytes"`
+	ExpiresAt       time.Time   `db:"expires_at"`
+	CreatedAt       time.Time   `db:"created_at"`
+	UpdatedAt       time.Time   `db:"updated_at"`
+}
+
+// FileTransferSegment 续传会话中单次传输请求覆盖的区间
+type FileTransferSegment struct {
+	TransferID     string    `db:"transfer_id"`
+	SessionID      int64     `db:"session_id"`
+	RangeStart     int64     `db:"range_start"`
+	RangeEnd       int64     `db:"range_end"`
+	DeliveredBytes int64     `db:"delivered_bytes"`
+	BilledBytes    int64     `db:"billed_bytes"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+}
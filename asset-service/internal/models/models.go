@@ -2,6 +2,7 @@ package models
 
 import (
 	"database/sql"
+	"fmt"
 	"time"
 )
 
@@ -39,6 +40,19 @@ type DownloadHistory struct {
 	Thumbnail    string         `db:"thumbnail"` // 缩略图URL
 	Duration     int64          `db:"duration"`  // 视频时长(秒)
 	Author       string         `db:"author"`    // 作者/上传者
+	ObjectID     sql.NullInt64  `db:"object_id"` // 关联的去重存储对象
+	Priority     int            `db:"priority"`  // 下载任务的消息优先级
+	DedupKey     string         `db:"dedup_key"` // 重复提交检测键，为空表示不检测
+}
+
+// DuplicateSubmissionError 同一用户已有相同 dedup_key 的未结束任务
+type DuplicateSubmissionError struct {
+	HistoryID int64
+	TaskID    string
+}
+
+func (e *DuplicateSubmissionError) Error() string {
+	return fmt.Sprintf("duplicate submission of task %s", e.TaskID)
 }
 
 // UserQuota 用户配额
@@ -168,8 +182,19 @@ type HistoryResult struct {
 
 // FileInfo 文件信息
 type FileInfo struct {
+	FilePath    string
+	FileName    string
+	FileSize    int64
+	FileHash    string
+	Attachments []AttachedFile // 附加产物（字幕等）
+}
+
+// AttachedFile 任务附加产物，对应 download_files 表
+type AttachedFile struct {
+	ID       int64
+	Kind     string // subtitle
+	Language string
 	FilePath string
 	FileName string
 	FileSize int64
-	FileHash string
 }
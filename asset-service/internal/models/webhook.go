@@ -0,0 +1,118 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Webhook 事件类型
+const (
+	WebhookEventTaskCompleted   = "task.completed"
+	WebhookEventTaskFailed      = "task.failed"
+	WebhookEventBillingCaptured = "billing.captured"
+	WebhookEventBalanceLow      = "balance.low"
+)
+
+// Webhook 投递状态
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusSucceeded = "succeeded"
+	WebhookDeliveryStatusFailed    = "failed"
+)
+
+// WebhookEventTypes 全部可订阅事件
+var WebhookEventTypes = []string{
+	WebhookEventTaskCompleted,
+	WebhookEventTaskFailed,
+	WebhookEventBillingCaptured,
+	WebhookEventBalanceLow,
+}
+
+// IsWebhookEventType 判断是否为支持的事件类型
+func IsWebhookEventType(eventType string) bool {
+	for _, item := range WebhookEventTypes {
+		if item == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookEndpoint 订阅端点；UserID 为空表示全局端点
+type WebhookEndpoint struct {
+	ID          int64
+	UserID      string
+	URL         string
+	Secret      string
+	Events      []string // 为空表示订阅全部事件
+	Description string
+	Enabled     bool
+	CreatedBy   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Global 是否为全局端点
+func (e *WebhookEndpoint) Global() bool {
+	return e.UserID == ""
+}
+
+// WebhookEvent 投递给订阅方的事件信封
+type WebhookEvent struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	UserID    string          `json:"user_id"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// WebhookDelivery 单个端点的一次事件投递
+type WebhookDelivery struct {
+	ID             int64
+	EndpointID     int64
+	EventID        string
+	EventType      string
+	UserID         string
+	Payload        []byte
+	Status         string
+	Attempts       int32
+	NextAttemptAt  time.Time
+	LastAttemptAt  *time.Time
+	ResponseStatus int32
+	ResponseBody   string
+	LastError      string
+	DurationMS     int64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	// 投递时从端点关联出的字段
+	EndpointURL    string
+	EndpointSecret string
+}
+
+// WebhookEndpointUpdate 端点更新字段；nil 表示不修改
+type WebhookEndpointUpdate struct {
+	URL         *string
+	Events      []string
+	EventsSet   bool
+	Description *string
+	Enabled     *bool
+}
+
+// WebhookDeliveryFilter 投递记录查询条件
+type WebhookDeliveryFilter struct {
+	OwnerID    string // 端点归属用户，空字符串表示全局端点
+	EndpointID int64
+	Status     string
+	EventType  string
+	Page       int
+	PageSize   int
+}
+
+// WebhookDeliveryResult 投递记录分页结果
+type WebhookDeliveryResult struct {
+	Items    []WebhookDelivery
+	Total    int64
+	Page     int
+	PageSize int
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"youdlp/asset-service/internal/models"
+)
+
+const fileTransferSessionColumns = `
+	id, user_id, history_id, file_id, file_size_bytes, delivered_ranges,
+	billed_bytes, expires_at, created_at, updated_at
+`
+
+// GetActiveTransferSessionForUpdate 查询用户对同一文件仍在窗口期内的续传会话，文件大小变化视为不同文件
+func (r *BillingRepository) GetActiveTransferSessionForUpdate(ctx context.Context, tx *sql.Tx, userID string, historyID, fileID, fileSizeBytes int64, now time.Time) (*models.FileTransferSession, error) {
+	return scanFileTransferSession(tx.QueryRowContext(ctx, `
+		SELECT `+fileTransferSessionColumns+`
+		FROM file_transfer_sessions
+		WHERE user_id = $1 AND history_id = $2 AND file_id = $3 AND file_size_bytes = $4 AND expires_at > $5
+		ORDER BY expires_at DESC
+		LIMIT 1
+		FOR UPDATE
+	`, userID, historyID, fileID, fileSizeBytes, now))
+}
+
+func (r *BillingRepository) GetTransferSessionForUpdate(ctx context.Context, tx *sql.Tx, sessionID int64) (*models.FileTransferSession, error) {
+	return scanFileTransferSession(tx.QueryRowContext(ctx, `
+		SELECT `+fileTransferSessionColumns+`
+		FROM file_transfer_sessions
+		WHERE id = $1
+		FOR UPDATE
+	`, sessionID))
+}
+
+func (r *BillingRepository) CreateTransferSessionTx(ctx context.Context, tx *sql.Tx, session *models.FileTransferSession) error {
+	ranges, err := json.Marshal(nonNilRanges(session.DeliveredRanges))
+	if err != nil {
+		return fmt.Errorf("failed to encode delivered ranges: %w", err)
+	}
+
+	now := time.Now()
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO file_transfer_sessions (
+			user_id, history_id, file_id, file_size_bytes, delivered_ranges,
+			billed_bytes, expires_at, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8)
+		RETURNING id
+	`,
+		session.UserID, session.HistoryID, session.FileID, session.FileSizeBytes, ranges,
+		session.BilledBytes, session.ExpiresAt, now,
+	).Scan(&session.ID); err != nil {
+		return fmt.Errorf("failed to create transfer session: %w", err)
+	}
+	session.CreatedAt = now
+	session.UpdatedAt = now
+	return nil
+}
+
+func (r *BillingRepository) UpdateTransferSessionTx(ctx context.Context, tx *sql.Tx, session *models.FileTransferSession) error {
+	ranges, err := json.Marshal(nonNilRanges(session.DeliveredRanges))
+	if err != nil {
+		return fmt.Errorf("failed to encode delivered ranges: %w", err)
+	}
+
+	now := time.Now()
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE file_transfer_sessions
+		SET delivered_ranges = $1,
+		    billed_bytes = $2,
+		    expires_at = $3,
+		    updated_at = $4
+		WHERE id = $5
+	`, ranges, session.BilledBytes, session.ExpiresAt, now, session.ID); err != nil {
+		return fmt.Errorf("failed to update transfer session: %w", err)
+	}
+	session.UpdatedAt = now
+	return nil
+}
+
+func (r *BillingRepository) CreateTransferSegmentTx(ctx context.Context, tx *sql.Tx, segment *models.FileTransferSegment) error {
+	now := time.Now()
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO file_transfer_segments (
+			transfer_id, session_id, range_start, range_end, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $5)
+	`, segment.TransferID, segment.SessionID, segment.RangeStart, segment.RangeEnd, now); err != nil {
+		return fmt.Errorf("failed to create transfer segment: %w", err)
+	}
+	segment.CreatedAt = now
+	segment.UpdatedAt = now
+	return nil
+}
+
+func (r *BillingRepository) GetTransferSegmentForUpdate(ctx context.Context, tx *sql.Tx, transferID string) (*models.FileTransferSegment, error) {
+	var segment models.FileTransferSegment
+	if err := tx.QueryRowContext(ctx, `
+		SELECT transfer_id, session_id, range_start, range_end, delivered_bytes, billed_bytes, created_at, updated_at
+		FROM file_transfer_segments
+		WHERE transfer_id = $1
+		FOR UPDATE
+	`, transferID).Scan(
+		&segment.TransferID, &segment.SessionID, &segment.RangeStart, &segment.RangeEnd,
+		&segment.DeliveredBytes, &segment.BilledBytes, &segment.CreatedAt, &segment.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &segment, nil
+}
+
+func (r *BillingRepository) UpdateTransferSegmentTx(ctx context.Context, tx *sql.Tx, segment *models.FileTransferSegment) error {
+	now := time.Now()
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE file_transfer_segments
+		SET delivered_bytes = $1,
+		    billed_bytes = $2,
+		    updated_at = $3
+		WHERE transfer_id = $4
+	`, segment.DeliveredBytes, segment.BilledBytes, now, segment.TransferID); err != nil {
+		return fmt.Errorf("failed to update transfer segment: %w", err)
+	}
+	segment.UpdatedAt = now
+	return nil
+}
+
+func scanFileTransferSession(row rowScanner) (*models.FileTransferSession, error) {
+	var session models.FileTransferSession
+	var ranges []byte
+	if err := row.Scan(
+		&session.ID, &session.UserID, &session.HistoryID, &session.FileID, &session.FileSizeBytes, &ranges,
+		&session.BilledBytes, &session.ExpiresAt, &session.CreatedAt, &session.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(ranges, &session.DeliveredRanges); err != nil {
+		return nil, fmt.Errorf("failed to decode delivered ranges: %w", err)
+	}
+	return &session, nil
+}
+
+func nonNilRanges(ranges []models.ByteRange) []models.ByteRange {
+	if ranges == nil {
+		return []models.ByteRange{}
+	}
+	return ranges
+}
@@ -55,7 +55,7 @@ func (r *HistoryRepository) GetByIDAndUserID(ctx context.Context, id int64, user
 	query := `
 		SELECT id, task_id, user_id, url, platform, title, mode, quality, 
 		       file_size, file_path, file_name, file_hash, status, error_message, 
-		       created_at, started_at, completed_at
+		       created_at, started_at, completed_at, object_id
 		FROM download_history
 		WHERE id = $1 AND user_id = $2
 	`
@@ -67,6 +67,7 @@ func (r *HistoryRepository) GetByIDAndUserID(ctx context.Context, id int64, user
 		&h.ID, &h.TaskID, &h.UserID, &h.URL, &h.Platform, &h.Title,
 		&h.Mode, &h.Quality, &h.FileSize, &h.FilePath, &h.FileName,
 		&h.FileHash, &h.Status, &h.ErrorMessage, &h.CreatedAt, &h.StartedAt, &completedAt,
+		&h.ObjectID,
 	)
 
 	if err != nil {
@@ -109,29 +110,76 @@ func (r *HistoryRepository) GetByTaskIDAndUserID(ctx context.Context, taskID, us
 	return &h, nil
 }
 
-// Create 创建历史记录
+// Create 创建历史记录。设置了 DedupKey 且同一用户已有相同键的待处理或下载中任务时不创建，
+// 返回 *models.DuplicateSubmissionError
 func (r *HistoryRepository) Create(ctx context.Context, history *models.DownloadHistory) (int64, error) {
 	query := `
 		INSERT INTO download_history (
 			task_id, user_id, url, platform, title, mode, quality, 
-			thumbnail, duration, author, status, created_at
+			thumbnail, duration, author, status, created_at, priority, dedup_key
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
-		) RETURNING id
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, NULLIF($14, '')
+		)
+		ON CONFLICT (user_id, dedup_key) WHERE dedup_key IS NOT NULL AND status IN (0, 1) DO NOTHING
+		RETURNING id
 	`
 
-	var id int64
-	err := r.db.QueryRowContext(ctx, query,
-		history.TaskID, history.UserID, history.URL, history.Platform,
-		history.Title, history.Mode, history.Quality, history.Thumbnail,
-		history.Duration, history.Author, history.Status, time.Now(),
-	).Scan(&id)
+	// 冲突的任务可能在两次查询之间结束，此时重试一次插入
+	for attempt := 0; attempt < 2; attempt++ {
+		var id int64
+		err := r.db.QueryRowContext(ctx, query,
+			history.TaskID, history.UserID, history.URL, history.Platform,
+			history.Title, history.Mode, history.Quality, history.Thumbnail,
+			history.Duration, history.Author, history.Status, time.Now(), history.Priority,
+			history.DedupKey,
+		).Scan(&id)
+		if err == nil {
+			return id, nil
+		}
+		if err != sql.ErrNoRows {
+			return 0, fmt.Errorf("failed to create history: %w", err)
+		}
+
+		duplicate := &models.DuplicateSubmissionError{}
+		err = r.db.QueryRowContext(ctx, `
+			SELECT id, task_id FROM download_history
+			WHERE user_id = $1 AND dedup_key = $2 AND status IN (0, 1)
+		`, history.UserID, history.DedupKey).Scan(&duplicate.HistoryID, &duplicate.TaskID)
+		if err == nil {
+			return 0, duplicate
+		}
+		if err != sql.ErrNoRows {
+			return 0, fmt.Errorf("failed to query duplicate history: %w", err)
+		}
+	}
+	return 0, fmt.Errorf("failed to create history: dedup key %s kept conflicting", history.DedupKey)
+}
+
+// ListAttachedFiles 查询任务的附加产物（字幕等）
+func (r *HistoryRepository) ListAttachedFiles(ctx context.Context, taskID string) ([]models.AttachedFile, error) {
+	query := `
+		SELECT id, kind, COALESCE(language, ''), file_path, file_name, file_size
+		FROM download_files
+		WHERE task_id = $1
+		ORDER BY id
+	`
 
+	rows, err := r.db.QueryContext(ctx, query, taskID)
 	if err != nil {
-		return 0, fmt.Errorf("failed to create history: %w", err)
+		return nil, fmt.Errorf("failed to query attached files: %w", err)
 	}
+	defer rows.Close()
 
-	return id, nil
+	var files []models.AttachedFile
+	for rows.Next() {
+		var f models.AttachedFile
+		if err := rows.Scan(&f.ID, &f.Kind, &f.Language, &f.FilePath, &f.FileName, &f.FileSize); err != nil {
+			return nil, fmt.Errorf("failed to scan attached file: %w", err)
+		}
+		files = append(files, f)
+	}
+
+	return files, rows.Err()
 }
 
 // UpdateCompletionByTaskID 按任务 ID 更新完成状态和文件信息
@@ -317,20 +365,75 @@ func (r *HistoryRepository) Query(ctx context.Context, filter *models.HistoryFil
 	}, nil
 }
 
-// Delete 删除历史记录
+// Delete 删除历史记录并释放去重对象引用。
+// asset-service 不直接访问存储后端，需删除的主文件、附加产物和无人引用的对象在同一事务中
+// 登记到 storage_deletions，由 media-service 清理任务异步删除
 func (r *HistoryRepository) Delete(ctx context.Context, id int64, userID string) error {
-	query := `DELETE FROM download_history WHERE id = $1 AND user_id = $2`
-	result, err := r.db.ExecContext(ctx, query, id, userID)
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
 
-	rows, _ := result.RowsAffected()
-	if rows == 0 {
-		return sql.ErrNoRows
+	// 附加产物随历史记录级联删除，需先取出文件键
+	var keys []string
+	rows, err := tx.QueryContext(ctx, `
+		SELECT file_path FROM download_files
+		WHERE task_id = (SELECT task_id FROM download_history WHERE id = $1 AND user_id = $2)
+	`, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to list attached files: %w", err)
+	}
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan attached file: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to list attached files: %w", err)
 	}
 
-	return nil
+	var filePath sql.NullString
+	var objectID sql.NullInt64
+	query := `DELETE FROM download_history WHERE id = $1 AND user_id = $2 RETURNING file_path, object_id`
+	if err := tx.QueryRowContext(ctx, query, id, userID).Scan(&filePath, &objectID); err != nil {
+		return err
+	}
+
+	if objectID.Valid {
+		// 主文件即共享对象，仅在引用归零时删除
+		var refCount int
+		var objectPath string
+		err := tx.QueryRowContext(ctx, `
+			UPDATE storage_objects
+			SET ref_count = ref_count - 1
+			WHERE id = $1 AND ref_count > 0
+			RETURNING ref_count, file_path
+		`, objectID.Int64).Scan(&refCount, &objectPath)
+		if err != nil && err != sql.ErrNoRows {
+			return fmt.Errorf("failed to release storage object: %w", err)
+		}
+		if err == nil && refCount == 0 {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM storage_objects WHERE id = $1 AND ref_count = 0`, objectID.Int64); err != nil {
+				return fmt.Errorf("failed to delete storage object: %w", err)
+			}
+			keys = append(keys, objectPath)
+		}
+	} else if filePath.Valid && filePath.String != "" {
+		keys = append(keys, filePath.String)
+	}
+
+	for _, key := range keys {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO storage_deletions (storage_key) VALUES ($1)`, key); err != nil {
+			return fmt.Errorf("failed to enqueue storage deletion: %w", err)
+		}
+	}
+
+	return tx.Commit()
 }
 
 // GetTotalCount 获取用户总下载数
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"youdlp/asset-service/internal/models"
+)
+
+func TestDeleteReleasesLastObjectReference(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("create sqlmock failed: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT file_path FROM download_files`).
+		WithArgs(int64(7), "user-1").
+		WillReturnRows(sqlmock.NewRows([]string{"file_path"}).AddRow("tmp/task-1/video.en.srt"))
+	mock.ExpectQuery(`DELETE FROM download_history WHERE id = \$1 AND user_id = \$2 RETURNING file_path, object_id`).
+		WithArgs(int64(7), "user-1").
+		WillReturnRows(sqlmock.NewRows([]string{"file_path", "object_id"}).AddRow("objects/ab/abc.mp4", int64(3)))
+	mock.ExpectQuery(`UPDATE storage_objects\s+SET ref_count = ref_count - 1`).
+		WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"ref_count", "file_path"}).AddRow(0, "objects/ab/abc.mp4"))
+	mock.ExpectExec(`DELETE FROM storage_objects WHERE id = \$1 AND ref_count = 0`).
+		WithArgs(int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO storage_deletions`).
+		WithArgs("tmp/task-1/video.en.srt").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`INSERT INTO storage_deletions`).
+		WithArgs("objects/ab/abc.mp4").
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	if err := NewHistoryRepository(db).Delete(context.Background(), 7, "user-1"); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet sql expectations: %v", err)
+	}
+}
+
+func TestDeleteKeepsSharedObject(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("create sqlmock failed: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT file_path FROM download_files`).
+		WithArgs(int64(7), "user-1").
+		WillReturnRows(sqlmock.NewRows([]string{"file_path"}))
+	mock.ExpectQuery(`DELETE FROM download_history`).
+		WithArgs(int64(7), "user-1").
+		WillReturnRows(sqlmock.NewRows([]string{"file_path", "object_id"}).AddRow("objects/ab/abc.mp4", int64(3)))
+	mock.ExpectQuery(`UPDATE storage_objects`).
+		WithArgs(int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"ref_count", "file_path"}).AddRow(1, "objects/ab/abc.mp4"))
+	mock.ExpectCommit()
+
+	if err := NewHistoryRepository(db).Delete(context.Background(), 7, "user-1"); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet sql expectations: %v", err)
+	}
+}
+
+func TestDeleteEnqueuesStandaloneFile(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("create sqlmock failed: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT file_path FROM download_files`).
+		WithArgs(int64(8), "user-1").
+		WillReturnRows(sqlmock.NewRows([]string{"file_path"}))
+	mock.ExpectQuery(`DELETE FROM download_history`).
+		WithArgs(int64(8), "user-1").
+		WillReturnRows(sqlmock.NewRows([]string{"file_path", "object_id"}).AddRow("archive/user-1/20260101/video.mp4", nil))
+	mock.ExpectExec(`INSERT INTO storage_deletions`).
+		WithArgs("archive/user-1/20260101/video.mp4").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	if err := NewHistoryRepository(db).Delete(context.Background(), 8, "user-1"); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet sql expectations: %v", err)
+	}
+}
+
+func TestCreateReturnsActiveDuplicate(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("create sqlmock failed: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(`INSERT INTO download_history .* ON CONFLICT \(user_id, dedup_key\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`SELECT id, task_id FROM download_history`).
+		WithArgs("user-1", "dedup-1").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "task_id"}).AddRow(int64(5), "task-existing"))
+
+	_, err = NewHistoryRepository(db).Create(context.Background(), &models.DownloadHistory{
+		TaskID:   "task-new",
+		UserID:   "user-1",
+		URL:      "https://www.youtube.com/watch?v=dQw4w9WgXcQ",
+		DedupKey: "dedup-1",
+	})
+	var duplicate *models.DuplicateSubmissionError
+	if !errors.As(err, &duplicate) || duplicate.HistoryID != 5 || duplicate.TaskID != "task-existing" {
+		t.Fatalf("expected duplicate submission error, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet sql expectations: %v", err)
+	}
+}
+
+func TestCreateRetriesWhenDuplicateFinished(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("create sqlmock failed: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(`INSERT INTO download_history`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`SELECT id, task_id FROM download_history`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "task_id"}))
+	mock.ExpectQuery(`INSERT INTO download_history`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(9)))
+
+	id, err := NewHistoryRepository(db).Create(context.Background(), &models.DownloadHistory{
+		TaskID:   "task-new",
+		UserID:   "user-1",
+		DedupKey: "dedup-1",
+	})
+	if err != nil || id != 9 {
+		t.Fatalf("expected retry to create history 9, got %d %v", id, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet sql expectations: %v", err)
+	}
+}
@@ -0,0 +1,357 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"youdlp/asset-service/internal/models"
+)
+
+// webhookQueryer 同时兼容 *sql.DB 与 *sql.Tx，事件可随账务事务一起落库
+type webhookQueryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// WebhookRepository Webhook 仓储
+type WebhookRepository struct {
+	db *sql.DB
+}
+
+// NewWebhookRepository 创建 Webhook 仓储
+func NewWebhookRepository(db *sql.DB) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+const webhookEndpointColumns = `
+	id, user_id, url, secret, events, description, enabled, created_by, created_at, updated_at
+`
+
+// CreateEndpoint 创建订阅端点
+func (r *WebhookRepository) CreateEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint) error {
+	events, err := marshalWebhookEvents(endpoint.Events)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	err = r.db.QueryRowContext(ctx, `
+		INSERT INTO webhook_endpoints (user_id, url, secret, events, description, enabled, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8)
+		RETURNING id
+	`, endpoint.UserID, endpoint.URL, endpoint.Secret, events, endpoint.Description, endpoint.Enabled, endpoint.CreatedBy, now).Scan(&endpoint.ID)
+	if err != nil {
+		return fmt.Errorf("create webhook endpoint failed: %w", err)
+	}
+	endpoint.CreatedAt = now
+	endpoint.UpdatedAt = now
+	return nil
+}
+
+// GetEndpoint 按归属获取端点；ownerID 为空表示全局端点
+func (r *WebhookRepository) GetEndpoint(ctx context.Context, id int64, ownerID string) (*models.WebhookEndpoint, error) {
+	row := r.db.QueryRowContext(ctx, `SELECT `+webhookEndpointColumns+`
+		FROM webhook_endpoints
+		WHERE id = $1 AND user_id = $2
+	`, id, ownerID)
+	return scanWebhookEndpoint(row)
+}
+
+// ListEndpoints 列出归属下的全部端点
+func (r *WebhookRepository) ListEndpoints(ctx context.Context, ownerID string) ([]*models.WebhookEndpoint, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+webhookEndpointColumns+`
+		FROM webhook_endpoints
+		WHERE user_id = $1
+		ORDER BY id DESC
+	`, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook endpoints failed: %w", err)
+	}
+	defer rows.Close()
+
+	return scanWebhookEndpoints(rows)
+}
+
+// UpdateEndpoint 更新端点
+func (r *WebhookRepository) UpdateEndpoint(ctx context.Context, id int64, ownerID string, update *models.WebhookEndpointUpdate) error {
+	sets := []string{"updated_at = $1"}
+	args := []interface{}{time.Now()}
+
+	if update.URL != nil {
+		args = append(args, *update.URL)
+		sets = append(sets, fmt.Sprintf("url = $%d", len(args)))
+	}
+	if update.EventsSet {
+		events, err := marshalWebhookEvents(update.Events)
+		if err != nil {
+			return err
+		}
+		args = append(args, events)
+		sets = append(sets, fmt.Sprintf("events = $%d", len(args)))
+	}
+	if update.Description != nil {
+		args = append(args, *update.Description)
+		sets = append(sets, fmt.Sprintf("description = $%d", len(args)))
+	}
+	if update.Enabled != nil {
+		args = append(args, *update.Enabled)
+		sets = append(sets, fmt.Sprintf("enabled = $%d", len(args)))
+	}
+
+	args = append(args, id, ownerID)
+	result, err := r.db.ExecContext(ctx, fmt.Sprintf(
+		"UPDATE webhook_endpoints SET %s WHERE id = $%d AND user_id = $%d",
+		strings.Join(sets, ", "), len(args)-1, len(args),
+	), args...)
+	if err != nil {
+		return fmt.Errorf("update webhook endpoint failed: %w", err)
+	}
+	return requireAffected(result)
+}
+
+// DeleteEndpoint 删除端点，投递记录级联删除
+func (r *WebhookRepository) DeleteEndpoint(ctx context.Context, id int64, ownerID string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM webhook_endpoints WHERE id = $1 AND user_id = $2
+	`, id, ownerID)
+	if err != nil {
+		return fmt.Errorf("delete webhook endpoint failed: %w", err)
+	}
+	return requireAffected(result)
+}
+
+// FindSubscribedEndpoints 查找订阅了事件的启用端点：用户自己的端点加全局端点
+func (r *WebhookRepository) FindSubscribedEndpoints(ctx context.Context, q webhookQueryer, userID, eventType string) ([]*models.WebhookEndpoint, error) {
+	if q == nil {
+		q = r.db
+	}
+	rows, err := q.QueryContext(ctx, `SELECT `+webhookEndpointColumns+`
+		FROM webhook_endpoints
+		WHERE enabled = TRUE
+		  AND (user_id = $1 OR user_id = '')
+		  AND (jsonb_array_length(events) = 0 OR events ? $2)
+		ORDER BY id
+	`, userID, eventType)
+	if err != nil {
+		return nil, fmt.Errorf("find webhook endpoints failed: %w", err)
+	}
+	defer rows.Close()
+
+	return scanWebhookEndpoints(rows)
+}
+
+// CreateDelivery 写入待投递记录
+func (r *WebhookRepository) CreateDelivery(ctx context.Context, q webhookQueryer, delivery *models.WebhookDelivery) error {
+	if q == nil {
+		q = r.db
+	}
+	now := time.Now()
+	_, err := q.ExecContext(ctx, `
+		INSERT INTO webhook_deliveries (
+			endpoint_id, event_id, event_type, user_id, payload, status, next_attempt_at, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $7, $7)
+	`, delivery.EndpointID, delivery.EventID, delivery.EventType, delivery.UserID, string(delivery.Payload),
+		models.WebhookDeliveryStatusPending, now)
+	if err != nil {
+		return fmt.Errorf("create webhook delivery failed: %w", err)
+	}
+	return nil
+}
+
+// ClaimDueDeliveries 领取到期的投递记录，并把 next_attempt_at 顺延 lease 防止并发实例重复投递
+func (r *WebhookRepository) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*models.WebhookDelivery, error) {
+	now := time.Now()
+	rows, err := r.db.QueryContext(ctx, `
+		WITH due AS (
+			SELECT d.id
+			FROM webhook_deliveries d
+			JOIN webhook_endpoints e ON e.id = d.endpoint_id
+			WHERE d.status = $1 AND d.next_attempt_at <= $2 AND e.enabled = TRUE
+			ORDER BY d.next_attempt_at
+			LIMIT $3
+			FOR UPDATE OF d SKIP LOCKED
+		)
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = $4, updated_at = $2
+		FROM due, webhook_endpoints e
+		WHERE d.id = due.id AND e.id = d.endpoint_id
+		RETURNING d.id, d.endpoint_id, d.event_id, d.event_type, d.user_id, d.payload, d.status,
+		          d.attempts, d.next_attempt_at, d.last_attempt_at, d.response_status, d.response_body,
+		          d.last_error, d.duration_ms, d.created_at, d.updated_at, e.url, e.secret
+	`, models.WebhookDeliveryStatusPending, now, limit, now.Add(lease))
+	if err != nil {
+		return nil, fmt.Errorf("claim webhook deliveries failed: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*models.WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows, true)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+// RecordAttempt 记录一次投递结果
+func (r *WebhookRepository) RecordAttempt(ctx context.Context, delivery *models.WebhookDelivery) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = $3, next_attempt_at = $4, last_attempt_at = $5,
+		    response_status = $6, response_body = $7, last_error = $8, duration_ms = $9, updated_at = $5
+		WHERE id = $1
+	`, delivery.ID, delivery.Status, delivery.Attempts, delivery.NextAttemptAt, delivery.LastAttemptAt,
+		delivery.ResponseStatus, delivery.ResponseBody, delivery.LastError, delivery.DurationMS)
+	if err != nil {
+		return fmt.Errorf("record webhook attempt failed: %w", err)
+	}
+	return nil
+}
+
+// ListDeliveries 按端点归属查询投递记录
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, filter *models.WebhookDeliveryFilter) (*models.WebhookDeliveryResult, error) {
+	conditions := []string{"e.user_id = $1"}
+	args := []interface{}{filter.OwnerID}
+
+	if filter.EndpointID > 0 {
+		args = append(args, filter.EndpointID)
+		conditions = append(conditions, fmt.Sprintf("d.endpoint_id = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("d.status = $%d", len(args)))
+	}
+	if filter.EventType != "" {
+		args = append(args, filter.EventType)
+		conditions = append(conditions, fmt.Sprintf("d.event_type = $%d", len(args)))
+	}
+	whereClause := "WHERE " + strings.Join(conditions, " AND ")
+
+	var total int64
+	if err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM webhook_deliveries d
+		JOIN webhook_endpoints e ON e.id = d.endpoint_id
+		`+whereClause, args...).Scan(&total); err != nil {
+		return nil, fmt.Errorf("count webhook deliveries failed: %w", err)
+	}
+
+	offset := (filter.Page - 1) * filter.PageSize
+	queryArgs := append(append([]interface{}{}, args...), filter.PageSize, offset)
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT d.id, d.endpoint_id, d.event_id, d.event_type, d.user_id, d.payload, d.status,
+		       d.attempts, d.next_attempt_at, d.last_attempt_at, d.response_status, d.response_body,
+		       d.last_error, d.duration_ms, d.created_at, d.updated_at
+		FROM webhook_deliveries d
+		JOIN webhook_endpoints e ON e.id = d.endpoint_id
+		`+whereClause+`
+		ORDER BY d.id DESC
+		LIMIT $`+fmt.Sprintf("%d", len(args)+1)+` OFFSET $`+fmt.Sprintf("%d", len(args)+2), queryArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook deliveries failed: %w", err)
+	}
+	defer rows.Close()
+
+	result := &models.WebhookDeliveryResult{Total: total, Page: filter.Page, PageSize: filter.PageSize}
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows, false)
+		if err != nil {
+			return nil, err
+		}
+		result.Items = append(result.Items, *delivery)
+	}
+	return result, rows.Err()
+}
+
+// ResetDelivery 人工重投：重新置为待投递并立即到期，保留历史尝试次数
+func (r *WebhookRepository) ResetDelivery(ctx context.Context, id int64, ownerID string) error {
+	now := time.Now()
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE webhook_deliveries d
+		SET status = $1, next_attempt_at = $2, last_error = '', updated_at = $2
+		FROM webhook_endpoints e
+		WHERE d.id = $3 AND e.id = d.endpoint_id AND e.user_id = $4
+	`, models.WebhookDeliveryStatusPending, now, id, ownerID)
+	if err != nil {
+		return fmt.Errorf("reset webhook delivery failed: %w", err)
+	}
+	return requireAffected(result)
+}
+
+func requireAffected(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func marshalWebhookEvents(events []string) (string, error) {
+	if events == nil {
+		events = []string{}
+	}
+	data, err := json.Marshal(events)
+	if err != nil {
+		return "", fmt.Errorf("marshal webhook events failed: %w", err)
+	}
+	return string(data), nil
+}
+
+func scanWebhookEndpoint(row rowScanner) (*models.WebhookEndpoint, error) {
+	var endpoint models.WebhookEndpoint
+	var events []byte
+	if err := row.Scan(
+		&endpoint.ID, &endpoint.UserID, &endpoint.URL, &endpoint.Secret, &events,
+		&endpoint.Description, &endpoint.Enabled, &endpoint.CreatedBy, &endpoint.CreatedAt, &endpoint.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if len(events) > 0 {
+		if err := json.Unmarshal(events, &endpoint.Events); err != nil {
+			return nil, fmt.Errorf("decode webhook events failed: %w", err)
+		}
+	}
+	return &endpoint, nil
+}
+
+func scanWebhookEndpoints(rows *sql.Rows) ([]*models.WebhookEndpoint, error) {
+	var endpoints []*models.WebhookEndpoint
+	for rows.Next() {
+		endpoint, err := scanWebhookEndpoint(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan webhook endpoint failed: %w", err)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints, rows.Err()
+}
+
+func scanWebhookDelivery(row rowScanner, withEndpoint bool) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	var lastAttemptAt sql.NullTime
+	dest := []interface{}{
+		&delivery.ID, &delivery.EndpointID, &delivery.EventID, &delivery.EventType, &delivery.UserID,
+		&delivery.Payload, &delivery.Status, &delivery.Attempts, &delivery.NextAttemptAt, &lastAttemptAt,
+		&delivery.ResponseStatus, &delivery.ResponseBody, &delivery.LastError, &delivery.DurationMS,
+		&delivery.CreatedAt, &delivery.UpdatedAt,
+	}
+	if withEndpoint {
+		dest = append(dest, &delivery.EndpointURL, &delivery.EndpointSecret)
+	}
+	if err := row.Scan(dest...); err != nil {
+		return nil, fmt.Errorf("scan webhook delivery failed: %w", err)
+	}
+	if lastAttemptAt.Valid {
+		delivery.LastAttemptAt = &lastAttemptAt.Time
+	}
+	return &delivery, nil
+}
@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -18,6 +19,10 @@ const (
 	mbBytes       = int64(1000 * 1000)
 	minBillableMB = int64(100)
 	gbMB          = int64(1000)
+
+	// 同一文件的续传请求在该窗口内合并计费，窗口从会话创建起算、不随交付顺延，
+	// 否则定期请求一个区间即可无限期免费重复下载已交付完的文件
+	transferResumeWindow = 24 * time.Hour
 )
 
 var defaultWelcomeCreditSettings = &models.WelcomeCreditSettings{
@@ -36,6 +41,7 @@ var (
 type BillingService struct {
 	repo              *repository.BillingRepository
 	welcomeCreditRepo *repository.WelcomeCreditSettingsRepository
+	webhooks          *WebhookService
 }
 
 // NewBillingService 创建账务服务
@@ -43,6 +49,22 @@ func NewBillingService(repo *repository.BillingRepository, welcomeCreditRepo *re
 	return &BillingService{repo: repo, welcomeCreditRepo: welcomeCreditRepo}
 }
 
+// SetWebhooks 启用账务事件 Webhook；流水写入时在同一事务内生成投递记录
+func (s *BillingService) SetWebhooks(webhooks *WebhookService) {
+	s.webhooks = webhooks
+}
+
+// createLedgerTx 写入账务流水，并派生对应的 Webhook 事件
+func (s *BillingService) createLedgerTx(ctx context.Context, tx *sql.Tx, entry *models.BillingLedgerEntry) error {
+	if err := s.repo.CreateLedgerTx(ctx, tx, entry); err != nil {
+		return err
+	}
+	if s.webhooks == nil {
+		return nil
+	}
+	return s.webhooks.EnqueueLedgerTx(ctx, tx, entry)
+}
+
 func (s *BillingService) GetBillingAccount(ctx context.Context, userID string, autoCreate bool) (*models.BillingAccount, error) {
 	if autoCreate {
 		return s.repo.GetOrCreateAccount(ctx, userID)
@@ -127,7 +149,7 @@ func (s *BillingService) GrantWelcomeCredit(ctx context.Context, userID, operati
 			Remark:                    models.WelcomeCreditReasonCode,
 			CreatedAt:                 now,
 		}
-		if err := s.repo.CreateLedgerTx(ctx, tx, entry); err != nil {
+		if err := s.createLedgerTx(ctx, tx, entry); err != nil {
 			return err
 		}
 
@@ -184,7 +206,8 @@ func shouldBootstrapWelcomeCreditSettings(settings *models.WelcomeCreditSettings
 	return strings.TrimSpace(settings.CurrencyCode) == "" || strings.TrimSpace(settings.UpdatedBy) == "" || settings.UpdatedAt.IsZero()
 }
 
-func (s *BillingService) EstimateDownloadBilling(ctx context.Context, selectedFormatFilesize int64) (*models.BillingEstimate, *models.BillingPricing, error) {
+// EstimateDownloadBilling 按所选格式文件大小预估流量费用，片段下载时按片段时长占比折算
+func (s *BillingService) EstimateDownloadBilling(ctx context.Context, selectedFormatFilesize int64, clip *models.DownloadClip) (*models.BillingEstimate, *models.BillingPricing, error) {
 	pricing, err := s.repo.GetActivePricing(ctx)
 	if err != nil {
 		return nil, nil, err
@@ -203,6 +226,12 @@ func (s *BillingService) EstimateDownloadBilling(ctx context.Context, selectedFo
 		}, pricing, nil
 	}
 
+	estimateReason := ""
+	if ratio, ok := clipRatio(clip); ok {
+		fileBytes = int64(math.Ceil(float64(fileBytes) * ratio))
+		estimateReason = "clip_prorated"
+	}
+
 	ingressCost, err := calculateAmountYuan(fileBytes, pricing.IngressPriceYuanPerGB)
 	if err != nil {
 		return nil, nil, err
@@ -218,11 +247,33 @@ func (s *BillingService) EstimateDownloadBilling(ctx context.Context, selectedFo
 		EstimatedTrafficBytes: fileBytes * 2,
 		EstimatedCostYuan:     ingressCost.Add(egressCost),
 		PricingVersion:        pricing.Version,
-		IsEstimated:           false,
-		EstimateReason:        "",
+		IsEstimated:           estimateReason != "",
+		EstimateReason:        estimateReason,
 	}, pricing, nil
 }
 
+// clipRatio 计算片段时长占整段时长的比例；未指定片段或总时长未知时不折算
+func clipRatio(clip *models.DownloadClip) (float64, bool) {
+	if clip == nil || clip.DurationSeconds <= 0 {
+		return 0, false
+	}
+
+	duration := float64(clip.DurationSeconds)
+	start := math.Max(clip.StartSeconds, 0)
+	end := clip.EndSeconds
+	if end <= 0 || end > duration {
+		end = duration
+	}
+	if start <= 0 && end >= duration {
+		return 0, false
+	}
+	if end <= start {
+		return 0, false
+	}
+
+	return (end - start) / duration, true
+}
+
 func (s *BillingService) HoldInitialDownload(ctx context.Context, userID string, historyID int64, taskID string, estimate *models.BillingEstimate) (*models.BillingChargeOrder, *models.BillingHold, *models.BillingAccount, error) {
 	var (
 		order   *models.BillingChargeOrder
@@ -318,7 +369,7 @@ func (s *BillingService) HoldInitialDownload(ctx context.Context, userID string,
 			Remark:                    "hold initial download",
 			CreatedAt:                 now,
 		}
-		return s.repo.CreateLedgerTx(ctx, tx, entry)
+		return s.createLedgerTx(ctx, tx, entry)
 	})
 	if err != nil {
 		return nil, nil, nil, err
@@ -430,7 +481,7 @@ func (s *BillingService) CaptureIngressUsage(ctx context.Context, taskID string,
 				Remark:                    "top up ingress reserve",
 				CreatedAt:                 now,
 			}
-			if err := s.repo.CreateLedgerTx(ctx, tx, holdEntry); err != nil {
+			if err := s.createLedgerTx(ctx, tx, holdEntry); err != nil {
 				return err
 			}
 		}
@@ -472,7 +523,7 @@ func (s *BillingService) CaptureIngressUsage(ctx context.Context, taskID string,
 			Remark:                    "capture ingress usage",
 			CreatedAt:                 now,
 		}
-		return s.repo.CreateLedgerTx(ctx, tx, entry)
+		return s.createLedgerTx(ctx, tx, entry)
 	})
 	if err != nil {
 		return nil, money.Zero(), err
@@ -548,7 +599,7 @@ func (s *BillingService) ReleaseInitialDownload(ctx context.Context, taskID, rea
 			Remark:                    reason,
 			CreatedAt:                 time.Now(),
 		}
-		return s.repo.CreateLedgerTx(ctx, tx, entry)
+		return s.createLedgerTx(ctx, tx, entry)
 	})
 	if err != nil {
 		return nil, money.Zero(), err
@@ -557,13 +608,17 @@ func (s *BillingService) ReleaseInitialDownload(ctx context.Context, taskID, rea
 	return order, releasedAmount, nil
 }
 
-func (s *BillingService) PrepareFileTransferBilling(ctx context.Context, userID string, historyID, fileSizeBytes int64) (*models.BillingChargeOrder, *models.BillingHold, *models.BillingAccount, *models.BillingPricing, error) {
+// PrepareFileTransferBilling 为一次文件传输预留费用。
+// 窗口期内对同一文件的续传只为尚未交付过的字节预留
+func (s *BillingService) PrepareFileTransferBilling(ctx context.Context, userID string, historyID int64, transfer models.FileTransferRange) (*models.BillingChargeOrder, *models.BillingHold, *models.BillingAccount, *models.BillingPricing, error) {
 	var (
 		order   *models.BillingChargeOrder
 		hold    *models.BillingHold
 		account *models.BillingAccount
 		pricing *models.BillingPricing
 	)
+	fileSizeBytes := transfer.FileSizeBytes
+	requested := transferByteRange(transfer)
 
 	err := s.repo.WithTx(ctx, func(tx *sql.Tx) error {
 		var err error
@@ -586,12 +641,22 @@ func (s *BillingService) PrepareFileTransferBilling(ctx context.Context, userID
 		fundingSource := int32(models.BillingFundingSourceNewReserve)
 		requiredReserve := money.Zero()
 
-		if errors.Is(err, sql.ErrNoRows) || order == nil || !canUseInitialOrder(order) {
+		session, err := s.getOrCreateTransferSession(ctx, tx, userID, historyID, transfer, now)
+		if err != nil {
+			return err
+		}
+		billedBytes, uncovered := int64(0), requested.End-requested.Start
+		if session != nil {
+			billedBytes = session.BilledBytes
+			uncovered = uncoveredBytes(session.DeliveredRanges, requested)
+		}
+
+		if order == nil || !canUseInitialOrder(order) {
 			pricing, err = s.repo.GetActivePricing(ctx)
 			if err != nil {
 				return err
 			}
-			requiredReserve, err = calculateAmountYuan(fileSizeBytes, pricing.EgressPriceYuanPerGB)
+			requiredReserve, err = transferChargeYuan(billedBytes, uncovered, pricing.EgressPriceYuanPerGB)
 			if err != nil {
 				return err
 			}
@@ -639,7 +704,7 @@ func (s *BillingService) PrepareFileTransferBilling(ctx context.Context, userID
 				Remark:                    "hold redownload transfer",
 				CreatedAt:                 now,
 			}
-			if err := s.repo.CreateLedgerTx(ctx, tx, entry); err != nil {
+			if err := s.createLedgerTx(ctx, tx, entry); err != nil {
 				return err
 			}
 		} else {
@@ -647,7 +712,7 @@ func (s *BillingService) PrepareFileTransferBilling(ctx context.Context, userID
 			if err != nil {
 				return err
 			}
-			requiredReserve, err = calculateAmountYuan(fileSizeBytes, pricing.EgressPriceYuanPerGB)
+			requiredReserve, err = transferChargeYuan(billedBytes, uncovered, pricing.EgressPriceYuanPerGB)
 			if err != nil {
 				return err
 			}
@@ -691,7 +756,7 @@ func (s *BillingService) PrepareFileTransferBilling(ctx context.Context, userID
 					Remark:                    "top up first transfer reserve",
 					CreatedAt:                 now,
 				}
-				if err := s.repo.CreateLedgerTx(ctx, tx, entry); err != nil {
+				if err := s.createLedgerTx(ctx, tx, entry); err != nil {
 					return err
 				}
 			} else {
@@ -714,7 +779,18 @@ func (s *BillingService) PrepareFileTransferBilling(ctx context.Context, userID
 			CreatedAt:          now,
 			UpdatedAt:          now,
 		}
-		return s.repo.CreateHoldTx(ctx, tx, hold)
+		if err := s.repo.CreateHoldTx(ctx, tx, hold); err != nil {
+			return err
+		}
+		if session == nil {
+			return nil
+		}
+		return s.repo.CreateTransferSegmentTx(ctx, tx, &models.FileTransferSegment{
+			TransferID: hold.TransferID,
+			SessionID:  session.ID,
+			RangeStart: requested.Start,
+			RangeEnd:   requested.End,
+		})
 	})
 	if err != nil {
 		return nil, nil, nil, nil, err
@@ -748,14 +824,14 @@ func (s *BillingService) CompleteFileTransferBilling(ctx context.Context, transf
 		if err != nil {
 			return err
 		}
-		capturedAmount, err = calculateAmountYuan(actualEgressBytes, pricing.EgressPriceYuanPerGB)
-		if err != nil {
-			return err
-		}
 		if hold.Status == models.BillingHoldStatusCaptured || (hold.CapturedAmountYuan.Cmp(money.Zero()) > 0 && order.ShortfallYuan.IsZero()) {
 			capturedAmount = hold.CapturedAmountYuan
 			return nil
 		}
+		if hold.Status == models.BillingHoldStatusReleased {
+			capturedAmount = money.Zero()
+			return nil
+		}
 		if order.ActualEgressBytes > 0 {
 			if order.ActualEgressBytes != actualEgressBytes {
 				return fmt.Errorf("egress usage already recorded for transfer %s", transferID)
@@ -766,6 +842,18 @@ func (s *BillingService) CompleteFileTransferBilling(ctx context.Context, transf
 			}
 		}
 
+		// 续传会话内只对首次交付的字节计费
+		var billableBytes int64
+		billableBytes, capturedAmount, err = s.settleTransferSegment(ctx, tx, transferID, actualEgressBytes, pricing)
+		if err != nil {
+			return err
+		}
+		if billableBytes == 0 {
+			capturedAmount = money.Zero()
+			_, err := s.releaseTransferHoldTx(ctx, tx, hold, order, account, "transfer delivered no new bytes")
+			return err
+		}
+
 		additionalReserve := money.Zero()
 		if remaining := remainingOrderReserve(order); remaining.Cmp(capturedAmount) < 0 {
 			additionalReserve = capturedAmount.Sub(remaining)
@@ -812,7 +900,7 @@ func (s *BillingService) CompleteFileTransferBilling(ctx context.Context, transf
 
 		account.ReservedBalanceYuan = account.ReservedBalanceYuan.Sub(capturedAmount)
 		account.TotalSpentYuan = account.TotalSpentYuan.Add(capturedAmount)
-		account.TotalTrafficBytes += actualEgressBytes
+		account.TotalTrafficBytes += billableBytes
 		if err := s.repo.UpdateAccountTx(ctx, tx, account); err != nil {
 			return err
 		}
@@ -837,7 +925,7 @@ func (s *BillingService) CompleteFileTransferBilling(ctx context.Context, transf
 				Remark:                    "top up transfer reserve",
 				CreatedAt:                 now,
 			}
-			if err := s.repo.CreateLedgerTx(ctx, tx, holdEntry); err != nil {
+			if err := s.createLedgerTx(ctx, tx, holdEntry); err != nil {
 				return err
 			}
 		}
@@ -850,7 +938,7 @@ func (s *BillingService) CompleteFileTransferBilling(ctx context.Context, transf
 			TaskID:             order.TaskID,
 			TransferID:         transferID,
 			Direction:          models.TrafficDirectionEgress,
-			TrafficBytes:       actualEgressBytes,
+			TrafficBytes:       billableBytes,
 			UnitPriceYuanPerGB: pricing.EgressPriceYuanPerGB,
 			AmountYuan:         capturedAmount,
 			PricingVersion:     pricing.Version,
@@ -881,7 +969,7 @@ func (s *BillingService) CompleteFileTransferBilling(ctx context.Context, transf
 			Remark:                    "capture file transfer",
 			CreatedAt:                 now,
 		}
-		if err := s.repo.CreateLedgerTx(ctx, tx, entry); err != nil {
+		if err := s.createLedgerTx(ctx, tx, entry); err != nil {
 			return err
 		}
 
@@ -920,7 +1008,7 @@ func (s *BillingService) CompleteFileTransferBilling(ctx context.Context, transf
 				Remark:                    "release unused transfer reserve",
 				CreatedAt:                 time.Now(),
 			}
-			if err := s.repo.CreateLedgerTx(ctx, tx, releaseEntry); err != nil {
+			if err := s.createLedgerTx(ctx, tx, releaseEntry); err != nil {
 				return err
 			}
 		}
@@ -955,56 +1043,130 @@ func (s *BillingService) AbortFileTransferBilling(ctx context.Context, transferI
 			return err
 		}
 
-		releasedAmount = remainingHoldAmount(hold)
-		if releasedAmount.IsZero() {
-			return nil
-		}
+		releasedAmount, err = s.releaseTransferHoldTx(ctx, tx, hold, order, account, reason)
+		return err
+	})
+	if err != nil {
+		return nil, money.Zero(), err
+	}
 
-		hold.ReleasedAmountYuan = hold.ReleasedAmountYuan.Add(releasedAmount)
-		hold.Status = models.BillingHoldStatusReleased
-		if err := s.repo.UpdateHoldTx(ctx, tx, hold); err != nil {
-			return err
-		}
+	return order, releasedAmount, nil
+}
 
-		order.ReleasedAmountYuan = order.ReleasedAmountYuan.Add(releasedAmount)
-		order.Remark = reason
-		order.Status = deriveOrderStatus(order)
-		if err := s.repo.UpdateOrderTx(ctx, tx, order); err != nil {
-			return err
-		}
+// releaseTransferHoldTx 释放传输预留的剩余金额
+func (s *BillingService) releaseTransferHoldTx(ctx context.Context, tx *sql.Tx, hold *models.BillingHold, order *models.BillingChargeOrder, account *models.BillingAccount, reason string) (money.Decimal, error) {
+	releasedAmount := remainingHoldAmount(hold)
+	if releasedAmount.IsZero() {
+		return releasedAmount, nil
+	}
 
-		account.AvailableBalanceYuan = account.AvailableBalanceYuan.Add(releasedAmount)
-		account.ReservedBalanceYuan = account.ReservedBalanceYuan.Sub(releasedAmount)
-		if err := s.repo.UpdateAccountTx(ctx, tx, account); err != nil {
-			return err
-		}
+	hold.ReleasedAmountYuan = hold.ReleasedAmountYuan.Add(releasedAmount)
+	hold.Status = models.BillingHoldStatusReleased
+	if err := s.repo.UpdateHoldTx(ctx, tx, hold); err != nil {
+		return money.Zero(), err
+	}
 
-		entry := &models.BillingLedgerEntry{
-			EntryNo:                   newBillingID("led"),
-			AccountID:                 account.ID,
-			UserID:                    order.UserID,
-			OrderNo:                   order.OrderNo,
-			HoldNo:                    hold.HoldNo,
-			HistoryID:                 order.HistoryID,
-			TaskID:                    order.TaskID,
-			TransferID:                transferID,
-			EntryType:                 models.LedgerEntryTypeRelease,
-			Scene:                     order.Scene,
-			ActionAmountYuan:          releasedAmount,
-			AvailableDeltaYuan:        releasedAmount,
-			ReservedDeltaYuan:         releasedAmount.Neg(),
-			BalanceAfterAvailableYuan: account.AvailableBalanceYuan,
-			BalanceAfterReservedYuan:  account.ReservedBalanceYuan,
-			Remark:                    reason,
-			CreatedAt:                 time.Now(),
-		}
-		return s.repo.CreateLedgerTx(ctx, tx, entry)
-	})
+	order.ReleasedAmountYuan = order.ReleasedAmountYuan.Add(releasedAmount)
+	order.Remark = reason
+	order.Status = deriveOrderStatus(order)
+	if err := s.repo.UpdateOrderTx(ctx, tx, order); err != nil {
+		return money.Zero(), err
+	}
+
+	account.AvailableBalanceYuan = account.AvailableBalanceYuan.Add(releasedAmount)
+	account.ReservedBalanceYuan = account.ReservedBalanceYuan.Sub(releasedAmount)
+	if err := s.repo.UpdateAccountTx(ctx, tx, account); err != nil {
+		return money.Zero(), err
+	}
+
+	entry := &models.BillingLedgerEntry{
+		EntryNo:                   newBillingID("led"),
+		AccountID:                 account.ID,
+		UserID:                    order.UserID,
+		OrderNo:                   order.OrderNo,
+		HoldNo:                    hold.HoldNo,
+		HistoryID:                 order.HistoryID,
+		TaskID:                    order.TaskID,
+		TransferID:                hold.TransferID,
+		EntryType:                 models.LedgerEntryTypeRelease,
+		Scene:                     order.Scene,
+		ActionAmountYuan:          releasedAmount,
+		AvailableDeltaYuan:        releasedAmount,
+		ReservedDeltaYuan:         releasedAmount.Neg(),
+		BalanceAfterAvailableYuan: account.AvailableBalanceYuan,
+		BalanceAfterReservedYuan:  account.ReservedBalanceYuan,
+		Remark:                    reason,
+		CreatedAt:                 time.Now(),
+	}
+	return releasedAmount, s.createLedgerTx(ctx, tx, entry)
+}
+
+// getOrCreateTransferSession 获取窗口期内同一文件的续传会话，不存在时新建。文件大小未知时不做续传合并
+func (s *BillingService) getOrCreateTransferSession(ctx context.Context, tx *sql.Tx, userID string, historyID int64, transfer models.FileTransferRange, now time.Time) (*models.FileTransferSession, error) {
+	if transfer.FileSizeBytes <= 0 {
+		return nil, nil
+	}
+
+	session, err := s.repo.GetActiveTransferSessionForUpdate(ctx, tx, userID, historyID, transfer.FileID, transfer.FileSizeBytes, now)
+	if err == nil {
+		return session, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	session = &models.FileTransferSession{
+		UserID:        userID,
+		HistoryID:     historyID,
+		FileID:        transfer.FileID,
+		FileSizeBytes: transfer.FileSizeBytes,
+		ExpiresAt:     now.Add(transferResumeWindow),
+	}
+	if err := s.repo.CreateTransferSessionTx(ctx, tx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// settleTransferSegment 将实际交付的区间并入续传会话，返回新增计费字节数与应扣金额。
+// 没有续传会话的传输按实际字节数计费
+func (s *BillingService) settleTransferSegment(ctx context.Context, tx *sql.Tx, transferID string, actualEgressBytes int64, pricing *models.BillingPricing) (int64, money.Decimal, error) {
+	segment, err := s.repo.GetTransferSegmentForUpdate(ctx, tx, transferID)
+	if errors.Is(err, sql.ErrNoRows) {
+		amount, err := calculateAmountYuan(actualEgressBytes, pricing.EgressPriceYuanPerGB)
+		return actualEgressBytes, amount, err
+	}
 	if err != nil {
-		return nil, money.Zero(), err
+		return 0, money.Zero(), err
+	}
+	session, err := s.repo.GetTransferSessionForUpdate(ctx, tx, segment.SessionID)
+	if err != nil {
+		return 0, money.Zero(), err
 	}
 
-	return order, releasedAmount, nil
+	delivered := models.ByteRange{Start: segment.RangeStart, End: segment.RangeStart + actualEgressBytes}
+	if delivered.End > segment.RangeEnd {
+		delivered.End = segment.RangeEnd
+	}
+
+	var added int64
+	session.DeliveredRanges, added = mergeByteRanges(session.DeliveredRanges, delivered)
+	amount, err := transferChargeYuan(session.BilledBytes, added, pricing.EgressPriceYuanPerGB)
+	if err != nil {
+		return 0, money.Zero(), err
+	}
+
+	session.BilledBytes += added
+	if err := s.repo.UpdateTransferSessionTx(ctx, tx, session); err != nil {
+		return 0, money.Zero(), err
+	}
+
+	segment.DeliveredBytes = delivered.End - delivered.Start
+	segment.BilledBytes = added
+	if err := s.repo.UpdateTransferSegmentTx(ctx, tx, segment); err != nil {
+		return 0, money.Zero(), err
+	}
+	return added, amount, nil
 }
 
 func (s *BillingService) resolveInitialDownloadShortfall(ctx context.Context, tx *sql.Tx, order *models.BillingChargeOrder, account *models.BillingAccount, remark, operatorUserID string) (*models.BillingLedgerEntry, error) {
@@ -1065,7 +1227,7 @@ func (s *BillingService) resolveInitialDownloadShortfall(ctx context.Context, tx
 
 		holdEntry.HoldNo = hold.HoldNo
 		holdEntry.BalanceAfterReservedYuan = account.ReservedBalanceYuan.Add(capturedAmount)
-		if err := s.repo.CreateLedgerTx(ctx, tx, holdEntry); err != nil {
+		if err := s.createLedgerTx(ctx, tx, holdEntry); err != nil {
 			return nil, err
 		}
 
@@ -1106,7 +1268,7 @@ func (s *BillingService) resolveInitialDownloadShortfall(ctx context.Context, tx
 			Remark:                    "capture ingress usage after shortfall resolution",
 			CreatedAt:                 now,
 		}
-		return holdEntry, s.repo.CreateLedgerTx(ctx, tx, captureEntry)
+		return holdEntry, s.createLedgerTx(ctx, tx, captureEntry)
 	}
 
 	order.ShortfallYuan = money.Zero()
@@ -1118,7 +1280,7 @@ func (s *BillingService) resolveInitialDownloadShortfall(ctx context.Context, tx
 	if err := s.repo.UpdateAccountTx(ctx, tx, account); err != nil {
 		return nil, err
 	}
-	return holdEntry, s.repo.CreateLedgerTx(ctx, tx, holdEntry)
+	return holdEntry, s.createLedgerTx(ctx, tx, holdEntry)
 }
 
 func (s *BillingService) resolveTransferShortfall(ctx context.Context, tx *sql.Tx, order *models.BillingChargeOrder, account *models.BillingAccount, remark, operatorUserID string) (*models.BillingLedgerEntry, error) {
@@ -1183,7 +1345,7 @@ func (s *BillingService) resolveTransferShortfall(ctx context.Context, tx *sql.T
 	}
 
 	holdEntry.BalanceAfterReservedYuan = account.ReservedBalanceYuan.Add(capturedAmount)
-	if err := s.repo.CreateLedgerTx(ctx, tx, holdEntry); err != nil {
+	if err := s.createLedgerTx(ctx, tx, holdEntry); err != nil {
 		return nil, err
 	}
 
@@ -1226,7 +1388,7 @@ func (s *BillingService) resolveTransferShortfall(ctx context.Context, tx *sql.T
 		Remark:                    "capture file transfer after shortfall resolution",
 		CreatedAt:                 now,
 	}
-	if err := s.repo.CreateLedgerTx(ctx, tx, captureEntry); err != nil {
+	if err := s.createLedgerTx(ctx, tx, captureEntry); err != nil {
 		return nil, err
 	}
 
@@ -1265,7 +1427,7 @@ func (s *BillingService) resolveTransferShortfall(ctx context.Context, tx *sql.T
 			Remark:                    "release unused reserve after shortfall resolution",
 			CreatedAt:                 now,
 		}
-		if err := s.repo.CreateLedgerTx(ctx, tx, releaseEntry); err != nil {
+		if err := s.createLedgerTx(ctx, tx, releaseEntry); err != nil {
 			return nil, err
 		}
 	}
@@ -1344,7 +1506,7 @@ func (s *BillingService) AdjustBillingBalance(ctx context.Context, userID, opera
 			Remark:                    remark,
 			CreatedAt:                 time.Now(),
 		}
-		return s.repo.CreateLedgerTx(ctx, tx, entry)
+		return s.createLedgerTx(ctx, tx, entry)
 	})
 	if err != nil {
 		return nil, nil, err
@@ -1574,6 +1736,82 @@ func calculateAmountYuan(bytes int64, pricePerGB money.Decimal) (money.Decimal,
 	return amount.Ceil(2), nil
 }
 
+// transferChargeYuan 续传会话中新增 addedBytes 的费用：按会话累计字节计价后扣除已计费部分，
+// 避免每个分段都按最低计费量收费
+func transferChargeYuan(billedBytes, addedBytes int64, pricePerGB money.Decimal) (money.Decimal, error) {
+	if addedBytes <= 0 {
+		return money.Zero(), nil
+	}
+	total, err := calculateAmountYuan(billedBytes+addedBytes, pricePerGB)
+	if err != nil || billedBytes <= 0 {
+		return total, err
+	}
+	billed, err := calculateAmountYuan(billedBytes, pricePerGB)
+	if err != nil {
+		return money.Zero(), err
+	}
+	return total.Sub(billed), nil
+}
+
+// transferByteRange 将请求范围规整为 [Start, End)，End 为 0 或越界时截到文件末尾
+func transferByteRange(transfer models.FileTransferRange) models.ByteRange {
+	r := models.ByteRange{Start: transfer.Start, End: transfer.End}
+	if r.End <= 0 || (transfer.FileSizeBytes > 0 && r.End > transfer.FileSizeBytes) {
+		r.End = transfer.FileSizeBytes
+	}
+	if r.Start < 0 {
+		r.Start = 0
+	}
+	if r.End < r.Start {
+		r.End = r.Start
+	}
+	return r
+}
+
+// uncoveredBytes 计算区间 r 中尚未被 ranges 覆盖的字节数，ranges 须有序且互不重叠
+func uncoveredBytes(ranges []models.ByteRange, r models.ByteRange) int64 {
+	uncovered := r.End - r.Start
+	if uncovered <= 0 {
+		return 0
+	}
+	for _, existing := range ranges {
+		start, end := max(existing.Start, r.Start), min(existing.End, r.End)
+		if end > start {
+			uncovered -= end - start
+		}
+	}
+	return uncovered
+}
+
+// mergeByteRanges 将区间 r 并入有序区间列表，返回合并后的列表及新覆盖的字节数
+func mergeByteRanges(ranges []models.ByteRange, r models.ByteRange) ([]models.ByteRange, int64) {
+	if r.End <= r.Start {
+		return ranges, 0
+	}
+	added := uncoveredBytes(ranges, r)
+
+	merged := make([]models.ByteRange, 0, len(ranges)+1)
+	inserted := false
+	for _, existing := range ranges {
+		switch {
+		case existing.End < r.Start:
+			merged = append(merged, existing)
+		case existing.Start > r.End:
+			if !inserted {
+				merged = append(merged, r)
+				inserted = true
+			}
+			merged = append(merged, existing)
+		default:
+			r.Start, r.End = min(r.Start, existing.Start), max(r.End, existing.End)
+		}
+	}
+	if !inserted {
+		merged = append(merged, r)
+	}
+	return merged, added
+}
+
 func newBillingID(prefix string) string {
 	buf := make([]byte, 6)
 	if _, err := rand.Read(buf); err != nil {
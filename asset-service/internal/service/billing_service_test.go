@@ -104,6 +104,84 @@ func TestCalculateAmountYuan_Minimum100MBAndCeilTwoDecimals(t *testing.T) {
 	}
 }
 
+func TestTransferChargeYuan_ResumedChunksSumToWholeFile(t *testing.T) {
+	t.Parallel()
+
+	price := money.MustParse("1.00")
+
+	whole, err := transferChargeYuan(0, 500*mbBytes, price)
+	if err != nil {
+		t.Fatalf("charge whole file failed: %v", err)
+	}
+
+	total := money.Zero()
+	billed := int64(0)
+	for _, chunk := range []int64{10 * mbBytes, 40 * mbBytes, 450 * mbBytes} {
+		amount, err := transferChargeYuan(billed, chunk, price)
+		if err != nil {
+			t.Fatalf("charge chunk failed: %v", err)
+		}
+		total = total.Add(amount)
+		billed += chunk
+	}
+
+	if total.Cmp(whole) != 0 {
+		t.Fatalf("expected resumed chunks to cost %s, got %s", whole.String(), total.String())
+	}
+
+	none, err := transferChargeYuan(billed, 0, price)
+	if err != nil {
+		t.Fatalf("charge empty chunk failed: %v", err)
+	}
+	if !none.IsZero() {
+		t.Fatalf("expected no charge for already delivered bytes, got %s", none.String())
+	}
+}
+
+func TestMergeByteRanges_CountsOnlyNewBytes(t *testing.T) {
+	t.Parallel()
+
+	var ranges []models.ByteRange
+	var added int64
+
+	ranges, added = mergeByteRanges(ranges, models.ByteRange{Start: 0, End: 100})
+	if added != 100 {
+		t.Fatalf("expected 100 new bytes, got %d", added)
+	}
+	ranges, added = mergeByteRanges(ranges, models.ByteRange{Start: 200, End: 300})
+	if added != 100 {
+		t.Fatalf("expected 100 new bytes, got %d", added)
+	}
+	ranges, added = mergeByteRanges(ranges, models.ByteRange{Start: 50, End: 250})
+	if added != 100 {
+		t.Fatalf("expected gap of 100 bytes to be billed, got %d", added)
+	}
+	if len(ranges) != 1 || ranges[0] != (models.ByteRange{Start: 0, End: 300}) {
+		t.Fatalf("expected ranges to merge into [0,300), got %+v", ranges)
+	}
+
+	ranges, added = mergeByteRanges(ranges, models.ByteRange{Start: 10, End: 20})
+	if added != 0 || len(ranges) != 1 {
+		t.Fatalf("expected covered range to add nothing, got added=%d ranges=%+v", added, ranges)
+	}
+	if got := uncoveredBytes(ranges, models.ByteRange{Start: 250, End: 400}); got != 100 {
+		t.Fatalf("expected 100 uncovered bytes, got %d", got)
+	}
+}
+
+func TestTransferByteRange_OpenEndedUsesFileSize(t *testing.T) {
+	t.Parallel()
+
+	got := transferByteRange(models.FileTransferRange{FileSizeBytes: 1000, Start: 400})
+	if got != (models.ByteRange{Start: 400, End: 1000}) {
+		t.Fatalf("expected [400,1000), got %+v", got)
+	}
+	got = transferByteRange(models.FileTransferRange{FileSizeBytes: 1000, Start: 0, End: 5000})
+	if got != (models.ByteRange{Start: 0, End: 1000}) {
+		t.Fatalf("expected range clamped to file size, got %+v", got)
+	}
+}
+
 func TestEstimateDownloadBilling_UnknownFilesizeReturnsZeroEstimate(t *testing.T) {
 	t.Parallel()
 
@@ -125,7 +203,7 @@ func TestEstimateDownloadBilling_UnknownFilesizeReturnsZeroEstimate(t *testing.T
 		repository.NewWelcomeCreditSettingsRepository(db),
 	)
 
-	estimate, pricing, err := svc.EstimateDownloadBilling(context.Background(), 0)
+	estimate, pricing, err := svc.EstimateDownloadBilling(context.Background(), 0, nil)
 	if err != nil {
 		t.Fatalf("estimate failed: %v", err)
 	}
@@ -148,3 +226,43 @@ func TestEstimateDownloadBilling_UnknownFilesizeReturnsZeroEstimate(t *testing.T
 		t.Fatalf("sql expectations not met: %v", err)
 	}
 }
+
+func TestEstimateDownloadBilling_ClipProratesByDuration(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("create sqlmock failed: %v", err)
+	}
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`SELECT id, version, ingress_price_yuan_per_gb, egress_price_yuan_per_gb`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "version", "ingress_price_yuan_per_gb", "egress_price_yuan_per_gb",
+			"enabled", "remark", "updated_by_user_id", "effective_at", "created_at",
+		}).AddRow(1, 7, "1.00", "1.00", true, "test-pricing", "system", now, now))
+
+	svc := NewBillingService(
+		repository.NewBillingRepository(db),
+		repository.NewWelcomeCreditSettingsRepository(db),
+	)
+
+	estimate, _, err := svc.EstimateDownloadBilling(context.Background(), 3_600_000, &models.DownloadClip{
+		DurationSeconds: 3600,
+		StartSeconds:    600,
+		EndSeconds:      900,
+	})
+	if err != nil {
+		t.Fatalf("estimate failed: %v", err)
+	}
+	if estimate.EstimatedIngressBytes != 300_000 || estimate.EstimatedTrafficBytes != 600_000 {
+		t.Fatalf("expected clip to be prorated to 300000 bytes, got %+v", estimate)
+	}
+	if estimate.EstimateReason != "clip_prorated" {
+		t.Fatalf("expected reason clip_prorated, got %s", estimate.EstimateReason)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("sql expectations not met: %v", err)
+	}
+}
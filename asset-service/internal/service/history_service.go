@@ -5,7 +5,6 @@ import (
 	"database/sql"
 	"errors"
 	"log"
-	"os"
 
 	"youdlp/asset-service/internal/models"
 	"youdlp/asset-service/internal/repository"
@@ -65,15 +64,12 @@ func (s *HistoryService) DeleteHistory(ctx context.Context, historyID int64, use
 		return err
 	}
 
-	// 2. 如果文件存在,尝试删除物理文件
-	if record.FilePath.Valid && record.FilePath.String != "" && record.Status == models.StatusCompleted {
-		if err := os.Remove(record.FilePath.String); err != nil && !os.IsNotExist(err) {
-			log.Printf("Warning: failed to delete file %s: %v", record.FilePath.String, err)
-		}
+	// 2. 删除数据库记录，存储中的文件由 media-service 异步删除
+	if err := s.historyRepo.Delete(ctx, record.ID, userID); err != nil {
+		return err
 	}
-
-	// 3. 删除数据库记录
-	return s.historyRepo.Delete(ctx, historyID, userID)
+	log.Printf("[HistoryService] Deleted history %d for user %s", record.ID, userID)
+	return nil
 }
 
 // GetFileInfo 获取文件信息(带权限验证)
@@ -92,18 +88,22 @@ func (s *HistoryService) GetFileInfo(ctx context.Context, historyID int64, userI
 		return nil, errors.New("download not completed")
 	}
 
-	// 3. 检查文件是否存在
+	// 3. 检查文件键，文件本身由网关通过存储后端读取
 	if !record.FilePath.Valid || record.FilePath.String == "" {
 		return nil, errors.New("file not found")
 	}
-	if _, err := os.Stat(record.FilePath.String); os.IsNotExist(err) {
-		return nil, errors.New("file not found")
+
+	// 4. 附加产物查询失败不影响主文件下载
+	attachments, err := s.historyRepo.ListAttachedFiles(ctx, record.TaskID)
+	if err != nil {
+		log.Printf("Warning: failed to list attached files for task %s: %v", record.TaskID, err)
 	}
 
 	return &models.FileInfo{
-		FilePath: record.FilePath.String,
-		FileName: record.FileName.String,
-		FileSize: record.FileSize.Int64,
-		FileHash: record.FileHash.String,
+		FilePath:    record.FilePath.String,
+		FileName:    record.FileName.String,
+		FileSize:    record.FileSize.Int64,
+		FileHash:    record.FileHash.String,
+		Attachments: attachments,
 	}, nil
 }
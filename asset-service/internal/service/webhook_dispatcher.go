@@ -0,0 +1,217 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"youdlp/asset-service/internal/models"
+)
+
+const maxWebhookResponseBodyBytes = 2048
+
+// errWebhookAddressBlocked 端点域名解析到本机或内网地址
+var errWebhookAddressBlocked = errors.New("webhook endpoint resolves to a blocked address")
+
+type webhookDeliveryStore interface {
+	ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*models.WebhookDelivery, error)
+	RecordAttempt(ctx context.Context, delivery *models.WebhookDelivery) error
+}
+
+// WebhookDispatcherOptions 投递参数
+type WebhookDispatcherOptions struct {
+	Interval    time.Duration
+	BatchSize   int
+	Timeout     time.Duration
+	MaxAttempts int
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+}
+
+// WebhookDispatcher 周期性领取到期投递并发送签名请求
+type WebhookDispatcher struct {
+	store  webhookDeliveryStore
+	client *http.Client
+	opts   WebhookDispatcherOptions
+	now    func() time.Time
+}
+
+// NewWebhookDispatcher 创建投递器
+func NewWebhookDispatcher(store webhookDeliveryStore, opts WebhookDispatcherOptions) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		store:  store,
+		client: newWebhookHTTPClient(opts.Timeout),
+		opts:   opts,
+		now:    time.Now,
+	}
+}
+
+// newWebhookHTTPClient 创建投递用的 HTTP 客户端。端点地址在注册时只能校验字面量，
+// 域名解析结果可能指向内网或在校验后被改写（DNS rebinding），因此每次建立连接时
+// 都在 Control 中检查实际连接的 IP，每个新建连接（包括重定向后的连接）都会经过该检查
+func newWebhookHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: webhookDialControl,
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// 不走环境代理，否则检查的是代理地址而不是端点地址
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: time.Second,
+		},
+		// 不跟随重定向，避免被引导到内网地址
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// webhookDialControl 在连接建立前检查解析后的目标 IP
+func webhookDialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || isBlockedWebhookIP(ip) {
+		return fmt.Errorf("%w: %s", errWebhookAddressBlocked, host)
+	}
+	return nil
+}
+
+func (d *WebhookDispatcher) Start(ctx context.Context) {
+	if d == nil || d.store == nil || d.opts.Interval <= 0 {
+		return
+	}
+
+	go d.loop(ctx)
+}
+
+func (d *WebhookDispatcher) loop(ctx context.Context) {
+	d.run(ctx)
+
+	ticker := time.NewTicker(d.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.run(ctx)
+		}
+	}
+}
+
+func (d *WebhookDispatcher) run(ctx context.Context) {
+	// 租约覆盖一轮内全部请求的最长耗时，进程中途退出时到期后可被重新领取
+	lease := d.opts.Timeout*time.Duration(d.opts.BatchSize) + d.opts.Interval
+	deliveries, err := d.store.ClaimDueDeliveries(ctx, d.opts.BatchSize, lease)
+	if err != nil {
+		log.Printf("[WebhookDispatcher] claim deliveries failed: %v", err)
+		return
+	}
+
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return
+		}
+		d.deliver(ctx, delivery)
+		if err := d.store.RecordAttempt(ctx, delivery); err != nil {
+			log.Printf("[WebhookDispatcher] record attempt failed: delivery=%d err=%v", delivery.ID, err)
+		}
+	}
+}
+
+// deliver 发送一次请求，并把结果写回 delivery
+func (d *WebhookDispatcher) deliver(ctx context.Context, delivery *models.WebhookDelivery) {
+	startedAt := d.now()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &startedAt
+	delivery.ResponseStatus = 0
+	delivery.ResponseBody = ""
+	delivery.LastError = ""
+
+	statusCode, body, err := d.post(ctx, delivery, startedAt)
+	delivery.DurationMS = d.now().Sub(startedAt).Milliseconds()
+	delivery.ResponseStatus = int32(statusCode)
+	delivery.ResponseBody = body
+
+	if err == nil && statusCode >= 200 && statusCode < 300 {
+		delivery.Status = models.WebhookDeliveryStatusSucceeded
+		delivery.NextAttemptAt = startedAt
+		return
+	}
+
+	if err != nil {
+		delivery.LastError = err.Error()
+	} else {
+		delivery.LastError = fmt.Sprintf("unexpected status %d", statusCode)
+	}
+
+	if int(delivery.Attempts) >= d.opts.MaxAttempts {
+		delivery.Status = models.WebhookDeliveryStatusFailed
+		delivery.NextAttemptAt = startedAt
+		log.Printf("[WebhookDispatcher] ❌ delivery %d to %s failed after %d attempts: %s", delivery.ID, delivery.EndpointURL, delivery.Attempts, delivery.LastError)
+		return
+	}
+
+	delivery.Status = models.WebhookDeliveryStatusPending
+	delivery.NextAttemptAt = startedAt.Add(webhookBackoff(delivery.Attempts, d.opts.BackoffBase, d.opts.BackoffMax))
+}
+
+func (d *WebhookDispatcher) post(ctx context.Context, delivery *models.WebhookDelivery, sentAt time.Time) (int, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.EndpointURL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, "", err
+	}
+	timestamp := sentAt.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "YouDLP-Webhooks/1.0")
+	req.Header.Set("X-YouDLP-Event", delivery.EventType)
+	req.Header.Set("X-YouDLP-Delivery", strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set("X-YouDLP-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Set("X-YouDLP-Signature", SignWebhookPayload(delivery.EndpointSecret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxWebhookResponseBodyBytes))
+	return resp.StatusCode, string(body), nil
+}
+
+// webhookBackoff 第 n 次失败后的等待时间：base·2^(n-1)，不超过 max
+func webhookBackoff(attempts int32, base, max time.Duration) time.Duration {
+	if base <= 0 {
+		return max
+	}
+	delay := base
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if max > 0 && delay >= max {
+			return max
+		}
+	}
+	if max > 0 && delay > max {
+		return max
+	}
+	return delay
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"youdlp/asset-service/internal/models"
+)
+
+type fakeWebhookDeliveryStore struct {
+	claimed  []*models.WebhookDelivery
+	recorded []*models.WebhookDelivery
+}
+
+func (s *fakeWebhookDeliveryStore) ClaimDueDeliveries(context.Context, int, time.Duration) ([]*models.WebhookDelivery, error) {
+	claimed := s.claimed
+	s.claimed = nil
+	return claimed, nil
+}
+
+func (s *fakeWebhookDeliveryStore) RecordAttempt(_ context.Context, delivery *models.WebhookDelivery) error {
+	copied := *delivery
+	s.recorded = append(s.recorded, &copied)
+	return nil
+}
+
+func TestWebhookBackoffDoublesUntilCap(t *testing.T) {
+	t.Parallel()
+
+	cases := map[int32]time.Duration{
+		1: 30 * time.Second,
+		2: time.Minute,
+		3: 2 * time.Minute,
+		8: time.Hour,
+	}
+	for attempts, want := range cases {
+		if got := webhookBackoff(attempts, 30*time.Second, time.Hour); got != want {
+			t.Fatalf("attempt %d: expected %s, got %s", attempts, want, got)
+		}
+	}
+}
+
+func TestWebhookDispatcherSignsAndMarksSucceeded(t *testing.T) {
+	t.Parallel()
+
+	payload := []byte(`{"id":"evt_1","type":"task.completed"}`)
+	var gotSignature, gotTimestamp, gotEvent string
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSignature = r.Header.Get("X-YouDLP-Signature")
+		gotTimestamp = r.Header.Get("X-YouDLP-Timestamp")
+		gotEvent = r.Header.Get("X-YouDLP-Event")
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	store := &fakeWebhookDeliveryStore{claimed: []*models.WebhookDelivery{{
+		ID:             7,
+		EventType:      models.WebhookEventTaskCompleted,
+		Payload:        payload,
+		Status:         models.WebhookDeliveryStatusPending,
+		EndpointURL:    server.URL,
+		EndpointSecret: "whsec_test",
+	}}}
+	dispatcher := NewWebhookDispatcher(store, WebhookDispatcherOptions{
+		Interval: time.Second, BatchSize: 10, Timeout: time.Second,
+		MaxAttempts: 3, BackoffBase: time.Second, BackoffMax: time.Minute,
+	})
+	// 测试服务监听在回环地址，绕过内网地址检查
+	dispatcher.client = server.Client()
+	dispatcher.run(context.Background())
+
+	if len(store.recorded) != 1 {
+		t.Fatalf("expected one recorded attempt, got %d", len(store.recorded))
+	}
+	recorded := store.recorded[0]
+	if recorded.Status != models.WebhookDeliveryStatusSucceeded || recorded.Attempts != 1 || recorded.ResponseStatus != http.StatusNoContent {
+		t.Fatalf("unexpected delivery state: %+v", recorded)
+	}
+	if gotEvent != models.WebhookEventTaskCompleted || string(gotBody) != string(payload) {
+		t.Fatalf("unexpected request: event=%s body=%s", gotEvent, gotBody)
+	}
+	timestamp, err := strconv.ParseInt(gotTimestamp, 10, 64)
+	if err != nil {
+		t.Fatalf("invalid timestamp header %q", gotTimestamp)
+	}
+	if want := SignWebhookPayload("whsec_test", timestamp, payload); gotSignature != want {
+		t.Fatalf("expected signature %s, got %s", want, gotSignature)
+	}
+}
+
+func TestWebhookDispatcherRetriesThenFails(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write([]byte("upstream down"))
+	}))
+	defer server.Close()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := &fakeWebhookDeliveryStore{}
+	dispatcher := NewWebhookDispatcher(store, WebhookDispatcherOptions{
+		Interval: time.Second, BatchSize: 10, Timeout: time.Second,
+		MaxAttempts: 2, BackoffBase: 30 * time.Second, BackoffMax: time.Hour,
+	})
+	dispatcher.now = func() time.Time { return now }
+	dispatcher.client = server.Client()
+
+	delivery := &models.WebhookDelivery{ID: 1, Payload: []byte(`{}`), EndpointURL: server.URL, EndpointSecret: "s"}
+	dispatcher.deliver(context.Background(), delivery)
+	if delivery.Status != models.WebhookDeliveryStatusPending || !delivery.NextAttemptAt.Equal(now.Add(30*time.Second)) {
+		t.Fatalf("expected retry in 30s, got status=%s next=%s", delivery.Status, delivery.NextAttemptAt)
+	}
+	if delivery.ResponseStatus != http.StatusBadGateway || delivery.ResponseBody != "upstream down" || delivery.LastError == "" {
+		t.Fatalf("expected response details to be recorded, got %+v", delivery)
+	}
+
+	dispatcher.deliver(context.Background(), delivery)
+	if delivery.Status != models.WebhookDeliveryStatusFailed || delivery.Attempts != 2 {
+		t.Fatalf("expected delivery to fail after max attempts, got status=%s attempts=%d", delivery.Status, delivery.Attempts)
+	}
+}
+
+func TestWebhookDispatcherRejectsHostnameResolvingToLoopback(t *testing.T) {
+	t.Parallel()
+
+	var hit atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit.Store(true)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// 端点使用域名而非 IP 字面量，解析结果为回环地址
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	endpointURL := "http://localhost:" + port + "/hook"
+	dispatcher := NewWebhookDispatcher(&fakeWebhookDeliveryStore{}, WebhookDispatcherOptions{
+		Interval: time.Second, BatchSize: 10, Timeout: time.Second,
+		MaxAttempts: 3, BackoffBase: time.Second, BackoffMax: time.Minute,
+	})
+
+	delivery := &models.WebhookDelivery{ID: 1, Payload: []byte(`{}`), EndpointURL: endpointURL, EndpointSecret: "s"}
+	dispatcher.deliver(context.Background(), delivery)
+
+	if hit.Load() {
+		t.Fatal("expected request to loopback address to be blocked")
+	}
+	if delivery.Status != models.WebhookDeliveryStatusPending || !strings.Contains(delivery.LastError, errWebhookAddressBlocked.Error()) {
+		t.Fatalf("expected blocked address error, got status=%s err=%q", delivery.Status, delivery.LastError)
+	}
+}
+
+func TestWebhookDialControlBlocksPrivateAddresses(t *testing.T) {
+	t.Parallel()
+
+	for _, address := range []string{"127.0.0.1:80", "10.0.0.8:443", "169.254.169.254:80", "[::1]:80", "100.64.1.1:80", "[::ffff:192.168.1.1]:80"} {
+		if err := webhookDialControl("tcp", address, nil); !errors.Is(err, errWebhookAddressBlocked) {
+			t.Fatalf("expected %s to be blocked, got %v", address, err)
+		}
+	}
+	if err := webhookDialControl("tcp", "93.184.216.34:443", nil); err != nil {
+		t.Fatalf("expected public address to be allowed, got %v", err)
+	}
+}
@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"youdlp/asset-service/internal/models"
+	"youdlp/asset-service/internal/money"
+	"youdlp/asset-service/internal/repository"
+)
+
+const (
+	// 单个归属（用户或全局）最多可注册的端点数
+	maxWebhookEndpointsPerOwner = 10
+	maxWebhookDescriptionLength = 255
+)
+
+var (
+	ErrInvalidWebhookURL       = errors.New("invalid webhook url")
+	ErrInvalidWebhookEvent     = errors.New("unsupported webhook event type")
+	ErrWebhookEndpointLimit    = errors.New("webhook endpoint limit reached")
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrInvalidWebhookEventData = errors.New("webhook event data must be a json object")
+)
+
+// WebhookService Webhook 订阅与事件入队服务
+type WebhookService struct {
+	repo                *repository.WebhookRepository
+	lowBalanceThreshold money.Decimal
+}
+
+// NewWebhookService 创建 Webhook 服务
+func NewWebhookService(repo *repository.WebhookRepository, lowBalanceThreshold money.Decimal) *WebhookService {
+	return &WebhookService{repo: repo, lowBalanceThreshold: lowBalanceThreshold}
+}
+
+// CreateEndpoint 创建端点；返回值中的 Secret 只在创建时下发
+func (s *WebhookService) CreateEndpoint(ctx context.Context, ownerID, rawURL string, events []string, description, createdBy string) (*models.WebhookEndpoint, error) {
+	endpointURL, err := validateWebhookURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	events, err = normalizeWebhookEvents(events)
+	if err != nil {
+		return nil, err
+	}
+	if len(description) > maxWebhookDescriptionLength {
+		description = description[:maxWebhookDescriptionLength]
+	}
+
+	existing, err := s.repo.ListEndpoints(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= maxWebhookEndpointsPerOwner {
+		return nil, ErrWebhookEndpointLimit
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := &models.WebhookEndpoint{
+		UserID:      ownerID,
+		URL:         endpointURL,
+		Secret:      secret,
+		Events:      events,
+		Description: description,
+		Enabled:     true,
+		CreatedBy:   createdBy,
+	}
+	if err := s.repo.CreateEndpoint(ctx, endpoint); err != nil {
+		return nil, err
+	}
+	return endpoint, nil
+}
+
+// ListEndpoints 列出端点
+func (s *WebhookService) ListEndpoints(ctx context.Context, ownerID string) ([]*models.WebhookEndpoint, error) {
+	return s.repo.ListEndpoints(ctx, ownerID)
+}
+
+// UpdateEndpoint 更新端点并返回最新状态
+func (s *WebhookService) UpdateEndpoint(ctx context.Context, id int64, ownerID string, update *models.WebhookEndpointUpdate) (*models.WebhookEndpoint, error) {
+	if update.URL != nil {
+		endpointURL, err := validateWebhookURL(*update.URL)
+		if err != nil {
+			return nil, err
+		}
+		update.URL = &endpointURL
+	}
+	if update.EventsSet {
+		events, err := normalizeWebhookEvents(update.Events)
+		if err != nil {
+			return nil, err
+		}
+		update.Events = events
+	}
+	if update.Description != nil && len(*update.Description) > maxWebhookDescriptionLength {
+		description := (*update.Description)[:maxWebhookDescriptionLength]
+		update.Description = &description
+	}
+
+	if err := s.repo.UpdateEndpoint(ctx, id, ownerID, update); err != nil {
+		return nil, mapWebhookNotFound(err)
+	}
+	endpoint, err := s.repo.GetEndpoint(ctx, id, ownerID)
+	if err != nil {
+		return nil, mapWebhookNotFound(err)
+	}
+	return endpoint, nil
+}
+
+// DeleteEndpoint 删除端点
+func (s *WebhookService) DeleteEndpoint(ctx context.Context, id int64, ownerID string) error {
+	return mapWebhookNotFound(s.repo.DeleteEndpoint(ctx, id, ownerID))
+}
+
+// ListDeliveries 查询投递记录
+func (s *WebhookService) ListDeliveries(ctx context.Context, filter *models.WebhookDeliveryFilter) (*models.WebhookDeliveryResult, error) {
+	return s.repo.ListDeliveries(ctx, filter)
+}
+
+// Redeliver 人工重投一条投递记录
+func (s *WebhookService) Redeliver(ctx context.Context, deliveryID int64, ownerID string) error {
+	return mapWebhookNotFound(s.repo.ResetDelivery(ctx, deliveryID, ownerID))
+}
+
+// Emit 在独立连接上为订阅端点生成投递记录，返回入队的投递数
+func (s *WebhookService) Emit(ctx context.Context, eventType, userID string, data json.RawMessage) (int, error) {
+	return s.enqueue(ctx, nil, eventType, userID, data)
+}
+
+// EnqueueTx 在调用方事务中生成投递记录，事务回滚时事件一并丢弃
+func (s *WebhookService) EnqueueTx(ctx context.Context, tx *sql.Tx, eventType, userID string, data json.RawMessage) error {
+	_, err := s.enqueue(ctx, tx, eventType, userID, data)
+	return err
+}
+
+// EnqueueLedgerTx 根据账务流水派生 billing.captured / balance.low 事件。
+// 入队失败只回滚到保存点并记录日志，不影响账务事务本身。
+func (s *WebhookService) EnqueueLedgerTx(ctx context.Context, tx *sql.Tx, entry *models.BillingLedgerEntry) error {
+	events := ledgerWebhookEvents(entry, s.lowBalanceThreshold)
+	if len(events) == 0 {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT webhook_enqueue"); err != nil {
+		return fmt.Errorf("create webhook savepoint failed: %w", err)
+	}
+	for _, event := range events {
+		if err := s.EnqueueTx(ctx, tx, event.Type, entry.UserID, event.Data); err != nil {
+			log.Printf("[WebhookService] ⚠ enqueue %s for ledger %s failed: %v", event.Type, entry.EntryNo, err)
+			if _, rbErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT webhook_enqueue"); rbErr != nil {
+				return fmt.Errorf("rollback webhook savepoint failed: %w", rbErr)
+			}
+			return nil
+		}
+	}
+	if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT webhook_enqueue"); err != nil {
+		return fmt.Errorf("release webhook savepoint failed: %w", err)
+	}
+	return nil
+}
+
+func (s *WebhookService) enqueue(ctx context.Context, tx *sql.Tx, eventType, userID string, data json.RawMessage) (int, error) {
+	if !models.IsWebhookEventType(eventType) {
+		return 0, ErrInvalidWebhookEvent
+	}
+	if len(data) == 0 {
+		data = json.RawMessage(`{}`)
+	}
+	var probe map[string]interface{}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return 0, ErrInvalidWebhookEventData
+	}
+
+	var endpoints []*models.WebhookEndpoint
+	var err error
+	if tx != nil {
+		endpoints, err = s.repo.FindSubscribedEndpoints(ctx, tx, userID, eventType)
+	} else {
+		endpoints, err = s.repo.FindSubscribedEndpoints(ctx, nil, userID, eventType)
+	}
+	if err != nil || len(endpoints) == 0 {
+		return 0, err
+	}
+
+	event := models.WebhookEvent{
+		ID:        newBillingID("evt"),
+		Type:      eventType,
+		UserID:    userID,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return 0, fmt.Errorf("marshal webhook event failed: %w", err)
+	}
+
+	for _, endpoint := range endpoints {
+		delivery := &models.WebhookDelivery{
+			EndpointID: endpoint.ID,
+			EventID:    event.ID,
+			EventType:  eventType,
+			UserID:     userID,
+			Payload:    payload,
+		}
+		if tx != nil {
+			err = s.repo.CreateDelivery(ctx, tx, delivery)
+		} else {
+			err = s.repo.CreateDelivery(ctx, nil, delivery)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(endpoints), nil
+}
+
+type webhookEventData struct {
+	Type string
+	Data json.RawMessage
+}
+
+// ledgerWebhookEvents 扣款流水产生 billing.captured；可用余额由阈值之上跌破阈值时产生 balance.low
+func ledgerWebhookEvents(entry *models.BillingLedgerEntry, lowBalanceThreshold money.Decimal) []webhookEventData {
+	if entry == nil || entry.UserID == "" {
+		return nil
+	}
+
+	var events []webhookEventData
+	if entry.EntryType == models.LedgerEntryTypeCapture {
+		data, _ := json.Marshal(map[string]interface{}{
+			"entry_no":               entry.EntryNo,
+			"order_no":               entry.OrderNo,
+			"task_id":                entry.TaskID,
+			"history_id":             entry.HistoryID,
+			"transfer_id":            entry.TransferID,
+			"scene":                  entry.Scene,
+			"amount_yuan":            entry.ActionAmountYuan.String(),
+			"available_balance_yuan": entry.BalanceAfterAvailableYuan.String(),
+			"reserved_balance_yuan":  entry.BalanceAfterReservedYuan.String(),
+		})
+		events = append(events, webhookEventData{Type: models.WebhookEventBillingCaptured, Data: data})
+	}
+
+	if entry.AvailableDeltaYuan.Cmp(money.Zero()) < 0 && !lowBalanceThreshold.IsZero() {
+		after := entry.BalanceAfterAvailableYuan
+		before := after.Sub(entry.AvailableDeltaYuan)
+		if before.Cmp(lowBalanceThreshold) >= 0 && after.Cmp(lowBalanceThreshold) < 0 {
+			data, _ := json.Marshal(map[string]interface{}{
+				"entry_no":               entry.EntryNo,
+				"task_id":                entry.TaskID,
+				"available_balance_yuan": after.String(),
+				"threshold_yuan":         lowBalanceThreshold.String(),
+			})
+			events = append(events, webhookEventData{Type: models.WebhookEventBalanceLow, Data: data})
+		}
+	}
+	return events
+}
+
+// SignWebhookPayload 计算签名：sha256=hex(HMAC-SHA256(secret, timestamp + "." + body))
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// validateWebhookURL 只允许 http(s)，并拒绝指向本机或内网字面量地址的端点；
+// 域名解析结果在投递建立连接时检查，见 newWebhookHTTPClient
+func validateWebhookURL(rawURL string) (string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" {
+		return "", ErrInvalidWebhookURL
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return "", ErrInvalidWebhookURL
+	}
+	if parsed.User != nil {
+		return "", ErrInvalidWebhookURL
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return "", ErrInvalidWebhookURL
+	}
+	if ip := net.ParseIP(host); ip != nil && isBlockedWebhookIP(ip) {
+		return "", ErrInvalidWebhookURL
+	}
+	parsed.Fragment = ""
+	return parsed.String(), nil
+}
+
+// isBlockedWebhookIP 本机、内网、链路本地（含云厂商元数据地址 169.254.169.254）与组播地址不允许投递
+func isBlockedWebhookIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast() ||
+		sharedAddressSpace.Contains(ip)
+}
+
+// sharedAddressSpace 运营商级 NAT 地址段（RFC 6598），同样不可从公网访问
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+func normalizeWebhookEvents(events []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(events))
+	normalized := make([]string, 0, len(events))
+	for _, event := range events {
+		event = strings.TrimSpace(event)
+		if event == "" {
+			continue
+		}
+		if !models.IsWebhookEventType(event) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidWebhookEvent, event)
+		}
+		if _, ok := seen[event]; ok {
+			continue
+		}
+		seen[event] = struct{}{}
+		normalized = append(normalized, event)
+	}
+	return normalized, nil
+}
+
+func newWebhookSecret() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate webhook secret failed: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
+
+func mapWebhookNotFound(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrWebhookNotFound
+	}
+	return err
+}
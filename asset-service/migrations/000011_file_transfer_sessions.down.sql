@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS file_transfer_segments;
+DROP TABLE IF EXISTS file_transfer_sessions;
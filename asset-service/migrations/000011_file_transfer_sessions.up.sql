@@ -0,0 +1,30 @@
+-- 续传会话：同一用户在窗口期内对同一文件的多次（分段）传输，按已交付字节区间的并集计费
+CREATE TABLE IF NOT EXISTS file_transfer_sessions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(64) NOT NULL,
+    history_id BIGINT NOT NULL,
+    file_id BIGINT NOT NULL DEFAULT 0,
+    file_size_bytes BIGINT NOT NULL,
+    delivered_ranges JSONB NOT NULL DEFAULT '[]',
+    billed_bytes BIGINT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_file_transfer_sessions_lookup
+    ON file_transfer_sessions(user_id, history_id, file_id, expires_at DESC);
+
+CREATE TABLE IF NOT EXISTS file_transfer_segments (
+    transfer_id VARCHAR(64) PRIMARY KEY,
+    session_id BIGINT NOT NULL REFERENCES file_transfer_sessions(id) ON DELETE CASCADE,
+    range_start BIGINT NOT NULL,
+    range_end BIGINT NOT NULL,
+    delivered_bytes BIGINT NOT NULL DEFAULT 0,
+    billed_bytes BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_file_transfer_segments_session_id
+    ON file_transfer_segments(session_id);
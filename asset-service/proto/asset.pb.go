@@ -2165,6 +2165,7 @@ type GetFileInfoResponse struct {
 	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
 	FileSize      int64                  `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
 	FileHash      string                 `protobuf:"bytes,4,opt,name=file_hash,json=fileHash,proto3" json:"file_hash,omitempty"`
+	Attachments   []*AttachedFile        `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"` // 附加产物（字幕等）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2227,6 +2228,98 @@ func (x *GetFileInfoResponse) GetFileHash() string {
 	return ""
 }
 
+func (x *GetFileInfoResponse) GetAttachments() []*AttachedFile {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// 任务附加产物
+type AttachedFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`         // subtitle
+	Language      string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"` // 字幕语言代码
+	FilePath      string                 `protobuf:"bytes,4,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	FileName      string                 `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileSize      int64                  `protobuf:"varint,6,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachedFile) Reset() {
+	*x = AttachedFile{}
+	mi := &file_proto_asset_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachedFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachedFile) ProtoMessage() {}
+
+func (x *AttachedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachedFile.ProtoReflect.Descriptor instead.
+func (*AttachedFile) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AttachedFile) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttachedFile) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AttachedFile) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *AttachedFile) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *AttachedFile) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *AttachedFile) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
 // 创建历史请求
 type CreateHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2240,13 +2333,15 @@ type CreateHistoryRequest struct {
 	Thumbnail     string                 `protobuf:"bytes,8,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
 	Duration      int64                  `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`
 	Author        string                 `protobuf:"bytes,10,opt,name=author,proto3" json:"author,omitempty"`
+	Priority      int32                  `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`                // 下载任务的消息优先级，用于计算排队位置
+	DedupKey      string                 `protobuf:"bytes,12,opt,name=dedup_key,json=dedupKey,proto3" json:"dedup_key,omitempty"` // 重复提交检测键，同一用户相同键的任务未结束时不再创建
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateHistoryRequest) Reset() {
 	*x = CreateHistoryRequest{}
-	mi := &file_proto_asset_proto_msgTypes[34]
+	mi := &file_proto_asset_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2258,7 +2353,7 @@ func (x *CreateHistoryRequest) String() string {
 func (*CreateHistoryRequest) ProtoMessage() {}
 
 func (x *CreateHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[34]
+	mi := &file_proto_asset_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2271,7 +2366,7 @@ func (x *CreateHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateHistoryRequest.ProtoReflect.Descriptor instead.
 func (*CreateHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{34}
+	return file_proto_asset_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CreateHistoryRequest) GetUserId() string {
@@ -2344,16 +2439,32 @@ func (x *CreateHistoryRequest) GetAuthor() string {
 	return ""
 }
 
+func (x *CreateHistoryRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreateHistoryRequest) GetDedupKey() string {
+	if x != nil {
+		return x.DedupKey
+	}
+	return ""
+}
+
 type CreateHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HistoryId     int64                  `protobuf:"varint,1,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"`
+	Duplicate     bool                   `protobuf:"varint,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"` // 已有相同 dedup_key 的未结束任务，history_id 与 task_id 为该任务
+	TaskId        string                 `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateHistoryResponse) Reset() {
 	*x = CreateHistoryResponse{}
-	mi := &file_proto_asset_proto_msgTypes[35]
+	mi := &file_proto_asset_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2365,7 +2476,7 @@ func (x *CreateHistoryResponse) String() string {
 func (*CreateHistoryResponse) ProtoMessage() {}
 
 func (x *CreateHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[35]
+	mi := &file_proto_asset_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2378,7 +2489,7 @@ func (x *CreateHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateHistoryResponse.ProtoReflect.Descriptor instead.
 func (*CreateHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{35}
+	return file_proto_asset_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CreateHistoryResponse) GetHistoryId() int64 {
@@ -2388,6 +2499,20 @@ func (x *CreateHistoryResponse) GetHistoryId() int64 {
 	return 0
 }
 
+func (x *CreateHistoryResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *CreateHistoryResponse) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
 type UpdateHistoryStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -2403,7 +2528,7 @@ type UpdateHistoryStatusRequest struct {
 
 func (x *UpdateHistoryStatusRequest) Reset() {
 	*x = UpdateHistoryStatusRequest{}
-	mi := &file_proto_asset_proto_msgTypes[36]
+	mi := &file_proto_asset_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2415,7 +2540,7 @@ func (x *UpdateHistoryStatusRequest) String() string {
 func (*UpdateHistoryStatusRequest) ProtoMessage() {}
 
 func (x *UpdateHistoryStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[36]
+	mi := &file_proto_asset_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2428,7 +2553,7 @@ func (x *UpdateHistoryStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateHistoryStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateHistoryStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{36}
+	return file_proto_asset_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *UpdateHistoryStatusRequest) GetTaskId() string {
@@ -2489,7 +2614,7 @@ type UpdateHistoryStatusResponse struct {
 
 func (x *UpdateHistoryStatusResponse) Reset() {
 	*x = UpdateHistoryStatusResponse{}
-	mi := &file_proto_asset_proto_msgTypes[37]
+	mi := &file_proto_asset_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2501,7 +2626,7 @@ func (x *UpdateHistoryStatusResponse) String() string {
 func (*UpdateHistoryStatusResponse) ProtoMessage() {}
 
 func (x *UpdateHistoryStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[37]
+	mi := &file_proto_asset_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2514,7 +2639,7 @@ func (x *UpdateHistoryStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateHistoryStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdateHistoryStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{37}
+	return file_proto_asset_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *UpdateHistoryStatusResponse) GetSuccess() bool {
@@ -2543,7 +2668,7 @@ type BillingAccountSnapshot struct {
 
 func (x *BillingAccountSnapshot) Reset() {
 	*x = BillingAccountSnapshot{}
-	mi := &file_proto_asset_proto_msgTypes[38]
+	mi := &file_proto_asset_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2555,7 +2680,7 @@ func (x *BillingAccountSnapshot) String() string {
 func (*BillingAccountSnapshot) ProtoMessage() {}
 
 func (x *BillingAccountSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[38]
+	mi := &file_proto_asset_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2568,7 +2693,7 @@ func (x *BillingAccountSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BillingAccountSnapshot.ProtoReflect.Descriptor instead.
 func (*BillingAccountSnapshot) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{38}
+	return file_proto_asset_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *BillingAccountSnapshot) GetUserId() string {
@@ -2658,7 +2783,7 @@ type GetBillingAccountRequest struct {
 
 func (x *GetBillingAccountRequest) Reset() {
 	*x = GetBillingAccountRequest{}
-	mi := &file_proto_asset_proto_msgTypes[39]
+	mi := &file_proto_asset_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2670,7 +2795,7 @@ func (x *GetBillingAccountRequest) String() string {
 func (*GetBillingAccountRequest) ProtoMessage() {}
 
 func (x *GetBillingAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[39]
+	mi := &file_proto_asset_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2683,7 +2808,7 @@ func (x *GetBillingAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBillingAccountRequest.ProtoReflect.Descriptor instead.
 func (*GetBillingAccountRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{39}
+	return file_proto_asset_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetBillingAccountRequest) GetUserId() string {
@@ -2709,7 +2834,7 @@ type GetBillingAccountResponse struct {
 
 func (x *GetBillingAccountResponse) Reset() {
 	*x = GetBillingAccountResponse{}
-	mi := &file_proto_asset_proto_msgTypes[40]
+	mi := &file_proto_asset_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2721,7 +2846,7 @@ func (x *GetBillingAccountResponse) String() string {
 func (*GetBillingAccountResponse) ProtoMessage() {}
 
 func (x *GetBillingAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[40]
+	mi := &file_proto_asset_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2734,7 +2859,7 @@ func (x *GetBillingAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBillingAccountResponse.ProtoReflect.Descriptor instead.
 func (*GetBillingAccountResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{40}
+	return file_proto_asset_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetBillingAccountResponse) GetAccount() *BillingAccountSnapshot {
@@ -2760,7 +2885,7 @@ type BillingStatementItem struct {
 
 func (x *BillingStatementItem) Reset() {
 	*x = BillingStatementItem{}
-	mi := &file_proto_asset_proto_msgTypes[41]
+	mi := &file_proto_asset_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2772,7 +2897,7 @@ func (x *BillingStatementItem) String() string {
 func (*BillingStatementItem) ProtoMessage() {}
 
 func (x *BillingStatementItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[41]
+	mi := &file_proto_asset_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2785,7 +2910,7 @@ func (x *BillingStatementItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BillingStatementItem.ProtoReflect.Descriptor instead.
 func (*BillingStatementItem) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{41}
+	return file_proto_asset_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *BillingStatementItem) GetStatementId() string {
@@ -2857,7 +2982,7 @@ type ListBillingStatementsRequest struct {
 
 func (x *ListBillingStatementsRequest) Reset() {
 	*x = ListBillingStatementsRequest{}
-	mi := &file_proto_asset_proto_msgTypes[42]
+	mi := &file_proto_asset_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2869,7 +2994,7 @@ func (x *ListBillingStatementsRequest) String() string {
 func (*ListBillingStatementsRequest) ProtoMessage() {}
 
 func (x *ListBillingStatementsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[42]
+	mi := &file_proto_asset_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2882,7 +3007,7 @@ func (x *ListBillingStatementsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBillingStatementsRequest.ProtoReflect.Descriptor instead.
 func (*ListBillingStatementsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{42}
+	return file_proto_asset_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ListBillingStatementsRequest) GetUserId() string {
@@ -2932,7 +3057,7 @@ type ListBillingStatementsResponse struct {
 
 func (x *ListBillingStatementsResponse) Reset() {
 	*x = ListBillingStatementsResponse{}
-	mi := &file_proto_asset_proto_msgTypes[43]
+	mi := &file_proto_asset_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2944,7 +3069,7 @@ func (x *ListBillingStatementsResponse) String() string {
 func (*ListBillingStatementsResponse) ProtoMessage() {}
 
 func (x *ListBillingStatementsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[43]
+	mi := &file_proto_asset_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2957,7 +3082,7 @@ func (x *ListBillingStatementsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBillingStatementsResponse.ProtoReflect.Descriptor instead.
 func (*ListBillingStatementsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{43}
+	return file_proto_asset_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ListBillingStatementsResponse) GetTotal() int64 {
@@ -3008,7 +3133,7 @@ type BillingSelectedFormat struct {
 
 func (x *BillingSelectedFormat) Reset() {
 	*x = BillingSelectedFormat{}
-	mi := &file_proto_asset_proto_msgTypes[44]
+	mi := &file_proto_asset_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3020,7 +3145,7 @@ func (x *BillingSelectedFormat) String() string {
 func (*BillingSelectedFormat) ProtoMessage() {}
 
 func (x *BillingSelectedFormat) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[44]
+	mi := &file_proto_asset_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3033,7 +3158,7 @@ func (x *BillingSelectedFormat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BillingSelectedFormat.ProtoReflect.Descriptor instead.
 func (*BillingSelectedFormat) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{44}
+	return file_proto_asset_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *BillingSelectedFormat) GetFormatId() string {
@@ -3121,19 +3246,22 @@ func (x *BillingSelectedFormat) GetAsr() int32 {
 }
 
 type EstimateDownloadBillingRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Url            string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Platform       string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
-	Mode           string                 `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
-	SelectedFormat *BillingSelectedFormat `protobuf:"bytes,5,opt,name=selected_format,json=selectedFormat,proto3" json:"selected_format,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Url              string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Platform         string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	Mode             string                 `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	SelectedFormat   *BillingSelectedFormat `protobuf:"bytes,5,opt,name=selected_format,json=selectedFormat,proto3" json:"selected_format,omitempty"`
+	DurationSeconds  int64                  `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`       // 视频总时长，用于按片段比例折算
+	ClipStartSeconds float64                `protobuf:"fixed64,7,opt,name=clip_start_seconds,json=clipStartSeconds,proto3" json:"clip_start_seconds,omitempty"` // 片段起点，0 表示从头
+	ClipEndSeconds   float64                `protobuf:"fixed64,8,opt,name=clip_end_seconds,json=clipEndSeconds,proto3" json:"clip_end_seconds,omitempty"`       // 片段终点，0 表示到结尾
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *EstimateDownloadBillingRequest) Reset() {
 	*x = EstimateDownloadBillingRequest{}
-	mi := &file_proto_asset_proto_msgTypes[45]
+	mi := &file_proto_asset_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3145,7 +3273,7 @@ func (x *EstimateDownloadBillingRequest) String() string {
 func (*EstimateDownloadBillingRequest) ProtoMessage() {}
 
 func (x *EstimateDownloadBillingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[45]
+	mi := &file_proto_asset_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3158,7 +3286,7 @@ func (x *EstimateDownloadBillingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EstimateDownloadBillingRequest.ProtoReflect.Descriptor instead.
 func (*EstimateDownloadBillingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{45}
+	return file_proto_asset_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *EstimateDownloadBillingRequest) GetUserId() string {
@@ -3196,6 +3324,27 @@ func (x *EstimateDownloadBillingRequest) GetSelectedFormat() *BillingSelectedFor
 	return nil
 }
 
+func (x *EstimateDownloadBillingRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *EstimateDownloadBillingRequest) GetClipStartSeconds() float64 {
+	if x != nil {
+		return x.ClipStartSeconds
+	}
+	return 0
+}
+
+func (x *EstimateDownloadBillingRequest) GetClipEndSeconds() float64 {
+	if x != nil {
+		return x.ClipEndSeconds
+	}
+	return 0
+}
+
 type EstimateDownloadBillingResponse struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	EstimatedIngressBytes int64                  `protobuf:"varint,1,opt,name=estimated_ingress_bytes,json=estimatedIngressBytes,proto3" json:"estimated_ingress_bytes,omitempty"`
@@ -3211,7 +3360,7 @@ type EstimateDownloadBillingResponse struct {
 
 func (x *EstimateDownloadBillingResponse) Reset() {
 	*x = EstimateDownloadBillingResponse{}
-	mi := &file_proto_asset_proto_msgTypes[46]
+	mi := &file_proto_asset_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3223,7 +3372,7 @@ func (x *EstimateDownloadBillingResponse) String() string {
 func (*EstimateDownloadBillingResponse) ProtoMessage() {}
 
 func (x *EstimateDownloadBillingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[46]
+	mi := &file_proto_asset_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3236,7 +3385,7 @@ func (x *EstimateDownloadBillingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EstimateDownloadBillingResponse.ProtoReflect.Descriptor instead.
 func (*EstimateDownloadBillingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{46}
+	return file_proto_asset_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *EstimateDownloadBillingResponse) GetEstimatedIngressBytes() int64 {
@@ -3304,7 +3453,7 @@ type HoldInitialDownloadRequest struct {
 
 func (x *HoldInitialDownloadRequest) Reset() {
 	*x = HoldInitialDownloadRequest{}
-	mi := &file_proto_asset_proto_msgTypes[47]
+	mi := &file_proto_asset_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3316,7 +3465,7 @@ func (x *HoldInitialDownloadRequest) String() string {
 func (*HoldInitialDownloadRequest) ProtoMessage() {}
 
 func (x *HoldInitialDownloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[47]
+	mi := &file_proto_asset_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3329,7 +3478,7 @@ func (x *HoldInitialDownloadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HoldInitialDownloadRequest.ProtoReflect.Descriptor instead.
 func (*HoldInitialDownloadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{47}
+	return file_proto_asset_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *HoldInitialDownloadRequest) GetUserId() string {
@@ -3401,7 +3550,7 @@ type HoldInitialDownloadResponse struct {
 
 func (x *HoldInitialDownloadResponse) Reset() {
 	*x = HoldInitialDownloadResponse{}
-	mi := &file_proto_asset_proto_msgTypes[48]
+	mi := &file_proto_asset_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3413,7 +3562,7 @@ func (x *HoldInitialDownloadResponse) String() string {
 func (*HoldInitialDownloadResponse) ProtoMessage() {}
 
 func (x *HoldInitialDownloadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[48]
+	mi := &file_proto_asset_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3426,7 +3575,7 @@ func (x *HoldInitialDownloadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HoldInitialDownloadResponse.ProtoReflect.Descriptor instead.
 func (*HoldInitialDownloadResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{48}
+	return file_proto_asset_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *HoldInitialDownloadResponse) GetOrderNo() string {
@@ -3474,7 +3623,7 @@ type CaptureIngressUsageRequest struct {
 
 func (x *CaptureIngressUsageRequest) Reset() {
 	*x = CaptureIngressUsageRequest{}
-	mi := &file_proto_asset_proto_msgTypes[49]
+	mi := &file_proto_asset_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3486,7 +3635,7 @@ func (x *CaptureIngressUsageRequest) String() string {
 func (*CaptureIngressUsageRequest) ProtoMessage() {}
 
 func (x *CaptureIngressUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[49]
+	mi := &file_proto_asset_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3499,7 +3648,7 @@ func (x *CaptureIngressUsageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CaptureIngressUsageRequest.ProtoReflect.Descriptor instead.
 func (*CaptureIngressUsageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{49}
+	return file_proto_asset_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *CaptureIngressUsageRequest) GetTaskId() string {
@@ -3530,7 +3679,7 @@ type CaptureIngressUsageResponse struct {
 
 func (x *CaptureIngressUsageResponse) Reset() {
 	*x = CaptureIngressUsageResponse{}
-	mi := &file_proto_asset_proto_msgTypes[50]
+	mi := &file_proto_asset_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3542,7 +3691,7 @@ func (x *CaptureIngressUsageResponse) String() string {
 func (*CaptureIngressUsageResponse) ProtoMessage() {}
 
 func (x *CaptureIngressUsageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[50]
+	mi := &file_proto_asset_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3555,7 +3704,7 @@ func (x *CaptureIngressUsageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CaptureIngressUsageResponse.ProtoReflect.Descriptor instead.
 func (*CaptureIngressUsageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{50}
+	return file_proto_asset_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *CaptureIngressUsageResponse) GetOrderNo() string {
@@ -3610,7 +3759,7 @@ type ReleaseInitialDownloadRequest struct {
 
 func (x *ReleaseInitialDownloadRequest) Reset() {
 	*x = ReleaseInitialDownloadRequest{}
-	mi := &file_proto_asset_proto_msgTypes[51]
+	mi := &file_proto_asset_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3622,7 +3771,7 @@ func (x *ReleaseInitialDownloadRequest) String() string {
 func (*ReleaseInitialDownloadRequest) ProtoMessage() {}
 
 func (x *ReleaseInitialDownloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[51]
+	mi := &file_proto_asset_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3635,7 +3784,7 @@ func (x *ReleaseInitialDownloadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseInitialDownloadRequest.ProtoReflect.Descriptor instead.
 func (*ReleaseInitialDownloadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{51}
+	return file_proto_asset_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ReleaseInitialDownloadRequest) GetTaskId() string {
@@ -3663,7 +3812,7 @@ type ReleaseInitialDownloadResponse struct {
 
 func (x *ReleaseInitialDownloadResponse) Reset() {
 	*x = ReleaseInitialDownloadResponse{}
-	mi := &file_proto_asset_proto_msgTypes[52]
+	mi := &file_proto_asset_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3675,7 +3824,7 @@ func (x *ReleaseInitialDownloadResponse) String() string {
 func (*ReleaseInitialDownloadResponse) ProtoMessage() {}
 
 func (x *ReleaseInitialDownloadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[52]
+	mi := &file_proto_asset_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3688,7 +3837,7 @@ func (x *ReleaseInitialDownloadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseInitialDownloadResponse.ProtoReflect.Descriptor instead.
 func (*ReleaseInitialDownloadResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{52}
+	return file_proto_asset_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ReleaseInitialDownloadResponse) GetSuccess() bool {
@@ -3717,13 +3866,16 @@ type PrepareFileTransferBillingRequest struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	HistoryId     int64                  `protobuf:"varint,2,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"`
 	FileSizeBytes int64                  `protobuf:"varint,3,opt,name=file_size_bytes,json=fileSizeBytes,proto3" json:"file_size_bytes,omitempty"`
+	FileId        int64                  `protobuf:"varint,4,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`             // 0 表示主文件，否则为附加产物 ID
+	RangeStart    int64                  `protobuf:"varint,5,opt,name=range_start,json=rangeStart,proto3" json:"range_start,omitempty"` // 本次传输的起始偏移
+	RangeEnd      int64                  `protobuf:"varint,6,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`       // 结束偏移（不含），0 表示到文件末尾
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PrepareFileTransferBillingRequest) Reset() {
 	*x = PrepareFileTransferBillingRequest{}
-	mi := &file_proto_asset_proto_msgTypes[53]
+	mi := &file_proto_asset_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3735,7 +3887,7 @@ func (x *PrepareFileTransferBillingRequest) String() string {
 func (*PrepareFileTransferBillingRequest) ProtoMessage() {}
 
 func (x *PrepareFileTransferBillingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[53]
+	mi := &file_proto_asset_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3748,7 +3900,7 @@ func (x *PrepareFileTransferBillingRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use PrepareFileTransferBillingRequest.ProtoReflect.Descriptor instead.
 func (*PrepareFileTransferBillingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{53}
+	return file_proto_asset_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *PrepareFileTransferBillingRequest) GetUserId() string {
@@ -3772,6 +3924,27 @@ func (x *PrepareFileTransferBillingRequest) GetFileSizeBytes() int64 {
 	return 0
 }
 
+func (x *PrepareFileTransferBillingRequest) GetFileId() int64 {
+	if x != nil {
+		return x.FileId
+	}
+	return 0
+}
+
+func (x *PrepareFileTransferBillingRequest) GetRangeStart() int64 {
+	if x != nil {
+		return x.RangeStart
+	}
+	return 0
+}
+
+func (x *PrepareFileTransferBillingRequest) GetRangeEnd() int64 {
+	if x != nil {
+		return x.RangeEnd
+	}
+	return 0
+}
+
 type PrepareFileTransferBillingResponse struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	TransferId           string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
@@ -3788,7 +3961,7 @@ type PrepareFileTransferBillingResponse struct {
 
 func (x *PrepareFileTransferBillingResponse) Reset() {
 	*x = PrepareFileTransferBillingResponse{}
-	mi := &file_proto_asset_proto_msgTypes[54]
+	mi := &file_proto_asset_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3800,7 +3973,7 @@ func (x *PrepareFileTransferBillingResponse) String() string {
 func (*PrepareFileTransferBillingResponse) ProtoMessage() {}
 
 func (x *PrepareFileTransferBillingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[54]
+	mi := &file_proto_asset_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3813,7 +3986,7 @@ func (x *PrepareFileTransferBillingResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use PrepareFileTransferBillingResponse.ProtoReflect.Descriptor instead.
 func (*PrepareFileTransferBillingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{54}
+	return file_proto_asset_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *PrepareFileTransferBillingResponse) GetTransferId() string {
@@ -3882,7 +4055,7 @@ type CompleteFileTransferBillingRequest struct {
 
 func (x *CompleteFileTransferBillingRequest) Reset() {
 	*x = CompleteFileTransferBillingRequest{}
-	mi := &file_proto_asset_proto_msgTypes[55]
+	mi := &file_proto_asset_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3894,7 +4067,7 @@ func (x *CompleteFileTransferBillingRequest) String() string {
 func (*CompleteFileTransferBillingRequest) ProtoMessage() {}
 
 func (x *CompleteFileTransferBillingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[55]
+	mi := &file_proto_asset_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3907,7 +4080,7 @@ func (x *CompleteFileTransferBillingRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CompleteFileTransferBillingRequest.ProtoReflect.Descriptor instead.
 func (*CompleteFileTransferBillingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{55}
+	return file_proto_asset_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *CompleteFileTransferBillingRequest) GetTransferId() string {
@@ -3937,7 +4110,7 @@ type CompleteFileTransferBillingResponse struct {
 
 func (x *CompleteFileTransferBillingResponse) Reset() {
 	*x = CompleteFileTransferBillingResponse{}
-	mi := &file_proto_asset_proto_msgTypes[56]
+	mi := &file_proto_asset_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3949,7 +4122,7 @@ func (x *CompleteFileTransferBillingResponse) String() string {
 func (*CompleteFileTransferBillingResponse) ProtoMessage() {}
 
 func (x *CompleteFileTransferBillingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[56]
+	mi := &file_proto_asset_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3962,7 +4135,7 @@ func (x *CompleteFileTransferBillingResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use CompleteFileTransferBillingResponse.ProtoReflect.Descriptor instead.
 func (*CompleteFileTransferBillingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{56}
+	return file_proto_asset_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *CompleteFileTransferBillingResponse) GetOrderNo() string {
@@ -4010,7 +4183,7 @@ type AbortFileTransferBillingRequest struct {
 
 func (x *AbortFileTransferBillingRequest) Reset() {
 	*x = AbortFileTransferBillingRequest{}
-	mi := &file_proto_asset_proto_msgTypes[57]
+	mi := &file_proto_asset_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4022,7 +4195,7 @@ func (x *AbortFileTransferBillingRequest) String() string {
 func (*AbortFileTransferBillingRequest) ProtoMessage() {}
 
 func (x *AbortFileTransferBillingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[57]
+	mi := &file_proto_asset_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4035,7 +4208,7 @@ func (x *AbortFileTransferBillingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbortFileTransferBillingRequest.ProtoReflect.Descriptor instead.
 func (*AbortFileTransferBillingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{57}
+	return file_proto_asset_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *AbortFileTransferBillingRequest) GetTransferId() string {
@@ -4063,7 +4236,7 @@ type AbortFileTransferBillingResponse struct {
 
 func (x *AbortFileTransferBillingResponse) Reset() {
 	*x = AbortFileTransferBillingResponse{}
-	mi := &file_proto_asset_proto_msgTypes[58]
+	mi := &file_proto_asset_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4075,7 +4248,7 @@ func (x *AbortFileTransferBillingResponse) String() string {
 func (*AbortFileTransferBillingResponse) ProtoMessage() {}
 
 func (x *AbortFileTransferBillingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[58]
+	mi := &file_proto_asset_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4088,7 +4261,7 @@ func (x *AbortFileTransferBillingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbortFileTransferBillingResponse.ProtoReflect.Descriptor instead.
 func (*AbortFileTransferBillingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{58}
+	return file_proto_asset_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *AbortFileTransferBillingResponse) GetSuccess() bool {
@@ -4124,7 +4297,7 @@ type ListBillingAccountsRequest struct {
 
 func (x *ListBillingAccountsRequest) Reset() {
 	*x = ListBillingAccountsRequest{}
-	mi := &file_proto_asset_proto_msgTypes[59]
+	mi := &file_proto_asset_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4136,7 +4309,7 @@ func (x *ListBillingAccountsRequest) String() string {
 func (*ListBillingAccountsRequest) ProtoMessage() {}
 
 func (x *ListBillingAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[59]
+	mi := &file_proto_asset_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4149,7 +4322,7 @@ func (x *ListBillingAccountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBillingAccountsRequest.ProtoReflect.Descriptor instead.
 func (*ListBillingAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{59}
+	return file_proto_asset_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *ListBillingAccountsRequest) GetUserIds() []string {
@@ -4192,7 +4365,7 @@ type ListBillingAccountsResponse struct {
 
 func (x *ListBillingAccountsResponse) Reset() {
 	*x = ListBillingAccountsResponse{}
-	mi := &file_proto_asset_proto_msgTypes[60]
+	mi := &file_proto_asset_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4204,7 +4377,7 @@ func (x *ListBillingAccountsResponse) String() string {
 func (*ListBillingAccountsResponse) ProtoMessage() {}
 
 func (x *ListBillingAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[60]
+	mi := &file_proto_asset_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4217,7 +4390,7 @@ func (x *ListBillingAccountsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBillingAccountsResponse.ProtoReflect.Descriptor instead.
 func (*ListBillingAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{60}
+	return file_proto_asset_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *ListBillingAccountsResponse) GetTotal() int64 {
@@ -4257,7 +4430,7 @@ type GetBillingAccountDetailRequest struct {
 
 func (x *GetBillingAccountDetailRequest) Reset() {
 	*x = GetBillingAccountDetailRequest{}
-	mi := &file_proto_asset_proto_msgTypes[61]
+	mi := &file_proto_asset_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4269,7 +4442,7 @@ func (x *GetBillingAccountDetailRequest) String() string {
 func (*GetBillingAccountDetailRequest) ProtoMessage() {}
 
 func (x *GetBillingAccountDetailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[61]
+	mi := &file_proto_asset_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4282,7 +4455,7 @@ func (x *GetBillingAccountDetailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBillingAccountDetailRequest.ProtoReflect.Descriptor instead.
 func (*GetBillingAccountDetailRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{61}
+	return file_proto_asset_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *GetBillingAccountDetailRequest) GetUserId() string {
@@ -4301,7 +4474,7 @@ type GetBillingAccountDetailResponse struct {
 
 func (x *GetBillingAccountDetailResponse) Reset() {
 	*x = GetBillingAccountDetailResponse{}
-	mi := &file_proto_asset_proto_msgTypes[62]
+	mi := &file_proto_asset_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4313,7 +4486,7 @@ func (x *GetBillingAccountDetailResponse) String() string {
 func (*GetBillingAccountDetailResponse) ProtoMessage() {}
 
 func (x *GetBillingAccountDetailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[62]
+	mi := &file_proto_asset_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4326,7 +4499,7 @@ func (x *GetBillingAccountDetailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBillingAccountDetailResponse.ProtoReflect.Descriptor instead.
 func (*GetBillingAccountDetailResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{62}
+	return file_proto_asset_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *GetBillingAccountDetailResponse) GetAccount() *BillingAccountSnapshot {
@@ -4349,7 +4522,7 @@ type AdjustBillingBalanceRequest struct {
 
 func (x *AdjustBillingBalanceRequest) Reset() {
 	*x = AdjustBillingBalanceRequest{}
-	mi := &file_proto_asset_proto_msgTypes[63]
+	mi := &file_proto_asset_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4361,7 +4534,7 @@ func (x *AdjustBillingBalanceRequest) String() string {
 func (*AdjustBillingBalanceRequest) ProtoMessage() {}
 
 func (x *AdjustBillingBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[63]
+	mi := &file_proto_asset_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4374,7 +4547,7 @@ func (x *AdjustBillingBalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdjustBillingBalanceRequest.ProtoReflect.Descriptor instead.
 func (*AdjustBillingBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{63}
+	return file_proto_asset_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *AdjustBillingBalanceRequest) GetUserId() string {
@@ -4423,7 +4596,7 @@ type AdjustBillingBalanceResponse struct {
 
 func (x *AdjustBillingBalanceResponse) Reset() {
 	*x = AdjustBillingBalanceResponse{}
-	mi := &file_proto_asset_proto_msgTypes[64]
+	mi := &file_proto_asset_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4435,7 +4608,7 @@ func (x *AdjustBillingBalanceResponse) String() string {
 func (*AdjustBillingBalanceResponse) ProtoMessage() {}
 
 func (x *AdjustBillingBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[64]
+	mi := &file_proto_asset_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4448,7 +4621,7 @@ func (x *AdjustBillingBalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdjustBillingBalanceResponse.ProtoReflect.Descriptor instead.
 func (*AdjustBillingBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{64}
+	return file_proto_asset_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *AdjustBillingBalanceResponse) GetSuccess() bool {
@@ -4498,7 +4671,7 @@ type LedgerEntryItem struct {
 
 func (x *LedgerEntryItem) Reset() {
 	*x = LedgerEntryItem{}
-	mi := &file_proto_asset_proto_msgTypes[65]
+	mi := &file_proto_asset_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4510,7 +4683,7 @@ func (x *LedgerEntryItem) String() string {
 func (*LedgerEntryItem) ProtoMessage() {}
 
 func (x *LedgerEntryItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[65]
+	mi := &file_proto_asset_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4523,7 +4696,7 @@ func (x *LedgerEntryItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LedgerEntryItem.ProtoReflect.Descriptor instead.
 func (*LedgerEntryItem) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{65}
+	return file_proto_asset_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *LedgerEntryItem) GetEntryNo() string {
@@ -4664,7 +4837,7 @@ type ListBillingLedgerRequest struct {
 
 func (x *ListBillingLedgerRequest) Reset() {
 	*x = ListBillingLedgerRequest{}
-	mi := &file_proto_asset_proto_msgTypes[66]
+	mi := &file_proto_asset_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4676,7 +4849,7 @@ func (x *ListBillingLedgerRequest) String() string {
 func (*ListBillingLedgerRequest) ProtoMessage() {}
 
 func (x *ListBillingLedgerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[66]
+	mi := &file_proto_asset_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4689,7 +4862,7 @@ func (x *ListBillingLedgerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBillingLedgerRequest.ProtoReflect.Descriptor instead.
 func (*ListBillingLedgerRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{66}
+	return file_proto_asset_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *ListBillingLedgerRequest) GetUserId() string {
@@ -4732,7 +4905,7 @@ type ListBillingLedgerResponse struct {
 
 func (x *ListBillingLedgerResponse) Reset() {
 	*x = ListBillingLedgerResponse{}
-	mi := &file_proto_asset_proto_msgTypes[67]
+	mi := &file_proto_asset_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4744,7 +4917,7 @@ func (x *ListBillingLedgerResponse) String() string {
 func (*ListBillingLedgerResponse) ProtoMessage() {}
 
 func (x *ListBillingLedgerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[67]
+	mi := &file_proto_asset_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4757,7 +4930,7 @@ func (x *ListBillingLedgerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBillingLedgerResponse.ProtoReflect.Descriptor instead.
 func (*ListBillingLedgerResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{67}
+	return file_proto_asset_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *ListBillingLedgerResponse) GetTotal() int64 {
@@ -4811,7 +4984,7 @@ type TrafficUsageRecordItem struct {
 
 func (x *TrafficUsageRecordItem) Reset() {
 	*x = TrafficUsageRecordItem{}
-	mi := &file_proto_asset_proto_msgTypes[68]
+	mi := &file_proto_asset_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4823,7 +4996,7 @@ func (x *TrafficUsageRecordItem) String() string {
 func (*TrafficUsageRecordItem) ProtoMessage() {}
 
 func (x *TrafficUsageRecordItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[68]
+	mi := &file_proto_asset_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4836,7 +5009,7 @@ func (x *TrafficUsageRecordItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrafficUsageRecordItem.ProtoReflect.Descriptor instead.
 func (*TrafficUsageRecordItem) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{68}
+	return file_proto_asset_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *TrafficUsageRecordItem) GetUsageNo() string {
@@ -4956,7 +5129,7 @@ type ListTrafficUsageRecordsRequest struct {
 
 func (x *ListTrafficUsageRecordsRequest) Reset() {
 	*x = ListTrafficUsageRecordsRequest{}
-	mi := &file_proto_asset_proto_msgTypes[69]
+	mi := &file_proto_asset_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4968,7 +5141,7 @@ func (x *ListTrafficUsageRecordsRequest) String() string {
 func (*ListTrafficUsageRecordsRequest) ProtoMessage() {}
 
 func (x *ListTrafficUsageRecordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[69]
+	mi := &file_proto_asset_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4981,7 +5154,7 @@ func (x *ListTrafficUsageRecordsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTrafficUsageRecordsRequest.ProtoReflect.Descriptor instead.
 func (*ListTrafficUsageRecordsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{69}
+	return file_proto_asset_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *ListTrafficUsageRecordsRequest) GetUserId() string {
@@ -5024,7 +5197,7 @@ type ListTrafficUsageRecordsResponse struct {
 
 func (x *ListTrafficUsageRecordsResponse) Reset() {
 	*x = ListTrafficUsageRecordsResponse{}
-	mi := &file_proto_asset_proto_msgTypes[70]
+	mi := &file_proto_asset_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5036,7 +5209,7 @@ func (x *ListTrafficUsageRecordsResponse) String() string {
 func (*ListTrafficUsageRecordsResponse) ProtoMessage() {}
 
 func (x *ListTrafficUsageRecordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[70]
+	mi := &file_proto_asset_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5049,7 +5222,7 @@ func (x *ListTrafficUsageRecordsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTrafficUsageRecordsResponse.ProtoReflect.Descriptor instead.
 func (*ListTrafficUsageRecordsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{70}
+	return file_proto_asset_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *ListTrafficUsageRecordsResponse) GetTotal() int64 {
@@ -5096,7 +5269,7 @@ type BillingPricing struct {
 
 func (x *BillingPricing) Reset() {
 	*x = BillingPricing{}
-	mi := &file_proto_asset_proto_msgTypes[71]
+	mi := &file_proto_asset_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5108,7 +5281,7 @@ func (x *BillingPricing) String() string {
 func (*BillingPricing) ProtoMessage() {}
 
 func (x *BillingPricing) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[71]
+	mi := &file_proto_asset_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5121,7 +5294,7 @@ func (x *BillingPricing) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BillingPricing.ProtoReflect.Descriptor instead.
 func (*BillingPricing) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{71}
+	return file_proto_asset_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *BillingPricing) GetVersion() int32 {
@@ -5188,7 +5361,7 @@ type GetBillingPricingRequest struct {
 
 func (x *GetBillingPricingRequest) Reset() {
 	*x = GetBillingPricingRequest{}
-	mi := &file_proto_asset_proto_msgTypes[72]
+	mi := &file_proto_asset_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5200,7 +5373,7 @@ func (x *GetBillingPricingRequest) String() string {
 func (*GetBillingPricingRequest) ProtoMessage() {}
 
 func (x *GetBillingPricingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[72]
+	mi := &file_proto_asset_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5213,7 +5386,7 @@ func (x *GetBillingPricingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBillingPricingRequest.ProtoReflect.Descriptor instead.
 func (*GetBillingPricingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{72}
+	return file_proto_asset_proto_rawDescGZIP(), []int{73}
 }
 
 type GetBillingPricingResponse struct {
@@ -5225,7 +5398,7 @@ type GetBillingPricingResponse struct {
 
 func (x *GetBillingPricingResponse) Reset() {
 	*x = GetBillingPricingResponse{}
-	mi := &file_proto_asset_proto_msgTypes[73]
+	mi := &file_proto_asset_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5237,7 +5410,7 @@ func (x *GetBillingPricingResponse) String() string {
 func (*GetBillingPricingResponse) ProtoMessage() {}
 
 func (x *GetBillingPricingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[73]
+	mi := &file_proto_asset_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5250,7 +5423,7 @@ func (x *GetBillingPricingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBillingPricingResponse.ProtoReflect.Descriptor instead.
 func (*GetBillingPricingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{73}
+	return file_proto_asset_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *GetBillingPricingResponse) GetPricing() *BillingPricing {
@@ -5272,7 +5445,7 @@ type UpdateBillingPricingRequest struct {
 
 func (x *UpdateBillingPricingRequest) Reset() {
 	*x = UpdateBillingPricingRequest{}
-	mi := &file_proto_asset_proto_msgTypes[74]
+	mi := &file_proto_asset_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5284,7 +5457,7 @@ func (x *UpdateBillingPricingRequest) String() string {
 func (*UpdateBillingPricingRequest) ProtoMessage() {}
 
 func (x *UpdateBillingPricingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[74]
+	mi := &file_proto_asset_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5297,7 +5470,7 @@ func (x *UpdateBillingPricingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBillingPricingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateBillingPricingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{74}
+	return file_proto_asset_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *UpdateBillingPricingRequest) GetIngressPriceYuanPerGb() string {
@@ -5338,7 +5511,7 @@ type UpdateBillingPricingResponse struct {
 
 func (x *UpdateBillingPricingResponse) Reset() {
 	*x = UpdateBillingPricingResponse{}
-	mi := &file_proto_asset_proto_msgTypes[75]
+	mi := &file_proto_asset_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5350,7 +5523,7 @@ func (x *UpdateBillingPricingResponse) String() string {
 func (*UpdateBillingPricingResponse) ProtoMessage() {}
 
 func (x *UpdateBillingPricingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[75]
+	mi := &file_proto_asset_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5363,7 +5536,7 @@ func (x *UpdateBillingPricingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBillingPricingResponse.ProtoReflect.Descriptor instead.
 func (*UpdateBillingPricingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{75}
+	return file_proto_asset_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *UpdateBillingPricingResponse) GetSuccess() bool {
@@ -5393,7 +5566,7 @@ type WelcomeCreditSettings struct {
 
 func (x *WelcomeCreditSettings) Reset() {
 	*x = WelcomeCreditSettings{}
-	mi := &file_proto_asset_proto_msgTypes[76]
+	mi := &file_proto_asset_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5405,7 +5578,7 @@ func (x *WelcomeCreditSettings) String() string {
 func (*WelcomeCreditSettings) ProtoMessage() {}
 
 func (x *WelcomeCreditSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[76]
+	mi := &file_proto_asset_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5418,7 +5591,7 @@ func (x *WelcomeCreditSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WelcomeCreditSettings.ProtoReflect.Descriptor instead.
 func (*WelcomeCreditSettings) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{76}
+	return file_proto_asset_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *WelcomeCreditSettings) GetEnabled() bool {
@@ -5464,7 +5637,7 @@ type GetWelcomeCreditSettingsRequest struct {
 
 func (x *GetWelcomeCreditSettingsRequest) Reset() {
 	*x = GetWelcomeCreditSettingsRequest{}
-	mi := &file_proto_asset_proto_msgTypes[77]
+	mi := &file_proto_asset_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5476,7 +5649,7 @@ func (x *GetWelcomeCreditSettingsRequest) String() string {
 func (*GetWelcomeCreditSettingsRequest) ProtoMessage() {}
 
 func (x *GetWelcomeCreditSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[77]
+	mi := &file_proto_asset_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5489,7 +5662,7 @@ func (x *GetWelcomeCreditSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWelcomeCreditSettingsRequest.ProtoReflect.Descriptor instead.
 func (*GetWelcomeCreditSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{77}
+	return file_proto_asset_proto_rawDescGZIP(), []int{78}
 }
 
 type GetWelcomeCreditSettingsResponse struct {
@@ -5501,7 +5674,7 @@ type GetWelcomeCreditSettingsResponse struct {
 
 func (x *GetWelcomeCreditSettingsResponse) Reset() {
 	*x = GetWelcomeCreditSettingsResponse{}
-	mi := &file_proto_asset_proto_msgTypes[78]
+	mi := &file_proto_asset_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5513,7 +5686,7 @@ func (x *GetWelcomeCreditSettingsResponse) String() string {
 func (*GetWelcomeCreditSettingsResponse) ProtoMessage() {}
 
 func (x *GetWelcomeCreditSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[78]
+	mi := &file_proto_asset_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5526,7 +5699,7 @@ func (x *GetWelcomeCreditSettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWelcomeCreditSettingsResponse.ProtoReflect.Descriptor instead.
 func (*GetWelcomeCreditSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{78}
+	return file_proto_asset_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *GetWelcomeCreditSettingsResponse) GetSettings() *WelcomeCreditSettings {
@@ -5548,7 +5721,7 @@ type UpdateWelcomeCreditSettingsRequest struct {
 
 func (x *UpdateWelcomeCreditSettingsRequest) Reset() {
 	*x = UpdateWelcomeCreditSettingsRequest{}
-	mi := &file_proto_asset_proto_msgTypes[79]
+	mi := &file_proto_asset_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5560,7 +5733,7 @@ func (x *UpdateWelcomeCreditSettingsRequest) String() string {
 func (*UpdateWelcomeCreditSettingsRequest) ProtoMessage() {}
 
 func (x *UpdateWelcomeCreditSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[79]
+	mi := &file_proto_asset_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5573,7 +5746,7 @@ func (x *UpdateWelcomeCreditSettingsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateWelcomeCreditSettingsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateWelcomeCreditSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{79}
+	return file_proto_asset_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *UpdateWelcomeCreditSettingsRequest) GetEnabled() bool {
@@ -5614,7 +5787,7 @@ type UpdateWelcomeCreditSettingsResponse struct {
 
 func (x *UpdateWelcomeCreditSettingsResponse) Reset() {
 	*x = UpdateWelcomeCreditSettingsResponse{}
-	mi := &file_proto_asset_proto_msgTypes[80]
+	mi := &file_proto_asset_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5626,7 +5799,7 @@ func (x *UpdateWelcomeCreditSettingsResponse) String() string {
 func (*UpdateWelcomeCreditSettingsResponse) ProtoMessage() {}
 
 func (x *UpdateWelcomeCreditSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[80]
+	mi := &file_proto_asset_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5639,7 +5812,7 @@ func (x *UpdateWelcomeCreditSettingsResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use UpdateWelcomeCreditSettingsResponse.ProtoReflect.Descriptor instead.
 func (*UpdateWelcomeCreditSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{80}
+	return file_proto_asset_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *UpdateWelcomeCreditSettingsResponse) GetSuccess() bool {
@@ -5670,7 +5843,7 @@ type WelcomeCreditGrantSnapshot struct {
 
 func (x *WelcomeCreditGrantSnapshot) Reset() {
 	*x = WelcomeCreditGrantSnapshot{}
-	mi := &file_proto_asset_proto_msgTypes[81]
+	mi := &file_proto_asset_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5682,7 +5855,7 @@ func (x *WelcomeCreditGrantSnapshot) String() string {
 func (*WelcomeCreditGrantSnapshot) ProtoMessage() {}
 
 func (x *WelcomeCreditGrantSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[81]
+	mi := &file_proto_asset_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5695,7 +5868,7 @@ func (x *WelcomeCreditGrantSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WelcomeCreditGrantSnapshot.ProtoReflect.Descriptor instead.
 func (*WelcomeCreditGrantSnapshot) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{81}
+	return file_proto_asset_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *WelcomeCreditGrantSnapshot) GetOperationId() string {
@@ -5750,7 +5923,7 @@ type GrantWelcomeCreditRequest struct {
 
 func (x *GrantWelcomeCreditRequest) Reset() {
 	*x = GrantWelcomeCreditRequest{}
-	mi := &file_proto_asset_proto_msgTypes[82]
+	mi := &file_proto_asset_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5762,7 +5935,7 @@ func (x *GrantWelcomeCreditRequest) String() string {
 func (*GrantWelcomeCreditRequest) ProtoMessage() {}
 
 func (x *GrantWelcomeCreditRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[82]
+	mi := &file_proto_asset_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5775,7 +5948,7 @@ func (x *GrantWelcomeCreditRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GrantWelcomeCreditRequest.ProtoReflect.Descriptor instead.
 func (*GrantWelcomeCreditRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{82}
+	return file_proto_asset_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *GrantWelcomeCreditRequest) GetUserId() string {
@@ -5805,7 +5978,7 @@ type GrantWelcomeCreditResponse struct {
 
 func (x *GrantWelcomeCreditResponse) Reset() {
 	*x = GrantWelcomeCreditResponse{}
-	mi := &file_proto_asset_proto_msgTypes[83]
+	mi := &file_proto_asset_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5817,7 +5990,7 @@ func (x *GrantWelcomeCreditResponse) String() string {
 func (*GrantWelcomeCreditResponse) ProtoMessage() {}
 
 func (x *GrantWelcomeCreditResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[83]
+	mi := &file_proto_asset_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5830,7 +6003,7 @@ func (x *GrantWelcomeCreditResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GrantWelcomeCreditResponse.ProtoReflect.Descriptor instead.
 func (*GrantWelcomeCreditResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{83}
+	return file_proto_asset_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *GrantWelcomeCreditResponse) GetSuccess() bool {
@@ -5893,7 +6066,7 @@ type BillingShortfallOrderItem struct {
 
 func (x *BillingShortfallOrderItem) Reset() {
 	*x = BillingShortfallOrderItem{}
-	mi := &file_proto_asset_proto_msgTypes[84]
+	mi := &file_proto_asset_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5905,7 +6078,7 @@ func (x *BillingShortfallOrderItem) String() string {
 func (*BillingShortfallOrderItem) ProtoMessage() {}
 
 func (x *BillingShortfallOrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[84]
+	mi := &file_proto_asset_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5918,7 +6091,7 @@ func (x *BillingShortfallOrderItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BillingShortfallOrderItem.ProtoReflect.Descriptor instead.
 func (*BillingShortfallOrderItem) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{84}
+	return file_proto_asset_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *BillingShortfallOrderItem) GetOrderNo() string {
@@ -6051,7 +6224,7 @@ type ListBillingShortfallsRequest struct {
 
 func (x *ListBillingShortfallsRequest) Reset() {
 	*x = ListBillingShortfallsRequest{}
-	mi := &file_proto_asset_proto_msgTypes[85]
+	mi := &file_proto_asset_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6063,7 +6236,7 @@ func (x *ListBillingShortfallsRequest) String() string {
 func (*ListBillingShortfallsRequest) ProtoMessage() {}
 
 func (x *ListBillingShortfallsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[85]
+	mi := &file_proto_asset_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6076,7 +6249,7 @@ func (x *ListBillingShortfallsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBillingShortfallsRequest.ProtoReflect.Descriptor instead.
 func (*ListBillingShortfallsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{85}
+	return file_proto_asset_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *ListBillingShortfallsRequest) GetUserId() string {
@@ -6112,7 +6285,7 @@ type ListBillingShortfallsResponse struct {
 
 func (x *ListBillingShortfallsResponse) Reset() {
 	*x = ListBillingShortfallsResponse{}
-	mi := &file_proto_asset_proto_msgTypes[86]
+	mi := &file_proto_asset_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6124,7 +6297,7 @@ func (x *ListBillingShortfallsResponse) String() string {
 func (*ListBillingShortfallsResponse) ProtoMessage() {}
 
 func (x *ListBillingShortfallsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[86]
+	mi := &file_proto_asset_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6137,7 +6310,7 @@ func (x *ListBillingShortfallsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBillingShortfallsResponse.ProtoReflect.Descriptor instead.
 func (*ListBillingShortfallsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{86}
+	return file_proto_asset_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *ListBillingShortfallsResponse) GetTotal() int64 {
@@ -6179,7 +6352,7 @@ type ReconcileBillingShortfallRequest struct {
 
 func (x *ReconcileBillingShortfallRequest) Reset() {
 	*x = ReconcileBillingShortfallRequest{}
-	mi := &file_proto_asset_proto_msgTypes[87]
+	mi := &file_proto_asset_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6191,7 +6364,7 @@ func (x *ReconcileBillingShortfallRequest) String() string {
 func (*ReconcileBillingShortfallRequest) ProtoMessage() {}
 
 func (x *ReconcileBillingShortfallRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[87]
+	mi := &file_proto_asset_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6204,7 +6377,7 @@ func (x *ReconcileBillingShortfallRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReconcileBillingShortfallRequest.ProtoReflect.Descriptor instead.
 func (*ReconcileBillingShortfallRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{87}
+	return file_proto_asset_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *ReconcileBillingShortfallRequest) GetOrderNo() string {
@@ -6240,7 +6413,7 @@ type ReconcileBillingShortfallResponse struct {
 
 func (x *ReconcileBillingShortfallResponse) Reset() {
 	*x = ReconcileBillingShortfallResponse{}
-	mi := &file_proto_asset_proto_msgTypes[88]
+	mi := &file_proto_asset_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6252,7 +6425,7 @@ func (x *ReconcileBillingShortfallResponse) String() string {
 func (*ReconcileBillingShortfallResponse) ProtoMessage() {}
 
 func (x *ReconcileBillingShortfallResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[88]
+	mi := &file_proto_asset_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6265,7 +6438,7 @@ func (x *ReconcileBillingShortfallResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ReconcileBillingShortfallResponse.ProtoReflect.Descriptor instead.
 func (*ReconcileBillingShortfallResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{88}
+	return file_proto_asset_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *ReconcileBillingShortfallResponse) GetSuccess() bool {
@@ -6308,7 +6481,7 @@ type AcquireProxyForTaskRequest struct {
 
 func (x *AcquireProxyForTaskRequest) Reset() {
 	*x = AcquireProxyForTaskRequest{}
-	mi := &file_proto_asset_proto_msgTypes[89]
+	mi := &file_proto_asset_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6320,7 +6493,7 @@ func (x *AcquireProxyForTaskRequest) String() string {
 func (*AcquireProxyForTaskRequest) ProtoMessage() {}
 
 func (x *AcquireProxyForTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[89]
+	mi := &file_proto_asset_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6333,7 +6506,7 @@ func (x *AcquireProxyForTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcquireProxyForTaskRequest.ProtoReflect.Descriptor instead.
 func (*AcquireProxyForTaskRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{89}
+	return file_proto_asset_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *AcquireProxyForTaskRequest) GetTaskId() string {
@@ -6379,7 +6552,7 @@ type AcquireProxyForTaskResponse struct {
 
 func (x *AcquireProxyForTaskResponse) Reset() {
 	*x = AcquireProxyForTaskResponse{}
-	mi := &file_proto_asset_proto_msgTypes[90]
+	mi := &file_proto_asset_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6391,7 +6564,7 @@ func (x *AcquireProxyForTaskResponse) String() string {
 func (*AcquireProxyForTaskResponse) ProtoMessage() {}
 
 func (x *AcquireProxyForTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[90]
+	mi := &file_proto_asset_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6404,7 +6577,7 @@ func (x *AcquireProxyForTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcquireProxyForTaskResponse.ProtoReflect.Descriptor instead.
 func (*AcquireProxyForTaskResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{90}
+	return file_proto_asset_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *AcquireProxyForTaskResponse) GetProxyUrl() string {
@@ -6467,7 +6640,7 @@ type GetAvailableProxyRequest struct {
 
 func (x *GetAvailableProxyRequest) Reset() {
 	*x = GetAvailableProxyRequest{}
-	mi := &file_proto_asset_proto_msgTypes[91]
+	mi := &file_proto_asset_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6479,7 +6652,7 @@ func (x *GetAvailableProxyRequest) String() string {
 func (*GetAvailableProxyRequest) ProtoMessage() {}
 
 func (x *GetAvailableProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[91]
+	mi := &file_proto_asset_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6492,7 +6665,7 @@ func (x *GetAvailableProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAvailableProxyRequest.ProtoReflect.Descriptor instead.
 func (*GetAvailableProxyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{91}
+	return file_proto_asset_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *GetAvailableProxyRequest) GetProtocol() string {
@@ -6520,7 +6693,7 @@ type GetAvailableProxyResponse struct {
 
 func (x *GetAvailableProxyResponse) Reset() {
 	*x = GetAvailableProxyResponse{}
-	mi := &file_proto_asset_proto_msgTypes[92]
+	mi := &file_proto_asset_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6532,7 +6705,7 @@ func (x *GetAvailableProxyResponse) String() string {
 func (*GetAvailableProxyResponse) ProtoMessage() {}
 
 func (x *GetAvailableProxyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[92]
+	mi := &file_proto_asset_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6545,7 +6718,7 @@ func (x *GetAvailableProxyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAvailableProxyResponse.ProtoReflect.Descriptor instead.
 func (*GetAvailableProxyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{92}
+	return file_proto_asset_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *GetAvailableProxyResponse) GetProxyUrl() string {
@@ -6579,7 +6752,7 @@ type CheckProxySourceStatusRequest struct {
 
 func (x *CheckProxySourceStatusRequest) Reset() {
 	*x = CheckProxySourceStatusRequest{}
-	mi := &file_proto_asset_proto_msgTypes[93]
+	mi := &file_proto_asset_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6591,7 +6764,7 @@ func (x *CheckProxySourceStatusRequest) String() string {
 func (*CheckProxySourceStatusRequest) ProtoMessage() {}
 
 func (x *CheckProxySourceStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[93]
+	mi := &file_proto_asset_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6604,7 +6777,7 @@ func (x *CheckProxySourceStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckProxySourceStatusRequest.ProtoReflect.Descriptor instead.
 func (*CheckProxySourceStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{93}
+	return file_proto_asset_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *CheckProxySourceStatusRequest) GetProtocol() string {
@@ -6635,7 +6808,7 @@ type CheckProxySourceStatusResponse struct {
 
 func (x *CheckProxySourceStatusResponse) Reset() {
 	*x = CheckProxySourceStatusResponse{}
-	mi := &file_proto_asset_proto_msgTypes[94]
+	mi := &file_proto_asset_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6647,7 +6820,7 @@ func (x *CheckProxySourceStatusResponse) String() string {
 func (*CheckProxySourceStatusResponse) ProtoMessage() {}
 
 func (x *CheckProxySourceStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[94]
+	mi := &file_proto_asset_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6660,7 +6833,7 @@ func (x *CheckProxySourceStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckProxySourceStatusResponse.ProtoReflect.Descriptor instead.
 func (*CheckProxySourceStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{94}
+	return file_proto_asset_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *CheckProxySourceStatusResponse) GetHealthy() bool {
@@ -6722,7 +6895,7 @@ type ReportProxyUsageRequest struct {
 
 func (x *ReportProxyUsageRequest) Reset() {
 	*x = ReportProxyUsageRequest{}
-	mi := &file_proto_asset_proto_msgTypes[95]
+	mi := &file_proto_asset_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6734,7 +6907,7 @@ func (x *ReportProxyUsageRequest) String() string {
 func (*ReportProxyUsageRequest) ProtoMessage() {}
 
 func (x *ReportProxyUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[95]
+	mi := &file_proto_asset_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6747,7 +6920,7 @@ func (x *ReportProxyUsageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportProxyUsageRequest.ProtoReflect.Descriptor instead.
 func (*ReportProxyUsageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{95}
+	return file_proto_asset_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *ReportProxyUsageRequest) GetProxyLeaseId() string {
@@ -6815,7 +6988,7 @@ type ReportProxyUsageResponse struct {
 
 func (x *ReportProxyUsageResponse) Reset() {
 	*x = ReportProxyUsageResponse{}
-	mi := &file_proto_asset_proto_msgTypes[96]
+	mi := &file_proto_asset_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6827,7 +7000,7 @@ func (x *ReportProxyUsageResponse) String() string {
 func (*ReportProxyUsageResponse) ProtoMessage() {}
 
 func (x *ReportProxyUsageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[96]
+	mi := &file_proto_asset_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6840,7 +7013,7 @@ func (x *ReportProxyUsageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportProxyUsageResponse.ProtoReflect.Descriptor instead.
 func (*ReportProxyUsageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{96}
+	return file_proto_asset_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *ReportProxyUsageResponse) GetSuccess() bool {
@@ -6860,7 +7033,7 @@ type ReleaseProxyForTaskRequest struct {
 
 func (x *ReleaseProxyForTaskRequest) Reset() {
 	*x = ReleaseProxyForTaskRequest{}
-	mi := &file_proto_asset_proto_msgTypes[97]
+	mi := &file_proto_asset_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6872,7 +7045,7 @@ func (x *ReleaseProxyForTaskRequest) String() string {
 func (*ReleaseProxyForTaskRequest) ProtoMessage() {}
 
 func (x *ReleaseProxyForTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[97]
+	mi := &file_proto_asset_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6885,7 +7058,7 @@ func (x *ReleaseProxyForTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseProxyForTaskRequest.ProtoReflect.Descriptor instead.
 func (*ReleaseProxyForTaskRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{97}
+	return file_proto_asset_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *ReleaseProxyForTaskRequest) GetTaskId() string {
@@ -6911,7 +7084,7 @@ type ReleaseProxyForTaskResponse struct {
 
 func (x *ReleaseProxyForTaskResponse) Reset() {
 	*x = ReleaseProxyForTaskResponse{}
-	mi := &file_proto_asset_proto_msgTypes[98]
+	mi := &file_proto_asset_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6923,7 +7096,7 @@ func (x *ReleaseProxyForTaskResponse) String() string {
 func (*ReleaseProxyForTaskResponse) ProtoMessage() {}
 
 func (x *ReleaseProxyForTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[98]
+	mi := &file_proto_asset_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6936,7 +7109,7 @@ func (x *ReleaseProxyForTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseProxyForTaskResponse.ProtoReflect.Descriptor instead.
 func (*ReleaseProxyForTaskResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{98}
+	return file_proto_asset_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *ReleaseProxyForTaskResponse) GetSuccess() bool {
@@ -6967,7 +7140,7 @@ type ListProxyUsageEventsRequest struct {
 
 func (x *ListProxyUsageEventsRequest) Reset() {
 	*x = ListProxyUsageEventsRequest{}
-	mi := &file_proto_asset_proto_msgTypes[99]
+	mi := &file_proto_asset_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6979,7 +7152,7 @@ func (x *ListProxyUsageEventsRequest) String() string {
 func (*ListProxyUsageEventsRequest) ProtoMessage() {}
 
 func (x *ListProxyUsageEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[99]
+	mi := &file_proto_asset_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6992,7 +7165,7 @@ func (x *ListProxyUsageEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProxyUsageEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListProxyUsageEventsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{99}
+	return file_proto_asset_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *ListProxyUsageEventsRequest) GetTaskId() string {
@@ -7113,7 +7286,7 @@ type ProxyUsageEventItem struct {
 
 func (x *ProxyUsageEventItem) Reset() {
 	*x = ProxyUsageEventItem{}
-	mi := &file_proto_asset_proto_msgTypes[100]
+	mi := &file_proto_asset_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7125,7 +7298,7 @@ func (x *ProxyUsageEventItem) String() string {
 func (*ProxyUsageEventItem) ProtoMessage() {}
 
 func (x *ProxyUsageEventItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[100]
+	mi := &file_proto_asset_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7138,7 +7311,7 @@ func (x *ProxyUsageEventItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProxyUsageEventItem.ProtoReflect.Descriptor instead.
 func (*ProxyUsageEventItem) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{100}
+	return file_proto_asset_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *ProxyUsageEventItem) GetId() int64 {
@@ -7284,7 +7457,7 @@ type ProxyUsageEventCount struct {
 
 func (x *ProxyUsageEventCount) Reset() {
 	*x = ProxyUsageEventCount{}
-	mi := &file_proto_asset_proto_msgTypes[101]
+	mi := &file_proto_asset_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7296,7 +7469,7 @@ func (x *ProxyUsageEventCount) String() string {
 func (*ProxyUsageEventCount) ProtoMessage() {}
 
 func (x *ProxyUsageEventCount) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[101]
+	mi := &file_proto_asset_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7309,7 +7482,7 @@ func (x *ProxyUsageEventCount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProxyUsageEventCount.ProtoReflect.Descriptor instead.
 func (*ProxyUsageEventCount) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{101}
+	return file_proto_asset_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *ProxyUsageEventCount) GetKey() string {
@@ -7340,7 +7513,7 @@ type ProxyUsageEventSummary struct {
 
 func (x *ProxyUsageEventSummary) Reset() {
 	*x = ProxyUsageEventSummary{}
-	mi := &file_proto_asset_proto_msgTypes[102]
+	mi := &file_proto_asset_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7352,7 +7525,7 @@ func (x *ProxyUsageEventSummary) String() string {
 func (*ProxyUsageEventSummary) ProtoMessage() {}
 
 func (x *ProxyUsageEventSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[102]
+	mi := &file_proto_asset_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7365,7 +7538,7 @@ func (x *ProxyUsageEventSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProxyUsageEventSummary.ProtoReflect.Descriptor instead.
 func (*ProxyUsageEventSummary) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{102}
+	return file_proto_asset_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *ProxyUsageEventSummary) GetSuccessCount() int64 {
@@ -7423,7 +7596,7 @@ type ListProxyUsageEventsResponse struct {
 
 func (x *ListProxyUsageEventsResponse) Reset() {
 	*x = ListProxyUsageEventsResponse{}
-	mi := &file_proto_asset_proto_msgTypes[103]
+	mi := &file_proto_asset_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7435,7 +7608,7 @@ func (x *ListProxyUsageEventsResponse) String() string {
 func (*ListProxyUsageEventsResponse) ProtoMessage() {}
 
 func (x *ListProxyUsageEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[103]
+	mi := &file_proto_asset_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7448,7 +7621,7 @@ func (x *ListProxyUsageEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProxyUsageEventsResponse.ProtoReflect.Descriptor instead.
 func (*ListProxyUsageEventsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{103}
+	return file_proto_asset_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *ListProxyUsageEventsResponse) GetEvents() []*ProxyUsageEventItem {
@@ -7494,7 +7667,7 @@ type GetProxySourcePolicyRequest struct {
 
 func (x *GetProxySourcePolicyRequest) Reset() {
 	*x = GetProxySourcePolicyRequest{}
-	mi := &file_proto_asset_proto_msgTypes[104]
+	mi := &file_proto_asset_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7506,7 +7679,7 @@ func (x *GetProxySourcePolicyRequest) String() string {
 func (*GetProxySourcePolicyRequest) ProtoMessage() {}
 
 func (x *GetProxySourcePolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[104]
+	mi := &file_proto_asset_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7519,7 +7692,7 @@ func (x *GetProxySourcePolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProxySourcePolicyRequest.ProtoReflect.Descriptor instead.
 func (*GetProxySourcePolicyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{104}
+	return file_proto_asset_proto_rawDescGZIP(), []int{105}
 }
 
 type GetProxySourcePolicyResponse struct {
@@ -7541,7 +7714,7 @@ type GetProxySourcePolicyResponse struct {
 
 func (x *GetProxySourcePolicyResponse) Reset() {
 	*x = GetProxySourcePolicyResponse{}
-	mi := &file_proto_asset_proto_msgTypes[105]
+	mi := &file_proto_asset_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7553,7 +7726,7 @@ func (x *GetProxySourcePolicyResponse) String() string {
 func (*GetProxySourcePolicyResponse) ProtoMessage() {}
 
 func (x *GetProxySourcePolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[105]
+	mi := &file_proto_asset_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7566,7 +7739,7 @@ func (x *GetProxySourcePolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProxySourcePolicyResponse.ProtoReflect.Descriptor instead.
 func (*GetProxySourcePolicyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{105}
+	return file_proto_asset_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *GetProxySourcePolicyResponse) GetId() int64 {
@@ -7663,7 +7836,7 @@ type UpdateProxySourcePolicyRequest struct {
 
 func (x *UpdateProxySourcePolicyRequest) Reset() {
 	*x = UpdateProxySourcePolicyRequest{}
-	mi := &file_proto_asset_proto_msgTypes[106]
+	mi := &file_proto_asset_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7675,7 +7848,7 @@ func (x *UpdateProxySourcePolicyRequest) String() string {
 func (*UpdateProxySourcePolicyRequest) ProtoMessage() {}
 
 func (x *UpdateProxySourcePolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[106]
+	mi := &file_proto_asset_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7688,7 +7861,7 @@ func (x *UpdateProxySourcePolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProxySourcePolicyRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProxySourcePolicyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{106}
+	return file_proto_asset_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *UpdateProxySourcePolicyRequest) GetId() int64 {
@@ -7763,7 +7936,7 @@ type UpdateProxySourcePolicyResponse struct {
 
 func (x *UpdateProxySourcePolicyResponse) Reset() {
 	*x = UpdateProxySourcePolicyResponse{}
-	mi := &file_proto_asset_proto_msgTypes[107]
+	mi := &file_proto_asset_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7775,7 +7948,7 @@ func (x *UpdateProxySourcePolicyResponse) String() string {
 func (*UpdateProxySourcePolicyResponse) ProtoMessage() {}
 
 func (x *UpdateProxySourcePolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[107]
+	mi := &file_proto_asset_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7788,7 +7961,7 @@ func (x *UpdateProxySourcePolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProxySourcePolicyResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProxySourcePolicyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{107}
+	return file_proto_asset_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *UpdateProxySourcePolicyResponse) GetSuccess() bool {
@@ -7828,7 +8001,7 @@ type ProxyInfo struct {
 
 func (x *ProxyInfo) Reset() {
 	*x = ProxyInfo{}
-	mi := &file_proto_asset_proto_msgTypes[108]
+	mi := &file_proto_asset_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7840,7 +8013,7 @@ func (x *ProxyInfo) String() string {
 func (*ProxyInfo) ProtoMessage() {}
 
 func (x *ProxyInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[108]
+	mi := &file_proto_asset_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7853,7 +8026,7 @@ func (x *ProxyInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProxyInfo.ProtoReflect.Descriptor instead.
 func (*ProxyInfo) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{108}
+	return file_proto_asset_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *ProxyInfo) GetId() int64 {
@@ -8026,7 +8199,7 @@ type ListProxiesRequest struct {
 
 func (x *ListProxiesRequest) Reset() {
 	*x = ListProxiesRequest{}
-	mi := &file_proto_asset_proto_msgTypes[109]
+	mi := &file_proto_asset_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8038,7 +8211,7 @@ func (x *ListProxiesRequest) String() string {
 func (*ListProxiesRequest) ProtoMessage() {}
 
 func (x *ListProxiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[109]
+	mi := &file_proto_asset_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8051,7 +8224,7 @@ func (x *ListProxiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProxiesRequest.ProtoReflect.Descriptor instead.
 func (*ListProxiesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{109}
+	return file_proto_asset_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *ListProxiesRequest) GetSearch() string {
@@ -8122,7 +8295,7 @@ type ListProxiesResponse struct {
 
 func (x *ListProxiesResponse) Reset() {
 	*x = ListProxiesResponse{}
-	mi := &file_proto_asset_proto_msgTypes[110]
+	mi := &file_proto_asset_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8134,7 +8307,7 @@ func (x *ListProxiesResponse) String() string {
 func (*ListProxiesResponse) ProtoMessage() {}
 
 func (x *ListProxiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[110]
+	mi := &file_proto_asset_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8147,7 +8320,7 @@ func (x *ListProxiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProxiesResponse.ProtoReflect.Descriptor instead.
 func (*ListProxiesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{110}
+	return file_proto_asset_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *ListProxiesResponse) GetItems() []*ProxyInfo {
@@ -8196,7 +8369,7 @@ type CreateProxyRequest struct {
 
 func (x *CreateProxyRequest) Reset() {
 	*x = CreateProxyRequest{}
-	mi := &file_proto_asset_proto_msgTypes[111]
+	mi := &file_proto_asset_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8208,7 +8381,7 @@ func (x *CreateProxyRequest) String() string {
 func (*CreateProxyRequest) ProtoMessage() {}
 
 func (x *CreateProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[111]
+	mi := &file_proto_asset_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8221,7 +8394,7 @@ func (x *CreateProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProxyRequest.ProtoReflect.Descriptor instead.
 func (*CreateProxyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{111}
+	return file_proto_asset_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *CreateProxyRequest) GetHost() string {
@@ -8303,7 +8476,7 @@ type CreateProxyResponse struct {
 
 func (x *CreateProxyResponse) Reset() {
 	*x = CreateProxyResponse{}
-	mi := &file_proto_asset_proto_msgTypes[112]
+	mi := &file_proto_asset_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8315,7 +8488,7 @@ func (x *CreateProxyResponse) String() string {
 func (*CreateProxyResponse) ProtoMessage() {}
 
 func (x *CreateProxyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[112]
+	mi := &file_proto_asset_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8328,7 +8501,7 @@ func (x *CreateProxyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProxyResponse.ProtoReflect.Descriptor instead.
 func (*CreateProxyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{112}
+	return file_proto_asset_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *CreateProxyResponse) GetId() int64 {
@@ -8356,7 +8529,7 @@ type UpdateProxyRequest struct {
 
 func (x *UpdateProxyRequest) Reset() {
 	*x = UpdateProxyRequest{}
-	mi := &file_proto_asset_proto_msgTypes[113]
+	mi := &file_proto_asset_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8368,7 +8541,7 @@ func (x *UpdateProxyRequest) String() string {
 func (*UpdateProxyRequest) ProtoMessage() {}
 
 func (x *UpdateProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[113]
+	mi := &file_proto_asset_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8381,7 +8554,7 @@ func (x *UpdateProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProxyRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProxyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{113}
+	return file_proto_asset_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *UpdateProxyRequest) GetId() int64 {
@@ -8463,7 +8636,7 @@ type UpdateProxyResponse struct {
 
 func (x *UpdateProxyResponse) Reset() {
 	*x = UpdateProxyResponse{}
-	mi := &file_proto_asset_proto_msgTypes[114]
+	mi := &file_proto_asset_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8475,7 +8648,7 @@ func (x *UpdateProxyResponse) String() string {
 func (*UpdateProxyResponse) ProtoMessage() {}
 
 func (x *UpdateProxyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[114]
+	mi := &file_proto_asset_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8488,7 +8661,7 @@ func (x *UpdateProxyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProxyResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProxyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{114}
+	return file_proto_asset_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *UpdateProxyResponse) GetSuccess() bool {
@@ -8508,7 +8681,7 @@ type UpdateProxyStatusRequest struct {
 
 func (x *UpdateProxyStatusRequest) Reset() {
 	*x = UpdateProxyStatusRequest{}
-	mi := &file_proto_asset_proto_msgTypes[115]
+	mi := &file_proto_asset_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8520,7 +8693,7 @@ func (x *UpdateProxyStatusRequest) String() string {
 func (*UpdateProxyStatusRequest) ProtoMessage() {}
 
 func (x *UpdateProxyStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[115]
+	mi := &file_proto_asset_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8533,7 +8706,7 @@ func (x *UpdateProxyStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProxyStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProxyStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{115}
+	return file_proto_asset_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *UpdateProxyStatusRequest) GetId() int64 {
@@ -8559,7 +8732,7 @@ type UpdateProxyStatusResponse struct {
 
 func (x *UpdateProxyStatusResponse) Reset() {
 	*x = UpdateProxyStatusResponse{}
-	mi := &file_proto_asset_proto_msgTypes[116]
+	mi := &file_proto_asset_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8571,7 +8744,7 @@ func (x *UpdateProxyStatusResponse) String() string {
 func (*UpdateProxyStatusResponse) ProtoMessage() {}
 
 func (x *UpdateProxyStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[116]
+	mi := &file_proto_asset_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8584,7 +8757,7 @@ func (x *UpdateProxyStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProxyStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProxyStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{116}
+	return file_proto_asset_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *UpdateProxyStatusResponse) GetSuccess() bool {
@@ -8603,7 +8776,7 @@ type DeleteProxyRequest struct {
 
 func (x *DeleteProxyRequest) Reset() {
 	*x = DeleteProxyRequest{}
-	mi := &file_proto_asset_proto_msgTypes[117]
+	mi := &file_proto_asset_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8615,7 +8788,7 @@ func (x *DeleteProxyRequest) String() string {
 func (*DeleteProxyRequest) ProtoMessage() {}
 
 func (x *DeleteProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[117]
+	mi := &file_proto_asset_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8628,7 +8801,7 @@ func (x *DeleteProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProxyRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProxyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{117}
+	return file_proto_asset_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *DeleteProxyRequest) GetId() int64 {
@@ -8647,7 +8820,7 @@ type DeleteProxyResponse struct {
 
 func (x *DeleteProxyResponse) Reset() {
 	*x = DeleteProxyResponse{}
-	mi := &file_proto_asset_proto_msgTypes[118]
+	mi := &file_proto_asset_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8659,7 +8832,7 @@ func (x *DeleteProxyResponse) String() string {
 func (*DeleteProxyResponse) ProtoMessage() {}
 
 func (x *DeleteProxyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[118]
+	mi := &file_proto_asset_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8672,7 +8845,7 @@ func (x *DeleteProxyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProxyResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProxyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{118}
+	return file_proto_asset_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *DeleteProxyResponse) GetSuccess() bool {
@@ -8705,7 +8878,7 @@ type CookieInfo struct {
 
 func (x *CookieInfo) Reset() {
 	*x = CookieInfo{}
-	mi := &file_proto_asset_proto_msgTypes[119]
+	mi := &file_proto_asset_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8717,7 +8890,7 @@ func (x *CookieInfo) String() string {
 func (*CookieInfo) ProtoMessage() {}
 
 func (x *CookieInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[119]
+	mi := &file_proto_asset_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8730,7 +8903,7 @@ func (x *CookieInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CookieInfo.ProtoReflect.Descriptor instead.
 func (*CookieInfo) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{119}
+	return file_proto_asset_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *CookieInfo) GetId() int64 {
@@ -8845,7 +9018,7 @@ type CreateCookieRequest struct {
 
 func (x *CreateCookieRequest) Reset() {
 	*x = CreateCookieRequest{}
-	mi := &file_proto_asset_proto_msgTypes[120]
+	mi := &file_proto_asset_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8857,7 +9030,7 @@ func (x *CreateCookieRequest) String() string {
 func (*CreateCookieRequest) ProtoMessage() {}
 
 func (x *CreateCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[120]
+	mi := &file_proto_asset_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8870,7 +9043,7 @@ func (x *CreateCookieRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCookieRequest.ProtoReflect.Descriptor instead.
 func (*CreateCookieRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{120}
+	return file_proto_asset_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *CreateCookieRequest) GetPlatform() string {
@@ -8917,7 +9090,7 @@ type CreateCookieResponse struct {
 
 func (x *CreateCookieResponse) Reset() {
 	*x = CreateCookieResponse{}
-	mi := &file_proto_asset_proto_msgTypes[121]
+	mi := &file_proto_asset_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8929,7 +9102,7 @@ func (x *CreateCookieResponse) String() string {
 func (*CreateCookieResponse) ProtoMessage() {}
 
 func (x *CreateCookieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[121]
+	mi := &file_proto_asset_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8942,7 +9115,7 @@ func (x *CreateCookieResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCookieResponse.ProtoReflect.Descriptor instead.
 func (*CreateCookieResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{121}
+	return file_proto_asset_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *CreateCookieResponse) GetId() int64 {
@@ -8966,7 +9139,7 @@ type UpdateCookieRequest struct {
 
 func (x *UpdateCookieRequest) Reset() {
 	*x = UpdateCookieRequest{}
-	mi := &file_proto_asset_proto_msgTypes[122]
+	mi := &file_proto_asset_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8978,7 +9151,7 @@ func (x *UpdateCookieRequest) String() string {
 func (*UpdateCookieRequest) ProtoMessage() {}
 
 func (x *UpdateCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[122]
+	mi := &file_proto_asset_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8991,7 +9164,7 @@ func (x *UpdateCookieRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCookieRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCookieRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{122}
+	return file_proto_asset_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *UpdateCookieRequest) GetId() int64 {
@@ -9038,7 +9211,7 @@ type UpdateCookieResponse struct {
 
 func (x *UpdateCookieResponse) Reset() {
 	*x = UpdateCookieResponse{}
-	mi := &file_proto_asset_proto_msgTypes[123]
+	mi := &file_proto_asset_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9050,7 +9223,7 @@ func (x *UpdateCookieResponse) String() string {
 func (*UpdateCookieResponse) ProtoMessage() {}
 
 func (x *UpdateCookieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[123]
+	mi := &file_proto_asset_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9063,7 +9236,7 @@ func (x *UpdateCookieResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCookieResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCookieResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{123}
+	return file_proto_asset_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *UpdateCookieResponse) GetSuccess() bool {
@@ -9083,7 +9256,7 @@ type DeleteCookieRequest struct {
 
 func (x *DeleteCookieRequest) Reset() {
 	*x = DeleteCookieRequest{}
-	mi := &file_proto_asset_proto_msgTypes[124]
+	mi := &file_proto_asset_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9095,7 +9268,7 @@ func (x *DeleteCookieRequest) String() string {
 func (*DeleteCookieRequest) ProtoMessage() {}
 
 func (x *DeleteCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[124]
+	mi := &file_proto_asset_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9108,7 +9281,7 @@ func (x *DeleteCookieRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCookieRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCookieRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{124}
+	return file_proto_asset_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *DeleteCookieRequest) GetId() int64 {
@@ -9127,7 +9300,7 @@ type DeleteCookieResponse struct {
 
 func (x *DeleteCookieResponse) Reset() {
 	*x = DeleteCookieResponse{}
-	mi := &file_proto_asset_proto_msgTypes[125]
+	mi := &file_proto_asset_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9139,7 +9312,7 @@ func (x *DeleteCookieResponse) String() string {
 func (*DeleteCookieResponse) ProtoMessage() {}
 
 func (x *DeleteCookieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[125]
+	mi := &file_proto_asset_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9152,7 +9325,7 @@ func (x *DeleteCookieResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCookieResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCookieResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{125}
+	return file_proto_asset_proto_rawDescGZIP(), []int{126}
 }
 
 func (x *DeleteCookieResponse) GetSuccess() bool {
@@ -9172,7 +9345,7 @@ type GetCookieRequest struct {
 
 func (x *GetCookieRequest) Reset() {
 	*x = GetCookieRequest{}
-	mi := &file_proto_asset_proto_msgTypes[126]
+	mi := &file_proto_asset_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9184,7 +9357,7 @@ func (x *GetCookieRequest) String() string {
 func (*GetCookieRequest) ProtoMessage() {}
 
 func (x *GetCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[126]
+	mi := &file_proto_asset_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9197,7 +9370,7 @@ func (x *GetCookieRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCookieRequest.ProtoReflect.Descriptor instead.
 func (*GetCookieRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{126}
+	return file_proto_asset_proto_rawDescGZIP(), []int{127}
 }
 
 func (x *GetCookieRequest) GetId() int64 {
@@ -9216,7 +9389,7 @@ type GetCookieResponse struct {
 
 func (x *GetCookieResponse) Reset() {
 	*x = GetCookieResponse{}
-	mi := &file_proto_asset_proto_msgTypes[127]
+	mi := &file_proto_asset_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9228,7 +9401,7 @@ func (x *GetCookieResponse) String() string {
 func (*GetCookieResponse) ProtoMessage() {}
 
 func (x *GetCookieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[127]
+	mi := &file_proto_asset_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9241,7 +9414,7 @@ func (x *GetCookieResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCookieResponse.ProtoReflect.Descriptor instead.
 func (*GetCookieResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{127}
+	return file_proto_asset_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *GetCookieResponse) GetCookie() *CookieInfo {
@@ -9264,7 +9437,7 @@ type ListCookiesRequest struct {
 
 func (x *ListCookiesRequest) Reset() {
 	*x = ListCookiesRequest{}
-	mi := &file_proto_asset_proto_msgTypes[128]
+	mi := &file_proto_asset_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9276,7 +9449,7 @@ func (x *ListCookiesRequest) String() string {
 func (*ListCookiesRequest) ProtoMessage() {}
 
 func (x *ListCookiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[128]
+	mi := &file_proto_asset_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9289,7 +9462,7 @@ func (x *ListCookiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCookiesRequest.ProtoReflect.Descriptor instead.
 func (*ListCookiesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{128}
+	return file_proto_asset_proto_rawDescGZIP(), []int{129}
 }
 
 func (x *ListCookiesRequest) GetPlatform() string {
@@ -9332,7 +9505,7 @@ type ListCookiesResponse struct {
 
 func (x *ListCookiesResponse) Reset() {
 	*x = ListCookiesResponse{}
-	mi := &file_proto_asset_proto_msgTypes[129]
+	mi := &file_proto_asset_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9344,7 +9517,7 @@ func (x *ListCookiesResponse) String() string {
 func (*ListCookiesResponse) ProtoMessage() {}
 
 func (x *ListCookiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[129]
+	mi := &file_proto_asset_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9357,7 +9530,7 @@ func (x *ListCookiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCookiesResponse.ProtoReflect.Descriptor instead.
 func (*ListCookiesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{129}
+	return file_proto_asset_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *ListCookiesResponse) GetTotal() int64 {
@@ -9398,7 +9571,7 @@ type GetAvailableCookieRequest struct {
 
 func (x *GetAvailableCookieRequest) Reset() {
 	*x = GetAvailableCookieRequest{}
-	mi := &file_proto_asset_proto_msgTypes[130]
+	mi := &file_proto_asset_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9410,7 +9583,7 @@ func (x *GetAvailableCookieRequest) String() string {
 func (*GetAvailableCookieRequest) ProtoMessage() {}
 
 func (x *GetAvailableCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[130]
+	mi := &file_proto_asset_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9423,7 +9596,7 @@ func (x *GetAvailableCookieRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAvailableCookieRequest.ProtoReflect.Descriptor instead.
 func (*GetAvailableCookieRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{130}
+	return file_proto_asset_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *GetAvailableCookieRequest) GetPlatform() string {
@@ -9443,7 +9616,7 @@ type GetAvailableCookieResponse struct {
 
 func (x *GetAvailableCookieResponse) Reset() {
 	*x = GetAvailableCookieResponse{}
-	mi := &file_proto_asset_proto_msgTypes[131]
+	mi := &file_proto_asset_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9455,7 +9628,7 @@ func (x *GetAvailableCookieResponse) String() string {
 func (*GetAvailableCookieResponse) ProtoMessage() {}
 
 func (x *GetAvailableCookieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[131]
+	mi := &file_proto_asset_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9468,7 +9641,7 @@ func (x *GetAvailableCookieResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAvailableCookieResponse.ProtoReflect.Descriptor instead.
 func (*GetAvailableCookieResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{131}
+	return file_proto_asset_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *GetAvailableCookieResponse) GetCookieId() int64 {
@@ -9499,7 +9672,7 @@ type ReportCookieUsageRequest struct {
 
 func (x *ReportCookieUsageRequest) Reset() {
 	*x = ReportCookieUsageRequest{}
-	mi := &file_proto_asset_proto_msgTypes[132]
+	mi := &file_proto_asset_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9511,7 +9684,7 @@ func (x *ReportCookieUsageRequest) String() string {
 func (*ReportCookieUsageRequest) ProtoMessage() {}
 
 func (x *ReportCookieUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[132]
+	mi := &file_proto_asset_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9524,7 +9697,7 @@ func (x *ReportCookieUsageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportCookieUsageRequest.ProtoReflect.Descriptor instead.
 func (*ReportCookieUsageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{132}
+	return file_proto_asset_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *ReportCookieUsageRequest) GetCookieId() int64 {
@@ -9571,7 +9744,7 @@ type ReportCookieUsageResponse struct {
 
 func (x *ReportCookieUsageResponse) Reset() {
 	*x = ReportCookieUsageResponse{}
-	mi := &file_proto_asset_proto_msgTypes[133]
+	mi := &file_proto_asset_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9583,7 +9756,7 @@ func (x *ReportCookieUsageResponse) String() string {
 func (*ReportCookieUsageResponse) ProtoMessage() {}
 
 func (x *ReportCookieUsageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[133]
+	mi := &file_proto_asset_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9596,7 +9769,7 @@ func (x *ReportCookieUsageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportCookieUsageResponse.ProtoReflect.Descriptor instead.
 func (*ReportCookieUsageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{133}
+	return file_proto_asset_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *ReportCookieUsageResponse) GetSuccess() bool {
@@ -9617,7 +9790,7 @@ type FreezeCookieRequest struct {
 
 func (x *FreezeCookieRequest) Reset() {
 	*x = FreezeCookieRequest{}
-	mi := &file_proto_asset_proto_msgTypes[134]
+	mi := &file_proto_asset_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9629,7 +9802,7 @@ func (x *FreezeCookieRequest) String() string {
 func (*FreezeCookieRequest) ProtoMessage() {}
 
 func (x *FreezeCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[134]
+	mi := &file_proto_asset_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9642,7 +9815,7 @@ func (x *FreezeCookieRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FreezeCookieRequest.ProtoReflect.Descriptor instead.
 func (*FreezeCookieRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{134}
+	return file_proto_asset_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *FreezeCookieRequest) GetCookieId() int64 {
@@ -9669,7 +9842,7 @@ type FreezeCookieResponse struct {
 
 func (x *FreezeCookieResponse) Reset() {
 	*x = FreezeCookieResponse{}
-	mi := &file_proto_asset_proto_msgTypes[135]
+	mi := &file_proto_asset_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9681,7 +9854,7 @@ func (x *FreezeCookieResponse) String() string {
 func (*FreezeCookieResponse) ProtoMessage() {}
 
 func (x *FreezeCookieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[135]
+	mi := &file_proto_asset_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9694,7 +9867,7 @@ func (x *FreezeCookieResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FreezeCookieResponse.ProtoReflect.Descriptor instead.
 func (*FreezeCookieResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{135}
+	return file_proto_asset_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *FreezeCookieResponse) GetSuccess() bool {
@@ -9711,31 +9884,1168 @@ func (x *FreezeCookieResponse) GetFrozenUntil() string {
 	return ""
 }
 
-var File_proto_asset_proto protoreflect.FileDescriptor
+// Webhook 端点
+type WebhookEndpointInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 空表示全局端点
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Events        []string               `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"` // 为空表示订阅全部事件
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Enabled       bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_asset_proto_rawDesc = "" +
-	"\n" +
-	"\x11proto/asset.proto\x12\x05asset\"\x83\x02\n" +
-	"\x11GetHistoryRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x1a\n" +
-	"\bplatform\x18\x03 \x01(\tR\bplatform\x12\x1d\n" +
-	"\n" +
-	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x05 \x01(\tR\aendDate\x12\x12\n" +
-	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x17\n" +
-	"\asort_by\x18\b \x01(\tR\x06sortBy\x12\x1d\n" +
-	"\n" +
-	"sort_order\x18\t \x01(\tR\tsortOrder\"\x85\x01\n" +
-	"\x12GetHistoryResponse\x12\x14\n" +
-	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12(\n" +
-	"\x05items\x18\x04 \x03(\v2\x12.asset.HistoryItemR\x05items\"\xba\x03\n" +
-	"\vHistoryItem\x12\x1d\n" +
-	"\n" +
-	"history_id\x18\x01 \x01(\x03R\thistoryId\x12\x17\n" +
+func (x *WebhookEndpointInfo) Reset() {
+	*x = WebhookEndpointInfo{}
+	mi := &file_proto_asset_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEndpointInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEndpointInfo) ProtoMessage() {}
+
+func (x *WebhookEndpointInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEndpointInfo.ProtoReflect.Descriptor instead.
+func (*WebhookEndpointInfo) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *WebhookEndpointInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookEndpointInfo) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WebhookEndpointInfo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookEndpointInfo) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WebhookEndpointInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WebhookEndpointInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WebhookEndpointInfo) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *WebhookEndpointInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WebhookEndpointInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// 创建 Webhook 端点
+type CreateWebhookRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Global         bool                   `protobuf:"varint,2,opt,name=global,proto3" json:"global,omitempty"` // 后台全局端点，忽略 user_id
+	Url            string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Events         []string               `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	OperatorUserId string                 `protobuf:"bytes,6,opt,name=operator_user_id,json=operatorUserId,proto3" json:"operator_user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_proto_asset_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *CreateWebhookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetGlobal() bool {
+	if x != nil {
+		return x.Global
+	}
+	return false
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetOperatorUserId() string {
+	if x != nil {
+		return x.OperatorUserId
+	}
+	return ""
+}
+
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      *WebhookEndpointInfo   `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // 签名密钥，仅创建时返回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_proto_asset_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *CreateWebhookResponse) GetEndpoint() *WebhookEndpointInfo {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+func (x *CreateWebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// 列出 Webhook 端点
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Global        bool                   `protobuf:"varint,2,opt,name=global,proto3" json:"global,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_proto_asset_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListWebhooksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListWebhooksRequest) GetGlobal() bool {
+	if x != nil {
+		return x.Global
+	}
+	return false
+}
+
+type ListWebhooksResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Items           []*WebhookEndpointInfo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	AvailableEvents []string               `protobuf:"bytes,2,rep,name=available_events,json=availableEvents,proto3" json:"available_events,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_proto_asset_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ListWebhooksResponse) GetItems() []*WebhookEndpointInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListWebhooksResponse) GetAvailableEvents() []string {
+	if x != nil {
+		return x.AvailableEvents
+	}
+	return nil
+}
+
+// 更新 Webhook 端点
+type UpdateWebhookRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Global            bool                   `protobuf:"varint,3,opt,name=global,proto3" json:"global,omitempty"`
+	Url               string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"` // 空表示不修改
+	UpdateEvents      bool                   `protobuf:"varint,5,opt,name=update_events,json=updateEvents,proto3" json:"update_events,omitempty"`
+	Events            []string               `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+	UpdateDescription bool                   `protobuf:"varint,7,opt,name=update_description,json=updateDescription,proto3" json:"update_description,omitempty"`
+	Description       string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Status            string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"` // enabled / disabled，空表示不修改
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	mi := &file_proto_asset_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *UpdateWebhookRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateWebhookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetGlobal() bool {
+	if x != nil {
+		return x.Global
+	}
+	return false
+}
+
+func (x *UpdateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetUpdateEvents() bool {
+	if x != nil {
+		return x.UpdateEvents
+	}
+	return false
+}
+
+func (x *UpdateWebhookRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetUpdateDescription() bool {
+	if x != nil {
+		return x.UpdateDescription
+	}
+	return false
+}
+
+func (x *UpdateWebhookRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      *WebhookEndpointInfo   `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookResponse) Reset() {
+	*x = UpdateWebhookResponse{}
+	mi := &file_proto_asset_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *UpdateWebhookResponse) GetEndpoint() *WebhookEndpointInfo {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+// 删除 Webhook 端点
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Global        bool                   `protobuf:"varint,3,opt,name=global,proto3" json:"global,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_proto_asset_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *DeleteWebhookRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteWebhookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteWebhookRequest) GetGlobal() bool {
+	if x != nil {
+		return x.Global
+	}
+	return false
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_proto_asset_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *DeleteWebhookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Webhook 投递记录
+type WebhookDeliveryInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EndpointId     int64                  `protobuf:"varint,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	UserId         string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PayloadJson    string                 `protobuf:"bytes,6,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"`
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // pending / succeeded / failed
+	Attempts       int32                  `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt  string                 `protobuf:"bytes,9,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	LastAttemptAt  string                 `protobuf:"bytes,10,opt,name=last_attempt_at,json=lastAttemptAt,proto3" json:"last_attempt_at,omitempty"`
+	ResponseStatus int32                  `protobuf:"varint,11,opt,name=response_status,json=responseStatus,proto3" json:"response_status,omitempty"`
+	ResponseBody   string                 `protobuf:"bytes,12,opt,name=response_body,json=responseBody,proto3" json:"response_body,omitempty"`
+	LastError      string                 `protobuf:"bytes,13,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	DurationMs     int64                  `protobuf:"varint,14,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDeliveryInfo) Reset() {
+	*x = WebhookDeliveryInfo{}
+	mi := &file_proto_asset_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDeliveryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryInfo) ProtoMessage() {}
+
+func (x *WebhookDeliveryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryInfo.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryInfo) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *WebhookDeliveryInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryInfo) GetEndpointId() int64 {
+	if x != nil {
+		return x.EndpointId
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryInfo) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetPayloadJson() string {
+	if x != nil {
+		return x.PayloadJson
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryInfo) GetNextAttemptAt() string {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetLastAttemptAt() string {
+	if x != nil {
+		return x.LastAttemptAt
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetResponseStatus() int32 {
+	if x != nil {
+		return x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryInfo) GetResponseBody() string {
+	if x != nil {
+		return x.ResponseBody
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDeliveryInfo) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *WebhookDeliveryInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 查询 Webhook 投递记录
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Global        bool                   `protobuf:"varint,2,opt,name=global,proto3" json:"global,omitempty"`
+	EndpointId    int64                  `protobuf:"varint,3,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"` // 可选
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                            // 可选
+	EventType     string                 `protobuf:"bytes,5,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`     // 可选
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_proto_asset_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetGlobal() bool {
+	if x != nil {
+		return x.Global
+	}
+	return false
+}
+
+func (x *ListWebhookDeliveriesRequest) GetEndpointId() int64 {
+	if x != nil {
+		return x.EndpointId
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*WebhookDeliveryInfo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_proto_asset_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetItems() []*WebhookDeliveryInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 人工重投
+type RedeliverWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    int64                  `protobuf:"varint,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Global        bool                   `protobuf:"varint,3,opt,name=global,proto3" json:"global,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	mi := &file_proto_asset_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *RedeliverWebhookRequest) GetDeliveryId() int64 {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return 0
+}
+
+func (x *RedeliverWebhookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RedeliverWebhookRequest) GetGlobal() bool {
+	if x != nil {
+		return x.Global
+	}
+	return false
+}
+
+type RedeliverWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookResponse) Reset() {
+	*x = RedeliverWebhookResponse{}
+	mi := &file_proto_asset_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookResponse) ProtoMessage() {}
+
+func (x *RedeliverWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookResponse.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *RedeliverWebhookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 发布业务事件
+type EmitWebhookEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // task.completed / task.failed / billing.captured / balance.low
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DataJson      string                 `protobuf:"bytes,3,opt,name=data_json,json=dataJson,proto3" json:"data_json,omitempty"` // 事件数据（JSON 对象）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmitWebhookEventRequest) Reset() {
+	*x = EmitWebhookEventRequest{}
+	mi := &file_proto_asset_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmitWebhookEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmitWebhookEventRequest) ProtoMessage() {}
+
+func (x *EmitWebhookEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmitWebhookEventRequest.ProtoReflect.Descriptor instead.
+func (*EmitWebhookEventRequest) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *EmitWebhookEventRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *EmitWebhookEventRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EmitWebhookEventRequest) GetDataJson() string {
+	if x != nil {
+		return x.DataJson
+	}
+	return ""
+}
+
+type EmitWebhookEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    int32                  `protobuf:"varint,1,opt,name=deliveries,proto3" json:"deliveries,omitempty"` // 生成的投递数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmitWebhookEventResponse) Reset() {
+	*x = EmitWebhookEventResponse{}
+	mi := &file_proto_asset_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmitWebhookEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmitWebhookEventResponse) ProtoMessage() {}
+
+func (x *EmitWebhookEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmitWebhookEventResponse.ProtoReflect.Descriptor instead.
+func (*EmitWebhookEventResponse) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *EmitWebhookEventResponse) GetDeliveries() int32 {
+	if x != nil {
+		return x.Deliveries
+	}
+	return 0
+}
+
+var File_proto_asset_proto protoreflect.FileDescriptor
+
+const file_proto_asset_proto_rawDesc = "" +
+	"\n" +
+	"\x11proto/asset.proto\x12\x05asset\"\x83\x02\n" +
+	"\x11GetHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x1a\n" +
+	"\bplatform\x18\x03 \x01(\tR\bplatform\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x17\n" +
+	"\asort_by\x18\b \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\t \x01(\tR\tsortOrder\"\x85\x01\n" +
+	"\x12GetHistoryResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12(\n" +
+	"\x05items\x18\x04 \x03(\v2\x12.asset.HistoryItemR\x05items\"\xba\x03\n" +
+	"\vHistoryItem\x12\x1d\n" +
+	"\n" +
+	"history_id\x18\x01 \x01(\x03R\thistoryId\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x10\n" +
 	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1a\n" +
 	"\bplatform\x18\x04 \x01(\tR\bplatform\x12\x14\n" +
@@ -9880,12 +11190,20 @@ const file_proto_asset_proto_rawDesc = "" +
 	"\x12GetFileInfoRequest\x12\x1d\n" +
 	"\n" +
 	"history_id\x18\x01 \x01(\x03R\thistoryId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x89\x01\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xc0\x01\n" +
 	"\x13GetFileInfoResponse\x12\x1b\n" +
 	"\tfile_path\x18\x01 \x01(\tR\bfilePath\x12\x1b\n" +
 	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x1b\n" +
 	"\tfile_size\x18\x03 \x01(\x03R\bfileSize\x12\x1b\n" +
-	"\tfile_hash\x18\x04 \x01(\tR\bfileHash\"\x8c\x02\n" +
+	"\tfile_hash\x18\x04 \x01(\tR\bfileHash\x125\n" +
+	"\vattachments\x18\x05 \x03(\v2\x13.asset.AttachedFileR\vattachments\"\xa5\x01\n" +
+	"\fAttachedFile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x1b\n" +
+	"\tfile_path\x18\x04 \x01(\tR\bfilePath\x12\x1b\n" +
+	"\tfile_name\x18\x05 \x01(\tR\bfileName\x12\x1b\n" +
+	"\tfile_size\x18\x06 \x01(\x03R\bfileSize\"\xc5\x02\n" +
 	"\x14CreateHistoryRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x10\n" +
@@ -9897,10 +11215,14 @@ const file_proto_asset_proto_rawDesc = "" +
 	"\tthumbnail\x18\b \x01(\tR\tthumbnail\x12\x1a\n" +
 	"\bduration\x18\t \x01(\x03R\bduration\x12\x16\n" +
 	"\x06author\x18\n" +
-	" \x01(\tR\x06author\"6\n" +
+	" \x01(\tR\x06author\x12\x1a\n" +
+	"\bpriority\x18\v \x01(\x05R\bpriority\x12\x1b\n" +
+	"\tdedup_key\x18\f \x01(\tR\bdedupKey\"m\n" +
 	"\x15CreateHistoryResponse\x12\x1d\n" +
 	"\n" +
-	"history_id\x18\x01 \x01(\x03R\thistoryId\"\xe6\x01\n" +
+	"history_id\x18\x01 \x01(\x03R\thistoryId\x12\x1c\n" +
+	"\tduplicate\x18\x02 \x01(\bR\tduplicate\x12\x17\n" +
+	"\atask_id\x18\x03 \x01(\tR\x06taskId\"\xe6\x01\n" +
 	"\x1aUpdateHistoryStatusRequest\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x1b\n" +
@@ -9970,13 +11292,16 @@ const file_proto_asset_proto_rawDesc = "" +
 	"\x03vbr\x18\n" +
 	" \x01(\x01R\x03vbr\x12\x10\n" +
 	"\x03abr\x18\v \x01(\x01R\x03abr\x12\x10\n" +
-	"\x03asr\x18\f \x01(\x05R\x03asr\"\xc2\x01\n" +
+	"\x03asr\x18\f \x01(\x05R\x03asr\"\xc5\x02\n" +
 	"\x1eEstimateDownloadBillingRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1a\n" +
 	"\bplatform\x18\x03 \x01(\tR\bplatform\x12\x12\n" +
 	"\x04mode\x18\x04 \x01(\tR\x04mode\x12E\n" +
-	"\x0fselected_format\x18\x05 \x01(\v2\x1c.asset.BillingSelectedFormatR\x0eselectedFormat\"\xec\x02\n" +
+	"\x0fselected_format\x18\x05 \x01(\v2\x1c.asset.BillingSelectedFormatR\x0eselectedFormat\x12)\n" +
+	"\x10duration_seconds\x18\x06 \x01(\x03R\x0fdurationSeconds\x12,\n" +
+	"\x12clip_start_seconds\x18\a \x01(\x01R\x10clipStartSeconds\x12(\n" +
+	"\x10clip_end_seconds\x18\b \x01(\x01R\x0eclipEndSeconds\"\xec\x02\n" +
 	"\x1fEstimateDownloadBillingResponse\x126\n" +
 	"\x17estimated_ingress_bytes\x18\x01 \x01(\x03R\x15estimatedIngressBytes\x124\n" +
 	"\x16estimated_egress_bytes\x18\x02 \x01(\x03R\x14estimatedEgressBytes\x126\n" +
@@ -10017,12 +11342,16 @@ const file_proto_asset_proto_rawDesc = "" +
 	"\x1eReleaseInitialDownloadResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x19\n" +
 	"\border_no\x18\x02 \x01(\tR\aorderNo\x120\n" +
-	"\x14released_amount_yuan\x18\x03 \x01(\tR\x12releasedAmountYuan\"\x83\x01\n" +
+	"\x14released_amount_yuan\x18\x03 \x01(\tR\x12releasedAmountYuan\"\xda\x01\n" +
 	"!PrepareFileTransferBillingRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
 	"history_id\x18\x02 \x01(\x03R\thistoryId\x12&\n" +
-	"\x0ffile_size_bytes\x18\x03 \x01(\x03R\rfileSizeBytes\"\xcc\x02\n" +
+	"\x0ffile_size_bytes\x18\x03 \x01(\x03R\rfileSizeBytes\x12\x17\n" +
+	"\afile_id\x18\x04 \x01(\x03R\x06fileId\x12\x1f\n" +
+	"\vrange_start\x18\x05 \x01(\x03R\n" +
+	"rangeStart\x12\x1b\n" +
+	"\trange_end\x18\x06 \x01(\x03R\brangeEnd\"\xcc\x02\n" +
 	"\"PrepareFileTransferBillingResponse\x12\x1f\n" +
 	"\vtransfer_id\x18\x01 \x01(\tR\n" +
 	"transferId\x12\x19\n" +
@@ -10535,7 +11864,107 @@ const file_proto_asset_proto_rawDesc = "" +
 	"\x0efreeze_seconds\x18\x02 \x01(\x05R\rfreezeSeconds\"S\n" +
 	"\x14FreezeCookieResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12!\n" +
-	"\ffrozen_until\x18\x02 \x01(\tR\vfrozenUntil2\x9d&\n" +
+	"\ffrozen_until\x18\x02 \x01(\tR\vfrozenUntil\"\x81\x02\n" +
+	"\x13WebhookEndpointInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x04 \x03(\tR\x06events\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\"\xbd\x01\n" +
+	"\x14CreateWebhookRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06global\x18\x02 \x01(\bR\x06global\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06events\x18\x04 \x03(\tR\x06events\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12(\n" +
+	"\x10operator_user_id\x18\x06 \x01(\tR\x0eoperatorUserId\"g\n" +
+	"\x15CreateWebhookResponse\x126\n" +
+	"\bendpoint\x18\x01 \x01(\v2\x1a.asset.WebhookEndpointInfoR\bendpoint\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"F\n" +
+	"\x13ListWebhooksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06global\x18\x02 \x01(\bR\x06global\"s\n" +
+	"\x14ListWebhooksResponse\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.asset.WebhookEndpointInfoR\x05items\x12)\n" +
+	"\x10available_events\x18\x02 \x03(\tR\x0favailableEvents\"\x8f\x02\n" +
+	"\x14UpdateWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06global\x18\x03 \x01(\bR\x06global\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12#\n" +
+	"\rupdate_events\x18\x05 \x01(\bR\fupdateEvents\x12\x16\n" +
+	"\x06events\x18\x06 \x03(\tR\x06events\x12-\n" +
+	"\x12update_description\x18\a \x01(\bR\x11updateDescription\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\"O\n" +
+	"\x15UpdateWebhookResponse\x126\n" +
+	"\bendpoint\x18\x01 \x01(\v2\x1a.asset.WebhookEndpointInfoR\bendpoint\"W\n" +
+	"\x14DeleteWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06global\x18\x03 \x01(\bR\x06global\"1\n" +
+	"\x15DeleteWebhookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xed\x03\n" +
+	"\x13WebhookDeliveryInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vendpoint_id\x18\x02 \x01(\x03R\n" +
+	"endpointId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12!\n" +
+	"\fpayload_json\x18\x06 \x01(\tR\vpayloadJson\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\b \x01(\x05R\battempts\x12&\n" +
+	"\x0fnext_attempt_at\x18\t \x01(\tR\rnextAttemptAt\x12&\n" +
+	"\x0flast_attempt_at\x18\n" +
+	" \x01(\tR\rlastAttemptAt\x12'\n" +
+	"\x0fresponse_status\x18\v \x01(\x05R\x0eresponseStatus\x12#\n" +
+	"\rresponse_body\x18\f \x01(\tR\fresponseBody\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\r \x01(\tR\tlastError\x12\x1f\n" +
+	"\vduration_ms\x18\x0e \x01(\x03R\n" +
+	"durationMs\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\tR\tcreatedAt\"\xd8\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06global\x18\x02 \x01(\bR\x06global\x12\x1f\n" +
+	"\vendpoint_id\x18\x03 \x01(\x03R\n" +
+	"endpointId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x05 \x01(\tR\teventType\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\"\x98\x01\n" +
+	"\x1dListWebhookDeliveriesResponse\x120\n" +
+	"\x05items\x18\x01 \x03(\v2\x1a.asset.WebhookDeliveryInfoR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"k\n" +
+	"\x17RedeliverWebhookRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\x03R\n" +
+	"deliveryId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06global\x18\x03 \x01(\bR\x06global\"4\n" +
+	"\x18RedeliverWebhookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"n\n" +
+	"\x17EmitWebhookEventRequest\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tR\teventType\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tdata_json\x18\x03 \x01(\tR\bdataJson\":\n" +
+	"\x18EmitWebhookEventResponse\x12\x1e\n" +
+	"\n" +
+	"deliveries\x18\x01 \x01(\x05R\n" +
+	"deliveries2\xd8*\n" +
 	"\fAssetService\x12A\n" +
 	"\n" +
 	"GetHistory\x12\x18.asset.GetHistoryRequest\x1a\x19.asset.GetHistoryResponse\x12J\n" +
@@ -10593,7 +12022,14 @@ const file_proto_asset_proto_rawDesc = "" +
 	"\vListCookies\x12\x19.asset.ListCookiesRequest\x1a\x1a.asset.ListCookiesResponse\x12Y\n" +
 	"\x12GetAvailableCookie\x12 .asset.GetAvailableCookieRequest\x1a!.asset.GetAvailableCookieResponse\x12V\n" +
 	"\x11ReportCookieUsage\x12\x1f.asset.ReportCookieUsageRequest\x1a .asset.ReportCookieUsageResponse\x12G\n" +
-	"\fFreezeCookie\x12\x1a.asset.FreezeCookieRequest\x1a\x1b.asset.FreezeCookieResponseB\x1fZ\x1dyoudlp/asset-service/proto;pbb\x06proto3"
+	"\fFreezeCookie\x12\x1a.asset.FreezeCookieRequest\x1a\x1b.asset.FreezeCookieResponse\x12J\n" +
+	"\rCreateWebhook\x12\x1b.asset.CreateWebhookRequest\x1a\x1c.asset.CreateWebhookResponse\x12G\n" +
+	"\fListWebhooks\x12\x1a.asset.ListWebhooksRequest\x1a\x1b.asset.ListWebhooksResponse\x12J\n" +
+	"\rUpdateWebhook\x12\x1b.asset.UpdateWebhookRequest\x1a\x1c.asset.UpdateWebhookResponse\x12J\n" +
+	"\rDeleteWebhook\x12\x1b.asset.DeleteWebhookRequest\x1a\x1c.asset.DeleteWebhookResponse\x12b\n" +
+	"\x15ListWebhookDeliveries\x12#.asset.ListWebhookDeliveriesRequest\x1a$.asset.ListWebhookDeliveriesResponse\x12S\n" +
+	"\x10RedeliverWebhook\x12\x1e.asset.RedeliverWebhookRequest\x1a\x1f.asset.RedeliverWebhookResponse\x12S\n" +
+	"\x10EmitWebhookEvent\x12\x1e.asset.EmitWebhookEventRequest\x1a\x1f.asset.EmitWebhookEventResponseB\x1fZ\x1dyoudlp/asset-service/proto;pbb\x06proto3"
 
 var (
 	file_proto_asset_proto_rawDescOnce sync.Once
@@ -10607,7 +12043,7 @@ func file_proto_asset_proto_rawDescGZIP() []byte {
 	return file_proto_asset_proto_rawDescData
 }
 
-var file_proto_asset_proto_msgTypes = make([]protoimpl.MessageInfo, 136)
+var file_proto_asset_proto_msgTypes = make([]protoimpl.MessageInfo, 153)
 var file_proto_asset_proto_goTypes = []any{
 	(*GetHistoryRequest)(nil),                   // 0: asset.GetHistoryRequest
 	(*GetHistoryResponse)(nil),                  // 1: asset.GetHistoryResponse
@@ -10643,108 +12079,125 @@ var file_proto_asset_proto_goTypes = []any{
 	(*GetDashboardHealthResponse)(nil),          // 31: asset.GetDashboardHealthResponse
 	(*GetFileInfoRequest)(nil),                  // 32: asset.GetFileInfoRequest
 	(*GetFileInfoResponse)(nil),                 // 33: asset.GetFileInfoResponse
-	(*CreateHistoryRequest)(nil),                // 34: asset.CreateHistoryRequest
-	(*CreateHistoryResponse)(nil),               // 35: asset.CreateHistoryResponse
-	(*UpdateHistoryStatusRequest)(nil),          // 36: asset.UpdateHistoryStatusRequest
-	(*UpdateHistoryStatusResponse)(nil),         // 37: asset.UpdateHistoryStatusResponse
-	(*BillingAccountSnapshot)(nil),              // 38: asset.BillingAccountSnapshot
-	(*GetBillingAccountRequest)(nil),            // 39: asset.GetBillingAccountRequest
-	(*GetBillingAccountResponse)(nil),           // 40: asset.GetBillingAccountResponse
-	(*BillingStatementItem)(nil),                // 41: asset.BillingStatementItem
-	(*ListBillingStatementsRequest)(nil),        // 42: asset.ListBillingStatementsRequest
-	(*ListBillingStatementsResponse)(nil),       // 43: asset.ListBillingStatementsResponse
-	(*BillingSelectedFormat)(nil),               // 44: asset.BillingSelectedFormat
-	(*EstimateDownloadBillingRequest)(nil),      // 45: asset.EstimateDownloadBillingRequest
-	(*EstimateDownloadBillingResponse)(nil),     // 46: asset.EstimateDownloadBillingResponse
-	(*HoldInitialDownloadRequest)(nil),          // 47: asset.HoldInitialDownloadRequest
-	(*HoldInitialDownloadResponse)(nil),         // 48: asset.HoldInitialDownloadResponse
-	(*CaptureIngressUsageRequest)(nil),          // 49: asset.CaptureIngressUsageRequest
-	(*CaptureIngressUsageResponse)(nil),         // 50: asset.CaptureIngressUsageResponse
-	(*ReleaseInitialDownloadRequest)(nil),       // 51: asset.ReleaseInitialDownloadRequest
-	(*ReleaseInitialDownloadResponse)(nil),      // 52: asset.ReleaseInitialDownloadResponse
-	(*PrepareFileTransferBillingRequest)(nil),   // 53: asset.PrepareFileTransferBillingRequest
-	(*PrepareFileTransferBillingResponse)(nil),  // 54: asset.PrepareFileTransferBillingResponse
-	(*CompleteFileTransferBillingRequest)(nil),  // 55: asset.CompleteFileTransferBillingRequest
-	(*CompleteFileTransferBillingResponse)(nil), // 56: asset.CompleteFileTransferBillingResponse
-	(*AbortFileTransferBillingRequest)(nil),     // 57: asset.AbortFileTransferBillingRequest
-	(*AbortFileTransferBillingResponse)(nil),    // 58: asset.AbortFileTransferBillingResponse
-	(*ListBillingAccountsRequest)(nil),          // 59: asset.ListBillingAccountsRequest
-	(*ListBillingAccountsResponse)(nil),         // 60: asset.ListBillingAccountsResponse
-	(*GetBillingAccountDetailRequest)(nil),      // 61: asset.GetBillingAccountDetailRequest
-	(*GetBillingAccountDetailResponse)(nil),     // 62: asset.GetBillingAccountDetailResponse
-	(*AdjustBillingBalanceRequest)(nil),         // 63: asset.AdjustBillingBalanceRequest
-	(*AdjustBillingBalanceResponse)(nil),        // 64: asset.AdjustBillingBalanceResponse
-	(*LedgerEntryItem)(nil),                     // 65: asset.LedgerEntryItem
-	(*ListBillingLedgerRequest)(nil),            // 66: asset.ListBillingLedgerRequest
-	(*ListBillingLedgerResponse)(nil),           // 67: asset.ListBillingLedgerResponse
-	(*TrafficUsageRecordItem)(nil),              // 68: asset.TrafficUsageRecordItem
-	(*ListTrafficUsageRecordsRequest)(nil),      // 69: asset.ListTrafficUsageRecordsRequest
-	(*ListTrafficUsageRecordsResponse)(nil),     // 70: asset.ListTrafficUsageRecordsResponse
-	(*BillingPricing)(nil),                      // 71: asset.BillingPricing
-	(*GetBillingPricingRequest)(nil),            // 72: asset.GetBillingPricingRequest
-	(*GetBillingPricingResponse)(nil),           // 73: asset.GetBillingPricingResponse
-	(*UpdateBillingPricingRequest)(nil),         // 74: asset.UpdateBillingPricingRequest
-	(*UpdateBillingPricingResponse)(nil),        // 75: asset.UpdateBillingPricingResponse
-	(*WelcomeCreditSettings)(nil),               // 76: asset.WelcomeCreditSettings
-	(*GetWelcomeCreditSettingsRequest)(nil),     // 77: asset.GetWelcomeCreditSettingsRequest
-	(*GetWelcomeCreditSettingsResponse)(nil),    // 78: asset.GetWelcomeCreditSettingsResponse
-	(*UpdateWelcomeCreditSettingsRequest)(nil),  // 79: asset.UpdateWelcomeCreditSettingsRequest
-	(*UpdateWelcomeCreditSettingsResponse)(nil), // 80: asset.UpdateWelcomeCreditSettingsResponse
-	(*WelcomeCreditGrantSnapshot)(nil),          // 81: asset.WelcomeCreditGrantSnapshot
-	(*GrantWelcomeCreditRequest)(nil),           // 82: asset.GrantWelcomeCreditRequest
-	(*GrantWelcomeCreditResponse)(nil),          // 83: asset.GrantWelcomeCreditResponse
-	(*BillingShortfallOrderItem)(nil),           // 84: asset.BillingShortfallOrderItem
-	(*ListBillingShortfallsRequest)(nil),        // 85: asset.ListBillingShortfallsRequest
-	(*ListBillingShortfallsResponse)(nil),       // 86: asset.ListBillingShortfallsResponse
-	(*ReconcileBillingShortfallRequest)(nil),    // 87: asset.ReconcileBillingShortfallRequest
-	(*ReconcileBillingShortfallResponse)(nil),   // 88: asset.ReconcileBillingShortfallResponse
-	(*AcquireProxyForTaskRequest)(nil),          // 89: asset.AcquireProxyForTaskRequest
-	(*AcquireProxyForTaskResponse)(nil),         // 90: asset.AcquireProxyForTaskResponse
-	(*GetAvailableProxyRequest)(nil),            // 91: asset.GetAvailableProxyRequest
-	(*GetAvailableProxyResponse)(nil),           // 92: asset.GetAvailableProxyResponse
-	(*CheckProxySourceStatusRequest)(nil),       // 93: asset.CheckProxySourceStatusRequest
-	(*CheckProxySourceStatusResponse)(nil),      // 94: asset.CheckProxySourceStatusResponse
-	(*ReportProxyUsageRequest)(nil),             // 95: asset.ReportProxyUsageRequest
-	(*ReportProxyUsageResponse)(nil),            // 96: asset.ReportProxyUsageResponse
-	(*ReleaseProxyForTaskRequest)(nil),          // 97: asset.ReleaseProxyForTaskRequest
-	(*ReleaseProxyForTaskResponse)(nil),         // 98: asset.ReleaseProxyForTaskResponse
-	(*ListProxyUsageEventsRequest)(nil),         // 99: asset.ListProxyUsageEventsRequest
-	(*ProxyUsageEventItem)(nil),                 // 100: asset.ProxyUsageEventItem
-	(*ProxyUsageEventCount)(nil),                // 101: asset.ProxyUsageEventCount
-	(*ProxyUsageEventSummary)(nil),              // 102: asset.ProxyUsageEventSummary
-	(*ListProxyUsageEventsResponse)(nil),        // 103: asset.ListProxyUsageEventsResponse
-	(*GetProxySourcePolicyRequest)(nil),         // 104: asset.GetProxySourcePolicyRequest
-	(*GetProxySourcePolicyResponse)(nil),        // 105: asset.GetProxySourcePolicyResponse
-	(*UpdateProxySourcePolicyRequest)(nil),      // 106: asset.UpdateProxySourcePolicyRequest
-	(*UpdateProxySourcePolicyResponse)(nil),     // 107: asset.UpdateProxySourcePolicyResponse
-	(*ProxyInfo)(nil),                           // 108: asset.ProxyInfo
-	(*ListProxiesRequest)(nil),                  // 109: asset.ListProxiesRequest
-	(*ListProxiesResponse)(nil),                 // 110: asset.ListProxiesResponse
-	(*CreateProxyRequest)(nil),                  // 111: asset.CreateProxyRequest
-	(*CreateProxyResponse)(nil),                 // 112: asset.CreateProxyResponse
-	(*UpdateProxyRequest)(nil),                  // 113: asset.UpdateProxyRequest
-	(*UpdateProxyResponse)(nil),                 // 114: asset.UpdateProxyResponse
-	(*UpdateProxyStatusRequest)(nil),            // 115: asset.UpdateProxyStatusRequest
-	(*UpdateProxyStatusResponse)(nil),           // 116: asset.UpdateProxyStatusResponse
-	(*DeleteProxyRequest)(nil),                  // 117: asset.DeleteProxyRequest
-	(*DeleteProxyResponse)(nil),                 // 118: asset.DeleteProxyResponse
-	(*CookieInfo)(nil),                          // 119: asset.CookieInfo
-	(*CreateCookieRequest)(nil),                 // 120: asset.CreateCookieRequest
-	(*CreateCookieResponse)(nil),                // 121: asset.CreateCookieResponse
-	(*UpdateCookieRequest)(nil),                 // 122: asset.UpdateCookieRequest
-	(*UpdateCookieResponse)(nil),                // 123: asset.UpdateCookieResponse
-	(*DeleteCookieRequest)(nil),                 // 124: asset.DeleteCookieRequest
-	(*DeleteCookieResponse)(nil),                // 125: asset.DeleteCookieResponse
-	(*GetCookieRequest)(nil),                    // 126: asset.GetCookieRequest
-	(*GetCookieResponse)(nil),                   // 127: asset.GetCookieResponse
-	(*ListCookiesRequest)(nil),                  // 128: asset.ListCookiesRequest
-	(*ListCookiesResponse)(nil),                 // 129: asset.ListCookiesResponse
-	(*GetAvailableCookieRequest)(nil),           // 130: asset.GetAvailableCookieRequest
-	(*GetAvailableCookieResponse)(nil),          // 131: asset.GetAvailableCookieResponse
-	(*ReportCookieUsageRequest)(nil),            // 132: asset.ReportCookieUsageRequest
-	(*ReportCookieUsageResponse)(nil),           // 133: asset.ReportCookieUsageResponse
-	(*FreezeCookieRequest)(nil),                 // 134: asset.FreezeCookieRequest
-	(*FreezeCookieResponse)(nil),                // 135: asset.FreezeCookieResponse
+	(*AttachedFile)(nil),                        // 34: asset.AttachedFile
+	(*CreateHistoryRequest)(nil),                // 35: asset.CreateHistoryRequest
+	(*CreateHistoryResponse)(nil),               // 36: asset.CreateHistoryResponse
+	(*UpdateHistoryStatusRequest)(nil),          // 37: asset.UpdateHistoryStatusRequest
+	(*UpdateHistoryStatusResponse)(nil),         // 38: asset.UpdateHistoryStatusResponse
+	(*BillingAccountSnapshot)(nil),              // 39: asset.BillingAccountSnapshot
+	(*GetBillingAccountRequest)(nil),            // 40: asset.GetBillingAccountRequest
+	(*GetBillingAccountResponse)(nil),           // 41: asset.GetBillingAccountResponse
+	(*BillingStatementItem)(nil),                // 42: asset.BillingStatementItem
+	(*ListBillingStatementsRequest)(nil),        // 43: asset.ListBillingStatementsRequest
+	(*ListBillingStatementsResponse)(nil),       // 44: asset.ListBillingStatementsResponse
+	(*BillingSelectedFormat)(nil),               // 45: asset.BillingSelectedFormat
+	(*EstimateDownloadBillingRequest)(nil),      // 46: asset.EstimateDownloadBillingRequest
+	(*EstimateDownloadBillingResponse)(nil),     // 47: asset.EstimateDownloadBillingResponse
+	(*HoldInitialDownloadRequest)(nil),          // 48: asset.HoldInitialDownloadRequest
+	(*HoldInitialDownloadResponse)(nil),         // 49: asset.HoldInitialDownloadResponse
+	(*CaptureIngressUsageRequest)(nil),          // 50: asset.CaptureIngressUsageRequest
+	(*CaptureIngressUsageResponse)(nil),         // 51: asset.CaptureIngressUsageResponse
+	(*ReleaseInitialDownloadRequest)(nil),       // 52: asset.ReleaseInitialDownloadRequest
+	(*ReleaseInitialDownloadResponse)(nil),      // 53: asset.ReleaseInitialDownloadResponse
+	(*PrepareFileTransferBillingRequest)(nil),   // 54: asset.PrepareFileTransferBillingRequest
+	(*PrepareFileTransferBillingResponse)(nil),  // 55: asset.PrepareFileTransferBillingResponse
+	(*CompleteFileTransferBillingRequest)(nil),  // 56: asset.CompleteFileTransferBillingRequest
+	(*CompleteFileTransferBillingResponse)(nil), // 57: asset.CompleteFileTransferBillingResponse
+	(*AbortFileTransferBillingRequest)(nil),     // 58: asset.AbortFileTransferBillingRequest
+	(*AbortFileTransferBillingResponse)(nil),    // 59: asset.AbortFileTransferBillingResponse
+	(*ListBillingAccountsRequest)(nil),          // 60: asset.ListBillingAccountsRequest
+	(*ListBillingAccountsResponse)(nil),         // 61: asset.ListBillingAccountsResponse
+	(*GetBillingAccountDetailRequest)(nil),      // 62: asset.GetBillingAccountDetailRequest
+	(*GetBillingAccountDetailResponse)(nil),     // 63: asset.GetBillingAccountDetailResponse
+	(*AdjustBillingBalanceRequest)(nil),         // 64: asset.AdjustBillingBalanceRequest
+	(*AdjustBillingBalanceResponse)(nil),        // 65: asset.AdjustBillingBalanceResponse
+	(*LedgerEntryItem)(nil),                     // 66: asset.LedgerEntryItem
+	(*ListBillingLedgerRequest)(nil),            // 67: asset.ListBillingLedgerRequest
+	(*ListBillingLedgerResponse)(nil),           // 68: asset.ListBillingLedgerResponse
+	(*TrafficUsageRecordItem)(nil),              // 69: asset.TrafficUsageRecordItem
+	(*ListTrafficUsageRecordsRequest)(nil),      // 70: asset.ListTrafficUsageRecordsRequest
+	(*ListTrafficUsageRecordsResponse)(nil),     // 71: asset.ListTrafficUsageRecordsResponse
+	(*BillingPricing)(nil),                      // 72: asset.BillingPricing
+	(*GetBillingPricingRequest)(nil),            // 73: asset.GetBillingPricingRequest
+	(*GetBillingPricingResponse)(nil),           // 74: asset.GetBillingPricingResponse
+	(*UpdateBillingPricingRequest)(nil),         // 75: asset.UpdateBillingPricingRequest
+	(*UpdateBillingPricingResponse)(nil),        // 76: asset.UpdateBillingPricingResponse
+	(*WelcomeCreditSettings)(nil),               // 77: asset.WelcomeCreditSettings
+	(*GetWelcomeCreditSettingsRequest)(nil),     // 78: asset.GetWelcomeCreditSettingsRequest
+	(*GetWelcomeCreditSettingsResponse)(nil),    // 79: asset.GetWelcomeCreditSettingsResponse
+	(*UpdateWelcomeCreditSettingsRequest)(nil),  // 80: asset.UpdateWelcomeCreditSettingsRequest
+	(*UpdateWelcomeCreditSettingsResponse)(nil), // 81: asset.UpdateWelcomeCreditSettingsResponse
+	(*WelcomeCreditGrantSnapshot)(nil),          // 82: asset.WelcomeCreditGrantSnapshot
+	(*GrantWelcomeCreditRequest)(nil),           // 83: asset.GrantWelcomeCreditRequest
+	(*GrantWelcomeCreditResponse)(nil),          // 84: asset.GrantWelcomeCreditResponse
+	(*BillingShortfallOrderItem)(nil),           // 85: asset.BillingShortfallOrderItem
+	(*ListBillingShortfallsRequest)(nil),        // 86: asset.ListBillingShortfallsRequest
+	(*ListBillingShortfallsResponse)(nil),       // 87: asset.ListBillingShortfallsResponse
+	(*ReconcileBillingShortfallRequest)(nil),    // 88: asset.ReconcileBillingShortfallRequest
+	(*ReconcileBillingShortfallResponse)(nil),   // 89: asset.ReconcileBillingShortfallResponse
+	(*AcquireProxyForTaskRequest)(nil),          // 90: asset.AcquireProxyForTaskRequest
+	(*AcquireProxyForTaskResponse)(nil),         // 91: asset.AcquireProxyForTaskResponse
+	(*GetAvailableProxyRequest)(nil),            // 92: asset.GetAvailableProxyRequest
+	(*GetAvailableProxyResponse)(nil),           // 93: asset.GetAvailableProxyResponse
+	(*CheckProxySourceStatusRequest)(nil),       // 94: asset.CheckProxySourceStatusRequest
+	(*CheckProxySourceStatusResponse)(nil),      // 95: asset.CheckProxySourceStatusResponse
+	(*ReportProxyUsageRequest)(nil),             // 96: asset.ReportProxyUsageRequest
+	(*ReportProxyUsageResponse)(nil),            // 97: asset.ReportProxyUsageResponse
+	(*ReleaseProxyForTaskRequest)(nil),          // 98: asset.ReleaseProxyForTaskRequest
+	(*ReleaseProxyForTaskResponse)(nil),         // 99: asset.ReleaseProxyForTaskResponse
+	(*ListProxyUsageEventsRequest)(nil),         // 100: asset.ListProxyUsageEventsRequest
+	(*ProxyUsageEventItem)(nil),                 // 101: asset.ProxyUsageEventItem
+	(*ProxyUsageEventCount)(nil),                // 102: asset.ProxyUsageEventCount
+	(*ProxyUsageEventSummary)(nil),              // 103: asset.ProxyUsageEventSummary
+	(*ListProxyUsageEventsResponse)(nil),        // 104: asset.ListProxyUsageEventsResponse
+	(*GetProxySourcePolicyRequest)(nil),         // 105: asset.GetProxySourcePolicyRequest
+	(*GetProxySourcePolicyResponse)(nil),        // 106: asset.GetProxySourcePolicyResponse
+	(*UpdateProxySourcePolicyRequest)(nil),      // 107: asset.UpdateProxySourcePolicyRequest
+	(*UpdateProxySourcePolicyResponse)(nil),     // 108: asset.UpdateProxySourcePolicyResponse
+	(*ProxyInfo)(nil),                           // 109: asset.ProxyInfo
+	(*ListProxiesRequest)(nil),                  // 110: asset.ListProxiesRequest
+	(*ListProxiesResponse)(nil),                 // 111: asset.ListProxiesResponse
+	(*CreateProxyRequest)(nil),                  // 112: asset.CreateProxyRequest
+	(*CreateProxyResponse)(nil),                 // 113: asset.CreateProxyResponse
+	(*UpdateProxyRequest)(nil),                  // 114: asset.UpdateProxyRequest
+	(*UpdateProxyResponse)(nil),                 // 115: asset.UpdateProxyResponse
+	(*UpdateProxyStatusRequest)(nil),            // 116: asset.UpdateProxyStatusRequest
+	(*UpdateProxyStatusResponse)(nil),           // 117: asset.UpdateProxyStatusResponse
+	(*DeleteProxyRequest)(nil),                  // 118: asset.DeleteProxyRequest
+	(*DeleteProxyResponse)(nil),                 // 119: asset.DeleteProxyResponse
+	(*CookieInfo)(nil),                          // 120: asset.CookieInfo
+	(*CreateCookieRequest)(nil),                 // 121: asset.CreateCookieRequest
+	(*CreateCookieResponse)(nil),                // 122: asset.CreateCookieResponse
+	(*UpdateCookieRequest)(nil),                 // 123: asset.UpdateCookieRequest
+	(*UpdateCookieResponse)(nil),                // 124: asset.UpdateCookieResponse
+	(*DeleteCookieRequest)(nil),                 // 125: asset.DeleteCookieRequest
+	(*DeleteCookieResponse)(nil),                // 126: asset.DeleteCookieResponse
+	(*GetCookieRequest)(nil),                    // 127: asset.GetCookieRequest
+	(*GetCookieResponse)(nil),                   // 128: asset.GetCookieResponse
+	(*ListCookiesRequest)(nil),                  // 129: asset.ListCookiesRequest
+	(*ListCookiesResponse)(nil),                 // 130: asset.ListCookiesResponse
+	(*GetAvailableCookieRequest)(nil),           // 131: asset.GetAvailableCookieRequest
+	(*GetAvailableCookieResponse)(nil),          // 132: asset.GetAvailableCookieResponse
+	(*ReportCookieUsageRequest)(nil),            // 133: asset.ReportCookieUsageRequest
+	(*ReportCookieUsageResponse)(nil),           // 134: asset.ReportCookieUsageResponse
+	(*FreezeCookieRequest)(nil),                 // 135: asset.FreezeCookieRequest
+	(*FreezeCookieResponse)(nil),                // 136: asset.FreezeCookieResponse
+	(*WebhookEndpointInfo)(nil),                 // 137: asset.WebhookEndpointInfo
+	(*CreateWebhookRequest)(nil),                // 138: asset.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),               // 139: asset.CreateWebhookResponse
+	(*ListWebhooksRequest)(nil),                 // 140: asset.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),                // 141: asset.ListWebhooksResponse
+	(*UpdateWebhookRequest)(nil),                // 142: asset.UpdateWebhookRequest
+	(*UpdateWebhookResponse)(nil),               // 143: asset.UpdateWebhookResponse
+	(*DeleteWebhookRequest)(nil),                // 144: asset.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),               // 145: asset.DeleteWebhookResponse
+	(*WebhookDeliveryInfo)(nil),                 // 146: asset.WebhookDeliveryInfo
+	(*ListWebhookDeliveriesRequest)(nil),        // 147: asset.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),       // 148: asset.ListWebhookDeliveriesResponse
+	(*RedeliverWebhookRequest)(nil),             // 149: asset.RedeliverWebhookRequest
+	(*RedeliverWebhookResponse)(nil),            // 150: asset.RedeliverWebhookResponse
+	(*EmitWebhookEventRequest)(nil),             // 151: asset.EmitWebhookEventRequest
+	(*EmitWebhookEventResponse)(nil),            // 152: asset.EmitWebhookEventResponse
 }
 var file_proto_asset_proto_depIdxs = []int32{
 	2,   // 0: asset.GetHistoryResponse.items:type_name -> asset.HistoryItem
@@ -10759,146 +12212,165 @@ var file_proto_asset_proto_depIdxs = []int32{
 	28,  // 9: asset.GetDashboardHealthResponse.cookies:type_name -> asset.AssetDashboardCookies
 	29,  // 10: asset.GetDashboardHealthResponse.billing:type_name -> asset.AssetDashboardBilling
 	30,  // 11: asset.GetDashboardHealthResponse.users:type_name -> asset.AssetDashboardUsers
-	38,  // 12: asset.GetBillingAccountResponse.account:type_name -> asset.BillingAccountSnapshot
-	41,  // 13: asset.ListBillingStatementsResponse.items:type_name -> asset.BillingStatementItem
-	44,  // 14: asset.EstimateDownloadBillingRequest.selected_format:type_name -> asset.BillingSelectedFormat
-	38,  // 15: asset.ListBillingAccountsResponse.items:type_name -> asset.BillingAccountSnapshot
-	38,  // 16: asset.GetBillingAccountDetailResponse.account:type_name -> asset.BillingAccountSnapshot
-	38,  // 17: asset.AdjustBillingBalanceResponse.account:type_name -> asset.BillingAccountSnapshot
-	65,  // 18: asset.ListBillingLedgerResponse.items:type_name -> asset.LedgerEntryItem
-	68,  // 19: asset.ListTrafficUsageRecordsResponse.items:type_name -> asset.TrafficUsageRecordItem
-	71,  // 20: asset.GetBillingPricingResponse.pricing:type_name -> asset.BillingPricing
-	71,  // 21: asset.UpdateBillingPricingResponse.pricing:type_name -> asset.BillingPricing
-	76,  // 22: asset.GetWelcomeCreditSettingsResponse.settings:type_name -> asset.WelcomeCreditSettings
-	76,  // 23: asset.UpdateWelcomeCreditSettingsResponse.settings:type_name -> asset.WelcomeCreditSettings
-	38,  // 24: asset.GrantWelcomeCreditResponse.account:type_name -> asset.BillingAccountSnapshot
-	81,  // 25: asset.GrantWelcomeCreditResponse.grant:type_name -> asset.WelcomeCreditGrantSnapshot
-	84,  // 26: asset.ListBillingShortfallsResponse.items:type_name -> asset.BillingShortfallOrderItem
-	84,  // 27: asset.ReconcileBillingShortfallResponse.order:type_name -> asset.BillingShortfallOrderItem
-	38,  // 28: asset.ReconcileBillingShortfallResponse.account:type_name -> asset.BillingAccountSnapshot
-	101, // 29: asset.ProxyUsageEventSummary.category_counts:type_name -> asset.ProxyUsageEventCount
-	101, // 30: asset.ProxyUsageEventSummary.stage_counts:type_name -> asset.ProxyUsageEventCount
-	101, // 31: asset.ProxyUsageEventSummary.platform_counts:type_name -> asset.ProxyUsageEventCount
-	100, // 32: asset.ListProxyUsageEventsResponse.events:type_name -> asset.ProxyUsageEventItem
-	102, // 33: asset.ListProxyUsageEventsResponse.summary:type_name -> asset.ProxyUsageEventSummary
-	108, // 34: asset.ListProxiesResponse.items:type_name -> asset.ProxyInfo
-	119, // 35: asset.GetCookieResponse.cookie:type_name -> asset.CookieInfo
-	119, // 36: asset.ListCookiesResponse.items:type_name -> asset.CookieInfo
-	0,   // 37: asset.AssetService.GetHistory:input_type -> asset.GetHistoryRequest
-	3,   // 38: asset.AssetService.DeleteHistory:input_type -> asset.DeleteHistoryRequest
-	5,   // 39: asset.AssetService.GetHistoryByTask:input_type -> asset.GetHistoryByTaskRequest
-	7,   // 40: asset.AssetService.CheckQuota:input_type -> asset.CheckQuotaRequest
-	9,   // 41: asset.AssetService.ConsumeQuota:input_type -> asset.ConsumeQuotaRequest
-	11,  // 42: asset.AssetService.RefundQuota:input_type -> asset.RefundQuotaRequest
-	13,  // 43: asset.AssetService.GetUserStats:input_type -> asset.GetUserStatsRequest
-	17,  // 44: asset.AssetService.GetPlatformStats:input_type -> asset.GetPlatformStatsRequest
-	19,  // 45: asset.AssetService.GetRequestTrend:input_type -> asset.GetRequestTrendRequest
-	22,  // 46: asset.AssetService.GetDashboardHealth:input_type -> asset.GetDashboardHealthRequest
-	32,  // 47: asset.AssetService.GetFileInfo:input_type -> asset.GetFileInfoRequest
-	34,  // 48: asset.AssetService.CreateHistory:input_type -> asset.CreateHistoryRequest
-	36,  // 49: asset.AssetService.UpdateHistoryStatus:input_type -> asset.UpdateHistoryStatusRequest
-	39,  // 50: asset.AssetService.GetBillingAccount:input_type -> asset.GetBillingAccountRequest
-	42,  // 51: asset.AssetService.ListBillingStatements:input_type -> asset.ListBillingStatementsRequest
-	45,  // 52: asset.AssetService.EstimateDownloadBilling:input_type -> asset.EstimateDownloadBillingRequest
-	47,  // 53: asset.AssetService.HoldInitialDownload:input_type -> asset.HoldInitialDownloadRequest
-	49,  // 54: asset.AssetService.CaptureIngressUsage:input_type -> asset.CaptureIngressUsageRequest
-	51,  // 55: asset.AssetService.ReleaseInitialDownload:input_type -> asset.ReleaseInitialDownloadRequest
-	53,  // 56: asset.AssetService.PrepareFileTransferBilling:input_type -> asset.PrepareFileTransferBillingRequest
-	55,  // 57: asset.AssetService.CompleteFileTransferBilling:input_type -> asset.CompleteFileTransferBillingRequest
-	57,  // 58: asset.AssetService.AbortFileTransferBilling:input_type -> asset.AbortFileTransferBillingRequest
-	59,  // 59: asset.AssetService.ListBillingAccounts:input_type -> asset.ListBillingAccountsRequest
-	61,  // 60: asset.AssetService.GetBillingAccountDetail:input_type -> asset.GetBillingAccountDetailRequest
-	63,  // 61: asset.AssetService.AdjustBillingBalance:input_type -> asset.AdjustBillingBalanceRequest
-	66,  // 62: asset.AssetService.ListBillingLedger:input_type -> asset.ListBillingLedgerRequest
-	69,  // 63: asset.AssetService.ListTrafficUsageRecords:input_type -> asset.ListTrafficUsageRecordsRequest
-	72,  // 64: asset.AssetService.GetBillingPricing:input_type -> asset.GetBillingPricingRequest
-	74,  // 65: asset.AssetService.UpdateBillingPricing:input_type -> asset.UpdateBillingPricingRequest
-	77,  // 66: asset.AssetService.GetWelcomeCreditSettings:input_type -> asset.GetWelcomeCreditSettingsRequest
-	79,  // 67: asset.AssetService.UpdateWelcomeCreditSettings:input_type -> asset.UpdateWelcomeCreditSettingsRequest
-	82,  // 68: asset.AssetService.GrantWelcomeCredit:input_type -> asset.GrantWelcomeCreditRequest
-	85,  // 69: asset.AssetService.ListBillingShortfalls:input_type -> asset.ListBillingShortfallsRequest
-	87,  // 70: asset.AssetService.ReconcileBillingShortfall:input_type -> asset.ReconcileBillingShortfallRequest
-	89,  // 71: asset.AssetService.AcquireProxyForTask:input_type -> asset.AcquireProxyForTaskRequest
-	91,  // 72: asset.AssetService.GetAvailableProxy:input_type -> asset.GetAvailableProxyRequest
-	93,  // 73: asset.AssetService.CheckProxySourceStatus:input_type -> asset.CheckProxySourceStatusRequest
-	95,  // 74: asset.AssetService.ReportProxyUsage:input_type -> asset.ReportProxyUsageRequest
-	97,  // 75: asset.AssetService.ReleaseProxyForTask:input_type -> asset.ReleaseProxyForTaskRequest
-	99,  // 76: asset.AssetService.ListProxyUsageEvents:input_type -> asset.ListProxyUsageEventsRequest
-	104, // 77: asset.AssetService.GetProxySourcePolicy:input_type -> asset.GetProxySourcePolicyRequest
-	106, // 78: asset.AssetService.UpdateProxySourcePolicy:input_type -> asset.UpdateProxySourcePolicyRequest
-	109, // 79: asset.AssetService.ListProxies:input_type -> asset.ListProxiesRequest
-	111, // 80: asset.AssetService.CreateProxy:input_type -> asset.CreateProxyRequest
-	113, // 81: asset.AssetService.UpdateProxy:input_type -> asset.UpdateProxyRequest
-	115, // 82: asset.AssetService.UpdateProxyStatus:input_type -> asset.UpdateProxyStatusRequest
-	117, // 83: asset.AssetService.DeleteProxy:input_type -> asset.DeleteProxyRequest
-	120, // 84: asset.AssetService.CreateCookie:input_type -> asset.CreateCookieRequest
-	122, // 85: asset.AssetService.UpdateCookie:input_type -> asset.UpdateCookieRequest
-	124, // 86: asset.AssetService.DeleteCookie:input_type -> asset.DeleteCookieRequest
-	126, // 87: asset.AssetService.GetCookie:input_type -> asset.GetCookieRequest
-	128, // 88: asset.AssetService.ListCookies:input_type -> asset.ListCookiesRequest
-	130, // 89: asset.AssetService.GetAvailableCookie:input_type -> asset.GetAvailableCookieRequest
-	132, // 90: asset.AssetService.ReportCookieUsage:input_type -> asset.ReportCookieUsageRequest
-	134, // 91: asset.AssetService.FreezeCookie:input_type -> asset.FreezeCookieRequest
-	1,   // 92: asset.AssetService.GetHistory:output_type -> asset.GetHistoryResponse
-	4,   // 93: asset.AssetService.DeleteHistory:output_type -> asset.DeleteHistoryResponse
-	6,   // 94: asset.AssetService.GetHistoryByTask:output_type -> asset.GetHistoryByTaskResponse
-	8,   // 95: asset.AssetService.CheckQuota:output_type -> asset.CheckQuotaResponse
-	10,  // 96: asset.AssetService.ConsumeQuota:output_type -> asset.ConsumeQuotaResponse
-	12,  // 97: asset.AssetService.RefundQuota:output_type -> asset.RefundQuotaResponse
-	14,  // 98: asset.AssetService.GetUserStats:output_type -> asset.GetUserStatsResponse
-	18,  // 99: asset.AssetService.GetPlatformStats:output_type -> asset.GetPlatformStatsResponse
-	21,  // 100: asset.AssetService.GetRequestTrend:output_type -> asset.GetRequestTrendResponse
-	31,  // 101: asset.AssetService.GetDashboardHealth:output_type -> asset.GetDashboardHealthResponse
-	33,  // 102: asset.AssetService.GetFileInfo:output_type -> asset.GetFileInfoResponse
-	35,  // 103: asset.AssetService.CreateHistory:output_type -> asset.CreateHistoryResponse
-	37,  // 104: asset.AssetService.UpdateHistoryStatus:output_type -> asset.UpdateHistoryStatusResponse
-	40,  // 105: asset.AssetService.GetBillingAccount:output_type -> asset.GetBillingAccountResponse
-	43,  // 106: asset.AssetService.ListBillingStatements:output_type -> asset.ListBillingStatementsResponse
-	46,  // 107: asset.AssetService.EstimateDownloadBilling:output_type -> asset.EstimateDownloadBillingResponse
-	48,  // 108: asset.AssetService.HoldInitialDownload:output_type -> asset.HoldInitialDownloadResponse
-	50,  // 109: asset.AssetService.CaptureIngressUsage:output_type -> asset.CaptureIngressUsageResponse
-	52,  // 110: asset.AssetService.ReleaseInitialDownload:output_type -> asset.ReleaseInitialDownloadResponse
-	54,  // 111: asset.AssetService.PrepareFileTransferBilling:output_type -> asset.PrepareFileTransferBillingResponse
-	56,  // 112: asset.AssetService.CompleteFileTransferBilling:output_type -> asset.CompleteFileTransferBillingResponse
-	58,  // 113: asset.AssetService.AbortFileTransferBilling:output_type -> asset.AbortFileTransferBillingResponse
-	60,  // 114: asset.AssetService.ListBillingAccounts:output_type -> asset.ListBillingAccountsResponse
-	62,  // 115: asset.AssetService.GetBillingAccountDetail:output_type -> asset.GetBillingAccountDetailResponse
-	64,  // 116: asset.AssetService.AdjustBillingBalance:output_type -> asset.AdjustBillingBalanceResponse
-	67,  // 117: asset.AssetService.ListBillingLedger:output_type -> asset.ListBillingLedgerResponse
-	70,  // 118: asset.AssetService.ListTrafficUsageRecords:output_type -> asset.ListTrafficUsageRecordsResponse
-	73,  // 119: asset.AssetService.GetBillingPricing:output_type -> asset.GetBillingPricingResponse
-	75,  // 120: asset.AssetService.UpdateBillingPricing:output_type -> asset.UpdateBillingPricingResponse
-	78,  // 121: asset.AssetService.GetWelcomeCreditSettings:output_type -> asset.GetWelcomeCreditSettingsResponse
-	80,  // 122: asset.AssetService.UpdateWelcomeCreditSettings:output_type -> asset.UpdateWelcomeCreditSettingsResponse
-	83,  // 123: asset.AssetService.GrantWelcomeCredit:output_type -> asset.GrantWelcomeCreditResponse
-	86,  // 124: asset.AssetService.ListBillingShortfalls:output_type -> asset.ListBillingShortfallsResponse
-	88,  // 125: asset.AssetService.ReconcileBillingShortfall:output_type -> asset.ReconcileBillingShortfallResponse
-	90,  // 126: asset.AssetService.AcquireProxyForTask:output_type -> asset.AcquireProxyForTaskResponse
-	92,  // 127: asset.AssetService.GetAvailableProxy:output_type -> asset.GetAvailableProxyResponse
-	94,  // 128: asset.AssetService.CheckProxySourceStatus:output_type -> asset.CheckProxySourceStatusResponse
-	96,  // 129: asset.AssetService.ReportProxyUsage:output_type -> asset.ReportProxyUsageResponse
-	98,  // 130: asset.AssetService.ReleaseProxyForTask:output_type -> asset.ReleaseProxyForTaskResponse
-	103, // 131: asset.AssetService.ListProxyUsageEvents:output_type -> asset.ListProxyUsageEventsResponse
-	105, // 132: asset.AssetService.GetProxySourcePolicy:output_type -> asset.GetProxySourcePolicyResponse
-	107, // 133: asset.AssetService.UpdateProxySourcePolicy:output_type -> asset.UpdateProxySourcePolicyResponse
-	110, // 134: asset.AssetService.ListProxies:output_type -> asset.ListProxiesResponse
-	112, // 135: asset.AssetService.CreateProxy:output_type -> asset.CreateProxyResponse
-	114, // 136: asset.AssetService.UpdateProxy:output_type -> asset.UpdateProxyResponse
-	116, // 137: asset.AssetService.UpdateProxyStatus:output_type -> asset.UpdateProxyStatusResponse
-	118, // 138: asset.AssetService.DeleteProxy:output_type -> asset.DeleteProxyResponse
-	121, // 139: asset.AssetService.CreateCookie:output_type -> asset.CreateCookieResponse
-	123, // 140: asset.AssetService.UpdateCookie:output_type -> asset.UpdateCookieResponse
-	125, // 141: asset.AssetService.DeleteCookie:output_type -> asset.DeleteCookieResponse
-	127, // 142: asset.AssetService.GetCookie:output_type -> asset.GetCookieResponse
-	129, // 143: asset.AssetService.ListCookies:output_type -> asset.ListCookiesResponse
-	131, // 144: asset.AssetService.GetAvailableCookie:output_type -> asset.GetAvailableCookieResponse
-	133, // 145: asset.AssetService.ReportCookieUsage:output_type -> asset.ReportCookieUsageResponse
-	135, // 146: asset.AssetService.FreezeCookie:output_type -> asset.FreezeCookieResponse
-	92,  // [92:147] is the sub-list for method output_type
-	37,  // [37:92] is the sub-list for method input_type
-	37,  // [37:37] is the sub-list for extension type_name
-	37,  // [37:37] is the sub-list for extension extendee
-	0,   // [0:37] is the sub-list for field type_name
+	34,  // 12: asset.GetFileInfoResponse.attachments:type_name -> asset.AttachedFile
+	39,  // 13: asset.GetBillingAccountResponse.account:type_name -> asset.BillingAccountSnapshot
+	42,  // 14: asset.ListBillingStatementsResponse.items:type_name -> asset.BillingStatementItem
+	45,  // 15: asset.EstimateDownloadBillingRequest.selected_format:type_name -> asset.BillingSelectedFormat
+	39,  // 16: asset.ListBillingAccountsResponse.items:type_name -> asset.BillingAccountSnapshot
+	39,  // 17: asset.GetBillingAccountDetailResponse.account:type_name -> asset.BillingAccountSnapshot
+	39,  // 18: asset.AdjustBillingBalanceResponse.account:type_name -> asset.BillingAccountSnapshot
+	66,  // 19: asset.ListBillingLedgerResponse.items:type_name -> asset.LedgerEntryItem
+	69,  // 20: asset.ListTrafficUsageRecordsResponse.items:type_name -> asset.TrafficUsageRecordItem
+	72,  // 21: asset.GetBillingPricingResponse.pricing:type_name -> asset.BillingPricing
+	72,  // 22: asset.UpdateBillingPricingResponse.pricing:type_name -> asset.BillingPricing
+	77,  // 23: asset.GetWelcomeCreditSettingsResponse.settings:type_name -> asset.WelcomeCreditSettings
+	77,  // 24: asset.UpdateWelcomeCreditSettingsResponse.settings:type_name -> asset.WelcomeCreditSettings
+	39,  // 25: asset.GrantWelcomeCreditResponse.account:type_name -> asset.BillingAccountSnapshot
+	82,  // 26: asset.GrantWelcomeCreditResponse.grant:type_name -> asset.WelcomeCreditGrantSnapshot
+	85,  // 27: asset.ListBillingShortfallsResponse.items:type_name -> asset.BillingShortfallOrderItem
+	85,  // 28: asset.ReconcileBillingShortfallResponse.order:type_name -> asset.BillingShortfallOrderItem
+	39,  // 29: asset.ReconcileBillingShortfallResponse.account:type_name -> asset.BillingAccountSnapshot
+	102, // 30: asset.ProxyUsageEventSummary.category_counts:type_name -> asset.ProxyUsageEventCount
+	102, // 31: asset.ProxyUsageEventSummary.stage_counts:type_name -> asset.ProxyUsageEventCount
+	102, // 32: asset.ProxyUsageEventSummary.platform_counts:type_name -> asset.ProxyUsageEventCount
+	101, // 33: asset.ListProxyUsageEventsResponse.events:type_name -> asset.ProxyUsageEventItem
+	103, // 34: asset.ListProxyUsageEventsResponse.summary:type_name -> asset.ProxyUsageEventSummary
+	109, // 35: asset.ListProxiesResponse.items:type_name -> asset.ProxyInfo
+	120, // 36: asset.GetCookieResponse.cookie:type_name -> asset.CookieInfo
+	120, // 37: asset.ListCookiesResponse.items:type_name -> asset.CookieInfo
+	137, // 38: asset.CreateWebhookResponse.endpoint:type_name -> asset.WebhookEndpointInfo
+	137, // 39: asset.ListWebhooksResponse.items:type_name -> asset.WebhookEndpointInfo
+	137, // 40: asset.UpdateWebhookResponse.endpoint:type_name -> asset.WebhookEndpointInfo
+	146, // 41: asset.ListWebhookDeliveriesResponse.items:type_name -> asset.WebhookDeliveryInfo
+	0,   // 42: asset.AssetService.GetHistory:input_type -> asset.GetHistoryRequest
+	3,   // 43: asset.AssetService.DeleteHistory:input_type -> asset.DeleteHistoryRequest
+	5,   // 44: asset.AssetService.GetHistoryByTask:input_type -> asset.GetHistoryByTaskRequest
+	7,   // 45: asset.AssetService.CheckQuota:input_type -> asset.CheckQuotaRequest
+	9,   // 46: asset.AssetService.ConsumeQuota:input_type -> asset.ConsumeQuotaRequest
+	11,  // 47: asset.AssetService.RefundQuota:input_type -> asset.RefundQuotaRequest
+	13,  // 48: asset.AssetService.GetUserStats:input_type -> asset.GetUserStatsRequest
+	17,  // 49: asset.AssetService.GetPlatformStats:input_type -> asset.GetPlatformStatsRequest
+	19,  // 50: asset.AssetService.GetRequestTrend:input_type -> asset.GetRequestTrendRequest
+	22,  // 51: asset.AssetService.GetDashboardHealth:input_type -> asset.GetDashboardHealthRequest
+	32,  // 52: asset.AssetService.GetFileInfo:input_type -> asset.GetFileInfoRequest
+	35,  // 53: asset.AssetService.CreateHistory:input_type -> asset.CreateHistoryRequest
+	37,  // 54: asset.AssetService.UpdateHistoryStatus:input_type -> asset.UpdateHistoryStatusRequest
+	40,  // 55: asset.AssetService.GetBillingAccount:input_type -> asset.GetBillingAccountRequest
+	43,  // 56: asset.AssetService.ListBillingStatements:input_type -> asset.ListBillingStatementsRequest
+	46,  // 57: asset.AssetService.EstimateDownloadBilling:input_type -> asset.EstimateDownloadBillingRequest
+	48,  // 58: asset.AssetService.HoldInitialDownload:input_type -> asset.HoldInitialDownloadRequest
+	50,  // 59: asset.AssetService.CaptureIngressUsage:input_type -> asset.CaptureIngressUsageRequest
+	52,  // 60: asset.AssetService.ReleaseInitialDownload:input_type -> asset.ReleaseInitialDownloadRequest
+	54,  // 61: asset.AssetService.PrepareFileTransferBilling:input_type -> asset.PrepareFileTransferBillingRequest
+	56,  // 62: asset.AssetService.CompleteFileTransferBilling:input_type -> asset.CompleteFileTransferBillingRequest
+	58,  // 63: asset.AssetService.AbortFileTransferBilling:input_type -> asset.AbortFileTransferBillingRequest
+	60,  // 64: asset.AssetService.ListBillingAccounts:input_type -> asset.ListBillingAccountsRequest
+	62,  // 65: asset.AssetService.GetBillingAccountDetail:input_type -> asset.GetBillingAccountDetailRequest
+	64,  // 66: asset.AssetService.AdjustBillingBalance:input_type -> asset.AdjustBillingBalanceRequest
+	67,  // 67: asset.AssetService.ListBillingLedger:input_type -> asset.ListBillingLedgerRequest
+	70,  // 68: asset.AssetService.ListTrafficUsageRecords:input_type -> asset.ListTrafficUsageRecordsRequest
+	73,  // 69: asset.AssetService.GetBillingPricing:input_type -> asset.GetBillingPricingRequest
+	75,  // 70: asset.AssetService.UpdateBillingPricing:input_type -> asset.UpdateBillingPricingRequest
+	78,  // 71: asset.AssetService.GetWelcomeCreditSettings:input_type -> asset.GetWelcomeCreditSettingsRequest
+	80,  // 72: asset.AssetService.UpdateWelcomeCreditSettings:input_type -> asset.UpdateWelcomeCreditSettingsRequest
+	83,  // 73: asset.AssetService.GrantWelcomeCredit:input_type -> asset.GrantWelcomeCreditRequest
+	86,  // 74: asset.AssetService.ListBillingShortfalls:input_type -> asset.ListBillingShortfallsRequest
+	88,  // 75: asset.AssetService.ReconcileBillingShortfall:input_type -> asset.ReconcileBillingShortfallRequest
+	90,  // 76: asset.AssetService.AcquireProxyForTask:input_type -> asset.AcquireProxyForTaskRequest
+	92,  // 77: asset.AssetService.GetAvailableProxy:input_type -> asset.GetAvailableProxyRequest
+	94,  // 78: asset.AssetService.CheckProxySourceStatus:input_type -> asset.CheckProxySourceStatusRequest
+	96,  // 79: asset.AssetService.ReportProxyUsage:input_type -> asset.ReportProxyUsageRequest
+	98,  // 80: asset.AssetService.ReleaseProxyForTask:input_type -> asset.ReleaseProxyForTaskRequest
+	100, // 81: asset.AssetService.ListProxyUsageEvents:input_type -> asset.ListProxyUsageEventsRequest
+	105, // 82: asset.AssetService.GetProxySourcePolicy:input_type -> asset.GetProxySourcePolicyRequest
+	107, // 83: asset.AssetService.UpdateProxySourcePolicy:input_type -> asset.UpdateProxySourcePolicyRequest
+	110, // 84: asset.AssetService.ListProxies:input_type -> asset.ListProxiesRequest
+	112, // 85: asset.AssetService.CreateProxy:input_type -> asset.CreateProxyRequest
+	114, // 86: asset.AssetService.UpdateProxy:input_type -> asset.UpdateProxyRequest
+	116, // 87: asset.AssetService.UpdateProxyStatus:input_type -> asset.UpdateProxyStatusRequest
+	118, // 88: asset.AssetService.DeleteProxy:input_type -> asset.DeleteProxyRequest
+	121, // 89: asset.AssetService.CreateCookie:input_type -> asset.CreateCookieRequest
+	123, // 90: asset.AssetService.UpdateCookie:input_type -> asset.UpdateCookieRequest
+	125, // 91: asset.AssetService.DeleteCookie:input_type -> asset.DeleteCookieRequest
+	127, // 92: asset.AssetService.GetCookie:input_type -> asset.GetCookieRequest
+	129, // 93: asset.AssetService.ListCookies:input_type -> asset.ListCookiesRequest
+	131, // 94: asset.AssetService.GetAvailableCookie:input_type -> asset.GetAvailableCookieRequest
+	133, // 95: asset.AssetService.ReportCookieUsage:input_type -> asset.ReportCookieUsageRequest
+	135, // 96: asset.AssetService.FreezeCookie:input_type -> asset.FreezeCookieRequest
+	138, // 97: asset.AssetService.CreateWebhook:input_type -> asset.CreateWebhookRequest
+	140, // 98: asset.AssetService.ListWebhooks:input_type -> asset.ListWebhooksRequest
+	142, // 99: asset.AssetService.UpdateWebhook:input_type -> asset.UpdateWebhookRequest
+	144, // 100: asset.AssetService.DeleteWebhook:input_type -> asset.DeleteWebhookRequest
+	147, // 101: asset.AssetService.ListWebhookDeliveries:input_type -> asset.ListWebhookDeliveriesRequest
+	149, // 102: asset.AssetService.RedeliverWebhook:input_type -> asset.RedeliverWebhookRequest
+	151, // 103: asset.AssetService.EmitWebhookEvent:input_type -> asset.EmitWebhookEventRequest
+	1,   // 104: asset.AssetService.GetHistory:output_type -> asset.GetHistoryResponse
+	4,   // 105: asset.AssetService.DeleteHistory:output_type -> asset.DeleteHistoryResponse
+	6,   // 106: asset.AssetService.GetHistoryByTask:output_type -> asset.GetHistoryByTaskResponse
+	8,   // 107: asset.AssetService.CheckQuota:output_type -> asset.CheckQuotaResponse
+	10,  // 108: asset.AssetService.ConsumeQuota:output_type -> asset.ConsumeQuotaResponse
+	12,  // 109: asset.AssetService.RefundQuota:output_type -> asset.RefundQuotaResponse
+	14,  // 110: asset.AssetService.GetUserStats:output_type -> asset.GetUserStatsResponse
+	18,  // 111: asset.AssetService.GetPlatformStats:output_type -> asset.GetPlatformStatsResponse
+	21,  // 112: asset.AssetService.GetRequestTrend:output_type -> asset.GetRequestTrendResponse
+	31,  // 113: asset.AssetService.GetDashboardHealth:output_type -> asset.GetDashboardHealthResponse
+	33,  // 114: asset.AssetService.GetFileInfo:output_type -> asset.GetFileInfoResponse
+	36,  // 115: asset.AssetService.CreateHistory:output_type -> asset.CreateHistoryResponse
+	38,  // 116: asset.AssetService.UpdateHistoryStatus:output_type -> asset.UpdateHistoryStatusResponse
+	41,  // 117: asset.AssetService.GetBillingAccount:output_type -> asset.GetBillingAccountResponse
+	44,  // 118: asset.AssetService.ListBillingStatements:output_type -> asset.ListBillingStatementsResponse
+	47,  // 119: asset.AssetService.EstimateDownloadBilling:output_type -> asset.EstimateDownloadBillingResponse
+	49,  // 120: asset.AssetService.HoldInitialDownload:output_type -> asset.HoldInitialDownloadResponse
+	51,  // 121: asset.AssetService.CaptureIngressUsage:output_type -> asset.CaptureIngressUsageResponse
+	53,  // 122: asset.AssetService.ReleaseInitialDownload:output_type -> asset.ReleaseInitialDownloadResponse
+	55,  // 123: asset.AssetService.PrepareFileTransferBilling:output_type -> asset.PrepareFileTransferBillingResponse
+	57,  // 124: asset.AssetService.CompleteFileTransferBilling:output_type -> asset.CompleteFileTransferBillingResponse
+	59,  // 125: asset.AssetService.AbortFileTransferBilling:output_type -> asset.AbortFileTransferBillingResponse
+	61,  // 126: asset.AssetService.ListBillingAccounts:output_type -> asset.ListBillingAccountsResponse
+	63,  // 127: asset.AssetService.GetBillingAccountDetail:output_type -> asset.GetBillingAccountDetailResponse
+	65,  // 128: asset.AssetService.AdjustBillingBalance:output_type -> asset.AdjustBillingBalanceResponse
+	68,  // 129: asset.AssetService.ListBillingLedger:output_type -> asset.ListBillingLedgerResponse
+	71,  // 130: asset.AssetService.ListTrafficUsageRecords:output_type -> asset.ListTrafficUsageRecordsResponse
+	74,  // 131: asset.AssetService.GetBillingPricing:output_type -> asset.GetBillingPricingResponse
+	76,  // 132: asset.AssetService.UpdateBillingPricing:output_type -> asset.UpdateBillingPricingResponse
+	79,  // 133: asset.AssetService.GetWelcomeCreditSettings:output_type -> asset.GetWelcomeCreditSettingsResponse
+	81,  // 134: asset.AssetService.UpdateWelcomeCreditSettings:output_type -> asset.UpdateWelcomeCreditSettingsResponse
+	84,  // 135: asset.AssetService.GrantWelcomeCredit:output_type -> asset.GrantWelcomeCreditResponse
+	87,  // 136: asset.AssetService.ListBillingShortfalls:output_type -> asset.ListBillingShortfallsResponse
+	89,  // 137: asset.AssetService.ReconcileBillingShortfall:output_type -> asset.ReconcileBillingShortfallResponse
+	91,  // 138: asset.AssetService.AcquireProxyForTask:output_type -> asset.AcquireProxyForTaskResponse
+	93,  // 139: asset.AssetService.GetAvailableProxy:output_type -> asset.GetAvailableProxyResponse
+	95,  // 140: asset.AssetService.CheckProxySourceStatus:output_type -> asset.CheckProxySourceStatusResponse
+	97,  // 141: asset.AssetService.ReportProxyUsage:output_type -> asset.ReportProxyUsageResponse
+	99,  // 142: asset.AssetService.ReleaseProxyForTask:output_type -> asset.ReleaseProxyForTaskResponse
+	104, // 143: asset.AssetService.ListProxyUsageEvents:output_type -> asset.ListProxyUsageEventsResponse
+	106, // 144: asset.AssetService.GetProxySourcePolicy:output_type -> asset.GetProxySourcePolicyResponse
+	108, // 145: asset.AssetService.UpdateProxySourcePolicy:output_type -> asset.UpdateProxySourcePolicyResponse
+	111, // 146: asset.AssetService.ListProxies:output_type -> asset.ListProxiesResponse
+	113, // 147: asset.AssetService.CreateProxy:output_type -> asset.CreateProxyResponse
+	115, // 148: asset.AssetService.UpdateProxy:output_type -> asset.UpdateProxyResponse
+	117, // 149: asset.AssetService.UpdateProxyStatus:output_type -> asset.UpdateProxyStatusResponse
+	119, // 150: asset.AssetService.DeleteProxy:output_type -> asset.DeleteProxyResponse
+	122, // 151: asset.AssetService.CreateCookie:output_type -> asset.CreateCookieResponse
+	124, // 152: asset.AssetService.UpdateCookie:output_type -> asset.UpdateCookieResponse
+	126, // 153: asset.AssetService.DeleteCookie:output_type -> asset.DeleteCookieResponse
+	128, // 154: asset.AssetService.GetCookie:output_type -> asset.GetCookieResponse
+	130, // 155: asset.AssetService.ListCookies:output_type -> asset.ListCookiesResponse
+	132, // 156: asset.AssetService.GetAvailableCookie:output_type -> asset.GetAvailableCookieResponse
+	134, // 157: asset.AssetService.ReportCookieUsage:output_type -> asset.ReportCookieUsageResponse
+	136, // 158: asset.AssetService.FreezeCookie:output_type -> asset.FreezeCookieResponse
+	139, // 159: asset.AssetService.CreateWebhook:output_type -> asset.CreateWebhookResponse
+	141, // 160: asset.AssetService.ListWebhooks:output_type -> asset.ListWebhooksResponse
+	143, // 161: asset.AssetService.UpdateWebhook:output_type -> asset.UpdateWebhookResponse
+	145, // 162: asset.AssetService.DeleteWebhook:output_type -> asset.DeleteWebhookResponse
+	148, // 163: asset.AssetService.ListWebhookDeliveries:output_type -> asset.ListWebhookDeliveriesResponse
+	150, // 164: asset.AssetService.RedeliverWebhook:output_type -> asset.RedeliverWebhookResponse
+	152, // 165: asset.AssetService.EmitWebhookEvent:output_type -> asset.EmitWebhookEventResponse
+	104, // [104:166] is the sub-list for method output_type
+	42,  // [42:104] is the sub-list for method input_type
+	42,  // [42:42] is the sub-list for extension type_name
+	42,  // [42:42] is the sub-list for extension extendee
+	0,   // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_proto_asset_proto_init() }
@@ -10912,7 +12384,7 @@ func file_proto_asset_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_asset_proto_rawDesc), len(file_proto_asset_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   136,
+			NumMessages:   153,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
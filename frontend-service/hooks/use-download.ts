@@ -57,6 +57,14 @@ export function useDownload() {
 
     // Handle progress update
     const handleProgress = React.useCallback((data: ProgressData) => {
+        // Media service restarted mid-download; the task resumes on another worker
+        if (data.status === "requeued") {
+            setSpeed("0 MB/s")
+            setTimeLeft("")
+            setPhaseLabel("Service restarting, task requeued...")
+            return
+        }
+
         setProgress(data.percent)
         setSpeed(data.speed || "0 MB/s")
         setTimeLeft(data.eta || "")
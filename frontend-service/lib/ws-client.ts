@@ -3,7 +3,7 @@ import { resolveWsBaseUrl } from './runtime-config';
 
 export interface ProgressData {
     task_id: string;
-    status: number | string;   // 0=待处理, 1=下载中, 2=完成, 3=失败 或 "pending"/"downloading"/"completed"/"failed"
+    status: number | string;   // 0=待处理, 1=下载中, 2=完成, 3=失败 或 "pending"/"downloading"/"completed"/"failed"/"requeued"
     status_text: string;
     percent: number;
     phase?: string;            // downloading_video, downloading_audio, downloading, merging, processing
@@ -22,6 +22,7 @@ import (
 	dlclient "youdlp/media-service/internal/download/client"
 	dlconfig "youdlp/media-service/internal/download/config"
 	dldatabase "youdlp/media-service/internal/download/database"
+	dlpostprocess "youdlp/media-service/internal/download/postprocess"
 	dlrepo "youdlp/media-service/internal/download/repository"
 	dlscheduler "youdlp/media-service/internal/download/scheduler"
 	dlstorage "youdlp/media-service/internal/download/storage"
@@ -29,6 +30,7 @@ import (
 	dlytdlp "youdlp/media-service/internal/download/ytdlp"
 	"youdlp/media-service/internal/handler"
 	"youdlp/media-service/internal/observability"
+	"youdlp/media-service/internal/platform"
 	"youdlp/media-service/internal/ratelimit"
 	"youdlp/media-service/internal/service"
 	pb "youdlp/media-service/proto"
@@ -76,14 +78,36 @@ func main() {
 	defer db.Close()
 
 	downloadRepo := dlrepo.NewDownloadRepository(db)
-	executor := dlytdlp.NewExecutor(&downloadCfg.YtDLP)
+
+	// 平台注册表：解析与下载共用，管理端的启用状态持久化在数据库并定期同步
+	platforms, err := platform.NewRegistry(parseCfg.Platforms)
+	if err != nil {
+		logger.Fatal("Failed to build platform registry", zap.Error(err))
+	}
+	platformController := platform.NewController(platforms, downloadRepo, parseCfg.GetPlatformSyncInterval())
+	go platformController.Start(appCtx)
+	logger.Info("✓ Platform registry loaded", zap.Int("platforms", len(platforms.List())))
+
+	executor := dlytdlp.NewExecutor(&downloadCfg.YtDLP, platforms)
+	postProcessor := dlpostprocess.NewPipeline(&downloadCfg.PostProcess)
 	pathGenerator := dlstorage.NewPathGenerator(&downloadCfg.Storage)
 	fileManager := dlstorage.NewFileManager(downloadCfg.Storage.BasePath)
 	if err := fileManager.EnsureDir(downloadCfg.Storage.BasePath); err != nil {
 		logger.Fatal("Failed to create storage directory", zap.Error(err))
 	}
+	fileStore, err := dlstorage.NewStorage(&downloadCfg.Storage)
+	if err != nil {
+		logger.Fatal("Failed to initialize storage backend", zap.Error(err))
+	}
+	logger.Info("✓ Storage backend initialized", zap.String("backend", downloadCfg.Storage.Backend))
 	progressPublisher := dlworker.NewProgressPublisher(redisClient)
 	platformLimiter := ratelimit.NewPlatformLimiter(redisClient)
+	platformLimiter.SetLimits(platforms)
+	heartbeats := dlworker.NewHeartbeats(redisClient, &downloadCfg.Reaper)
+	platformPauses := dlworker.NewPlatformPauses(downloadRepo, &downloadCfg.Partitions)
+	if platformPauses != nil {
+		go platformPauses.Start(appCtx)
+	}
 
 	var assetClient *dlclient.AssetClient
 	if downloadCfg.AssetService.Addr != "" {
@@ -106,17 +130,26 @@ func main() {
 		&downloadCfg.Retry,
 		downloadRepo,
 		executor,
+		postProcessor,
 		pathGenerator,
 		fileManager,
+		fileStore,
 		progressPublisher,
 		assetClient,
 		downloadCfg.YtDLP.YouTube,
+		platforms,
 		platformLimiter,
+		heartbeats,
+		dlworker.NewFairScheduler(&downloadCfg.Scheduler, redisClient),
+		platformPauses,
 	)
 	workerPool.Start()
 
+	cancelBus := dlworker.NewCancelBus(redisClient)
+	go cancelBus.Listen(appCtx, workerPool)
+
 	var taskConsumer *dlworker.TaskConsumer
-	taskConsumer, err = dlworker.NewTaskConsumer(&downloadCfg.RabbitMQ, workerPool)
+	taskConsumer, err = dlworker.NewTaskConsumer(&downloadCfg.RabbitMQ, &downloadCfg.Partitions, workerPool)
 	if err != nil {
 		log.Printf("Warning: Failed to connect to RabbitMQ: %v", err)
 	} else {
@@ -127,17 +160,34 @@ func main() {
 		}()
 	}
 
-	cleanupScheduler := dlcleanup.NewScheduler(&downloadCfg.Cleanup, downloadRepo, fileManager)
+	poolControl := dlworker.NewPoolControl(redisClient, &downloadCfg.Worker, workerPool, taskConsumer, progressPublisher)
+	go poolControl.Start(appCtx)
+
+	reaper := dlworker.NewReaper(&downloadCfg.Reaper, workerPool, heartbeats, taskConsumer)
+	go reaper.Start(appCtx)
+
+	cleanupScheduler := dlcleanup.NewScheduler(&downloadCfg.Cleanup, &downloadCfg.Storage, downloadRepo, fileStore, pathGenerator)
 	go cleanupScheduler.Start(appCtx)
 
 	ytDLPUpdater := dlscheduler.NewYtDLPUpdater(&downloadCfg.YtDLP, &downloadCfg.YtDLPUpdate)
 	go ytDLPUpdater.Start(appCtx)
 
 	// 5. 初始化解析服务
-	cacheService := cache.NewService(redisClient, parseCfg.Cache.GetCacheTTL())
-	parserService := service.NewParserService(parseCfg, cacheService, redisClient, logger)
+	cacheService := cache.NewService(redisClient, parseCfg.Cache.GetCacheTTL(), parseCfg.Cache.GetNegativeTTL())
+	parserService := service.NewParserService(parseCfg, platforms, cacheService, redisClient, logger)
 	grpcHandler := handler.NewGRPCServer(parserService, logger)
 
+	// 避免将 nil 指针包装为非 nil 接口
+	var downloaderAsset handler.DownloadAssetClient
+	if assetClient != nil {
+		downloaderAsset = assetClient
+	}
+	var downloaderQueue handler.TaskQueue
+	if taskConsumer != nil {
+		downloaderQueue = taskConsumer
+	}
+	downloaderHandler := handler.NewDownloaderServer(downloadRepo, progressPublisher, workerPool, cancelBus, downloaderQueue, downloaderAsset, poolControl, platformController, logger)
+
 	// 6. 启动 gRPC 服务
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", parseCfg.Server.Port))
 	if err != nil {
@@ -147,6 +197,7 @@ func main() {
 		grpc.UnaryInterceptor(observability.UnaryServerInterceptor("media-service")),
 	)
 	pb.RegisterMediaServiceServer(grpcServer, grpcHandler)
+	pb.RegisterDownloaderServiceServer(grpcServer, downloaderHandler)
 
 	go func() {
 		logger.Info("✓ gRPC server listening", zap.Int("port", parseCfg.Server.Port))
@@ -161,12 +212,18 @@ func main() {
 	<-quit
 
 	logger.Info("Shutting down server...")
-	appCancel()
-	grpcServer.GracefulStop()
+	// 先停止消费并排空 Worker 池，未完成的任务交回队列后再关闭 MQ 通道
+	if taskConsumer != nil {
+		if err := taskConsumer.StopConsuming(); err != nil {
+			logger.Warn("Failed to stop consuming", zap.Error(err))
+		}
+	}
+	workerPool.Drain(time.Duration(downloadCfg.Worker.ShutdownGracePeriod) * time.Second)
 	if taskConsumer != nil {
 		taskConsumer.Stop()
 	}
-	workerPool.Stop()
+	appCancel()
+	grpcServer.GracefulStop()
 	logger.Info("Server stopped")
 }
 
@@ -2,7 +2,9 @@ package adapter
 
 import (
 	"context"
+	"fmt"
 
+	"youdlp/media-service/internal/platform"
 	"youdlp/media-service/internal/ytdlp"
 )
 
@@ -16,4 +18,19 @@ type Adapter interface {
 
 	// ParseWithProxyAndCookie 解析视频URL（使用动态 proxy 和 cookie）
 	ParseWithProxyAndCookie(ctx context.Context, url, proxyURL, cookieFile string) (*ytdlp.VideoInfo, error)
+
+	// ParsePlaylistWithProxyAndCookie 扁平解析播放列表/频道条目（start/end 为 1 起始的闭区间）
+	ParsePlaylistWithProxyAndCookie(ctx context.Context, url, proxyURL, cookieFile string, start, end int) (*ytdlp.PlaylistInfo, error)
+}
+
+// New 按注册表中的适配器类型创建适配器
+func New(kind string, wrapper *ytdlp.Wrapper, extraArgs []string) (Adapter, error) {
+	switch kind {
+	case "", platform.AdapterGeneric:
+		return NewGenericAdapter(wrapper, "", extraArgs), nil
+	case platform.AdapterYouTube:
+		return NewYouTubeAdapter(wrapper, "", extraArgs), nil
+	default:
+		return nil, fmt.Errorf("unsupported adapter: %s", kind)
+	}
 }
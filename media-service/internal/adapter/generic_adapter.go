@@ -6,29 +6,46 @@ import (
 	"youdlp/media-service/internal/ytdlp"
 )
 
-// GenericAdapter 通用平台适配器
+// GenericAdapter 通用平台适配器，平台差异通过注册表中的额外参数表达
 type GenericAdapter struct {
-	ytdlp *ytdlp.Wrapper
+	ytdlp      *ytdlp.Wrapper
+	cookieFile string
+	args       []string
 }
 
 // NewGenericAdapter 创建通用适配器
-func NewGenericAdapter(wrapper *ytdlp.Wrapper) *GenericAdapter {
+func NewGenericAdapter(wrapper *ytdlp.Wrapper, cookieFile string, extraArgs []string) *GenericAdapter {
 	return &GenericAdapter{
-		ytdlp: wrapper,
+		ytdlp:      wrapper,
+		cookieFile: cookieFile,
+		args:       extraArgs,
 	}
 }
 
-// Parse 解析视频(使用默认参数)
+// Parse 解析视频（使用静态 cookie）
 func (a *GenericAdapter) Parse(ctx context.Context, url string) (*ytdlp.VideoInfo, error) {
-	return a.ytdlp.ExtractInfo(ctx, url, "")
+	return a.ytdlp.ExtractInfo(ctx, url, a.cookieFile, a.args...)
 }
 
 // ParseWithCookie 解析视频（使用动态 cookie）
 func (a *GenericAdapter) ParseWithCookie(ctx context.Context, url string, cookieFile string) (*ytdlp.VideoInfo, error) {
-	return a.ytdlp.ExtractInfo(ctx, url, cookieFile)
+	return a.ytdlp.ExtractInfo(ctx, url, a.cookie(cookieFile), a.args...)
 }
 
 // ParseWithProxyAndCookie 解析视频（使用动态 proxy 和 cookie）
 func (a *GenericAdapter) ParseWithProxyAndCookie(ctx context.Context, url, proxyURL, cookieFile string) (*ytdlp.VideoInfo, error) {
-	return a.ytdlp.ExtractInfoWithProxy(ctx, url, proxyURL, cookieFile)
+	return a.ytdlp.ExtractInfoWithProxy(ctx, url, proxyURL, a.cookie(cookieFile), a.args...)
+}
+
+// ParsePlaylistWithProxyAndCookie 解析播放列表/合集/用户主页（使用动态 proxy 和 cookie）
+func (a *GenericAdapter) ParsePlaylistWithProxyAndCookie(ctx context.Context, url, proxyURL, cookieFile string, start, end int) (*ytdlp.PlaylistInfo, error) {
+	return a.ytdlp.ExtractPlaylist(ctx, url, proxyURL, a.cookie(cookieFile), start, end, a.args...)
+}
+
+// cookie 优先使用传入的动态 cookie，为空时回退到静态 cookie
+func (a *GenericAdapter) cookie(cookieFile string) string {
+	if cookieFile != "" {
+		return cookieFile
+	}
+	return a.cookieFile
 }
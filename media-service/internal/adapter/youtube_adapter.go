@@ -3,6 +3,7 @@ package adapter
 import (
 	"context"
 	"log"
+	neturl "net/url"
 	"strings"
 
 	"youdlp/media-service/internal/utils"
@@ -78,6 +79,38 @@ func (a *YouTubeAdapter) ParseWithProxyAndCookie(ctx context.Context, url, proxy
 	return info, nil
 }
 
+// ParsePlaylistWithProxyAndCookie 解析YouTube播放列表/频道（使用动态 proxy 和 cookie）
+func (a *YouTubeAdapter) ParsePlaylistWithProxyAndCookie(ctx context.Context, url, proxyURL, cookieFile string, start, end int) (*ytdlp.PlaylistInfo, error) {
+	cookie := cookieFile
+	if cookie == "" {
+		cookie = a.cookieFile
+	}
+	return a.ytdlp.ExtractPlaylist(ctx, youtubeChannelVideosURL(url), proxyURL, cookie, start, end, a.args...)
+}
+
+// youtubeChannelVideosURL 频道首页在扁平提取时返回的是各个标签页，这里统一指向"视频"标签
+func youtubeChannelVideosURL(rawURL string) string {
+	parsed, err := neturl.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	isChannelRoot := false
+	switch {
+	case len(segments) == 1 && strings.HasPrefix(segments[0], "@"):
+		isChannelRoot = true
+	case len(segments) == 2 && (segments[0] == "channel" || segments[0] == "c" || segments[0] == "user"):
+		isChannelRoot = true
+	}
+	if !isChannelRoot {
+		return rawURL
+	}
+
+	parsed.Path = "/" + strings.Join(segments, "/") + "/videos"
+	return parsed.String()
+}
+
 func hasSufficientYouTubeFormats(info *ytdlp.VideoInfo) bool {
 	if info == nil {
 		return false
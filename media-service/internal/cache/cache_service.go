@@ -3,6 +3,8 @@ package cache
 import (
 	"context"
 	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -14,39 +16,58 @@ import (
 
 // ParseResult 解析结果
 type ParseResult struct {
-	VideoID       string                   `json:"video_id"`
-	Platform      string                   `json:"platform"`
-	Title         string                   `json:"title"`
-	Description   string                   `json:"description"`
-	Duration      int64                    `json:"duration"`
-	Thumbnail     string                   `json:"thumbnail"`
-	Author        string                   `json:"author"`
-	UploadDate    string                   `json:"upload_date"`
-	ViewCount     int64                    `json:"view_count"`
-	Formats       []utils.NormalizedFormat `json:"formats"`
-	CookieID      int64                    `json:"cookie_id,omitempty"`       // 不缓存，仅用于传递
-	ProxyURL      string                   `json:"proxy_url,omitempty"`       // 不缓存，仅用于传递
-	ProxyLeaseID  string                   `json:"proxy_lease_id,omitempty"`  // 不缓存，仅用于传递
-	ProxyExpireAt string                   `json:"proxy_expire_at,omitempty"` // 不缓存，仅用于传递
+	VideoID        string                   `json:"video_id"`
+	Platform       string                   `json:"platform"`
+	Title          string                   `json:"title"`
+	Description    string                   `json:"description"`
+	Duration       int64                    `json:"duration"`
+	Thumbnail      string                   `json:"thumbnail"`
+	Author         string                   `json:"author"`
+	UploadDate     string                   `json:"upload_date"`
+	ViewCount      int64                    `json:"view_count"`
+	Formats        []utils.NormalizedFormat `json:"formats"`
+	Subtitles      []utils.SubtitleTrack    `json:"subtitles,omitempty"`
+	Chapters       []utils.Chapter          `json:"chapters,omitempty"`
+	AudioLanguages []string                 `json:"audio_languages,omitempty"` // 可选的配音音轨语言
+	LiveStatus     string                   `json:"live_status,omitempty"`
+	AgeLimit       int                      `json:"age_limit,omitempty"`
+	Tags           []string                 `json:"tags,omitempty"`
+	Categories     []string                 `json:"categories,omitempty"`
+	ChannelID      string                   `json:"channel_id,omitempty"`
+	ChannelURL     string                   `json:"channel_url,omitempty"`
+	CookieID       int64                    `json:"cookie_id,omitempty"`       // 不缓存，仅用于传递
+	ProxyURL       string                   `json:"proxy_url,omitempty"`       // 不缓存，仅用于传递
+	ProxyLeaseID   string                   `json:"proxy_lease_id,omitempty"`  // 不缓存，仅用于传递
+	ProxyExpireAt  string                   `json:"proxy_expire_at,omitempty"` // 不缓存，仅用于传递
 }
 
+// releaseParseLockScript 仅在锁仍归属当前持有者时删除，避免误删超时后被其他实例重新获取的锁
+var releaseParseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
 // Service 缓存服务
 type Service struct {
-	redis *redis.Client
-	ttl   time.Duration
+	redis       *redis.Client
+	ttl         time.Duration
+	negativeTTL time.Duration
 }
 
-// NewService 创建缓存服务
-func NewService(redisClient *redis.Client, ttl time.Duration) *Service {
+// NewService 创建缓存服务，negativeTTL 为终态解析错误的缓存时间，为 0 时不缓存失败结果
+func NewService(redisClient *redis.Client, ttl, negativeTTL time.Duration) *Service {
 	return &Service{
-		redis: redisClient,
-		ttl:   ttl,
+		redis:       redisClient,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
 	}
 }
 
-// Get 从缓存获取解析结果
-func (s *Service) Get(ctx context.Context, url string) (*ParseResult, error) {
-	key := generateCacheKey(url)
+// Get 从缓存获取解析结果，identity 为视频的规范身份（见 platform.Identity.Key）
+func (s *Service) Get(ctx context.Context, identity string) (*ParseResult, error) {
+	key := generateCacheKey(identity)
 
 	data, err := s.redis.Get(ctx, key).Bytes()
 	if err == redis.Nil {
@@ -65,8 +86,8 @@ func (s *Service) Get(ctx context.Context, url string) (*ParseResult, error) {
 }
 
 // Set 将解析结果写入缓存
-func (s *Service) Set(ctx context.Context, url string, result *ParseResult) error {
-	key := generateCacheKey(url)
+func (s *Service) Set(ctx context.Context, identity string, result *ParseResult) error {
+	key := generateCacheKey(identity)
 
 	cacheResult := *result
 	cacheResult.CookieID = 0
@@ -87,13 +108,71 @@ func (s *Service) Set(ctx context.Context, url string, result *ParseResult) erro
 }
 
 // Delete 删除缓存
-func (s *Service) Delete(ctx context.Context, url string) error {
-	key := generateCacheKey(url)
+func (s *Service) Delete(ctx context.Context, identity string) error {
+	key := generateCacheKey(identity)
 	return s.redis.Del(ctx, key).Err()
 }
 
-// generateCacheKey 生成缓存key
-func generateCacheKey(url string) string {
-	hash := md5.Sum([]byte(url))
-	return fmt.Sprintf("parser:url:%x", hash)
+// GetFailure 返回近期缓存的终态解析错误（私有、已删除、地区限制等），未命中时返回 nil
+func (s *Service) GetFailure(ctx context.Context, identity string) error {
+	message, err := s.redis.Get(ctx, generateFailureKey(identity)).Result()
+	if err != nil {
+		return nil
+	}
+	return utils.TerminalVideoErrorFromMessage(message)
+}
+
+// SetFailure 短时缓存终态解析错误，其他错误（超时、风控等）可能重试成功，不缓存
+func (s *Service) SetFailure(ctx context.Context, identity string, parseErr error) error {
+	terminal := utils.TerminalVideoError(parseErr)
+	if terminal == nil || s.negativeTTL <= 0 {
+		return nil
+	}
+	if err := s.redis.Set(ctx, generateFailureKey(identity), terminal.Error(), s.negativeTTL).Err(); err != nil {
+		return fmt.Errorf("redis set failed: %w", err)
+	}
+	return nil
+}
+
+// AcquireParseLock 获取同一视频的跨实例解析锁，锁在 ttl 后自动过期以防持有者崩溃。
+// 锁已被其他实例持有时返回 ok=false；Redis 不可用时放行，避免锁故障阻塞解析
+func (s *Service) AcquireParseLock(ctx context.Context, identity string, ttl time.Duration) (release func(), ok bool) {
+	key := generateLockKey(identity)
+	token := randomToken()
+
+	acquired, err := s.redis.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return func() {}, true
+	}
+	if !acquired {
+		return nil, false
+	}
+
+	return func() {
+		releaseCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		_ = releaseParseLockScript.Run(releaseCtx, s.redis, []string{key}, token).Err()
+	}, true
+}
+
+// generateCacheKey 按规范身份生成缓存key，youtu.be 短链、移动端地址与带时间戳的地址命中同一条缓存
+func generateCacheKey(identity string) string {
+	hash := md5.Sum([]byte(identity))
+	return fmt.Sprintf("parser:video:%x", hash)
+}
+
+func generateFailureKey(identity string) string {
+	return fmt.Sprintf("parser:negative:%x", md5.Sum([]byte(identity)))
+}
+
+func generateLockKey(identity string) string {
+	return fmt.Sprintf("parser:lock:%x", md5.Sum([]byte(identity)))
+}
+
+func randomToken() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(buf)
 }
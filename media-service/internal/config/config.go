@@ -7,17 +7,19 @@ import (
 
 	"gopkg.in/yaml.v3"
 
+	"youdlp/media-service/internal/platform"
 	"youdlp/media-service/internal/platformpolicy"
 )
 
 // Config 应用配置
 type Config struct {
-	Server       ServerConfig              `yaml:"server"`
-	Redis        RedisConfig               `yaml:"redis"`
-	YTDLP        YTDLPConfig               `yaml:"ytdlp"`
-	Cache        CacheConfig               `yaml:"cache"`
-	Platforms    map[string]PlatformConfig `yaml:"platforms"`
-	AssetService AssetServiceConfig        `yaml:"asset_service"`
+	Server               ServerConfig          `yaml:"server"`
+	Redis                RedisConfig           `yaml:"redis"`
+	YTDLP                YTDLPConfig           `yaml:"ytdlp"`
+	Cache                CacheConfig           `yaml:"cache"`
+	Platforms            []platform.Definition `yaml:"platforms"`              // 与内置平台按名称合并，新平台排在 generic 之前
+	PlatformSyncInterval int                   `yaml:"platform_sync_interval"` // 同步管理端平台开关的间隔(秒)
+	AssetService         AssetServiceConfig    `yaml:"asset_service"`
 }
 
 // ServerConfig 服务器配置
@@ -35,26 +37,23 @@ type RedisConfig struct {
 
 // YTDLPConfig yt-dlp配置
 type YTDLPConfig struct {
-	BinaryPath    string                       `yaml:"binary_path"`
-	Timeout       int                          `yaml:"timeout"`        // 解析超时(秒)
-	MaxConcurrent int                          `yaml:"max_concurrent"` // 最大并发解析数
-	CookiesDir    string                       `yaml:"cookies_dir"`
-	Proxy         string                       `yaml:"proxy"`        // 代理地址
-	DefaultArgs   []string                     `yaml:"default_args"` // 默认参数
-	YouTube       platformpolicy.YouTubePolicy `yaml:"youtube"`
+	BinaryPath       string                       `yaml:"binary_path"`
+	Timeout          int                          `yaml:"timeout"`           // 解析超时(秒)
+	MaxConcurrent    int                          `yaml:"max_concurrent"`    // 最大并发解析数
+	BatchConcurrency int                          `yaml:"batch_concurrency"` // 单个批量解析请求的并发数，仍受 max_concurrent 约束
+	CookiesDir       string                       `yaml:"cookies_dir"`
+	Proxy            string                       `yaml:"proxy"`        // 代理地址
+	DefaultArgs      []string                     `yaml:"default_args"` // 默认参数
+	YouTube          platformpolicy.YouTubePolicy `yaml:"youtube"`
 }
 
 // CacheConfig 缓存配置
 type CacheConfig struct {
-	TTL     int `yaml:"ttl"`      // 缓存TTL(秒)
-	MaxSize int `yaml:"max_size"` // 最大缓存条目数
-}
-
-// PlatformConfig 平台特定配置
-type PlatformConfig struct {
-	Enabled    bool     `yaml:"enabled"`
-	ExtraArgs  []string `yaml:"extra_args"`
-	CookieFile string   `yaml:"cookie_file"`
+	TTL         int `yaml:"ttl"`          // 缓存TTL(秒)
+	MaxSize     int `yaml:"max_size"`     // 最大缓存条目数
+	NegativeTTL int `yaml:"negative_ttl"` // 终态解析错误（私有、已删除等）缓存时间(秒)
+	LockTTL     int `yaml:"lock_ttl"`     // 跨实例解析锁过期时间(秒)
+	LockWait    int `yaml:"lock_wait"`    // 等待其他实例解析结果的最长时间(秒)，超时后自行解析
 }
 
 // AssetServiceConfig Asset服务配置
@@ -100,10 +99,26 @@ func LoadConfig(configPath string) (*Config, error) {
 	if cfg.YTDLP.MaxConcurrent == 0 {
 		cfg.YTDLP.MaxConcurrent = 10
 	}
+	if cfg.YTDLP.BatchConcurrency <= 0 {
+		cfg.YTDLP.BatchConcurrency = 4
+	}
 	cfg.YTDLP.YouTube = platformpolicy.NormalizeYouTubePolicy(cfg.YTDLP.YouTube)
+	cfg.Platforms = platform.MergeDefinitions(platform.DefaultDefinitions(), cfg.Platforms)
+	if cfg.PlatformSyncInterval == 0 {
+		cfg.PlatformSyncInterval = 30
+	}
 	if cfg.Cache.TTL == 0 {
 		cfg.Cache.TTL = 3600
 	}
+	if cfg.Cache.NegativeTTL == 0 {
+		cfg.Cache.NegativeTTL = 300
+	}
+	if cfg.Cache.LockTTL == 0 {
+		cfg.Cache.LockTTL = 120
+	}
+	if cfg.Cache.LockWait == 0 {
+		cfg.Cache.LockWait = 60
+	}
 	if cfg.AssetService.Timeout == 0 {
 		cfg.AssetService.Timeout = 5
 	}
@@ -122,6 +137,29 @@ func (c *CacheConfig) GetCacheTTL() time.Duration {
 	return time.Duration(c.TTL) * time.Second
 }
 
+// GetNegativeTTL 获取终态解析错误缓存时间，配置为负数时不缓存
+func (c *CacheConfig) GetNegativeTTL() time.Duration {
+	if c.NegativeTTL < 0 {
+		return 0
+	}
+	return time.Duration(c.NegativeTTL) * time.Second
+}
+
+// GetLockTTL 获取跨实例解析锁过期时间
+func (c *CacheConfig) GetLockTTL() time.Duration {
+	return time.Duration(c.LockTTL) * time.Second
+}
+
+// GetLockWait 获取等待其他实例解析结果的最长时间
+func (c *CacheConfig) GetLockWait() time.Duration {
+	return time.Duration(c.LockWait) * time.Second
+}
+
+// GetPlatformSyncInterval 获取平台开关同步间隔
+func (c *Config) GetPlatformSyncInterval() time.Duration {
+	return time.Duration(c.PlatformSyncInterval) * time.Second
+}
+
 // GetTimeout 获取超时时间
 func (c *YTDLPConfig) GetTimeout() time.Duration {
 	return time.Duration(c.Timeout) * time.Second
@@ -3,6 +3,8 @@ package cleanup
 import (
 	"context"
 	"log"
+	"os"
+	"path/filepath"
 	"time"
 
 	"youdlp/media-service/internal/download/config"
@@ -12,21 +14,25 @@ import (
 
 // Scheduler 清理调度器
 type Scheduler struct {
-	repo        *repository.DownloadRepository
-	fileManager *storage.FileManager
-	interval    time.Duration
-	batchSize   int
-	enabled     bool
+	repo      *repository.DownloadRepository
+	store     storage.Storage
+	workRoot  string
+	workTTL   time.Duration
+	interval  time.Duration
+	batchSize int
+	enabled   bool
 }
 
 // NewScheduler 创建清理调度器
-func NewScheduler(cfg *config.CleanupConfig, repo *repository.DownloadRepository, fileManager *storage.FileManager) *Scheduler {
+func NewScheduler(cfg *config.CleanupConfig, storageCfg *config.StorageConfig, repo *repository.DownloadRepository, store storage.Storage, pathGenerator *storage.PathGenerator) *Scheduler {
 	return &Scheduler{
-		repo:        repo,
-		fileManager: fileManager,
-		interval:    time.Duration(cfg.Interval) * time.Second,
-		batchSize:   cfg.BatchSize,
-		enabled:     cfg.Enabled,
+		repo:      repo,
+		store:     store,
+		workRoot:  pathGenerator.WorkRoot(),
+		workTTL:   time.Duration(storageCfg.WorkTTL) * time.Second,
+		interval:  time.Duration(cfg.Interval) * time.Second,
+		batchSize: cfg.BatchSize,
+		enabled:   cfg.Enabled,
 	}
 }
 
@@ -61,6 +67,11 @@ func (s *Scheduler) cleanup(ctx context.Context) {
 	log.Println("[Cleanup] Starting cleanup task")
 	startTime := time.Now()
 
+	// 先处理其他服务登记的删除请求
+	s.drainDeletions(ctx)
+	// 清理重试未再回来的任务遗留的部分下载
+	s.sweepWorkDirs()
+
 	// 查询待清理的记录
 	records, err := s.repo.FindExpiredRecords(ctx, s.batchSize)
 	if err != nil {
@@ -101,20 +112,30 @@ func (s *Scheduler) cleanup(ctx context.Context) {
 
 		filePath := record.FilePath.String
 
-		// 删除物理文件
-		if err := s.fileManager.DeleteFile(filePath); err != nil {
-			log.Printf("[Cleanup] Failed to delete file %s: %v", filePath, err)
-			failedCount++
-			continue
+		// 删除存储中的文件；共享对象由引用计数决定是否删除
+		if !record.ObjectID.Valid {
+			if err := s.store.Delete(ctx, filePath); err != nil {
+				log.Printf("[Cleanup] Failed to delete file %s: %v", filePath, err)
+				failedCount++
+				continue
+			}
 		}
 
-		// 更新数据库状态
+		// 删除附加产物（字幕等）
+		s.deleteAttachedFiles(ctx, record.TaskID)
+
+		// 更新数据库状态（同时解除对象关联，避免重复释放引用）
 		if err := s.repo.MarkExpired(ctx, record.TaskID); err != nil {
 			log.Printf("[Cleanup] Failed to mark expired: %v", err)
 			failedCount++
 			continue
 		}
 
+		// 释放去重对象引用，仅在无人引用时删除对象文件
+		if record.ObjectID.Valid {
+			s.releaseObject(ctx, record.ObjectID.Int64)
+		}
+
 		deletedCount++
 		log.Printf("[Cleanup] Cleaned up: %s", filePath)
 	}
@@ -122,3 +143,107 @@ func (s *Scheduler) cleanup(ctx context.Context) {
 	elapsed := time.Since(startTime)
 	log.Printf("[Cleanup] Completed in %v: deleted=%d, failed=%d", elapsed, deletedCount, failedCount)
 }
+
+// deleteAttachedFiles 删除任务的附加产物，失败只记录日志
+func (s *Scheduler) deleteAttachedFiles(ctx context.Context, taskID string) {
+	files, err := s.repo.FindFilesByTaskID(ctx, taskID)
+	if err != nil {
+		log.Printf("[Cleanup] Failed to find attached files for task %s: %v", taskID, err)
+		return
+	}
+	for _, file := range files {
+		if err := s.store.Delete(ctx, file.FilePath); err != nil {
+			log.Printf("[Cleanup] Failed to delete attached file %s: %v", file.FilePath, err)
+		}
+	}
+}
+
+// releaseObject 释放去重对象的一个引用，引用归零时删除存储中的对象
+func (s *Scheduler) releaseObject(ctx context.Context, objectID int64) {
+	objectPath, orphaned, err := s.repo.ReleaseObject(ctx, objectID)
+	if err != nil {
+		log.Printf("[Cleanup] Failed to release storage object %d: %v", objectID, err)
+		return
+	}
+	if !orphaned {
+		return
+	}
+	if err := s.store.Delete(ctx, objectPath); err != nil {
+		log.Printf("[Cleanup] Failed to delete stored object %s: %v", objectPath, err)
+		return
+	}
+	log.Printf("[Cleanup] Released storage object %d: %s", objectID, objectPath)
+}
+
+// drainDeletions 删除队列中登记的存储对象，失败的请求保留到下次重试
+func (s *Scheduler) drainDeletions(ctx context.Context) {
+	deletions, err := s.repo.FindPendingDeletions(ctx, s.batchSize)
+	if err != nil {
+		log.Printf("[Cleanup] Failed to find pending storage deletions: %v", err)
+		return
+	}
+
+	for _, deletion := range deletions {
+		if err := s.store.Delete(ctx, deletion.StorageKey); err != nil {
+			log.Printf("[Cleanup] Failed to delete %s (attempt %d): %v", deletion.StorageKey, deletion.Attempts+1, err)
+			if failErr := s.repo.FailDeletion(ctx, deletion.ID, err.Error()); failErr != nil {
+				log.Printf("[Cleanup] Failed to record deletion failure: %v", failErr)
+			}
+			continue
+		}
+		if err := s.repo.CompleteDeletion(ctx, deletion.ID); err != nil {
+			log.Printf("[Cleanup] Failed to complete storage deletion %d: %v", deletion.ID, err)
+			continue
+		}
+		log.Printf("[Cleanup] Deleted queued object: %s", deletion.StorageKey)
+	}
+}
+
+// sweepWorkDirs 删除长时间未更新的任务工作目录。
+// 失败任务的部分文件保留供重试续传，消息丢失或服务迁移时由此兜底清理
+func (s *Scheduler) sweepWorkDirs() {
+	if s.workTTL <= 0 {
+		return
+	}
+	entries, err := os.ReadDir(s.workRoot)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[Cleanup] Failed to read work directory: %v", err)
+		}
+		return
+	}
+
+	cutoff := time.Now().Add(-s.workTTL)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(s.workRoot, entry.Name())
+		if latestModTime(dir).After(cutoff) {
+			continue
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			log.Printf("[Cleanup] Failed to remove stale work directory %s: %v", dir, err)
+			continue
+		}
+		log.Printf("[Cleanup] Removed stale work directory: %s", dir)
+	}
+}
+
+// latestModTime 返回目录及其直接子项中最新的修改时间，追加写入 .part 文件不会更新目录本身的时间
+func latestModTime(dir string) time.Time {
+	var latest time.Time
+	if info, err := os.Stat(dir); err == nil {
+		latest = info.ModTime()
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return latest
+	}
+	for _, entry := range entries {
+		if info, err := entry.Info(); err == nil && info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest
+}
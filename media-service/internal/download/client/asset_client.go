@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
@@ -326,6 +327,32 @@ func (c *AssetClient) ReleaseInitialDownload(taskID, reason string) error {
 	return nil
 }
 
+// EmitWebhookEvent 发布任务事件，由 Asset Service 投递到用户订阅的 Webhook
+func (c *AssetClient) EmitWebhookEvent(userID, eventType string, data map[string]interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshal webhook event data: %w", err)
+	}
+
+	_, err = c.client.EmitWebhookEvent(ctx, &pb.EmitWebhookEventRequest{
+		EventType: eventType,
+		UserId:    userID,
+		DataJson:  string(dataJSON),
+	})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return nil
+		}
+		log.Printf("[AssetClient] ERROR: Failed to emit %s webhook event for user %s: %v", eventType, userID, err)
+		return err
+	}
+
+	return nil
+}
+
 // Close 关闭连接
 func (c *AssetClient) Close() error {
 	if c.conn != nil {
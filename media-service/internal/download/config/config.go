@@ -21,8 +21,12 @@ type Config struct {
 	YtDLP        YtDLPConfig        `yaml:"ytdlp"`
 	YtDLPUpdate  YtDLPUpdateConfig  `yaml:"ytdlp_update"`
 	Storage      StorageConfig      `yaml:"storage"`
+	PostProcess  PostProcessConfig  `yaml:"post_process"`
 	Cleanup      CleanupConfig      `yaml:"cleanup"`
 	Retry        RetryConfig        `yaml:"retry"`
+	Reaper       ReaperConfig       `yaml:"reaper"`
+	Scheduler    SchedulerConfig    `yaml:"scheduler"`
+	Partitions   PartitionConfig    `yaml:"partitions"`
 	AssetService AssetServiceConfig `yaml:"asset_service"`
 }
 
@@ -62,9 +66,13 @@ func (c *DatabaseConfig) GetURL() string {
 
 // RabbitMQConfig RabbitMQ 配置
 type RabbitMQConfig struct {
-	URL           string `yaml:"url"`
-	Queue         string `yaml:"queue"`
-	PrefetchCount int    `yaml:"prefetch_count"`
+	URL             string `yaml:"url"`
+	Queue           string `yaml:"queue"`
+	DeadLetterQueue string `yaml:"dead_letter_queue"` // 终态失败任务的死信队列，默认 <queue>.dlq
+	PrefetchCount   int    `yaml:"prefetch_count"`
+	MaxPriority     int    `yaml:"max_priority"` // 下载队列的 x-max-priority，0 表示不启用优先级队列
+	// 按平台路由的 topic 交换机，路由键为 download.<platform>，需与 api-gateway 一致；为空时不拆分平台队列
+	PlatformExchange string `yaml:"platform_exchange"`
 }
 
 // RedisConfig Redis 配置
@@ -79,6 +87,12 @@ type RedisConfig struct {
 type WorkerConfig struct {
 	PoolSize      int `yaml:"pool_size"`
 	MaxConcurrent int `yaml:"max_concurrent"`
+	// 关闭时等待运行中任务完成的最长时间（秒），超时后中断任务并重新入队
+	ShutdownGracePeriod int `yaml:"shutdown_grace_period"`
+	// 上报 Worker 池状态供管理端查询的间隔（秒）
+	StatusInterval int `yaml:"status_interval"`
+	// 实例标识，管理端按它定位实例并持久化实例级设置，重启后应保持不变；默认取主机名
+	InstanceID string `yaml:"instance_id"`
 }
 
 // YtDLPConfig yt-dlp 配置
@@ -88,7 +102,7 @@ type YtDLPConfig struct {
 	ConcurrentFragments int                          `yaml:"concurrent_fragments"`
 	CookiesDir          string                       `yaml:"cookies_dir"`
 	DefaultArgs         []string                     `yaml:"default_args"`
-	PlatformArgs        map[string][]string          `yaml:"platform_args"`
+	PlatformArgs        map[string][]string          `yaml:"platform_args"` // 平台注册表未配置 extra_args 时的回退参数
 	YouTube             platformpolicy.YouTubePolicy `yaml:"youtube"`
 }
 
@@ -102,8 +116,29 @@ type YtDLPUpdateConfig struct {
 
 // StorageConfig 存储配置
 type StorageConfig struct {
-	BasePath string `yaml:"base_path"`
-	TmpTTL   int    `yaml:"tmp_ttl"` // 秒
+	BasePath string   `yaml:"base_path"` // 本地存储根目录，同时作为下载工作目录
+	TmpTTL   int      `yaml:"tmp_ttl"`   // 秒
+	WorkTTL  int      `yaml:"work_ttl"`  // 秒，重试保留的未完成工作目录超过该时长未更新即清理
+	Backend  string   `yaml:"backend"`   // local 或 s3，默认 local
+	S3       S3Config `yaml:"s3"`
+}
+
+// S3Config S3 兼容对象存储配置（AWS S3、MinIO 等）
+type S3Config struct {
+	Endpoint     string `yaml:"endpoint"`
+	Region       string `yaml:"region"`
+	Bucket       string `yaml:"bucket"`
+	AccessKey    string `yaml:"access_key"`
+	SecretKey    string `yaml:"secret_key"`
+	Prefix       string `yaml:"prefix"`
+	UsePathStyle bool   `yaml:"use_path_style"`
+}
+
+// PostProcessConfig 后处理（ffmpeg 转码）配置
+type PostProcessConfig struct {
+	FFmpegPath string `yaml:"ffmpeg_path"`
+	Timeout    int    `yaml:"timeout"` // 秒
+	Threads    int    `yaml:"threads"` // 0 表示由 ffmpeg 自动决定
 }
 
 // CleanupConfig 清理配置
@@ -120,6 +155,32 @@ type RetryConfig struct {
 	MaxInterval     int `yaml:"max_interval"`     // 秒
 }
 
+// ReaperConfig 任务心跳与卡住任务回收配置
+type ReaperConfig struct {
+	Enabled           bool `yaml:"enabled"`
+	Interval          int  `yaml:"interval"`           // 回收扫描间隔，秒
+	HeartbeatInterval int  `yaml:"heartbeat_interval"` // Worker 心跳间隔，秒
+	HeartbeatTTL      int  `yaml:"heartbeat_ttl"`      // 心跳过期时间，超过后任务视为失联，秒
+	BatchSize         int  `yaml:"batch_size"`
+}
+
+// SchedulerConfig 按用户公平调度配置
+type SchedulerConfig struct {
+	Enabled         bool           `yaml:"enabled"`
+	MaxPerUser      int            `yaml:"max_per_user"`       // 默认优先级类别下单个用户在所有实例合计同时占用的任务数
+	ClassMaxPerUser map[string]int `yaml:"class_max_per_user"` // 按优先级类别覆盖单用户并发上限，如 vip: 5
+	DeferDelay      int            `yaml:"defer_delay"`        // 超出上限的任务延后重新投递的时间，秒
+	SlotTTL         int            `yaml:"slot_ttl"`           // Redis 中用户名额计数的过期时间，秒，需大于单个任务的最长耗时
+}
+
+// PartitionConfig 按平台拆分下载队列与并发预算配置
+type PartitionConfig struct {
+	Enabled            bool           `yaml:"enabled"`
+	Platforms          map[string]int `yaml:"platforms"`            // 平台 → 并发预算，即该平台队列的预取数
+	DeferDelay         int            `yaml:"defer_delay"`          // 平台限流或冷却中的任务延后重新投递的时间，秒
+	PauseCheckInterval int            `yaml:"pause_check_interval"` // 检查平台冷却状态的间隔，秒，0 表示不随冷却暂停
+}
+
 // AssetServiceConfig Asset 服务配置
 type AssetServiceConfig struct {
 	Addr          string `yaml:"addr"`            // Asset Service 地址
@@ -175,6 +236,65 @@ func LoadConfig(configPath string) (*Config, error) {
 		cfg.AssetService.Addr = assetAddr
 	}
 
+	// 存储配置
+	if backend := os.Getenv("STORAGE_BACKEND"); backend != "" {
+		cfg.Storage.Backend = backend
+	}
+	if endpoint := os.Getenv("S3_ENDPOINT"); endpoint != "" {
+		cfg.Storage.S3.Endpoint = endpoint
+	}
+	if bucket := os.Getenv("S3_BUCKET"); bucket != "" {
+		cfg.Storage.S3.Bucket = bucket
+	}
+	if accessKey := os.Getenv("S3_ACCESS_KEY"); accessKey != "" {
+		cfg.Storage.S3.AccessKey = accessKey
+	}
+	if secretKey := os.Getenv("S3_SECRET_KEY"); secretKey != "" {
+		cfg.Storage.S3.SecretKey = secretKey
+	}
+	if instanceID := os.Getenv("WORKER_INSTANCE_ID"); instanceID != "" {
+		cfg.Worker.InstanceID = instanceID
+	}
+
+	if cfg.RabbitMQ.DeadLetterQueue == "" {
+		cfg.RabbitMQ.DeadLetterQueue = cfg.RabbitMQ.Queue + ".dlq"
+	}
+	if cfg.Worker.ShutdownGracePeriod <= 0 {
+		cfg.Worker.ShutdownGracePeriod = 60
+	}
+	if cfg.Worker.StatusInterval <= 0 {
+		cfg.Worker.StatusInterval = 10
+	}
+	if cfg.Worker.InstanceID == "" {
+		cfg.Worker.InstanceID, _ = os.Hostname()
+	}
+	if cfg.Scheduler.MaxPerUser <= 0 {
+		cfg.Scheduler.MaxPerUser = 2
+	}
+	if cfg.Scheduler.DeferDelay <= 0 {
+		cfg.Scheduler.DeferDelay = 10
+	}
+	if cfg.Scheduler.SlotTTL <= 0 {
+		cfg.Scheduler.SlotTTL = 7200
+	}
+	if cfg.Partitions.DeferDelay <= 0 {
+		cfg.Partitions.DeferDelay = 30
+	}
+	if cfg.Reaper.Interval <= 0 {
+		cfg.Reaper.Interval = 60
+	}
+	if cfg.Reaper.HeartbeatInterval <= 0 {
+		cfg.Reaper.HeartbeatInterval = 15
+	}
+	if cfg.Reaper.HeartbeatTTL <= cfg.Reaper.HeartbeatInterval {
+		cfg.Reaper.HeartbeatTTL = cfg.Reaper.HeartbeatInterval * 4
+	}
+	if cfg.Reaper.BatchSize <= 0 {
+		cfg.Reaper.BatchSize = 50
+	}
+	if cfg.Storage.WorkTTL <= 0 {
+		cfg.Storage.WorkTTL = 86400
+	}
 	if cfg.YtDLPUpdate.IntervalHours <= 0 {
 		cfg.YtDLPUpdate.IntervalHours = 6
 	}
@@ -46,26 +46,143 @@ type DownloadHistory struct {
 	CreatedAt    time.Time      `json:"created_at"`
 	StartedAt    sql.NullTime   `json:"started_at"`
 	CompletedAt  sql.NullTime   `json:"completed_at"`
+	ObjectID     sql.NullInt64  `json:"object_id"` // 关联的去重存储对象
+
+	// 以下字段仅由管理端任务查询填充
+	ErrorCategory  sql.NullString `json:"error_category"`   // 最后一次失败的错误分类
+	DeadLetteredAt sql.NullTime   `json:"dead_lettered_at"` // 转入死信队列的时间
+	HasPayload     bool           `json:"has_payload"`      // 是否保存了可重放的任务消息
+}
+
+// 管理端任务查询状态
+const (
+	AdminTaskStateFailed       = "failed"        // 所有失败任务（含死信、取消）
+	AdminTaskStateDeadLettered = "dead_lettered" // 重试耗尽或不可重试的任务
+	AdminTaskStateStuck        = "stuck"         // 下载中且开始时间早于阈值的任务
+)
+
+// AdminTaskFilter 管理端跨用户任务查询条件，State 为空时返回失败与长时间运行的任务
+type AdminTaskFilter struct {
+	State         string
+	Platform      string
+	ErrorCategory string
+	UserID        string
+	StartTime     *time.Time
+	EndTime       *time.Time
+	StuckBefore   time.Time // 下载中任务的开始时间早于该时间视为长时间运行
+	Page          int
+	PageSize      int
 }
 
 // DownloadTask MQ 任务消息结构
 type DownloadTask struct {
-	TaskID         string          `json:"task_id"`
-	UserID         string          `json:"user_id"`
-	HistoryID      int64           `json:"history_id"`
-	URL            string          `json:"url"`
-	Mode           string          `json:"mode"`    // quick_download, archive
-	Quality        string          `json:"quality"` // 1080p, 720p, 160kbps, etc.
-	Format         string          `json:"format"`  // mp4, webm, m4a
-	FormatID       string          `json:"format_id"`
-	SelectedFormat *SelectedFormat `json:"selected_format,omitempty"`
-	Platform       string          `json:"platform"`
-	Title          string          `json:"title"`
-	Metadata       Metadata        `json:"metadata"`
-	CookieID       int64           `json:"cookie_id"`       // parser 使用的 cookie ID
-	ProxyURL       string          `json:"proxy_url"`       // parser 使用的 proxy URL
-	ProxyLeaseID   string          `json:"proxy_lease_id"`  // parser 使用的动态代理租约 ID
-	ProxyExpireAt  string          `json:"proxy_expire_at"` // parser 获取到的代理过期时间
+	TaskID         string              `json:"task_id"`
+	UserID         string              `json:"user_id"`
+	HistoryID      int64               `json:"history_id"`
+	URL            string              `json:"url"`
+	Mode           string              `json:"mode"`    // quick_download, archive
+	Quality        string              `json:"quality"` // 1080p, 720p, 160kbps, etc.
+	Format         string              `json:"format"`  // mp4, webm, m4a
+	FormatID       string              `json:"format_id"`
+	SelectedFormat *SelectedFormat     `json:"selected_format,omitempty"`
+	Platform       string              `json:"platform"`
+	Title          string              `json:"title"`
+	Metadata       Metadata            `json:"metadata"`
+	CookieID       int64               `json:"cookie_id"`                // parser 使用的 cookie ID
+	ProxyURL       string              `json:"proxy_url"`                // parser 使用的 proxy URL
+	ProxyLeaseID   string              `json:"proxy_lease_id"`           // parser 使用的动态代理租约 ID
+	ProxyExpireAt  string              `json:"proxy_expire_at"`          // parser 获取到的代理过期时间
+	BatchID        string              `json:"batch_id,omitempty"`       // 所属批量任务，单个提交时为空
+	VideoID        string              `json:"video_id,omitempty"`       // 解析得到的平台视频 ID，用于去重存储
+	Subtitles      *SubtitleOptions    `json:"subtitles,omitempty"`      // 字幕选项，为空表示不下载字幕
+	PostProcess    *PostProcessOptions `json:"post_process,omitempty"`   // 后处理选项，为空表示保留原始文件
+	Clip           *ClipOptions        `json:"clip,omitempty"`           // 片段/章节选项，为空表示下载完整视频
+	AudioLanguage  string              `json:"audio_language,omitempty"` // 优先选择的音轨语言（配音），找不到时回退到默认音轨
+	ReplayedAtUnix int64               `json:"replayed_at,omitempty"`    // 管理端重放时间（毫秒），早于该时间的取消标记不再生效
+	Priority       uint8               `json:"priority,omitempty"`       // RabbitMQ 消息优先级
+	PriorityClass  string              `json:"priority_class,omitempty"` // 优先级类别，决定单用户并发上限
+}
+
+// ReplayedAt 管理端重放时间，未重放时为零值
+func (t *DownloadTask) ReplayedAt() time.Time {
+	if t.ReplayedAtUnix <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(t.ReplayedAtUnix)
+}
+
+// ClipOptions 片段下载选项（秒）
+type ClipOptions struct {
+	StartTime     float64 `json:"start_time,omitempty"`     // 起始时间，0 表示从头
+	EndTime       float64 `json:"end_time,omitempty"`       // 结束时间，0 表示到结尾
+	SplitChapters bool    `json:"split_chapters,omitempty"` // 按章节拆分输出
+}
+
+// HasRange 是否指定了时间范围
+func (c *ClipOptions) HasRange() bool {
+	return c != nil && (c.StartTime > 0 || c.EndTime > 0)
+}
+
+// PostProcessOptions 后处理选项
+type PostProcessOptions struct {
+	Profile      string `json:"profile"`                 // audio_mp3, audio_flac, audio_opus, remux_mp4, remux_mkv, h264_mp4
+	AudioBitrate int    `json:"audio_bitrate,omitempty"` // kbps，仅有损音频编码生效
+}
+
+// 字幕交付方式
+const (
+	SubtitleModeEmbed   = "embed"   // 嵌入视频容器
+	SubtitleModeSidecar = "sidecar" // 独立字幕文件
+)
+
+// SubtitleOptions 字幕下载选项
+type SubtitleOptions struct {
+	Languages   []string `json:"languages"`              // 语言代码，如 en、zh-Hans
+	IncludeAuto bool     `json:"include_auto,omitempty"` // 是否允许使用自动生成字幕
+	Mode        string   `json:"mode,omitempty"`         // embed, sidecar
+	Format      string   `json:"format,omitempty"`       // srt, vtt（仅 sidecar 生效）
+}
+
+// 任务附加产物类型
+const (
+	FileKindSubtitle = "subtitle"
+	FileKindChapter  = "chapter"
+)
+
+// DownloadFile 任务的附加产物（如字幕文件），主文件仍记录在 download_history
+type DownloadFile struct {
+	ID        int64     `json:"id"`
+	TaskID    string    `json:"task_id"`
+	Kind      string    `json:"kind"`
+	Language  string    `json:"language"` // 字幕语言，其他类型为空
+	FilePath  string    `json:"file_path"`
+	FileName  string    `json:"file_name"`
+	FileSize  int64     `json:"file_size"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// StorageObject 去重存储对象
+type StorageObject struct {
+	ID         int64     `json:"id"`
+	ObjectKey  string    `json:"object_key"`
+	Platform   string    `json:"platform"`
+	VideoID    string    `json:"video_id"`
+	FormatKey  string    `json:"format_key"`
+	Profile    string    `json:"profile"`
+	FilePath   string    `json:"file_path"`
+	FileSize   int64     `json:"file_size"`
+	FileHash   string    `json:"file_hash"`
+	RefCount   int       `json:"ref_count"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+}
+
+// StorageDeletion 待删除的存储对象
+type StorageDeletion struct {
+	ID         int64     `json:"id"`
+	StorageKey string    `json:"storage_key"`
+	Attempts   int       `json:"attempts"`
+	CreatedAt  time.Time `json:"created_at"`
 }
 
 // Metadata 视频元数据
@@ -94,22 +211,32 @@ type SelectedFormat struct {
 // ProgressMessage 进度消息
 type ProgressMessage struct {
 	TaskID          string  `json:"task_id"`
-	Status          string  `json:"status"` // downloading, completed, failed
+	Status          string  `json:"status"` // downloading, completed, failed, cancelled
 	Percent         float64 `json:"percent"`
-	Phase           string  `json:"phase,omitempty"`       // downloading_video, downloading_audio, downloading, merging, processing
+	Phase           string  `json:"phase,omitempty"`       // downloading_video, downloading_audio, downloading, merging, post_processing, processing
 	PhaseLabel      string  `json:"phase_label,omitempty"` // 中文阶段标签
 	DownloadedBytes int64   `json:"downloaded_bytes"`
 	TotalBytes      int64   `json:"total_bytes"`
 	Speed           string  `json:"speed"`
 	ETA             string  `json:"eta"`
+	FormatID        string  `json:"format_id,omitempty"`      // 当前下载的流
+	FragmentIndex   int     `json:"fragment_index,omitempty"` // 分片下载时的当前分片
+	FragmentCount   int     `json:"fragment_count,omitempty"`
 	Message         string  `json:"message"`
 }
 
-// Progress yt-dlp 解析的进度
+// Progress yt-dlp 解析的进度，字节数与百分比均针对当前流
 type Progress struct {
+	Status          string // downloading、finished 或 error
+	Filename        string // 当前流的输出文件
+	FormatID        string
+	VideoCodec      string
+	AudioCodec      string
 	Percent         float64
 	DownloadedBytes int64
 	TotalBytes      int64
+	FragmentIndex   int
+	FragmentCount   int
 	Speed           string
 	ETA             string
 }
@@ -0,0 +1,202 @@
+package postprocess
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const stderrTailLimit = 4096
+
+var durationRegexp = regexp.MustCompile(`Duration:\s*(\d+):(\d+):(\d+(?:\.\d+)?)`)
+
+// FFmpegProcessor 使用 ffmpeg 按档位转换文件
+type FFmpegProcessor struct {
+	binaryPath string
+	timeout    time.Duration
+	threads    int
+	profile    FFmpegProfile
+}
+
+// NewFFmpegProcessor 创建 ffmpeg 后处理器
+func NewFFmpegProcessor(binaryPath string, timeout time.Duration, threads int, profile FFmpegProfile) *FFmpegProcessor {
+	return &FFmpegProcessor{
+		binaryPath: binaryPath,
+		timeout:    timeout,
+		threads:    threads,
+		profile:    profile,
+	}
+}
+
+// Process 执行转换，成功后删除原始文件并返回新文件路径
+func (f *FFmpegProcessor) Process(ctx context.Context, job *Job, onProgress func(percent float64)) (string, error) {
+	outputPath := replaceExtension(job.InputPath, f.profile.Extension)
+	// 输出与输入同名时先写入临时文件，完成后再覆盖
+	targetPath := outputPath
+	if outputPath == job.InputPath {
+		targetPath = replaceExtension(job.InputPath, "pp."+f.profile.Extension)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	args := f.buildArgs(job, targetPath)
+	cmd := exec.CommandContext(ctx, f.binaryPath, args...)
+	log.Printf("[PostProcess] [Task %s] Command: %s %s", job.TaskID, f.binaryPath, strings.Join(args, " "))
+
+	stdoutPipe, err := cmd.StdoutPipe()
+	if err != nil {
+		return "", fmt.Errorf("failed to get stdout pipe: %w", err)
+	}
+	stderrPipe, err := cmd.StderrPipe()
+	if err != nil {
+		return "", fmt.Errorf("failed to get stderr pipe: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return "", fmt.Errorf("failed to start ffmpeg: %w", err)
+	}
+
+	tracker := &progressTracker{totalMicros: job.Duration * int64(time.Second/time.Microsecond)}
+
+	var stderrWG sync.WaitGroup
+	var stderrTail tailBuffer
+	stderrWG.Add(1)
+	go func() {
+		defer stderrWG.Done()
+		scanner := bufio.NewScanner(stderrPipe)
+		for scanner.Scan() {
+			line := scanner.Text()
+			stderrTail.Write(line)
+			tracker.observeDuration(line)
+		}
+	}()
+
+	scanner := bufio.NewScanner(stdoutPipe)
+	for scanner.Scan() {
+		if percent, ok := tracker.observeProgress(scanner.Text()); ok {
+			onProgress(percent)
+		}
+	}
+	stderrWG.Wait()
+
+	if err := cmd.Wait(); err != nil {
+		_ = os.Remove(targetPath)
+		if ctx.Err() == context.DeadlineExceeded {
+			return "", fmt.Errorf("post-processing timeout after %v", f.timeout)
+		}
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return "", fmt.Errorf("post-processing cancelled: %w", context.Canceled)
+		}
+		return "", fmt.Errorf("ffmpeg failed: %w, stderr: %s", err, stderrTail.String())
+	}
+
+	if targetPath != outputPath {
+		if err := os.Rename(targetPath, outputPath); err != nil {
+			_ = os.Remove(targetPath)
+			return "", fmt.Errorf("failed to replace output file: %w", err)
+		}
+	} else if err := os.Remove(job.InputPath); err != nil && !os.IsNotExist(err) {
+		log.Printf("[PostProcess] [Task %s] ⚠ Failed to remove raw file %s: %v", job.TaskID, job.InputPath, err)
+	}
+
+	onProgress(100)
+	return outputPath, nil
+}
+
+func (f *FFmpegProcessor) buildArgs(job *Job, targetPath string) []string {
+	args := []string{"-hide_banner", "-nostdin", "-y", "-i", job.InputPath}
+	if f.threads > 0 {
+		args = append(args, "-threads", strconv.Itoa(f.threads))
+	}
+	args = append(args, f.profile.Args(f.profile.resolveBitrate(job.AudioBitrate))...)
+	args = append(args, "-progress", "pipe:1", "-nostats", targetPath)
+	return args
+}
+
+// replaceExtension 替换文件扩展名
+func replaceExtension(path, ext string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + "." + ext
+}
+
+// progressTracker 根据 ffmpeg -progress 输出计算处理进度
+type progressTracker struct {
+	mu          sync.Mutex
+	totalMicros int64
+}
+
+// observeDuration 从 stderr 的输入信息中读取时长（任务未携带时长时使用）
+func (t *progressTracker) observeDuration(line string) {
+	match := durationRegexp.FindStringSubmatch(line)
+	if len(match) < 4 {
+		return
+	}
+	hours, _ := strconv.Atoi(match[1])
+	minutes, _ := strconv.Atoi(match[2])
+	seconds, _ := strconv.ParseFloat(match[3], 64)
+	total := int64((float64(hours*3600+minutes*60) + seconds) * 1e6)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.totalMicros <= 0 {
+		t.totalMicros = total
+	}
+}
+
+// observeProgress 解析 out_time_us=<微秒> 行
+func (t *progressTracker) observeProgress(line string) (float64, bool) {
+	key, value, ok := strings.Cut(strings.TrimSpace(line), "=")
+	if !ok || (key != "out_time_us" && key != "out_time_ms") {
+		return 0, false
+	}
+	// 旧版 ffmpeg 的 out_time_ms 实际单位同样是微秒
+	current, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || current < 0 {
+		return 0, false
+	}
+
+	t.mu.Lock()
+	total := t.totalMicros
+	t.mu.Unlock()
+	if total <= 0 {
+		return 0, false
+	}
+
+	percent := float64(current) / float64(total) * 100
+	if percent > 99 {
+		percent = 99
+	}
+	return percent, true
+}
+
+// tailBuffer 只保留 stderr 尾部，避免长时间转码占用过多内存
+type tailBuffer struct {
+	mu  sync.Mutex
+	buf []byte
+}
+
+func (b *tailBuffer) Write(line string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.buf = append(b.buf, line...)
+	b.buf = append(b.buf, '\n')
+	if len(b.buf) > stderrTailLimit {
+		b.buf = b.buf[len(b.buf)-stderrTailLimit:]
+	}
+}
+
+func (b *tailBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return string(b.buf)
+}
@@ -0,0 +1,74 @@
+package postprocess
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFFmpegArgsUseProfileBitrate(t *testing.T) {
+	processor := NewFFmpegProcessor("ffmpeg", time.Minute, 2, builtinProfiles[ProfileAudioMP3])
+
+	args := strings.Join(processor.buildArgs(&Job{InputPath: "/data/a.webm", AudioBitrate: 999}, "/data/a.mp3"), " ")
+	want := "-hide_banner -nostdin -y -i /data/a.webm -threads 2 -vn -c:a libmp3lame -b:a 512k -progress pipe:1 -nostats /data/a.mp3"
+	if args != want {
+		t.Fatalf("unexpected args:\n got %q\nwant %q", args, want)
+	}
+}
+
+func TestProgressTrackerUsesDurationFromStderr(t *testing.T) {
+	tracker := &progressTracker{}
+	if _, ok := tracker.observeProgress("out_time_us=1000000"); ok {
+		t.Fatal("expected no progress before duration is known")
+	}
+
+	tracker.observeDuration("  Duration: 00:00:04.00, start: 0.000000, bitrate: 128 kb/s")
+	percent, ok := tracker.observeProgress("out_time_us=1000000")
+	if !ok || percent != 25 {
+		t.Fatalf("expected 25%%, got %v (ok=%v)", percent, ok)
+	}
+}
+
+func TestFFmpegProcessorReplacesRawFile(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "ffmpeg")
+	// 伪造 ffmpeg：输出进度并写入最后一个参数指定的文件
+	content := "#!/bin/sh\n" +
+		"echo 'out_time_us=5000000'\n" +
+		"echo 'progress=end'\n" +
+		"for last; do :; done\n" +
+		"echo converted > \"$last\"\n"
+	if err := os.WriteFile(script, []byte(content), 0o755); err != nil {
+		t.Fatalf("write fake ffmpeg: %v", err)
+	}
+
+	input := filepath.Join(dir, "clip.mp4")
+	if err := os.WriteFile(input, []byte("raw"), 0o644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+
+	processor := NewFFmpegProcessor(script, time.Minute, 0, builtinProfiles[ProfileRemuxMP4])
+	var updates []float64
+	output, err := processor.Process(context.Background(), &Job{TaskID: "task-1", InputPath: input, Duration: 10}, func(percent float64) {
+		updates = append(updates, percent)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output != input {
+		t.Fatalf("expected in-place output %s, got %s", input, output)
+	}
+	data, err := os.ReadFile(output)
+	if err != nil || strings.TrimSpace(string(data)) != "converted" {
+		t.Fatalf("expected converted output, got %q (%v)", data, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "clip.pp.mp4")); !os.IsNotExist(err) {
+		t.Fatalf("expected temporary file to be renamed, stat err=%v", err)
+	}
+	if len(updates) != 2 || updates[0] != 50 || updates[1] != 100 {
+		t.Fatalf("unexpected progress updates: %v", updates)
+	}
+}
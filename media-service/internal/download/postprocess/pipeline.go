@@ -0,0 +1,86 @@
+package postprocess
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"youdlp/media-service/internal/download/config"
+)
+
+// Processor 后处理器，将下载得到的原始文件转换为目标文件
+type Processor interface {
+	// Process 处理 job.InputPath 并返回最终输出路径，onProgress 回调 0-100 的处理进度
+	Process(ctx context.Context, job *Job, onProgress func(percent float64)) (string, error)
+}
+
+// Job 后处理作业
+type Job struct {
+	TaskID       string
+	InputPath    string
+	Duration     int64 // 秒，未知时为 0（从 ffmpeg 输出中获取）
+	AudioBitrate int   // kbps，0 表示使用档位默认值
+}
+
+// Pipeline 按档位名称分发后处理器
+type Pipeline struct {
+	processors map[string]Processor
+}
+
+// NewPipeline 创建后处理流水线并注册内置的 ffmpeg 档位
+func NewPipeline(cfg *config.PostProcessConfig) *Pipeline {
+	binaryPath := cfg.FFmpegPath
+	if binaryPath == "" {
+		binaryPath = "ffmpeg"
+	}
+	timeout := time.Duration(cfg.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = 30 * time.Minute
+	}
+
+	pipeline := &Pipeline{processors: make(map[string]Processor)}
+	for name, profile := range builtinProfiles {
+		pipeline.Register(name, NewFFmpegProcessor(binaryPath, timeout, cfg.Threads, profile))
+	}
+	return pipeline
+}
+
+// Register 注册（或覆盖）指定档位的后处理器
+func (p *Pipeline) Register(profile string, processor Processor) {
+	p.processors[profile] = processor
+}
+
+// Supports 判断档位是否可用
+func (p *Pipeline) Supports(profile string) bool {
+	if p == nil {
+		return false
+	}
+	_, ok := p.processors[profile]
+	return ok
+}
+
+// Profiles 返回已注册的档位名称
+func (p *Pipeline) Profiles() []string {
+	names := make([]string, 0, len(p.processors))
+	for name := range p.processors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Run 使用指定档位处理文件
+func (p *Pipeline) Run(ctx context.Context, profile string, job *Job, onProgress func(percent float64)) (string, error) {
+	if p == nil {
+		return "", fmt.Errorf("post-processing is not configured")
+	}
+	processor, ok := p.processors[profile]
+	if !ok {
+		return "", fmt.Errorf("unsupported post-processing profile: %s", profile)
+	}
+	if onProgress == nil {
+		onProgress = func(float64) {}
+	}
+	return processor.Process(ctx, job, onProgress)
+}
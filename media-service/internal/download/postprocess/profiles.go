@@ -0,0 +1,111 @@
+package postprocess
+
+import "fmt"
+
+// 内置后处理档位
+const (
+	ProfileAudioMP3  = "audio_mp3"  // 提取音频并转为 mp3
+	ProfileAudioFLAC = "audio_flac" // 提取音频并转为 flac（无损）
+	ProfileAudioOpus = "audio_opus" // 提取音频并转为 opus
+	ProfileRemuxMP4  = "remux_mp4"  // 不重新编码，封装为 mp4
+	ProfileRemuxMKV  = "remux_mkv"  // 不重新编码，封装为 mkv
+	ProfileH264MP4   = "h264_mp4"   // 重新编码为 H.264/AAC mp4，兼容老旧设备
+)
+
+const (
+	minAudioBitrate = 32
+	maxAudioBitrate = 512
+)
+
+// FFmpegProfile 基于 ffmpeg 的后处理档位
+type FFmpegProfile struct {
+	Name           string
+	Extension      string
+	DefaultBitrate int // 音频码率 kbps，0 表示不使用码率参数
+	Args           func(bitrate int) []string
+}
+
+var builtinProfiles = map[string]FFmpegProfile{
+	ProfileAudioMP3: {
+		Name:           ProfileAudioMP3,
+		Extension:      "mp3",
+		DefaultBitrate: 192,
+		Args: func(bitrate int) []string {
+			return []string{"-vn", "-c:a", "libmp3lame", "-b:a", kbps(bitrate)}
+		},
+	},
+	ProfileAudioFLAC: {
+		Name:      ProfileAudioFLAC,
+		Extension: "flac",
+		Args: func(int) []string {
+			return []string{"-vn", "-c:a", "flac"}
+		},
+	},
+	ProfileAudioOpus: {
+		Name:           ProfileAudioOpus,
+		Extension:      "opus",
+		DefaultBitrate: 128,
+		Args: func(bitrate int) []string {
+			return []string{"-vn", "-c:a", "libopus", "-b:a", kbps(bitrate)}
+		},
+	},
+	ProfileRemuxMP4: {
+		Name:      ProfileRemuxMP4,
+		Extension: "mp4",
+		Args: func(int) []string {
+			return []string{
+				"-map", "0:v?", "-map", "0:a?", "-map", "0:s?",
+				"-c", "copy", "-c:s", "mov_text",
+				"-movflags", "+faststart",
+			}
+		},
+	},
+	ProfileRemuxMKV: {
+		Name:      ProfileRemuxMKV,
+		Extension: "mkv",
+		Args: func(int) []string {
+			return []string{"-map", "0", "-c", "copy"}
+		},
+	},
+	ProfileH264MP4: {
+		Name:           ProfileH264MP4,
+		Extension:      "mp4",
+		DefaultBitrate: 192,
+		Args: func(bitrate int) []string {
+			return []string{
+				"-map", "0:v:0", "-map", "0:a?", "-map", "0:s?",
+				"-c:v", "libx264", "-preset", "veryfast", "-crf", "23", "-pix_fmt", "yuv420p",
+				"-c:a", "aac", "-b:a", kbps(bitrate),
+				"-c:s", "mov_text",
+				"-movflags", "+faststart",
+			}
+		},
+	},
+}
+
+// IsBuiltinProfile 判断是否为内置档位
+func IsBuiltinProfile(name string) bool {
+	_, ok := builtinProfiles[name]
+	return ok
+}
+
+// resolveBitrate 规范化用户请求的码率，超出范围时截断
+func (p FFmpegProfile) resolveBitrate(requested int) int {
+	if p.DefaultBitrate == 0 {
+		return 0
+	}
+	if requested <= 0 {
+		return p.DefaultBitrate
+	}
+	if requested < minAudioBitrate {
+		return minAudioBitrate
+	}
+	if requested > maxAudioBitrate {
+		return maxAudioBitrate
+	}
+	return requested
+}
+
+func kbps(bitrate int) string {
+	return fmt.Sprintf("%dk", bitrate)
+}
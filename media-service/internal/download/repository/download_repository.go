@@ -68,29 +68,32 @@ func (r *DownloadRepository) UpdateStatus(ctx context.Context, taskID string, st
 	return nil
 }
 
-// UpdateProcessing 更新为处理中状态
+// UpdateProcessing 更新为处理中状态。只有待处理或下载中的任务可以开始处理，
+// 任务已被取消、强制失败或已结束时返回 sql.ErrNoRows，避免延迟重试的消息让终态任务复活
 func (r *DownloadRepository) UpdateProcessing(ctx context.Context, taskID string) error {
 	query := `
 		UPDATE download_history
 		SET status = $1, started_at = $2
-		WHERE task_id = $3
+		WHERE task_id = $3 AND status IN ($4, $5)
 	`
 
-	_, err := r.db.ExecContext(ctx, query, models.StatusProcessing, time.Now(), taskID)
+	result, err := r.db.ExecContext(ctx, query, models.StatusProcessing, time.Now(), taskID,
+		models.StatusPending, models.StatusProcessing)
 	if err != nil {
 		return fmt.Errorf("failed to update processing status: %w", err)
 	}
 
-	return nil
+	return requireAffected(result, "processing")
 }
 
-// UpdateComplete 更新为完成状态
+// UpdateComplete 更新为完成状态。只有待处理或下载中的任务可以完成，
+// 任务已被取消或已结束时返回 sql.ErrNoRows
 func (r *DownloadRepository) UpdateComplete(ctx context.Context, taskID, filePath, fileName, fileHash string, fileSize int64, expireAt *time.Time) error {
 	query := `
 		UPDATE download_history
 		SET status = $1, file_path = $2, file_name = $3, file_hash = $4, 
 		    file_size = $5, expire_at = $6, completed_at = $7
-		WHERE task_id = $8
+		WHERE task_id = $8 AND status IN ($9, $10)
 	`
 
 	status := models.StatusCompleted
@@ -98,44 +101,61 @@ func (r *DownloadRepository) UpdateComplete(ctx context.Context, taskID, filePat
 		status = models.StatusPendingCleanup
 	}
 
-	_, err := r.db.ExecContext(ctx, query, status, filePath, fileName, fileHash, fileSize, expireAt, time.Now(), taskID)
+	result, err := r.db.ExecContext(ctx, query, status, filePath, fileName, fileHash, fileSize, expireAt, time.Now(), taskID,
+		models.StatusPending, models.StatusProcessing)
 	if err != nil {
 		return fmt.Errorf("failed to update complete status: %w", err)
 	}
 
-	return nil
+	return requireAffected(result, "complete")
 }
 
-// UpdateFailed 更新为失败状态
+// UpdateFailed 更新为失败状态。只有待处理或下载中的任务可以标记失败，
+// 避免取消与 Worker 收尾并发时互相覆盖终态；任务已结束时返回 sql.ErrNoRows
 func (r *DownloadRepository) UpdateFailed(ctx context.Context, taskID, errorMsg string, retryCount int) error {
 	query := `
 		UPDATE download_history
 		SET status = $1, error_message = $2, retry_count = $3
-		WHERE task_id = $4
+		WHERE task_id = $4 AND status IN ($5, $6)
 	`
 
-	_, err := r.db.ExecContext(ctx, query, models.StatusFailed, errorMsg, retryCount, taskID)
+	result, err := r.db.ExecContext(ctx, query, models.StatusFailed, errorMsg, retryCount, taskID,
+		models.StatusPending, models.StatusProcessing)
 	if err != nil {
 		return fmt.Errorf("failed to update failed status: %w", err)
 	}
 
+	return requireAffected(result, "failed")
+}
+
+// requireAffected 条件更新未命中任何行时返回 sql.ErrNoRows
+func requireAffected(result sql.Result, status string) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to inspect %s status update: %w", status, err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
 	return nil
 }
 
-// IncrementRetry 增加重试次数
+// IncrementRetry 增加重试次数，任务在重试等待期间保持待处理状态。
+// 任务已被取消或强制失败时返回 sql.ErrNoRows
 func (r *DownloadRepository) IncrementRetry(ctx context.Context, taskID string) error {
 	query := `
 		UPDATE download_history
-		SET retry_count = retry_count + 1, status = $1
-		WHERE task_id = $2
+		SET retry_count = retry_count + 1, status = $1, started_at = NULL
+		WHERE task_id = $2 AND status IN ($3, $4)
 	`
 
-	_, err := r.db.ExecContext(ctx, query, models.StatusPending, taskID)
+	result, err := r.db.ExecContext(ctx, query, models.StatusPending, taskID,
+		models.StatusPending, models.StatusProcessing)
 	if err != nil {
 		return fmt.Errorf("failed to increment retry count: %w", err)
 	}
 
-	return nil
+	return requireAffected(result, "retry")
 }
 
 // FindByTaskID 按任务 ID 查询
@@ -143,7 +163,7 @@ func (r *DownloadRepository) FindByTaskID(ctx context.Context, taskID string) (*
 	query := `
 		SELECT id, task_id, user_id, url, platform, title, mode, quality,
 		       file_path, file_name, file_size, file_hash, status, error_message,
-		       retry_count, expire_at, created_at, started_at, completed_at
+		       retry_count, expire_at, created_at, started_at, completed_at, object_id
 		FROM download_history
 		WHERE task_id = $1
 	`
@@ -153,7 +173,7 @@ func (r *DownloadRepository) FindByTaskID(ctx context.Context, taskID string) (*
 		&record.ID, &record.TaskID, &record.UserID, &record.URL, &record.Platform,
 		&record.Title, &record.Mode, &record.Quality, &record.FilePath, &record.FileName,
 		&record.FileSize, &record.FileHash, &record.Status, &record.ErrorMessage,
-		&record.RetryCount, &record.ExpireAt, &record.CreatedAt, &record.StartedAt, &record.CompletedAt,
+		&record.RetryCount, &record.ExpireAt, &record.CreatedAt, &record.StartedAt, &record.CompletedAt, &record.ObjectID,
 	)
 
 	if err == sql.ErrNoRows {
@@ -189,7 +209,7 @@ func (r *DownloadRepository) FindByUserID(ctx context.Context, userID string, pa
 	listQuery := `
 		SELECT id, task_id, user_id, url, platform, title, mode, quality,
 		       file_path, file_name, file_size, file_hash, status, error_message,
-		       retry_count, expire_at, created_at, started_at, completed_at
+		       retry_count, expire_at, created_at, started_at, completed_at, object_id
 		FROM download_history
 		WHERE user_id = $1
 	`
@@ -214,7 +234,7 @@ func (r *DownloadRepository) FindByUserID(ctx context.Context, userID string, pa
 			&record.ID, &record.TaskID, &record.UserID, &record.URL, &record.Platform,
 			&record.Title, &record.Mode, &record.Quality, &record.FilePath, &record.FileName,
 			&record.FileSize, &record.FileHash, &record.Status, &record.ErrorMessage,
-			&record.RetryCount, &record.ExpireAt, &record.CreatedAt, &record.StartedAt, &record.CompletedAt,
+			&record.RetryCount, &record.ExpireAt, &record.CreatedAt, &record.StartedAt, &record.CompletedAt, &record.ObjectID,
 		)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan record: %w", err)
@@ -230,7 +250,7 @@ func (r *DownloadRepository) FindExpiredRecords(ctx context.Context, batchSize i
 	query := `
 		SELECT id, task_id, user_id, url, platform, title, mode, quality,
 		       file_path, file_name, file_size, file_hash, status, error_message,
-		       retry_count, expire_at, created_at, started_at, completed_at
+		       retry_count, expire_at, created_at, started_at, completed_at, object_id
 		FROM download_history
 		WHERE status = $1 AND expire_at < $2
 		LIMIT $3
@@ -249,7 +269,7 @@ func (r *DownloadRepository) FindExpiredRecords(ctx context.Context, batchSize i
 			&record.ID, &record.TaskID, &record.UserID, &record.URL, &record.Platform,
 			&record.Title, &record.Mode, &record.Quality, &record.FilePath, &record.FileName,
 			&record.FileSize, &record.FileHash, &record.Status, &record.ErrorMessage,
-			&record.RetryCount, &record.ExpireAt, &record.CreatedAt, &record.StartedAt, &record.CompletedAt,
+			&record.RetryCount, &record.ExpireAt, &record.CreatedAt, &record.StartedAt, &record.CompletedAt, &record.ObjectID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan record: %w", err)
@@ -260,11 +280,82 @@ func (r *DownloadRepository) FindExpiredRecords(ctx context.Context, batchSize i
 	return records, nil
 }
 
+// FindProcessingStartedBefore 查询在指定时间之前开始、仍处于下载中的任务，供回收器核对心跳
+func (r *DownloadRepository) FindProcessingStartedBefore(ctx context.Context, before time.Time, batchSize int) ([]*models.DownloadHistory, error) {
+	query := `
+		SELECT id, task_id, user_id, url, platform, title, mode, quality,
+		       file_path, file_name, file_size, file_hash, status, error_message,
+		       retry_count, expire_at, created_at, started_at, completed_at, object_id
+		FROM download_history
+		WHERE status = $1 AND started_at < $2
+		ORDER BY started_at
+		LIMIT $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, models.StatusProcessing, before, batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query processing records: %w", err)
+	}
+	defer rows.Close()
+
+	var records []*models.DownloadHistory
+	for rows.Next() {
+		record := &models.DownloadHistory{}
+		err := rows.Scan(
+			&record.ID, &record.TaskID, &record.UserID, &record.URL, &record.Platform,
+			&record.Title, &record.Mode, &record.Quality, &record.FilePath, &record.FileName,
+			&record.FileSize, &record.FileHash, &record.Status, &record.ErrorMessage,
+			&record.RetryCount, &record.ExpireAt, &record.CreatedAt, &record.StartedAt, &record.CompletedAt, &record.ObjectID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan record: %w", err)
+		}
+		records = append(records, record)
+	}
+
+	return records, rows.Err()
+}
+
+// RequeueLapsed 将心跳失联的下载中任务重置为待处理并累加重试次数，任务状态已变化时返回 false
+func (r *DownloadRepository) RequeueLapsed(ctx context.Context, taskID string) (bool, error) {
+	query := `
+		UPDATE download_history
+		SET status = $1, retry_count = retry_count + 1, started_at = NULL
+		WHERE task_id = $2 AND status = $3
+	`
+
+	result, err := r.db.ExecContext(ctx, query, models.StatusPending, taskID, models.StatusProcessing)
+	if err != nil {
+		return false, fmt.Errorf("failed to requeue lapsed task: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to inspect requeue result: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// ResetInterrupted 将因实例关闭而中断的任务恢复为待处理，不计入重试次数
+func (r *DownloadRepository) ResetInterrupted(ctx context.Context, taskID string) error {
+	query := `
+		UPDATE download_history
+		SET status = $1, started_at = NULL
+		WHERE task_id = $2 AND status = $3
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, models.StatusPending, taskID, models.StatusProcessing); err != nil {
+		return fmt.Errorf("failed to reset interrupted task: %w", err)
+	}
+
+	return nil
+}
+
 // MarkExpired 标记为已过期
 func (r *DownloadRepository) MarkExpired(ctx context.Context, taskID string) error {
 	query := `
 		UPDATE download_history
-		SET status = $1, file_path = NULL
+		SET status = $1, file_path = NULL, object_id = NULL
 		WHERE task_id = $2
 	`
 
@@ -275,3 +366,59 @@ func (r *DownloadRepository) MarkExpired(ctx context.Context, taskID string) err
 
 	return nil
 }
+
+// SaveFiles 记录任务的附加产物，同一任务重复写入时先清除旧记录
+func (r *DownloadRepository) SaveFiles(ctx context.Context, taskID string, files []models.DownloadFile) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM download_files WHERE task_id = $1`, taskID); err != nil {
+		return fmt.Errorf("failed to clear download files: %w", err)
+	}
+
+	query := `
+		INSERT INTO download_files (task_id, kind, language, file_path, file_name, file_size, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	now := time.Now()
+	for _, file := range files {
+		if _, err := tx.ExecContext(ctx, query, taskID, file.Kind, file.Language, file.FilePath, file.FileName, file.FileSize, now); err != nil {
+			return fmt.Errorf("failed to save download file: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit download files: %w", err)
+	}
+	return nil
+}
+
+// FindFilesByTaskID 查询任务的附加产物
+func (r *DownloadRepository) FindFilesByTaskID(ctx context.Context, taskID string) ([]*models.DownloadFile, error) {
+	query := `
+		SELECT id, task_id, kind, COALESCE(language, ''), file_path, file_name, file_size, created_at
+		FROM download_files
+		WHERE task_id = $1
+		ORDER BY id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query download files: %w", err)
+	}
+	defer rows.Close()
+
+	var files []*models.DownloadFile
+	for rows.Next() {
+		file := &models.DownloadFile{}
+		if err := rows.Scan(&file.ID, &file.TaskID, &file.Kind, &file.Language, &file.FilePath, &file.FileName, &file.FileSize, &file.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan download file: %w", err)
+		}
+		files = append(files, file)
+	}
+
+	return files, rows.Err()
+}
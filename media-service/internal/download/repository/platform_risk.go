@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// FindPlatformCooldowns 查询仍处于冷却期的平台及冷却结束时间。
+// platform_risk_states 由 asset-service 在平台连续触发风控后写入
+func (r *DownloadRepository) FindPlatformCooldowns(ctx context.Context, now time.Time) (map[string]time.Time, error) {
+	query := `SELECT platform, cooldown_until FROM platform_risk_states WHERE cooldown_until > $1`
+
+	rows, err := r.db.QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query platform cooldowns: %w", err)
+	}
+	defer rows.Close()
+
+	cooldowns := make(map[string]time.Time)
+	for rows.Next() {
+		var platform string
+		var until time.Time
+		if err := rows.Scan(&platform, &until); err != nil {
+			return nil, fmt.Errorf("failed to scan platform cooldown: %w", err)
+		}
+		cooldowns[platform] = until
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate platform cooldowns: %w", err)
+	}
+
+	return cooldowns, nil
+}
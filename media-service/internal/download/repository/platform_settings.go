@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListPlatformSettings 查询管理端设置的平台启用状态
+func (r *DownloadRepository) ListPlatformSettings(ctx context.Context) (map[string]bool, error) {
+	query := `SELECT platform, enabled FROM platform_settings`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query platform settings: %w", err)
+	}
+	defer rows.Close()
+
+	settings := make(map[string]bool)
+	for rows.Next() {
+		var platform string
+		var enabled bool
+		if err := rows.Scan(&platform, &enabled); err != nil {
+			return nil, fmt.Errorf("failed to scan platform setting: %w", err)
+		}
+		settings[platform] = enabled
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate platform settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// UpsertPlatformSetting 写入平台启用状态
+func (r *DownloadRepository) UpsertPlatformSetting(ctx context.Context, platform string, enabled bool, operatorUserID string) error {
+	query := `
+		INSERT INTO platform_settings (platform, enabled, updated_by, updated_at)
+		VALUES ($1, $2, $3, CURRENT_TIMESTAMP)
+		ON CONFLICT (platform) DO UPDATE
+		SET enabled = EXCLUDED.enabled, updated_by = EXCLUDED.updated_by, updated_at = EXCLUDED.updated_at
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, platform, enabled, operatorUserID); err != nil {
+		return fmt.Errorf("failed to upsert platform setting: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"youdlp/media-service/internal/download/models"
+)
+
+// QueuePosition 查询待处理任务的排队位置（从 1 开始）：优先级更高或同优先级更早提交的待处理任务排在前面。
+// 任务不是待处理状态时返回 0
+func (r *DownloadRepository) QueuePosition(ctx context.Context, taskID string) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM download_history h, download_history t
+		WHERE t.task_id = $1 AND t.status = $2 AND h.status = $2
+		  AND (h.priority > t.priority OR (h.priority = t.priority AND h.created_at <= t.created_at))
+	`
+
+	var position int64
+	if err := r.db.QueryRowContext(ctx, query, taskID, models.StatusPending).Scan(&position); err != nil {
+		return 0, fmt.Errorf("failed to query queue position: %w", err)
+	}
+
+	return position, nil
+}
+
+// CountStartedSince 统计指定时间之后开始执行的任务数，用于估算出队速率
+func (r *DownloadRepository) CountStartedSince(ctx context.Context, since time.Time) (int64, error) {
+	query := `SELECT COUNT(*) FROM download_history WHERE started_at >= $1`
+
+	var count int64
+	if err := r.db.QueryRowContext(ctx, query, since).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count started tasks: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"youdlp/media-service/internal/download/models"
+)
+
+// FindObjectByKey 按对象键查询去重存储对象，不存在时返回 nil
+func (r *DownloadRepository) FindObjectByKey(ctx context.Context, objectKey string) (*models.StorageObject, error) {
+	query := `
+		SELECT id, object_key, platform, video_id, format_key, profile,
+		       file_path, file_size, file_hash, ref_count, created_at, last_used_at
+		FROM storage_objects
+		WHERE object_key = $1
+	`
+
+	obj := &models.StorageObject{}
+	err := r.db.QueryRowContext(ctx, query, objectKey).Scan(
+		&obj.ID, &obj.ObjectKey, &obj.Platform, &obj.VideoID, &obj.FormatKey, &obj.Profile,
+		&obj.FilePath, &obj.FileSize, &obj.FileHash, &obj.RefCount, &obj.CreatedAt, &obj.LastUsedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find storage object: %w", err)
+	}
+
+	return obj, nil
+}
+
+// CreateObject 登记新的去重存储对象，初始引用计数为 1（创建者本身）。
+// 对象键已存在时返回 false，调用方应放弃登记。
+func (r *DownloadRepository) CreateObject(ctx context.Context, obj *models.StorageObject) (bool, error) {
+	query := `
+		INSERT INTO storage_objects (object_key, platform, video_id, format_key, profile,
+		                             file_path, file_size, file_hash, ref_count, created_at, last_used_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, 1, $9, $9)
+		ON CONFLICT (object_key) DO NOTHING
+		RETURNING id
+	`
+
+	now := time.Now()
+	err := r.db.QueryRowContext(ctx, query,
+		obj.ObjectKey, obj.Platform, obj.VideoID, obj.FormatKey, obj.Profile,
+		obj.FilePath, obj.FileSize, obj.FileHash, now,
+	).Scan(&obj.ID)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to create storage object: %w", err)
+	}
+
+	obj.RefCount = 1
+	obj.CreatedAt = now
+	obj.LastUsedAt = now
+	return true, nil
+}
+
+// AcquireObject 为对象增加一个引用。引用计数已归零的对象正在被删除，不可再复用，此时返回 false
+func (r *DownloadRepository) AcquireObject(ctx context.Context, objectID int64) (bool, error) {
+	query := `
+		UPDATE storage_objects
+		SET ref_count = ref_count + 1, last_used_at = $1
+		WHERE id = $2 AND ref_count > 0
+	`
+
+	result, err := r.db.ExecContext(ctx, query, time.Now(), objectID)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire storage object: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire storage object: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// ReleaseObject 释放对象的一个引用。引用归零时删除对象记录，并返回对象文件路径供调用方删除
+func (r *DownloadRepository) ReleaseObject(ctx context.Context, objectID int64) (string, bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var refCount int
+	var filePath string
+	err = tx.QueryRowContext(ctx, `
+		UPDATE storage_objects
+		SET ref_count = ref_count - 1
+		WHERE id = $1 AND ref_count > 0
+		RETURNING ref_count, file_path
+	`, objectID).Scan(&refCount, &filePath)
+	if err == sql.ErrNoRows {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to release storage object: %w", err)
+	}
+
+	orphaned := refCount == 0
+	if orphaned {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM storage_objects WHERE id = $1 AND ref_count = 0`, objectID); err != nil {
+			return "", false, fmt.Errorf("failed to delete storage object: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", false, fmt.Errorf("failed to commit storage object release: %w", err)
+	}
+	return filePath, orphaned, nil
+}
+
+// DeleteObject 删除对象记录（对象文件丢失或校验失败时使用），已引用该对象的记录解除关联后各自持有文件键
+func (r *DownloadRepository) DeleteObject(ctx context.Context, objectID int64) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM storage_objects WHERE id = $1`, objectID); err != nil {
+		return fmt.Errorf("failed to delete storage object: %w", err)
+	}
+	return nil
+}
+
+// SetObjectID 关联下载记录与去重存储对象
+func (r *DownloadRepository) SetObjectID(ctx context.Context, taskID string, objectID int64) error {
+	query := `
+		UPDATE download_history
+		SET object_id = $1
+		WHERE task_id = $2
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, objectID, taskID); err != nil {
+		return fmt.Errorf("failed to set object id: %w", err)
+	}
+	return nil
+}
+
+// FindPendingDeletions 查询待删除的存储对象，失败次数少的优先
+func (r *DownloadRepository) FindPendingDeletions(ctx context.Context, limit int) ([]*models.StorageDeletion, error) {
+	query := `
+		SELECT id, storage_key, attempts, created_at
+		FROM storage_deletions
+		ORDER BY attempts, id
+		LIMIT $1
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query storage deletions: %w", err)
+	}
+	defer rows.Close()
+
+	var deletions []*models.StorageDeletion
+	for rows.Next() {
+		deletion := &models.StorageDeletion{}
+		if err := rows.Scan(&deletion.ID, &deletion.StorageKey, &deletion.Attempts, &deletion.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan storage deletion: %w", err)
+		}
+		deletions = append(deletions, deletion)
+	}
+
+	return deletions, rows.Err()
+}
+
+// CompleteDeletion 移除已完成的删除请求
+func (r *DownloadRepository) CompleteDeletion(ctx context.Context, id int64) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM storage_deletions WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to complete storage deletion: %w", err)
+	}
+	return nil
+}
+
+// FailDeletion 记录删除失败，留待下次重试
+func (r *DownloadRepository) FailDeletion(ctx context.Context, id int64, errMsg string) error {
+	query := `
+		UPDATE storage_deletions
+		SET attempts = attempts + 1, last_error = $1
+		WHERE id = $2
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, errMsg, id); err != nil {
+		return fmt.Errorf("failed to record storage deletion failure: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"youdlp/media-service/internal/download/models"
+)
+
+// SaveTaskPayload 保存任务消息，供管理端重放和重新入队使用
+func (r *DownloadRepository) SaveTaskPayload(ctx context.Context, taskID string, payload []byte) error {
+	query := `UPDATE download_history SET task_payload = $1 WHERE task_id = $2`
+
+	if _, err := r.db.ExecContext(ctx, query, string(payload), taskID); err != nil {
+		return fmt.Errorf("failed to save task payload: %w", err)
+	}
+
+	return nil
+}
+
+// FindTaskPayload 查询保存的任务消息，未保存时返回 nil
+func (r *DownloadRepository) FindTaskPayload(ctx context.Context, taskID string) ([]byte, error) {
+	query := `SELECT task_payload FROM download_history WHERE task_id = $1`
+
+	var payload sql.NullString
+	err := r.db.QueryRowContext(ctx, query, taskID).Scan(&payload)
+	if err == sql.ErrNoRows || (err == nil && !payload.Valid) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find task payload: %w", err)
+	}
+
+	return []byte(payload.String), nil
+}
+
+// MarkDeadLettered 标记任务已转入死信队列并记录最后一次失败的错误分类
+func (r *DownloadRepository) MarkDeadLettered(ctx context.Context, taskID, errorCategory, errorMsg string) error {
+	query := `
+		UPDATE download_history
+		SET status = $1, error_category = $2, error_message = COALESCE(NULLIF($3, ''), error_message),
+		    dead_lettered_at = $4
+		WHERE task_id = $5
+	`
+
+	_, err := r.db.ExecContext(ctx, query, models.StatusFailed, errorCategory, errorMsg, time.Now(), taskID)
+	if err != nil {
+		return fmt.Errorf("failed to mark task dead-lettered: %w", err)
+	}
+
+	return nil
+}
+
+// ResetForReplay 将失败或长时间运行的任务重置为待处理，清除上次的失败信息
+func (r *DownloadRepository) ResetForReplay(ctx context.Context, taskID string) error {
+	query := `
+		UPDATE download_history
+		SET status = $1, error_message = NULL, error_category = NULL, dead_lettered_at = NULL,
+		    retry_count = 0, started_at = NULL
+		WHERE task_id = $2 AND status IN ($3, $4)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, models.StatusPending, taskID, models.StatusFailed, models.StatusProcessing)
+	if err != nil {
+		return fmt.Errorf("failed to reset task for replay: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to inspect replay reset result: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// PurgeFailed 删除失败任务的记录，附加产物随外键级联删除。任务不是失败状态时返回 sql.ErrNoRows
+func (r *DownloadRepository) PurgeFailed(ctx context.Context, taskID string) error {
+	query := `DELETE FROM download_history WHERE task_id = $1 AND status = $2`
+
+	result, err := r.db.ExecContext(ctx, query, taskID, models.StatusFailed)
+	if err != nil {
+		return fmt.Errorf("failed to purge task: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to inspect purge result: %w", err)
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// ListAdminTasks 跨用户查询失败、死信与长时间运行的任务
+func (r *DownloadRepository) ListAdminTasks(ctx context.Context, filter *models.AdminTaskFilter) ([]*models.DownloadHistory, int64, error) {
+	whereClause, args := buildAdminTaskWhere(filter)
+
+	var total int64
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM download_history WHERE %s", whereClause)
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count admin tasks: %w", err)
+	}
+
+	listQuery := fmt.Sprintf(`
+		SELECT id, task_id, user_id, url, COALESCE(platform, ''), COALESCE(title, ''), mode, COALESCE(quality, ''),
+		       status, error_message, COALESCE(retry_count, 0), created_at, started_at,
+		       error_category, dead_lettered_at, task_payload IS NOT NULL
+		FROM download_history
+		WHERE %s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, whereClause, len(args)+1, len(args)+2)
+	args = append(args, filter.PageSize, (filter.Page-1)*filter.PageSize)
+
+	rows, err := r.db.QueryContext(ctx, listQuery, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query admin tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var records []*models.DownloadHistory
+	for rows.Next() {
+		record := &models.DownloadHistory{}
+		if err := rows.Scan(
+			&record.ID, &record.TaskID, &record.UserID, &record.URL, &record.Platform,
+			&record.Title, &record.Mode, &record.Quality,
+			&record.Status, &record.ErrorMessage, &record.RetryCount, &record.CreatedAt, &record.StartedAt,
+			&record.ErrorCategory, &record.DeadLetteredAt, &record.HasPayload,
+		); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan admin task: %w", err)
+		}
+		records = append(records, record)
+	}
+
+	return records, total, rows.Err()
+}
+
+// buildAdminTaskWhere 构建管理端任务查询条件
+func buildAdminTaskWhere(filter *models.AdminTaskFilter) (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	switch filter.State {
+	case models.AdminTaskStateFailed:
+		conditions = append(conditions, "status = "+arg(models.StatusFailed))
+	case models.AdminTaskStateDeadLettered:
+		conditions = append(conditions, "status = "+arg(models.StatusFailed), "dead_lettered_at IS NOT NULL")
+	case models.AdminTaskStateStuck:
+		conditions = append(conditions, "status = "+arg(models.StatusProcessing), "started_at < "+arg(filter.StuckBefore))
+	default:
+		failed := arg(models.StatusFailed)
+		processing := arg(models.StatusProcessing)
+		conditions = append(conditions, fmt.Sprintf("(status = %s OR (status = %s AND started_at < %s))", failed, processing, arg(filter.StuckBefore)))
+	}
+
+	if filter.Platform != "" {
+		conditions = append(conditions, "platform = "+arg(filter.Platform))
+	}
+	if filter.ErrorCategory != "" {
+		conditions = append(conditions, "error_category = "+arg(filter.ErrorCategory))
+	}
+	if filter.UserID != "" {
+		conditions = append(conditions, "user_id = "+arg(filter.UserID))
+	}
+	if filter.StartTime != nil {
+		conditions = append(conditions, "created_at >= "+arg(*filter.StartTime))
+	}
+	if filter.EndTime != nil {
+		conditions = append(conditions, "created_at <= "+arg(*filter.EndTime))
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"youdlp/media-service/internal/download/config"
+	"youdlp/objectstore"
+)
+
+// 存储后端类型
+const (
+	BackendLocal = objectstore.BackendLocal
+	BackendS3    = objectstore.BackendS3
+)
+
+// ErrNotExist 对象不存在
+var ErrNotExist = objectstore.ErrNotExist
+
+// 存储实现位于共享模块 objectstore，与 api-gateway 共用
+type (
+	Storage      = objectstore.Storage
+	ObjectInfo   = objectstore.ObjectInfo
+	LocalStorage = objectstore.LocalStorage
+)
+
+// NewLocalStorage 创建本地文件系统存储
+func NewLocalStorage(root string) *LocalStorage {
+	return objectstore.NewLocalStorage(root)
+}
+
+// NewStorage 按配置创建存储后端
+func NewStorage(cfg *config.StorageConfig) (Storage, error) {
+	switch cfg.Backend {
+	case "", BackendLocal:
+		return NewLocalStorage(cfg.BasePath), nil
+	case BackendS3:
+		return objectstore.NewS3Storage(objectstore.S3Options{
+			Endpoint:     cfg.S3.Endpoint,
+			Region:       cfg.S3.Region,
+			Bucket:       cfg.S3.Bucket,
+			AccessKey:    cfg.S3.AccessKey,
+			SecretKey:    cfg.S3.SecretKey,
+			Prefix:       cfg.S3.Prefix,
+			UsePathStyle: cfg.S3.UsePathStyle,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported storage backend: %s", cfg.Backend)
+	}
+}
+
+// Upload 将本地文件写入存储并删除本地文件。本地后端直接移动文件，避免重复拷贝
+func Upload(ctx context.Context, store Storage, key, localPath string) error {
+	if local, ok := store.(*LocalStorage); ok {
+		return local.Move(localPath, key)
+	}
+
+	file, err := os.Open(localPath)
+	if err != nil {
+		return fmt.Errorf("failed to open local file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to stat local file: %w", err)
+	}
+
+	err = store.Put(ctx, key, file, info.Size())
+	file.Close()
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(localPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove local file: %w", err)
+	}
+	return nil
+}
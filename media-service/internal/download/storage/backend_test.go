@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"youdlp/media-service/internal/download/config"
+)
+
+func TestUploadMovesIntoLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	store := NewLocalStorage(root)
+
+	path := filepath.Join(root, "work", "task-1", "a.mp4")
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte("video"), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := Upload(ctx, store, "tmp/task-1/a.mp4", path); err != nil {
+		t.Fatalf("upload: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatal("expected local file to be moved")
+	}
+
+	info, err := store.Stat(ctx, "tmp/task-1/a.mp4")
+	if err != nil || info.Size != int64(len("video")) {
+		t.Fatalf("expected uploaded object, got %+v, %v", info, err)
+	}
+}
+
+func TestNewStorageRejectsUnknownBackend(t *testing.T) {
+	_, err := NewStorage(&config.StorageConfig{Backend: "ftp"})
+	if err == nil || !strings.Contains(err.Error(), "unsupported storage backend") {
+		t.Fatalf("expected unsupported backend error, got %v", err)
+	}
+}
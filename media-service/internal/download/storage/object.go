@@ -0,0 +1,23 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// ObjectKey 计算去重存储对象键：同一平台视频、同一格式、同一后处理配置得到相同的键
+func ObjectKey(platform, videoID, formatKey, profile string) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{platform, videoID, formatKey, profile}, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// ObjectStorageKey 生成对象在存储后端中的规范键: objects/{key 前两位}/{key}{ext}
+func ObjectStorageKey(objectKey, ext string) (string, error) {
+	if len(objectKey) < 2 {
+		return "", fmt.Errorf("invalid object key: %q", objectKey)
+	}
+	return path.Join("objects", objectKey[:2], objectKey+ext), nil
+}
@@ -0,0 +1,33 @@
+package storage
+
+import "testing"
+
+func TestObjectKeyDependsOnAllParts(t *testing.T) {
+	base := ObjectKey("youtube", "abc", "id:137/mp4", "")
+	if base != ObjectKey("youtube", "abc", "id:137/mp4", "") {
+		t.Fatal("expected object key to be deterministic")
+	}
+	for _, other := range []string{
+		ObjectKey("bilibili", "abc", "id:137/mp4", ""),
+		ObjectKey("youtube", "abd", "id:137/mp4", ""),
+		ObjectKey("youtube", "abc", "id:22/mp4", ""),
+		ObjectKey("youtube", "abc", "id:137/mp4", "audio_mp3"),
+	} {
+		if other == base {
+			t.Fatalf("expected distinct object keys, got %s twice", base)
+		}
+	}
+}
+
+func TestObjectStorageKeyShardsByPrefix(t *testing.T) {
+	key, err := ObjectStorageKey("abcdef", ".mp4")
+	if err != nil {
+		t.Fatalf("object storage key: %v", err)
+	}
+	if key != "objects/ab/abcdef.mp4" {
+		t.Fatalf("unexpected object storage key: %s", key)
+	}
+	if _, err := ObjectStorageKey("a", ".mp4"); err == nil {
+		t.Fatal("expected short object key to be rejected")
+	}
+}
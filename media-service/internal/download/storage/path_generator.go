@@ -3,12 +3,17 @@ package storage
 import (
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"youdlp/media-service/internal/download/config"
 	"youdlp/media-service/internal/download/models"
+	"youdlp/media-service/internal/download/ytdlp"
 )
 
 // PathGenerator 路径生成器
@@ -23,7 +28,7 @@ func NewPathGenerator(cfg *config.StorageConfig) *PathGenerator {
 	}
 }
 
-// GeneratePath 生成文件存储路径
+// GeneratePath 生成任务的本地工作文件路径，下载与后处理在此完成后再写入存储后端
 func (g *PathGenerator) GeneratePath(task *models.DownloadTask) (string, error) {
 	// 清理文件名
 	safeTitle := sanitizeFilename(task.Metadata.Title)
@@ -40,36 +45,17 @@ func (g *PathGenerator) GeneratePath(task *models.DownloadTask) (string, error)
 		format = "mp4"
 	}
 
-	var filePath string
-
-	switch task.Mode {
-	case "quick_download":
-		// 临时文件: /data/youdlp/tmp/{task_id}/video.mp4
-		filePath = filepath.Join(
-			g.basePath, "tmp", task.TaskID,
-			fmt.Sprintf("%s.%s", safeTitle, format),
-		)
-
-	case "archive":
-		// 归档文件: /data/youdlp/archive/{user_id}/{YYYYMMDD}/video_{timestamp}.mp4
-		date := time.Now().Format("20060102")
-		timestamp := time.Now().Unix()
-
-		filePath = filepath.Join(
-			g.basePath, "archive",
-			task.UserID,
-			date,
-			fmt.Sprintf("%s_%d.%s", safeTitle, timestamp, format),
-		)
-
-	default:
-		// 默认使用临时路径
-		filePath = filepath.Join(
-			g.basePath, "tmp", task.TaskID,
-			fmt.Sprintf("%s.%s", safeTitle, format),
-		)
+	var fileName string
+	if task.Mode == "archive" {
+		// 归档文件名带时间戳，避免同日重复下载互相覆盖
+		fileName = fmt.Sprintf("%s_%d.%s", safeTitle, time.Now().Unix(), format)
+	} else {
+		fileName = fmt.Sprintf("%s.%s", safeTitle, format)
 	}
 
+	// 工作目录: /data/youdlp/work/{task_id}/video.mp4
+	filePath := filepath.Join(g.WorkDir(task.TaskID), fileName)
+
 	// 确保目录存在
 	dir := filepath.Dir(filePath)
 	if err := os.MkdirAll(dir, 0755); err != nil {
@@ -79,11 +65,142 @@ func (g *PathGenerator) GeneratePath(task *models.DownloadTask) (string, error)
 	return filePath, nil
 }
 
+// WorkRoot 返回所有任务工作目录的根目录
+func (g *PathGenerator) WorkRoot() string {
+	return filepath.Join(g.basePath, "work")
+}
+
+// WorkDir 返回任务的本地工作目录，重试期间保持不变以便续传
+func (g *PathGenerator) WorkDir(taskID string) string {
+	return filepath.Join(g.WorkRoot(), taskID)
+}
+
+// StorageKey 生成文件在存储后端中的对象键
+func (g *PathGenerator) StorageKey(task *models.DownloadTask, fileName string) string {
+	switch task.Mode {
+	case "archive":
+		// 归档文件: archive/{user_id}/{YYYYMMDD}/video_{timestamp}.mp4
+		return path.Join("archive", task.UserID, time.Now().Format("20060102"), fileName)
+	default:
+		// 临时文件: tmp/{task_id}/video.mp4
+		return path.Join("tmp", task.TaskID, fileName)
+	}
+}
+
 // GetFileName 从路径获取文件名
 func (g *PathGenerator) GetFileName(filePath string) string {
 	return filepath.Base(filePath)
 }
 
+// SubtitleFile 与主文件一同生成的字幕文件
+type SubtitleFile struct {
+	Path     string
+	Language string
+	Format   string
+}
+
+// SubtitleFiles 查找主文件旁的字幕文件
+// yt-dlp 将字幕命名为 <主文件名去扩展名>.<语言>.<格式>，扩展名与实际容器不一致时为 <主文件名>.<语言>.<格式>
+func (g *PathGenerator) SubtitleFiles(mediaPath string) ([]SubtitleFile, error) {
+	dir := filepath.Dir(mediaPath)
+	base := filepath.Base(mediaPath)
+	stem := strings.TrimSuffix(base, filepath.Ext(base))
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+
+	var files []SubtitleFile
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || name == base {
+			continue
+		}
+
+		var rest string
+		switch {
+		case strings.HasPrefix(name, base+"."):
+			rest = strings.TrimPrefix(name, base+".")
+		case strings.HasPrefix(name, stem+"."):
+			rest = strings.TrimPrefix(name, stem+".")
+		default:
+			continue
+		}
+
+		// rest 形如 en.srt
+		parts := strings.Split(rest, ".")
+		if len(parts) != 2 || parts[0] == "" {
+			continue
+		}
+		format := strings.ToLower(parts[1])
+		if !ytdlp.SubtitleExtensions[format] {
+			continue
+		}
+
+		files = append(files, SubtitleFile{
+			Path:     filepath.Join(dir, name),
+			Language: parts[0],
+			Format:   format,
+		})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+	return files, nil
+}
+
+// ChapterFile 按章节拆分生成的文件
+type ChapterFile struct {
+	Path  string
+	Index int
+	Title string
+}
+
+// 章节文件名（去掉主文件名前缀后）形如 " - 001 Intro.mp4"
+var chapterFileRegexp = regexp.MustCompile(`^ - (\d{3,}) (.*)\.([A-Za-z0-9]+)$`)
+
+// ChapterFiles 查找主文件旁按章节拆分的文件，按章节序号排序
+func (g *PathGenerator) ChapterFiles(mediaPath string) ([]ChapterFile, error) {
+	dir := filepath.Dir(mediaPath)
+	base := filepath.Base(mediaPath)
+	stem := strings.TrimSuffix(base, filepath.Ext(base))
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+
+	var files []ChapterFile
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, stem) {
+			continue
+		}
+
+		match := chapterFileRegexp.FindStringSubmatch(strings.TrimPrefix(name, stem))
+		if match == nil || strings.EqualFold(match[3], "part") {
+			continue
+		}
+		index, err := strconv.Atoi(match[1])
+		if err != nil {
+			continue
+		}
+
+		files = append(files, ChapterFile{
+			Path:  filepath.Join(dir, name),
+			Index: index,
+			Title: match[2],
+		})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Index < files[j].Index
+	})
+	return files, nil
+}
+
 // sanitizeFilename 清理文件名,移除非法字符
 func sanitizeFilename(name string) string {
 	if name == "" {
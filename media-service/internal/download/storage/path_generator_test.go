@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSubtitleFilesFindsSidecarsOfMediaFile(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"clip_1700000000.mp4",
+		"clip_1700000000.en.srt",
+		"clip_1700000000.mp4.zh-Hans.vtt",
+		"clip_1700000000.en.srt.part",
+		"clip_1700000001.en.srt",
+		"other.en.srt",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("x"), 0644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	files, err := (&PathGenerator{}).SubtitleFiles(filepath.Join(dir, "clip_1700000000.mp4"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("expected two subtitle files, got %+v", files)
+	}
+	if files[0].Language != "en" || files[0].Format != "srt" {
+		t.Fatalf("unexpected first subtitle: %+v", files[0])
+	}
+	if files[1].Language != "zh-Hans" || files[1].Format != "vtt" {
+		t.Fatalf("unexpected second subtitle: %+v", files[1])
+	}
+}
+
+func TestChapterFilesFindsSplitChapters(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"clip_1700000000.mp4",
+		"clip_1700000000 - 002 Main Part.mp4",
+		"clip_1700000000 - 001 Intro.mp4",
+		"clip_1700000000 - 003 Outro.mp4.part",
+		"clip_1700000001 - 001 Intro.mp4",
+		"clip_1700000000.en.srt",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("x"), 0644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	files, err := (&PathGenerator{}).ChapterFiles(filepath.Join(dir, "clip_1700000000.mp4"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("expected two chapter files, got %+v", files)
+	}
+	if files[0].Index != 1 || files[0].Title != "Intro" {
+		t.Fatalf("unexpected first chapter: %+v", files[0])
+	}
+	if files[1].Index != 2 || files[1].Title != "Main Part" {
+		t.Fatalf("unexpected second chapter: %+v", files[1])
+	}
+}
@@ -0,0 +1,35 @@
+package worker
+
+import (
+	"youdlp/media-service/internal/platform"
+	"youdlp/media-service/internal/platformpolicy"
+)
+
+// downloadAccessPolicy 下载阶段生效的 Cookie / 代理策略，与解析阶段保持一致
+type downloadAccessPolicy struct {
+	CookiesDisabled bool
+	CookiesRequired bool
+	ProxyDirect     bool
+	ProxyRequired   bool
+}
+
+// accessPolicy 从平台注册表解析下载阶段的访问策略，平台未注册时按 optional 处理
+func (p *Pool) accessPolicy(platformName string) downloadAccessPolicy {
+	policy := downloadAccessPolicy{
+		CookiesDisabled: platformpolicy.IsYouTubePlatform(platformName) && p.youtubePolicy.CookiesDisabled(),
+	}
+	if p.platforms == nil || platformName == "" {
+		return policy
+	}
+	cfg, ok := p.platforms.Get(platformName)
+	if !ok || cfg == nil {
+		return policy
+	}
+	if cfg.CookiesDisabled() {
+		policy.CookiesDisabled = true
+	}
+	policy.CookiesRequired = !policy.CookiesDisabled && cfg.Cookies == platform.AccessRequired
+	policy.ProxyDirect = cfg.Proxy == platform.ProxyDirect
+	policy.ProxyRequired = cfg.Proxy == platform.AccessRequired
+	return policy
+}
@@ -0,0 +1,83 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"youdlp/media-service/internal/download/models"
+	"youdlp/media-service/internal/platform"
+	"youdlp/media-service/internal/utils"
+)
+
+func newPolicyRegistry(t *testing.T) *platform.Registry {
+	t.Helper()
+	registry, err := platform.NewRegistry([]platform.Definition{
+		{Name: "bilibili", Patterns: []string{`bilibili\.com`}, Cookies: platform.CookieDisabled, Proxy: platform.ProxyDirect},
+		{Name: "instagram", Patterns: []string{`instagram\.com`}, Cookies: platform.AccessRequired, Proxy: platform.AccessRequired},
+		{Name: "vimeo", Patterns: []string{`vimeo\.com`}},
+	})
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+	return registry
+}
+
+func TestAccessPolicyFollowsPlatformRegistry(t *testing.T) {
+	pool := &Pool{platforms: newPolicyRegistry(t)}
+
+	cases := map[string]downloadAccessPolicy{
+		"bilibili":  {CookiesDisabled: true, ProxyDirect: true},
+		"instagram": {CookiesRequired: true, ProxyRequired: true},
+		"vimeo":     {},
+		"unknown":   {},
+	}
+	for name, want := range cases {
+		if got := pool.accessPolicy(name); got != want {
+			t.Errorf("accessPolicy(%q) = %+v, want %+v", name, got, want)
+		}
+	}
+}
+
+func TestAccessPolicyWithoutRegistryIsOptional(t *testing.T) {
+	pool := &Pool{}
+	if got := pool.accessPolicy("instagram"); got != (downloadAccessPolicy{}) {
+		t.Fatalf("accessPolicy without registry = %+v, want optional", got)
+	}
+}
+
+type proxyLeaseAssetClient struct {
+	AssetClientInterface
+	lease    *ProxyLease
+	acquired int
+}
+
+func (c *proxyLeaseAssetClient) AcquireProxyForTask(ctx context.Context, taskID, platform string) (*ProxyLease, error) {
+	c.acquired++
+	return c.lease, nil
+}
+
+func TestRefreshTaskProxySkipsLeaseForDirectPlatform(t *testing.T) {
+	client := &proxyLeaseAssetClient{lease: &ProxyLease{URL: "http://proxy:8080", LeaseID: "lease-1"}}
+	pool := &Pool{platforms: newPolicyRegistry(t), assetClient: client}
+	task := &models.DownloadTask{TaskID: "task-1", Platform: "bilibili", ProxyURL: "http://old:8080", ProxyLeaseID: "old"}
+
+	if err := pool.RefreshTaskProxy(context.Background(), task); err != nil {
+		t.Fatalf("RefreshTaskProxy: %v", err)
+	}
+	if client.acquired != 0 {
+		t.Fatalf("acquired %d proxy leases for a direct platform", client.acquired)
+	}
+	if task.ProxyURL != "" || task.ProxyLeaseID != "" {
+		t.Fatalf("proxy fields not cleared: url=%q lease=%q", task.ProxyURL, task.ProxyLeaseID)
+	}
+}
+
+func TestRefreshTaskProxyFailsWhenRequiredProxyUnavailable(t *testing.T) {
+	pool := &Pool{platforms: newPolicyRegistry(t), assetClient: &proxyLeaseAssetClient{}}
+	task := &models.DownloadTask{TaskID: "task-1", Platform: "instagram"}
+
+	if err := pool.RefreshTaskProxy(context.Background(), task); !errors.Is(err, utils.ErrProxyRequired) {
+		t.Fatalf("RefreshTaskProxy error = %v, want ErrProxyRequired", err)
+	}
+}
@@ -0,0 +1,53 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// cancelChannel 跨实例广播任务取消的 Redis 频道
+const cancelChannel = "task:cancel"
+
+// CancelBus 通过 Redis Pub/Sub 将取消请求广播到所有 media-service 实例
+type CancelBus struct {
+	redis *redis.Client
+}
+
+// NewCancelBus 创建取消广播器
+func NewCancelBus(redisClient *redis.Client) *CancelBus {
+	return &CancelBus{
+		redis: redisClient,
+	}
+}
+
+// Broadcast 广播取消请求
+func (b *CancelBus) Broadcast(ctx context.Context, taskID string) error {
+	if err := b.redis.Publish(ctx, cancelChannel, taskID).Err(); err != nil {
+		return fmt.Errorf("failed to broadcast cancel: %w", err)
+	}
+	return nil
+}
+
+// Listen 订阅取消请求并转发给本实例的 Worker 池，阻塞直到 ctx 结束
+func (b *CancelBus) Listen(ctx context.Context, pool *Pool) {
+	pubsub := b.redis.Subscribe(ctx, cancelChannel)
+	defer pubsub.Close()
+
+	log.Printf("[CancelBus] Listening on %s", cancelChannel)
+	ch := pubsub.Channel()
+	for {
+		select {
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			pool.CancelTask(msg.Payload)
+		case <-ctx.Done():
+			log.Println("[CancelBus] Stopped")
+			return
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package worker
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"youdlp/media-service/internal/download/models"
+	"youdlp/media-service/internal/download/storage"
+	"youdlp/media-service/internal/download/ytdlp"
+)
+
+// storageObjectSpec 返回任务对应的去重对象描述，任务产物因人而异（片段、字幕）或缺少视频 ID 时返回 nil
+func storageObjectSpec(task *models.DownloadTask) *models.StorageObject {
+	if task.VideoID == "" || task.Clip != nil || task.Subtitles != nil {
+		return nil
+	}
+
+	platform := task.Platform
+	if platform == "" {
+		platform = task.Metadata.Platform
+	}
+	if platform == "" {
+		return nil
+	}
+
+	formatID := task.FormatID
+	format := task.Format
+	if task.SelectedFormat != nil {
+		if formatID == "" {
+			formatID = task.SelectedFormat.FormatID
+		}
+		if format == "" {
+			format = task.SelectedFormat.Extension
+		}
+	}
+	if format == "" {
+		format = "mp4"
+	}
+
+	var formatKey string
+	if formatID != "" {
+		formatKey = "id:" + formatID + "/" + format
+	} else {
+		formatKey = "q:" + task.Quality + "/" + format
+	}
+	if language := ytdlp.AudioLanguage(task); language != "" {
+		formatKey += "/lang:" + language
+	}
+
+	var profile string
+	if task.PostProcess != nil && task.PostProcess.Profile != "" {
+		profile = task.PostProcess.Profile
+		if task.PostProcess.AudioBitrate > 0 {
+			profile = fmt.Sprintf("%s@%dk", profile, task.PostProcess.AudioBitrate)
+		}
+	}
+
+	return &models.StorageObject{
+		ObjectKey: storage.ObjectKey(platform, task.VideoID, formatKey, profile),
+		Platform:  platform,
+		VideoID:   task.VideoID,
+		FormatKey: formatKey,
+		Profile:   profile,
+	}
+}
+
+// reuseStorageObject 命中去重对象时校验对象并增加引用，成功后任务直接交付该对象
+func (p *Pool) reuseStorageObject(ctx context.Context, task *models.DownloadTask, spec *models.StorageObject) (*models.StorageObject, bool) {
+	taskID := task.TaskID
+
+	obj, err := p.repo.FindObjectByKey(ctx, spec.ObjectKey)
+	if err != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Failed to look up stored object: %v", taskID, err)
+		return nil, false
+	}
+	if obj == nil {
+		return nil, false
+	}
+
+	acquired, err := p.repo.AcquireObject(ctx, obj.ID)
+	if err != nil || !acquired {
+		log.Printf("[Worker] [Task %s] ⚠ Stored object %d not reusable (acquired=%t, err=%v)", taskID, obj.ID, acquired, err)
+		return nil, false
+	}
+
+	if err := p.verifyStorageObject(ctx, obj); err != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Stored object %d failed verification: %v", taskID, obj.ID, err)
+		if errors.Is(err, storage.ErrNotExist) || errors.Is(err, errObjectMismatch) {
+			// 对象已损坏或丢失，移除登记，本任务照常下载
+			if delErr := p.repo.DeleteObject(ctx, obj.ID); delErr != nil {
+				log.Printf("[Worker] [Task %s] ⚠ Failed to delete stored object %d: %v", taskID, obj.ID, delErr)
+			}
+		} else {
+			// 存储后端暂时不可用，仅归还引用
+			p.releaseStorageObject(ctx, taskID, obj.ID)
+		}
+		return nil, false
+	}
+
+	if err := p.repo.SetObjectID(ctx, taskID, obj.ID); err != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Failed to attach stored object %d: %v", taskID, obj.ID, err)
+		p.releaseStorageObject(ctx, taskID, obj.ID)
+		return nil, false
+	}
+
+	return obj, true
+}
+
+var errObjectMismatch = errors.New("stored object mismatch")
+
+// verifyStorageObject 用文件大小和 MD5 校验存储中的对象与登记信息一致
+func (p *Pool) verifyStorageObject(ctx context.Context, obj *models.StorageObject) error {
+	info, err := p.store.Stat(ctx, obj.FilePath)
+	if err != nil {
+		return err
+	}
+	if info.Size != obj.FileSize {
+		return fmt.Errorf("%w: expected size %d, got %d", errObjectMismatch, obj.FileSize, info.Size)
+	}
+
+	reader, err := p.store.Open(ctx, obj.FilePath, 0, -1)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	hasher := md5.New()
+	if _, err := io.Copy(hasher, reader); err != nil {
+		return fmt.Errorf("failed to read stored object: %w", err)
+	}
+	if hash := hex.EncodeToString(hasher.Sum(nil)); hash != obj.FileHash {
+		return fmt.Errorf("%w: expected hash %s, got %s", errObjectMismatch, obj.FileHash, hash)
+	}
+	return nil
+}
+
+// registerStorageObject 将新下载的文件登记为去重对象，登记成功时返回对象，文件应写入对象的存储键；
+// 登记失败或并发任务已登记同一对象时返回 nil，本任务保留独立文件
+func (p *Pool) registerStorageObject(ctx context.Context, task *models.DownloadTask, spec *models.StorageObject, ext string, fileSize int64, fileHash string) *models.StorageObject {
+	taskID := task.TaskID
+
+	objectPath, err := storage.ObjectStorageKey(spec.ObjectKey, ext)
+	if err != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Failed to generate object key: %v", taskID, err)
+		return nil
+	}
+
+	obj := *spec
+	obj.FilePath = objectPath
+	obj.FileSize = fileSize
+	obj.FileHash = fileHash
+	created, err := p.repo.CreateObject(ctx, &obj)
+	if err != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Failed to register stored object: %v", taskID, err)
+		return nil
+	}
+	if !created {
+		log.Printf("[Worker] [Task %s] Stored object already registered by another task, keeping standalone file", taskID)
+		return nil
+	}
+
+	return &obj
+}
+
+// releaseStorageObject 释放任务持有的对象引用，引用归零时删除存储中的对象
+func (p *Pool) releaseStorageObject(ctx context.Context, taskID string, objectID int64) {
+	objectPath, orphaned, err := p.repo.ReleaseObject(ctx, objectID)
+	if err != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Failed to release stored object %d: %v", taskID, objectID, err)
+		return
+	}
+	if orphaned {
+		if err := p.store.Delete(ctx, objectPath); err != nil {
+			log.Printf("[Worker] [Task %s] ⚠ Failed to delete stored object %s: %v", taskID, objectPath, err)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package worker
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"youdlp/media-service/internal/download/models"
+	"youdlp/media-service/internal/download/storage"
+)
+
+func TestStorageObjectSpecSharesKeyForSameFormat(t *testing.T) {
+	first := storageObjectSpec(&models.DownloadTask{
+		TaskID:   "task-1",
+		UserID:   "user-1",
+		Mode:     "archive",
+		Platform: "youtube",
+		VideoID:  "abc",
+		FormatID: "137+140",
+		Format:   "mp4",
+	})
+	second := storageObjectSpec(&models.DownloadTask{
+		TaskID:   "task-2",
+		UserID:   "user-2",
+		Mode:     "quick_download",
+		Platform: "youtube",
+		VideoID:  "abc",
+		Format:   "mp4",
+		SelectedFormat: &models.SelectedFormat{
+			FormatID: "137+140",
+		},
+	})
+	if first == nil || second == nil {
+		t.Fatal("expected both tasks to be eligible for dedup")
+	}
+	if first.ObjectKey != second.ObjectKey {
+		t.Fatalf("expected same object key, got %s and %s", first.ObjectKey, second.ObjectKey)
+	}
+
+	transcoded := storageObjectSpec(&models.DownloadTask{
+		Platform:    "youtube",
+		VideoID:     "abc",
+		FormatID:    "137+140",
+		Format:      "mp4",
+		PostProcess: &models.PostProcessOptions{Profile: "audio_mp3"},
+	})
+	if transcoded == nil || transcoded.ObjectKey == first.ObjectKey {
+		t.Fatal("expected post-processing profile to change object key")
+	}
+
+	dubbed := storageObjectSpec(&models.DownloadTask{
+		Platform:      "youtube",
+		VideoID:       "abc",
+		FormatID:      "137+140",
+		Format:        "mp4",
+		AudioLanguage: "es",
+	})
+	if dubbed == nil || dubbed.ObjectKey == first.ObjectKey {
+		t.Fatal("expected audio language to change object key")
+	}
+}
+
+func TestStorageObjectSpecSkipsPersonalisedOutput(t *testing.T) {
+	for name, task := range map[string]*models.DownloadTask{
+		"no video id": {Platform: "youtube", FormatID: "22"},
+		"clip":        {Platform: "youtube", VideoID: "abc", Clip: &models.ClipOptions{EndTime: 60}},
+		"subtitles":   {Platform: "youtube", VideoID: "abc", Subtitles: &models.SubtitleOptions{Languages: []string{"en"}}},
+	} {
+		if spec := storageObjectSpec(task); spec != nil {
+			t.Fatalf("%s: expected task to skip dedup, got %+v", name, spec)
+		}
+	}
+}
+
+func TestVerifyStorageObjectReadsThroughStorage(t *testing.T) {
+	ctx := context.Background()
+	store := storage.NewLocalStorage(t.TempDir())
+	p := &Pool{store: store}
+
+	if err := store.Put(ctx, "objects/ab/abcdef.mp4", strings.NewReader("video"), 5); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+	sum := md5.Sum([]byte("video"))
+	obj := &models.StorageObject{
+		FilePath: "objects/ab/abcdef.mp4",
+		FileSize: 5,
+		FileHash: hex.EncodeToString(sum[:]),
+	}
+
+	if err := p.verifyStorageObject(ctx, obj); err != nil {
+		t.Fatalf("expected object to verify, got %v", err)
+	}
+
+	corrupted := *obj
+	corrupted.FileHash = "0000"
+	if err := p.verifyStorageObject(ctx, &corrupted); !errors.Is(err, errObjectMismatch) {
+		t.Fatalf("expected hash mismatch, got %v", err)
+	}
+
+	missing := *obj
+	missing.FilePath = "objects/cd/cdef.mp4"
+	if err := p.verifyStorageObject(ctx, &missing); !errors.Is(err, storage.ErrNotExist) {
+		t.Fatalf("expected ErrNotExist, got %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"youdlp/media-service/internal/download/models"
+)
+
+func newDrainTestPool(buffer int) *Pool {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Pool{
+		taskChan:    make(chan *TaskWrapper, buffer),
+		limiter:     newConcurrencyLimiter(1),
+		ctx:         ctx,
+		cancel:      cancel,
+		running:     make(map[string]context.CancelFunc),
+		active:      make(map[string]ActiveTask),
+		cancelled:   make(map[string]time.Time),
+		interrupted: make(map[string]bool),
+		stopping:    make(chan struct{}),
+	}
+}
+
+func TestDrainRequeuesBufferedTasks(t *testing.T) {
+	p := newDrainTestPool(2)
+
+	var results []error
+	p.Submit(&models.DownloadTask{TaskID: "task-1"}, func(err error) { results = append(results, err) })
+	p.Submit(&models.DownloadTask{TaskID: "task-2"}, func(err error) { results = append(results, err) })
+
+	p.Drain(time.Second)
+
+	if len(results) != 2 {
+		t.Fatalf("expected both buffered tasks to be returned, got %d", len(results))
+	}
+	for _, err := range results {
+		if !errors.Is(err, ErrTaskRequeued) {
+			t.Fatalf("expected ErrTaskRequeued, got %v", err)
+		}
+	}
+}
+
+func TestSubmitAfterDrainDoesNotPanic(t *testing.T) {
+	p := newDrainTestPool(1)
+	p.Drain(0)
+
+	var got error
+	p.Submit(&models.DownloadTask{TaskID: "task-1"}, func(err error) { got = err })
+	if !errors.Is(got, ErrTaskRequeued) {
+		t.Fatalf("expected ErrTaskRequeued after drain, got %v", got)
+	}
+	// 重复排空是安全的
+	p.Stop()
+}
+
+func TestInterruptRunningMarksTasks(t *testing.T) {
+	p := newDrainTestPool(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	if !p.trackTask("task-1", time.Time{}, cancel) {
+		t.Fatal("expected task to be tracked")
+	}
+
+	if n := p.interruptRunning(); n != 1 {
+		t.Fatalf("expected 1 interrupted task, got %d", n)
+	}
+	if ctx.Err() == nil {
+		t.Fatal("expected running task context to be cancelled")
+	}
+	if !p.isInterrupted("task-1") {
+		t.Fatal("expected task to be marked interrupted")
+	}
+
+	p.untrackTask("task-1")
+	if p.isInterrupted("task-1") {
+		t.Fatal("expected interrupted mark to be cleared with the task")
+	}
+}
+
+func TestPoolSnapshotReportsActiveTasks(t *testing.T) {
+	p := newDrainTestPool(2)
+	p.size = 1
+	p.markActive(&models.DownloadTask{TaskID: "task-1", UserID: "user-1"}, "youtube")
+	p.Submit(&models.DownloadTask{TaskID: "task-2"}, nil)
+
+	snapshot := p.Snapshot()
+	if snapshot.PoolSize != 1 || snapshot.MaxConcurrent != 1 || snapshot.Buffered != 1 || snapshot.BufferCapacity != 2 {
+		t.Fatalf("unexpected pool snapshot: %+v", snapshot)
+	}
+	if len(snapshot.ActiveTasks) != 1 || snapshot.ActiveTasks[0].Platform != "youtube" {
+		t.Fatalf("expected active task to be reported, got %+v", snapshot.ActiveTasks)
+	}
+
+	p.untrackTask("task-1")
+	if len(p.Snapshot().ActiveTasks) != 0 {
+		t.Fatal("expected finished task to be removed from snapshot")
+	}
+}
+
+func TestPoolCommandValidate(t *testing.T) {
+	valid := []*PoolCommand{
+		{Action: PoolActionSetConcurrency, MaxConcurrent: 4},
+		{Action: PoolActionPause},
+		{Action: PoolActionResume, InstanceID: "host-1"},
+	}
+	for _, cmd := range valid {
+		if err := cmd.Validate(); err != nil {
+			t.Fatalf("expected %+v to be valid, got %v", cmd, err)
+		}
+	}
+
+	invalid := []*PoolCommand{
+		{Action: PoolActionSetConcurrency},
+		{Action: PoolActionSetConcurrency, MaxConcurrent: maxPoolConcurrency + 1},
+		{Action: "restart"},
+	}
+	for _, cmd := range invalid {
+		if err := cmd.Validate(); err == nil {
+			t.Fatalf("expected %+v to be rejected", cmd)
+		}
+	}
+}
@@ -4,10 +4,16 @@ import "errors"
 
 // 错误定义
 var (
-	ErrProxyUnavailable  = errors.New("proxy unavailable")
-	ErrProxyMissing      = errors.New("proxy lease missing from download task")
-	ErrDownloadTimeout   = errors.New("download timeout")
-	ErrVideoNotFound     = errors.New("video not found")
-	ErrInsufficientSpace = errors.New("insufficient disk space")
-	ErrTaskCancelled     = errors.New("task cancelled")
+	ErrProxyUnavailable   = errors.New("proxy unavailable")
+	ErrProxyMissing       = errors.New("proxy lease missing from download task")
+	ErrDownloadTimeout    = errors.New("download timeout")
+	ErrVideoNotFound      = errors.New("video not found")
+	ErrInsufficientSpace  = errors.New("insufficient disk space")
+	ErrTaskCancelled      = errors.New("task cancelled")
+	ErrTaskAlreadyRunning = errors.New("task already running on another worker")
+	ErrHeartbeatLost      = errors.New("worker heartbeat lost")
+	ErrTaskOwnershipLost  = errors.New("task ownership lost to another worker")
+	ErrTaskRequeued       = errors.New("task requeued for worker shutdown")
+	ErrTaskDeferred       = errors.New("task deferred by per-user concurrency limit")
+	ErrPlatformPaused     = errors.New("platform paused or rate limited")
 )
@@ -0,0 +1,175 @@
+package worker
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"youdlp/media-service/internal/download/config"
+)
+
+// claimHeartbeatScript 写入任务心跳。心跳已存在时，仅当其写入时间早于任务重放时间
+// （管理端重放了卡住的任务，原执行者已被放弃）才允许接管。心跳值末尾为写入时间（毫秒）。
+var claimHeartbeatScript = redis.NewScript(`
+local current = redis.call("GET", KEYS[1])
+if current then
+	local claimedAt = tonumber(string.match(current, ":(%d+)$"))
+	if not claimedAt or tonumber(ARGV[3]) <= claimedAt then
+		return 0
+	end
+end
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+return 1
+`)
+
+// refreshHeartbeatScript 仅在心跳仍归属当前执行者时续期
+var refreshHeartbeatScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseHeartbeatScript 仅在心跳仍归属当前执行者时删除
+var releaseHeartbeatScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// Heartbeats 运行中任务的 Redis 心跳。心跳同时作为任务的执行租约，
+// 同一任务被重复投递时只有持有心跳的执行者会继续处理。
+type Heartbeats struct {
+	redis      *redis.Client
+	instanceID string
+	interval   time.Duration
+	ttl        time.Duration
+}
+
+// NewHeartbeats 创建任务心跳
+func NewHeartbeats(redisClient *redis.Client, cfg *config.ReaperConfig) *Heartbeats {
+	return &Heartbeats{
+		redis:      redisClient,
+		instanceID: instanceID(),
+		interval:   time.Duration(cfg.HeartbeatInterval) * time.Second,
+		ttl:        time.Duration(cfg.HeartbeatTTL) * time.Second,
+	}
+}
+
+// TTL 心跳过期时间
+func (h *Heartbeats) TTL() time.Duration {
+	return h.ttl
+}
+
+// Claim 为任务写入心跳并持续续期，直到调用返回的 release。
+// 任务的心跳已被其他执行者持有时返回 ok=false；Redis 不可用时放行，避免心跳故障阻塞下载。
+// 续期时发现心跳已被其他执行者接管（例如管理端重放了任务）则调用一次 onLost 并停止续期。
+func (h *Heartbeats) Claim(ctx context.Context, taskID string, replayedAt time.Time, onLost func()) (release func(), ok bool) {
+	token := fmt.Sprintf("%s:%s:%d", h.instanceID, randomToken(), time.Now().UnixMilli())
+	key := heartbeatKey(taskID)
+
+	var replayedAtMillis int64
+	if !replayedAt.IsZero() {
+		replayedAtMillis = replayedAt.UnixMilli()
+	}
+	claimed, err := claimHeartbeatScript.Run(ctx, h.redis, []string{key}, token, h.ttl.Milliseconds(), replayedAtMillis).Int()
+	if err != nil {
+		log.Printf("[Heartbeat] ⚠ Failed to claim heartbeat for task %s, continuing without it: %v", taskID, err)
+		return func() {}, true
+	}
+	if claimed == 0 {
+		return nil, false
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(h.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				beatCtx, cancel := context.WithTimeout(context.Background(), h.interval)
+				res, err := refreshHeartbeatScript.Run(beatCtx, h.redis, []string{key}, token, h.ttl.Milliseconds()).Int()
+				cancel()
+				if err != nil {
+					log.Printf("[Heartbeat] ⚠ Failed to refresh heartbeat for task %s: %v", taskID, err)
+				} else if res == 0 {
+					log.Printf("[Heartbeat] ⚠ Heartbeat for task %s is no longer owned by this worker, stopping task", taskID)
+					if onLost != nil {
+						onLost()
+					}
+					return
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+		<-done
+		releaseCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := releaseHeartbeatScript.Run(releaseCtx, h.redis, []string{key}, token).Err(); err != nil {
+			log.Printf("[Heartbeat] ⚠ Failed to release heartbeat for task %s: %v", taskID, err)
+		}
+	}, true
+}
+
+// Alive 批量检查任务心跳是否仍在
+func (h *Heartbeats) Alive(ctx context.Context, taskIDs []string) (map[string]bool, error) {
+	alive := make(map[string]bool, len(taskIDs))
+	if len(taskIDs) == 0 {
+		return alive, nil
+	}
+
+	pipe := h.redis.Pipeline()
+	cmds := make(map[string]*redis.IntCmd, len(taskIDs))
+	for _, taskID := range taskIDs {
+		cmds[taskID] = pipe.Exists(ctx, heartbeatKey(taskID))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to check heartbeats: %w", err)
+	}
+	for taskID, cmd := range cmds {
+		alive[taskID] = cmd.Val() > 0
+	}
+	return alive, nil
+}
+
+// ClaimReap 多个实例同时扫描时，只有一个回收器处理同一个失联任务
+func (h *Heartbeats) ClaimReap(ctx context.Context, taskID string, ttl time.Duration) (bool, error) {
+	return h.redis.SetNX(ctx, reapLockKey(taskID), h.instanceID, ttl).Result()
+}
+
+// instanceID 当前 media-service 实例标识
+func instanceID() string {
+	hostname, _ := os.Hostname()
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}
+
+func randomToken() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(buf)
+}
+
+func heartbeatKey(taskID string) string {
+	return fmt.Sprintf("task:heartbeat:%s", taskID)
+}
+
+func reapLockKey(taskID string) string {
+	return fmt.Sprintf("task:reap:%s", taskID)
+}
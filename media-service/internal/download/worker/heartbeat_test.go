@@ -0,0 +1,30 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"youdlp/media-service/internal/download/models"
+)
+
+func TestOwnershipLostSkipsFailureAndCompletion(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(ErrTaskOwnershipLost)
+
+	// 未注入仓库与发布器：若继续写入失败或完成状态会直接 panic
+	p := &Pool{}
+	task := &models.DownloadTask{TaskID: "task-1"}
+	if err := p.handleError(ctx, task, context.Canceled); !errors.Is(err, ErrTaskOwnershipLost) {
+		t.Fatalf("expected ownership lost error, got %v", err)
+	}
+	if err := p.finishTask(ctx, task, &taskOutput{path: "/tmp/out.mp4"}); !errors.Is(err, ErrTaskOwnershipLost) {
+		t.Fatalf("expected completion to be skipped, got %v", err)
+	}
+
+	plain, stop := context.WithCancel(context.Background())
+	stop()
+	if ownershipLost(plain) {
+		t.Fatal("expected plain cancellation not to count as ownership loss")
+	}
+}
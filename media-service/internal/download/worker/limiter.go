@@ -0,0 +1,73 @@
+package worker
+
+import "sync"
+
+// concurrencyLimiter 可在运行时调整上限的信号量。上限调低时已占用的名额不受影响，
+// 运行中的任务结束后占用数自然回落到新上限以内
+type concurrencyLimiter struct {
+	mu    sync.Mutex
+	max   int
+	inUse int
+	// wake 每次释放名额或调整上限时关闭并替换，唤醒所有等待者
+	wake chan struct{}
+}
+
+func newConcurrencyLimiter(max int) *concurrencyLimiter {
+	return &concurrencyLimiter{
+		max:  max,
+		wake: make(chan struct{}),
+	}
+}
+
+// Acquire 占用一个名额，abort 关闭时放弃等待并返回 false
+func (l *concurrencyLimiter) Acquire(abort <-chan struct{}) bool {
+	for {
+		l.mu.Lock()
+		if l.inUse < l.max {
+			l.inUse++
+			l.mu.Unlock()
+			return true
+		}
+		wake := l.wake
+		l.mu.Unlock()
+
+		select {
+		case <-wake:
+		case <-abort:
+			return false
+		}
+	}
+}
+
+// Release 释放一个名额
+func (l *concurrencyLimiter) Release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.inUse > 0 {
+		l.inUse--
+	}
+	l.broadcastLocked()
+}
+
+// SetMax 调整并发上限
+func (l *concurrencyLimiter) SetMax(max int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.max = max
+	l.broadcastLocked()
+}
+
+// Usage 返回已占用的名额与当前上限
+func (l *concurrencyLimiter) Usage() (inUse, max int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.inUse, l.max
+}
+
+func (l *concurrencyLimiter) broadcastLocked() {
+	close(l.wake)
+	l.wake = make(chan struct{})
+}
@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConcurrencyLimiterRaisesLimitAtRuntime(t *testing.T) {
+	l := newConcurrencyLimiter(1)
+	abort := make(chan struct{})
+
+	if !l.Acquire(abort) {
+		t.Fatal("expected first acquire to succeed")
+	}
+
+	acquired := make(chan bool, 1)
+	go func() { acquired <- l.Acquire(abort) }()
+
+	select {
+	case <-acquired:
+		t.Fatal("expected second acquire to wait at the limit")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	l.SetMax(2)
+	select {
+	case ok := <-acquired:
+		if !ok {
+			t.Fatal("expected second acquire to succeed after raising the limit")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected raising the limit to wake the waiter")
+	}
+	if inUse, max := l.Usage(); inUse != 2 || max != 2 {
+		t.Fatalf("expected 2/2 in use, got %d/%d", inUse, max)
+	}
+}
+
+func TestConcurrencyLimiterLoweredLimitAppliesAfterRelease(t *testing.T) {
+	l := newConcurrencyLimiter(2)
+	abort := make(chan struct{})
+	l.Acquire(abort)
+	l.Acquire(abort)
+
+	l.SetMax(1)
+	l.Release()
+
+	acquired := make(chan bool, 1)
+	go func() { acquired <- l.Acquire(abort) }()
+	select {
+	case <-acquired:
+		t.Fatal("expected acquire to wait while usage is at the lowered limit")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(abort)
+	if ok := <-acquired; ok {
+		t.Fatal("expected aborted acquire to return false")
+	}
+}
@@ -0,0 +1,126 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"youdlp/media-service/internal/download/config"
+	"youdlp/media-service/internal/download/models"
+	"youdlp/media-service/internal/download/repository"
+)
+
+// PlatformPauses 平台冷却暂停状态。asset-service 在平台连续触发风控后写入 platform_risk_states.cooldown_until，
+// 冷却期内暂停该平台队列的消费，已投递的任务延后重新投递，任务留在队列中等待而不是失败
+type PlatformPauses struct {
+	repo     *repository.DownloadRepository
+	interval time.Duration
+
+	mu       sync.RWMutex
+	until    map[string]time.Time
+	onChange []func(platform string, paused bool)
+}
+
+// NewPlatformPauses 创建平台冷却暂停状态，未配置检查间隔时返回 nil
+func NewPlatformPauses(repo *repository.DownloadRepository, cfg *config.PartitionConfig) *PlatformPauses {
+	if cfg == nil || cfg.PauseCheckInterval <= 0 {
+		return nil
+	}
+	return &PlatformPauses{
+		repo:     repo,
+		interval: time.Duration(cfg.PauseCheckInterval) * time.Second,
+		until:    make(map[string]time.Time),
+	}
+}
+
+// Start 定期同步平台冷却状态，阻塞直到 ctx 结束
+func (p *PlatformPauses) Start(ctx context.Context) {
+	log.Printf("[PlatformPauses] Watching platform cooldowns, interval: %v", p.interval)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	p.refresh(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			p.refresh(ctx)
+		case <-ctx.Done():
+			log.Println("[PlatformPauses] Stopped")
+			return
+		}
+	}
+}
+
+// OnChange 注册平台暂停状态变化的回调
+func (p *PlatformPauses) OnChange(fn func(platform string, paused bool)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.onChange = append(p.onChange, fn)
+}
+
+// Paused 平台当前是否处于冷却期
+func (p *PlatformPauses) Paused(platform string) bool {
+	if p == nil || platform == "" {
+		return false
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.until[strings.ToLower(platform)].After(time.Now())
+}
+
+func (p *PlatformPauses) refresh(ctx context.Context) {
+	now := time.Now()
+	cooldowns, err := p.repo.FindPlatformCooldowns(ctx, now)
+	if err != nil {
+		log.Printf("[PlatformPauses] ⚠ Failed to load platform cooldowns: %v", err)
+		return
+	}
+	p.apply(cooldowns, now)
+}
+
+// apply 更新冷却状态，并对暂停或恢复的平台触发回调
+func (p *PlatformPauses) apply(cooldowns map[string]time.Time, now time.Time) {
+	until := make(map[string]time.Time, len(cooldowns))
+	for platform, t := range cooldowns {
+		if t.After(now) {
+			until[strings.ToLower(platform)] = t
+		}
+	}
+
+	p.mu.Lock()
+	previous := p.until
+	p.until = until
+	listeners := append([]func(string, bool){}, p.onChange...)
+	p.mu.Unlock()
+
+	for platform, t := range until {
+		if _, ok := previous[platform]; !ok {
+			log.Printf("[PlatformPauses] Platform %s cooling down until %s, pausing", platform, t.Format(time.RFC3339))
+			for _, fn := range listeners {
+				fn(platform, true)
+			}
+		}
+	}
+	for platform := range previous {
+		if _, ok := until[platform]; !ok {
+			log.Printf("[PlatformPauses] ✓ Platform %s cooldown ended, resuming", platform)
+			for _, fn := range listeners {
+				fn(platform, false)
+			}
+		}
+	}
+}
+
+// taskPlatform 任务所属平台（小写）
+func taskPlatform(task *models.DownloadTask) string {
+	platform := task.Metadata.Platform
+	if platform == "" {
+		platform = task.Platform
+	}
+	return strings.ToLower(platform)
+}
@@ -0,0 +1,65 @@
+package worker
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"youdlp/media-service/internal/download/models"
+)
+
+func TestPlatformPausesApplyNotifiesTransitions(t *testing.T) {
+	pauses := &PlatformPauses{until: make(map[string]time.Time)}
+
+	changes := make(map[string]bool)
+	pauses.OnChange(func(platform string, paused bool) { changes[platform] = paused })
+
+	now := time.Now()
+	pauses.apply(map[string]time.Time{
+		"YouTube":  now.Add(5 * time.Minute),
+		"bilibili": now.Add(-time.Minute),
+	}, now)
+
+	if paused, ok := changes["youtube"]; !ok || !paused {
+		t.Fatalf("expected youtube to be paused, got %v", changes)
+	}
+	if _, ok := changes["bilibili"]; ok {
+		t.Fatalf("expected expired cooldown to be ignored, got %v", changes)
+	}
+	if !pauses.Paused("youtube") || pauses.Paused("bilibili") {
+		t.Fatal("unexpected paused state after apply")
+	}
+
+	changes = make(map[string]bool)
+	pauses.apply(map[string]time.Time{"youtube": now.Add(10 * time.Minute)}, now)
+	if len(changes) != 0 {
+		t.Fatalf("expected no transitions while cooldown continues, got %v", changes)
+	}
+
+	pauses.apply(nil, now)
+	if paused, ok := changes["youtube"]; !ok || paused {
+		t.Fatalf("expected youtube to resume, got %v", changes)
+	}
+	if pauses.Paused("youtube") {
+		t.Fatal("expected youtube to be resumed")
+	}
+}
+
+func TestSubmitDefersPausedPlatform(t *testing.T) {
+	p := newDrainTestPool(1)
+	p.pauses = &PlatformPauses{until: map[string]time.Time{"youtube": time.Now().Add(time.Minute)}}
+
+	var got error
+	p.Submit(&models.DownloadTask{TaskID: "task-1", Platform: "youtube"}, func(err error) { got = err })
+	if !errors.Is(got, ErrPlatformPaused) {
+		t.Fatalf("expected ErrPlatformPaused, got %v", got)
+	}
+	if len(p.taskChan) != 0 {
+		t.Fatal("expected paused task not to enter the pool")
+	}
+
+	p.Submit(&models.DownloadTask{TaskID: "task-2", Platform: "bilibili"}, nil)
+	if len(p.taskChan) != 1 {
+		t.Fatal("expected other platforms to be submitted")
+	}
+}
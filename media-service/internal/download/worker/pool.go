@@ -2,24 +2,43 @@ package worker
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
+	"path"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
 	dlclient "youdlp/media-service/internal/download/client"
 	"youdlp/media-service/internal/download/config"
 	"youdlp/media-service/internal/download/models"
+	"youdlp/media-service/internal/download/postprocess"
 	"youdlp/media-service/internal/download/repository"
 	"youdlp/media-service/internal/download/storage"
 	"youdlp/media-service/internal/download/ytdlp"
+	"youdlp/media-service/internal/platform"
 	"youdlp/media-service/internal/platformpolicy"
 	"youdlp/media-service/internal/ratelimit"
 	"youdlp/media-service/internal/redact"
 	"youdlp/media-service/internal/utils"
 )
 
+// cancelledTaskRetention 取消标记的最长保留时间，覆盖任务在本地缓冲区中的等待时长
+const cancelledTaskRetention = time.Hour
+
+// postProcessEndPercent 后处理阶段结束时的整体进度，剩余部分留给哈希计算与落库
+const postProcessEndPercent = 98.0
+
+// 任务 Webhook 事件类型，与 Asset Service 保持一致
+const (
+	webhookEventTaskCompleted = "task.completed"
+	webhookEventTaskFailed    = "task.failed"
+)
+
 // AssetClientInterface Asset 服务客户端接口
 type AssetClientInterface interface {
 	GetCookieContent(cookieID int64, platform, taskID string) (string, error)
@@ -32,32 +51,73 @@ type AssetClientInterface interface {
 	UpdateHistoryFailed(taskID, errorMessage string) error
 	CaptureIngressUsage(taskID string, actualIngressBytes int64) error
 	ReleaseInitialDownload(taskID, reason string) error
+	EmitWebhookEvent(userID, eventType string, data map[string]interface{}) error
 }
 
 type ProxyLease = dlclient.ProxyLease
 
+// downloadRepository Worker 读写下载记录与去重对象所需的仓储能力
+type downloadRepository interface {
+	FindByTaskID(ctx context.Context, taskID string) (*models.DownloadHistory, error)
+	UpdateProcessing(ctx context.Context, taskID string) error
+	UpdateComplete(ctx context.Context, taskID, filePath, fileName, fileHash string, fileSize int64, expireAt *time.Time) error
+	UpdateFailed(ctx context.Context, taskID, errorMsg string, retryCount int) error
+	IncrementRetry(ctx context.Context, taskID string) error
+	MarkDeadLettered(ctx context.Context, taskID, errorCategory, errorMsg string) error
+	FindProcessingStartedBefore(ctx context.Context, before time.Time, batchSize int) ([]*models.DownloadHistory, error)
+	RequeueLapsed(ctx context.Context, taskID string) (bool, error)
+	ResetInterrupted(ctx context.Context, taskID string) error
+	SaveTaskPayload(ctx context.Context, taskID string, payload []byte) error
+	FindTaskPayload(ctx context.Context, taskID string) ([]byte, error)
+	SaveFiles(ctx context.Context, taskID string, files []models.DownloadFile) error
+	FindObjectByKey(ctx context.Context, objectKey string) (*models.StorageObject, error)
+	CreateObject(ctx context.Context, obj *models.StorageObject) (bool, error)
+	AcquireObject(ctx context.Context, objectID int64) (bool, error)
+	ReleaseObject(ctx context.Context, objectID int64) (string, bool, error)
+	DeleteObject(ctx context.Context, objectID int64) error
+	SetObjectID(ctx context.Context, taskID string, objectID int64) error
+}
+
 // Pool Worker 池
 type Pool struct {
-	size      int
-	taskChan  chan *TaskWrapper
-	semaphore chan struct{}
-	wg        sync.WaitGroup
-	ctx       context.Context
-	cancel    context.CancelFunc
+	size     int // Worker 协程数，运行时调高并发时随之增加
+	sizeMu   sync.Mutex
+	taskChan chan *TaskWrapper
+	limiter  *concurrencyLimiter
+	wg       sync.WaitGroup
+	ctx      context.Context
+	cancel   context.CancelFunc
+
+	// 运行中任务的取消函数与基本信息，已被用户取消但尚未出队的任务，以及因实例关闭被中断的任务
+	tasksMu     sync.Mutex
+	running     map[string]context.CancelFunc
+	active      map[string]ActiveTask
+	cancelled   map[string]time.Time
+	interrupted map[string]bool
+
+	// stopping 关闭后不再接收新任务，进入排空流程
+	stopping chan struct{}
+	stopOnce sync.Once
 
 	// 依赖
-	repo              *repository.DownloadRepository
+	repo              downloadRepository
 	executor          *ytdlp.Executor
+	postProcessor     *postprocess.Pipeline
 	pathGenerator     *storage.PathGenerator
 	fileManager       *storage.FileManager
+	store             storage.Storage
 	progressPublisher *ProgressPublisher
 	assetClient       AssetClientInterface // 新增：Asset 客户端
+	heartbeats        *Heartbeats
+	scheduler         *FairScheduler
+	pauses            *PlatformPauses
 
 	// 配置
 	storageCfg      *config.StorageConfig
 	retryCfg        *config.RetryConfig
 	youtubePolicy   platformpolicy.YouTubePolicy
 	platformLimiter *ratelimit.PlatformLimiter
+	platforms       *platform.Registry // 平台 Cookie / 代理策略，为空时按 optional 处理
 }
 
 // TaskWrapper 任务包装器
@@ -73,31 +133,48 @@ func NewPool(
 	retryCfg *config.RetryConfig,
 	repo *repository.DownloadRepository,
 	executor *ytdlp.Executor,
+	postProcessor *postprocess.Pipeline,
 	pathGenerator *storage.PathGenerator,
 	fileManager *storage.FileManager,
+	store storage.Storage,
 	progressPublisher *ProgressPublisher,
 	assetClient AssetClientInterface, // 新增：Asset 客户端（可选）
 	youtubePolicy platformpolicy.YouTubePolicy,
+	platforms *platform.Registry,
 	platformLimiter *ratelimit.PlatformLimiter,
+	heartbeats *Heartbeats,
+	scheduler *FairScheduler,
+	pauses *PlatformPauses,
 ) *Pool {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	pool := &Pool{
 		size:              cfg.PoolSize,
 		taskChan:          make(chan *TaskWrapper, cfg.PoolSize*2),
-		semaphore:         make(chan struct{}, cfg.MaxConcurrent),
+		limiter:           newConcurrencyLimiter(cfg.MaxConcurrent),
 		ctx:               ctx,
 		cancel:            cancel,
+		running:           make(map[string]context.CancelFunc),
+		active:            make(map[string]ActiveTask),
+		cancelled:         make(map[string]time.Time),
+		interrupted:       make(map[string]bool),
+		stopping:          make(chan struct{}),
 		repo:              repo,
 		executor:          executor,
+		postProcessor:     postProcessor,
 		pathGenerator:     pathGenerator,
 		fileManager:       fileManager,
+		store:             store,
 		progressPublisher: progressPublisher,
 		assetClient:       assetClient, // 新增
 		storageCfg:        storageCfg,
 		retryCfg:          retryCfg,
 		youtubePolicy:     youtubePolicy,
+		platforms:         platforms,
 		platformLimiter:   platformLimiter,
+		heartbeats:        heartbeats,
+		scheduler:         scheduler,
+		pauses:            pauses,
 	}
 
 	return pool
@@ -105,6 +182,9 @@ func NewPool(
 
 // Start 启动 Worker 池
 func (p *Pool) Start() {
+	p.sizeMu.Lock()
+	defer p.sizeMu.Unlock()
+
 	log.Printf("[WorkerPool] Starting %d workers", p.size)
 	for i := 0; i < p.size; i++ {
 		p.wg.Add(1)
@@ -112,32 +192,106 @@ func (p *Pool) Start() {
 	}
 }
 
-// Stop 停止 Worker 池
+// SetMaxConcurrent 运行时调整并发上限，上限超过 Worker 协程数时补充协程
+func (p *Pool) SetMaxConcurrent(max int) {
+	p.limiter.SetMax(max)
+
+	p.sizeMu.Lock()
+	defer p.sizeMu.Unlock()
+
+	if p.isStopping() {
+		return
+	}
+	for p.size < max {
+		p.wg.Add(1)
+		go p.worker(p.size)
+		p.size++
+	}
+	log.Printf("[WorkerPool] ✓ Max concurrent set to %d (workers: %d)", max, p.size)
+}
+
+// Drain 排空 Worker 池：不再接收新任务，缓冲区中尚未开始的任务以 ErrTaskRequeued 交回队列；
+// 运行中的任务在 grace 内正常完成，超时后中断并重新入队
+func (p *Pool) Drain(grace time.Duration) {
+	p.stopOnce.Do(func() {
+		log.Printf("[WorkerPool] Draining workers, grace period: %v", grace)
+		p.sizeMu.Lock()
+		close(p.stopping)
+		p.sizeMu.Unlock()
+
+		done := make(chan struct{})
+		go func() {
+			p.wg.Wait()
+			close(done)
+		}()
+
+		timer := time.NewTimer(grace)
+		defer timer.Stop()
+		select {
+		case <-done:
+		case <-timer.C:
+			log.Printf("[WorkerPool] ⚠ Grace period elapsed, interrupting %d running tasks", p.interruptRunning())
+			<-done
+		}
+
+		// Worker 退出后才提交成功的任务同样交回队列
+		p.requeueBuffered()
+		p.cancel()
+		log.Println("[WorkerPool] All workers stopped")
+	})
+}
+
+// Stop 立即停止 Worker 池，运行中的任务被中断并重新入队
 func (p *Pool) Stop() {
-	log.Println("[WorkerPool] Stopping workers...")
-	p.cancel()
-	close(p.taskChan)
-	p.wg.Wait()
-	log.Println("[WorkerPool] All workers stopped")
+	p.Drain(0)
 }
 
-// Submit 提交任务
+// Submit 提交任务，Worker 池排空后提交的任务直接以 ErrTaskRequeued 回调，
+// 平台冷却中的任务以 ErrPlatformPaused 回调，用户已占满名额的任务以 ErrTaskDeferred 回调
 func (p *Pool) Submit(task *models.DownloadTask, callback func(error)) {
 	log.Printf("[WorkerPool] Submitting task %s to channel (current queue size: %d/%d)",
 		task.TaskID, len(p.taskChan), cap(p.taskChan))
+	if p.pauses.Paused(taskPlatform(task)) {
+		log.Printf("[WorkerPool] Platform %s is cooling down, deferring task %s", taskPlatform(task), task.TaskID)
+		if callback != nil {
+			callback(ErrPlatformPaused)
+		}
+		return
+	}
+	if p.scheduler != nil {
+		release, ok := p.scheduler.Admit(task)
+		if !ok {
+			log.Printf("[WorkerPool] User %s reached concurrent task limit, deferring task %s", task.UserID, task.TaskID)
+			if callback != nil {
+				callback(ErrTaskDeferred)
+			}
+			return
+		}
+		done := callback
+		callback = func(err error) {
+			release()
+			if done != nil {
+				done(err)
+			}
+		}
+	}
 	wrapper := &TaskWrapper{
 		Task:     task,
 		Callback: callback,
 	}
 
+	select {
+	case <-p.stopping:
+		p.requeue(wrapper)
+		return
+	default:
+	}
+
 	select {
 	case p.taskChan <- wrapper:
 		log.Printf("[WorkerPool] ✓ Task %s submitted successfully", task.TaskID)
-	case <-p.ctx.Done():
-		log.Printf("[WorkerPool] ❌ Failed to submit task %s: context cancelled", task.TaskID)
-		if callback != nil {
-			callback(p.ctx.Err())
-		}
+	case <-p.stopping:
+		p.requeue(wrapper)
 	}
 }
 
@@ -148,17 +302,16 @@ func (p *Pool) worker(id int) {
 
 	for {
 		select {
-		case wrapper, ok := <-p.taskChan:
-			if !ok {
-				log.Printf("[Worker %d] Channel closed, exiting", id)
-				return
+		case wrapper := <-p.taskChan:
+			if p.isStopping() {
+				p.requeue(wrapper)
+				continue
 			}
 
-			// 获取信号量
-			select {
-			case p.semaphore <- struct{}{}:
-			case <-p.ctx.Done():
-				return
+			// 获取并发名额
+			if !p.limiter.Acquire(p.stopping) {
+				p.requeue(wrapper)
+				continue
 			}
 
 			log.Printf("[Worker %d] ▶ Starting to process task: %s (URL: %s)", id, wrapper.Task.TaskID, wrapper.Task.URL)
@@ -172,38 +325,199 @@ func (p *Pool) worker(id int) {
 				log.Printf("[Worker %d] ✓ Task %s completed successfully in %v", id, wrapper.Task.TaskID, duration)
 			}
 
-			// 释放信号量
-			<-p.semaphore
+			// 释放并发名额
+			p.limiter.Release()
 
 			if wrapper.Callback != nil {
 				wrapper.Callback(err)
 			}
 
-		case <-p.ctx.Done():
-			log.Printf("[Worker %d] Context cancelled, exiting", id)
+		case <-p.stopping:
+			p.requeueBuffered()
+			log.Printf("[Worker %d] Pool draining, exiting", id)
 			return
 		}
 	}
 }
 
+func (p *Pool) isStopping() bool {
+	select {
+	case <-p.stopping:
+		return true
+	default:
+		return false
+	}
+}
+
+// requeueBuffered 将缓冲区中尚未开始的任务交回队列
+func (p *Pool) requeueBuffered() {
+	for {
+		select {
+		case wrapper := <-p.taskChan:
+			p.requeue(wrapper)
+		default:
+			return
+		}
+	}
+}
+
+func (p *Pool) requeue(wrapper *TaskWrapper) {
+	log.Printf("[WorkerPool] Task %s not started before shutdown, returning to queue", wrapper.Task.TaskID)
+	if wrapper.Callback != nil {
+		wrapper.Callback(ErrTaskRequeued)
+	}
+}
+
+// interruptRunning 中断所有运行中的任务，返回中断的任务数
+func (p *Pool) interruptRunning() int {
+	p.tasksMu.Lock()
+	defer p.tasksMu.Unlock()
+
+	for taskID, cancel := range p.running {
+		log.Printf("[WorkerPool] Interrupting running task %s for shutdown", taskID)
+		p.interrupted[taskID] = true
+		cancel()
+	}
+	return len(p.running)
+}
+
+func (p *Pool) isInterrupted(taskID string) bool {
+	p.tasksMu.Lock()
+	defer p.tasksMu.Unlock()
+
+	return p.interrupted[taskID]
+}
+
+// CancelTask 取消任务：运行中的任务会终止 yt-dlp 进程，仍在缓冲区的任务出队时直接跳过。
+// 返回任务当前是否正在本实例运行。
+func (p *Pool) CancelTask(taskID string) bool {
+	p.tasksMu.Lock()
+	defer p.tasksMu.Unlock()
+
+	now := time.Now()
+	for id, markedAt := range p.cancelled {
+		if now.Sub(markedAt) > cancelledTaskRetention {
+			delete(p.cancelled, id)
+		}
+	}
+	p.cancelled[taskID] = now
+
+	cancel, ok := p.running[taskID]
+	if ok {
+		log.Printf("[WorkerPool] Cancelling running task %s", taskID)
+		cancel()
+	}
+	return ok
+}
+
+// trackTask 登记运行中的任务，任务已被取消时返回 false。
+// 管理端重放的任务只受重放之后的取消标记约束。
+func (p *Pool) trackTask(taskID string, replayedAt time.Time, cancel context.CancelFunc) bool {
+	p.tasksMu.Lock()
+	defer p.tasksMu.Unlock()
+
+	if markedAt, ok := p.cancelled[taskID]; ok {
+		if replayedAt.IsZero() || markedAt.After(replayedAt) {
+			return false
+		}
+		delete(p.cancelled, taskID)
+	}
+	p.running[taskID] = cancel
+	return true
+}
+
+// ownershipLost 任务的心跳租约是否已被其他 Worker 接管
+func ownershipLost(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), ErrTaskOwnershipLost)
+}
+
+// untrackTask 清理任务登记信息
+func (p *Pool) untrackTask(taskID string) {
+	p.tasksMu.Lock()
+	defer p.tasksMu.Unlock()
+
+	delete(p.running, taskID)
+	delete(p.active, taskID)
+	delete(p.cancelled, taskID)
+	delete(p.interrupted, taskID)
+}
+
+func (p *Pool) isCancelled(taskID string) bool {
+	p.tasksMu.Lock()
+	defer p.tasksMu.Unlock()
+
+	_, ok := p.cancelled[taskID]
+	return ok
+}
+
+// isTerminatedInStore 检查任务是否已在数据库中结束：取消、强制失败等请求可能先于消息投递到达，
+// 重试等待期间被终止的任务也在此跳过
+func (p *Pool) isTerminatedInStore(ctx context.Context, taskID string) bool {
+	if p.repo == nil {
+		return false
+	}
+	record, err := p.repo.FindByTaskID(ctx, taskID)
+	if err != nil || record == nil {
+		return false
+	}
+	return record.Status != models.StatusPending && record.Status != models.StatusProcessing
+}
+
 // processTask 处理下载任务
-func (p *Pool) processTask(task *models.DownloadTask) error {
-	ctx := p.ctx
+func (p *Pool) processTask(task *models.DownloadTask) (retErr error) {
 	taskID := task.TaskID
 	platform := task.Metadata.Platform
 	if platform == "" {
 		platform = task.Platform
 	}
 
+	ctx, cancelCause := context.WithCancelCause(p.ctx)
+	cancel := func() { cancelCause(context.Canceled) }
+	defer cancel()
+
+	// 心跳同时作为执行租约：重复投递的任务已在其他 Worker 上运行时直接跳过；
+	// 运行中租约被其他 Worker 接管时终止本地下载，且不再写入完成状态与计费
+	if p.heartbeats != nil {
+		release, ok := p.heartbeats.Claim(ctx, taskID, task.ReplayedAt(), func() {
+			cancelCause(ErrTaskOwnershipLost)
+		})
+		if !ok {
+			log.Printf("[Worker] [Task %s] Task is already running on another worker, skipping", taskID)
+			return ErrTaskAlreadyRunning
+		}
+		defer release()
+	}
+
+	if !p.trackTask(taskID, task.ReplayedAt(), cancel) || p.isTerminatedInStore(ctx, taskID) {
+		log.Printf("[Worker] [Task %s] Task was cancelled before start, skipping", taskID)
+		p.untrackTask(taskID)
+		return ErrTaskCancelled
+	}
+	defer p.untrackTask(taskID)
+	p.markActive(task, platform)
+
+	// 保存任务消息，管理端重放死信或卡住的任务时据此重新入队
+	if payload, err := json.Marshal(task); err == nil {
+		if err := p.repo.SaveTaskPayload(ctx, taskID, payload); err != nil {
+			log.Printf("[Worker] [Task %s] ⚠ Failed to save task payload: %v", taskID, err)
+		}
+	}
+
 	if allowed, limitErr := p.platformLimiter.Allow(ctx, platform, ratelimit.StageDownload); limitErr != nil {
 		log.Printf("[Worker] [Task %s] ⚠ Platform download limiter failed open: %v", taskID, limitErr)
 	} else if !allowed {
-		return p.handleError(ctx, task, errors.New("platform download rate limited"))
+		// 平台限流时任务延后重新投递，不计入失败与重试次数
+		log.Printf("[Worker] [Task %s] Platform %s download rate limited, deferring", taskID, platform)
+		return ErrPlatformPaused
 	}
+	p.progressPublisher.Track(ctx, taskID, task.UserID)
 
 	log.Printf("[Worker] [Task %s] Step 1/10: Updating status to processing...", taskID)
 	// 1. 更新状态为处理中
-	if err := p.repo.UpdateProcessing(ctx, taskID); err != nil {
+	if err := p.repo.UpdateProcessing(ctx, taskID); errors.Is(err, sql.ErrNoRows) {
+		log.Printf("[Worker] [Task %s] Task already terminated, skipping", taskID)
+		return ErrTaskCancelled
+	} else if err != nil {
 		log.Printf("[Worker] [Task %s] ❌ Failed to update status to processing: %v", taskID, err)
 	} else {
 		log.Printf("[Worker] [Task %s] ✓ Status updated to processing", taskID)
@@ -224,7 +538,25 @@ func (p *Pool) processTask(task *models.DownloadTask) error {
 
 	log.Printf("[Worker] [Task %s] Step 3/10: Getting proxy IP...", taskID)
 	// 3. 使用 Parser 阶段传递的 proxy，确保解析和下载代理一致
+	access := p.accessPolicy(platform)
 	proxyURL := task.ProxyURL
+	if access.ProxyDirect && proxyURL != "" {
+		// 平台策略在解析后改为直连，释放解析阶段带来的租约
+		log.Printf("[Worker] [Task %s] ✓ Platform proxy policy is direct, dropping proxy lease %s", taskID, task.ProxyLeaseID)
+		if p.assetClient != nil && task.ProxyLeaseID != "" {
+			if err := p.assetClient.ReleaseProxyForTask(taskID, "platform proxy policy is direct"); err != nil {
+				log.Printf("[Worker] [Task %s] ⚠ Failed to release proxy lease: %v", taskID, err)
+			}
+		}
+		proxyURL = ""
+		task.ProxyURL = ""
+		task.ProxyLeaseID = ""
+		task.ProxyExpireAt = ""
+	}
+	if proxyURL == "" && access.ProxyRequired {
+		log.Printf("[Worker] [Task %s] ❌ Platform %s requires a proxy but no lease is attached", taskID, platform)
+		return p.handleError(ctx, task, utils.ErrProxyRequired)
+	}
 	if proxyURL == "" {
 		log.Printf("[Worker] [Task %s] ✓ No proxy lease attached, using direct connection", taskID)
 	} else {
@@ -232,26 +564,52 @@ func (p *Pool) processTask(task *models.DownloadTask) error {
 	}
 
 	log.Printf("[Worker] [Task %s] Step 4/10: Generating output path...", taskID)
-	// 4. 生成文件路径
-	outputPath, err := p.pathGenerator.GeneratePath(task)
+	// 4. 生成文件路径，重试时沿用上次的工作目录续传
+	outputPath, resumed, err := p.prepareWorkDir(task)
 	if err != nil {
 		log.Printf("[Worker] [Task %s] ❌ Failed to generate path: %v", taskID, err)
 		return p.handleError(ctx, task, err)
 	}
-	log.Printf("[Worker] [Task %s] ✓ Output path: %s", taskID, outputPath)
+	if resumed {
+		log.Printf("[Worker] [Task %s] ✓ Resuming partial download: %s", taskID, outputPath)
+	} else {
+		log.Printf("[Worker] [Task %s] ✓ Output path: %s", taskID, outputPath)
+	}
+	// 产物写入存储后端或任务取消后清理本地工作目录；失败时保留部分文件供重试续传
+	defer func() {
+		if retErr == nil || errors.Is(retErr, ErrTaskCancelled) {
+			p.removeWorkDir(taskID)
+		}
+	}()
+
+	// 命中去重存储时直接交付已有对象，跳过下载与后处理
+	objectSpec := storageObjectSpec(task)
+	if objectSpec != nil {
+		if obj, ok := p.reuseStorageObject(ctx, task, objectSpec); ok {
+			log.Printf("[Worker] [Task %s] ✓ Reusing stored object %d, skipping download", taskID, obj.ID)
+			if err := p.progressPublisher.PublishPhase(ctx, taskID, ytdlp.PhaseProcessing, 85.0); err != nil {
+				log.Printf("[Worker] [Task %s] ⚠ Failed to publish processing phase: %v", taskID, err)
+			}
+			// 后处理可能改变扩展名，文件名沿用对象的扩展名
+			fileName := strings.TrimSuffix(filepath.Base(outputPath), filepath.Ext(outputPath)) + path.Ext(obj.FilePath)
+			return p.finishTask(ctx, task, &taskOutput{fileName: fileName, reused: obj})
+		}
+	}
 
 	log.Printf("[Worker] [Task %s] Step 5/10: Setting up progress callback...", taskID)
 	// 5. 设置进度回调（带阶段跟踪）
 	needsMerge := ytdlp.NeedsMerge(task)
-	downloadRound := 0
-	lastRawPercent := 0.0
-	accumulatedIngressBytes := int64(0)
-	currentRoundPeakBytes := int64(0)
+	phases := ytdlp.NewPhaseTracker(needsMerge)
+	ingress := newIngressMeter(filepath.Dir(outputPath))
 	log.Printf("[Worker] [Task %s] NeedsMerge: %v", taskID, needsMerge)
 
 	progressCallback := func(event *ytdlp.OutputEvent) {
 		switch event.Type {
-		case "merger":
+		case ytdlp.OutputEventPostprocess:
+			log.Printf("[Worker] [Task %s] Postprocessor %s: %s", taskID, event.Postprocessor, event.Status)
+			if event.Postprocessor != ytdlp.PostprocessorMerger || event.Status != "started" {
+				return
+			}
 			// 合流阶段
 			phase := ytdlp.PhaseMerging
 			percent := 85.0
@@ -260,37 +618,18 @@ func (p *Pool) processTask(task *models.DownloadTask) error {
 				log.Printf("[Worker] [Task %s] ⚠ Failed to publish merger phase: %v", taskID, err)
 			}
 
-		case "progress":
-			raw := event.Progress.Percent
-
-			// 检测是否进入新一轮下载（进度大幅回退说明开始下载第二个流）
-			if raw < lastRawPercent-10 && lastRawPercent > 90 {
-				accumulatedIngressBytes += currentRoundPeakBytes
-				currentRoundPeakBytes = 0
-				downloadRound++
-				log.Printf("[Worker] [Task %s] Detected new download round: %d", taskID, downloadRound)
-			}
-			lastRawPercent = raw
-			if event.Progress.DownloadedBytes > currentRoundPeakBytes {
-				currentRoundPeakBytes = event.Progress.DownloadedBytes
-			}
-
-			// 确定当前阶段
-			var phase ytdlp.DownloadPhase
-			if !needsMerge {
-				phase = ytdlp.PhaseDownloading
-			} else if downloadRound == 0 {
-				phase = ytdlp.PhaseDownloadingVideo
-			} else {
-				phase = ytdlp.PhaseDownloadingAudio
-			}
+		case ytdlp.OutputEventProgress:
+			progress := event.Progress
+			// 入站流量按流（输出文件）分别统计
+			ingress.record(progress.Filename, progress.DownloadedBytes)
 
-			// 计算加权整体进度
-			overall := calcOverallPercent(raw, phase, needsMerge)
+			// 由当前流的格式确定阶段，并计算加权整体进度
+			phase := phases.Phase(progress)
+			overall := calcOverallPercent(progress.Percent, phase, needsMerge)
 
-			log.Printf("[Worker] [Task %s] Progress: %.1f%% (raw) → %.1f%% (overall), Phase: %s, Speed: %s, ETA: %s",
-				taskID, raw, overall, phase, event.Progress.Speed, event.Progress.ETA)
-			if err := p.progressPublisher.PublishDownloading(ctx, taskID, event.Progress, phase, overall); err != nil {
+			log.Printf("[Worker] [Task %s] Progress: %.1f%% (format %s, %d/%d bytes) → %.1f%% (overall), Phase: %s, Speed: %s, ETA: %s",
+				taskID, progress.Percent, progress.FormatID, progress.DownloadedBytes, progress.TotalBytes, overall, phase, progress.Speed, progress.ETA)
+			if err := p.progressPublisher.PublishDownloading(ctx, taskID, progress, phase, overall); err != nil {
 				log.Printf("[Worker] [Task %s] ⚠ Failed to publish progress: %v", taskID, err)
 			}
 		}
@@ -303,11 +642,11 @@ func (p *Pool) processTask(task *models.DownloadTask) error {
 
 	// 6. 获取 Cookie 并执行下载
 	var cookieFile string
-	log.Printf("[Worker] [Task %s] Download access policy: platform=%s youtube_cookie_disabled=%t has_proxy=%t", taskID, platform, platformpolicy.IsYouTubePlatform(platform) && p.youtubePolicy.CookiesDisabled(), proxyURL != "")
+	log.Printf("[Worker] [Task %s] Download access policy: platform=%s cookie_disabled=%t cookie_required=%t proxy_direct=%t proxy_required=%t has_proxy=%t", taskID, platform, access.CookiesDisabled, access.CookiesRequired, access.ProxyDirect, access.ProxyRequired, proxyURL != "")
 
 	// 如果有 CookieID，从 Asset Service 获取 cookie 内容
-	if platformpolicy.IsYouTubePlatform(platform) && p.youtubePolicy.CookiesDisabled() {
-		log.Printf("[Worker] [Task %s] youtube_cookie_disabled=true, skipping cookie fetch", taskID)
+	if access.CookiesDisabled {
+		log.Printf("[Worker] [Task %s] cookie_disabled=true, skipping cookie fetch", taskID)
 	} else if task.CookieID > 0 && p.assetClient != nil {
 		log.Printf("[Worker] [Task %s] Getting cookie content for ID: %d", taskID, task.CookieID)
 		var err error
@@ -324,6 +663,10 @@ func (p *Pool) processTask(task *models.DownloadTask) error {
 			}()
 		}
 	}
+	if cookieFile == "" && access.CookiesRequired {
+		log.Printf("[Worker] [Task %s] ❌ Platform %s requires a cookie but none is available", taskID, platform)
+		return p.handleError(ctx, task, utils.ErrCookieRequired)
+	}
 
 	downloadErr := p.executor.Download(ctx, task, proxyURL, outputPath, cookieFile, progressCallback)
 	errorCategory := utils.ClassifyAccessError(downloadErr)
@@ -340,8 +683,8 @@ func (p *Pool) processTask(task *models.DownloadTask) error {
 	}
 
 	// 报告 Cookie 使用结果
-	if platformpolicy.IsYouTubePlatform(platform) && p.youtubePolicy.CookiesDisabled() {
-		log.Printf("[Worker] [Task %s] youtube_cookie_disabled=true, skipping cookie usage report", taskID)
+	if access.CookiesDisabled {
+		log.Printf("[Worker] [Task %s] cookie_disabled=true, skipping cookie usage report", taskID)
 	} else if task.CookieID > 0 && p.assetClient != nil {
 		success := downloadErr == nil
 		if reportErr := p.assetClient.ReportCookieUsage(task.CookieID, success, taskID, errorCategory, errorMessage); reportErr != nil {
@@ -354,40 +697,94 @@ func (p *Pool) processTask(task *models.DownloadTask) error {
 		return p.handleError(ctx, task, downloadErr)
 	}
 	log.Printf("[Worker] [Task %s] ✓ Download completed", taskID)
-	actualIngressBytes := accumulatedIngressBytes + currentRoundPeakBytes
+	if resumedBytes := ingress.resumedBytes(); resumedBytes > 0 {
+		log.Printf("[Worker] [Task %s] ✓ Resumed %d bytes from previous attempts, transferred %d new bytes", taskID, resumedBytes, ingress.newBytes())
+	}
+	actualIngressBytes := ingress.billableBytes()
 
 	// 发布 processing 阶段
 	processingStart := 85.0
 	if needsMerge {
 		processingStart = 92.0
 	}
+
+	// 后处理（转码/封装），历史与计费记录最终产物
+	if task.PostProcess != nil && task.PostProcess.Profile != "" {
+		processedPath, err := p.runPostProcess(ctx, task, outputPath, processingStart)
+		if err != nil {
+			log.Printf("[Worker] [Task %s] ❌ Post-processing failed: %v", taskID, err)
+			return p.handleError(ctx, task, err)
+		}
+		outputPath = processedPath
+		processingStart = postProcessEndPercent
+	}
+
 	if err := p.progressPublisher.PublishPhase(ctx, taskID, ytdlp.PhaseProcessing, processingStart); err != nil {
 		log.Printf("[Worker] [Task %s] ⚠ Failed to publish processing phase: %v", taskID, err)
 	}
 
-	log.Printf("[Worker] [Task %s] Step 7/10: Calculating file information...", taskID)
-	// 7. 计算文件信息
-	fileSize, err := p.fileManager.GetFileSize(outputPath)
-	if err != nil {
-		log.Printf("[Worker] [Task %s] ❌ Failed to get file size: %v", taskID, err)
-		return p.handleError(ctx, task, err)
+	return p.finishTask(ctx, task, &taskOutput{
+		path:         outputPath,
+		ingressBytes: actualIngressBytes,
+		objectSpec:   objectSpec,
+	})
+}
+
+// taskOutput 下载或复用得到的最终产物
+type taskOutput struct {
+	path         string // 本地工作文件，复用对象时为空
+	fileName     string // 复用对象时交付给用户的文件名
+	ingressBytes int64
+	reused       *models.StorageObject // 复用的去重对象，为空表示本次实际下载
+	objectSpec   *models.StorageObject // 下载完成后待登记的去重对象
+}
+
+// finishTask 计算文件信息、写入存储、落库、结算入站流量并发布完成消息
+func (p *Pool) finishTask(ctx context.Context, task *models.DownloadTask, out *taskOutput) error {
+	taskID := task.TaskID
+	if ownershipLost(ctx) {
+		log.Printf("[Worker] [Task %s] Task was taken over by another worker, skipping completion", taskID)
+		return ErrTaskOwnershipLost
 	}
-	log.Printf("[Worker] [Task %s] ✓ File size: %d bytes (%.2f MB)", taskID, fileSize, float64(fileSize)/1024/1024)
+	outputPath := out.path
+	actualIngressBytes := out.ingressBytes
 
-	log.Printf("[Worker] [Task %s] Step 8/10: Calculating MD5 hash...", taskID)
-	fileHash, err := p.fileManager.CalculateMD5(outputPath)
-	if err != nil {
-		log.Printf("[Worker] [Task %s] ❌ Failed to calculate MD5: %v", taskID, err)
-		return p.handleError(ctx, task, err)
+	var (
+		fileSize int64
+		fileHash string
+		fileName string
+		err      error
+	)
+	if out.reused != nil {
+		// 复用前已校验对象大小与哈希
+		fileSize = out.reused.FileSize
+		fileHash = out.reused.FileHash
+		fileName = out.fileName
+	} else {
+		log.Printf("[Worker] [Task %s] Step 7/10: Calculating file information...", taskID)
+		// 7. 计算文件信息
+		fileSize, err = p.fileManager.GetFileSize(outputPath)
+		if err != nil {
+			log.Printf("[Worker] [Task %s] ❌ Failed to get file size: %v", taskID, err)
+			return p.handleError(ctx, task, err)
+		}
+
+		log.Printf("[Worker] [Task %s] Step 8/10: Calculating MD5 hash...", taskID)
+		if fileHash, err = p.fileManager.CalculateMD5(outputPath); err != nil {
+			log.Printf("[Worker] [Task %s] ❌ Failed to calculate MD5: %v", taskID, err)
+			return p.handleError(ctx, task, err)
+		}
+		fileName = filepath.Base(outputPath)
 	}
+	log.Printf("[Worker] [Task %s] ✓ File size: %d bytes (%.2f MB)", taskID, fileSize, float64(fileSize)/1024/1024)
 	log.Printf("[Worker] [Task %s] ✓ File hash: %s", taskID, fileHash)
-
-	fileName := filepath.Base(outputPath)
 	log.Printf("[Worker] [Task %s] ✓ File name: %s", taskID, fileName)
 
-	if actualIngressBytes <= 0 {
+	// 复用已有对象时没有产生入站流量
+	if actualIngressBytes <= 0 && out.reused == nil {
 		switch {
-		case task.SelectedFormat != nil && task.SelectedFormat.Filesize > 0:
+		// 片段下载只拉取部分数据，所选格式的完整大小不能代表实际入站流量
+		case task.SelectedFormat != nil && task.SelectedFormat.Filesize > 0 && !task.Clip.HasRange():
 			actualIngressBytes = task.SelectedFormat.Filesize
 		case fileSize > 0:
 			actualIngressBytes = fileSize
@@ -406,9 +803,28 @@ func (p *Pool) processTask(task *models.DownloadTask) error {
 		log.Printf("[Worker] [Task %s] ✓ No expiration (permanent storage)", taskID)
 	}
 
+	// 写入存储后端，历史记录保存对象键
+	var storageKey string
+	if out.reused != nil {
+		storageKey = out.reused.FilePath
+	} else {
+		storageKey, err = p.storeOutput(ctx, task, out, fileName, fileSize, fileHash)
+		if err != nil {
+			log.Printf("[Worker] [Task %s] ❌ Failed to upload file to storage: %v", taskID, err)
+			return p.handleError(ctx, task, err)
+		}
+		p.recordAttachedFiles(ctx, task, outputPath)
+	}
+	log.Printf("[Worker] [Task %s] ✓ Storage key: %s", taskID, storageKey)
+
 	log.Printf("[Worker] [Task %s] Step 10/10: Updating database...", taskID)
 	// 9. 更新数据库
-	if err := p.repo.UpdateComplete(ctx, taskID, outputPath, fileName, fileHash, fileSize, expireAt); err != nil {
+	if err := p.repo.UpdateComplete(ctx, taskID, storageKey, fileName, fileHash, fileSize, expireAt); errors.Is(err, sql.ErrNoRows) {
+		// 收尾期间任务被取消或强制失败，保持终态不被覆盖，计费与代理已由终止流程释放。
+		// 对象可能被去重复用，这里不删除
+		log.Printf("[Worker] [Task %s] Task terminated before completion, keeping terminal status (object %s)", taskID, storageKey)
+		return ErrTaskCancelled
+	} else if err != nil {
 		log.Printf("[Worker] [Task %s] ❌ Failed to update database: %v", taskID, err)
 		return p.handleError(ctx, task, err)
 	}
@@ -421,7 +837,7 @@ func (p *Pool) processTask(task *models.DownloadTask) error {
 		}
 		log.Printf("[Worker] [Task %s] ✓ Ingress usage captured", taskID)
 
-		if err := p.assetClient.UpdateHistoryCompleted(taskID, outputPath, fileName, fileHash, fileSize, expireAt != nil); err != nil {
+		if err := p.assetClient.UpdateHistoryCompleted(taskID, storageKey, fileName, fileHash, fileSize, expireAt != nil); err != nil {
 			log.Printf("[Worker] [Task %s] ❌ Failed to sync completed history to asset service: %v", taskID, err)
 			return p.handleError(ctx, task, err)
 		}
@@ -435,34 +851,265 @@ func (p *Pool) processTask(task *models.DownloadTask) error {
 	} else {
 		log.Printf("[Worker] [Task %s] ✓ Completion message published", taskID)
 	}
+	p.emitTaskEvent(task, webhookEventTaskCompleted, map[string]interface{}{
+		"file_name": fileName,
+		"file_size": fileSize,
+		"file_hash": fileHash,
+		"reused":    out.reused != nil,
+	})
 
-	log.Printf("[Worker] [Task %s] ✅ All steps completed successfully - File: %s, Size: %d bytes", taskID, outputPath, fileSize)
+	log.Printf("[Worker] [Task %s] ✅ All steps completed successfully - File: %s, Size: %d bytes", taskID, storageKey, fileSize)
 	return nil
 }
 
-// handleError 处理错误
+// storeOutput 将本地产物写入存储后端并返回对象键。可去重的产物登记为共享对象并写入对象键
+func (p *Pool) storeOutput(ctx context.Context, task *models.DownloadTask, out *taskOutput, fileName string, fileSize int64, fileHash string) (string, error) {
+	taskID := task.TaskID
+
+	var obj *models.StorageObject
+	storageKey := p.pathGenerator.StorageKey(task, fileName)
+	if out.objectSpec != nil {
+		if obj = p.registerStorageObject(ctx, task, out.objectSpec, filepath.Ext(out.path), fileSize, fileHash); obj != nil {
+			storageKey = obj.FilePath
+		}
+	}
+
+	if err := storage.Upload(ctx, p.store, storageKey, out.path); err != nil {
+		if obj != nil {
+			p.releaseStorageObject(ctx, taskID, obj.ID)
+		}
+		return "", err
+	}
+
+	if obj != nil {
+		if err := p.repo.SetObjectID(ctx, taskID, obj.ID); err != nil {
+			// 对象已写入但无法关联，释放引用并删除对象，本任务视为失败
+			p.releaseStorageObject(ctx, taskID, obj.ID)
+			return "", err
+		}
+		log.Printf("[Worker] [Task %s] ✓ Registered stored object %d: %s", taskID, obj.ID, storageKey)
+	}
+	return storageKey, nil
+}
+
+// DiscardWorkDir 任务不再重试时删除其保留的部分下载文件
+func (p *Pool) DiscardWorkDir(taskID string) {
+	p.removeWorkDir(taskID)
+}
+
+// removeWorkDir 删除任务的本地工作目录
+func (p *Pool) removeWorkDir(taskID string) {
+	if err := p.fileManager.DeleteDir(p.pathGenerator.WorkDir(taskID)); err != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Failed to remove work directory: %v", taskID, err)
+	}
+}
+
+// runPostProcess 执行后处理并按 [startPercent, postProcessEndPercent] 区间发布进度
+func (p *Pool) runPostProcess(ctx context.Context, task *models.DownloadTask, inputPath string, startPercent float64) (string, error) {
+	taskID := task.TaskID
+	profile := task.PostProcess.Profile
+	if !p.postProcessor.Supports(profile) {
+		return "", fmt.Errorf("unsupported post-processing profile: %s", profile)
+	}
+
+	log.Printf("[Worker] [Task %s] Post-processing with profile %s...", taskID, profile)
+	if err := p.progressPublisher.PublishPostProcessing(ctx, taskID, profile, startPercent); err != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Failed to publish post-processing phase: %v", taskID, err)
+	}
+
+	lastPublished := -1.0
+	onProgress := func(percent float64) {
+		overall := startPercent + percent*(postProcessEndPercent-startPercent)/100
+		// 转码进度输出频繁，至少变化 1% 才推送
+		if overall-lastPublished < 1 && percent < 100 {
+			return
+		}
+		lastPublished = overall
+		if err := p.progressPublisher.PublishPostProcessing(ctx, taskID, profile, overall); err != nil {
+			log.Printf("[Worker] [Task %s] ⚠ Failed to publish post-processing progress: %v", taskID, err)
+		}
+	}
+
+	outputPath, err := p.postProcessor.Run(ctx, profile, &postprocess.Job{
+		TaskID:       taskID,
+		InputPath:    inputPath,
+		Duration:     task.Metadata.Duration,
+		AudioBitrate: task.PostProcess.AudioBitrate,
+	}, onProgress)
+	if err != nil {
+		return "", err
+	}
+
+	log.Printf("[Worker] [Task %s] ✓ Post-processing completed: %s", taskID, outputPath)
+	return outputPath, nil
+}
+
+// recordAttachedFiles 上传并登记与主文件一同生成的独立字幕文件和章节文件，失败不影响主文件交付
+func (p *Pool) recordAttachedFiles(ctx context.Context, task *models.DownloadTask, outputPath string) {
+	taskID := task.TaskID
+	var files []models.DownloadFile
+
+	if ytdlp.ResolveSubtitleMode(task) == models.SubtitleModeSidecar {
+		subtitles, err := p.pathGenerator.SubtitleFiles(outputPath)
+		if err != nil {
+			log.Printf("[Worker] [Task %s] ⚠ Failed to scan subtitle files: %v", taskID, err)
+		} else if len(subtitles) == 0 {
+			log.Printf("[Worker] [Task %s] ⚠ No subtitle files produced for requested languages", taskID)
+		}
+		for _, subtitle := range subtitles {
+			if file, ok := p.attachedFile(ctx, task, models.FileKindSubtitle, subtitle.Language, subtitle.Path); ok {
+				files = append(files, file)
+			}
+		}
+	}
+
+	if task.Clip != nil && task.Clip.SplitChapters {
+		chapters, err := p.pathGenerator.ChapterFiles(outputPath)
+		if err != nil {
+			log.Printf("[Worker] [Task %s] ⚠ Failed to scan chapter files: %v", taskID, err)
+		} else if len(chapters) == 0 {
+			log.Printf("[Worker] [Task %s] ⚠ No chapter files produced, video may have no chapters", taskID)
+		}
+		for _, chapter := range chapters {
+			if file, ok := p.attachedFile(ctx, task, models.FileKindChapter, "", chapter.Path); ok {
+				files = append(files, file)
+			}
+		}
+	}
+
+	if len(files) == 0 {
+		return
+	}
+	if err := p.repo.SaveFiles(ctx, taskID, files); err != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Failed to record attached files: %v", taskID, err)
+		return
+	}
+	log.Printf("[Worker] [Task %s] ✓ Recorded %d attached file(s)", taskID, len(files))
+}
+
+func (p *Pool) attachedFile(ctx context.Context, task *models.DownloadTask, kind, language, localPath string) (models.DownloadFile, bool) {
+	taskID := task.TaskID
+	size, err := p.fileManager.GetFileSize(localPath)
+	if err != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Failed to stat %s file %s: %v", taskID, kind, localPath, err)
+		return models.DownloadFile{}, false
+	}
+
+	fileName := filepath.Base(localPath)
+	key := p.pathGenerator.StorageKey(task, fileName)
+	if err := storage.Upload(ctx, p.store, key, localPath); err != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Failed to upload %s file %s: %v", taskID, kind, localPath, err)
+		return models.DownloadFile{}, false
+	}
+
+	return models.DownloadFile{
+		TaskID:   taskID,
+		Kind:     kind,
+		Language: language,
+		FilePath: key,
+		FileName: fileName,
+		FileSize: size,
+	}, true
+}
+
+// NotifyTaskFailed 任务最终失败（不再重试）时发布 task.failed 事件
+func (p *Pool) NotifyTaskFailed(task *models.DownloadTask, category string, taskErr error) {
+	data := map[string]interface{}{"error_category": category}
+	if taskErr != nil {
+		data["error"] = taskErr.Error()
+	}
+	p.emitTaskEvent(task, webhookEventTaskFailed, data)
+}
+
+// emitTaskEvent 发布任务 Webhook 事件，失败只记录日志
+func (p *Pool) emitTaskEvent(task *models.DownloadTask, eventType string, extra map[string]interface{}) {
+	if p.assetClient == nil || task == nil || task.UserID == "" {
+		return
+	}
+
+	data := map[string]interface{}{
+		"task_id":    task.TaskID,
+		"history_id": task.HistoryID,
+		"url":        task.URL,
+		"platform":   task.Platform,
+		"title":      task.Title,
+		"mode":       task.Mode,
+		"batch_id":   task.BatchID,
+	}
+	for key, value := range extra {
+		data[key] = value
+	}
+	if err := p.assetClient.EmitWebhookEvent(task.UserID, eventType, data); err != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Failed to emit %s webhook event: %v", task.TaskID, eventType, err)
+	}
+}
+
+// handleError 处理任务执行错误。取消、中断与被接管的任务返回对应的哨兵错误；
+// 其余错误原样返回，由消费者决定延迟重试（scheduleRetry）或终结任务（failTask）
 func (p *Pool) handleError(ctx context.Context, task *models.DownloadTask, err error) error {
 	taskID := task.TaskID
+	if p.isCancelled(taskID) {
+		// 取消流程已负责释放计费预占、代理绑定并同步历史状态
+		log.Printf("[Worker] [Task %s] Task cancelled by user, skipping failure handling: %v", taskID, err)
+		return ErrTaskCancelled
+	}
+	if p.isInterrupted(taskID) {
+		return p.requeueInterrupted(task, err)
+	}
+	if ownershipLost(ctx) {
+		// 接管的 Worker 负责任务状态、计费与代理绑定
+		log.Printf("[Worker] [Task %s] Task was taken over by another worker, skipping failure handling: %v", taskID, err)
+		return ErrTaskOwnershipLost
+	}
 	log.Printf("[Worker] [Task %s] ❌ Handling error: %v", taskID, err)
 	log.Printf("[Worker] [Task %s] Task details - URL: %s, Mode: %s, Quality: %s, Format: %s",
 		taskID, task.URL, task.Mode, task.Quality, task.Format)
+	return err
+}
 
-	// 发布失败消息
-	log.Printf("[Worker] [Task %s] Publishing failure message...", taskID)
-	if pubErr := p.progressPublisher.PublishFailed(ctx, taskID, err.Error()); pubErr != nil {
-		log.Printf("[Worker] [Task %s] ⚠ Failed to publish error: %v", taskID, pubErr)
-	} else {
-		log.Printf("[Worker] [Task %s] ✓ Failure message published", taskID)
+// scheduleRetry 任务将延迟重试：保持待处理状态并计入重试次数，保留计费预占、代理绑定与进度订阅。
+// 任务已被取消或强制失败时返回 ErrTaskCancelled，不再重试
+func (p *Pool) scheduleRetry(ctx context.Context, task *models.DownloadTask, cause error, delay time.Duration) error {
+	taskID := task.TaskID
+	if err := p.repo.IncrementRetry(ctx, taskID); errors.Is(err, sql.ErrNoRows) {
+		log.Printf("[Worker] [Task %s] Task already terminated, skipping retry: %v", taskID, cause)
+		return ErrTaskCancelled
+	} else if err != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Failed to increment retry count: %v", taskID, err)
 	}
 
+	notice := fmt.Sprintf("下载失败，%s 后自动重试", delay.Round(time.Second))
+	if err := p.progressPublisher.PublishRequeued(ctx, taskID, notice); err != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Failed to publish retry status: %v", taskID, err)
+	}
+	return nil
+}
+
+// failTask 终结失败的任务：标记失败、通知订阅方、释放代理绑定与计费预占并同步历史状态。
+// 任务已在其他流程中结束（取消、强制失败）时返回 ErrTaskCancelled，收尾已由终止流程完成
+func (p *Pool) failTask(ctx context.Context, task *models.DownloadTask, err error, retryCount int) error {
+	taskID := task.TaskID
+
 	// 更新数据库状态
 	log.Printf("[Worker] [Task %s] Updating database with failed status...", taskID)
-	if dbErr := p.repo.UpdateFailed(ctx, taskID, err.Error(), 0); dbErr != nil {
+	if dbErr := p.repo.UpdateFailed(ctx, taskID, err.Error(), retryCount); errors.Is(dbErr, sql.ErrNoRows) {
+		// 任务已在其他实例被取消或强制失败，收尾已由终止流程完成
+		log.Printf("[Worker] [Task %s] Task already terminated, skipping failure handling: %v", taskID, err)
+		return ErrTaskCancelled
+	} else if dbErr != nil {
 		log.Printf("[Worker] [Task %s] ⚠ Failed to update failed status in DB: %v", taskID, dbErr)
 	} else {
 		log.Printf("[Worker] [Task %s] ✓ Database updated with failed status", taskID)
 	}
 
+	// 发布失败消息
+	log.Printf("[Worker] [Task %s] Publishing failure message...", taskID)
+	if pubErr := p.progressPublisher.PublishFailed(ctx, taskID, err.Error()); pubErr != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Failed to publish error: %v", taskID, pubErr)
+	} else {
+		log.Printf("[Worker] [Task %s] ✓ Failure message published", taskID)
+	}
+
 	if p.assetClient != nil {
 		if releaseErr := p.assetClient.ReleaseProxyForTask(taskID, err.Error()); releaseErr != nil {
 			log.Printf("[Worker] [Task %s] ⚠ Failed to release proxy binding: %v", taskID, releaseErr)
@@ -481,7 +1128,34 @@ func (p *Pool) handleError(ctx context.Context, task *models.DownloadTask, err e
 		}
 	}
 
-	return err
+	return nil
+}
+
+// requeueInterrupted 实例关闭中断的任务不算失败：恢复为待处理并通知订阅方，
+// 保留工作目录、计费预占与代理绑定，由消费者将消息交回队列后在其他实例续传
+func (p *Pool) requeueInterrupted(task *models.DownloadTask, cause error) error {
+	taskID := task.TaskID
+	log.Printf("[Worker] [Task %s] Task interrupted by shutdown, requeueing: %v", taskID, cause)
+
+	// 任务上下文已被取消，使用独立的超时上下文收尾
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := p.repo.ResetInterrupted(ctx, taskID); err != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Failed to reset interrupted task: %v", taskID, err)
+	}
+	if err := p.progressPublisher.PublishRequeued(ctx, taskID, "服务重启中，任务已重新排队"); err != nil {
+		log.Printf("[Worker] [Task %s] ⚠ Failed to publish requeued status: %v", taskID, err)
+	}
+	return ErrTaskRequeued
+}
+
+// maxAttempts 任务最多执行次数（含首次）
+func (p *Pool) maxAttempts() int {
+	if p.retryCfg != nil && p.retryCfg.MaxAttempts > 0 {
+		return p.retryCfg.MaxAttempts
+	}
+	return 1
 }
 
 func (p *Pool) RefreshTaskProxy(ctx context.Context, task *models.DownloadTask) error {
@@ -492,11 +1166,21 @@ func (p *Pool) RefreshTaskProxy(ctx context.Context, task *models.DownloadTask)
 	if platform == "" {
 		platform = task.Platform
 	}
+	access := p.accessPolicy(platform)
+	if access.ProxyDirect {
+		task.ProxyURL = ""
+		task.ProxyLeaseID = ""
+		task.ProxyExpireAt = ""
+		return nil
+	}
 	lease, err := p.assetClient.AcquireProxyForTask(ctx, task.TaskID, platform)
 	if err != nil {
 		return err
 	}
 	if lease == nil {
+		if access.ProxyRequired {
+			return utils.ErrProxyRequired
+		}
 		task.ProxyURL = ""
 		task.ProxyLeaseID = ""
 		task.ProxyExpireAt = ""
@@ -0,0 +1,426 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"youdlp/media-service/internal/download/config"
+	"youdlp/media-service/internal/download/models"
+)
+
+const (
+	// poolControlChannel 跨实例广播 Worker 池控制命令的 Redis 频道
+	poolControlChannel = "worker:control"
+	// poolInstancesKey 上报过状态的实例集合
+	poolInstancesKey = "worker:pools"
+	// poolDesiredKey 管理端设置的目标状态，字段为 max_concurrent / paused（作用于所有实例）
+	// 或 <instance_id>/max_concurrent、<instance_id>/paused（仅作用于该实例，优先于全局设置）
+	poolDesiredKey = "worker:control:desired"
+
+	desiredMaxConcurrentField = "max_concurrent"
+	desiredPausedField        = "paused"
+)
+
+// ErrUnknownInstance 控制命令指定的实例没有上报过状态
+var ErrUnknownInstance = errors.New("unknown worker instance")
+
+// Worker 池控制命令
+const (
+	PoolActionSetConcurrency = "set_concurrency"
+	PoolActionPause          = "pause"
+	PoolActionResume         = "resume"
+)
+
+// maxPoolConcurrency 运行时可设置的并发上限
+const maxPoolConcurrency = 256
+
+// ActiveTask 运行中的任务
+type ActiveTask struct {
+	TaskID    string    `json:"task_id"`
+	UserID    string    `json:"user_id"`
+	Platform  string    `json:"platform"`
+	Phase     string    `json:"phase,omitempty"`
+	Percent   float64   `json:"percent"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// QueueDepth 下载队列积压
+type QueueDepth struct {
+	Queue     string `json:"queue"`
+	Platform  string `json:"platform,omitempty"`
+	Messages  int    `json:"messages"`
+	Consumers int    `json:"consumers"`
+	Paused    bool   `json:"paused"`
+}
+
+// PoolSnapshot 单个实例的 Worker 池状态
+type PoolSnapshot struct {
+	InstanceID     string       `json:"instance_id"`
+	PoolSize       int          `json:"pool_size"`
+	MaxConcurrent  int          `json:"max_concurrent"`
+	Running        int          `json:"running"`
+	Buffered       int          `json:"buffered"`
+	BufferCapacity int          `json:"buffer_capacity"`
+	Paused         bool         `json:"paused"`
+	Queues         []QueueDepth `json:"queues"`
+	ActiveTasks    []ActiveTask `json:"active_tasks"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
+
+// PoolCommand Worker 池控制命令，InstanceID 为空时作用于所有实例
+type PoolCommand struct {
+	InstanceID    string `json:"instance_id,omitempty"`
+	Action        string `json:"action"`
+	MaxConcurrent int    `json:"max_concurrent,omitempty"`
+}
+
+// Validate 校验控制命令
+func (c *PoolCommand) Validate() error {
+	switch c.Action {
+	case PoolActionSetConcurrency:
+		if c.MaxConcurrent < 1 || c.MaxConcurrent > maxPoolConcurrency {
+			return fmt.Errorf("max_concurrent must be between 1 and %d", maxPoolConcurrency)
+		}
+	case PoolActionPause, PoolActionResume:
+	default:
+		return errors.New("action must be one of set_concurrency, pause, resume")
+	}
+	return nil
+}
+
+// PoolControl Worker 池状态上报与运行时控制。各实例定期将状态写入 Redis，
+// 任一实例都能汇总所有实例的状态；控制命令通过 Redis Pub/Sub 广播到目标实例
+type PoolControl struct {
+	redis      *redis.Client
+	pool       *Pool
+	consumer   *TaskConsumer
+	progress   *ProgressPublisher
+	instanceID string // 配置的稳定实例标识，实例级目标状态按它持久化，重启后仍然生效
+	interval   time.Duration
+}
+
+// NewPoolControl 创建 Worker 池控制，consumer 为空时不支持暂停消费与队列积压查询
+func NewPoolControl(redisClient *redis.Client, cfg *config.WorkerConfig, pool *Pool, consumer *TaskConsumer, progress *ProgressPublisher) *PoolControl {
+	return &PoolControl{
+		redis:      redisClient,
+		pool:       pool,
+		consumer:   consumer,
+		progress:   progress,
+		instanceID: cfg.InstanceID,
+		interval:   time.Duration(cfg.StatusInterval) * time.Second,
+	}
+}
+
+// Start 定期上报本实例状态并监听控制命令，阻塞直到 ctx 结束
+func (c *PoolControl) Start(ctx context.Context) {
+	pubsub := c.redis.Subscribe(ctx, poolControlChannel)
+	defer pubsub.Close()
+
+	log.Printf("[PoolControl] Reporting pool status as %s every %v", c.instanceID, c.interval)
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	// 广播只送达在线实例，启动时补齐此前下发的暂停与并发设置
+	c.syncDesired(ctx)
+	c.report(ctx)
+	ch := pubsub.Channel()
+	for {
+		select {
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			var cmd PoolCommand
+			if err := json.Unmarshal([]byte(msg.Payload), &cmd); err != nil {
+				log.Printf("[PoolControl] ⚠ Ignoring malformed command: %v", err)
+				continue
+			}
+			if cmd.InstanceID != "" && cmd.InstanceID != c.instanceID {
+				continue
+			}
+			c.apply(&cmd)
+			c.report(ctx)
+		case <-ticker.C:
+			// 订阅断线重连期间可能错过广播，每轮按持久化的目标状态校正
+			c.syncDesired(ctx)
+			c.report(ctx)
+		case <-ctx.Done():
+			// 实例退出后不再出现在汇总中
+			cleanupCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			c.redis.Del(cleanupCtx, poolStatusKey(c.instanceID))
+			c.redis.SRem(cleanupCtx, poolInstancesKey, c.instanceID)
+			cancel()
+			log.Println("[PoolControl] Stopped")
+			return
+		}
+	}
+}
+
+// Broadcast 校验并广播控制命令，先持久化目标状态，供重启或错过广播的实例恢复
+func (c *PoolControl) Broadcast(ctx context.Context, cmd *PoolCommand) error {
+	if err := cmd.Validate(); err != nil {
+		return err
+	}
+	if cmd.InstanceID != "" {
+		known, err := c.redis.SIsMember(ctx, poolInstancesKey, cmd.InstanceID).Result()
+		if err != nil {
+			return fmt.Errorf("failed to check worker instance: %w", err)
+		}
+		if !known {
+			return fmt.Errorf("%w: %s", ErrUnknownInstance, cmd.InstanceID)
+		}
+	}
+	if err := c.persistDesired(ctx, cmd); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(cmd)
+	if err != nil {
+		return fmt.Errorf("failed to marshal pool command: %w", err)
+	}
+	if err := c.redis.Publish(ctx, poolControlChannel, data).Err(); err != nil {
+		return fmt.Errorf("failed to broadcast pool command: %w", err)
+	}
+	return nil
+}
+
+// Snapshots 汇总所有实例最近上报的状态，按实例标识排序
+func (c *PoolControl) Snapshots(ctx context.Context) ([]*PoolSnapshot, error) {
+	instances, err := c.redis.SMembers(ctx, poolInstancesKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list worker instances: %w", err)
+	}
+	if len(instances) == 0 {
+		return nil, nil
+	}
+	sort.Strings(instances)
+
+	keys := make([]string, 0, len(instances))
+	for _, id := range instances {
+		keys = append(keys, poolStatusKey(id))
+	}
+	values, err := c.redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load worker status: %w", err)
+	}
+
+	snapshots := make([]*PoolSnapshot, 0, len(values))
+	for i, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			// 状态已过期的实例视为已退出
+			c.redis.SRem(ctx, poolInstancesKey, instances[i])
+			continue
+		}
+		var snapshot PoolSnapshot
+		if err := json.Unmarshal([]byte(data), &snapshot); err != nil {
+			log.Printf("[PoolControl] ⚠ Failed to parse status of %s: %v", instances[i], err)
+			continue
+		}
+		snapshots = append(snapshots, &snapshot)
+	}
+	return snapshots, nil
+}
+
+// Snapshot 本实例当前的 Worker 池状态
+func (c *PoolControl) Snapshot(ctx context.Context) *PoolSnapshot {
+	snapshot := c.pool.Snapshot()
+	snapshot.InstanceID = c.instanceID
+	for i := range snapshot.ActiveTasks {
+		task := &snapshot.ActiveTasks[i]
+		latest, err := c.progress.Latest(ctx, task.TaskID)
+		if err != nil || latest == nil {
+			continue
+		}
+		task.Phase = latest.Phase
+		task.Percent = latest.Percent
+	}
+	if c.consumer != nil {
+		snapshot.Paused = c.consumer.ConsumingPaused()
+		snapshot.Queues = c.consumer.QueueDepths()
+	}
+	return snapshot
+}
+
+func (c *PoolControl) apply(cmd *PoolCommand) {
+	if err := cmd.Validate(); err != nil {
+		log.Printf("[PoolControl] ⚠ Ignoring invalid command: %v", err)
+		return
+	}
+
+	switch cmd.Action {
+	case PoolActionSetConcurrency:
+		c.pool.SetMaxConcurrent(cmd.MaxConcurrent)
+		if c.consumer != nil {
+			c.consumer.SetConcurrency(cmd.MaxConcurrent)
+		}
+	case PoolActionPause:
+		if c.consumer != nil {
+			c.consumer.PauseConsuming()
+		}
+	case PoolActionResume:
+		if c.consumer != nil {
+			c.consumer.ResumeConsuming()
+		}
+	}
+	log.Printf("[PoolControl] ✓ Applied %s command", cmd.Action)
+}
+
+// persistDesired 写入命令对应的目标状态，全局命令同时清除各实例对同一字段的单独设置
+func (c *PoolControl) persistDesired(ctx context.Context, cmd *PoolCommand) error {
+	field, value := desiredField(cmd)
+
+	var overrides []string
+	if cmd.InstanceID == "" {
+		fields, err := c.redis.HKeys(ctx, poolDesiredKey).Result()
+		if err != nil {
+			return fmt.Errorf("failed to load desired worker state: %w", err)
+		}
+		for _, f := range fields {
+			if strings.HasSuffix(f, "/"+field) {
+				overrides = append(overrides, f)
+			}
+		}
+	} else {
+		field = cmd.InstanceID + "/" + field
+	}
+
+	pipe := c.redis.TxPipeline()
+	if len(overrides) > 0 {
+		pipe.HDel(ctx, poolDesiredKey, overrides...)
+	}
+	pipe.HSet(ctx, poolDesiredKey, field, value)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to persist desired worker state: %w", err)
+	}
+	return nil
+}
+
+// syncDesired 将本实例调整到持久化的目标状态，未设置过的字段保持配置值
+func (c *PoolControl) syncDesired(ctx context.Context) {
+	values, err := c.redis.HGetAll(ctx, poolDesiredKey).Result()
+	if err != nil {
+		log.Printf("[PoolControl] ⚠ Failed to load desired worker state: %v", err)
+		return
+	}
+
+	for _, cmd := range desiredCommands(values, c.instanceID) {
+		switch cmd.Action {
+		case PoolActionSetConcurrency:
+			if _, max := c.pool.limiter.Usage(); max == cmd.MaxConcurrent {
+				continue
+			}
+		case PoolActionPause, PoolActionResume:
+			if c.consumer == nil || c.consumer.ConsumingPaused() == (cmd.Action == PoolActionPause) {
+				continue
+			}
+		}
+		c.apply(cmd)
+	}
+}
+
+// desiredField 控制命令对应的目标状态字段与取值
+func desiredField(cmd *PoolCommand) (string, string) {
+	switch cmd.Action {
+	case PoolActionSetConcurrency:
+		return desiredMaxConcurrentField, strconv.Itoa(cmd.MaxConcurrent)
+	case PoolActionPause:
+		return desiredPausedField, "1"
+	default:
+		return desiredPausedField, "0"
+	}
+}
+
+// desiredCommands 将目标状态还原为本实例需要执行的命令，实例单独设置优先于全局设置
+func desiredCommands(values map[string]string, instanceID string) []*PoolCommand {
+	lookup := func(field string) (string, bool) {
+		if value, ok := values[instanceID+"/"+field]; ok {
+			return value, true
+		}
+		value, ok := values[field]
+		return value, ok
+	}
+
+	var commands []*PoolCommand
+	if value, ok := lookup(desiredMaxConcurrentField); ok {
+		if max, err := strconv.Atoi(value); err == nil {
+			commands = append(commands, &PoolCommand{Action: PoolActionSetConcurrency, MaxConcurrent: max})
+		}
+	}
+	if value, ok := lookup(desiredPausedField); ok {
+		action := PoolActionResume
+		if value == "1" {
+			action = PoolActionPause
+		}
+		commands = append(commands, &PoolCommand{Action: action})
+	}
+	return commands
+}
+
+func (c *PoolControl) report(ctx context.Context) {
+	data, err := json.Marshal(c.Snapshot(ctx))
+	if err != nil {
+		log.Printf("[PoolControl] ⚠ Failed to marshal pool status: %v", err)
+		return
+	}
+
+	pipe := c.redis.TxPipeline()
+	pipe.Set(ctx, poolStatusKey(c.instanceID), data, 3*c.interval)
+	pipe.SAdd(ctx, poolInstancesKey, c.instanceID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("[PoolControl] ⚠ Failed to report pool status: %v", err)
+	}
+}
+
+// Snapshot 本地 Worker 池状态（不含进度与队列信息）
+func (p *Pool) Snapshot() *PoolSnapshot {
+	inUse, max := p.limiter.Usage()
+
+	p.sizeMu.Lock()
+	size := p.size
+	p.sizeMu.Unlock()
+
+	p.tasksMu.Lock()
+	active := make([]ActiveTask, 0, len(p.active))
+	for _, task := range p.active {
+		active = append(active, task)
+	}
+	p.tasksMu.Unlock()
+	sort.Slice(active, func(i, j int) bool { return active[i].StartedAt.Before(active[j].StartedAt) })
+
+	return &PoolSnapshot{
+		PoolSize:       size,
+		MaxConcurrent:  max,
+		Running:        inUse,
+		Buffered:       len(p.taskChan),
+		BufferCapacity: cap(p.taskChan),
+		ActiveTasks:    active,
+		UpdatedAt:      time.Now(),
+	}
+}
+
+// markActive 登记运行中任务的基本信息
+func (p *Pool) markActive(task *models.DownloadTask, platform string) {
+	p.tasksMu.Lock()
+	defer p.tasksMu.Unlock()
+
+	p.active[task.TaskID] = ActiveTask{
+		TaskID:    task.TaskID,
+		UserID:    task.UserID,
+		Platform:  platform,
+		StartedAt: time.Now(),
+	}
+}
+
+func poolStatusKey(instanceID string) string {
+	return fmt.Sprintf("worker:pool:%s", instanceID)
+}
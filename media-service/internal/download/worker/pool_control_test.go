@@ -0,0 +1,115 @@
+package worker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDesiredCommandsPrefersInstanceOverrides(t *testing.T) {
+	values := map[string]string{
+		"max_concurrent":         "8",
+		"paused":                 "1",
+		"media-1/paused":         "0",
+		"media-2/max_concurrent": "2",
+	}
+
+	got := desiredCommands(values, "media-1")
+	want := []*PoolCommand{
+		{Action: PoolActionSetConcurrency, MaxConcurrent: 8},
+		{Action: PoolActionResume},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("desiredCommands(media-1) = %+v, want %+v", got, want)
+	}
+
+	got = desiredCommands(values, "media-2")
+	want = []*PoolCommand{
+		{Action: PoolActionSetConcurrency, MaxConcurrent: 2},
+		{Action: PoolActionPause},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("desiredCommands(media-2) = %+v, want %+v", got, want)
+	}
+}
+
+func TestDesiredCommandsKeepsConfigWhenUnset(t *testing.T) {
+	if got := desiredCommands(map[string]string{"media-2/paused": "1"}, "media-1"); len(got) != 0 {
+		t.Fatalf("expected no commands without desired state, got %+v", got)
+	}
+}
+
+func TestDesiredFieldRoundTrips(t *testing.T) {
+	for _, cmd := range []*PoolCommand{
+		{Action: PoolActionSetConcurrency, MaxConcurrent: 5},
+		{Action: PoolActionPause},
+		{Action: PoolActionResume},
+	} {
+		field, value := desiredField(cmd)
+		got := desiredCommands(map[string]string{field: value}, "media-1")
+		if len(got) != 1 || !reflect.DeepEqual(got[0], cmd) {
+			t.Fatalf("round trip of %+v = %+v", cmd, got)
+		}
+	}
+}
+
+// prefetchBroker 模拟按通道预取数投递：未确认的消息达到预取数后不再投递
+type prefetchBroker struct {
+	prefetch int
+	backlog  int
+	unacked  int
+}
+
+func (b *prefetchBroker) qos(prefetch int) error {
+	b.prefetch = prefetch
+	return nil
+}
+
+func (b *prefetchBroker) deliver() int {
+	delivered := 0
+	for b.backlog > 0 && b.unacked < b.prefetch {
+		b.backlog--
+		b.unacked++
+		delivered++
+	}
+	return delivered
+}
+
+func TestSetConcurrencyRaisesPrefetch(t *testing.T) {
+	pool := newDrainTestPool(1)
+	t.Cleanup(pool.Stop)
+
+	defaultQueue := &prefetchBroker{prefetch: 2, backlog: 20}
+	youtubeQueue := &prefetchBroker{prefetch: 1, backlog: 20}
+	consumer := &TaskConsumer{
+		baseConcurrency: 2,
+		partitions: []*partition{
+			{queue: "q", basePrefetch: 2, qos: defaultQueue.qos},
+			{platform: "youtube", queue: "q.youtube", basePrefetch: 1, qos: youtubeQueue.qos},
+		},
+	}
+	control := &PoolControl{pool: pool, consumer: consumer}
+
+	if got := defaultQueue.deliver() + youtubeQueue.deliver(); got != 3 {
+		t.Fatalf("expected 3 deliveries at startup prefetch, got %d", got)
+	}
+
+	control.apply(&PoolCommand{Action: PoolActionSetConcurrency, MaxConcurrent: 8})
+	if _, max := pool.limiter.Usage(); max != 8 {
+		t.Fatalf("expected max concurrent 8, got %d", max)
+	}
+	if defaultQueue.prefetch != 8 || youtubeQueue.prefetch != 4 {
+		t.Fatalf("expected prefetch scaled to 8/4, got %d/%d", defaultQueue.prefetch, youtubeQueue.prefetch)
+	}
+	if got := defaultQueue.deliver() + youtubeQueue.deliver(); got != 9 {
+		t.Fatalf("expected 9 more deliveries after raising the limit, got %d", got)
+	}
+
+	// 调低并发后不再投递新消息，直到未确认的消息降到新的预取数以下
+	control.apply(&PoolCommand{Action: PoolActionSetConcurrency, MaxConcurrent: 1})
+	if defaultQueue.prefetch != 1 || youtubeQueue.prefetch != 1 {
+		t.Fatalf("expected prefetch scaled down to 1/1, got %d/%d", defaultQueue.prefetch, youtubeQueue.prefetch)
+	}
+	if got := defaultQueue.deliver() + youtubeQueue.deliver(); got != 0 {
+		t.Fatalf("expected no deliveries after lowering the limit, got %d", got)
+	}
+}
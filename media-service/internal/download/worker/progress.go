@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sync"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 
@@ -12,9 +14,18 @@ import (
 	"youdlp/media-service/internal/download/ytdlp"
 )
 
-// ProgressPublisher 进度发布器
+// progressSnapshotTTL 最新进度快照与进度日志保留时长
+const progressSnapshotTTL = 24 * time.Hour
+
+// progressLogMaxLen 每个任务进度日志保留的条数上限，供 SSE 客户端按 Last-Event-ID 续传
+const progressLogMaxLen = 500
+
+// ProgressPublisher 进度发布器。除任务频道 progress:<task_id> 外，已登记所属用户的任务
+// 同时发布到用户级频道 progress:user:<user_id>，供单个连接订阅用户的全部任务
 type ProgressPublisher struct {
 	redis *redis.Client
+	// owners 任务所属用户缓存 map[taskID]userID
+	owners sync.Map
 }
 
 // NewProgressPublisher 创建进度发布器
@@ -33,14 +44,111 @@ func (p *ProgressPublisher) Publish(ctx context.Context, msg *models.ProgressMes
 		return fmt.Errorf("failed to marshal progress message: %w", err)
 	}
 
+	// 保存最新快照供状态查询使用，并追加到进度日志供断线续传
+	pipe := p.redis.TxPipeline()
+	pipe.Set(ctx, progressSnapshotKey(msg.TaskID), data, progressSnapshotTTL)
+	pipe.XAdd(ctx, &redis.XAddArgs{
+		Stream: progressLogKey(msg.TaskID),
+		MaxLen: progressLogMaxLen,
+		Approx: true,
+		Values: map[string]interface{}{"data": data},
+	})
+	pipe.Expire(ctx, progressLogKey(msg.TaskID), progressSnapshotTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("[Progress] ⚠ Failed to store progress snapshot for %s: %v", msg.TaskID, err)
+	}
+
 	if err := p.redis.Publish(ctx, channel, data).Err(); err != nil {
 		return fmt.Errorf("failed to publish progress: %w", err)
 	}
 
+	if userID := p.owner(ctx, msg.TaskID); userID != "" {
+		if err := p.redis.Publish(ctx, userProgressChannel(userID), data).Err(); err != nil {
+			log.Printf("[Progress] ⚠ Failed to publish progress for %s to user channel: %v", msg.TaskID, err)
+		}
+		if isTerminalProgress(msg.Status) {
+			p.redis.SRem(ctx, userActiveTasksKey(userID), msg.TaskID)
+		}
+	}
+	if isTerminalProgress(msg.Status) || msg.Status == "requeued" {
+		// 任务离开本实例，重新执行时会再次登记
+		p.owners.Delete(msg.TaskID)
+	}
+
 	log.Printf("[Progress] Published to %s: %.2f%% phase=%s", channel, msg.Percent, msg.Phase)
 	return nil
 }
 
+// Track 登记任务所属用户：之后的进度同时发布到用户级频道，任务在结束前保留在用户的活跃任务集合中
+func (p *ProgressPublisher) Track(ctx context.Context, taskID, userID string) {
+	if userID == "" {
+		return
+	}
+	p.owners.Store(taskID, userID)
+
+	pipe := p.redis.TxPipeline()
+	pipe.Set(ctx, progressOwnerKey(taskID), userID, progressSnapshotTTL)
+	pipe.SAdd(ctx, userActiveTasksKey(userID), taskID)
+	pipe.Expire(ctx, userActiveTasksKey(userID), progressSnapshotTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("[Progress] ⚠ Failed to track owner of %s: %v", taskID, err)
+	}
+}
+
+// owner 任务所属用户，本实例未登记时从 Redis 读取（如其他实例取消任务）
+func (p *ProgressPublisher) owner(ctx context.Context, taskID string) string {
+	if userID, ok := p.owners.Load(taskID); ok {
+		return userID.(string)
+	}
+	userID, err := p.redis.Get(ctx, progressOwnerKey(taskID)).Result()
+	if err != nil {
+		return ""
+	}
+	return userID
+}
+
+// Latest 获取任务最新的进度快照，不存在时返回 nil
+func (p *ProgressPublisher) Latest(ctx context.Context, taskID string) (*models.ProgressMessage, error) {
+	data, err := p.redis.Get(ctx, progressSnapshotKey(taskID)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get progress snapshot: %w", err)
+	}
+
+	var msg models.ProgressMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal progress snapshot: %w", err)
+	}
+	return &msg, nil
+}
+
+func progressSnapshotKey(taskID string) string {
+	return fmt.Sprintf("progress:snapshot:%s", taskID)
+}
+
+func progressLogKey(taskID string) string {
+	return fmt.Sprintf("progress:log:%s", taskID)
+}
+
+func progressOwnerKey(taskID string) string {
+	return fmt.Sprintf("progress:owner:%s", taskID)
+}
+
+func userProgressChannel(userID string) string {
+	return fmt.Sprintf("progress:user:%s", userID)
+}
+
+func userActiveTasksKey(userID string) string {
+	return fmt.Sprintf("progress:active:%s", userID)
+}
+
+// isTerminalProgress 任务是否已结束，结束后不会再有进度消息
+func isTerminalProgress(status string) bool {
+	return status == "completed" || status == "failed" || status == "cancelled"
+}
+
 // phaseLabel 阶段中文标签映射
 func phaseLabel(phase ytdlp.DownloadPhase) string {
 	switch phase {
@@ -54,6 +162,8 @@ func phaseLabel(phase ytdlp.DownloadPhase) string {
 		return "正在合并音视频"
 	case ytdlp.PhaseProcessing:
 		return "正在处理文件"
+	case ytdlp.PhasePostProcessing:
+		return "正在转码"
 	default:
 		return "下载中"
 	}
@@ -71,6 +181,9 @@ func (p *ProgressPublisher) PublishDownloading(ctx context.Context, taskID strin
 		TotalBytes:      progress.TotalBytes,
 		Speed:           progress.Speed,
 		ETA:             progress.ETA,
+		FormatID:        progress.FormatID,
+		FragmentIndex:   progress.FragmentIndex,
+		FragmentCount:   progress.FragmentCount,
 	}
 	return p.Publish(ctx, msg)
 }
@@ -87,6 +200,19 @@ func (p *ProgressPublisher) PublishPhase(ctx context.Context, taskID string, pha
 	return p.Publish(ctx, msg)
 }
 
+// PublishPostProcessing 发布后处理（转码）进度
+func (p *ProgressPublisher) PublishPostProcessing(ctx context.Context, taskID, profile string, percent float64) error {
+	msg := &models.ProgressMessage{
+		TaskID:     taskID,
+		Status:     "downloading",
+		Percent:    percent,
+		Phase:      string(ytdlp.PhasePostProcessing),
+		PhaseLabel: phaseLabel(ytdlp.PhasePostProcessing),
+		Message:    profile,
+	}
+	return p.Publish(ctx, msg)
+}
+
 // PublishStarted 发布下载开始状态，避免长时间静默导致前端看不到任何进度
 func (p *ProgressPublisher) PublishStarted(ctx context.Context, taskID string, message string) error {
 	msg := &models.ProgressMessage{
@@ -118,3 +244,23 @@ func (p *ProgressPublisher) PublishFailed(ctx context.Context, taskID, errorMsg
 	}
 	return p.Publish(ctx, msg)
 }
+
+// PublishCancelled 发布取消状态
+func (p *ProgressPublisher) PublishCancelled(ctx context.Context, taskID, message string) error {
+	msg := &models.ProgressMessage{
+		TaskID:  taskID,
+		Status:  "cancelled",
+		Message: message,
+	}
+	return p.Publish(ctx, msg)
+}
+
+// PublishRequeued 发布重新排队状态：实例关闭时任务未完成，稍后由其他 Worker 继续
+func (p *ProgressPublisher) PublishRequeued(ctx context.Context, taskID, message string) error {
+	msg := &models.ProgressMessage{
+		TaskID:  taskID,
+		Status:  "requeued",
+		Message: message,
+	}
+	return p.Publish(ctx, msg)
+}
@@ -0,0 +1,32 @@
+package worker
+
+import "testing"
+
+func TestProgressKeysMatchGatewaySubscriptions(t *testing.T) {
+	if got := userProgressChannel("user-1"); got != "progress:user:user-1" {
+		t.Fatalf("unexpected user channel %q", got)
+	}
+	if got := userActiveTasksKey("user-1"); got != "progress:active:user-1" {
+		t.Fatalf("unexpected active tasks key %q", got)
+	}
+	if got := progressSnapshotKey("task-1"); got != "progress:snapshot:task-1" {
+		t.Fatalf("unexpected snapshot key %q", got)
+	}
+	if got := progressLogKey("task-1"); got != "progress:log:task-1" {
+		t.Fatalf("unexpected progress log key %q", got)
+	}
+}
+
+func TestIsTerminalProgress(t *testing.T) {
+	for status, want := range map[string]bool{
+		"completed":   true,
+		"failed":      true,
+		"cancelled":   true,
+		"downloading": false,
+		"requeued":    false,
+	} {
+		if got := isTerminalProgress(status); got != want {
+			t.Fatalf("isTerminalProgress(%q) = %v, want %v", status, got, want)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"youdlp/media-service/internal/download/config"
+	"youdlp/media-service/internal/download/models"
+)
+
+// reapAction 心跳失联任务的处理方式
+type reapAction int
+
+const (
+	reapRequeue reapAction = iota // 重新投递到下载队列
+	reapFail                      // 标记失败并释放计费预占与代理绑定
+)
+
+// Reaper 卡住任务回收器：Worker 所在实例退出或卡死后，任务停留在下载中且心跳过期，
+// 回收器将其重新入队，或在重试预算耗尽时按 Pool.handleError 的路径标记失败
+type Reaper struct {
+	pool       *Pool
+	heartbeats *Heartbeats
+	consumer   *TaskConsumer
+	interval   time.Duration
+	batchSize  int
+	enabled    bool
+}
+
+// NewReaper 创建卡住任务回收器，consumer 为空时失联任务一律标记失败
+func NewReaper(cfg *config.ReaperConfig, pool *Pool, heartbeats *Heartbeats, consumer *TaskConsumer) *Reaper {
+	return &Reaper{
+		pool:       pool,
+		heartbeats: heartbeats,
+		consumer:   consumer,
+		interval:   time.Duration(cfg.Interval) * time.Second,
+		batchSize:  cfg.BatchSize,
+		enabled:    cfg.Enabled,
+	}
+}
+
+// Start 启动回收器，阻塞直到 ctx 结束
+func (r *Reaper) Start(ctx context.Context) {
+	if !r.enabled {
+		log.Println("[Reaper] Reaper is disabled")
+		return
+	}
+
+	log.Printf("[Reaper] Starting reaper, interval: %v, heartbeat_ttl: %v, batch_size: %d", r.interval, r.heartbeats.TTL(), r.batchSize)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.reap(ctx)
+		case <-ctx.Done():
+			log.Println("[Reaper] Reaper stopped")
+			return
+		}
+	}
+}
+
+// reap 扫描一轮心跳失联的任务
+func (r *Reaper) reap(ctx context.Context) {
+	// 刚开始的任务可能还没写入第一次心跳，只检查开始时间早于一个心跳周期的任务
+	records, err := r.pool.repo.FindProcessingStartedBefore(ctx, time.Now().Add(-r.heartbeats.TTL()), r.batchSize)
+	if err != nil {
+		log.Printf("[Reaper] Failed to find processing tasks: %v", err)
+		return
+	}
+	if len(records) == 0 {
+		return
+	}
+
+	taskIDs := make([]string, 0, len(records))
+	for _, record := range records {
+		taskIDs = append(taskIDs, record.TaskID)
+	}
+	alive, err := r.heartbeats.Alive(ctx, taskIDs)
+	if err != nil {
+		log.Printf("[Reaper] Failed to check heartbeats: %v", err)
+		return
+	}
+
+	for _, record := range records {
+		if alive[record.TaskID] {
+			continue
+		}
+		claimed, err := r.heartbeats.ClaimReap(ctx, record.TaskID, r.interval)
+		if err != nil {
+			log.Printf("[Reaper] Failed to claim task %s: %v", record.TaskID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		r.reapTask(ctx, record)
+	}
+}
+
+// reapTask 处理单个心跳失联的任务
+func (r *Reaper) reapTask(ctx context.Context, record *models.DownloadHistory) {
+	taskID := record.TaskID
+	task := r.loadTask(ctx, record)
+
+	if lapsedAction(record, task != nil, r.consumer != nil, r.pool.maxAttempts()) == reapRequeue {
+		err := r.requeue(ctx, task)
+		if err == nil {
+			return
+		}
+		log.Printf("[Reaper] Failed to requeue task %s, marking failed: %v", taskID, err)
+	}
+
+	if task == nil {
+		task = taskFromRecord(record)
+	}
+	log.Printf("[Reaper] Task %s lost its worker heartbeat, marking failed", taskID)
+	r.pool.DiscardWorkDir(taskID)
+	r.pool.failTask(ctx, task, ErrHeartbeatLost, record.RetryCount)
+}
+
+// requeue 使用新的代理租约将任务重新投递到下载队列
+func (r *Reaper) requeue(ctx context.Context, task *models.DownloadTask) error {
+	if err := r.pool.RefreshTaskProxy(ctx, task); err != nil {
+		log.Printf("[Reaper] Failed to refresh proxy for task %s: %v", task.TaskID, err)
+	}
+
+	requeued, err := r.pool.repo.RequeueLapsed(ctx, task.TaskID)
+	if err != nil {
+		return err
+	}
+	if !requeued {
+		log.Printf("[Reaper] Task %s is no longer processing, skipping", task.TaskID)
+		return nil
+	}
+	if err := r.consumer.Requeue(ctx, task); err != nil {
+		return err
+	}
+
+	log.Printf("[Reaper] ✓ Task %s lost its worker heartbeat, requeued", task.TaskID)
+	return nil
+}
+
+// loadTask 读取任务消息，未保存或无法解析时返回 nil
+func (r *Reaper) loadTask(ctx context.Context, record *models.DownloadHistory) *models.DownloadTask {
+	payload, err := r.pool.repo.FindTaskPayload(ctx, record.TaskID)
+	if err != nil {
+		log.Printf("[Reaper] Failed to load payload for task %s: %v", record.TaskID, err)
+		return nil
+	}
+	if payload == nil {
+		return nil
+	}
+	task, err := parseTask(payload)
+	if err != nil {
+		log.Printf("[Reaper] Failed to parse payload for task %s: %v", record.TaskID, err)
+		return nil
+	}
+	return task
+}
+
+// lapsedAction 有任务消息且重试预算未耗尽时重新入队，否则标记失败
+func lapsedAction(record *models.DownloadHistory, hasPayload, canRequeue bool, maxAttempts int) reapAction {
+	if !hasPayload || !canRequeue {
+		return reapFail
+	}
+	if record.RetryCount+1 >= maxAttempts {
+		return reapFail
+	}
+	return reapRequeue
+}
+
+// taskFromRecord 没有任务消息时用下载记录构造失败处理所需的任务信息
+func taskFromRecord(record *models.DownloadHistory) *models.DownloadTask {
+	return &models.DownloadTask{
+		TaskID:   record.TaskID,
+		UserID:   record.UserID,
+		URL:      record.URL,
+		Mode:     record.Mode,
+		Quality:  record.Quality,
+		Platform: record.Platform,
+		Title:    record.Title,
+		Metadata: models.Metadata{Platform: record.Platform, Title: record.Title},
+	}
+}
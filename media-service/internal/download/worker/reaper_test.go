@@ -0,0 +1,53 @@
+package worker
+
+import (
+	"testing"
+
+	"youdlp/media-service/internal/download/models"
+)
+
+func TestLapsedActionRequeuesWithinRetryBudget(t *testing.T) {
+	record := &models.DownloadHistory{TaskID: "task-1", RetryCount: 1}
+
+	if got := lapsedAction(record, true, true, 3); got != reapRequeue {
+		t.Fatalf("expected requeue, got %v", got)
+	}
+}
+
+func TestLapsedActionFailsWhenBudgetExhausted(t *testing.T) {
+	record := &models.DownloadHistory{TaskID: "task-1", RetryCount: 2}
+
+	if got := lapsedAction(record, true, true, 3); got != reapFail {
+		t.Fatalf("expected fail after exhausting retries, got %v", got)
+	}
+	if got := lapsedAction(&models.DownloadHistory{TaskID: "task-2"}, true, true, 1); got != reapFail {
+		t.Fatalf("expected fail without retry budget, got %v", got)
+	}
+}
+
+func TestLapsedActionFailsWithoutPayloadOrQueue(t *testing.T) {
+	record := &models.DownloadHistory{TaskID: "task-1"}
+
+	if got := lapsedAction(record, false, true, 3); got != reapFail {
+		t.Fatalf("expected fail without payload, got %v", got)
+	}
+	if got := lapsedAction(record, true, false, 3); got != reapFail {
+		t.Fatalf("expected fail without queue, got %v", got)
+	}
+}
+
+func TestTaskFromRecordKeepsPlatform(t *testing.T) {
+	record := &models.DownloadHistory{
+		TaskID:   "task-1",
+		UserID:   "user-1",
+		URL:      "https://www.youtube.com/watch?v=abc",
+		Mode:     "quick_download",
+		Platform: "youtube",
+		Title:    "demo",
+	}
+
+	task := taskFromRecord(record)
+	if task.TaskID != "task-1" || task.UserID != "user-1" || task.Metadata.Platform != "youtube" {
+		t.Fatalf("unexpected task: %+v", task)
+	}
+}
@@ -0,0 +1,160 @@
+package worker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"youdlp/media-service/internal/download/models"
+)
+
+// resumeStateFile 工作目录中记录续传信息的文件
+const resumeStateFile = ".resume.json"
+
+// resumeState 任务工作目录的续传信息。重试时格式不变才沿用已有的部分文件
+type resumeState struct {
+	FormatID   string `json:"format_id"`
+	OutputPath string `json:"output_path"`
+}
+
+// resumeFormatID 返回决定下载内容的格式标识，未选择具体格式时退回画质与容器
+func resumeFormatID(task *models.DownloadTask) string {
+	if task.FormatID != "" {
+		return task.FormatID
+	}
+	if task.SelectedFormat != nil && task.SelectedFormat.FormatID != "" {
+		return task.SelectedFormat.FormatID
+	}
+	return task.Quality + "/" + task.Format
+}
+
+// prepareWorkDir 准备任务工作目录并返回输出路径。
+// 上次尝试留下的部分文件在格式未变时保留供 yt-dlp 续传，否则清空重新下载
+func (p *Pool) prepareWorkDir(task *models.DownloadTask) (string, bool, error) {
+	taskID := task.TaskID
+	workDir := p.pathGenerator.WorkDir(taskID)
+	statePath := filepath.Join(workDir, resumeStateFile)
+	formatID := resumeFormatID(task)
+
+	if state, err := loadResumeState(statePath); err == nil {
+		if state.FormatID == formatID && filepath.Dir(state.OutputPath) == workDir {
+			return state.OutputPath, true, nil
+		}
+		log.Printf("[Worker] [Task %s] Format changed (%s → %s), discarding partial download", taskID, state.FormatID, formatID)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		log.Printf("[Worker] [Task %s] ⚠ Invalid resume state, discarding partial download: %v", taskID, err)
+	}
+
+	if err := p.fileManager.DeleteDir(workDir); err != nil {
+		return "", false, err
+	}
+	outputPath, err := p.pathGenerator.GeneratePath(task)
+	if err != nil {
+		return "", false, err
+	}
+	if err := saveResumeState(statePath, &resumeState{FormatID: formatID, OutputPath: outputPath}); err != nil {
+		return "", false, err
+	}
+	return outputPath, false, nil
+}
+
+func loadResumeState(path string) (*resumeState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var state resumeState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to decode resume state: %w", err)
+	}
+	return &state, nil
+}
+
+func saveResumeState(path string, state *resumeState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to encode resume state: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write resume state: %w", err)
+	}
+	return nil
+}
+
+// ingressMeter 按输出文件统计入站流量。
+// 续传时 yt-dlp 报告的已下载字节包含上次保留的部分，本次新传输的字节需扣除各文件开始前已有的大小
+type ingressMeter struct {
+	baseline map[string]int64 // 输出文件 → 本次尝试开始前已落盘的字节数
+	peaks    map[string]int64
+	seen     map[string]bool // 本次尝试中 yt-dlp 写入或跳过的输出文件
+}
+
+// newIngressMeter 记录工作目录中已有的部分文件（.part）与已完成文件的大小
+func newIngressMeter(workDir string) *ingressMeter {
+	m := &ingressMeter{
+		baseline: make(map[string]int64),
+		peaks:    make(map[string]int64),
+		seen:     make(map[string]bool),
+	}
+
+	entries, err := os.ReadDir(workDir)
+	if err != nil {
+		return m
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || entry.Name() == resumeStateFile {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		path := filepath.Join(workDir, strings.TrimSuffix(entry.Name(), ".part"))
+		if info.Size() > m.baseline[path] {
+			m.baseline[path] = info.Size()
+		}
+	}
+	return m
+}
+
+// record 记录某个输出文件的已下载字节数，字节数来自 yt-dlp 对该流的进度报告
+func (m *ingressMeter) record(path string, downloadedBytes int64) {
+	if path == "" {
+		return
+	}
+	m.seen[path] = true
+	if downloadedBytes > m.peaks[path] {
+		m.peaks[path] = downloadedBytes
+	}
+}
+
+// resumedBytes 之前的尝试已传输、本次续用的字节数。
+// 只统计 yt-dlp 实际续写或跳过的文件，后处理残留等其他文件不计入
+func (m *ingressMeter) resumedBytes() int64 {
+	var total int64
+	for path := range m.seen {
+		total += m.baseline[path]
+	}
+	return total
+}
+
+// newBytes 本次尝试实际新传输的字节数
+func (m *ingressMeter) newBytes() int64 {
+	var total int64
+	for path, peak := range m.peaks {
+		if added := peak - m.baseline[path]; added > 0 {
+			total += added
+		}
+	}
+	return total
+}
+
+// billableBytes 任务完成时结算的入站字节数。失败的尝试不结算入站流量（重试期间只保留计费预占），
+// 续用的字节此前从未计费，与本次新传输的字节合计即每个字节恰好计费一次
+func (m *ingressMeter) billableBytes() int64 {
+	return m.resumedBytes() + m.newBytes()
+}
@@ -0,0 +1,153 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"youdlp/media-service/internal/download/config"
+	"youdlp/media-service/internal/download/models"
+	"youdlp/media-service/internal/download/storage"
+)
+
+func newResumeTestPool(t *testing.T) *Pool {
+	t.Helper()
+	base := t.TempDir()
+	return &Pool{
+		pathGenerator: storage.NewPathGenerator(&config.StorageConfig{BasePath: base}),
+		fileManager:   storage.NewFileManager(base),
+	}
+}
+
+func TestPrepareWorkDirResumesSameFormat(t *testing.T) {
+	p := newResumeTestPool(t)
+	task := &models.DownloadTask{
+		TaskID:   "task-1",
+		Mode:     "archive",
+		Format:   "mp4",
+		FormatID: "137+140",
+		Metadata: models.Metadata{Title: "video"},
+	}
+
+	outputPath, resumed, err := p.prepareWorkDir(task)
+	if err != nil {
+		t.Fatalf("prepare work dir failed: %v", err)
+	}
+	if resumed {
+		t.Fatal("expected first attempt to start fresh")
+	}
+	partPath := outputPath + ".part"
+	if err := os.WriteFile(partPath, []byte("partial"), 0o644); err != nil {
+		t.Fatalf("failed to write part file: %v", err)
+	}
+
+	retryPath, resumed, err := p.prepareWorkDir(task)
+	if err != nil {
+		t.Fatalf("prepare retry work dir failed: %v", err)
+	}
+	if !resumed || retryPath != outputPath {
+		t.Fatalf("expected retry to resume %s, got %s (resumed=%v)", outputPath, retryPath, resumed)
+	}
+	if _, err := os.Stat(partPath); err != nil {
+		t.Fatalf("expected part file to be kept: %v", err)
+	}
+
+	task.FormatID = "22"
+	_, resumed, err = p.prepareWorkDir(task)
+	if err != nil {
+		t.Fatalf("prepare work dir after format change failed: %v", err)
+	}
+	if resumed {
+		t.Fatal("expected format change to discard partial download")
+	}
+	if _, err := os.Stat(partPath); !os.IsNotExist(err) {
+		t.Fatalf("expected part file to be removed, got %v", err)
+	}
+}
+
+func TestIngressMeterCountsOnlyNewBytes(t *testing.T) {
+	dir := t.TempDir()
+	video := filepath.Join(dir, "video.f137.mp4")
+	audio := filepath.Join(dir, "video.f140.m4a")
+	if err := os.WriteFile(video+".part", make([]byte, 600), 0o644); err != nil {
+		t.Fatalf("failed to write part file: %v", err)
+	}
+	if err := os.WriteFile(audio, make([]byte, 100), 0o644); err != nil {
+		t.Fatalf("failed to write completed stream: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "video.mp3"), make([]byte, 50), 0o644); err != nil {
+		t.Fatalf("failed to write leftover file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, resumeStateFile), []byte("{}"), 0o644); err != nil {
+		t.Fatalf("failed to write resume state: %v", err)
+	}
+
+	meter := newIngressMeter(dir)
+
+	// 续传的视频流从 600 字节继续到 1000 字节
+	meter.record(video, 650)
+	meter.record(video, 1000)
+	// 已完成的音频流被 yt-dlp 跳过，仍报告 finished 与完整大小
+	meter.record(audio, 100)
+
+	if got := meter.newBytes(); got != 400 {
+		t.Fatalf("expected 400 new bytes, got %d", got)
+	}
+	if got := meter.resumedBytes(); got != 700 {
+		t.Fatalf("expected 700 resumed bytes, got %d", got)
+	}
+}
+
+func TestResumedDownloadBillsEachByteOnce(t *testing.T) {
+	dir := t.TempDir()
+	video := filepath.Join(dir, "video.mp4")
+	repo := &statusRepository{
+		statuses: map[string]int{"task-1": models.StatusProcessing},
+		retries:  map[string]int{},
+	}
+	assetClient := &releaseRecordingAssetClient{}
+	p := newDrainTestPool(1)
+	p.repo = repo
+	p.assetClient = assetClient
+	p.progressPublisher = NewProgressPublisher(redis.NewClient(&redis.Options{Addr: "127.0.0.1:0", MaxRetries: -1}))
+	task := &models.DownloadTask{TaskID: "task-1"}
+	ctx := context.Background()
+
+	// 前两次尝试分别传输到 400、700 字节后失败，.part 留在工作目录中
+	for _, reached := range []int64{400, 700} {
+		meter := newIngressMeter(dir)
+		meter.record(video, reached)
+		if err := os.WriteFile(video+".part", make([]byte, reached), 0o644); err != nil {
+			t.Fatalf("failed to write part file: %v", err)
+		}
+		taskErr := errors.New("connection reset by peer")
+		if err := p.handleError(ctx, task, taskErr); !errors.Is(err, taskErr) {
+			t.Fatalf("handleError = %v", err)
+		}
+		if err := p.scheduleRetry(ctx, task, taskErr, time.Minute); err != nil {
+			t.Fatalf("scheduleRetry: %v", err)
+		}
+	}
+	if assetClient.capturedBytes != 0 {
+		t.Fatalf("failed attempts must not capture ingress, got %d bytes", assetClient.capturedBytes)
+	}
+
+	// 第三次尝试从 700 字节续传到 1000 字节完成
+	meter := newIngressMeter(dir)
+	meter.record(video, 1000)
+	if err := assetClient.CaptureIngressUsage(task.TaskID, meter.billableBytes()); err != nil {
+		t.Fatalf("capture ingress: %v", err)
+	}
+
+	if meter.newBytes() != 300 {
+		t.Fatalf("expected 300 new bytes in the final attempt, got %d", meter.newBytes())
+	}
+	if assetClient.capturedBytes != 1000 {
+		t.Fatalf("expected the 1000-byte download to be billed exactly once, got %d", assetClient.capturedBytes)
+	}
+}
@@ -0,0 +1,98 @@
+package worker
+
+import (
+	"fmt"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+
+	"youdlp/media-service/internal/download/config"
+	"youdlp/media-service/internal/utils"
+)
+
+// 重试与死信消息头
+const (
+	headerRetryAttempt   = "x-retry-attempt"
+	headerErrorCategory  = "x-error-category"
+	headerErrorMessage   = "x-error-message"
+	headerOriginalQueue  = "x-original-queue"
+	headerDeadLetteredAt = "x-dead-lettered-at"
+)
+
+// maxErrorHeaderLength 死信消息头中错误信息的最大长度，避免 yt-dlp 长输出撑大消息头
+const maxErrorHeaderLength = 1024
+
+// retryDelay 计算第 attempt 次重试（从 1 开始）前的等待时长：
+// InitialInterval * 2^(attempt-1)，不超过 MaxInterval。未配置初始间隔时立即重试。
+func retryDelay(cfg *config.RetryConfig, attempt int) time.Duration {
+	if cfg == nil || cfg.InitialInterval <= 0 || attempt <= 0 {
+		return 0
+	}
+
+	delay := time.Duration(cfg.InitialInterval) * time.Second
+	maxDelay := time.Duration(cfg.MaxInterval) * time.Second
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if maxDelay > 0 && delay >= maxDelay {
+			return maxDelay
+		}
+	}
+	if maxDelay > 0 && delay > maxDelay {
+		return maxDelay
+	}
+	return delay
+}
+
+// retryQueueName 指定延迟对应的延迟队列名，同一延迟的重试共用一个队列
+func retryQueueName(queue string, delay time.Duration) string {
+	return fmt.Sprintf("%s.retry.%ds", queue, int64(delay/time.Second))
+}
+
+// retryQueueArgs 延迟队列参数：消息在队列中停留 delay 后过期，并经默认交换机转回主队列
+func retryQueueArgs(queue string, delay time.Duration) amqp.Table {
+	return amqp.Table{
+		"x-message-ttl":             delay.Milliseconds(),
+		"x-dead-letter-exchange":    "",
+		"x-dead-letter-routing-key": queue,
+	}
+}
+
+// retryDelays 返回重试预算内所有需要的延迟（去重、保持顺序）
+func retryDelays(cfg *config.RetryConfig, maxAttempts int) []time.Duration {
+	var delays []time.Duration
+	seen := make(map[time.Duration]bool)
+	for attempt := 1; attempt < maxAttempts; attempt++ {
+		delay := retryDelay(cfg, attempt)
+		if delay <= 0 || seen[delay] {
+			continue
+		}
+		seen[delay] = true
+		delays = append(delays, delay)
+	}
+	return delays
+}
+
+// shouldRetry 判断失败任务是否值得重试：视频本身不可用（私有、删除、地区限制等）时重试没有意义
+func shouldRetry(category string) bool {
+	return category != utils.ErrorCategoryTerminalVideo
+}
+
+// deadLetterHeaders 在原始消息头基础上附加死信原因
+func deadLetterHeaders(headers amqp.Table, queue string, attempt int, category string, taskErr error) amqp.Table {
+	cloned := cloneHeaders(headers)
+	cloned[headerRetryAttempt] = int32(attempt)
+	cloned[headerOriginalQueue] = queue
+	cloned[headerDeadLetteredAt] = time.Now().UTC().Format(time.RFC3339)
+	if category == "" {
+		category = utils.ErrorCategoryUnknown
+	}
+	cloned[headerErrorCategory] = category
+	if taskErr != nil {
+		message := taskErr.Error()
+		if len(message) > maxErrorHeaderLength {
+			message = message[:maxErrorHeaderLength]
+		}
+		cloned[headerErrorMessage] = message
+	}
+	return cloned
+}
@@ -0,0 +1,199 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/redis/go-redis/v9"
+
+	"youdlp/media-service/internal/download/config"
+	"youdlp/media-service/internal/download/models"
+	"youdlp/media-service/internal/utils"
+)
+
+func TestRetryDelayBacksOffExponentially(t *testing.T) {
+	cfg := &config.RetryConfig{MaxAttempts: 6, InitialInterval: 60, MaxInterval: 300}
+
+	want := []time.Duration{60 * time.Second, 120 * time.Second, 240 * time.Second, 300 * time.Second, 300 * time.Second}
+	for i, expected := range want {
+		if got := retryDelay(cfg, i+1); got != expected {
+			t.Fatalf("attempt %d: expected %v, got %v", i+1, expected, got)
+		}
+	}
+}
+
+func TestRetryDelayImmediateWithoutInterval(t *testing.T) {
+	if got := retryDelay(&config.RetryConfig{MaxAttempts: 3}, 1); got != 0 {
+		t.Fatalf("expected immediate retry, got %v", got)
+	}
+	if got := retryDelay(nil, 1); got != 0 {
+		t.Fatalf("expected immediate retry without config, got %v", got)
+	}
+}
+
+func TestRetryDelaysDeduplicatesCappedDelays(t *testing.T) {
+	cfg := &config.RetryConfig{MaxAttempts: 5, InitialInterval: 60, MaxInterval: 100}
+
+	delays := retryDelays(cfg, cfg.MaxAttempts)
+	if len(delays) != 2 || delays[0] != 60*time.Second || delays[1] != 100*time.Second {
+		t.Fatalf("unexpected delays: %v", delays)
+	}
+	if name := retryQueueName("youdlp.download.queue", delays[1]); name != "youdlp.download.queue.retry.100s" {
+		t.Fatalf("unexpected retry queue name: %s", name)
+	}
+}
+
+func TestShouldRetrySkipsTerminalVideo(t *testing.T) {
+	terminal := fmt.Errorf("download failed: %w", utils.ErrVideoPrivate)
+	if shouldRetry(utils.ClassifyAccessError(terminal)) {
+		t.Fatal("expected terminal video error to skip retries")
+	}
+	if !shouldRetry(utils.ClassifyAccessError(errors.New("HTTP Error 429: Too Many Requests"))) {
+		t.Fatal("expected rate limited error to be retried")
+	}
+}
+
+func TestDeadLetterHeadersKeepOriginalHeaders(t *testing.T) {
+	original := amqp.Table{"x-retry-attempt": int32(2), "x-trace-id": "abc"}
+
+	headers := deadLetterHeaders(original, "youdlp.download.queue", 2, utils.ErrorCategoryRateLimited, errors.New("too many requests"))
+	if headers["x-trace-id"] != "abc" {
+		t.Fatalf("expected original header to be kept, got %v", headers)
+	}
+	if headers[headerErrorCategory] != utils.ErrorCategoryRateLimited {
+		t.Fatalf("unexpected error category header: %v", headers[headerErrorCategory])
+	}
+	if headers[headerOriginalQueue] != "youdlp.download.queue" {
+		t.Fatalf("unexpected original queue header: %v", headers[headerOriginalQueue])
+	}
+	if _, ok := original[headerErrorCategory]; ok {
+		t.Fatal("expected original headers to stay untouched")
+	}
+}
+
+// statusRepository 按 download_history 的条件更新语义维护任务状态
+type statusRepository struct {
+	downloadRepository
+	statuses   map[string]int
+	retries    map[string]int
+	processing int
+}
+
+func (r *statusRepository) FindByTaskID(_ context.Context, taskID string) (*models.DownloadHistory, error) {
+	return &models.DownloadHistory{TaskID: taskID, Status: r.statuses[taskID], RetryCount: r.retries[taskID]}, nil
+}
+
+func (r *statusRepository) active(taskID string) bool {
+	status := r.statuses[taskID]
+	return status == models.StatusPending || status == models.StatusProcessing
+}
+
+func (r *statusRepository) UpdateProcessing(_ context.Context, taskID string) error {
+	if !r.active(taskID) {
+		return sql.ErrNoRows
+	}
+	r.processing++
+	r.statuses[taskID] = models.StatusProcessing
+	return nil
+}
+
+func (r *statusRepository) UpdateFailed(_ context.Context, taskID, _ string, _ int) error {
+	if !r.active(taskID) {
+		return sql.ErrNoRows
+	}
+	r.statuses[taskID] = models.StatusFailed
+	return nil
+}
+
+func (r *statusRepository) IncrementRetry(_ context.Context, taskID string) error {
+	if !r.active(taskID) {
+		return sql.ErrNoRows
+	}
+	r.retries[taskID]++
+	r.statuses[taskID] = models.StatusPending
+	return nil
+}
+
+// releaseRecordingAssetClient 记录终止流程释放的资源
+type releaseRecordingAssetClient struct {
+	AssetClientInterface
+	released      []string
+	capturedBytes int64
+}
+
+func (c *releaseRecordingAssetClient) CaptureIngressUsage(_ string, actualIngressBytes int64) error {
+	c.capturedBytes += actualIngressBytes
+	return nil
+}
+
+func (c *releaseRecordingAssetClient) ReleaseProxyForTask(_, _ string) error {
+	c.released = append(c.released, "proxy")
+	return nil
+}
+
+func (c *releaseRecordingAssetClient) ReleaseInitialDownload(_, _ string) error {
+	c.released = append(c.released, "hold")
+	return nil
+}
+
+func (c *releaseRecordingAssetClient) UpdateHistoryFailed(_, _ string) error {
+	c.released = append(c.released, "history")
+	return nil
+}
+
+func TestCancelDuringRetryBackoffStopsRedelivery(t *testing.T) {
+	repo := &statusRepository{
+		statuses: map[string]int{"task-1": models.StatusProcessing},
+		retries:  map[string]int{},
+	}
+	assetClient := &releaseRecordingAssetClient{}
+	p := newDrainTestPool(1)
+	p.repo = repo
+	p.assetClient = assetClient
+	// 进度发布失败只记录日志
+	p.progressPublisher = NewProgressPublisher(redis.NewClient(&redis.Options{Addr: "127.0.0.1:0", MaxRetries: -1}))
+	task := &models.DownloadTask{TaskID: "task-1"}
+	ctx := context.Background()
+
+	// 可重试的失败不走终止流程：任务保持待处理，计费预占与代理绑定保留到重试
+	taskErr := errors.New("connection reset by peer")
+	if err := p.handleError(ctx, task, taskErr); !errors.Is(err, taskErr) {
+		t.Fatalf("handleError = %v, want original error", err)
+	}
+	if err := p.scheduleRetry(ctx, task, taskErr, time.Minute); err != nil {
+		t.Fatalf("scheduleRetry: %v", err)
+	}
+	if repo.statuses["task-1"] != models.StatusPending || repo.retries["task-1"] != 1 {
+		t.Fatalf("expected pending task with one retry, got status=%d retries=%d", repo.statuses["task-1"], repo.retries["task-1"])
+	}
+	if len(assetClient.released) != 0 {
+		t.Fatalf("expected no terminal side effects before retry, got %v", assetClient.released)
+	}
+
+	// 等待重试期间被强制失败（与 DownloaderServer.terminateTask 相同的条件更新）
+	if err := repo.UpdateFailed(ctx, "task-1", "任务已被管理员强制失败", 1); err != nil {
+		t.Fatalf("force fail during backoff: %v", err)
+	}
+
+	// 重试消息到期后不再执行，也不会覆盖终态
+	if err := p.processTask(task); !errors.Is(err, ErrTaskCancelled) {
+		t.Fatalf("processTask after force fail = %v, want ErrTaskCancelled", err)
+	}
+	if repo.processing != 0 || repo.statuses["task-1"] != models.StatusFailed {
+		t.Fatalf("terminated task came back to life: status=%d processing=%d", repo.statuses["task-1"], repo.processing)
+	}
+	if err := p.failTask(ctx, task, taskErr, 1); !errors.Is(err, ErrTaskCancelled) {
+		t.Fatalf("failTask after force fail = %v, want ErrTaskCancelled", err)
+	}
+	if err := p.scheduleRetry(ctx, task, taskErr, time.Minute); !errors.Is(err, ErrTaskCancelled) {
+		t.Fatalf("scheduleRetry after force fail = %v, want ErrTaskCancelled", err)
+	}
+	if len(assetClient.released) != 0 {
+		t.Fatalf("expected terminate flow to own the release, got %v", assetClient.released)
+	}
+}
@@ -0,0 +1,164 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"youdlp/media-service/internal/download/config"
+	"youdlp/media-service/internal/download/models"
+)
+
+// acquireUserSlotScript 用户占用数未达上限时加一并续期，返回 1 表示占用成功
+var acquireUserSlotScript = redis.NewScript(`
+local current = tonumber(redis.call("GET", KEYS[1]) or "0")
+if current >= tonumber(ARGV[1]) then
+	return 0
+end
+redis.call("INCR", KEYS[1])
+redis.call("PEXPIRE", KEYS[1], ARGV[2])
+return 1
+`)
+
+// releaseUserSlotScript 释放一个名额，计数归零（或计数已过期后出现负数）时删除键
+var releaseUserSlotScript = redis.NewScript(`
+local current = redis.call("DECR", KEYS[1])
+if current <= 0 then
+	redis.call("DEL", KEYS[1])
+else
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return current
+`)
+
+// userSlots 用户名额计数
+type userSlots interface {
+	acquire(ctx context.Context, userID string, limit int) (bool, error)
+	release(ctx context.Context, userID string) error
+}
+
+// FairScheduler 按用户限制所有实例合计同时占用的任务数（含缓冲区中等待的任务）。
+// 超出上限的任务不进入 Worker 池，由消费者延后重新投递，让出预取额度给其他用户的任务。
+type FairScheduler struct {
+	slots      userSlots
+	maxPerUser int
+	classMax   map[string]int
+	deferDelay time.Duration
+}
+
+// NewFairScheduler 创建公平调度器，未启用时返回 nil。
+// 名额计数保存在 Redis 中由所有实例共享；redisClient 为空时退化为本实例计数
+func NewFairScheduler(cfg *config.SchedulerConfig, redisClient *redis.Client) *FairScheduler {
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+
+	log.Printf("[Scheduler] Fair scheduling enabled, max_per_user: %d, class overrides: %v, defer_delay: %ds, slot_ttl: %ds",
+		cfg.MaxPerUser, cfg.ClassMaxPerUser, cfg.DeferDelay, cfg.SlotTTL)
+	var slots userSlots = &localUserSlots{active: make(map[string]int)}
+	if redisClient != nil {
+		slots = &redisUserSlots{redis: redisClient, ttl: time.Duration(cfg.SlotTTL) * time.Second}
+	}
+	return &FairScheduler{
+		slots:      slots,
+		maxPerUser: cfg.MaxPerUser,
+		classMax:   cfg.ClassMaxPerUser,
+		deferDelay: time.Duration(cfg.DeferDelay) * time.Second,
+	}
+}
+
+// Admit 为任务占用用户名额，用户已达上限时返回 ok=false；任务结束后调用 release 归还名额。
+// Redis 不可用时放行且不计数，避免调度故障阻塞下载
+func (s *FairScheduler) Admit(task *models.DownloadTask) (release func(), ok bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	admitted, err := s.slots.acquire(ctx, task.UserID, s.limit(task.PriorityClass))
+	if err != nil {
+		log.Printf("[Scheduler] ⚠ Failed to acquire slot for user %s, admitting task %s without limit: %v", task.UserID, task.TaskID, err)
+		return func() {}, true
+	}
+	if !admitted {
+		return nil, false
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			releaseCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+			if err := s.slots.release(releaseCtx, task.UserID); err != nil {
+				log.Printf("[Scheduler] ⚠ Failed to release slot for user %s: %v", task.UserID, err)
+			}
+		})
+	}, true
+}
+
+// DeferDelay 超出上限的任务延后重新投递的时间
+func (s *FairScheduler) DeferDelay() time.Duration {
+	return s.deferDelay
+}
+
+// limit 优先级类别对应的单用户并发上限
+func (s *FairScheduler) limit(class string) int {
+	if max, ok := s.classMax[class]; ok && max > 0 {
+		return max
+	}
+	return s.maxPerUser
+}
+
+// redisUserSlots 跨实例共享的用户名额计数。计数键随每次占用、释放续期，
+// 实例崩溃未归还的名额在 ttl 后随键过期一并清除
+type redisUserSlots struct {
+	redis *redis.Client
+	ttl   time.Duration
+}
+
+func (r *redisUserSlots) acquire(ctx context.Context, userID string, limit int) (bool, error) {
+	res, err := acquireUserSlotScript.Run(ctx, r.redis, []string{userSlotKey(userID)}, limit, r.ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return res == 1, nil
+}
+
+func (r *redisUserSlots) release(ctx context.Context, userID string) error {
+	return releaseUserSlotScript.Run(ctx, r.redis, []string{userSlotKey(userID)}, r.ttl.Milliseconds()).Err()
+}
+
+// localUserSlots 本实例内的用户名额计数
+type localUserSlots struct {
+	mu     sync.Mutex
+	active map[string]int
+}
+
+func (l *localUserSlots) acquire(_ context.Context, userID string, limit int) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.active[userID] >= limit {
+		return false, nil
+	}
+	l.active[userID]++
+	return true, nil
+}
+
+func (l *localUserSlots) release(_ context.Context, userID string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.active[userID] <= 1 {
+		delete(l.active, userID)
+		return nil
+	}
+	l.active[userID]--
+	return nil
+}
+
+func userSlotKey(userID string) string {
+	return fmt.Sprintf("scheduler:user:%s:active", userID)
+}
@@ -0,0 +1,95 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"youdlp/media-service/internal/download/config"
+	"youdlp/media-service/internal/download/models"
+)
+
+func TestFairSchedulerCapsUserByClass(t *testing.T) {
+	s := NewFairScheduler(&config.SchedulerConfig{
+		Enabled:         true,
+		MaxPerUser:      1,
+		ClassMaxPerUser: map[string]int{"vip": 2},
+		DeferDelay:      10,
+	}, nil)
+	admitted := func(task *models.DownloadTask) bool {
+		_, ok := s.Admit(task)
+		return ok
+	}
+
+	release, ok := s.Admit(&models.DownloadTask{TaskID: "task-1", UserID: "user-1"})
+	if !ok {
+		t.Fatal("expected first task to be admitted")
+	}
+	if admitted(&models.DownloadTask{TaskID: "task-2", UserID: "user-1"}) {
+		t.Fatal("expected second task of standard user to be deferred")
+	}
+	if !admitted(&models.DownloadTask{TaskID: "task-3", UserID: "user-2"}) {
+		t.Fatal("expected other user's task to be admitted")
+	}
+
+	vip := &models.DownloadTask{UserID: "user-3", PriorityClass: "vip"}
+	if !admitted(vip) || !admitted(vip) || admitted(vip) {
+		t.Fatal("expected vip user to get two slots")
+	}
+
+	// 重复调用 release 只归还一次名额
+	release()
+	release()
+	if !admitted(&models.DownloadTask{TaskID: "task-2", UserID: "user-1"}) {
+		t.Fatal("expected task to be admitted after release")
+	}
+	if admitted(&models.DownloadTask{TaskID: "task-4", UserID: "user-1"}) {
+		t.Fatal("expected release to return exactly one slot")
+	}
+}
+
+type failingUserSlots struct{ released int }
+
+func (f *failingUserSlots) acquire(context.Context, string, int) (bool, error) {
+	return false, errors.New("redis unavailable")
+}
+
+func (f *failingUserSlots) release(context.Context, string) error {
+	f.released++
+	return nil
+}
+
+func TestFairSchedulerFailsOpenWithoutCounting(t *testing.T) {
+	slots := &failingUserSlots{}
+	s := &FairScheduler{slots: slots, maxPerUser: 1}
+
+	release, ok := s.Admit(&models.DownloadTask{TaskID: "task-1", UserID: "user-1"})
+	if !ok {
+		t.Fatal("expected task to be admitted when slot counter is unavailable")
+	}
+	release()
+	if slots.released != 0 {
+		t.Fatal("expected uncounted admission not to release a shared slot")
+	}
+}
+
+func TestSubmitDefersTaskOverUserLimit(t *testing.T) {
+	p := newDrainTestPool(4)
+	p.scheduler = NewFairScheduler(&config.SchedulerConfig{Enabled: true, MaxPerUser: 1, DeferDelay: 10}, nil)
+
+	var first, second error
+	p.Submit(&models.DownloadTask{TaskID: "task-1", UserID: "user-1"}, func(err error) { first = err })
+	p.Submit(&models.DownloadTask{TaskID: "task-2", UserID: "user-1"}, func(err error) { second = err })
+	if !errors.Is(second, ErrTaskDeferred) {
+		t.Fatalf("expected second task to be deferred, got %v", second)
+	}
+
+	// 排空时交回队列的任务同样释放用户名额
+	p.Drain(0)
+	if !errors.Is(first, ErrTaskRequeued) {
+		t.Fatalf("expected buffered task to be requeued, got %v", first)
+	}
+	if _, ok := p.scheduler.Admit(&models.DownloadTask{TaskID: "task-3", UserID: "user-1"}); !ok {
+		t.Fatal("expected user slot to be released")
+	}
+}
@@ -3,25 +3,64 @@ package worker
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 
 	"youdlp/media-service/internal/download/config"
 	"youdlp/media-service/internal/download/models"
+	"youdlp/media-service/internal/utils"
 )
 
-// TaskConsumer MQ 任务消费者
+// consumerTag 消费者标签前缀，排空时据此停止投递
+const consumerTag = "youdlp-media-worker"
+
+// TaskConsumer MQ 任务消费者。默认队列接收未拆分平台的任务，
+// 启用平台分区后每个平台队列使用独立通道消费，通道预取数即该平台的并发预算
 type TaskConsumer struct {
-	conn    *amqp.Connection
-	channel *amqp.Channel
-	queue   string
-	pool    *Pool
+	conn            *amqp.Connection
+	channel         *amqp.Channel // 默认队列的消费通道，同时用于发布重试、死信与延后投递的消息
+	queue           string
+	deadLetterQueue string
+	partitions      []*partition
+	pauseDelay      time.Duration
+	pool            *Pool
+	// baseConcurrency 配置的并发上限，各通道按运行时并发上限与它的比例缩放预取数
+	baseConcurrency int
 }
 
+// partition 一个下载队列及其消费通道
+type partition struct {
+	platform string // 为空表示默认队列
+	queue    string
+	tag      string
+	channel  *amqp.Channel
+	// basePrefetch 配置的预取数，qos 在运行时调整通道预取数
+	basePrefetch int
+	qos          func(prefetch int) error
+
+	// 平台冷却或管理端暂停期间取消消费，消息留在队列中等待
+	mu       sync.Mutex
+	cooldown bool
+	manual   bool
+	stopped  bool
+	resume   chan struct{}
+}
+
+// 队列暂停原因
+const (
+	pauseCooldown = iota // 平台冷却
+	pauseManual          // 管理端暂停
+)
+
 // NewTaskConsumer 创建任务消费者
-func NewTaskConsumer(cfg *config.RabbitMQConfig, pool *Pool) (*TaskConsumer, error) {
+func NewTaskConsumer(cfg *config.RabbitMQConfig, partitionCfg *config.PartitionConfig, pool *Pool) (*TaskConsumer, error) {
 	log.Printf("[TaskConsumer] Connecting to RabbitMQ: %s", cfg.URL)
 	// 连接 RabbitMQ
 	conn, err := amqp.Dial(cfg.URL)
@@ -41,100 +80,511 @@ func NewTaskConsumer(cfg *config.RabbitMQConfig, pool *Pool) (*TaskConsumer, err
 	}
 	log.Println("[TaskConsumer] ✓ Channel created")
 
-	// 声明队列
-	log.Printf("[TaskConsumer] Declaring queue: %s", cfg.Queue)
-	_, err = ch.QueueDeclare(
-		cfg.Queue, // 队列名
-		true,      // durable
-		false,     // delete when unused
-		false,     // exclusive
-		false,     // no-wait
-		nil,       // arguments
-	)
-	if err != nil {
-		log.Printf("[TaskConsumer] Failed to declare queue: %v", err)
-		ch.Close()
-		conn.Close()
+	c := &TaskConsumer{
+		conn:            conn,
+		channel:         ch,
+		queue:           cfg.Queue,
+		deadLetterQueue: cfg.DeadLetterQueue,
+		pauseDelay:      time.Duration(partitionCfg.DeferDelay) * time.Second,
+		pool:            pool,
+	}
+	if pool != nil {
+		_, c.baseConcurrency = pool.limiter.Usage()
+	}
+
+	if err := c.declareTopology(cfg, partitionCfg); err != nil {
+		c.Stop()
 		return nil, err
 	}
-	log.Printf("[TaskConsumer] ✓ Queue declared: %s", cfg.Queue)
 
-	// 设置预取数
-	log.Printf("[TaskConsumer] Setting QoS prefetch count: %d", cfg.PrefetchCount)
-	if err := ch.Qos(cfg.PrefetchCount, 0, false); err != nil {
+	if pool != nil && pool.pauses != nil {
+		pool.pauses.OnChange(c.setPlatformPaused)
+	}
+	return c, nil
+}
+
+// declareTopology 声明死信队列、默认队列与各平台队列，以及每个队列的重试与延后投递队列
+func (c *TaskConsumer) declareTopology(cfg *config.RabbitMQConfig, partitionCfg *config.PartitionConfig) error {
+	if _, err := c.channel.QueueDeclare(cfg.DeadLetterQueue, true, false, false, false, nil); err != nil {
+		log.Printf("[TaskConsumer] Failed to declare dead-letter queue: %v", err)
+		return err
+	}
+	log.Printf("[TaskConsumer] ✓ Dead-letter queue declared: %s", cfg.DeadLetterQueue)
+
+	if err := c.declarePartition(c.channel, cfg, "", cfg.Queue, cfg.PrefetchCount); err != nil {
+		return err
+	}
+
+	if cfg.PlatformExchange == "" {
+		if partitionCfg.Enabled {
+			log.Println("[TaskConsumer] ⚠ rabbitmq.platform_exchange is not configured, platform partitions disabled")
+		}
+		return nil
+	}
+	if err := declarePlatformExchange(c.channel, cfg.PlatformExchange, cfg.Queue); err != nil {
+		log.Printf("[TaskConsumer] Failed to declare platform exchange: %v", err)
+		return err
+	}
+	log.Printf("[TaskConsumer] ✓ Platform exchange declared: %s", cfg.PlatformExchange)
+
+	if !partitionCfg.Enabled {
+		return nil
+	}
+	platforms := make([]string, 0, len(partitionCfg.Platforms))
+	for platform := range partitionCfg.Platforms {
+		platforms = append(platforms, platform)
+	}
+	sort.Strings(platforms)
+	for _, platform := range platforms {
+		budget := partitionCfg.Platforms[platform]
+		if budget <= 0 {
+			continue
+		}
+		platform = strings.ToLower(platform)
+
+		ch, err := c.conn.Channel()
+		if err != nil {
+			log.Printf("[TaskConsumer] Failed to create channel for platform %s: %v", platform, err)
+			return err
+		}
+		queue := platformQueueName(cfg.Queue, platform)
+		if err := c.declarePartition(ch, cfg, platform, queue, budget); err != nil {
+			ch.Close()
+			return err
+		}
+		if err := ch.QueueBind(queue, platformRoutingKey(platform), cfg.PlatformExchange, false, nil); err != nil {
+			log.Printf("[TaskConsumer] Failed to bind queue %s: %v", queue, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// declarePartition 声明下载队列及其延迟队列，并按并发预算设置通道预取数
+func (c *TaskConsumer) declarePartition(ch *amqp.Channel, cfg *config.RabbitMQConfig, platform, queue string, prefetch int) error {
+	log.Printf("[TaskConsumer] Declaring queue: %s", queue)
+	if _, err := ch.QueueDeclare(queue, true, false, false, false, queueArgs(cfg)); err != nil {
+		log.Printf("[TaskConsumer] Failed to declare queue: %v", err)
+		return err
+	}
+	log.Printf("[TaskConsumer] ✓ Queue declared: %s", queue)
+
+	if err := declareRetryTopology(ch, queue, c.pool, c.pauseDelay); err != nil {
+		log.Printf("[TaskConsumer] Failed to declare retry queues: %v", err)
+		return err
+	}
+
+	log.Printf("[TaskConsumer] Setting QoS prefetch count for %s: %d", queue, prefetch)
+	if err := ch.Qos(prefetch, 0, false); err != nil {
 		log.Printf("[TaskConsumer] Failed to set QoS: %v", err)
-		ch.Close()
-		conn.Close()
-		return nil, err
+		return err
 	}
-	log.Printf("[TaskConsumer] ✓ QoS configured with prefetch: %d", cfg.PrefetchCount)
 
-	return &TaskConsumer{
-		conn:    conn,
-		channel: ch,
-		queue:   cfg.Queue,
-		pool:    pool,
-	}, nil
+	p := &partition{
+		platform:     platform,
+		queue:        queue,
+		tag:          consumerTag,
+		channel:      ch,
+		basePrefetch: prefetch,
+		qos:          func(prefetch int) error { return ch.Qos(prefetch, 0, false) },
+		resume:       make(chan struct{}),
+	}
+	if platform != "" {
+		p.tag = consumerTag + "." + platform
+		p.cooldown = c.pool != nil && c.pool.pauses.Paused(platform)
+	}
+	c.partitions = append(c.partitions, p)
+	return nil
 }
 
-// Start 启动消费
-func (c *TaskConsumer) Start(ctx context.Context) error {
-	msgs, err := c.channel.Consume(
-		c.queue, // 队列名
-		"",      // 消费者名
-		false,   // 手动 ACK
-		false,   // 非独占
-		false,   // no-local
-		false,   // no-wait
-		nil,     // args
-	)
-	if err != nil {
+// declareRetryTopology 声明队列在重试预算内每个退避延迟对应的 TTL 延迟队列，以及延后投递队列
+func declareRetryTopology(ch *amqp.Channel, queue string, pool *Pool, pauseDelay time.Duration) error {
+	var retryCfg *config.RetryConfig
+	maxAttempts := 1
+	if pool != nil && pool.retryCfg != nil {
+		retryCfg = pool.retryCfg
+		maxAttempts = retryCfg.MaxAttempts
+	}
+	for _, delay := range retryDelays(retryCfg, maxAttempts) {
+		name := retryQueueName(queue, delay)
+		if _, err := ch.QueueDeclare(name, true, false, false, false, retryQueueArgs(queue, delay)); err != nil {
+			return err
+		}
+		log.Printf("[TaskConsumer] ✓ Retry queue declared: %s (delay %v)", name, delay)
+	}
+
+	deferDelays := []time.Duration{pauseDelay}
+	if pool != nil && pool.scheduler != nil && pool.scheduler.DeferDelay() != pauseDelay {
+		deferDelays = append(deferDelays, pool.scheduler.DeferDelay())
+	}
+	for _, delay := range deferDelays {
+		if delay <= 0 {
+			continue
+		}
+		name := deferQueueName(queue, delay)
+		if _, err := ch.QueueDeclare(name, true, false, false, false, retryQueueArgs(queue, delay)); err != nil {
+			return err
+		}
+		log.Printf("[TaskConsumer] ✓ Defer queue declared: %s (delay %v)", name, delay)
+	}
+	return nil
+}
+
+// declarePlatformExchange 声明按平台路由的 topic 交换机。没有平台队列绑定的消息
+// 经备用交换机进入默认队列，未拆分的平台以及尚未声明平台队列时的任务都不会丢失
+func declarePlatformExchange(ch *amqp.Channel, exchange, defaultQueue string) error {
+	unrouted := unroutedExchangeName(exchange)
+	if err := ch.ExchangeDeclare(unrouted, "fanout", true, false, false, false, nil); err != nil {
+		return err
+	}
+	if err := ch.QueueBind(defaultQueue, "", unrouted, false, nil); err != nil {
 		return err
 	}
+	return ch.ExchangeDeclare(exchange, "topic", true, false, false, false, amqp.Table{"alternate-exchange": unrouted})
+}
 
-	log.Printf("[TaskConsumer] Started consuming from queue: %s", c.queue)
+// queueArgs 下载队列参数，配置了最大优先级时声明为优先级队列
+func queueArgs(cfg *config.RabbitMQConfig) amqp.Table {
+	if cfg.MaxPriority <= 0 {
+		return nil
+	}
+	return amqp.Table{"x-max-priority": int32(cfg.MaxPriority)}
+}
 
+// deferQueueName 延后投递的任务使用的延迟队列名
+func deferQueueName(queue string, delay time.Duration) string {
+	return fmt.Sprintf("%s.defer.%ds", queue, int64(delay/time.Second))
+}
+
+// platformQueueName 平台队列名
+func platformQueueName(queue, platform string) string {
+	return queue + "." + platform
+}
+
+// platformRoutingKey 平台交换机的路由键，需与 api-gateway 一致
+func platformRoutingKey(platform string) string {
+	return "download." + platform
+}
+
+// unroutedExchangeName 平台交换机的备用交换机名
+func unroutedExchangeName(exchange string) string {
+	return exchange + ".unrouted"
+}
+
+// Start 启动所有队列的消费，阻塞直到 ctx 结束或所有通道关闭
+func (c *TaskConsumer) Start(ctx context.Context) error {
+	var wg sync.WaitGroup
+	for _, p := range c.partitions {
+		wg.Add(1)
+		go func(p *partition) {
+			defer wg.Done()
+			c.consume(ctx, p)
+		}(p)
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		log.Println("[TaskConsumer] Context cancelled, stopping...")
+		return ctx.Err()
+	}
+	return nil
+}
+
+// consume 消费单个队列，平台暂停时取消消费并等待恢复
+func (c *TaskConsumer) consume(ctx context.Context, p *partition) {
 	for {
-		select {
-		case msg, ok := <-msgs:
-			if !ok {
-				log.Println("[TaskConsumer] Channel closed")
-				return nil
+		msgs, err := p.startConsuming(ctx)
+		if err != nil {
+			log.Printf("[TaskConsumer] Failed to consume queue %s: %v", p.queue, err)
+			return
+		}
+		if msgs == nil {
+			return
+		}
+		log.Printf("[TaskConsumer] Started consuming from queue: %s", p.queue)
+
+		for open := true; open; {
+			select {
+			case msg, ok := <-msgs:
+				if !ok {
+					open = false
+					continue
+				}
+				c.handleDelivery(msg, p)
+			case <-ctx.Done():
+				return
 			}
+		}
 
-			log.Printf("[TaskConsumer] Received message, size: %d bytes", len(msg.Body))
-			task, err := parseTask(msg.Body)
-			if err != nil {
-				log.Printf("[TaskConsumer] ❌ Failed to parse task: %v, body: %s", err, string(msg.Body))
-				msg.Nack(false, false) // 不重新入队
-				continue
+		if !p.isPaused() {
+			log.Printf("[TaskConsumer] Channel closed for queue: %s", p.queue)
+			return
+		}
+		log.Printf("[TaskConsumer] Paused consuming from queue: %s", p.queue)
+	}
+}
+
+// handleDelivery 解析消息并提交到 Worker 池，按任务结果确认、重试、延后或交回消息
+func (c *TaskConsumer) handleDelivery(msg amqp.Delivery, p *partition) {
+	log.Printf("[TaskConsumer] Received message from %s, size: %d bytes", p.queue, len(msg.Body))
+	task, err := parseTask(msg.Body)
+	if err != nil {
+		log.Printf("[TaskConsumer] ❌ Failed to parse task: %v, body: %s", err, string(msg.Body))
+		msg.Nack(false, false) // 不重新入队
+		return
+	}
+
+	log.Printf("[TaskConsumer] ✓ Parsed task: %s, URL: %s, Mode: %s, Quality: %s",
+		task.TaskID, task.URL, task.Mode, task.Quality)
+
+	// 提交到 Worker 池
+	log.Printf("[TaskConsumer] Submitting task %s to worker pool...", task.TaskID)
+	c.pool.Submit(task, func(err error) {
+		if errors.Is(err, ErrTaskCancelled) {
+			log.Printf("[TaskConsumer] Task %s cancelled, acking without retry", task.TaskID)
+			msg.Ack(false)
+		} else if errors.Is(err, ErrTaskRequeued) {
+			log.Printf("[TaskConsumer] Task %s interrupted by shutdown, returning to queue", task.TaskID)
+			if nackErr := msg.Nack(false, true); nackErr != nil {
+				log.Printf("[TaskConsumer] Failed to requeue task %s: %v", task.TaskID, nackErr)
 			}
+		} else if errors.Is(err, ErrTaskDeferred) {
+			c.deferTask(msg, task, p.queue, c.pool.scheduler.DeferDelay())
+		} else if errors.Is(err, ErrPlatformPaused) {
+			c.deferTask(msg, task, p.queue, c.pauseDelay)
+		} else if errors.Is(err, ErrTaskAlreadyRunning) {
+			log.Printf("[TaskConsumer] Task %s is a duplicate delivery, acking without retry", task.TaskID)
+			msg.Ack(false)
+		} else if errors.Is(err, ErrTaskOwnershipLost) {
+			log.Printf("[TaskConsumer] Task %s was taken over by another worker, acking without retry", task.TaskID)
+			msg.Ack(false)
+		} else if err != nil {
+			log.Printf("[TaskConsumer] ❌ Task %s failed: %v", task.TaskID, err)
+			c.retryOrFinalize(msg, task, p.queue, err)
+		} else {
+			log.Printf("[TaskConsumer] ✓ Task %s completed successfully", task.TaskID)
+			log.Printf("[TaskConsumer] Acking message for task %s", task.TaskID)
+			msg.Ack(false)
+		}
+	})
+}
 
-			log.Printf("[TaskConsumer] ✓ Parsed task: %s, URL: %s, Mode: %s, Quality: %s",
-				task.TaskID, task.URL, task.Mode, task.Quality)
-
-			// 提交到 Worker 池
-			log.Printf("[TaskConsumer] Submitting task %s to worker pool...", task.TaskID)
-			c.pool.Submit(task, func(err error) {
-				if err != nil {
-					log.Printf("[TaskConsumer] ❌ Task %s failed: %v", task.TaskID, err)
-					c.retryOrFinalize(msg, task)
-				} else {
-					log.Printf("[TaskConsumer] ✓ Task %s completed successfully", task.TaskID)
-					log.Printf("[TaskConsumer] Acking message for task %s", task.TaskID)
-					msg.Ack(false)
-				}
-			})
+// SetConcurrency 运行时并发上限变化后按比例重设各通道的预取数，
+// 否则调高并发时通道仍按启动时的预取数投递，新增的并发名额拿不到任务
+func (c *TaskConsumer) SetConcurrency(max int) {
+	if c.baseConcurrency <= 0 {
+		return
+	}
+	for _, p := range c.partitions {
+		prefetch := scaledPrefetch(p.basePrefetch, c.baseConcurrency, max)
+		if err := p.qos(prefetch); err != nil {
+			log.Printf("[TaskConsumer] ⚠ Failed to set QoS prefetch count for %s: %v", p.queue, err)
+			continue
+		}
+		log.Printf("[TaskConsumer] ✓ QoS prefetch count for %s set to %d", p.queue, prefetch)
+	}
+}
+
+// scaledPrefetch 按并发上限相对配置值的比例缩放预取数，向上取整且至少为 1
+func scaledPrefetch(base, baseConcurrency, max int) int {
+	prefetch := (base*max + baseConcurrency - 1) / baseConcurrency
+	if prefetch < 1 {
+		return 1
+	}
+	return prefetch
+}
+
+// setPlatformPaused 平台进入冷却时取消该平台队列的消费，冷却结束后恢复
+func (c *TaskConsumer) setPlatformPaused(platform string, paused bool) {
+	for _, p := range c.partitions {
+		if p.platform != platform {
+			continue
+		}
+		if paused {
+			p.pause(pauseCooldown)
+		} else {
+			p.unpause(pauseCooldown)
+		}
+	}
+}
+
+// PauseConsuming 管理端暂停所有队列的消费，已投递的任务继续执行
+func (c *TaskConsumer) PauseConsuming() {
+	for _, p := range c.partitions {
+		p.pause(pauseManual)
+	}
+	log.Println("[TaskConsumer] Paused consuming by admin")
+}
+
+// ResumeConsuming 恢复管理端暂停的消费，冷却中的平台队列仍保持暂停
+func (c *TaskConsumer) ResumeConsuming() {
+	for _, p := range c.partitions {
+		p.unpause(pauseManual)
+	}
+	log.Println("[TaskConsumer] Resumed consuming by admin")
+}
+
+// ConsumingPaused 管理端是否暂停了消费
+func (c *TaskConsumer) ConsumingPaused() bool {
+	for _, p := range c.partitions {
+		p.mu.Lock()
+		manual := p.manual
+		p.mu.Unlock()
+		if manual {
+			return true
+		}
+	}
+	return false
+}
+
+// QueueDepths 查询各下载队列的积压消息数与消费者数
+func (c *TaskConsumer) QueueDepths() []QueueDepth {
+	depths := make([]QueueDepth, 0, len(c.partitions))
+	var ch *amqp.Channel
+	defer func() {
+		if ch != nil {
+			ch.Close()
+		}
+	}()
+
+	for _, p := range c.partitions {
+		// 被动声明失败会关闭通道，使用独立通道查询，失败后重新打开
+		if ch == nil || ch.IsClosed() {
+			var err error
+			if ch, err = c.conn.Channel(); err != nil {
+				log.Printf("[TaskConsumer] Failed to open channel for queue inspection: %v", err)
+				return depths
+			}
+		}
+		depth := QueueDepth{Queue: p.queue, Platform: p.platform, Paused: p.isPaused()}
+		queue, err := ch.QueueDeclarePassive(p.queue, true, false, false, false, nil)
+		if err != nil {
+			log.Printf("[TaskConsumer] Failed to inspect queue %s: %v", p.queue, err)
+		} else {
+			depth.Messages = queue.Messages
+			depth.Consumers = queue.Consumers
+		}
+		depths = append(depths, depth)
+	}
+	return depths
+}
+
+// startConsuming 未暂停时开始消费，暂停期间阻塞等待恢复；ctx 结束或已停止消费时返回 nil
+func (p *partition) startConsuming(ctx context.Context) (<-chan amqp.Delivery, error) {
+	for {
+		p.mu.Lock()
+		if p.stopped {
+			p.mu.Unlock()
+			return nil, nil
+		}
+		if !p.pausedLocked() {
+			msgs, err := p.channel.Consume(
+				p.queue, // 队列名
+				p.tag,   // 消费者名
+				false,   // 手动 ACK
+				false,   // 非独占
+				false,   // no-local
+				false,   // no-wait
+				nil,     // args
+			)
+			p.mu.Unlock()
+			return msgs, err
+		}
+		resume := p.resume
+		p.mu.Unlock()
 
+		select {
+		case <-resume:
 		case <-ctx.Done():
-			log.Println("[TaskConsumer] Context cancelled, stopping...")
-			return ctx.Err()
+			return nil, nil
+		}
+	}
+}
+
+func (p *partition) pause(reason int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	wasPaused := p.pausedLocked()
+	p.setReasonLocked(reason, true)
+	if wasPaused || p.stopped {
+		return
+	}
+	p.resume = make(chan struct{})
+	if err := p.channel.Cancel(p.tag, false); err != nil {
+		log.Printf("[TaskConsumer] Failed to pause queue %s: %v", p.queue, err)
+	}
+}
+
+func (p *partition) unpause(reason int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	wasPaused := p.pausedLocked()
+	p.setReasonLocked(reason, false)
+	if wasPaused && !p.pausedLocked() && !p.stopped {
+		close(p.resume)
+	}
+}
+
+func (p *partition) setReasonLocked(reason int, paused bool) {
+	switch reason {
+	case pauseCooldown:
+		p.cooldown = paused
+	case pauseManual:
+		p.manual = paused
+	}
+}
+
+func (p *partition) pausedLocked() bool {
+	return p.cooldown || p.manual
+}
+
+func (p *partition) isPaused() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.pausedLocked()
+}
+
+// stop 停止消费，暂停中的队列不再恢复
+func (p *partition) stop() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.stopped {
+		return nil
+	}
+	p.stopped = true
+	if p.pausedLocked() {
+		close(p.resume)
+		return nil
+	}
+	return p.channel.Cancel(p.tag, false)
+}
+
+// StopConsuming 停止接收新消息，保留通道以便确认或交回已投递的消息
+func (c *TaskConsumer) StopConsuming() error {
+	var errs []error
+	for _, p := range c.partitions {
+		if err := p.stop(); err != nil {
+			errs = append(errs, fmt.Errorf("queue %s: %w", p.queue, err))
 		}
 	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+	log.Println("[TaskConsumer] Stopped consuming new messages")
+	return nil
 }
 
 // Stop 停止消费
 func (c *TaskConsumer) Stop() error {
+	for _, p := range c.partitions {
+		if p.channel != c.channel {
+			p.channel.Close()
+		}
+	}
 	if c.channel != nil {
 		c.channel.Close()
 	}
@@ -160,25 +610,51 @@ func parseTask(body []byte) (*models.DownloadTask, error) {
 	return &task, nil
 }
 
-func (c *TaskConsumer) retryOrFinalize(msg amqp.Delivery, task *models.DownloadTask) {
+// retryOrFinalize 按退避延迟将失败任务投递到来源队列的延迟队列，重试预算耗尽或不可重试时转入死信队列
+func (c *TaskConsumer) retryOrFinalize(msg amqp.Delivery, task *models.DownloadTask, queue string, taskErr error) {
 	currentAttempt := retryAttemptFromHeaders(msg.Headers)
 	nextAttempt := currentAttempt + 1
 	maxAttempts := c.maxAttempts()
+	category := utils.ClassifyAccessError(taskErr)
 
+	if !shouldRetry(category) {
+		log.Printf("[TaskConsumer] Task %s failed with terminal category %s, skipping retries", task.TaskID, category)
+		c.finalize(msg, task, queue, currentAttempt, category, taskErr)
+		return
+	}
 	if nextAttempt >= maxAttempts {
-		log.Printf("[TaskConsumer] Retry budget exhausted for task %s (%d/%d), acking terminal failure", task.TaskID, nextAttempt, maxAttempts)
-		if err := msg.Ack(false); err != nil {
-			log.Printf("[TaskConsumer] Failed to ack exhausted task %s: %v", task.TaskID, err)
-		}
+		log.Printf("[TaskConsumer] Retry budget exhausted for task %s (%d/%d), dead-lettering", task.TaskID, nextAttempt, maxAttempts)
+		c.finalize(msg, task, queue, currentAttempt, category, taskErr)
 		return
 	}
 
 	headers := cloneHeaders(msg.Headers)
-	headers["x-retry-attempt"] = int32(nextAttempt)
+	headers[headerRetryAttempt] = int32(nextAttempt)
+	if category != "" {
+		headers[headerErrorCategory] = category
+	}
+
+	// 按退避延迟投递到对应的 TTL 延迟队列，到期后回到主队列
+	routingKey := queue
+	delay := retryDelay(c.retryConfig(), nextAttempt)
+	if delay > 0 {
+		routingKey = retryQueueName(queue, delay)
+	}
 
 	publishCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	// 等待重试期间任务保持待处理，可被取消或强制失败；已被终止的任务不再重试
+	if c.pool != nil && c.pool.repo != nil {
+		if err := c.pool.scheduleRetry(publishCtx, task, taskErr, delay); errors.Is(err, ErrTaskCancelled) {
+			c.pool.DiscardWorkDir(task.TaskID)
+			if ackErr := msg.Ack(false); ackErr != nil {
+				log.Printf("[TaskConsumer] Failed to ack terminated task %s: %v", task.TaskID, ackErr)
+			}
+			return
+		}
+	}
+
 	body := msg.Body
 	if c.pool != nil {
 		if err := c.pool.RefreshTaskProxy(publishCtx, task); err != nil {
@@ -196,7 +672,7 @@ func (c *TaskConsumer) retryOrFinalize(msg amqp.Delivery, task *models.DownloadT
 	if err := c.channel.PublishWithContext(
 		publishCtx,
 		"",
-		c.queue,
+		routingKey,
 		false,
 		false,
 		amqp.Publishing{
@@ -216,25 +692,168 @@ func (c *TaskConsumer) retryOrFinalize(msg amqp.Delivery, task *models.DownloadT
 		return
 	}
 
+	log.Printf("[TaskConsumer] Scheduled task %s for retry attempt %d/%d in %v", task.TaskID, nextAttempt+1, maxAttempts, delay)
+	if err := msg.Ack(false); err != nil {
+		log.Printf("[TaskConsumer] Failed to ack original message for retried task %s: %v", task.TaskID, err)
+	}
+}
+
+// finalize 不再重试的任务：先终结任务（标记失败并释放资源），再转入死信队列；
+// 任务已被取消或强制失败时直接确认消息
+func (c *TaskConsumer) finalize(msg amqp.Delivery, task *models.DownloadTask, queue string, attempt int, category string, taskErr error) {
 	if c.pool != nil && c.pool.repo != nil {
-		retryCtx, retryCancel := context.WithTimeout(context.Background(), 5*time.Second)
-		if err := c.pool.repo.IncrementRetry(retryCtx, task.TaskID); err != nil {
-			log.Printf("[TaskConsumer] Failed to increment retry count for task %s: %v", task.TaskID, err)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		err := c.pool.failTask(ctx, task, taskErr, attempt)
+		cancel()
+		if errors.Is(err, ErrTaskCancelled) {
+			c.pool.DiscardWorkDir(task.TaskID)
+			if ackErr := msg.Ack(false); ackErr != nil {
+				log.Printf("[TaskConsumer] Failed to ack terminated task %s: %v", task.TaskID, ackErr)
+			}
+			return
+		}
+	}
+	c.deadLetter(msg, task, queue, attempt, category, taskErr)
+}
+
+// deadLetter 将终态失败的任务原样转入死信队列，消息头附带最后一次失败的错误分类
+func (c *TaskConsumer) deadLetter(msg amqp.Delivery, task *models.DownloadTask, queue string, attempt int, category string, taskErr error) {
+	publishCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := c.channel.PublishWithContext(
+		publishCtx,
+		"",
+		c.deadLetterQueue,
+		false,
+		false,
+		amqp.Publishing{
+			Headers:         deadLetterHeaders(msg.Headers, queue, attempt, category, taskErr),
+			ContentType:     msg.ContentType,
+			ContentEncoding: msg.ContentEncoding,
+			Body:            msg.Body,
+			DeliveryMode:    amqp.Persistent,
+			Priority:        msg.Priority,
+			Timestamp:       msg.Timestamp,
+		},
+	); err != nil {
+		// 死信未落地时不能确认原消息，否则任务会从 RabbitMQ 中永久丢失；退回队列等待重新投递
+		log.Printf("[TaskConsumer] Failed to dead-letter task %s, requeueing: %v", task.TaskID, err)
+		if nackErr := msg.Nack(false, true); nackErr != nil {
+			log.Printf("[TaskConsumer] Failed to requeue task %s after dead-letter failure: %v", task.TaskID, nackErr)
+		}
+		return
+	}
+	log.Printf("[TaskConsumer] Dead-lettered task %s to %s (category=%s)", task.TaskID, c.deadLetterQueue, category)
+
+	if c.pool != nil {
+		c.pool.DiscardWorkDir(task.TaskID)
+	}
+
+	// 记录死信时间与错误分类，供管理端筛选与重放
+	if c.pool != nil && c.pool.repo != nil {
+		if err := c.pool.repo.MarkDeadLettered(publishCtx, task.TaskID, category, taskErr.Error()); err != nil {
+			log.Printf("[TaskConsumer] Failed to mark task %s dead-lettered: %v", task.TaskID, err)
 		}
-		retryCancel()
 	}
 
-	log.Printf("[TaskConsumer] Requeued task %s for retry attempt %d/%d", task.TaskID, nextAttempt+1, maxAttempts)
 	if err := msg.Ack(false); err != nil {
-		log.Printf("[TaskConsumer] Failed to ack original message for retried task %s: %v", task.TaskID, err)
+		log.Printf("[TaskConsumer] Failed to ack dead-lettered task %s: %v", task.TaskID, err)
+	}
+
+	if c.pool != nil {
+		c.pool.NotifyTaskFailed(task, category, taskErr)
+	}
+}
+
+// deferTask 用户已占满并发名额或平台限流、冷却中，将消息连同消息头投递到延迟队列，
+// 到期后回到来源队列队尾，不计入重试次数
+func (c *TaskConsumer) deferTask(msg amqp.Delivery, task *models.DownloadTask, queue string, delay time.Duration) {
+	publishCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	routingKey := deferQueueName(queue, delay)
+	if err := c.channel.PublishWithContext(
+		publishCtx,
+		"",
+		routingKey,
+		false,
+		false,
+		amqp.Publishing{
+			Headers:         cloneHeaders(msg.Headers),
+			ContentType:     msg.ContentType,
+			ContentEncoding: msg.ContentEncoding,
+			Body:            msg.Body,
+			DeliveryMode:    amqp.Persistent,
+			Priority:        msg.Priority,
+			Timestamp:       msg.Timestamp,
+		},
+	); err != nil {
+		log.Printf("[TaskConsumer] Failed to defer task %s: %v", task.TaskID, err)
+		if nackErr := msg.Nack(false, true); nackErr != nil {
+			log.Printf("[TaskConsumer] Failed to requeue deferred task %s: %v", task.TaskID, nackErr)
+		}
+		return
+	}
+
+	log.Printf("[TaskConsumer] Deferred task %s to %s", task.TaskID, routingKey)
+	if err := msg.Ack(false); err != nil {
+		log.Printf("[TaskConsumer] Failed to ack deferred task %s: %v", task.TaskID, err)
+	}
+}
+
+// Requeue 将任务重新投递到所属平台的队列，重试计数从零开始，用于管理端重放
+func (c *TaskConsumer) Requeue(ctx context.Context, task *models.DownloadTask) error {
+	body, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+
+	queue := c.queueFor(taskPlatform(task))
+	if err := c.channel.PublishWithContext(
+		ctx,
+		"",
+		queue,
+		false,
+		false,
+		amqp.Publishing{
+			Headers:      amqp.Table{},
+			ContentType:  "application/json",
+			Body:         body,
+			DeliveryMode: amqp.Persistent,
+			Priority:     task.Priority,
+			Timestamp:    time.Now(),
+		},
+	); err != nil {
+		return err
+	}
+
+	log.Printf("[TaskConsumer] Requeued task %s to %s", task.TaskID, queue)
+	return nil
+}
+
+// queueFor 平台对应的下载队列，未拆分的平台使用默认队列
+func (c *TaskConsumer) queueFor(platform string) string {
+	for _, p := range c.partitions {
+		if p.platform != "" && p.platform == platform {
+			return p.queue
+		}
+	}
+	return c.queue
+}
+
+func (c *TaskConsumer) retryConfig() *config.RetryConfig {
+	if c.pool == nil {
+		return nil
 	}
+	return c.pool.retryCfg
 }
 
 func (c *TaskConsumer) maxAttempts() int {
-	if c.pool != nil && c.pool.retryCfg != nil && c.pool.retryCfg.MaxAttempts > 0 {
-		return c.pool.retryCfg.MaxAttempts
+	if c.pool == nil {
+		return 1
 	}
-	return 1
+	return c.pool.maxAttempts()
 }
 
 func retryAttemptFromHeaders(headers amqp.Table) int {
@@ -242,7 +861,7 @@ func retryAttemptFromHeaders(headers amqp.Table) int {
 		return 0
 	}
 
-	switch value := headers["x-retry-attempt"].(type) {
+	switch value := headers[headerRetryAttempt].(type) {
 	case int:
 		return value
 	case int8:
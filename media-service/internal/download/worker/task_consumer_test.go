@@ -0,0 +1,22 @@
+package worker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPlatformQueueNaming(t *testing.T) {
+	queue := platformQueueName("youdlp.download.queue", "youtube")
+	if queue != "youdlp.download.queue.youtube" {
+		t.Fatalf("unexpected platform queue name: %s", queue)
+	}
+	if name := retryQueueName(queue, 60*time.Second); name != "youdlp.download.queue.youtube.retry.60s" {
+		t.Fatalf("unexpected platform retry queue name: %s", name)
+	}
+	if name := deferQueueName(queue, 30*time.Second); name != "youdlp.download.queue.youtube.defer.30s" {
+		t.Fatalf("unexpected platform defer queue name: %s", name)
+	}
+	if key := platformRoutingKey("youtube"); key != "download.youtube" {
+		t.Fatalf("unexpected routing key: %s", key)
+	}
+}
@@ -0,0 +1,38 @@
+package ytdlp
+
+import (
+	"fmt"
+	"strings"
+
+	"youdlp/media-service/internal/download/models"
+)
+
+// AudioLanguage 返回任务请求的音轨语言，未指定或格式不合法时返回空字符串
+func AudioLanguage(task *models.DownloadTask) string {
+	language := strings.TrimSpace(task.AudioLanguage)
+	if !subtitleLangRegexp.MatchString(language) {
+		return ""
+	}
+	return language
+}
+
+// audioSelectors 按优先级返回音频流选择器。指定语言时先按语言前缀匹配（en 可命中 en-US），
+// 平台未提供该语言的音轨时回退到不限语言的选择器，不因语言缺失导致下载失败
+func (e *Executor) audioSelectors(format, language string) []string {
+	compatible := e.buildCompatibleAudioSelector(format)
+	selectors := make([]string, 0, 4)
+	if language != "" {
+		if compatible != "bestaudio" {
+			selectors = append(selectors, compatible+languageFilter(language))
+		}
+		selectors = append(selectors, "bestaudio"+languageFilter(language))
+	}
+	if compatible != "bestaudio" {
+		selectors = append(selectors, compatible)
+	}
+	return append(selectors, "bestaudio")
+}
+
+func languageFilter(language string) string {
+	return fmt.Sprintf("[language^=%s]", language)
+}
@@ -0,0 +1,59 @@
+package ytdlp
+
+import (
+	"testing"
+
+	"youdlp/media-service/internal/download/models"
+)
+
+func TestBuildFormatStringPrefersAudioLanguage(t *testing.T) {
+	e := &Executor{}
+	if got, want := e.buildFormatString("1080p", "mp4", ""), "bestvideo[height<=1080][ext=mp4]+bestaudio[ext=m4a]/bestvideo[height<=1080][ext=mp4]+bestaudio/bestvideo[height<=1080]+bestaudio/best[height<=1080][ext=mp4]/best[height<=1080]"; got != want {
+		t.Fatalf("unexpected default selector:\n got %s\nwant %s", got, want)
+	}
+
+	got := e.buildFormatString("1080p", "mp4", "es")
+	want := "bestvideo[height<=1080][ext=mp4]+bestaudio[ext=m4a][language^=es]/" +
+		"bestvideo[height<=1080][ext=mp4]+bestaudio[language^=es]/" +
+		"bestvideo[height<=1080][ext=mp4]+bestaudio[ext=m4a]/" +
+		"bestvideo[height<=1080][ext=mp4]+bestaudio/" +
+		"bestvideo[height<=1080]+bestaudio[language^=es]/" +
+		"bestvideo[height<=1080]+bestaudio/" +
+		"best[height<=1080][ext=mp4]/best[height<=1080]"
+	if got != want {
+		t.Fatalf("unexpected language selector:\n got %s\nwant %s", got, want)
+	}
+
+	if got := e.buildFormatString("best", "", "es"); got != "bestvideo+bestaudio[language^=es]/best" {
+		t.Fatalf("unexpected best selector: %s", got)
+	}
+}
+
+func TestBuildRequestedFormatPrefersAudioLanguage(t *testing.T) {
+	task := &models.DownloadTask{
+		Format:        "webm",
+		AudioLanguage: "pt-BR",
+		SelectedFormat: &models.SelectedFormat{
+			FormatID:   "248",
+			Extension:  "webm",
+			VideoCodec: "vp9",
+			AudioCodec: "none",
+		},
+	}
+	got := (&Executor{}).buildRequestedFormat(task)
+	want := "248+bestaudio[ext=webm][language^=pt-BR]/248+bestaudio[language^=pt-BR]/248+bestaudio[ext=webm]/248+bestaudio/248/best"
+	if got != want {
+		t.Fatalf("unexpected selector:\n got %s\nwant %s", got, want)
+	}
+}
+
+func TestAudioLanguageIgnoresInvalidValues(t *testing.T) {
+	for _, language := range []string{"", "  ", "en]/best[", "a b"} {
+		if got := AudioLanguage(&models.DownloadTask{AudioLanguage: language}); got != "" {
+			t.Fatalf("expected %q to be ignored, got %q", language, got)
+		}
+	}
+	if got := AudioLanguage(&models.DownloadTask{AudioLanguage: " ja "}); got != "ja" {
+		t.Fatalf("expected trimmed language, got %q", got)
+	}
+}
@@ -0,0 +1,58 @@
+package ytdlp
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"youdlp/media-service/internal/download/models"
+)
+
+// 章节文件命名为 <主文件名去扩展名> - <三位序号> <章节标题>.<扩展名>，
+// 需与 storage.PathGenerator.ChapterFiles 的匹配规则保持一致
+const chapterFileSeparator = " - "
+
+// buildClipArgs 构建片段下载与章节拆分参数
+func (e *Executor) buildClipArgs(task *models.DownloadTask, outputPath string) []string {
+	clip := task.Clip
+	if clip == nil {
+		return nil
+	}
+
+	var args []string
+	if clip.HasRange() {
+		args = append(args, "--download-sections", "*"+formatSection(clip.StartTime, clip.EndTime))
+	}
+	if clip.SplitChapters {
+		args = append(args,
+			"--split-chapters",
+			"--output", "chapter:"+chapterOutputTemplate(outputPath),
+		)
+	}
+	return args
+}
+
+// formatSection 格式化 yt-dlp 时间段，结束时间为 0 时下载到结尾
+func formatSection(start, end float64) string {
+	endValue := "inf"
+	if end > 0 {
+		endValue = formatSeconds(end)
+	}
+	return formatSeconds(start) + "-" + endValue
+}
+
+func formatSeconds(seconds float64) string {
+	if seconds < 0 {
+		seconds = 0
+	}
+	return strconv.FormatFloat(seconds, 'f', -1, 64)
+}
+
+// chapterOutputTemplate 章节文件输出模板，与主文件位于同一目录
+func chapterOutputTemplate(outputPath string) string {
+	base := filepath.Base(outputPath)
+	stem := strings.TrimSuffix(base, filepath.Ext(base))
+	// 主文件名中的 % 需转义，避免被 yt-dlp 当作模板字段
+	stem = strings.ReplaceAll(stem, "%", "%%")
+	return filepath.Join(filepath.Dir(outputPath), stem+chapterFileSeparator+"%(section_number)03d %(section_title)s.%(ext)s")
+}
@@ -0,0 +1,42 @@
+package ytdlp
+
+import (
+	"strings"
+	"testing"
+
+	"youdlp/media-service/internal/download/models"
+)
+
+func TestBuildClipArgsTimeRange(t *testing.T) {
+	executor := &Executor{}
+	args := executor.buildClipArgs(&models.DownloadTask{
+		Clip: &models.ClipOptions{StartTime: 90, EndTime: 330.5},
+	}, "/data/tmp/task/video.mp4")
+
+	if strings.Join(args, " ") != "--download-sections *90-330.5" {
+		t.Fatalf("unexpected clip args: %v", args)
+	}
+}
+
+func TestBuildClipArgsOpenEndedWithChapters(t *testing.T) {
+	executor := &Executor{}
+	args := executor.buildClipArgs(&models.DownloadTask{
+		Clip: &models.ClipOptions{StartTime: 60, SplitChapters: true},
+	}, "/data/tmp/task/100% video.mp4")
+
+	expected := []string{
+		"--download-sections", "*60-inf",
+		"--split-chapters",
+		"--output", "chapter:/data/tmp/task/100%% video - %(section_number)03d %(section_title)s.%(ext)s",
+	}
+	if strings.Join(args, "|") != strings.Join(expected, "|") {
+		t.Fatalf("unexpected clip args:\n got %v\nwant %v", args, expected)
+	}
+}
+
+func TestBuildClipArgsWithoutClip(t *testing.T) {
+	executor := &Executor{}
+	if args := executor.buildClipArgs(&models.DownloadTask{}, "/data/tmp/task/video.mp4"); len(args) != 0 {
+		t.Fatalf("expected no clip args, got %v", args)
+	}
+}
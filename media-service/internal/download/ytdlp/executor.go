@@ -4,19 +4,18 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
-	"math"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
 	"youdlp/media-service/internal/download/config"
 	"youdlp/media-service/internal/download/models"
+	"youdlp/media-service/internal/platform"
 	"youdlp/media-service/internal/platformpolicy"
 	"youdlp/media-service/internal/redact"
 )
@@ -26,13 +25,6 @@ const (
 	fileTracePrefix   = "[file-trace]"
 )
 
-var (
-	percentRegexp = regexp.MustCompile(`(\d+\.?\d*)%`)
-	sizeRegexp    = regexp.MustCompile(`of\s+~?\s*(\d+\.?\d*)([KMGT]?i?B)`)
-	speedRegexp   = regexp.MustCompile(`at\s+(\d+\.?\d*\w+/s)`)
-	etaRegexp     = regexp.MustCompile(`ETA\s+(\d+:\d+)`)
-)
-
 // DownloadPhase 下载阶段
 type DownloadPhase string
 
@@ -42,12 +34,24 @@ const (
 	PhaseDownloading      DownloadPhase = "downloading"
 	PhaseMerging          DownloadPhase = "merging"
 	PhaseProcessing       DownloadPhase = "processing"
+	PhasePostProcessing   DownloadPhase = "post_processing"
+)
+
+// OutputEvent 类型
+const (
+	OutputEventProgress    = "progress"
+	OutputEventPostprocess = "postprocess"
 )
 
+// PostprocessorMerger yt-dlp 音视频合流后处理器名称
+const PostprocessorMerger = "Merger"
+
 // OutputEvent 表示从 yt-dlp stdout 解析出的一个事件
 type OutputEvent struct {
-	Type     string           // "progress" 或 "merger"
-	Progress *models.Progress // Type=="progress" 时有值
+	Type          string           // OutputEventProgress 或 OutputEventPostprocess
+	Progress      *models.Progress // Type==OutputEventProgress 时有值
+	Postprocessor string           // Type==OutputEventPostprocess 时为后处理器名称，如 "Merger"
+	Status        string           // Type==OutputEventPostprocess 时为 started、processing 或 finished
 }
 
 // NeedsMerge 判断任务是否需要音视频合流
@@ -56,7 +60,9 @@ func NeedsMerge(task *models.DownloadTask) bool {
 	if sel == nil {
 		// 无精确选择，走 buildFormatString 路径
 		switch task.Quality {
-		case "best", "audio", "":
+		case "best", "":
+			return AudioLanguage(task) != "" // 指定音轨语言时改为音视频分离下载
+		case "audio":
 			return false
 		default:
 			return true // 720p/1080p 等大概率需要 video+audio
@@ -75,10 +81,11 @@ type Executor struct {
 	defaultArgs         []string
 	platformArgs        map[string][]string
 	youtubePolicy       platformpolicy.YouTubePolicy
+	platforms           *platform.Registry
 }
 
-// NewExecutor 创建 yt-dlp 执行器
-func NewExecutor(cfg *config.YtDLPConfig) *Executor {
+// NewExecutor 创建 yt-dlp 执行器，platforms 为空时所有 URL 按 generic 处理
+func NewExecutor(cfg *config.YtDLPConfig, platforms *platform.Registry) *Executor {
 	return &Executor{
 		binaryPath:          cfg.BinaryPath,
 		timeout:             time.Duration(cfg.Timeout) * time.Second,
@@ -87,6 +94,7 @@ func NewExecutor(cfg *config.YtDLPConfig) *Executor {
 		defaultArgs:         cfg.DefaultArgs,
 		platformArgs:        cfg.PlatformArgs,
 		youtubePolicy:       cfg.YouTube,
+		platforms:           platforms,
 	}
 }
 
@@ -151,7 +159,14 @@ func (e *Executor) Download(ctx context.Context, task *models.DownloadTask, prox
 			continue
 		}
 		event := parseOutput(line)
-		if event != nil && callback != nil {
+		if event == nil {
+			continue
+		}
+		// 字幕文件先于音视频流下载，其进度不计入整体进度
+		if event.Type == OutputEventProgress && IsSubtitleFile(event.Progress.Filename) {
+			continue
+		}
+		if callback != nil {
 			callback(event)
 		}
 	}
@@ -163,6 +178,10 @@ func (e *Executor) Download(ctx context.Context, task *models.DownloadTask, prox
 			log.Printf("[YtDLP] [Task %s] ❌ Download timeout after %v", task.TaskID, e.timeout)
 			return fmt.Errorf("download timeout after %v", e.timeout)
 		}
+		if errors.Is(ctx.Err(), context.Canceled) {
+			log.Printf("[YtDLP] [Task %s] Download cancelled", task.TaskID)
+			return fmt.Errorf("download cancelled: %w", context.Canceled)
+		}
 		log.Printf("[YtDLP] [Task %s] ❌ yt-dlp failed: %v, stderr: %s", task.TaskID, err, stderrOutput.String())
 		return fmt.Errorf("yt-dlp failed: %w, stderr: %s", err, stderrOutput.String())
 	}
@@ -179,6 +198,9 @@ func (e *Executor) buildCommand(task *models.DownloadTask, proxyURL, outputPath,
 		"--output", outputPath,
 		"--progress", // 输出进度
 		"--newline",  // 每次进度新行
+		// 进度与后处理事件按 JSON 输出，不依赖人类可读文本
+		"--progress-template", downloadProgressTemplate,
+		"--progress-template", postprocessProgressTemplate,
 	}
 
 	// 添加默认参数（从配置文件）
@@ -188,12 +210,13 @@ func (e *Executor) buildCommand(task *models.DownloadTask, proxyURL, outputPath,
 	}
 
 	// 检测平台并添加平台特定参数
-	platform := detectPlatform(task.URL)
+	platform := e.detectPlatform(task.URL)
 	log.Printf("[YtDLP] [Task %s] Detected platform: %s", task.TaskID, platform)
 	if platformpolicy.IsYouTubePlatform(platform) {
 		args = append(args, e.youtubePolicy.Args...)
 		log.Printf("[YtDLP] [Task %s] Applied shared YouTube args: %d", task.TaskID, len(e.youtubePolicy.Args))
-	} else if platformArgs, ok := e.platformArgs[platform]; ok && len(platformArgs) > 0 {
+	}
+	if platformArgs := e.platformExtraArgs(platform); len(platformArgs) > 0 {
 		args = append(args, platformArgs...)
 		log.Printf("[YtDLP] [Task %s] Added %d platform-specific args for %s", task.TaskID, len(platformArgs), platform)
 	}
@@ -201,6 +224,8 @@ func (e *Executor) buildCommand(task *models.DownloadTask, proxyURL, outputPath,
 	// 添加 cookies：优先使用传入的 cookieFile，否则回退到自动检测
 	if platformpolicy.IsYouTubePlatform(platform) && e.youtubePolicy.CookiesDisabled() {
 		log.Printf("[YtDLP] [Task %s] youtube_cookie_disabled=true, skipping cookie injection", task.TaskID)
+	} else if e.platformCookiesDisabled(platform) {
+		log.Printf("[YtDLP] [Task %s] Platform %s disables cookies, skipping cookie injection", task.TaskID, platform)
 	} else if cookieFile != "" {
 		args = append(args, "--cookies", cookieFile)
 		log.Printf("[YtDLP] [Task %s] Using provided cookie file: %s", task.TaskID, cookieFile)
@@ -213,6 +238,9 @@ func (e *Executor) buildCommand(task *models.DownloadTask, proxyURL, outputPath,
 		}
 	}
 
+	// 续传重试时保留的 .part 文件，放在默认参数之后避免被配置覆盖
+	args = append(args, "--continue", "--part")
+
 	// 添加并发分片下载
 	args = append(args, "--concurrent-fragments", fmt.Sprintf("%d", e.concurrentFragments))
 	args = append(args,
@@ -235,7 +263,19 @@ func (e *Executor) buildCommand(task *models.DownloadTask, proxyURL, outputPath,
 	if formatSelector := e.buildRequestedFormat(task); formatSelector != "" {
 		args = append(args, "--format", formatSelector)
 	} else if task.Quality != "" {
-		args = append(args, "--format", e.buildFormatString(task.Quality, format))
+		args = append(args, "--format", e.buildFormatString(task.Quality, format, AudioLanguage(task)))
+	}
+
+	// 添加字幕参数
+	if subtitleArgs := e.buildSubtitleArgs(task); len(subtitleArgs) > 0 {
+		args = append(args, subtitleArgs...)
+		log.Printf("[YtDLP] [Task %s] Subtitle args: %s", task.TaskID, strings.Join(subtitleArgs, " "))
+	}
+
+	// 添加片段与章节参数
+	if clipArgs := e.buildClipArgs(task, outputPath); len(clipArgs) > 0 {
+		args = append(args, clipArgs...)
+		log.Printf("[YtDLP] [Task %s] Clip args: %s", task.TaskID, strings.Join(clipArgs, " "))
 	}
 
 	// 添加 URL
@@ -246,8 +286,8 @@ func (e *Executor) buildCommand(task *models.DownloadTask, proxyURL, outputPath,
 	return cmd
 }
 
-// buildFormatString 构建格式选择字符串
-func (e *Executor) buildFormatString(quality, format string) string {
+// buildFormatString 构建格式选择字符串，language 非空时优先选择该语言的音轨
+func (e *Executor) buildFormatString(quality, format, language string) string {
 	// 根据质量选择格式
 	// 例如: bestvideo[height<=1080]+bestaudio[ext=m4a]/best[height<=1080]
 	height := ""
@@ -264,6 +304,11 @@ func (e *Executor) buildFormatString(quality, format string) string {
 		height = "480"
 	case "360p":
 		height = "360"
+	case "best", "":
+		if language != "" {
+			return fmt.Sprintf("bestvideo+bestaudio%s/best", languageFilter(language))
+		}
+		return "best"
 	default:
 		return "best"
 	}
@@ -273,17 +318,20 @@ func (e *Executor) buildFormatString(quality, format string) string {
 		preferredVideo = fmt.Sprintf("bestvideo[height<=%s][ext=%s]", height, format)
 	}
 	fallbackVideo := fmt.Sprintf("bestvideo[height<=%s]", height)
-	preferredAudio := e.buildCompatibleAudioSelector(format)
 	bestSelector := fmt.Sprintf("best[height<=%s]", height)
 	if format != "" {
 		bestSelector = fmt.Sprintf("best[height<=%s][ext=%s]/best[height<=%s]", height, format, height)
 	}
 
-	if preferredAudio == "bestaudio" {
-		return fmt.Sprintf("%s+bestaudio/%s+bestaudio/%s", preferredVideo, fallbackVideo, bestSelector)
+	selectors := make([]string, 0, 6)
+	for _, audio := range e.audioSelectors(format, language) {
+		selectors = append(selectors, preferredVideo+"+"+audio)
 	}
-
-	return fmt.Sprintf("%s+%s/%s+bestaudio/%s+bestaudio/%s", preferredVideo, preferredAudio, preferredVideo, fallbackVideo, bestSelector)
+	if language != "" {
+		selectors = append(selectors, fallbackVideo+"+bestaudio"+languageFilter(language))
+	}
+	selectors = append(selectors, fallbackVideo+"+bestaudio", bestSelector)
+	return strings.Join(selectors, "/")
 }
 
 func (e *Executor) resolveOutputFormat(task *models.DownloadTask) string {
@@ -325,11 +373,12 @@ func (e *Executor) buildRequestedFormat(task *models.DownloadTask) string {
 		return selected.FormatID
 	}
 
-	audioSelector := e.buildCompatibleAudioSelector(e.resolveOutputFormat(task))
-	if audioSelector == "bestaudio" {
-		return fmt.Sprintf("%s+bestaudio/%s/best", selected.FormatID, selected.FormatID)
+	selectors := make([]string, 0, 6)
+	for _, audio := range e.audioSelectors(e.resolveOutputFormat(task), AudioLanguage(task)) {
+		selectors = append(selectors, selected.FormatID+"+"+audio)
 	}
-	return fmt.Sprintf("%s+%s/%s+bestaudio/%s/best", selected.FormatID, audioSelector, selected.FormatID, selected.FormatID)
+	selectors = append(selectors, selected.FormatID, "best")
+	return strings.Join(selectors, "/")
 }
 
 func (e *Executor) buildCompatibleAudioSelector(format string) string {
@@ -367,108 +416,35 @@ func hasAudio(selected *models.SelectedFormat) bool {
 	return selected != nil && selected.AudioCodec != "" && selected.AudioCodec != "none"
 }
 
-// parseOutput 解析 yt-dlp stdout 输出为事件
-func parseOutput(line string) *OutputEvent {
-	// 识别 [Merger] 行
-	if strings.Contains(line, "[Merger]") {
-		return &OutputEvent{Type: "merger"}
-	}
-	// 识别 [download] 进度行
-	progress := parseDownloadProgress(line)
-	if progress != nil {
-		return &OutputEvent{Type: "progress", Progress: progress}
-	}
-	return nil
-}
-
-// parseDownloadProgress 解析 yt-dlp 进度输出
-// 格式: [download]  45.2% of 100.00MiB at 2.50MiB/s ETA 00:22
-func parseDownloadProgress(line string) *models.Progress {
-	if !strings.Contains(line, "[download]") {
-		return nil
+// detectPlatform 通过平台注册表检测 URL 所属平台
+func (e *Executor) detectPlatform(url string) string {
+	if e.platforms == nil {
+		return platform.GenericName
 	}
-
-	percentMatch := percentRegexp.FindStringSubmatch(line)
-	if len(percentMatch) < 2 {
-		return nil
-	}
-
-	percent, err := strconv.ParseFloat(percentMatch[1], 64)
+	p, err := e.platforms.Detect(url)
 	if err != nil {
-		return nil
+		return platform.GenericName
 	}
-
-	progress := &models.Progress{
-		Percent: percent,
-	}
-
-	sizeMatch := sizeRegexp.FindStringSubmatch(line)
-	if len(sizeMatch) >= 3 {
-		totalBytes, err := parseSizeBytes(sizeMatch[1], sizeMatch[2])
-		if err == nil {
-			progress.TotalBytes = totalBytes
-			progress.DownloadedBytes = int64(math.Round(float64(totalBytes) * percent / 100.0))
-		}
-	}
-
-	speedMatch := speedRegexp.FindStringSubmatch(line)
-	if len(speedMatch) >= 2 {
-		progress.Speed = speedMatch[1]
-	}
-
-	etaMatch := etaRegexp.FindStringSubmatch(line)
-	if len(etaMatch) >= 2 {
-		progress.ETA = etaMatch[1]
-	}
-
-	return progress
+	return p.Name
 }
 
-func parseSizeBytes(value, unit string) (int64, error) {
-	number, err := strconv.ParseFloat(value, 64)
-	if err != nil {
-		return 0, err
-	}
-
-	multiplier := float64(1)
-	switch strings.ToUpper(unit) {
-	case "B":
-		multiplier = 1
-	case "KIB":
-		multiplier = 1024
-	case "MIB":
-		multiplier = 1024 * 1024
-	case "GIB":
-		multiplier = 1024 * 1024 * 1024
-	case "TIB":
-		multiplier = 1024 * 1024 * 1024 * 1024
-	case "KB":
-		multiplier = 1000
-	case "MB":
-		multiplier = 1000 * 1000
-	case "GB":
-		multiplier = 1000 * 1000 * 1000
-	case "TB":
-		multiplier = 1000 * 1000 * 1000 * 1000
-	default:
-		return 0, fmt.Errorf("unsupported size unit: %s", unit)
+// platformExtraArgs 平台额外参数，注册表未配置时回退到 ytdlp.platform_args
+func (e *Executor) platformExtraArgs(name string) []string {
+	if e.platforms != nil {
+		if args := e.platforms.ExtraArgs(name); len(args) > 0 {
+			return args
+		}
 	}
-
-	return int64(math.Round(number * multiplier)), nil
+	return e.platformArgs[name]
 }
 
-// detectPlatform 从 URL 检测平台
-func detectPlatform(url string) string {
-	if strings.Contains(url, "youtube.com") || strings.Contains(url, "youtu.be") {
-		return "youtube"
-	}
-	if strings.Contains(url, "bilibili.com") {
-		return "bilibili"
-	}
-	if strings.Contains(url, "tiktok.com") {
-		return "tiktok"
+// platformCookiesDisabled 平台注册表是否禁用 Cookie（cookies: disabled）
+func (e *Executor) platformCookiesDisabled(name string) bool {
+	if e.platforms == nil {
+		return false
 	}
-	return "generic"
+	cfg, ok := e.platforms.Get(name)
+	return ok && cfg != nil && cfg.CookiesDisabled()
 }
 
 // getCookieFile 获取平台 cookie 文件
@@ -508,14 +484,14 @@ func (e *Executor) GetVideoInfo(ctx context.Context, url string) (*models.Metada
 		return nil, fmt.Errorf("failed to parse video info: %w", err)
 	}
 
-	platform := detectPlatform(url)
+	platformName := e.detectPlatform(url)
 	if info.ExtractorKey != "" {
-		platform = strings.ToLower(info.ExtractorKey)
+		platformName = strings.ToLower(info.ExtractorKey)
 	}
 
 	return &models.Metadata{
 		Title:    info.Title,
 		Duration: info.Duration,
-		Platform: platform,
+		Platform: platformName,
 	}, nil
 }
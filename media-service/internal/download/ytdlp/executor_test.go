@@ -1,28 +1,42 @@
 package ytdlp
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
 
-func TestParseDownloadProgressParsesCompleteLine(t *testing.T) {
-	progress := parseDownloadProgress("[download]  45.2% of 100.00MiB at 2.50MiB/s ETA 00:22")
-	if progress == nil {
-		t.Fatal("expected progress to be parsed")
-	}
-	if progress.Percent != 45.2 {
-		t.Fatalf("unexpected percent: %v", progress.Percent)
-	}
-	if progress.TotalBytes != 104857600 {
-		t.Fatalf("unexpected total bytes: %d", progress.TotalBytes)
+	"youdlp/media-service/internal/download/models"
+	"youdlp/media-service/internal/platform"
+)
+
+func TestBuildCommandSkipsCookiesForDisabledPlatform(t *testing.T) {
+	cookiesDir := t.TempDir()
+	for _, name := range []string{"bilibili.txt", "vimeo.txt"} {
+		if err := os.WriteFile(filepath.Join(cookiesDir, name), []byte("# Netscape HTTP Cookie File\n"), 0o644); err != nil {
+			t.Fatalf("failed to write cookie file: %v", err)
+		}
 	}
-	if progress.Speed != "2.50MiB/s" {
-		t.Fatalf("unexpected speed: %q", progress.Speed)
+	registry, err := platform.NewRegistry([]platform.Definition{
+		{Name: "bilibili", Patterns: []string{`bilibili\.com`}, Cookies: platform.CookieDisabled},
+		{Name: "vimeo", Patterns: []string{`vimeo\.com`}},
+	})
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
 	}
-	if progress.ETA != "00:22" {
-		t.Fatalf("unexpected eta: %q", progress.ETA)
+	e := &Executor{binaryPath: "yt-dlp", cookiesDir: cookiesDir, platforms: registry}
+
+	// 禁用 Cookie 的平台既不使用传入的 Cookie，也不回退到 cookies_dir 中的平台文件
+	for _, cookieFile := range []string{"", "/tmp/provided.txt"} {
+		cmd := e.buildCommand(&models.DownloadTask{TaskID: "t1", URL: "https://www.bilibili.com/video/BV1xx411c7mD"}, "", "/data/work/t1/video.mp4", cookieFile)
+		if slices.Contains(cmd.Args, "--cookies") {
+			t.Fatalf("expected no cookies for disabled platform (provided=%q), got args %v", cookieFile, cmd.Args)
+		}
 	}
-}
 
-func TestParseDownloadProgressRejectsNonDownloadLine(t *testing.T) {
-	if progress := parseDownloadProgress("[info] processing metadata"); progress != nil {
-		t.Fatal("expected non-download line to be ignored")
+	cmd := e.buildCommand(&models.DownloadTask{TaskID: "t2", URL: "https://vimeo.com/123"}, "", "/data/work/t2/video.mp4", "")
+	idx := slices.Index(cmd.Args, "--cookies")
+	if idx < 0 || cmd.Args[idx+1] != filepath.Join(cookiesDir, "vimeo.txt") {
+		t.Fatalf("expected auto-detected cookie for optional platform, got args %v", cmd.Args)
 	}
 }
@@ -0,0 +1,244 @@
+package ytdlp
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"youdlp/media-service/internal/download/models"
+)
+
+const (
+	progressJSONPrefix    = "[progress-json]"
+	postprocessJSONPrefix = "[postprocess-json]"
+)
+
+// downloadProgressTemplate 下载进度模板：每行一个 JSON 对象，字段取自 yt-dlp 进度钩子与当前流的 info
+var downloadProgressTemplate = "download:" + progressJSONPrefix + " {" +
+	`"status":%(progress.status)j,` +
+	`"filename":%(progress.filename)j,` +
+	`"format_id":%(info.format_id)j,` +
+	`"vcodec":%(info.vcodec)j,` +
+	`"acodec":%(info.acodec)j,` +
+	`"downloaded_bytes":%(progress.downloaded_bytes)j,` +
+	`"total_bytes":%(progress.total_bytes)j,` +
+	`"total_bytes_estimate":%(progress.total_bytes_estimate)j,` +
+	`"fragment_index":%(progress.fragment_index)j,` +
+	`"fragment_count":%(progress.fragment_count)j,` +
+	`"speed":%(progress.speed)j,` +
+	`"eta":%(progress.eta)j` +
+	"}"
+
+// postprocessProgressTemplate 后处理事件模板，合流等后处理开始与结束时各输出一行
+var postprocessProgressTemplate = "postprocess:" + postprocessJSONPrefix + " {" +
+	`"status":%(progress.status)j,` +
+	`"postprocessor":%(progress.postprocessor)j` +
+	"}"
+
+// templateString 进度模板中的字符串字段，yt-dlp 对缺失字段输出 "NA"
+type templateString string
+
+func (s *templateString) UnmarshalJSON(data []byte) error {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	switch v := value.(type) {
+	case string:
+		if v != "NA" {
+			*s = templateString(v)
+		}
+	case float64:
+		*s = templateString(strconv.FormatFloat(v, 'f', -1, 64))
+	}
+	return nil
+}
+
+// templateNumber 进度模板中的数值字段，缺失（"NA" 或 null）时为 0
+type templateNumber float64
+
+func (n *templateNumber) UnmarshalJSON(data []byte) error {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	switch v := value.(type) {
+	case float64:
+		*n = templateNumber(v)
+	case string:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			*n = templateNumber(f)
+		}
+	}
+	return nil
+}
+
+type progressLine struct {
+	Status             templateString `json:"status"`
+	Filename           templateString `json:"filename"`
+	FormatID           templateString `json:"format_id"`
+	VideoCodec         templateString `json:"vcodec"`
+	AudioCodec         templateString `json:"acodec"`
+	DownloadedBytes    templateNumber `json:"downloaded_bytes"`
+	TotalBytes         templateNumber `json:"total_bytes"`
+	TotalBytesEstimate templateNumber `json:"total_bytes_estimate"`
+	FragmentIndex      templateNumber `json:"fragment_index"`
+	FragmentCount      templateNumber `json:"fragment_count"`
+	Speed              templateNumber `json:"speed"`
+	ETA                templateNumber `json:"eta"`
+}
+
+type postprocessLine struct {
+	Status        templateString `json:"status"`
+	Postprocessor templateString `json:"postprocessor"`
+}
+
+// parseOutput 解析 yt-dlp stdout 中由进度模板输出的 JSON 行，其他行返回 nil
+func parseOutput(line string) *OutputEvent {
+	line = strings.TrimSpace(line)
+	if payload, ok := strings.CutPrefix(line, progressJSONPrefix); ok {
+		progress := parseDownloadProgress(payload)
+		if progress == nil {
+			return nil
+		}
+		return &OutputEvent{Type: OutputEventProgress, Progress: progress}
+	}
+	if payload, ok := strings.CutPrefix(line, postprocessJSONPrefix); ok {
+		var pp postprocessLine
+		if err := json.Unmarshal([]byte(payload), &pp); err != nil || pp.Postprocessor == "" {
+			return nil
+		}
+		return &OutputEvent{
+			Type:          OutputEventPostprocess,
+			Postprocessor: string(pp.Postprocessor),
+			Status:        string(pp.Status),
+		}
+	}
+	return nil
+}
+
+// parseDownloadProgress 解析单条下载进度 JSON
+func parseDownloadProgress(payload string) *models.Progress {
+	var line progressLine
+	if err := json.Unmarshal([]byte(payload), &line); err != nil {
+		return nil
+	}
+
+	total := int64(line.TotalBytes)
+	if total <= 0 {
+		total = int64(line.TotalBytesEstimate)
+	}
+	downloaded := int64(line.DownloadedBytes)
+	if line.Status == "finished" && downloaded <= 0 {
+		// 已完整存在的文件只报告 total_bytes
+		downloaded = total
+	}
+
+	progress := &models.Progress{
+		Status:          string(line.Status),
+		Filename:        string(line.Filename),
+		FormatID:        string(line.FormatID),
+		VideoCodec:      string(line.VideoCodec),
+		AudioCodec:      string(line.AudioCodec),
+		DownloadedBytes: downloaded,
+		TotalBytes:      total,
+		FragmentIndex:   int(line.FragmentIndex),
+		FragmentCount:   int(line.FragmentCount),
+		Speed:           formatSpeed(float64(line.Speed)),
+		ETA:             formatETA(float64(line.ETA)),
+	}
+
+	switch {
+	case progress.Status == "finished":
+		progress.Percent = 100
+	case total > 0:
+		progress.Percent = math.Min(float64(downloaded)*100/float64(total), 100)
+	case progress.FragmentCount > 0:
+		progress.Percent = math.Min(float64(progress.FragmentIndex)*100/float64(progress.FragmentCount), 100)
+	}
+	return progress
+}
+
+// formatSpeed 按 yt-dlp 的二进制单位格式化速度，如 "2.50MiB/s"
+func formatSpeed(bytesPerSecond float64) string {
+	if bytesPerSecond <= 0 {
+		return ""
+	}
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB"}
+	value := bytesPerSecond
+	unit := 0
+	for value >= 1024 && unit < len(units)-1 {
+		value /= 1024
+		unit++
+	}
+	return fmt.Sprintf("%.2f%s/s", value, units[unit])
+}
+
+// formatETA 格式化剩余时间为 "MM:SS" 或 "HH:MM:SS"
+func formatETA(seconds float64) string {
+	if seconds <= 0 {
+		return ""
+	}
+	total := int64(math.Round(seconds))
+	hours, minutes, secs := total/3600, total%3600/60, total%60
+	if hours > 0 {
+		return fmt.Sprintf("%02d:%02d:%02d", hours, minutes, secs)
+	}
+	return fmt.Sprintf("%02d:%02d", minutes, secs)
+}
+
+// PhaseTracker 根据当前流判断下载阶段。
+// 优先使用流的编解码信息；yt-dlp 未提供时按格式 ID 出现顺序判断（先视频后音频）
+type PhaseTracker struct {
+	needsMerge bool
+	formats    []string
+}
+
+// NewPhaseTracker 创建阶段跟踪器
+func NewPhaseTracker(needsMerge bool) *PhaseTracker {
+	return &PhaseTracker{needsMerge: needsMerge}
+}
+
+// Phase 返回当前进度所属的下载阶段
+func (t *PhaseTracker) Phase(progress *models.Progress) DownloadPhase {
+	if !t.needsMerge {
+		return PhaseDownloading
+	}
+
+	video := codecPresent(progress.VideoCodec)
+	audio := codecPresent(progress.AudioCodec)
+	switch {
+	case video && audio:
+		// 回退到了音视频一体的格式，无需合流
+		return PhaseDownloading
+	case video && progress.AudioCodec != "":
+		return PhaseDownloadingVideo
+	case audio && progress.VideoCodec != "":
+		return PhaseDownloadingAudio
+	}
+
+	index := t.formatIndex(progress.FormatID)
+	if index <= 0 {
+		return PhaseDownloadingVideo
+	}
+	return PhaseDownloadingAudio
+}
+
+func (t *PhaseTracker) formatIndex(formatID string) int {
+	if formatID == "" {
+		return len(t.formats) - 1
+	}
+	for i, id := range t.formats {
+		if id == formatID {
+			return i
+		}
+	}
+	t.formats = append(t.formats, formatID)
+	return len(t.formats) - 1
+}
+
+func codecPresent(codec string) bool {
+	return codec != "" && codec != "none"
+}
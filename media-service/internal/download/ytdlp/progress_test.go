@@ -0,0 +1,127 @@
+package ytdlp
+
+import (
+	"testing"
+
+	"youdlp/media-service/internal/download/models"
+)
+
+func TestParseOutputParsesProgressJSON(t *testing.T) {
+	event := parseOutput(`[progress-json] {"status":"downloading","filename":"/data/work/t1/video.f137.mp4","format_id":"137","vcodec":"avc1.640028","acodec":"none","downloaded_bytes":47395635,"total_bytes":104857600,"total_bytes_estimate":"NA","fragment_index":"NA","fragment_count":"NA","speed":2621440.0,"eta":22}`)
+	if event == nil || event.Type != OutputEventProgress {
+		t.Fatalf("expected progress event, got %+v", event)
+	}
+	progress := event.Progress
+	if progress.FormatID != "137" || progress.Filename != "/data/work/t1/video.f137.mp4" {
+		t.Fatalf("unexpected stream: %+v", progress)
+	}
+	if progress.DownloadedBytes != 47395635 || progress.TotalBytes != 104857600 {
+		t.Fatalf("unexpected bytes: %d/%d", progress.DownloadedBytes, progress.TotalBytes)
+	}
+	if progress.Percent < 45.19 || progress.Percent > 45.21 {
+		t.Fatalf("unexpected percent: %v", progress.Percent)
+	}
+	if progress.Speed != "2.50MiB/s" {
+		t.Fatalf("unexpected speed: %q", progress.Speed)
+	}
+	if progress.ETA != "00:22" {
+		t.Fatalf("unexpected eta: %q", progress.ETA)
+	}
+}
+
+func TestParseOutputUsesFragmentsWhenSizeUnknown(t *testing.T) {
+	event := parseOutput(`[progress-json] {"status":"downloading","filename":"/data/work/t1/video.mp4","format_id":"hls-1080p","vcodec":"NA","acodec":"NA","downloaded_bytes":1048576,"total_bytes":"NA","total_bytes_estimate":"NA","fragment_index":30,"fragment_count":120,"speed":"NA","eta":"NA"}`)
+	if event == nil {
+		t.Fatal("expected progress event")
+	}
+	if event.Progress.Percent != 25 {
+		t.Fatalf("unexpected percent: %v", event.Progress.Percent)
+	}
+	if event.Progress.FragmentIndex != 30 || event.Progress.FragmentCount != 120 {
+		t.Fatalf("unexpected fragments: %+v", event.Progress)
+	}
+	if event.Progress.Speed != "" || event.Progress.ETA != "" {
+		t.Fatalf("expected missing speed and eta to be empty: %+v", event.Progress)
+	}
+}
+
+func TestParseOutputReportsAlreadyDownloadedStream(t *testing.T) {
+	event := parseOutput(`[progress-json] {"status":"finished","filename":"/data/work/t1/video.f140.m4a","format_id":"140","vcodec":"none","acodec":"mp4a.40.2","downloaded_bytes":"NA","total_bytes":3145728,"total_bytes_estimate":"NA","fragment_index":"NA","fragment_count":"NA","speed":"NA","eta":"NA"}`)
+	if event == nil {
+		t.Fatal("expected progress event")
+	}
+	if event.Progress.Percent != 100 || event.Progress.DownloadedBytes != 3145728 {
+		t.Fatalf("unexpected finished progress: %+v", event.Progress)
+	}
+}
+
+func TestParseOutputParsesPostprocessorEvents(t *testing.T) {
+	event := parseOutput(`[postprocess-json] {"status":"started","postprocessor":"Merger"}`)
+	if event == nil || event.Type != OutputEventPostprocess {
+		t.Fatalf("expected postprocess event, got %+v", event)
+	}
+	if event.Postprocessor != PostprocessorMerger || event.Status != "started" {
+		t.Fatalf("unexpected postprocess event: %+v", event)
+	}
+}
+
+func TestParseOutputIgnoresHumanReadableLines(t *testing.T) {
+	for _, line := range []string{
+		"[download]  45.2% of 100.00MiB at 2.50MiB/s ETA 00:22",
+		"[Merger] Merging formats into \"/data/work/t1/video.mp4\"",
+		"[progress-json] not json",
+	} {
+		if event := parseOutput(line); event != nil {
+			t.Fatalf("expected %q to be ignored, got %+v", line, event)
+		}
+	}
+}
+
+func TestPhaseTrackerUsesStreamCodecs(t *testing.T) {
+	tracker := NewPhaseTracker(true)
+	// 音频流先于视频流下载时仍按编解码归类
+	if phase := tracker.Phase(&models.Progress{FormatID: "140", VideoCodec: "none", AudioCodec: "mp4a.40.2"}); phase != PhaseDownloadingAudio {
+		t.Fatalf("expected audio phase, got %s", phase)
+	}
+	if phase := tracker.Phase(&models.Progress{FormatID: "137", VideoCodec: "avc1", AudioCodec: "none"}); phase != PhaseDownloadingVideo {
+		t.Fatalf("expected video phase, got %s", phase)
+	}
+	if phase := tracker.Phase(&models.Progress{FormatID: "18", VideoCodec: "avc1", AudioCodec: "mp4a"}); phase != PhaseDownloading {
+		t.Fatalf("expected combined format to use downloading phase, got %s", phase)
+	}
+}
+
+func TestPhaseTrackerFallsBackToFormatOrder(t *testing.T) {
+	tracker := NewPhaseTracker(true)
+	steps := []struct {
+		formatID string
+		want     DownloadPhase
+	}{
+		{"dash-video", PhaseDownloadingVideo},
+		{"dash-video", PhaseDownloadingVideo},
+		{"dash-audio", PhaseDownloadingAudio},
+		{"dash-audio", PhaseDownloadingAudio},
+	}
+	for _, step := range steps {
+		if phase := tracker.Phase(&models.Progress{FormatID: step.formatID}); phase != step.want {
+			t.Fatalf("format %s: expected %s, got %s", step.formatID, step.want, phase)
+		}
+	}
+
+	if phase := NewPhaseTracker(false).Phase(&models.Progress{FormatID: "137", VideoCodec: "avc1", AudioCodec: "none"}); phase != PhaseDownloading {
+		t.Fatalf("expected single-stream task to use downloading phase, got %s", phase)
+	}
+}
+
+func TestBuildCommandRequestsJSONProgress(t *testing.T) {
+	cmd := (&Executor{binaryPath: "yt-dlp"}).buildCommand(&models.DownloadTask{TaskID: "t1", URL: "https://example.com/v"}, "", "/data/work/t1/video.mp4", "")
+	templates := 0
+	for i, arg := range cmd.Args {
+		if arg == "--progress-template" && i+1 < len(cmd.Args) {
+			templates++
+		}
+	}
+	if templates != 2 {
+		t.Fatalf("expected download and postprocess progress templates, got args %v", cmd.Args)
+	}
+}
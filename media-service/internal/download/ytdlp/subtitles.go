@@ -0,0 +1,107 @@
+package ytdlp
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"youdlp/media-service/internal/download/models"
+)
+
+const (
+	defaultSubtitleFormat = "srt"
+)
+
+var subtitleLangRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
+// 可嵌入字幕的容器格式
+var subtitleEmbeddableContainers = map[string]bool{
+	"mp4":  true,
+	"mov":  true,
+	"webm": true,
+	"mkv":  true,
+}
+
+// SubtitleExtensions 字幕文件扩展名（yt-dlp 下载或转换后可能出现的格式）
+var SubtitleExtensions = map[string]bool{
+	"srt": true,
+	"vtt": true,
+	"ass": true,
+	"lrc": true,
+}
+
+// ResolveSubtitleMode 返回任务实际使用的字幕交付方式，未请求字幕时返回空字符串。
+// 输出容器不支持嵌入字幕（如纯音频）时回退为独立字幕文件。
+func ResolveSubtitleMode(task *models.DownloadTask) string {
+	if task.Subtitles == nil || len(SubtitleLanguages(task.Subtitles)) == 0 {
+		return ""
+	}
+	if task.Subtitles.Mode != models.SubtitleModeEmbed {
+		return models.SubtitleModeSidecar
+	}
+
+	if task.SelectedFormat != nil && !hasVideo(task.SelectedFormat) {
+		return models.SubtitleModeSidecar
+	}
+	format := task.Format
+	if format == "" && task.SelectedFormat != nil {
+		format = task.SelectedFormat.Extension
+	}
+	if format == "" {
+		format = "mp4"
+	}
+	if !subtitleEmbeddableContainers[strings.ToLower(format)] {
+		return models.SubtitleModeSidecar
+	}
+	return models.SubtitleModeEmbed
+}
+
+// SubtitleLanguages 返回去重后的合法语言代码
+func SubtitleLanguages(opts *models.SubtitleOptions) []string {
+	if opts == nil {
+		return nil
+	}
+	seen := make(map[string]bool, len(opts.Languages))
+	languages := make([]string, 0, len(opts.Languages))
+	for _, lang := range opts.Languages {
+		lang = strings.TrimSpace(lang)
+		if !subtitleLangRegexp.MatchString(lang) || seen[lang] {
+			continue
+		}
+		seen[lang] = true
+		languages = append(languages, lang)
+	}
+	return languages
+}
+
+// buildSubtitleArgs 构建字幕相关参数
+func (e *Executor) buildSubtitleArgs(task *models.DownloadTask) []string {
+	mode := ResolveSubtitleMode(task)
+	if mode == "" {
+		return nil
+	}
+
+	args := []string{"--write-subs"}
+	if task.Subtitles.IncludeAuto {
+		args = append(args, "--write-auto-subs")
+	}
+	args = append(args, "--sub-langs", strings.Join(SubtitleLanguages(task.Subtitles), ","))
+
+	switch mode {
+	case models.SubtitleModeEmbed:
+		args = append(args, "--embed-subs")
+	default:
+		format := strings.ToLower(task.Subtitles.Format)
+		if format != "srt" && format != "vtt" {
+			format = defaultSubtitleFormat
+		}
+		args = append(args, "--convert-subs", format)
+	}
+	return args
+}
+
+// IsSubtitleFile 判断路径是否为字幕文件
+func IsSubtitleFile(path string) bool {
+	ext := strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	return SubtitleExtensions[ext]
+}
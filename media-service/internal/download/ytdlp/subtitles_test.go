@@ -0,0 +1,61 @@
+package ytdlp
+
+import (
+	"strings"
+	"testing"
+
+	"youdlp/media-service/internal/download/models"
+)
+
+func TestBuildSubtitleArgsEmbedsIntoVideo(t *testing.T) {
+	task := &models.DownloadTask{
+		TaskID: "task-1",
+		Format: "mp4",
+		Subtitles: &models.SubtitleOptions{
+			Languages:   []string{"en", "zh-Hans", "en", "bad lang"},
+			IncludeAuto: true,
+			Mode:        models.SubtitleModeEmbed,
+		},
+	}
+
+	args := strings.Join((&Executor{}).buildSubtitleArgs(task), " ")
+	want := "--write-subs --write-auto-subs --sub-langs en,zh-Hans --embed-subs"
+	if args != want {
+		t.Fatalf("unexpected args: %q, want %q", args, want)
+	}
+}
+
+func TestBuildSubtitleArgsFallsBackToSidecarForAudio(t *testing.T) {
+	task := &models.DownloadTask{
+		TaskID: "task-1",
+		Format: "m4a",
+		SelectedFormat: &models.SelectedFormat{
+			FormatID:   "140",
+			AudioCodec: "mp4a.40.2",
+			VideoCodec: "none",
+		},
+		Subtitles: &models.SubtitleOptions{
+			Languages: []string{"en"},
+			Mode:      models.SubtitleModeEmbed,
+			Format:    "vtt",
+		},
+	}
+
+	if mode := ResolveSubtitleMode(task); mode != models.SubtitleModeSidecar {
+		t.Fatalf("expected sidecar mode for audio output, got %q", mode)
+	}
+	args := strings.Join((&Executor{}).buildSubtitleArgs(task), " ")
+	if args != "--write-subs --sub-langs en --convert-subs vtt" {
+		t.Fatalf("unexpected args: %q", args)
+	}
+}
+
+func TestBuildSubtitleArgsSkipsWhenNoValidLanguage(t *testing.T) {
+	task := &models.DownloadTask{
+		Subtitles: &models.SubtitleOptions{Languages: []string{"en.*"}},
+	}
+
+	if args := (&Executor{}).buildSubtitleArgs(task); len(args) != 0 {
+		t.Fatalf("expected no subtitle args, got %v", args)
+	}
+}
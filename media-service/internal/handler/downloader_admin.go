@@ -0,0 +1,281 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"youdlp/media-service/internal/download/models"
+	pb "youdlp/media-service/proto"
+)
+
+// defaultStuckAfter 下载中超过该时长仍未结束的任务视为卡住
+const defaultStuckAfter = time.Hour
+
+// forceFailMessage 管理端强制失败时写入的默认错误信息
+const forceFailMessage = "task force-failed by admin"
+
+// ListTasks 跨用户查询失败、死信与长时间运行的任务
+func (s *DownloaderServer) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
+	switch req.State {
+	case "", models.AdminTaskStateFailed, models.AdminTaskStateDeadLettered, models.AdminTaskStateStuck:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "state must be one of failed, dead_lettered, stuck")
+	}
+
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	stuckBefore := time.Now().Add(-stuckAfter(req.StuckAfterSeconds))
+	filter := &models.AdminTaskFilter{
+		State:         req.State,
+		Platform:      req.Platform,
+		ErrorCategory: req.ErrorCategory,
+		UserID:        req.UserId,
+		StuckBefore:   stuckBefore,
+		Page:          page,
+		PageSize:      pageSize,
+	}
+	if req.StartTimeUnix > 0 {
+		startTime := time.Unix(req.StartTimeUnix, 0)
+		filter.StartTime = &startTime
+	}
+	if req.EndTimeUnix > 0 {
+		endTime := time.Unix(req.EndTimeUnix, 0)
+		filter.EndTime = &endTime
+	}
+
+	records, total, err := s.repo.ListAdminTasks(ctx, filter)
+	if err != nil {
+		s.logger.Error("ListTasks failed", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to query tasks")
+	}
+
+	tasks := make([]*pb.AdminTaskRecord, 0, len(records))
+	for _, r := range records {
+		tasks = append(tasks, &pb.AdminTaskRecord{
+			TaskId:         r.TaskID,
+			UserId:         r.UserID,
+			Url:            r.URL,
+			Platform:       r.Platform,
+			Title:          r.Title,
+			Mode:           r.Mode,
+			Quality:        r.Quality,
+			Status:         int32(r.Status),
+			StatusText:     models.StatusText[r.Status],
+			ErrorMessage:   r.ErrorMessage.String,
+			ErrorCategory:  r.ErrorCategory.String,
+			RetryCount:     int32(r.RetryCount),
+			CreatedAt:      r.CreatedAt.Format(timeLayout),
+			StartedAt:      formatNullTime(r.StartedAt),
+			DeadLetteredAt: formatNullTime(r.DeadLetteredAt),
+			DeadLettered:   r.DeadLetteredAt.Valid,
+			Stuck:          isStuck(r, stuckBefore),
+			Replayable:     r.HasPayload,
+		})
+	}
+
+	return &pb.ListTasksResponse{
+		Tasks:    tasks,
+		Total:    total,
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}, nil
+}
+
+// ReplayTask 为失败或卡住的任务申请新的代理租约并重新投递到下载队列
+func (s *DownloaderServer) ReplayTask(ctx context.Context, req *pb.ReplayTaskRequest) (*pb.TaskActionResponse, error) {
+	if req.TaskId == "" {
+		return nil, status.Error(codes.InvalidArgument, "task_id is required")
+	}
+	if s.queue == nil {
+		return nil, status.Error(codes.Unavailable, "task queue is not available")
+	}
+
+	record, err := s.repo.FindByTaskID(ctx, req.TaskId)
+	if err != nil {
+		s.logger.Error("ReplayTask lookup failed", zap.String("task_id", req.TaskId), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to query task")
+	}
+	if record == nil {
+		return nil, status.Error(codes.NotFound, "task not found")
+	}
+	stuck := isStuck(record, time.Now().Add(-stuckAfter(req.StuckAfterSeconds)))
+	if record.Status != models.StatusFailed && !stuck {
+		return nil, status.Error(codes.FailedPrecondition, "only failed or stuck tasks can be replayed")
+	}
+
+	payload, err := s.repo.FindTaskPayload(ctx, req.TaskId)
+	if err != nil {
+		s.logger.Error("ReplayTask payload lookup failed", zap.String("task_id", req.TaskId), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to query task payload")
+	}
+	if payload == nil {
+		return nil, status.Error(codes.FailedPrecondition, "task payload is not recorded, cannot replay")
+	}
+	var task models.DownloadTask
+	if err := json.Unmarshal(payload, &task); err != nil {
+		s.logger.Error("ReplayTask payload is invalid", zap.String("task_id", req.TaskId), zap.Error(err))
+		return nil, status.Error(codes.FailedPrecondition, "task payload is invalid")
+	}
+
+	// 卡住的任务可能仍挂在某个实例上，先终止再重放
+	if stuck {
+		s.canceller.CancelTask(req.TaskId)
+		if s.bus != nil {
+			if err := s.bus.Broadcast(ctx, req.TaskId); err != nil {
+				s.logger.Warn("Failed to broadcast cancel", zap.String("task_id", req.TaskId), zap.Error(err))
+			}
+		}
+	}
+
+	// 旧租约可能已过期或已在失败时释放，重放使用新的代理租约
+	if s.assetClient != nil {
+		platform := task.Metadata.Platform
+		if platform == "" {
+			platform = task.Platform
+		}
+		lease, err := s.assetClient.AcquireProxyForTask(ctx, task.TaskID, platform)
+		if err != nil {
+			s.logger.Warn("ReplayTask failed to acquire proxy", zap.String("task_id", req.TaskId), zap.Error(err))
+			return nil, status.Error(codes.Unavailable, "failed to acquire proxy lease")
+		}
+		task.ProxyURL, task.ProxyLeaseID, task.ProxyExpireAt = "", "", ""
+		if lease != nil {
+			task.ProxyURL = lease.URL
+			task.ProxyLeaseID = lease.LeaseID
+			task.ProxyExpireAt = lease.ExpireAt
+		}
+	}
+	task.ReplayedAtUnix = time.Now().UnixMilli()
+
+	if err := s.repo.ResetForReplay(ctx, req.TaskId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.FailedPrecondition, "task state changed, retry later")
+		}
+		s.logger.Error("ReplayTask reset failed", zap.String("task_id", req.TaskId), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to reset task")
+	}
+	if err := s.queue.Requeue(ctx, &task); err != nil {
+		s.logger.Error("ReplayTask requeue failed", zap.String("task_id", req.TaskId), zap.Error(err))
+		if s.assetClient != nil {
+			if releaseErr := s.assetClient.ReleaseProxyForTask(req.TaskId, "replay_failed"); releaseErr != nil {
+				s.logger.Warn("Failed to release proxy", zap.String("task_id", req.TaskId), zap.Error(releaseErr))
+			}
+		}
+		if updateErr := s.repo.UpdateFailed(ctx, req.TaskId, "replay requeue failed: "+err.Error(), 0); updateErr != nil {
+			s.logger.Warn("Failed to restore failed status", zap.String("task_id", req.TaskId), zap.Error(updateErr))
+		}
+		return nil, status.Error(codes.Unavailable, "failed to requeue task")
+	}
+
+	s.logger.Info("Task replayed",
+		zap.String("task_id", req.TaskId),
+		zap.String("operator_user_id", req.OperatorUserId),
+		zap.Bool("stuck", stuck),
+		zap.Bool("with_proxy", task.ProxyURL != ""),
+	)
+	return &pb.TaskActionResponse{
+		Success: true,
+		Message: "任务已重新入队",
+	}, nil
+}
+
+// ForceFailTask 强制将待处理或下载中的任务标记为失败，复用用户取消流程释放代理绑定与计费预占
+func (s *DownloaderServer) ForceFailTask(ctx context.Context, req *pb.ForceFailTaskRequest) (*pb.TaskActionResponse, error) {
+	if req.TaskId == "" {
+		return nil, status.Error(codes.InvalidArgument, "task_id is required")
+	}
+
+	record, err := s.repo.FindByTaskID(ctx, req.TaskId)
+	if err != nil {
+		s.logger.Error("ForceFailTask lookup failed", zap.String("task_id", req.TaskId), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to query task")
+	}
+	if record == nil {
+		return nil, status.Error(codes.NotFound, "task not found")
+	}
+	if record.Status != models.StatusPending && record.Status != models.StatusProcessing {
+		return &pb.TaskActionResponse{
+			Success: false,
+			Message: "任务已结束，无需强制失败",
+		}, nil
+	}
+
+	errorMsg := forceFailMessage
+	if req.Reason != "" {
+		errorMsg = forceFailMessage + ": " + req.Reason
+	}
+	running, err := s.terminateTask(ctx, record, errorMsg, "任务已被管理员终止", "admin_force_fail")
+	if errors.Is(err, sql.ErrNoRows) {
+		return &pb.TaskActionResponse{
+			Success: false,
+			Message: "任务已结束，无需强制失败",
+		}, nil
+	}
+	if err != nil {
+		s.logger.Error("ForceFailTask update failed", zap.String("task_id", req.TaskId), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to fail task")
+	}
+
+	s.logger.Info("Task force-failed",
+		zap.String("task_id", req.TaskId),
+		zap.String("operator_user_id", req.OperatorUserId),
+		zap.Bool("running_locally", running),
+	)
+	return &pb.TaskActionResponse{
+		Success: true,
+		Message: "任务已标记为失败",
+	}, nil
+}
+
+// PurgeTask 删除失败任务的记录并释放残留的代理绑定，工作目录由清理调度器按 WorkTTL 回收
+func (s *DownloaderServer) PurgeTask(ctx context.Context, req *pb.PurgeTaskRequest) (*pb.TaskActionResponse, error) {
+	if req.TaskId == "" {
+		return nil, status.Error(codes.InvalidArgument, "task_id is required")
+	}
+
+	if err := s.repo.PurgeFailed(ctx, req.TaskId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.FailedPrecondition, "only failed tasks can be purged")
+		}
+		s.logger.Error("PurgeTask failed", zap.String("task_id", req.TaskId), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to purge task")
+	}
+	if s.assetClient != nil {
+		if err := s.assetClient.ReleaseProxyForTask(req.TaskId, "task_purged"); err != nil {
+			s.logger.Warn("Failed to release proxy", zap.String("task_id", req.TaskId), zap.Error(err))
+		}
+	}
+
+	s.logger.Info("Task purged", zap.String("task_id", req.TaskId), zap.String("operator_user_id", req.OperatorUserId))
+	return &pb.TaskActionResponse{
+		Success: true,
+		Message: "任务记录已删除",
+	}, nil
+}
+
+func stuckAfter(seconds int64) time.Duration {
+	if seconds <= 0 {
+		return defaultStuckAfter
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func isStuck(record *models.DownloadHistory, stuckBefore time.Time) bool {
+	return record.Status == models.StatusProcessing &&
+		record.StartedAt.Valid &&
+		record.StartedAt.Time.Before(stuckBefore)
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dlclient "youdlp/media-service/internal/download/client"
+	"youdlp/media-service/internal/download/models"
+	pb "youdlp/media-service/proto"
+)
+
+func TestReplayTaskRequeuesWithFreshProxyLease(t *testing.T) {
+	repo := &fakeDownloadRepo{
+		record:  &models.DownloadHistory{TaskID: "task-1", UserID: "user-1", Status: models.StatusFailed},
+		payload: []byte(`{"task_id":"task-1","platform":"youtube","proxy_url":"http://old:8080","proxy_lease_id":"old"}`),
+	}
+	queue := &fakeTaskQueue{}
+	asset := &fakeDownloadAssetClient{lease: &dlclient.ProxyLease{URL: "http://new:8080", LeaseID: "lease-2"}}
+	server := NewDownloaderServer(repo, &fakeProgressStore{}, &fakeCanceller{}, nil, queue, asset, nil, nil, zap.NewNop())
+
+	resp, err := server.ReplayTask(context.Background(), &pb.ReplayTaskRequest{TaskId: "task-1"})
+	if err != nil {
+		t.Fatalf("ReplayTask returned error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected success, got %+v", resp)
+	}
+	if repo.resetTaskID != "task-1" {
+		t.Fatalf("expected task reset, got %q", repo.resetTaskID)
+	}
+	if len(queue.requeued) != 1 {
+		t.Fatalf("expected one requeued task, got %d", len(queue.requeued))
+	}
+	task := queue.requeued[0]
+	if task.ProxyURL != "http://new:8080" || task.ProxyLeaseID != "lease-2" {
+		t.Fatalf("expected fresh proxy lease, got %s/%s", task.ProxyURL, task.ProxyLeaseID)
+	}
+	if task.ReplayedAt().IsZero() {
+		t.Fatal("expected replay time to be recorded")
+	}
+}
+
+func TestReplayTaskRejectsRecentProcessingTask(t *testing.T) {
+	repo := &fakeDownloadRepo{
+		record: &models.DownloadHistory{
+			TaskID:    "task-1",
+			Status:    models.StatusProcessing,
+			StartedAt: sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true},
+		},
+		payload: []byte(`{"task_id":"task-1"}`),
+	}
+	queue := &fakeTaskQueue{}
+	server := NewDownloaderServer(repo, &fakeProgressStore{}, &fakeCanceller{}, nil, queue, nil, nil, nil, zap.NewNop())
+
+	_, err := server.ReplayTask(context.Background(), &pb.ReplayTaskRequest{TaskId: "task-1"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+	if len(queue.requeued) != 0 {
+		t.Fatalf("expected no requeue, got %d", len(queue.requeued))
+	}
+}
+
+func TestReplayTaskCancelsStuckTaskBeforeRequeue(t *testing.T) {
+	repo := &fakeDownloadRepo{
+		record: &models.DownloadHistory{
+			TaskID:    "task-1",
+			Status:    models.StatusProcessing,
+			StartedAt: sql.NullTime{Time: time.Now().Add(-2 * time.Hour), Valid: true},
+		},
+		payload: []byte(`{"task_id":"task-1"}`),
+	}
+	canceller := &fakeCanceller{}
+	queue := &fakeTaskQueue{}
+	server := NewDownloaderServer(repo, &fakeProgressStore{}, canceller, nil, queue, nil, nil, nil, zap.NewNop())
+
+	if _, err := server.ReplayTask(context.Background(), &pb.ReplayTaskRequest{TaskId: "task-1"}); err != nil {
+		t.Fatalf("ReplayTask returned error: %v", err)
+	}
+	if len(canceller.cancelled) != 1 || len(queue.requeued) != 1 {
+		t.Fatalf("expected stuck task to be cancelled then requeued, got cancelled=%v requeued=%d", canceller.cancelled, len(queue.requeued))
+	}
+}
+
+func TestForceFailTaskReleasesHolds(t *testing.T) {
+	repo := &fakeDownloadRepo{record: &models.DownloadHistory{TaskID: "task-1", UserID: "user-1", Status: models.StatusProcessing}}
+	asset := &fakeDownloadAssetClient{}
+	server := NewDownloaderServer(repo, &fakeProgressStore{}, &fakeCanceller{}, nil, nil, asset, nil, nil, zap.NewNop())
+
+	resp, err := server.ForceFailTask(context.Background(), &pb.ForceFailTaskRequest{TaskId: "task-1", Reason: "stuck"})
+	if err != nil {
+		t.Fatalf("ForceFailTask returned error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected success, got %+v", resp)
+	}
+	if repo.failedMsg != forceFailMessage+": stuck" {
+		t.Fatalf("unexpected failure message: %q", repo.failedMsg)
+	}
+	if len(asset.releasedProxy) != 1 || len(asset.releasedHold) != 1 {
+		t.Fatalf("expected proxy and billing hold to be released, got %+v", asset)
+	}
+}
+
+func TestPurgeTaskOnlyRemovesFailedTasks(t *testing.T) {
+	repo := &fakeDownloadRepo{record: &models.DownloadHistory{TaskID: "task-1", Status: models.StatusCompleted}}
+	server := NewDownloaderServer(repo, &fakeProgressStore{}, &fakeCanceller{}, nil, nil, nil, nil, nil, zap.NewNop())
+
+	_, err := server.PurgeTask(context.Background(), &pb.PurgeTaskRequest{TaskId: "task-1"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+	if repo.purgedTaskID != "" {
+		t.Fatalf("expected completed task to be kept")
+	}
+}
+
+func TestListTasksRejectsUnknownState(t *testing.T) {
+	server := NewDownloaderServer(&fakeDownloadRepo{}, &fakeProgressStore{}, &fakeCanceller{}, nil, nil, nil, nil, nil, zap.NewNop())
+
+	_, err := server.ListTasks(context.Background(), &pb.ListTasksRequest{State: "completed"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
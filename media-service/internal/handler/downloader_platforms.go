@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"youdlp/media-service/internal/platform"
+	pb "youdlp/media-service/proto"
+)
+
+// ListPlatforms 返回平台注册表及各平台启用状态
+func (s *DownloaderServer) ListPlatforms(_ context.Context, _ *pb.ListPlatformsRequest) (*pb.ListPlatformsResponse, error) {
+	if s.platforms == nil {
+		return nil, status.Error(codes.Unavailable, "platform registry is not available")
+	}
+
+	infos := s.platforms.List()
+	platforms := make([]*pb.PlatformInfo, 0, len(infos))
+	for i := range infos {
+		platforms = append(platforms, platformToProto(&infos[i]))
+	}
+	return &pb.ListPlatformsResponse{Platforms: platforms}, nil
+}
+
+// UpdatePlatform 启用或禁用平台
+func (s *DownloaderServer) UpdatePlatform(ctx context.Context, req *pb.UpdatePlatformRequest) (*pb.UpdatePlatformResponse, error) {
+	if s.platforms == nil {
+		return nil, status.Error(codes.Unavailable, "platform registry is not available")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	info, err := s.platforms.SetEnabled(ctx, req.Name, req.Enabled, req.OperatorUserId)
+	if err != nil {
+		if errors.Is(err, platform.ErrUnknownPlatform) {
+			return nil, status.Error(codes.NotFound, "platform not found")
+		}
+		s.logger.Error("UpdatePlatform failed", zap.String("platform", req.Name), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to update platform")
+	}
+
+	s.logger.Info("Platform updated",
+		zap.String("platform", info.Name),
+		zap.Bool("enabled", info.Enabled),
+		zap.String("operator_user_id", req.OperatorUserId),
+	)
+	return &pb.UpdatePlatformResponse{Platform: platformToProto(info)}, nil
+}
+
+func platformToProto(info *platform.Info) *pb.PlatformInfo {
+	return &pb.PlatformInfo{
+		Name:              info.Name,
+		DisplayName:       info.DisplayName,
+		Enabled:           info.Enabled,
+		Patterns:          info.Patterns,
+		Adapter:           info.Adapter,
+		ExtraArgs:         info.ExtraArgs,
+		Cookies:           info.Cookies,
+		Proxy:             info.Proxy,
+		ParsePerMinute:    info.RateLimit.ParsePerMinute,
+		DownloadPerMinute: info.RateLimit.DownloadPerMinute,
+	}
+}
@@ -0,0 +1,349 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dlclient "youdlp/media-service/internal/download/client"
+	"youdlp/media-service/internal/download/models"
+	dlworker "youdlp/media-service/internal/download/worker"
+	"youdlp/media-service/internal/platform"
+	pb "youdlp/media-service/proto"
+)
+
+const timeLayout = "2006-01-02 15:04:05"
+
+// queueThroughputWindow 估算排队等待时间时统计出队速率的时间窗口
+const queueThroughputWindow = 10 * time.Minute
+
+// downloadRepository DownloaderServer 依赖的下载记录仓储
+type downloadRepository interface {
+	FindByTaskID(ctx context.Context, taskID string) (*models.DownloadHistory, error)
+	FindByUserID(ctx context.Context, userID string, page, pageSize int, status *int) ([]*models.DownloadHistory, int64, error)
+	UpdateFailed(ctx context.Context, taskID, errorMsg string, retryCount int) error
+	ListAdminTasks(ctx context.Context, filter *models.AdminTaskFilter) ([]*models.DownloadHistory, int64, error)
+	FindTaskPayload(ctx context.Context, taskID string) ([]byte, error)
+	ResetForReplay(ctx context.Context, taskID string) error
+	PurgeFailed(ctx context.Context, taskID string) error
+	QueuePosition(ctx context.Context, taskID string) (int64, error)
+	CountStartedSince(ctx context.Context, since time.Time) (int64, error)
+}
+
+// progressStore 最新进度快照读取与取消事件发布
+type progressStore interface {
+	Latest(ctx context.Context, taskID string) (*models.ProgressMessage, error)
+	Track(ctx context.Context, taskID, userID string)
+	PublishCancelled(ctx context.Context, taskID, message string) error
+}
+
+// taskCanceller 本实例 Worker 池
+type taskCanceller interface {
+	CancelTask(taskID string) bool
+}
+
+// cancelBroadcaster 跨实例取消广播
+type cancelBroadcaster interface {
+	Broadcast(ctx context.Context, taskID string) error
+}
+
+// TaskQueue 任务队列，管理端重放时重新投递任务
+type TaskQueue interface {
+	Requeue(ctx context.Context, task *models.DownloadTask) error
+}
+
+// DownloadAssetClient 取消、重放任务时需要回调的 Asset 服务能力
+type DownloadAssetClient interface {
+	AcquireProxyForTask(ctx context.Context, taskID, platform string) (*dlclient.ProxyLease, error)
+	ReleaseProxyForTask(taskID, reason string) error
+	ReleaseInitialDownload(taskID, reason string) error
+	UpdateHistoryFailed(taskID, errorMessage string) error
+}
+
+// WorkerPools 所有 media-service 实例的 Worker 池状态与运行时控制
+type WorkerPools interface {
+	Snapshots(ctx context.Context) ([]*dlworker.PoolSnapshot, error)
+	Broadcast(ctx context.Context, cmd *dlworker.PoolCommand) error
+}
+
+// PlatformSettings 平台注册表查询与启用状态切换
+type PlatformSettings interface {
+	List() []platform.Info
+	SetEnabled(ctx context.Context, name string, enabled bool, operatorUserID string) (*platform.Info, error)
+}
+
+// DownloaderServer 下载任务 gRPC 服务
+type DownloaderServer struct {
+	pb.UnimplementedDownloaderServiceServer
+	repo        downloadRepository
+	progress    progressStore
+	canceller   taskCanceller
+	bus         cancelBroadcaster
+	queue       TaskQueue
+	assetClient DownloadAssetClient
+	pools       WorkerPools
+	platforms   PlatformSettings
+	logger      *zap.Logger
+}
+
+// NewDownloaderServer 创建下载任务 gRPC 服务，bus、queue、assetClient、pools 与 platforms 可为空
+func NewDownloaderServer(
+	repo downloadRepository,
+	progress progressStore,
+	canceller taskCanceller,
+	bus cancelBroadcaster,
+	queue TaskQueue,
+	assetClient DownloadAssetClient,
+	pools WorkerPools,
+	platforms PlatformSettings,
+	logger *zap.Logger,
+) *DownloaderServer {
+	return &DownloaderServer{
+		repo:        repo,
+		progress:    progress,
+		canceller:   canceller,
+		bus:         bus,
+		queue:       queue,
+		assetClient: assetClient,
+		pools:       pools,
+		platforms:   platforms,
+		logger:      logger,
+	}
+}
+
+// GetTaskStatus 查询任务状态（数据库记录 + 最新进度快照）
+func (s *DownloaderServer) GetTaskStatus(ctx context.Context, req *pb.GetTaskStatusRequest) (*pb.GetTaskStatusResponse, error) {
+	if req.TaskId == "" {
+		return nil, status.Error(codes.InvalidArgument, "task_id is required")
+	}
+
+	record, err := s.repo.FindByTaskID(ctx, req.TaskId)
+	if err != nil {
+		s.logger.Error("GetTaskStatus failed", zap.String("task_id", req.TaskId), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to query task")
+	}
+	if record == nil {
+		return nil, status.Error(codes.NotFound, "task not found")
+	}
+	if req.UserId != "" && record.UserID != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "task does not belong to user")
+	}
+
+	resp := &pb.GetTaskStatusResponse{
+		TaskId:       record.TaskID,
+		Status:       int32(record.Status),
+		StatusText:   models.StatusText[record.Status],
+		FilePath:     record.FilePath.String,
+		ErrorMessage: record.ErrorMessage.String,
+		CreatedAt:    record.CreatedAt.Format(timeLayout),
+		CompletedAt:  formatNullTime(record.CompletedAt),
+	}
+	if record.Status == models.StatusCompleted {
+		resp.Percent = 100
+	}
+
+	if s.progress != nil {
+		snapshot, err := s.progress.Latest(ctx, req.TaskId)
+		if err != nil {
+			s.logger.Warn("Failed to load progress snapshot", zap.String("task_id", req.TaskId), zap.Error(err))
+		} else if snapshot != nil {
+			if record.Status == models.StatusProcessing || record.Status == models.StatusPending {
+				resp.Percent = snapshot.Percent
+			}
+			resp.DownloadedBytes = snapshot.DownloadedBytes
+			resp.TotalBytes = snapshot.TotalBytes
+			resp.Speed = snapshot.Speed
+			resp.Eta = snapshot.ETA
+		}
+	}
+
+	if record.Status == models.StatusPending {
+		s.fillQueueEstimate(ctx, resp)
+	}
+
+	return resp, nil
+}
+
+// fillQueueEstimate 填充待处理任务的排队位置，并按近期出队速率估算开始前的等待时间
+func (s *DownloaderServer) fillQueueEstimate(ctx context.Context, resp *pb.GetTaskStatusResponse) {
+	position, err := s.repo.QueuePosition(ctx, resp.TaskId)
+	if err != nil {
+		s.logger.Warn("Failed to query queue position", zap.String("task_id", resp.TaskId), zap.Error(err))
+		return
+	}
+	resp.QueuePosition = position
+	if position == 0 {
+		return
+	}
+
+	started, err := s.repo.CountStartedSince(ctx, time.Now().Add(-queueThroughputWindow))
+	if err != nil {
+		s.logger.Warn("Failed to query queue throughput", zap.String("task_id", resp.TaskId), zap.Error(err))
+		return
+	}
+	resp.EstimatedStartSeconds = estimateQueueWait(position, started, queueThroughputWindow)
+}
+
+// estimateQueueWait 按窗口内开始执行的任务数估算排在 position 的任务还需等待的秒数，窗口内无任务开始时返回 0
+func estimateQueueWait(position, started int64, window time.Duration) int64 {
+	if position <= 0 || started <= 0 {
+		return 0
+	}
+	return int64(math.Ceil(float64(position) * window.Seconds() / float64(started)))
+}
+
+// GetDownloadHistory 分页查询用户下载记录
+func (s *DownloaderServer) GetDownloadHistory(ctx context.Context, req *pb.GetDownloadHistoryRequest) (*pb.GetDownloadHistoryResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	// status 为 0 时与"待处理"冲突，这里约定 0 表示不过滤
+	var statusFilter *int
+	if req.Status > 0 {
+		v := int(req.Status)
+		statusFilter = &v
+	}
+
+	records, total, err := s.repo.FindByUserID(ctx, req.UserId, page, pageSize, statusFilter)
+	if err != nil {
+		s.logger.Error("GetDownloadHistory failed", zap.String("user_id", req.UserId), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to query history")
+	}
+
+	items := make([]*pb.DownloadRecord, 0, len(records))
+	for _, r := range records {
+		items = append(items, &pb.DownloadRecord{
+			Id:           r.ID,
+			TaskId:       r.TaskID,
+			UserId:       r.UserID,
+			Url:          r.URL,
+			Platform:     r.Platform,
+			Title:        r.Title,
+			Mode:         r.Mode,
+			Quality:      r.Quality,
+			FilePath:     r.FilePath.String,
+			FileName:     r.FileName.String,
+			FileSize:     r.FileSize.Int64,
+			FileHash:     r.FileHash.String,
+			Status:       int32(r.Status),
+			ErrorMessage: r.ErrorMessage.String,
+			CreatedAt:    r.CreatedAt.Format(timeLayout),
+			CompletedAt:  formatNullTime(r.CompletedAt),
+			ExpireAt:     formatNullTime(r.ExpireAt),
+		})
+	}
+
+	return &pb.GetDownloadHistoryResponse{
+		Records:  items,
+		Total:    total,
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}, nil
+}
+
+// CancelTask 取消待处理或下载中的任务
+func (s *DownloaderServer) CancelTask(ctx context.Context, req *pb.CancelTaskRequest) (*pb.CancelTaskResponse, error) {
+	if req.TaskId == "" || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "task_id and user_id are required")
+	}
+
+	record, err := s.repo.FindByTaskID(ctx, req.TaskId)
+	if err != nil {
+		s.logger.Error("CancelTask lookup failed", zap.String("task_id", req.TaskId), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to query task")
+	}
+	if record == nil {
+		return nil, status.Error(codes.NotFound, "task not found")
+	}
+	if record.UserID != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "task does not belong to user")
+	}
+	if record.Status != models.StatusPending && record.Status != models.StatusProcessing {
+		return &pb.CancelTaskResponse{
+			Success: false,
+			Message: "任务已结束，无法取消",
+		}, nil
+	}
+
+	running, err := s.terminateTask(ctx, record, dlworker.ErrTaskCancelled.Error(), "任务已取消", "task_cancelled")
+	if errors.Is(err, sql.ErrNoRows) {
+		// 查询后任务已完成或已被终止
+		return &pb.CancelTaskResponse{
+			Success: false,
+			Message: "任务已结束，无法取消",
+		}, nil
+	}
+	if err != nil {
+		s.logger.Error("CancelTask update failed", zap.String("task_id", req.TaskId), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to cancel task")
+	}
+
+	s.logger.Info("Task cancelled", zap.String("task_id", req.TaskId), zap.Bool("running_locally", running))
+	return &pb.CancelTaskResponse{
+		Success: true,
+		Message: "任务已取消",
+	}, nil
+}
+
+// terminateTask 终止任务并标记失败：停止本实例及其他实例上的执行、通知前端，并释放代理绑定与计费预占。
+// 任务在此期间已结束时返回 sql.ErrNoRows，不产生任何副作用
+func (s *DownloaderServer) terminateTask(ctx context.Context, record *models.DownloadHistory, errorMsg, notice, reason string) (bool, error) {
+	taskID := record.TaskID
+
+	// 1. 先按状态条件标记失败，与 Worker 收尾竞争终态；Worker 出队时据此跳过
+	if err := s.repo.UpdateFailed(ctx, taskID, errorMsg, record.RetryCount); err != nil {
+		return false, err
+	}
+
+	// 2. 终止本实例上的任务，并通知其他实例
+	running := s.canceller.CancelTask(taskID)
+	if s.bus != nil {
+		if err := s.bus.Broadcast(ctx, taskID); err != nil {
+			s.logger.Warn("Failed to broadcast cancel", zap.String("task_id", taskID), zap.Error(err))
+		}
+	}
+
+	// 3. 通知前端并释放计费预占与代理绑定
+	if s.progress != nil {
+		s.progress.Track(ctx, taskID, record.UserID)
+		if err := s.progress.PublishCancelled(ctx, taskID, notice); err != nil {
+			s.logger.Warn("Failed to publish cancelled status", zap.String("task_id", taskID), zap.Error(err))
+		}
+	}
+	if s.assetClient != nil {
+		if err := s.assetClient.ReleaseProxyForTask(taskID, reason); err != nil {
+			s.logger.Warn("Failed to release proxy", zap.String("task_id", taskID), zap.Error(err))
+		}
+		if err := s.assetClient.ReleaseInitialDownload(taskID, reason); err != nil {
+			s.logger.Warn("Failed to release initial download hold", zap.String("task_id", taskID), zap.Error(err))
+		}
+		if err := s.assetClient.UpdateHistoryFailed(taskID, errorMsg); err != nil {
+			s.logger.Warn("Failed to sync failed history", zap.String("task_id", taskID), zap.Error(err))
+		}
+	}
+
+	return running, nil
+}
+
+func formatNullTime(t sql.NullTime) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.Format(timeLayout)
+}
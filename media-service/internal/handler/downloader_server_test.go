@@ -0,0 +1,264 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dlclient "youdlp/media-service/internal/download/client"
+	"youdlp/media-service/internal/download/models"
+	dlworker "youdlp/media-service/internal/download/worker"
+	pb "youdlp/media-service/proto"
+)
+
+type fakeDownloadRepo struct {
+	record       *models.DownloadHistory
+	failedTaskID string
+	failedMsg    string
+	payload      []byte
+	resetTaskID  string
+	purgedTaskID string
+	adminFilter  *models.AdminTaskFilter
+	position     int64
+	started      int64
+	// finishedConcurrently 模拟查询后任务已被 Worker 收尾，条件更新不再命中
+	finishedConcurrently bool
+}
+
+func (f *fakeDownloadRepo) FindByTaskID(context.Context, string) (*models.DownloadHistory, error) {
+	return f.record, nil
+}
+
+func (f *fakeDownloadRepo) FindByUserID(context.Context, string, int, int, *int) ([]*models.DownloadHistory, int64, error) {
+	return nil, 0, nil
+}
+
+func (f *fakeDownloadRepo) UpdateFailed(_ context.Context, taskID, errorMsg string, _ int) error {
+	if f.finishedConcurrently {
+		return sql.ErrNoRows
+	}
+	f.failedTaskID = taskID
+	f.failedMsg = errorMsg
+	return nil
+}
+
+func (f *fakeDownloadRepo) ListAdminTasks(_ context.Context, filter *models.AdminTaskFilter) ([]*models.DownloadHistory, int64, error) {
+	f.adminFilter = filter
+	if f.record == nil {
+		return nil, 0, nil
+	}
+	return []*models.DownloadHistory{f.record}, 1, nil
+}
+
+func (f *fakeDownloadRepo) FindTaskPayload(context.Context, string) ([]byte, error) {
+	return f.payload, nil
+}
+
+func (f *fakeDownloadRepo) ResetForReplay(_ context.Context, taskID string) error {
+	f.resetTaskID = taskID
+	return nil
+}
+
+func (f *fakeDownloadRepo) QueuePosition(context.Context, string) (int64, error) {
+	return f.position, nil
+}
+
+func (f *fakeDownloadRepo) CountStartedSince(context.Context, time.Time) (int64, error) {
+	return f.started, nil
+}
+
+func (f *fakeDownloadRepo) PurgeFailed(_ context.Context, taskID string) error {
+	if f.record == nil || f.record.Status != models.StatusFailed {
+		return sql.ErrNoRows
+	}
+	f.purgedTaskID = taskID
+	return nil
+}
+
+type fakeProgressStore struct {
+	latest    *models.ProgressMessage
+	cancelled []string
+}
+
+func (f *fakeProgressStore) Latest(context.Context, string) (*models.ProgressMessage, error) {
+	return f.latest, nil
+}
+
+func (f *fakeProgressStore) Track(context.Context, string, string) {}
+
+func (f *fakeProgressStore) PublishCancelled(_ context.Context, taskID, _ string) error {
+	f.cancelled = append(f.cancelled, taskID)
+	return nil
+}
+
+type fakeCanceller struct {
+	cancelled []string
+}
+
+func (f *fakeCanceller) CancelTask(taskID string) bool {
+	f.cancelled = append(f.cancelled, taskID)
+	return true
+}
+
+type fakeTaskQueue struct {
+	requeued []*models.DownloadTask
+}
+
+func (f *fakeTaskQueue) Requeue(_ context.Context, task *models.DownloadTask) error {
+	f.requeued = append(f.requeued, task)
+	return nil
+}
+
+type fakeDownloadAssetClient struct {
+	lease           *dlclient.ProxyLease
+	acquired        []string
+	releasedProxy   []string
+	releasedHold    []string
+	historyFailures []string
+}
+
+func (f *fakeDownloadAssetClient) AcquireProxyForTask(_ context.Context, taskID, _ string) (*dlclient.ProxyLease, error) {
+	f.acquired = append(f.acquired, taskID)
+	return f.lease, nil
+}
+
+func (f *fakeDownloadAssetClient) ReleaseProxyForTask(taskID, _ string) error {
+	f.releasedProxy = append(f.releasedProxy, taskID)
+	return nil
+}
+
+func (f *fakeDownloadAssetClient) ReleaseInitialDownload(taskID, _ string) error {
+	f.releasedHold = append(f.releasedHold, taskID)
+	return nil
+}
+
+func (f *fakeDownloadAssetClient) UpdateHistoryFailed(taskID, _ string) error {
+	f.historyFailures = append(f.historyFailures, taskID)
+	return nil
+}
+
+func TestCancelTaskCancelsRunningTaskAndReleasesHolds(t *testing.T) {
+	repo := &fakeDownloadRepo{record: &models.DownloadHistory{TaskID: "task-1", UserID: "user-1", Status: models.StatusProcessing}}
+	progress := &fakeProgressStore{}
+	canceller := &fakeCanceller{}
+	asset := &fakeDownloadAssetClient{}
+	server := NewDownloaderServer(repo, progress, canceller, nil, nil, asset, nil, nil, zap.NewNop())
+
+	resp, err := server.CancelTask(context.Background(), &pb.CancelTaskRequest{TaskId: "task-1", UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("CancelTask returned error: %v", err)
+	}
+	if !resp.Success {
+		t.Fatalf("expected success, got %+v", resp)
+	}
+	if len(canceller.cancelled) != 1 || canceller.cancelled[0] != "task-1" {
+		t.Fatalf("expected worker cancel for task-1, got %v", canceller.cancelled)
+	}
+	if repo.failedMsg != dlworker.ErrTaskCancelled.Error() {
+		t.Fatalf("expected cancelled failure message, got %q", repo.failedMsg)
+	}
+	if len(progress.cancelled) != 1 {
+		t.Fatalf("expected cancelled progress event, got %v", progress.cancelled)
+	}
+	if len(asset.releasedProxy) != 1 || len(asset.releasedHold) != 1 || len(asset.historyFailures) != 1 {
+		t.Fatalf("expected asset callbacks, got %+v", asset)
+	}
+}
+
+func TestCancelTaskRejectsOtherUsersTask(t *testing.T) {
+	repo := &fakeDownloadRepo{record: &models.DownloadHistory{TaskID: "task-1", UserID: "user-1", Status: models.StatusPending}}
+	canceller := &fakeCanceller{}
+	server := NewDownloaderServer(repo, &fakeProgressStore{}, canceller, nil, nil, nil, nil, nil, zap.NewNop())
+
+	_, err := server.CancelTask(context.Background(), &pb.CancelTaskRequest{TaskId: "task-1", UserId: "user-2"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied, got %v", err)
+	}
+	if len(canceller.cancelled) != 0 {
+		t.Fatalf("expected no cancel, got %v", canceller.cancelled)
+	}
+}
+
+func TestCancelTaskIgnoresFinishedTask(t *testing.T) {
+	repo := &fakeDownloadRepo{record: &models.DownloadHistory{TaskID: "task-1", UserID: "user-1", Status: models.StatusCompleted}}
+	canceller := &fakeCanceller{}
+	server := NewDownloaderServer(repo, &fakeProgressStore{}, canceller, nil, nil, nil, nil, nil, zap.NewNop())
+
+	resp, err := server.CancelTask(context.Background(), &pb.CancelTaskRequest{TaskId: "task-1", UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("CancelTask returned error: %v", err)
+	}
+	if resp.Success {
+		t.Fatalf("expected finished task to be rejected")
+	}
+	if len(canceller.cancelled) != 0 || repo.failedTaskID != "" {
+		t.Fatalf("expected no side effects for finished task")
+	}
+}
+
+func TestCancelTaskLosesRaceToCompletion(t *testing.T) {
+	repo := &fakeDownloadRepo{
+		record:               &models.DownloadHistory{TaskID: "task-1", UserID: "user-1", Status: models.StatusProcessing},
+		finishedConcurrently: true,
+	}
+	progress := &fakeProgressStore{}
+	canceller := &fakeCanceller{}
+	asset := &fakeDownloadAssetClient{}
+	server := NewDownloaderServer(repo, progress, canceller, nil, nil, asset, nil, nil, zap.NewNop())
+
+	resp, err := server.CancelTask(context.Background(), &pb.CancelTaskRequest{TaskId: "task-1", UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("CancelTask returned error: %v", err)
+	}
+	if resp.Success {
+		t.Fatalf("expected cancel of a task finished concurrently to be rejected")
+	}
+	if len(canceller.cancelled) != 0 || len(progress.cancelled) != 0 {
+		t.Fatalf("expected no cancel side effects, got cancelled=%v events=%v", canceller.cancelled, progress.cancelled)
+	}
+	if len(asset.releasedProxy) != 0 || len(asset.releasedHold) != 0 || len(asset.historyFailures) != 0 {
+		t.Fatalf("expected no asset callbacks, got %+v", asset)
+	}
+}
+
+func TestGetTaskStatusReportsQueuePosition(t *testing.T) {
+	repo := &fakeDownloadRepo{
+		record:   &models.DownloadHistory{TaskID: "task-1", UserID: "user-1", Status: models.StatusPending},
+		position: 12,
+		started:  60,
+	}
+	server := NewDownloaderServer(repo, &fakeProgressStore{}, &fakeCanceller{}, nil, nil, nil, nil, nil, zap.NewNop())
+
+	resp, err := server.GetTaskStatus(context.Background(), &pb.GetTaskStatusRequest{TaskId: "task-1", UserId: "user-1"})
+	if err != nil {
+		t.Fatalf("GetTaskStatus returned error: %v", err)
+	}
+	if resp.QueuePosition != 12 {
+		t.Fatalf("expected queue position 12, got %d", resp.QueuePosition)
+	}
+	// 10 分钟内开始 60 个任务，平均每 10 秒出队一个
+	if resp.EstimatedStartSeconds != 120 {
+		t.Fatalf("expected 120s estimated wait, got %d", resp.EstimatedStartSeconds)
+	}
+}
+
+func TestGetTaskStatusRejectsOtherUsersTask(t *testing.T) {
+	repo := &fakeDownloadRepo{record: &models.DownloadHistory{TaskID: "task-1", UserID: "user-1", Status: models.StatusPending}}
+	server := NewDownloaderServer(repo, &fakeProgressStore{}, &fakeCanceller{}, nil, nil, nil, nil, nil, zap.NewNop())
+
+	_, err := server.GetTaskStatus(context.Background(), &pb.GetTaskStatusRequest{TaskId: "task-1", UserId: "user-2"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied, got %v", err)
+	}
+}
+
+func TestEstimateQueueWaitWithoutThroughput(t *testing.T) {
+	if got := estimateQueueWait(5, 0, queueThroughputWindow); got != 0 {
+		t.Fatalf("expected unknown wait without throughput, got %d", got)
+	}
+}
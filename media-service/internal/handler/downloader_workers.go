@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dlworker "youdlp/media-service/internal/download/worker"
+	pb "youdlp/media-service/proto"
+)
+
+// GetWorkerPools 汇总所有实例最近上报的 Worker 池状态
+func (s *DownloaderServer) GetWorkerPools(ctx context.Context, _ *pb.GetWorkerPoolsRequest) (*pb.GetWorkerPoolsResponse, error) {
+	if s.pools == nil {
+		return nil, status.Error(codes.Unavailable, "worker pool control is not available")
+	}
+
+	snapshots, err := s.pools.Snapshots(ctx)
+	if err != nil {
+		s.logger.Error("GetWorkerPools failed", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to query worker pools")
+	}
+
+	instances := make([]*pb.WorkerPoolStatus, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		instances = append(instances, workerPoolToProto(snapshot))
+	}
+	return &pb.GetWorkerPoolsResponse{Instances: instances}, nil
+}
+
+// UpdateWorkerPool 向目标实例广播并发调整或暂停、恢复消费命令，目标实例须已上报过状态
+func (s *DownloaderServer) UpdateWorkerPool(ctx context.Context, req *pb.UpdateWorkerPoolRequest) (*pb.TaskActionResponse, error) {
+	if s.pools == nil {
+		return nil, status.Error(codes.Unavailable, "worker pool control is not available")
+	}
+
+	cmd := &dlworker.PoolCommand{
+		InstanceID:    req.InstanceId,
+		Action:        req.Action,
+		MaxConcurrent: int(req.MaxConcurrent),
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.pools.Broadcast(ctx, cmd); err != nil {
+		if errors.Is(err, dlworker.ErrUnknownInstance) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		s.logger.Error("UpdateWorkerPool failed", zap.String("action", req.Action), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to update worker pool")
+	}
+
+	s.logger.Info("Worker pool updated",
+		zap.String("instance_id", req.InstanceId),
+		zap.String("action", req.Action),
+		zap.Int32("max_concurrent", req.MaxConcurrent),
+		zap.String("operator_user_id", req.OperatorUserId),
+	)
+	return &pb.TaskActionResponse{Success: true, Message: "worker pool command sent"}, nil
+}
+
+func workerPoolToProto(snapshot *dlworker.PoolSnapshot) *pb.WorkerPoolStatus {
+	queues := make([]*pb.WorkerQueueDepth, 0, len(snapshot.Queues))
+	for _, q := range snapshot.Queues {
+		queues = append(queues, &pb.WorkerQueueDepth{
+			Queue:     q.Queue,
+			Platform:  q.Platform,
+			Messages:  int64(q.Messages),
+			Consumers: int32(q.Consumers),
+			Paused:    q.Paused,
+		})
+	}
+
+	tasks := make([]*pb.WorkerActiveTask, 0, len(snapshot.ActiveTasks))
+	for _, t := range snapshot.ActiveTasks {
+		tasks = append(tasks, &pb.WorkerActiveTask{
+			TaskId:    t.TaskID,
+			UserId:    t.UserID,
+			Platform:  t.Platform,
+			Phase:     t.Phase,
+			Percent:   t.Percent,
+			StartedAt: t.StartedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &pb.WorkerPoolStatus{
+		InstanceId:     snapshot.InstanceID,
+		PoolSize:       int32(snapshot.PoolSize),
+		MaxConcurrent:  int32(snapshot.MaxConcurrent),
+		Running:        int32(snapshot.Running),
+		Buffered:       int32(snapshot.Buffered),
+		BufferCapacity: int32(snapshot.BufferCapacity),
+		Paused:         snapshot.Paused,
+		Queues:         queues,
+		ActiveTasks:    tasks,
+		UpdatedAt:      snapshot.UpdatedAt.Format(time.RFC3339),
+	}
+}
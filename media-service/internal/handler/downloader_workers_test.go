@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dlworker "youdlp/media-service/internal/download/worker"
+	pb "youdlp/media-service/proto"
+)
+
+type fakeWorkerPools struct {
+	snapshots []*dlworker.PoolSnapshot
+	commands  []*dlworker.PoolCommand
+	known     map[string]bool // 为空时接受任意实例
+}
+
+func (f *fakeWorkerPools) Snapshots(context.Context) ([]*dlworker.PoolSnapshot, error) {
+	return f.snapshots, nil
+}
+
+func (f *fakeWorkerPools) Broadcast(_ context.Context, cmd *dlworker.PoolCommand) error {
+	if f.known != nil && cmd.InstanceID != "" && !f.known[cmd.InstanceID] {
+		return fmt.Errorf("%w: %s", dlworker.ErrUnknownInstance, cmd.InstanceID)
+	}
+	f.commands = append(f.commands, cmd)
+	return nil
+}
+
+func TestGetWorkerPoolsMapsSnapshots(t *testing.T) {
+	pools := &fakeWorkerPools{snapshots: []*dlworker.PoolSnapshot{{
+		InstanceID:    "media-1",
+		PoolSize:      10,
+		MaxConcurrent: 4,
+		Running:       3,
+		Queues:        []dlworker.QueueDepth{{Queue: "youdlp.download.queue.youtube", Platform: "youtube", Messages: 12, Paused: true}},
+		ActiveTasks:   []dlworker.ActiveTask{{TaskID: "task-1", Phase: "downloading_video", Percent: 42, StartedAt: time.Now()}},
+		UpdatedAt:     time.Now(),
+	}}}
+	server := NewDownloaderServer(&fakeDownloadRepo{}, &fakeProgressStore{}, &fakeCanceller{}, nil, nil, nil, pools, nil, zap.NewNop())
+
+	resp, err := server.GetWorkerPools(context.Background(), &pb.GetWorkerPoolsRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.Instances) != 1 {
+		t.Fatalf("expected one instance, got %d", len(resp.Instances))
+	}
+	instance := resp.Instances[0]
+	if instance.MaxConcurrent != 4 || instance.Running != 3 || len(instance.Queues) != 1 || !instance.Queues[0].Paused {
+		t.Fatalf("unexpected instance status: %+v", instance)
+	}
+	if len(instance.ActiveTasks) != 1 || instance.ActiveTasks[0].Percent != 42 {
+		t.Fatalf("unexpected active tasks: %+v", instance.ActiveTasks)
+	}
+}
+
+func TestUpdateWorkerPoolValidatesCommand(t *testing.T) {
+	pools := &fakeWorkerPools{}
+	server := NewDownloaderServer(&fakeDownloadRepo{}, &fakeProgressStore{}, &fakeCanceller{}, nil, nil, nil, pools, nil, zap.NewNop())
+
+	_, err := server.UpdateWorkerPool(context.Background(), &pb.UpdateWorkerPoolRequest{Action: dlworker.PoolActionSetConcurrency})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+
+	resp, err := server.UpdateWorkerPool(context.Background(), &pb.UpdateWorkerPoolRequest{
+		InstanceId:    "media-1",
+		Action:        dlworker.PoolActionSetConcurrency,
+		MaxConcurrent: 6,
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("expected success, got %+v, %v", resp, err)
+	}
+	if len(pools.commands) != 1 || pools.commands[0].MaxConcurrent != 6 || pools.commands[0].InstanceID != "media-1" {
+		t.Fatalf("unexpected broadcast commands: %+v", pools.commands)
+	}
+}
+
+func TestUpdateWorkerPoolRejectsUnknownInstance(t *testing.T) {
+	pools := &fakeWorkerPools{known: map[string]bool{"media-1": true}}
+	server := NewDownloaderServer(&fakeDownloadRepo{}, &fakeProgressStore{}, &fakeCanceller{}, nil, nil, nil, pools, nil, zap.NewNop())
+
+	_, err := server.UpdateWorkerPool(context.Background(), &pb.UpdateWorkerPoolRequest{
+		InstanceId: "media-typo",
+		Action:     dlworker.PoolActionPause,
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+	if len(pools.commands) != 0 {
+		t.Fatalf("expected no broadcast for unknown instance, got %+v", pools.commands)
+	}
+}
@@ -2,11 +2,13 @@ package handler
 
 import (
 	"context"
+	"errors"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"youdlp/media-service/internal/cache"
 	"youdlp/media-service/internal/service"
 	"youdlp/media-service/internal/utils"
 	pb "youdlp/media-service/proto"
@@ -38,63 +40,235 @@ func (s *GRPCServer) ParseURL(ctx context.Context, req *pb.ParseURLRequest) (*pb
 		return nil, mapErrorToGRPCStatus(err)
 	}
 
-	// 转换格式列表
-	formats := make([]*pb.VideoFormat, len(result.Formats))
-	for i, f := range result.Formats {
-		formats[i] = &pb.VideoFormat{
-			FormatId:   f.FormatID,
-			Quality:    f.Quality,
-			Extension:  f.Extension,
-			Filesize:   f.Filesize,
-			Height:     int32(f.Height),
-			Width:      int32(f.Width),
-			Fps:        f.FPS,
-			VideoCodec: f.VideoCodec,
-			AudioCodec: f.AudioCodec,
-			Vbr:        f.VBR,
-			Abr:        f.ABR,
-			Asr:        int32(f.ASR),
-		}
-	}
-
-	return &pb.ParseURLResponse{
-		VideoId:       result.VideoID,
-		Platform:      result.Platform,
-		Title:         result.Title,
-		Description:   result.Description,
-		Duration:      result.Duration,
-		Thumbnail:     result.Thumbnail,
-		Author:        result.Author,
-		UploadDate:    result.UploadDate,
-		ViewCount:     result.ViewCount,
-		Formats:       formats,
-		CookieId:      result.CookieID, // 添加 cookie ID
-		ProxyUrl:      result.ProxyURL,
-		ProxyLeaseId:  result.ProxyLeaseID,
-		ProxyExpireAt: result.ProxyExpireAt,
-	}, nil
+	return toParseURLResponse(result), nil
 }
 
 // ValidateURL 验证URL是否有效
 func (s *GRPCServer) ValidateURL(ctx context.Context, req *pb.ValidateURLRequest) (*pb.ValidateURLResponse, error) {
 	s.logger.Info("ValidateURL request", zap.String("url", req.Url))
 
-	valid, platform, message := s.parserService.ValidateURL(ctx, req.Url)
+	result := s.parserService.ValidateURL(ctx, req.Url)
 
 	return &pb.ValidateURLResponse{
-		Valid:    valid,
-		Platform: platform,
-		Message:  message,
+		Valid:        result.Valid,
+		Platform:     result.Platform,
+		Message:      result.Message,
+		CanonicalUrl: result.CanonicalURL,
+		VideoId:      result.VideoID,
+	}, nil
+}
+
+// ParsePlaylist 分页解析播放列表/频道
+func (s *GRPCServer) ParsePlaylist(ctx context.Context, req *pb.ParsePlaylistRequest) (*pb.ParsePlaylistResponse, error) {
+	s.logger.Info("ParsePlaylist request",
+		zap.String("url", req.Url),
+		zap.Int32("page", req.Page),
+		zap.Int32("page_size", req.PageSize))
+
+	result, err := s.parserService.ParsePlaylist(ctx, req.TaskId, req.Url, int(req.Page), int(req.PageSize))
+	if err != nil {
+		s.logger.Error("ParsePlaylist failed", zap.String("url", req.Url), zap.Error(err))
+		return nil, mapErrorToGRPCStatus(err)
+	}
+
+	entries := make([]*pb.PlaylistEntry, len(result.Entries))
+	for i, e := range result.Entries {
+		entries[i] = &pb.PlaylistEntry{
+			Index:     int32(e.Index),
+			VideoId:   e.VideoID,
+			Url:       e.URL,
+			Title:     e.Title,
+			Duration:  e.Duration,
+			Thumbnail: e.Thumbnail,
+		}
+	}
+
+	return &pb.ParsePlaylistResponse{
+		PlaylistId: result.PlaylistID,
+		Platform:   result.Platform,
+		Title:      result.Title,
+		Author:     result.Author,
+		TotalCount: result.TotalCount,
+		Page:       int32(result.Page),
+		PageSize:   int32(result.PageSize),
+		HasMore:    result.HasMore,
+		Entries:    entries,
 	}, nil
 }
 
+// ParseURLs 批量解析视频URL，每个去重后的条目完成即推送
+func (s *GRPCServer) ParseURLs(req *pb.ParseURLsRequest, stream pb.MediaService_ParseURLsServer) error {
+	if len(req.Urls) == 0 {
+		return status.Error(codes.InvalidArgument, "urls is required")
+	}
+	if len(req.Urls) > service.MaxBatchParseURLs {
+		return status.Errorf(codes.InvalidArgument, "at most %d urls per batch", service.MaxBatchParseURLs)
+	}
+	s.logger.Info("ParseURLs request", zap.Int("url_count", len(req.Urls)))
+
+	// 推送失败说明调用方已断开，取消剩余解析
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	var sendErr error
+	s.parserService.ParseURLs(ctx, req.Urls, req.SkipCache, func(item service.BatchParseItem) {
+		if sendErr != nil {
+			return
+		}
+		if sendErr = stream.Send(toParseURLsResult(item)); sendErr != nil {
+			cancel()
+		}
+	})
+	if sendErr != nil {
+		s.logger.Warn("ParseURLs stream aborted", zap.Error(sendErr))
+		return sendErr
+	}
+	return nil
+}
+
+func toParseURLsResult(item service.BatchParseItem) *pb.ParseURLsResult {
+	indexes := make([]int32, len(item.Indexes))
+	for i, index := range item.Indexes {
+		indexes[i] = int32(index)
+	}
+
+	result := &pb.ParseURLsResult{Indexes: indexes, Url: item.URL}
+	if item.Err != nil {
+		result.ErrorCode = parseErrorCode(item.Err)
+		result.ErrorMessage = status.Convert(mapErrorToGRPCStatus(item.Err)).Message()
+		return result
+	}
+	result.Result = toParseURLResponse(item.Result)
+	return result
+}
+
+// parseErrorCode 返回批量解析条目的错误编码，供前端按类型展示
+func parseErrorCode(err error) string {
+	if terminal := utils.TerminalVideoError(err); terminal != nil {
+		err = terminal
+	}
+	switch {
+	case errors.Is(err, utils.ErrInvalidURL):
+		return "invalid_url"
+	case errors.Is(err, utils.ErrUnsupportedPlatform):
+		return "unsupported_platform"
+	case errors.Is(err, utils.ErrPlatformDisabled):
+		return "platform_disabled"
+	case errors.Is(err, utils.ErrPlatformRateLimited):
+		return "rate_limited"
+	case errors.Is(err, utils.ErrCookieRequired):
+		return "cookie_required"
+	case errors.Is(err, utils.ErrProxyRequired):
+		return "proxy_required"
+	case errors.Is(err, utils.ErrVideoNotFound):
+		return "video_not_found"
+	case errors.Is(err, utils.ErrVideoPrivate):
+		return "video_private"
+	case errors.Is(err, utils.ErrVideoDeleted):
+		return "video_deleted"
+	case errors.Is(err, utils.ErrGeoRestricted):
+		return "geo_restricted"
+	case errors.Is(err, utils.ErrAgeRestricted):
+		return "age_restricted"
+	case errors.Is(err, utils.ErrCopyrightClaim):
+		return "copyright_claim"
+	case errors.Is(err, utils.ErrTimeout), errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	default:
+		return "parse_failed"
+	}
+}
+
+// toParseURLResponse 将解析结果转换为 gRPC 响应
+func toParseURLResponse(result *cache.ParseResult) *pb.ParseURLResponse {
+	// 转换格式列表
+	formats := make([]*pb.VideoFormat, len(result.Formats))
+	for i, f := range result.Formats {
+		formats[i] = &pb.VideoFormat{
+			FormatId:     f.FormatID,
+			Quality:      f.Quality,
+			Extension:    f.Extension,
+			Filesize:     f.Filesize,
+			Height:       int32(f.Height),
+			Width:        int32(f.Width),
+			Fps:          f.FPS,
+			VideoCodec:   f.VideoCodec,
+			AudioCodec:   f.AudioCodec,
+			Vbr:          f.VBR,
+			Abr:          f.ABR,
+			Asr:          int32(f.ASR),
+			Language:     f.Language,
+			DynamicRange: f.DynamicRange,
+		}
+	}
+
+	subtitles := make([]*pb.SubtitleTrack, len(result.Subtitles))
+	for i, st := range result.Subtitles {
+		subtitles[i] = &pb.SubtitleTrack{
+			Language:  st.Language,
+			Name:      st.Name,
+			Automatic: st.Automatic,
+			Formats:   st.Formats,
+		}
+	}
+
+	chapters := make([]*pb.Chapter, len(result.Chapters))
+	for i, ch := range result.Chapters {
+		chapters[i] = &pb.Chapter{
+			Title:     ch.Title,
+			StartTime: ch.StartTime,
+			EndTime:   ch.EndTime,
+		}
+	}
+
+	return &pb.ParseURLResponse{
+		VideoId:        result.VideoID,
+		Platform:       result.Platform,
+		Title:          result.Title,
+		Description:    result.Description,
+		Duration:       result.Duration,
+		Thumbnail:      result.Thumbnail,
+		Author:         result.Author,
+		UploadDate:     result.UploadDate,
+		ViewCount:      result.ViewCount,
+		Formats:        formats,
+		Subtitles:      subtitles,
+		Chapters:       chapters,
+		CookieId:       result.CookieID, // 添加 cookie ID
+		ProxyUrl:       result.ProxyURL,
+		ProxyLeaseId:   result.ProxyLeaseID,
+		ProxyExpireAt:  result.ProxyExpireAt,
+		AudioLanguages: result.AudioLanguages,
+		LiveStatus:     result.LiveStatus,
+		AgeLimit:       int32(result.AgeLimit),
+		Tags:           result.Tags,
+		Categories:     result.Categories,
+		ChannelId:      result.ChannelID,
+		ChannelUrl:     result.ChannelURL,
+	}
+}
+
 // mapErrorToGRPCStatus 将错误映射到gRPC状态码
 func mapErrorToGRPCStatus(err error) error {
+	// yt-dlp 的终态错误带有原始输出，按错误类型映射
+	if terminal := utils.TerminalVideoError(err); terminal != nil {
+		err = terminal
+	}
+	if errors.Is(err, utils.ErrPlatformRateLimited) {
+		return status.Error(codes.ResourceExhausted, "platform parse rate limited")
+	}
+
 	switch err {
 	case utils.ErrInvalidURL:
 		return status.Error(codes.InvalidArgument, "invalid URL")
 	case utils.ErrUnsupportedPlatform:
 		return status.Error(codes.InvalidArgument, "unsupported platform")
+	case utils.ErrPlatformDisabled:
+		return status.Error(codes.FailedPrecondition, "platform is disabled")
+	case utils.ErrCookieRequired:
+		return status.Error(codes.FailedPrecondition, "platform requires a cookie but none is available")
+	case utils.ErrProxyRequired:
+		return status.Error(codes.Unavailable, "platform requires a proxy but none is available")
 	case utils.ErrVideoNotFound:
 		return status.Error(codes.NotFound, "video not found")
 	case utils.ErrVideoPrivate:
@@ -0,0 +1,134 @@
+package platform
+
+import (
+	"net/url"
+	"strings"
+
+	"youdlp/media-service/internal/utils"
+)
+
+// trackingParams 与视频内容无关、只用于分享统计的查询参数
+var trackingParams = map[string]bool{
+	"fbclid": true, "gclid": true, "igshid": true, "igsh": true, "si": true,
+	"feature": true, "pp": true, "spm_id_from": true, "vd_source": true,
+	"share_source": true, "share_medium": true, "share_plat": true, "share_session_id": true,
+	"share_from": true, "share_tag": true, "share_id": true, "share_app_id": true,
+	"unique_k": true, "bbid": true, "ts": true, "timestamp": true, "is_from_webapp": true,
+	"sender_device": true, "web_id": true, "xhsshare": true, "app_platform": true,
+	"apptime": true, "appuid": true,
+}
+
+// Identity URL 对应的规范视频身份
+type Identity struct {
+	Platform *Platform
+	VideoID  string // 平台内视频 ID，无法提取时为空
+	URL      string // 规范地址，同一视频的不同写法得到相同的值，仅用于缓存、去重等身份比较
+	FetchURL string // 交给 yt-dlp 抓取的原始地址：签名直链的 ts、小红书的 xsec_token 等参数不能删改
+	variant  string // 规范地址保留的查询参数，区分同一 ID 下的不同内容（如分 P）
+}
+
+// Key 规范身份的稳定标识，用于解析缓存、历史与重复提交检测
+func (id *Identity) Key() string {
+	if id.VideoID == "" {
+		return "url:" + id.URL
+	}
+	key := id.Platform.Name + ":" + id.VideoID
+	if id.variant != "" {
+		key += "?" + id.variant
+	}
+	return key
+}
+
+// Canonicalize 将 URL 解析为规范身份：统一主机名大小写、去除追踪参数与锚点，
+// 能提取视频 ID 的平台再按 entry_url 模板重写，移动端域名与带时间戳的地址因此归一。
+// 归一结果只用于身份比较，抓取仍使用原始地址（FetchURL）。
+// 不展开短链，短链需先经 ShortLinkResolver 处理
+func (r *Registry) Canonicalize(rawURL string) (*Identity, error) {
+	normalized, err := normalizeURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := r.Detect(normalized.String())
+	if err != nil {
+		return nil, err
+	}
+
+	identity := &Identity{
+		Platform: p,
+		VideoID:  p.CanonicalID(normalized.String()),
+		URL:      normalized.String(),
+		FetchURL: strings.TrimSpace(rawURL),
+	}
+	if entry := p.EntryURLFor(identity.VideoID); entry != "" {
+		identity.variant = keptParams(normalized.Query(), p.KeepParams)
+		identity.URL = entry
+		if identity.variant != "" {
+			separator := "?"
+			if strings.Contains(entry, "?") {
+				separator = "&"
+			}
+			identity.URL += separator + identity.variant
+		}
+	}
+	return identity, nil
+}
+
+// IsShortLink 判断 URL 是否为已注册平台的短链
+func (r *Registry) IsShortLink(rawURL string) bool {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, p := range r.platforms {
+		for _, shortHost := range p.ShortLinks {
+			if host == shortHost {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func normalizeURL(rawURL string) (*url.URL, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if !utils.IsValidURL(rawURL) {
+		return nil, utils.ErrInvalidURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, utils.ErrInvalidURL
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Hostname())
+	if port := u.Port(); port != "" && !(u.Scheme == "http" && port == "80") && !(u.Scheme == "https" && port == "443") {
+		host += ":" + port
+	}
+	u.Host = host
+	u.Fragment = ""
+	u.RawFragment = ""
+	u.User = nil
+
+	query := u.Query()
+	for name := range query {
+		if trackingParams[strings.ToLower(name)] || strings.HasPrefix(strings.ToLower(name), "utm_") {
+			query.Del(name)
+		}
+	}
+	// Encode 按参数名排序，参数顺序不同的写法得到相同结果
+	u.RawQuery = query.Encode()
+	u.ForceQuery = false
+	return u, nil
+}
+
+func keptParams(query url.Values, keep []string) string {
+	kept := url.Values{}
+	for _, name := range keep {
+		if value := query.Get(name); value != "" {
+			kept.Set(name, value)
+		}
+	}
+	return kept.Encode()
+}
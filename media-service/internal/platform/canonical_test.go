@@ -0,0 +1,88 @@
+package platform
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCanonicalizeNormalizesURLVariants(t *testing.T) {
+	registry := newDefaultRegistry(t)
+
+	cases := []struct {
+		url       string
+		canonical string
+		key       string
+	}{
+		{"https://youtu.be/dQw4w9WgXcQ?si=abc&t=30", "https://www.youtube.com/watch?v=dQw4w9WgXcQ", "youtube:dQw4w9WgXcQ"},
+		{"https://m.youtube.com/watch?v=dQw4w9WgXcQ&list=RD1", "https://www.youtube.com/watch?v=dQw4w9WgXcQ", "youtube:dQw4w9WgXcQ"},
+		{"https://www.bilibili.com/video/BV1xx411c7mD/?spm_id_from=333.1007&p=3", "https://www.bilibili.com/video/BV1xx411c7mD?p=3", "bilibili:BV1xx411c7mD?p=3"},
+		{"https://twitter.com/someone/status/1790000000000000000?s=20", "https://x.com/i/status/1790000000000000000", "twitter:1790000000000000000"},
+		{"https://www.douyin.com/discover?modal_id=7312345678901234567", "https://www.douyin.com/video/7312345678901234567", "douyin:7312345678901234567"},
+		// 无法提取 ID 时只做通用归一：主机名小写、去除追踪参数与锚点、参数排序
+		{"HTTPS://Example.COM:443/watch?b=2&utm_source=x&a=1#t=10", "https://example.com/watch?a=1&b=2", "url:https://example.com/watch?a=1&b=2"},
+	}
+	for _, tc := range cases {
+		identity, err := registry.Canonicalize(tc.url)
+		if err != nil {
+			t.Fatalf("Canonicalize(%q) returned error: %v", tc.url, err)
+		}
+		if identity.URL != tc.canonical || identity.Key() != tc.key {
+			t.Fatalf("Canonicalize(%q) = %s (%s), want %s (%s)", tc.url, identity.URL, identity.Key(), tc.canonical, tc.key)
+		}
+	}
+
+	// 抓取地址保持原样，归一只影响身份比较
+	for _, rawURL := range []string{
+		"https://www.xiaohongshu.com/explore/64f1a2b3c4d5e6f7a8b9c0d1?xsec_token=abc&xhsshare=CopyLink",
+		"https://cdn.example.com/media/v.mp4?ts=1700000000&sig=f00d&utm_source=x",
+	} {
+		identity, _ := registry.Canonicalize(" " + rawURL + " ")
+		if identity.FetchURL != rawURL {
+			t.Fatalf("expected fetch url to stay %s, got %s", rawURL, identity.FetchURL)
+		}
+	}
+	signed, _ := registry.Canonicalize("https://cdn.example.com/media/v.mp4?ts=1700000000&sig=f00d")
+	if signed.Key() != "url:https://cdn.example.com/media/v.mp4?sig=f00d" {
+		t.Fatalf("expected tracking params to be stripped from key only, got %s", signed.Key())
+	}
+
+	if _, err := registry.Canonicalize("ftp://example.com/video"); err == nil {
+		t.Fatal("expected non-http url to be rejected")
+	}
+}
+
+func TestShortLinkResolverStopsAtFirstNonShortLink(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if r.URL.Path == "/hop" {
+			http.Redirect(w, r, "/final", http.StatusFound)
+			return
+		}
+		http.Redirect(w, r, "https://www.bilibili.com/video/BV1xx411c7mD?share_source=copy", http.StatusFound)
+	}))
+	defer server.Close()
+
+	registry, err := NewRegistry(append([]Definition{
+		{Name: "shortener", Patterns: []string{`^http://127\.0\.0\.1`}, ShortLinks: []string{"127.0.0.1"}},
+	}, DefaultDefinitions()...))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !registry.IsShortLink(server.URL+"/hop") || registry.IsShortLink("https://www.bilibili.com/video/BV1xx411c7mD") {
+		t.Fatal("unexpected short link detection")
+	}
+
+	target, err := NewShortLinkResolver(registry, nil).Expand(context.Background(), server.URL+"/hop")
+	if err != nil {
+		t.Fatalf("Expand returned error: %v", err)
+	}
+	if target != "https://www.bilibili.com/video/BV1xx411c7mD?share_source=copy" {
+		t.Fatalf("unexpected target: %s", target)
+	}
+	if hits != 2 {
+		t.Fatalf("expected resolver to stop before fetching the video page, got %d requests", hits)
+	}
+}
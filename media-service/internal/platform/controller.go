@@ -0,0 +1,81 @@
+package platform
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// SettingsStore 持久化管理端切换的平台启用状态，所有实例共享
+type SettingsStore interface {
+	ListPlatformSettings(ctx context.Context) (map[string]bool, error)
+	UpsertPlatformSetting(ctx context.Context, name string, enabled bool, operatorUserID string) error
+}
+
+// Controller 持久化平台开关，并周期性从存储同步到本实例的注册表
+type Controller struct {
+	registry *Registry
+	store    SettingsStore
+	interval time.Duration
+}
+
+// NewController 创建平台开关控制器，store 为空时只在本实例内生效
+func NewController(registry *Registry, store SettingsStore, interval time.Duration) *Controller {
+	return &Controller{registry: registry, store: store, interval: interval}
+}
+
+// Start 立即同步一次，之后按间隔同步其他实例的修改
+func (c *Controller) Start(ctx context.Context) {
+	if c == nil || c.store == nil {
+		return
+	}
+
+	c.sync(ctx)
+	if c.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.sync(ctx)
+		}
+	}
+}
+
+func (c *Controller) sync(ctx context.Context) {
+	overrides, err := c.store.ListPlatformSettings(ctx)
+	if err != nil {
+		log.Printf("[PlatformRegistry] ⚠ Failed to load platform settings: %v", err)
+		return
+	}
+	c.registry.Apply(overrides)
+}
+
+// List 返回全部平台及当前启用状态
+func (c *Controller) List() []Info {
+	return c.registry.List()
+}
+
+// SetEnabled 切换平台启用状态，先持久化再应用到本实例
+func (c *Controller) SetEnabled(ctx context.Context, name string, enabled bool, operatorUserID string) (*Info, error) {
+	p, ok := c.registry.Get(name)
+	if !ok {
+		return nil, ErrUnknownPlatform
+	}
+	if c.store != nil {
+		if err := c.store.UpsertPlatformSetting(ctx, p.Name, enabled, operatorUserID); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.registry.SetEnabled(p.Name, enabled); err != nil {
+		return nil, err
+	}
+	log.Printf("[PlatformRegistry] ✓ Platform %s enabled=%t (operator=%s)", p.Name, enabled, operatorUserID)
+	return &Info{Platform: p, Enabled: enabled}, nil
+}
@@ -0,0 +1,176 @@
+package platform
+
+import "strings"
+
+// DefaultDefinitions 内置平台条目，按匹配优先级排列，generic 必须位于最后
+func DefaultDefinitions() []Definition {
+	return []Definition{
+		{
+			Name:        "youtube",
+			DisplayName: "YouTube",
+			Patterns:    []string{`^https?://([a-z0-9-]+\.)?(youtube\.com|youtube-nocookie\.com|youtu\.be)/`},
+			IDPatterns:  []string{`(?:[?&]v=|youtu\.be/|/shorts/|/embed/|/live/)(?P<id>[A-Za-z0-9_-]{11})`},
+			EntryURL:    "https://www.youtube.com/watch?v={id}",
+			Adapter:     AdapterYouTube,
+		},
+		{
+			Name:        "bilibili",
+			DisplayName: "哔哩哔哩",
+			Patterns:    []string{`^https?://([a-z0-9-]+\.)?(bilibili\.com|b23\.tv)/`},
+			IDPatterns:  []string{`/video/(?P<id>BV[0-9A-Za-z]{10}|av\d+)`},
+			EntryURL:    "https://www.bilibili.com/video/{id}",
+			KeepParams:  []string{"p"},
+			ShortLinks:  []string{"b23.tv"},
+			ExtraArgs:   []string{"--extractor-args", "bilibili:referer=https://www.bilibili.com"},
+		},
+		{
+			Name:        "tiktok",
+			DisplayName: "TikTok",
+			Patterns:    []string{`^https?://([a-z0-9-]+\.)?tiktok\.com/`},
+			IDPatterns:  []string{`/video/(?P<id>\d+)`},
+			ExtraArgs:   []string{"--extractor-args", "tiktok:api_hostname=api22-normal-c-alisg.tiktokv.com"},
+			ShortLinks:  []string{"vm.tiktok.com", "vt.tiktok.com"},
+		},
+		{
+			Name:        "twitter",
+			DisplayName: "X (Twitter)",
+			Patterns:    []string{`^https?://(www\.|mobile\.)?(twitter\.com|x\.com)/`},
+			IDPatterns:  []string{`/status/(?P<id>\d+)`},
+			EntryURL:    "https://x.com/i/status/{id}",
+		},
+		{
+			Name:        "instagram",
+			DisplayName: "Instagram",
+			Patterns:    []string{`^https?://(www\.)?instagram\.com/`},
+			IDPatterns:  []string{`/(?:p|reels?|tv)/(?P<id>[A-Za-z0-9_-]+)`},
+			EntryURL:    "https://www.instagram.com/p/{id}/",
+		},
+		{
+			Name:        "vimeo",
+			DisplayName: "Vimeo",
+			Patterns:    []string{`^https?://(www\.|player\.)?vimeo\.com/`},
+			IDPatterns:  []string{`vimeo\.com/(?:video/|channels/[^/]+/)?(?P<id>\d+)`},
+			EntryURL:    "https://vimeo.com/{id}",
+		},
+		{
+			// 只接入点播回放，直播频道页不在此列
+			Name:        "twitch",
+			DisplayName: "Twitch VOD",
+			Patterns:    []string{`^https?://(www\.|m\.)?twitch\.tv/(videos/\d+|[^/]+/v/\d+)`},
+			IDPatterns:  []string{`/(?:videos|v)/(?P<id>\d+)`},
+			EntryURL:    "https://www.twitch.tv/videos/{id}",
+		},
+		{
+			// 抖音未登录时大多无法获取播放地址
+			Name:        "douyin",
+			DisplayName: "抖音",
+			Patterns:    []string{`^https?://([a-z0-9-]+\.)?(douyin\.com|iesdouyin\.com)/`},
+			IDPatterns:  []string{`/(?:video|note)/(?P<id>\d+)`, `[?&]modal_id=(?P<id>\d+)`},
+			EntryURL:    "https://www.douyin.com/video/{id}",
+			ShortLinks:  []string{"v.douyin.com"},
+			Cookies:     AccessRequired,
+			RateLimit:   RateLimit{ParsePerMinute: 20, DownloadPerMinute: 6},
+		},
+		{
+			Name:        "kuaishou",
+			DisplayName: "快手",
+			Patterns:    []string{`^https?://([a-z0-9-]+\.)?(kuaishou\.com|kwai\.com|chenzhongtech\.com)/`},
+			IDPatterns:  []string{`/(?:short-video|fw/photo)/(?P<id>[A-Za-z0-9_-]+)`},
+			EntryURL:    "https://www.kuaishou.com/short-video/{id}",
+			ShortLinks:  []string{"v.kuaishou.com"},
+			RateLimit:   RateLimit{ParsePerMinute: 20, DownloadPerMinute: 6},
+		},
+		{
+			Name:        "xiaohongshu",
+			DisplayName: "小红书",
+			Patterns:    []string{`^https?://([a-z0-9-]+\.)?(xiaohongshu\.com|xhslink\.com)/`},
+			IDPatterns:  []string{`/(?:explore|discovery/item)/(?P<id>[0-9a-f]{24})`},
+			EntryURL:    "https://www.xiaohongshu.com/explore/{id}",
+			ShortLinks:  []string{"xhslink.com"},
+			RateLimit:   RateLimit{ParsePerMinute: 20, DownloadPerMinute: 6},
+		},
+		{
+			Name:        "weibo",
+			DisplayName: "微博",
+			Patterns:    []string{`^https?://([a-z0-9-]+\.)?(weibo\.com|weibo\.cn)/`},
+			IDPatterns:  []string{`/tv/show/(?P<id>\d+:[0-9A-Za-z]+)`, `weibo\.(?:com|cn)/\d+/(?P<id>[0-9A-Za-z]+)`},
+			RateLimit:   RateLimit{ParsePerMinute: 20, DownloadPerMinute: 6},
+		},
+		{
+			Name:        GenericName,
+			DisplayName: "其他站点",
+			Adapter:     AdapterGeneric,
+		},
+	}
+}
+
+// MergeDefinitions 将配置中的条目合并到内置条目：同名条目只覆盖配置了的字段，
+// 新平台插入到 generic 之前
+func MergeDefinitions(base, overrides []Definition) []Definition {
+	merged := make([]Definition, len(base))
+	copy(merged, base)
+
+	index := make(map[string]int, len(merged))
+	for i := range merged {
+		index[strings.ToLower(merged[i].Name)] = i
+	}
+
+	for _, override := range overrides {
+		name := strings.ToLower(strings.TrimSpace(override.Name))
+		i, ok := index[name]
+		if !ok {
+			override.Name = name
+			pos := len(merged)
+			if g, hasGeneric := index[GenericName]; hasGeneric {
+				pos = g
+			}
+			merged = append(merged[:pos], append([]Definition{override}, merged[pos:]...)...)
+			for j := pos; j < len(merged); j++ {
+				index[strings.ToLower(merged[j].Name)] = j
+			}
+			continue
+		}
+
+		def := &merged[i]
+		if override.DisplayName != "" {
+			def.DisplayName = override.DisplayName
+		}
+		if override.Enabled != nil {
+			def.Enabled = override.Enabled
+		}
+		if len(override.Patterns) > 0 {
+			def.Patterns = override.Patterns
+		}
+		if len(override.IDPatterns) > 0 {
+			def.IDPatterns = override.IDPatterns
+		}
+		if override.EntryURL != "" {
+			def.EntryURL = override.EntryURL
+		}
+		if override.KeepParams != nil {
+			def.KeepParams = override.KeepParams
+		}
+		if override.ShortLinks != nil {
+			def.ShortLinks = override.ShortLinks
+		}
+		if override.Adapter != "" {
+			def.Adapter = override.Adapter
+		}
+		if override.ExtraArgs != nil {
+			def.ExtraArgs = override.ExtraArgs
+		}
+		if override.Cookies != "" {
+			def.Cookies = override.Cookies
+		}
+		if override.Proxy != "" {
+			def.Proxy = override.Proxy
+		}
+		if override.RateLimit.ParsePerMinute > 0 {
+			def.RateLimit.ParsePerMinute = override.RateLimit.ParsePerMinute
+		}
+		if override.RateLimit.DownloadPerMinute > 0 {
+			def.RateLimit.DownloadPerMinute = override.RateLimit.DownloadPerMinute
+		}
+	}
+	return merged
+}
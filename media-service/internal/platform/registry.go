@@ -0,0 +1,310 @@
+package platform
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"youdlp/media-service/internal/ratelimit"
+	"youdlp/media-service/internal/utils"
+)
+
+// GenericName 未匹配任何平台时使用的兜底条目
+const GenericName = "generic"
+
+// 适配器类型
+const (
+	AdapterGeneric = "generic"
+	AdapterYouTube = "youtube"
+)
+
+// Cookie 与代理策略
+const (
+	AccessOptional = "optional" // 有则使用（默认）
+	AccessRequired = "required" // 缺失时拒绝解析
+	CookieDisabled = "disabled" // 不注入 cookie
+	ProxyDirect    = "direct"   // 不申请代理，直连
+)
+
+var ErrUnknownPlatform = errors.New("unknown platform")
+
+// Definition 平台注册表中的一个条目
+type Definition struct {
+	Name        string    `yaml:"name"`
+	DisplayName string    `yaml:"display_name"`
+	Enabled     *bool     `yaml:"enabled"`     // 为空时默认启用
+	Patterns    []string  `yaml:"patterns"`    // 匹配 URL 的正则，条目按注册顺序依次匹配
+	IDPatterns  []string  `yaml:"id_patterns"` // 提取规范视频 ID 的正则，取命名分组 id
+	EntryURL    string    `yaml:"entry_url"`   // 规范地址模板，{id} 为占位符，也用于补全扁平播放列表条目
+	KeepParams  []string  `yaml:"keep_params"` // 规范地址需要保留的查询参数（如分 P）
+	ShortLinks  []string  `yaml:"short_links"` // 需要跟随跳转展开的短链域名
+	Adapter     string    `yaml:"adapter"`     // generic（默认）或 youtube
+	ExtraArgs   []string  `yaml:"extra_args"`  // 解析与下载共用的 yt-dlp 参数
+	Cookies     string    `yaml:"cookies"`     // optional、required 或 disabled
+	Proxy       string    `yaml:"proxy"`       // optional、required 或 direct
+	RateLimit   RateLimit `yaml:"rate_limit"`
+}
+
+// RateLimit 平台每分钟的解析与下载次数上限，0 表示使用全局默认值
+type RateLimit struct {
+	ParsePerMinute    int64 `yaml:"parse_per_minute"`
+	DownloadPerMinute int64 `yaml:"download_per_minute"`
+}
+
+// Platform 编译后的平台条目，创建后只读
+type Platform struct {
+	Name           string
+	DisplayName    string
+	Patterns       []string
+	EntryURL       string
+	KeepParams     []string
+	ShortLinks     []string
+	Adapter        string
+	ExtraArgs      []string
+	Cookies        string
+	Proxy          string
+	RateLimit      RateLimit
+	defaultEnabled bool
+	patterns       []*regexp.Regexp
+	idPatterns     []*regexp.Regexp
+}
+
+// Info 平台条目及其当前启用状态
+type Info struct {
+	*Platform
+	Enabled bool
+}
+
+// Matches 判断 URL 是否属于该平台
+func (p *Platform) Matches(url string) bool {
+	for _, pattern := range p.patterns {
+		if pattern.MatchString(url) {
+			return true
+		}
+	}
+	return false
+}
+
+// CanonicalID 从 URL 提取平台内的规范视频 ID，无法提取时返回空字符串
+func (p *Platform) CanonicalID(url string) string {
+	for _, pattern := range p.idPatterns {
+		match := pattern.FindStringSubmatch(url)
+		if match == nil {
+			continue
+		}
+		if id := match[pattern.SubexpIndex("id")]; id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// EntryURLFor 用地址模板补全只有 ID 的条目地址
+func (p *Platform) EntryURLFor(id string) string {
+	if p.EntryURL == "" || id == "" {
+		return ""
+	}
+	return strings.ReplaceAll(p.EntryURL, "{id}", id)
+}
+
+// CookiesDisabled 平台是否禁止注入 cookie
+func (p *Platform) CookiesDisabled() bool {
+	return p.Cookies == CookieDisabled
+}
+
+// Registry 平台注册表。条目顺序决定匹配优先级，启用状态可在运行时切换
+type Registry struct {
+	mu        sync.RWMutex
+	platforms []*Platform
+	byName    map[string]*Platform
+	enabled   map[string]bool
+}
+
+// NewRegistry 校验并编译平台条目
+func NewRegistry(definitions []Definition) (*Registry, error) {
+	r := &Registry{
+		byName:  make(map[string]*Platform, len(definitions)),
+		enabled: make(map[string]bool, len(definitions)),
+	}
+	for i := range definitions {
+		p, err := compile(&definitions[i])
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := r.byName[p.Name]; exists {
+			return nil, fmt.Errorf("duplicate platform %q", p.Name)
+		}
+		r.platforms = append(r.platforms, p)
+		r.byName[p.Name] = p
+		r.enabled[p.Name] = p.defaultEnabled
+	}
+	return r, nil
+}
+
+func compile(def *Definition) (*Platform, error) {
+	name := strings.ToLower(strings.TrimSpace(def.Name))
+	if name == "" {
+		return nil, errors.New("platform name is required")
+	}
+	if name != GenericName && len(def.Patterns) == 0 {
+		return nil, fmt.Errorf("platform %q has no url patterns", name)
+	}
+
+	p := &Platform{
+		Name:           name,
+		DisplayName:    def.DisplayName,
+		Patterns:       append([]string(nil), def.Patterns...),
+		EntryURL:       def.EntryURL,
+		KeepParams:     append([]string(nil), def.KeepParams...),
+		Adapter:        def.Adapter,
+		ExtraArgs:      append([]string(nil), def.ExtraArgs...),
+		Cookies:        def.Cookies,
+		Proxy:          def.Proxy,
+		RateLimit:      def.RateLimit,
+		defaultEnabled: def.Enabled == nil || *def.Enabled,
+	}
+	if p.DisplayName == "" {
+		p.DisplayName = name
+	}
+	for _, host := range def.ShortLinks {
+		if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+			p.ShortLinks = append(p.ShortLinks, host)
+		}
+	}
+	if p.Adapter == "" {
+		p.Adapter = AdapterGeneric
+	}
+	if p.Cookies == "" {
+		p.Cookies = AccessOptional
+	}
+	if p.Proxy == "" {
+		p.Proxy = AccessOptional
+	}
+
+	switch p.Adapter {
+	case AdapterGeneric, AdapterYouTube:
+	default:
+		return nil, fmt.Errorf("platform %q: unsupported adapter %q", name, p.Adapter)
+	}
+	switch p.Cookies {
+	case AccessOptional, AccessRequired, CookieDisabled:
+	default:
+		return nil, fmt.Errorf("platform %q: invalid cookies policy %q", name, p.Cookies)
+	}
+	switch p.Proxy {
+	case AccessOptional, AccessRequired, ProxyDirect:
+	default:
+		return nil, fmt.Errorf("platform %q: invalid proxy policy %q", name, p.Proxy)
+	}
+
+	for _, expr := range def.Patterns {
+		pattern, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("platform %q: invalid pattern %q: %w", name, expr, err)
+		}
+		p.patterns = append(p.patterns, pattern)
+	}
+	for _, expr := range def.IDPatterns {
+		pattern, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("platform %q: invalid id pattern %q: %w", name, expr, err)
+		}
+		if pattern.SubexpIndex("id") < 0 {
+			return nil, fmt.Errorf("platform %q: id pattern %q has no (?P<id>...) group", name, expr)
+		}
+		p.idPatterns = append(p.idPatterns, pattern)
+	}
+	return p, nil
+}
+
+// Detect 按注册顺序匹配 URL 所属平台，未匹配时返回 generic 条目。
+// 返回的平台可能已被禁用，由调用方结合 Enabled 判断
+func (r *Registry) Detect(url string) (*Platform, error) {
+	if !utils.IsValidURL(url) {
+		return nil, utils.ErrInvalidURL
+	}
+	for _, p := range r.platforms {
+		if p.Matches(url) {
+			return p, nil
+		}
+	}
+	if generic, ok := r.byName[GenericName]; ok {
+		return generic, nil
+	}
+	return nil, utils.ErrUnsupportedPlatform
+}
+
+// Get 按名称查找平台
+func (r *Registry) Get(name string) (*Platform, bool) {
+	p, ok := r.byName[strings.ToLower(name)]
+	return p, ok
+}
+
+// Enabled 平台当前是否启用，未知平台视为未启用
+func (r *Registry) Enabled(name string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.enabled[strings.ToLower(name)]
+}
+
+// SetEnabled 切换平台启用状态
+func (r *Registry) SetEnabled(name string, enabled bool) error {
+	name = strings.ToLower(name)
+	if _, ok := r.byName[name]; !ok {
+		return ErrUnknownPlatform
+	}
+	r.mu.Lock()
+	r.enabled[name] = enabled
+	r.mu.Unlock()
+	return nil
+}
+
+// Apply 应用持久化的启用状态，未出现在 overrides 中的平台恢复配置默认值
+func (r *Registry) Apply(overrides map[string]bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, p := range r.platforms {
+		enabled, ok := overrides[p.Name]
+		if !ok {
+			enabled = p.defaultEnabled
+		}
+		r.enabled[p.Name] = enabled
+	}
+}
+
+// List 按匹配顺序返回全部平台及其启用状态
+func (r *Registry) List() []Info {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	items := make([]Info, 0, len(r.platforms))
+	for _, p := range r.platforms {
+		items = append(items, Info{Platform: p, Enabled: r.enabled[p.Name]})
+	}
+	return items
+}
+
+// ExtraArgs 平台额外的 yt-dlp 参数
+func (r *Registry) ExtraArgs(name string) []string {
+	if p, ok := r.Get(name); ok {
+		return p.ExtraArgs
+	}
+	return nil
+}
+
+// RateLimit 实现 ratelimit.LimitSource，返回平台在指定阶段的每分钟上限
+func (r *Registry) RateLimit(name, stage string) (int64, bool) {
+	p, ok := r.Get(name)
+	if !ok {
+		return 0, false
+	}
+	var limit int64
+	switch stage {
+	case ratelimit.StageParse:
+		limit = p.RateLimit.ParsePerMinute
+	case ratelimit.StageDownload:
+		limit = p.RateLimit.DownloadPerMinute
+	}
+	return limit, limit > 0
+}
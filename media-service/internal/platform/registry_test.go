@@ -0,0 +1,219 @@
+package platform
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"youdlp/media-service/internal/ratelimit"
+)
+
+func newDefaultRegistry(t *testing.T) *Registry {
+	t.Helper()
+	registry, err := NewRegistry(DefaultDefinitions())
+	if err != nil {
+		t.Fatalf("failed to build default registry: %v", err)
+	}
+	return registry
+}
+
+func TestRegistryDetectsBuiltinPlatforms(t *testing.T) {
+	registry := newDefaultRegistry(t)
+
+	cases := map[string]string{
+		"https://www.youtube.com/watch?v=dQw4w9WgXcQ":                  "youtube",
+		"https://m.youtube.com/shorts/dQw4w9WgXcQ":                     "youtube",
+		"https://www.bilibili.com/video/BV1xx411c7mD":                  "bilibili",
+		"https://vimeo.com/76979871":                                   "vimeo",
+		"https://www.twitch.tv/videos/1234567890":                      "twitch",
+		"https://www.douyin.com/video/7312345678901234567":             "douyin",
+		"https://www.kuaishou.com/short-video/3xabc123":                "kuaishou",
+		"https://www.xiaohongshu.com/explore/64f1a2b3c4d5e6f7a8b9c0d1": "xiaohongshu",
+		"https://weibo.com/tv/show/1034:4797699866951785":              "weibo",
+		"https://x.com/user/status/1790000000000000000":                "twitter",
+		"https://example.com/video.mp4":                                GenericName,
+		// Twitch 只接入点播，直播频道页走通用适配器
+		"https://www.twitch.tv/somechannel": GenericName,
+	}
+	for url, want := range cases {
+		p, err := registry.Detect(url)
+		if err != nil {
+			t.Fatalf("Detect(%q) returned error: %v", url, err)
+		}
+		if p.Name != want {
+			t.Fatalf("Detect(%q) = %s, want %s", url, p.Name, want)
+		}
+	}
+
+	if _, err := registry.Detect("not a url"); err == nil {
+		t.Fatal("expected invalid url to be rejected")
+	}
+}
+
+func TestRegistryMatchesInRegistrationOrder(t *testing.T) {
+	registry, err := NewRegistry([]Definition{
+		{Name: "specific", Patterns: []string{`^https?://video\.example\.com/`}},
+		{Name: "broad", Patterns: []string{`^https?://([a-z]+\.)?example\.com/`}},
+		{Name: GenericName},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i := 0; i < 20; i++ {
+		p, err := registry.Detect("https://video.example.com/watch/1")
+		if err != nil || p.Name != "specific" {
+			t.Fatalf("expected first registered pattern to win, got %v %v", p, err)
+		}
+	}
+}
+
+func TestRegistryExtractsCanonicalIDs(t *testing.T) {
+	registry := newDefaultRegistry(t)
+
+	cases := map[string]string{
+		"https://youtu.be/dQw4w9WgXcQ?t=42":                                   "dQw4w9WgXcQ",
+		"https://www.youtube.com/watch?feature=share&v=dQw4w9WgXcQ":           "dQw4w9WgXcQ",
+		"https://www.bilibili.com/video/BV1xx411c7mD?p=2":                     "BV1xx411c7mD",
+		"https://player.vimeo.com/video/76979871":                             "76979871",
+		"https://www.twitch.tv/somechannel/v/1234567890":                      "1234567890",
+		"https://www.douyin.com/discover?modal_id=7312345678901234567":        "7312345678901234567",
+		"https://www.xiaohongshu.com/discovery/item/64f1a2b3c4d5e6f7a8b9c0d1": "64f1a2b3c4d5e6f7a8b9c0d1",
+	}
+	for url, want := range cases {
+		p, err := registry.Detect(url)
+		if err != nil {
+			t.Fatalf("Detect(%q) returned error: %v", url, err)
+		}
+		if got := p.CanonicalID(url); got != want {
+			t.Fatalf("CanonicalID(%q) = %q, want %q", url, got, want)
+		}
+	}
+}
+
+func TestRegistryTogglesAndAppliesOverrides(t *testing.T) {
+	disabled := false
+	registry, err := NewRegistry([]Definition{
+		{Name: "vimeo", Patterns: []string{`vimeo\.com`}},
+		{Name: "weibo", Patterns: []string{`weibo\.com`}, Enabled: &disabled},
+		{Name: GenericName},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !registry.Enabled("vimeo") || registry.Enabled("weibo") {
+		t.Fatal("expected config defaults to apply")
+	}
+
+	if err := registry.SetEnabled("vimeo", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if registry.Enabled("vimeo") {
+		t.Fatal("expected vimeo to be disabled")
+	}
+	if err := registry.SetEnabled("unknown", true); !errors.Is(err, ErrUnknownPlatform) {
+		t.Fatalf("expected ErrUnknownPlatform, got %v", err)
+	}
+
+	// 存储中只有 weibo 的设置，vimeo 恢复配置默认值
+	registry.Apply(map[string]bool{"weibo": true})
+	if !registry.Enabled("vimeo") || !registry.Enabled("weibo") {
+		t.Fatal("expected stored overrides to apply and others to reset")
+	}
+}
+
+func TestNewRegistryRejectsInvalidDefinitions(t *testing.T) {
+	cases := [][]Definition{
+		{{Name: "vimeo"}},
+		{{Name: "vimeo", Patterns: []string{`(`}}},
+		{{Name: "vimeo", Patterns: []string{`vimeo`}, IDPatterns: []string{`(\d+)`}}},
+		{{Name: "vimeo", Patterns: []string{`vimeo`}, Adapter: "custom"}},
+		{{Name: "vimeo", Patterns: []string{`vimeo`}, Proxy: "sometimes"}},
+		{{Name: "vimeo", Patterns: []string{`vimeo`}}, {Name: "Vimeo", Patterns: []string{`vimeo`}}},
+	}
+	for i, defs := range cases {
+		if _, err := NewRegistry(defs); err == nil {
+			t.Fatalf("case %d: expected invalid definitions to be rejected", i)
+		}
+	}
+}
+
+func TestMergeDefinitionsOverridesFieldsAndInsertsBeforeGeneric(t *testing.T) {
+	disabled := false
+	merged := MergeDefinitions(DefaultDefinitions(), []Definition{
+		{Name: "Douyin", Enabled: &disabled, RateLimit: RateLimit{DownloadPerMinute: 2}},
+		{Name: "niconico", Patterns: []string{`^https?://(www\.)?nicovideo\.jp/`}},
+	})
+
+	registry, err := NewRegistry(merged)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	douyin, ok := registry.Get("douyin")
+	if !ok || registry.Enabled("douyin") {
+		t.Fatal("expected douyin to be disabled by override")
+	}
+	if douyin.RateLimit.DownloadPerMinute != 2 || douyin.RateLimit.ParsePerMinute != 20 || douyin.Cookies != AccessRequired {
+		t.Fatalf("expected unspecified fields to keep defaults, got %+v", douyin)
+	}
+
+	items := registry.List()
+	if items[len(items)-1].Name != GenericName || items[len(items)-2].Name != "niconico" {
+		t.Fatalf("expected new platform before generic, got %s, %s", items[len(items)-2].Name, items[len(items)-1].Name)
+	}
+	if p, _ := registry.Detect("https://www.nicovideo.jp/watch/sm9"); p.Name != "niconico" {
+		t.Fatalf("expected niconico to be detected, got %s", p.Name)
+	}
+}
+
+func TestRegistryProvidesRateLimits(t *testing.T) {
+	registry := newDefaultRegistry(t)
+
+	if limit, ok := registry.RateLimit("douyin", ratelimit.StageDownload); !ok || limit != 6 {
+		t.Fatalf("expected douyin download limit 6, got %d %v", limit, ok)
+	}
+	if _, ok := registry.RateLimit("youtube", ratelimit.StageParse); ok {
+		t.Fatal("expected youtube to use the global default limit")
+	}
+}
+
+type fakeSettingsStore struct {
+	settings map[string]bool
+	upserts  []string
+}
+
+func (f *fakeSettingsStore) ListPlatformSettings(context.Context) (map[string]bool, error) {
+	return f.settings, nil
+}
+
+func (f *fakeSettingsStore) UpsertPlatformSetting(_ context.Context, name string, enabled bool, operator string) error {
+	f.settings[name] = enabled
+	f.upserts = append(f.upserts, name+"/"+operator)
+	return nil
+}
+
+func TestControllerPersistsAndSyncsToggles(t *testing.T) {
+	registry := newDefaultRegistry(t)
+	store := &fakeSettingsStore{settings: map[string]bool{"weibo": false}}
+	controller := NewController(registry, store, 0)
+
+	controller.Start(context.Background())
+	if registry.Enabled("weibo") {
+		t.Fatal("expected stored setting to be applied on start")
+	}
+
+	info, err := controller.SetEnabled(context.Background(), "Vimeo", false, "admin-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.Name != "vimeo" || info.Enabled || registry.Enabled("vimeo") {
+		t.Fatalf("unexpected toggle result: %+v", info)
+	}
+	if len(store.upserts) != 1 || store.upserts[0] != "vimeo/admin-1" {
+		t.Fatalf("expected toggle to be persisted, got %v", store.upserts)
+	}
+
+	if _, err := controller.SetEnabled(context.Background(), "unknown", true, "admin-1"); !errors.Is(err, ErrUnknownPlatform) {
+		t.Fatalf("expected ErrUnknownPlatform, got %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package platform
+
+import (
+	"context"
+	"crypto/md5"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"youdlp/media-service/internal/utils"
+)
+
+const (
+	shortLinkMaxHops  = 5
+	shortLinkCacheTTL = 24 * time.Hour
+	shortLinkTimeout  = 5 * time.Second
+	shortLinkAgent    = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0 Safari/537.36"
+)
+
+var errShortLinkUnresolved = errors.New("short link did not redirect to a video page")
+
+// ShortLinkResolver 跟随跳转展开已注册平台的短链，展开结果缓存在 Redis
+type ShortLinkResolver struct {
+	registry *Registry
+	client   *http.Client
+	redis    *redis.Client
+}
+
+// NewShortLinkResolver 创建短链展开器，redisClient 为空时不缓存
+func NewShortLinkResolver(registry *Registry, redisClient *redis.Client) *ShortLinkResolver {
+	return &ShortLinkResolver{
+		registry: registry,
+		client:   &http.Client{Timeout: shortLinkTimeout},
+		redis:    redisClient,
+	}
+}
+
+// Expand 返回短链跳转到的第一个非短链地址。只请求短链本身，不下载目标页面
+func (r *ShortLinkResolver) Expand(ctx context.Context, shortURL string) (string, error) {
+	cacheKey := fmt.Sprintf("parser:shortlink:%x", md5.Sum([]byte(shortURL)))
+	if r.redis != nil {
+		if cached, err := r.redis.Get(ctx, cacheKey).Result(); err == nil && cached != "" {
+			return cached, nil
+		}
+	}
+
+	target := ""
+	client := *r.client
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= shortLinkMaxHops {
+			return errShortLinkUnresolved
+		}
+		if !r.registry.IsShortLink(req.URL.String()) {
+			target = req.URL.String()
+			return http.ErrUseLastResponse
+		}
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, shortURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("User-Agent", shortLinkAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("expand short link: %w", err)
+	}
+	resp.Body.Close()
+
+	if target == "" || !utils.IsValidURL(target) {
+		return "", errShortLinkUnresolved
+	}
+
+	if r.redis != nil {
+		// 缓存失败只影响下次展开的耗时
+		_ = r.redis.Set(ctx, cacheKey, target, shortLinkCacheTTL).Err()
+	}
+	return target, nil
+}
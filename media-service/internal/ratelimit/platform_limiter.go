@@ -13,10 +13,16 @@ const (
 	StageDownload = "download"
 )
 
+// LimitSource 按平台覆盖默认的每分钟上限
+type LimitSource interface {
+	RateLimit(platform, stage string) (int64, bool)
+}
+
 type PlatformLimiter struct {
 	redis          *redis.Client
 	parsePerMin    int64
 	downloadPerMin int64
+	overrides      LimitSource
 }
 
 func NewPlatformLimiter(redisClient *redis.Client) *PlatformLimiter {
@@ -32,7 +38,7 @@ func (l *PlatformLimiter) Allow(ctx context.Context, platform, stage string) (bo
 		return true, nil
 	}
 
-	limit := l.limitFor(stage)
+	limit := l.limitFor(platform, stage)
 	if limit <= 0 {
 		return true, nil
 	}
@@ -49,7 +55,17 @@ func (l *PlatformLimiter) Allow(ctx context.Context, platform, stage string) (bo
 	return count <= limit, nil
 }
 
-func (l *PlatformLimiter) limitFor(stage string) int64 {
+// SetLimits 设置平台级上限，未覆盖的平台使用默认值
+func (l *PlatformLimiter) SetLimits(source LimitSource) {
+	l.overrides = source
+}
+
+func (l *PlatformLimiter) limitFor(platform, stage string) int64 {
+	if l.overrides != nil {
+		if limit, ok := l.overrides.RateLimit(platform, stage); ok {
+			return limit
+		}
+	}
 	switch stage {
 	case StageParse:
 		return l.parsePerMin
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"youdlp/media-service/internal/cache"
+	"youdlp/media-service/internal/platform"
+	"youdlp/media-service/internal/utils"
+)
+
+// MaxBatchParseURLs 单个批量解析请求允许的 URL 数量上限
+const MaxBatchParseURLs = 50
+
+const defaultBatchConcurrency = 4
+
+// BatchParseItem 批量解析中一个去重后的条目
+type BatchParseItem struct {
+	Indexes []int  // 请求中解析到同一视频的所有下标
+	URL     string // 首次出现的原始地址
+	Result  *cache.ParseResult
+	Err     error
+}
+
+// batchEntry 规范化后的批量条目，identity 为空表示 URL 无效
+type batchEntry struct {
+	item     BatchParseItem
+	identity *platform.Identity
+}
+
+// ParseURLs 批量解析 URL：按规范身份去重后以有限并发解析，每个条目完成即调用 emit（串行调用）。
+// 批次内某个平台触发限流后，该平台剩余条目只读缓存，不再请求平台
+func (s *ParserService) ParseURLs(ctx context.Context, rawURLs []string, skipCache bool, emit func(BatchParseItem)) {
+	entries := s.canonicalizeBatch(ctx, rawURLs)
+
+	var emitMu sync.Mutex
+	report := func(item BatchParseItem) {
+		emitMu.Lock()
+		defer emitMu.Unlock()
+		emit(item)
+	}
+
+	var limitedMu sync.Mutex
+	limited := make(map[string]bool)
+
+	s.runBatch(len(entries), func(i int) {
+		entry := entries[i]
+		if entry.identity == nil {
+			report(entry.item)
+			return
+		}
+
+		name := entry.identity.Platform.Name
+		limitedMu.Lock()
+		platformLimited := limited[name]
+		limitedMu.Unlock()
+
+		if platformLimited {
+			entry.item.Result, entry.item.Err = s.cachedOnly(ctx, entry.identity, skipCache)
+		} else {
+			entry.item.Result, entry.item.Err = s.parseIdentity(ctx, "", entry.identity, skipCache)
+			if errors.Is(entry.item.Err, utils.ErrPlatformRateLimited) {
+				limitedMu.Lock()
+				limited[name] = true
+				limitedMu.Unlock()
+			}
+		}
+		report(entry.item)
+	})
+}
+
+// canonicalizeBatch 并发展开短链并解析规范身份，同一视频的多个写法合并为一个条目
+func (s *ParserService) canonicalizeBatch(ctx context.Context, rawURLs []string) []*batchEntry {
+	identities := make([]*platform.Identity, len(rawURLs))
+	errs := make([]error, len(rawURLs))
+	s.runBatch(len(rawURLs), func(i int) {
+		if strings.TrimSpace(rawURLs[i]) == "" {
+			errs[i] = utils.ErrInvalidURL
+			return
+		}
+		identities[i], errs[i] = s.canonicalize(ctx, rawURLs[i])
+	})
+
+	entries := make([]*batchEntry, 0, len(rawURLs))
+	byKey := make(map[string]*batchEntry, len(rawURLs))
+	for i, rawURL := range rawURLs {
+		if errs[i] != nil {
+			entries = append(entries, &batchEntry{item: BatchParseItem{Indexes: []int{i}, URL: rawURL, Err: errs[i]}})
+			continue
+		}
+		key := identities[i].Key()
+		if entry, ok := byKey[key]; ok {
+			entry.item.Indexes = append(entry.item.Indexes, i)
+			continue
+		}
+		entry := &batchEntry{item: BatchParseItem{Indexes: []int{i}, URL: rawURL}, identity: identities[i]}
+		byKey[key] = entry
+		entries = append(entries, entry)
+	}
+
+	if duplicates := len(rawURLs) - len(entries); duplicates > 0 {
+		s.logger.Info("batch parse deduplicated urls",
+			zap.Int("total", len(rawURLs)),
+			zap.Int("unique", len(entries)),
+			zap.Int("duplicates", duplicates))
+	}
+	return entries
+}
+
+// cachedOnly 平台已限流时只返回缓存中的解析结果
+func (s *ParserService) cachedOnly(ctx context.Context, identity *platform.Identity, skipCache bool) (*cache.ParseResult, error) {
+	if _, _, err := s.platformAdapter(identity.Platform); err != nil {
+		return nil, err
+	}
+	if !skipCache {
+		if cached, err := s.cache.Get(ctx, identity.Key()); err == nil {
+			if err := s.attachDynamicAccess(cached, ""); err != nil {
+				return nil, err
+			}
+			return cached, nil
+		}
+	}
+	return nil, utils.ErrPlatformRateLimited
+}
+
+// runBatch 以 batchConcurrency 个并发执行 fn(0..n-1)
+func (s *ParserService) runBatch(n int, fn func(i int)) {
+	workers := s.batchConcurrency
+	if workers <= 0 {
+		workers = defaultBatchConcurrency
+	}
+	if workers > n {
+		workers = n
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"youdlp/media-service/internal/adapter"
+	"youdlp/media-service/internal/utils"
+	"youdlp/media-service/internal/ytdlp"
+)
+
+func TestParseURLsDeduplicatesAndReportsPerURLErrors(t *testing.T) {
+	t.Parallel()
+
+	adapterStub := &fakeAdapter{parseResponse: &ytdlp.VideoInfo{ID: "dQw4w9WgXcQ", Title: "Title"}}
+	svc := &ParserService{
+		platforms: platformsForTests(),
+		cache:     &fakeParserCache{getErr: utils.ErrCacheMiss},
+		adapters: map[string]adapter.Adapter{
+			"generic": adapterStub,
+		},
+		limiter:          utils.NewConcurrencyLimiter(1),
+		logger:           zap.NewNop(),
+		assetClient:      &fakeParserAssetClient{},
+		batchConcurrency: 1,
+	}
+
+	urls := []string{
+		"https://youtu.be/dQw4w9WgXcQ?t=30",
+		"not a url",
+		"https://www.youtube.com/watch?v=dQw4w9WgXcQ&utm_source=share",
+		"  ",
+		"https://example.com/video",
+	}
+	items := map[string]BatchParseItem{}
+	svc.ParseURLs(context.Background(), urls, false, func(item BatchParseItem) {
+		items[fmt.Sprint(item.Indexes)] = item
+	})
+
+	if len(items) != 4 {
+		t.Fatalf("expected 4 deduplicated items, got %d: %+v", len(items), items)
+	}
+	if item, ok := items["[0 2]"]; !ok || item.Err != nil || item.Result.VideoID != "dQw4w9WgXcQ" || item.URL != urls[0] {
+		t.Fatalf("expected youtube variants to share one result, got %+v", items)
+	}
+	for _, key := range []string{"[1]", "[3]"} {
+		if !errors.Is(items[key].Err, utils.ErrInvalidURL) {
+			t.Fatalf("expected invalid url error for %s, got %+v", key, items[key])
+		}
+	}
+	if items["[4]"].Err != nil {
+		t.Fatalf("expected generic url to parse, got %v", items["[4]"].Err)
+	}
+	if adapterStub.parseCalls != 2 {
+		t.Fatalf("expected one parse per unique video, got %d", adapterStub.parseCalls)
+	}
+}
+
+func TestParseURLsStopsRequestingRateLimitedPlatform(t *testing.T) {
+	t.Parallel()
+
+	adapterStub := &fakeAdapter{
+		parseErrors: []error{fmt.Errorf("%w: generic", utils.ErrPlatformRateLimited)},
+	}
+	svc := &ParserService{
+		platforms: platformsForTests(),
+		cache:     &fakeParserCache{getErr: utils.ErrCacheMiss},
+		adapters: map[string]adapter.Adapter{
+			"generic": adapterStub,
+		},
+		limiter:          utils.NewConcurrencyLimiter(1),
+		logger:           zap.NewNop(),
+		assetClient:      &fakeParserAssetClient{},
+		batchConcurrency: 1,
+	}
+
+	var items []BatchParseItem
+	svc.ParseURLs(context.Background(), []string{
+		"https://example.com/a",
+		"https://example.com/b",
+		"https://example.com/c",
+	}, false, func(item BatchParseItem) {
+		items = append(items, item)
+	})
+
+	if len(items) != 3 {
+		t.Fatalf("expected 3 items, got %d", len(items))
+	}
+	for _, item := range items {
+		if !errors.Is(item.Err, utils.ErrPlatformRateLimited) {
+			t.Fatalf("expected rate limited error, got %+v", item)
+		}
+	}
+	if adapterStub.parseCalls != 1 {
+		t.Fatalf("expected rate limited platform to be requested once, got %d", adapterStub.parseCalls)
+	}
+}
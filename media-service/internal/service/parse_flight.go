@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"youdlp/media-service/internal/cache"
+)
+
+var errParseAborted = errors.New("in-flight parse aborted")
+
+// parseFlight 一次进行中的解析
+type parseFlight struct {
+	done   chan struct{}
+	result *cache.ParseResult
+	err    error
+}
+
+// parseFlightGroup 合并同一实例内对同一视频的并发解析，零值可用
+type parseFlightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*parseFlight
+	// onJoin 调用加入进行中的解析后回调，仅供测试同步
+	onJoin func(key string)
+}
+
+// Do 执行 fn 并返回其结果；同一 key 已有解析进行中时不再执行 fn，而是等待该解析结束。
+// shared 表示结果来自其他调用，调用方需自行复制后再修改
+func (g *parseFlightGroup) Do(ctx context.Context, key string, fn func() (*cache.ParseResult, error)) (result *cache.ParseResult, shared bool, err error) {
+	g.mu.Lock()
+	if g.flights == nil {
+		g.flights = make(map[string]*parseFlight)
+	}
+	if flight, ok := g.flights[key]; ok {
+		g.mu.Unlock()
+		if g.onJoin != nil {
+			g.onJoin(key)
+		}
+		select {
+		case <-flight.done:
+			return flight.result, true, flight.err
+		case <-ctx.Done():
+			return nil, true, ctx.Err()
+		}
+	}
+
+	flight := &parseFlight{done: make(chan struct{}), err: errParseAborted}
+	g.flights[key] = flight
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.flights, key)
+		g.mu.Unlock()
+		close(flight.done)
+	}()
+
+	flight.result, flight.err = fn()
+	return flight.result, false, flight.err
+}
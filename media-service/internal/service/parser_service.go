@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -12,7 +14,7 @@ import (
 	"youdlp/media-service/internal/cache"
 	"youdlp/media-service/internal/client"
 	"youdlp/media-service/internal/config"
-	"youdlp/media-service/internal/detector"
+	"youdlp/media-service/internal/platform"
 	"youdlp/media-service/internal/platformpolicy"
 	"youdlp/media-service/internal/ratelimit"
 	"youdlp/media-service/internal/redact"
@@ -22,7 +24,8 @@ import (
 
 // ParserService 解析服务
 type ParserService struct {
-	detector              *detector.PlatformDetector
+	platforms             *platform.Registry
+	shortLinks            shortLinkExpander
 	cache                 parserCache
 	adapters              map[string]adapter.Adapter
 	limiter               *utils.ConcurrencyLimiter
@@ -32,11 +35,29 @@ type ParserService struct {
 	youtubePolicy         platformpolicy.YouTubePolicy
 	proxyRetryMaxAttempts int
 	platformLimiter       *ratelimit.PlatformLimiter
+	flights               parseFlightGroup
+	parseLock             parseLocker
+	parseLockTTL          time.Duration
+	parseLockWait         time.Duration
+	batchConcurrency      int
 }
 
 type parserCache interface {
-	Get(ctx context.Context, url string) (*cache.ParseResult, error)
-	Set(ctx context.Context, url string, result *cache.ParseResult) error
+	Get(ctx context.Context, identity string) (*cache.ParseResult, error)
+	Set(ctx context.Context, identity string, result *cache.ParseResult) error
+	GetFailure(ctx context.Context, identity string) error
+	SetFailure(ctx context.Context, identity string, parseErr error) error
+}
+
+type parseLocker interface {
+	AcquireParseLock(ctx context.Context, identity string, ttl time.Duration) (release func(), ok bool)
+}
+
+// parseLockPollInterval 等待其他实例解析时轮询缓存的间隔
+const parseLockPollInterval = 250 * time.Millisecond
+
+type shortLinkExpander interface {
+	Expand(ctx context.Context, shortURL string) (string, error)
 }
 
 type parserAssetClient interface {
@@ -57,6 +78,7 @@ type parseAccessContext struct {
 // NewParserService 创建解析服务
 func NewParserService(
 	cfg *config.Config,
+	platforms *platform.Registry,
 	cacheService *cache.Service,
 	redisClient *redis.Client,
 	logger *zap.Logger,
@@ -64,27 +86,19 @@ func NewParserService(
 	// 创建yt-dlp wrapper
 	ytdlpWrapper := ytdlp.NewWrapper(&cfg.YTDLP)
 
-	// 创建平台适配器（不传递静态 cookie 文件，改为动态获取）
+	// 为注册表中的每个平台创建适配器（不传递静态 cookie 文件，改为动态获取）
 	adapters := make(map[string]adapter.Adapter)
-
-	// YouTube适配器
-	if platformCfg, ok := cfg.Platforms["youtube"]; ok && platformCfg.Enabled {
-		adapters["youtube"] = adapter.NewYouTubeAdapter(ytdlpWrapper, "", cfg.YTDLP.YouTube.Args)
-	}
-
-	// Bilibili适配器
-	if platformCfg, ok := cfg.Platforms["bilibili"]; ok && platformCfg.Enabled {
-		adapters["bilibili"] = adapter.NewBilibiliAdapter(ytdlpWrapper, "", platformCfg.ExtraArgs)
-	}
-
-	// TikTok适配器
-	if platformCfg, ok := cfg.Platforms["tiktok"]; ok && platformCfg.Enabled {
-		adapters["tiktok"] = adapter.NewTikTokAdapter(ytdlpWrapper, "", platformCfg.ExtraArgs)
-	}
-
-	// 通用适配器
-	if platformCfg, ok := cfg.Platforms["generic"]; ok && platformCfg.Enabled {
-		adapters["generic"] = adapter.NewGenericAdapter(ytdlpWrapper)
+	for _, info := range platforms.List() {
+		args := info.ExtraArgs
+		if platformpolicy.IsYouTubePlatform(info.Name) {
+			args = append(append([]string(nil), cfg.YTDLP.YouTube.Args...), info.ExtraArgs...)
+		}
+		adpt, err := adapter.New(info.Adapter, ytdlpWrapper, args)
+		if err != nil {
+			logger.Warn("skipping platform with unsupported adapter", zap.String("platform", info.Name), zap.Error(err))
+			continue
+		}
+		adapters[info.Name] = adpt
 	}
 
 	// 创建 Asset 客户端（可选）
@@ -109,8 +123,12 @@ func NewParserService(
 			zap.Bool("enable_cookies", cfg.AssetService.EnableCookies))
 	}
 
+	platformLimiter := ratelimit.NewPlatformLimiter(redisClient)
+	platformLimiter.SetLimits(platforms)
+
 	return &ParserService{
-		detector:              detector.NewPlatformDetector(),
+		platforms:             platforms,
+		shortLinks:            platform.NewShortLinkResolver(platforms, redisClient),
 		cache:                 cacheService,
 		adapters:              adapters,
 		limiter:               utils.NewConcurrencyLimiter(cfg.YTDLP.MaxConcurrent),
@@ -119,51 +137,164 @@ func NewParserService(
 		enableCookies:         cfg.AssetService.EnableCookies && assetClient != nil,
 		youtubePolicy:         cfg.YTDLP.YouTube,
 		proxyRetryMaxAttempts: cfg.AssetService.ProxyRetryMaxAttempts,
-		platformLimiter:       ratelimit.NewPlatformLimiter(redisClient),
+		platformLimiter:       platformLimiter,
+		parseLock:             cacheService,
+		parseLockTTL:          cfg.Cache.GetLockTTL(),
+		parseLockWait:         cfg.Cache.GetLockWait(),
+		batchConcurrency:      cfg.YTDLP.BatchConcurrency,
 	}
 }
 
 // ParseURL 解析视频URL
-func (s *ParserService) ParseURL(ctx context.Context, taskID, url string, skipCache bool) (*cache.ParseResult, error) {
-	// 1. 标准化URL
-	url = utils.NormalizeURL(url)
+func (s *ParserService) ParseURL(ctx context.Context, taskID, rawURL string, skipCache bool) (*cache.ParseResult, error) {
+	// 1. 解析规范身份（展开短链、去除追踪参数）
+	identity, err := s.canonicalize(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return s.parseIdentity(ctx, taskID, identity, skipCache)
+}
+
+// parseIdentity 解析已规范化的视频身份
+func (s *ParserService) parseIdentity(ctx context.Context, taskID string, identity *platform.Identity, skipCache bool) (*cache.ParseResult, error) {
+	url := identity.FetchURL
 
-	// 2. 验证URL
-	if !utils.IsValidURL(url) {
-		return nil, utils.ErrInvalidURL
+	// 2. 检查平台是否启用并获取对应的适配器
+	platform, adpt, err := s.platformAdapter(identity.Platform)
+	if err != nil {
+		return nil, err
 	}
 
-	// 3. 检查缓存
+	// 3. 按规范身份检查缓存，同一视频的不同写法共用缓存
+	key := identity.Key()
 	if !skipCache {
-		if cached, err := s.cache.Get(ctx, url); err == nil {
-			s.logger.Info("cache hit", zap.String("url", url))
+		if cached, err := s.cache.Get(ctx, key); err == nil {
+			s.logger.Info("cache hit", zap.String("url", url), zap.String("identity", key))
 			if err := s.attachDynamicAccess(cached, taskID); err != nil {
 				return nil, err
 			}
 			return cached, nil
 		}
+
+		// 4. 近期已确认不可用（私有、已删除、地区限制等）的视频直接返回，不再请求平台
+		if failure := s.cache.GetFailure(ctx, key); failure != nil {
+			s.logger.Info("negative cache hit", zap.String("url", url), zap.String("identity", key), zap.Error(failure))
+			return nil, failure
+		}
+	}
+
+	// 5. 同一视频同一时间只解析一次，并发请求等待进行中的解析结果；
+	// 强制刷新的请求不加入普通解析（其结果可能来自缓存），单独合并
+	flightKey := key
+	if skipCache {
+		flightKey += "|refresh"
+	}
+	for {
+		result, shared, err := s.flights.Do(ctx, flightKey, func() (*cache.ParseResult, error) {
+			return s.parseExclusive(ctx, taskID, identity, platform, adpt, skipCache)
+		})
+		if !shared {
+			return result, err
+		}
+		if err != nil {
+			// 等待的解析被其调用方取消时，当前请求仍有效则重新发起
+			if ctx.Err() == nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+				continue
+			}
+			return nil, err
+		}
+
+		s.logger.Info("joined in-flight parse", zap.String("url", url), zap.String("identity", key))
+		// 结果复制后为当前任务单独获取 cookie/代理，不复用其他任务的租约
+		joined := *result
+		if err := s.attachDynamicAccess(&joined, taskID); err != nil {
+			return nil, err
+		}
+		return &joined, nil
 	}
+}
 
-	// 4. 检测平台
-	platform, err := s.detector.Detect(url)
+// parseExclusive 持有跨实例解析锁执行解析。其他实例正在解析同一视频时等待其写入缓存，
+// 解析失败且为终态错误时写入短时负缓存
+func (s *ParserService) parseExclusive(ctx context.Context, taskID string, identity *platform.Identity, platform string, adpt adapter.Adapter, skipCache bool) (*cache.ParseResult, error) {
+	key := identity.Key()
+	release, cached, err := s.waitParseLock(ctx, key, skipCache)
 	if err != nil {
 		return nil, err
 	}
+	if cached == nil && !skipCache {
+		// 获取锁前其他实例可能刚完成解析
+		if hit, err := s.cache.Get(ctx, key); err == nil {
+			cached = hit
+		}
+	}
+	if cached != nil {
+		release()
+		if err := s.attachDynamicAccess(cached, taskID); err != nil {
+			return nil, err
+		}
+		return cached, nil
+	}
+	defer release()
 
-	// 5. 获取对应的适配器
-	adpt, ok := s.adapters[platform]
-	if !ok {
-		// 如果没有对应适配器,使用通用适配器
-		adpt, ok = s.adapters["generic"]
-		if !ok {
-			return nil, utils.ErrUnsupportedPlatform
+	result, err := s.parse(ctx, taskID, identity, platform, adpt)
+	if err != nil {
+		cacheCtx, cacheCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cacheCancel()
+		if cacheErr := s.cache.SetFailure(cacheCtx, key, err); cacheErr != nil {
+			s.logger.Warn("negative cache set failed", zap.Error(cacheErr))
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// waitParseLock 获取跨实例解析锁。锁被其他实例持有时轮询缓存等待其结果，对方释放锁后重新竞争；
+// 等待超过 parseLockWait 后不再等待，直接解析
+func (s *ParserService) waitParseLock(ctx context.Context, key string, skipCache bool) (func(), *cache.ParseResult, error) {
+	if s.parseLock == nil {
+		return func() {}, nil, nil
+	}
+
+	deadline := time.Now().Add(s.parseLockWait)
+	for {
+		release, ok := s.parseLock.AcquireParseLock(ctx, key, s.parseLockTTL)
+		if ok {
+			return release, nil, nil
+		}
+		if !time.Now().Before(deadline) {
+			s.logger.Warn("timed out waiting for parse lock, parsing without it", zap.String("identity", key))
+			return func() {}, nil, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-time.After(parseLockPollInterval):
+		}
+
+		// 强制刷新的请求不接受旧缓存，只等待锁释放
+		if skipCache {
+			continue
+		}
+		if cached, err := s.cache.Get(ctx, key); err == nil {
+			s.logger.Info("parse result shared by another instance", zap.String("identity", key))
+			return func() {}, cached, nil
+		}
+		if failure := s.cache.GetFailure(ctx, key); failure != nil {
+			return nil, nil, failure
 		}
 	}
+}
+
+// parse 调用适配器解析视频并写入缓存
+func (s *ParserService) parse(ctx context.Context, taskID string, identity *platform.Identity, platform string, adpt adapter.Adapter) (*cache.ParseResult, error) {
+	url := identity.FetchURL
 
 	if allowed, limitErr := s.platformLimiter.Allow(ctx, platform, ratelimit.StageParse); limitErr != nil {
 		s.logger.Warn("platform parse limiter failed open", zap.String("platform", platform), zap.Error(limitErr))
 	} else if !allowed {
-		return nil, fmt.Errorf("platform parse rate limited: %s", platform)
+		return nil, fmt.Errorf("%w: %s", utils.ErrPlatformRateLimited, platform)
 	}
 
 	// 6. 并发控制
@@ -199,33 +330,45 @@ func (s *ParserService) ParseURL(ctx context.Context, taskID, url string, skipCa
 		zap.String("url", url),
 		zap.String("video_id", videoInfo.ID),
 		zap.String("title", videoInfo.Title),
-		zap.Int("format_count", len(videoInfo.Formats)))
+		zap.Int("format_count", len(videoInfo.Formats)),
+		zap.Int("subtitle_count", len(videoInfo.Subtitles)),
+		zap.Int("auto_caption_count", len(videoInfo.AutomaticCaptions)),
+		zap.Int("chapter_count", len(videoInfo.Chapters)))
 
 	// 8. 标准化格式
 	formats := utils.NormalizeFormats(videoInfo.Formats)
 
 	// 9. 构造结果
 	result := &cache.ParseResult{
-		VideoID:       videoInfo.ID,
-		Platform:      platform,
-		Title:         utils.SanitizeString(videoInfo.Title),
-		Description:   utils.SanitizeString(videoInfo.Description),
-		Duration:      videoInfo.Duration,
-		Thumbnail:     videoInfo.Thumbnail,
-		Author:        utils.SanitizeString(videoInfo.Uploader),
-		UploadDate:    videoInfo.UploadDate,
-		ViewCount:     videoInfo.ViewCount,
-		Formats:       formats,
-		CookieID:      accessCtx.cookieID,
-		ProxyURL:      proxyURL,
-		ProxyLeaseID:  proxyLeaseID,
-		ProxyExpireAt: proxyExpireAt,
+		VideoID:        videoInfo.ID,
+		Platform:       platform,
+		Title:          utils.SanitizeString(videoInfo.Title),
+		Description:    utils.SanitizeString(videoInfo.Description),
+		Duration:       videoInfo.Duration,
+		Thumbnail:      videoInfo.Thumbnail,
+		Author:         utils.SanitizeString(videoInfo.Uploader),
+		UploadDate:     videoInfo.UploadDate,
+		ViewCount:      videoInfo.ViewCount,
+		Formats:        formats,
+		Subtitles:      utils.NormalizeSubtitles(videoInfo.Subtitles, videoInfo.AutomaticCaptions),
+		Chapters:       utils.NormalizeChapters(videoInfo.Chapters),
+		AudioLanguages: utils.AudioLanguages(formats),
+		LiveStatus:     utils.NormalizeLiveStatus(videoInfo.LiveStatus, videoInfo.IsLive, videoInfo.WasLive),
+		AgeLimit:       videoInfo.AgeLimit,
+		Tags:           utils.NormalizeTerms(videoInfo.Tags),
+		Categories:     utils.NormalizeTerms(videoInfo.Categories),
+		ChannelID:      videoInfo.ChannelID,
+		ChannelURL:     videoInfo.ChannelURL,
+		CookieID:       accessCtx.cookieID,
+		ProxyURL:       proxyURL,
+		ProxyLeaseID:   proxyLeaseID,
+		ProxyExpireAt:  proxyExpireAt,
 	}
 
 	// 10. 写入缓存（使用独立的 context 避免超时）
 	cacheCtx, cacheCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cacheCancel()
-	if err := s.cache.Set(cacheCtx, url, result); err != nil {
+	if err := s.cache.Set(cacheCtx, identity.Key(), result); err != nil {
 		s.logger.Warn("cache set failed", zap.Error(err))
 	}
 
@@ -238,6 +381,21 @@ func (s *ParserService) ParseURL(ctx context.Context, taskID, url string, skipCa
 }
 
 func (s *ParserService) parseWithProxyRetry(ctx context.Context, taskID, url, platform string, adpt adapter.Adapter) (*ytdlp.VideoInfo, *parseAccessContext, error) {
+	var videoInfo *ytdlp.VideoInfo
+	accessCtx, err := s.runWithProxyRetry(ctx, taskID, url, platform, adpt, func(proxyURL, cookieFile string) error {
+		info, err := adpt.ParseWithProxyAndCookie(ctx, url, proxyURL, cookieFile)
+		videoInfo = info
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return videoInfo, accessCtx, nil
+}
+
+// runWithProxyRetry 获取 cookie/代理后执行 extract，遇到代理或风控类错误时轮换代理重试。
+// 成功时返回的 accessCtx 中 cookie 文件由调用方负责清理。
+func (s *ParserService) runWithProxyRetry(ctx context.Context, taskID, url, platform string, adpt adapter.Adapter, extract func(proxyURL, cookieFile string) error) (*parseAccessContext, error) {
 	maxAttempts := s.parseProxyMaxAttempts()
 	var lastErr error
 
@@ -251,9 +409,9 @@ func (s *ParserService) parseWithProxyRetry(ctx context.Context, taskID, url, pl
 				zap.Int("max_attempts", maxAttempts),
 				zap.Error(err))
 			if lastErr != nil {
-				return nil, nil, lastErr
+				return nil, lastErr
 			}
-			return nil, nil, err
+			return nil, err
 		}
 
 		s.logger.Info("parsing video",
@@ -271,16 +429,16 @@ func (s *ParserService) parseWithProxyRetry(ctx context.Context, taskID, url, pl
 			proxyURL = accessCtx.proxyLease.URL
 		}
 
-		videoInfo, err := adpt.ParseWithProxyAndCookie(ctx, url, proxyURL, accessCtx.cookieFile)
+		err = extract(proxyURL, accessCtx.cookieFile)
 		usageReported := s.reportParseAccessUsage(taskID, accessCtx, err)
 		if err == nil {
-			return videoInfo, accessCtx, nil
+			return accessCtx, nil
 		}
 
 		lastErr = err
 		if !s.shouldRetryParseWithNewProxy(attempt, maxAttempts, accessCtx, err, usageReported) {
 			s.cleanupCookieFile(accessCtx.cookieFile)
-			return nil, nil, err
+			return nil, err
 		}
 
 		s.logger.Warn("parse failed with retryable proxy or bot-detection error, rotating proxy",
@@ -292,7 +450,7 @@ func (s *ParserService) parseWithProxyRetry(ctx context.Context, taskID, url, pl
 		s.cleanupCookieFile(accessCtx.cookieFile)
 	}
 
-	return nil, nil, lastErr
+	return nil, lastErr
 }
 
 func (s *ParserService) reportParseAccessUsage(taskID string, accessCtx *parseAccessContext, parseErr error) bool {
@@ -342,20 +500,25 @@ func (s *ParserService) parseProxyMaxAttempts() int {
 	return s.proxyRetryMaxAttempts + 1
 }
 
-func (s *ParserService) getParseAccessContext(ctx context.Context, taskID, platform string) (*parseAccessContext, error) {
+func (s *ParserService) getParseAccessContext(ctx context.Context, taskID, platformName string) (*parseAccessContext, error) {
 	accessCtx := &parseAccessContext{}
+	policy := s.platformPolicy(platformName)
 
-	if platformpolicy.IsYouTubePlatform(platform) && s.youtubePolicy.CookiesDisabled() {
+	if platformpolicy.IsYouTubePlatform(platformName) && s.youtubePolicy.CookiesDisabled() {
 		s.logger.Info("youtube access policy applied",
-			zap.String("platform", platform),
+			zap.String("platform", platformName),
 			zap.Bool("youtube_cookie_disabled", true))
+	} else if policy != nil && policy.CookiesDisabled() {
+		s.logger.Info("platform cookie policy applied",
+			zap.String("platform", platformName),
+			zap.String("cookies", policy.Cookies))
 	} else if s.enableCookies && s.assetClient != nil {
-		s.logger.Info("attempting to get cookie", zap.String("platform", platform))
+		s.logger.Info("attempting to get cookie", zap.String("platform", platformName))
 
-		cookieFile, cookieID, err := s.assetClient.GetAvailableCookie(platform)
+		cookieFile, cookieID, err := s.assetClient.GetAvailableCookie(platformName)
 		if err != nil {
 			s.logger.Warn("failed to get cookie, continuing without cookie",
-				zap.String("platform", platform),
+				zap.String("platform", platformName),
 				zap.Error(err))
 		} else {
 			accessCtx.cookieFile = cookieFile
@@ -364,8 +527,20 @@ func (s *ParserService) getParseAccessContext(ctx context.Context, taskID, platf
 	} else {
 		s.logger.Info("cookie feature disabled or not configured")
 	}
+	if policy != nil && policy.Cookies == platform.AccessRequired && accessCtx.cookieFile == "" {
+		return nil, utils.ErrCookieRequired
+	}
+
+	if policy != nil && policy.Proxy == platform.ProxyDirect {
+		s.logger.Info("platform proxy policy is direct, skipping proxy lease", zap.String("platform", platformName))
+		return accessCtx, nil
+	}
 
+	proxyRequired := policy != nil && policy.Proxy == platform.AccessRequired
 	if s.assetClient == nil {
+		if proxyRequired {
+			return nil, utils.ErrProxyRequired
+		}
 		s.logger.Info("asset service not configured, using direct connection for parsing")
 		return accessCtx, nil
 	}
@@ -374,7 +549,7 @@ func (s *ParserService) getParseAccessContext(ctx context.Context, taskID, platf
 	var proxyLease *client.ProxyLease
 	var err error
 	if taskID != "" {
-		proxyLease, err = s.assetClient.AcquireProxyForTask(ctx, taskID, platform)
+		proxyLease, err = s.assetClient.AcquireProxyForTask(ctx, taskID, platformName)
 	} else {
 		proxyLease, err = s.assetClient.GetAvailableProxy()
 	}
@@ -382,6 +557,10 @@ func (s *ParserService) getParseAccessContext(ctx context.Context, taskID, platf
 		return nil, fmt.Errorf("failed to get proxy lease: %w", err)
 	}
 	if proxyLease == nil || proxyLease.URL == "" {
+		if proxyRequired {
+			s.cleanupCookieFile(accessCtx.cookieFile)
+			return nil, utils.ErrProxyRequired
+		}
 		s.logger.Info("no proxy lease available, using direct connection", zap.String("task_id", taskID))
 		return accessCtx, nil
 	}
@@ -429,30 +608,94 @@ func (s *ParserService) cleanupCookieFile(cookieFile string) {
 	s.logger.Info("cleaned up cookie file", zap.String("cookie_file", cookieFile))
 }
 
-// ValidateURL 验证URL是否有效
-func (s *ParserService) ValidateURL(ctx context.Context, url string) (bool, string, string) {
-	// 1. 标准化URL
-	url = utils.NormalizeURL(url)
+// URLValidation URL 校验结果，CanonicalURL 为同一视频各种写法共用的规范地址
+type URLValidation struct {
+	Valid        bool
+	Platform     string
+	Message      string
+	CanonicalURL string
+	VideoID      string
+}
+
+// ValidateURL 验证URL是否有效，并返回规范地址
+func (s *ParserService) ValidateURL(ctx context.Context, rawURL string) *URLValidation {
+	// 1. 解析规范身份，同时校验URL格式
+	identity, err := s.canonicalize(ctx, rawURL)
+	if err != nil {
+		if err == utils.ErrInvalidURL {
+			return &URLValidation{Message: "invalid URL format"}
+		}
+		return &URLValidation{Message: fmt.Sprintf("unsupported platform: %v", err)}
+	}
+
+	result := &URLValidation{
+		Platform:     identity.Platform.Name,
+		CanonicalURL: identity.URL,
+		VideoID:      identity.VideoID,
+	}
 
-	// 2. 验证URL格式
-	if !utils.IsValidURL(url) {
-		return false, "", "invalid URL format"
+	// 2. 检查平台是否启用、是否有对应的适配器
+	switch _, _, err := s.platformAdapter(identity.Platform); err {
+	case nil:
+		result.Valid = true
+	case utils.ErrPlatformDisabled:
+		result.Message = "platform is disabled"
+	case utils.ErrUnsupportedPlatform:
+		result.Message = "no adapter available for this platform"
+	default:
+		result.Message = err.Error()
 	}
+	return result
+}
 
-	// 3. 检测平台
-	platform, err := s.detector.Detect(url)
+// canonicalize 展开已注册平台的短链后解析规范身份，短链展开失败时按原地址处理
+func (s *ParserService) canonicalize(ctx context.Context, rawURL string) (*platform.Identity, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if s.shortLinks != nil && s.platforms.IsShortLink(rawURL) {
+		expanded, err := s.shortLinks.Expand(ctx, rawURL)
+		if err != nil {
+			s.logger.Warn("failed to expand short link", zap.String("url", rawURL), zap.Error(err))
+		} else {
+			rawURL = expanded
+		}
+	}
+	return s.platforms.Canonicalize(rawURL)
+}
+
+// resolvePlatform 检测 URL 所属平台并返回对应适配器
+func (s *ParserService) resolvePlatform(url string) (string, adapter.Adapter, error) {
+	p, err := s.platforms.Detect(url)
 	if err != nil {
-		return false, "", fmt.Sprintf("unsupported platform: %v", err)
+		return "", nil, err
 	}
+	return s.platformAdapter(p)
+}
 
-	// 4. 检查是否有对应的适配器
-	_, ok := s.adapters[platform]
+// platformAdapter 返回平台对应的适配器，平台无专用适配器时使用通用适配器。
+// 平台被禁用时仍返回平台名称，便于调用方提示
+func (s *ParserService) platformAdapter(p *platform.Platform) (string, adapter.Adapter, error) {
+	if !s.platforms.Enabled(p.Name) {
+		return p.Name, nil, utils.ErrPlatformDisabled
+	}
+
+	adpt, ok := s.adapters[p.Name]
 	if !ok {
-		_, ok = s.adapters["generic"]
+		adpt, ok = s.adapters[platform.GenericName]
 		if !ok {
-			return false, platform, "no adapter available for this platform"
+			return p.Name, nil, utils.ErrUnsupportedPlatform
 		}
 	}
+	return p.Name, adpt, nil
+}
 
-	return true, platform, ""
+// platformPolicy 返回平台的 cookie/代理策略，未注册的平台返回 nil
+func (s *ParserService) platformPolicy(name string) *platform.Platform {
+	if s.platforms == nil {
+		return nil
+	}
+	p, ok := s.platforms.Get(name)
+	if !ok {
+		return nil
+	}
+	return p
 }
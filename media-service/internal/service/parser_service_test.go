@@ -2,32 +2,65 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"go.uber.org/zap"
 
 	"youdlp/media-service/internal/adapter"
 	"youdlp/media-service/internal/cache"
 	"youdlp/media-service/internal/client"
-	"youdlp/media-service/internal/detector"
+	"youdlp/media-service/internal/platform"
 	"youdlp/media-service/internal/platformpolicy"
 	"youdlp/media-service/internal/utils"
 	"youdlp/media-service/internal/ytdlp"
 )
 
 type fakeParserCache struct {
-	getResult *cache.ParseResult
-	getErr    error
-	setCalls  int
-	setResult *cache.ParseResult
+	mu          sync.Mutex
+	getResult   *cache.ParseResult
+	getErr      error
+	hitAfter    int
+	setCalls    int
+	setResult   *cache.ParseResult
+	getKeys     []string
+	setKeys     []string
+	failure     error
+	failureSets []error
 }
 
-func (f *fakeParserCache) Get(context.Context, string) (*cache.ParseResult, error) {
+func (f *fakeParserCache) Get(_ context.Context, identity string) (*cache.ParseResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.getKeys = append(f.getKeys, identity)
+	if f.hitAfter > 0 && len(f.getKeys) > f.hitAfter {
+		cloned := *f.getResult
+		return &cloned, nil
+	}
 	return f.getResult, f.getErr
 }
 
-func (f *fakeParserCache) Set(_ context.Context, _ string, result *cache.ParseResult) error {
+func (f *fakeParserCache) GetFailure(context.Context, string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.failure
+}
+
+func (f *fakeParserCache) SetFailure(_ context.Context, _ string, parseErr error) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failureSets = append(f.failureSets, parseErr)
+	return nil
+}
+
+func (f *fakeParserCache) Set(_ context.Context, identity string, result *cache.ParseResult) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setKeys = append(f.setKeys, identity)
 	f.setCalls++
 	cloned := *result
 	f.setResult = &cloned
@@ -35,6 +68,7 @@ func (f *fakeParserCache) Set(_ context.Context, _ string, result *cache.ParseRe
 }
 
 type fakeParserAssetClient struct {
+	mu           sync.Mutex
 	proxyLease   *client.ProxyLease
 	proxyErr     error
 	proxyLeases  []*client.ProxyLease
@@ -64,6 +98,8 @@ func (f *fakeParserAssetClient) GetAvailableProxy() (*client.ProxyLease, error)
 }
 
 func (f *fakeParserAssetClient) ReportProxyUsage(taskID, proxyLeaseID, stage string, success bool, errorCategory, _ string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	f.reports = append(f.reports, proxyUsageReport{
 		taskID:        taskID,
 		proxyLeaseID:  proxyLeaseID,
@@ -83,6 +119,8 @@ func (f *fakeParserAssetClient) CleanupCookieFile(string) error {
 }
 
 func (f *fakeParserAssetClient) nextProxyLease() (*client.ProxyLease, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	idx := f.acquireCalls
 	f.acquireCalls++
 
@@ -103,6 +141,9 @@ type fakeAdapter struct {
 	parseResponse  *ytdlp.VideoInfo
 	parseResponses []*ytdlp.VideoInfo
 	parseErrors    []error
+	playlist       *ytdlp.PlaylistInfo
+	playlistStart  int
+	playlistEnd    int
 }
 
 func (f *fakeAdapter) Parse(context.Context, string) (*ytdlp.VideoInfo, error) {
@@ -129,6 +170,14 @@ func (f *fakeAdapter) ParseWithProxyAndCookie(_ context.Context, _ string, proxy
 	return f.parseResponse, nil
 }
 
+func (f *fakeAdapter) ParsePlaylistWithProxyAndCookie(_ context.Context, _ string, proxyURL, cookieFile string, start, end int) (*ytdlp.PlaylistInfo, error) {
+	f.lastProxyURL = proxyURL
+	f.lastCookie = cookieFile
+	f.playlistStart = start
+	f.playlistEnd = end
+	return f.playlist, nil
+}
+
 var _ adapter.Adapter = (*fakeAdapter)(nil)
 
 func TestParseURLFallsBackToDirectConnectionWhenProxyUnavailable(t *testing.T) {
@@ -147,8 +196,8 @@ func TestParseURLFallsBackToDirectConnectionWhenProxyUnavailable(t *testing.T) {
 	}
 
 	svc := &ParserService{
-		detector: detectorForTests(),
-		cache:    cacheStub,
+		platforms: platformsForTests(),
+		cache:     cacheStub,
 		adapters: map[string]adapter.Adapter{
 			"generic": adapterStub,
 		},
@@ -202,8 +251,8 @@ func TestParseURLRotatesProxyOnBotDetection(t *testing.T) {
 	}
 
 	svc := &ParserService{
-		detector: detectorForTests(),
-		cache:    cacheStub,
+		platforms: platformsForTests(),
+		cache:     cacheStub,
 		adapters: map[string]adapter.Adapter{
 			"generic": adapterStub,
 		},
@@ -268,8 +317,8 @@ func TestParseURLAllowsOnlyOneProxyRetry(t *testing.T) {
 	}
 
 	svc := &ParserService{
-		detector: detectorForTests(),
-		cache:    cacheStub,
+		platforms: platformsForTests(),
+		cache:     cacheStub,
 		adapters: map[string]adapter.Adapter{
 			"generic": adapterStub,
 		},
@@ -315,8 +364,8 @@ func TestParseURLDoesNotRotateProxyForTerminalVideoError(t *testing.T) {
 	}
 
 	svc := &ParserService{
-		detector: detectorForTests(),
-		cache:    cacheStub,
+		platforms: platformsForTests(),
+		cache:     cacheStub,
 		adapters: map[string]adapter.Adapter{
 			"generic": adapterStub,
 		},
@@ -341,6 +390,9 @@ func TestParseURLDoesNotRotateProxyForTerminalVideoError(t *testing.T) {
 	if len(assetStub.reports) != 1 || assetStub.reports[0].success {
 		t.Fatalf("expected one failed proxy report, got %#v", assetStub.reports)
 	}
+	if len(cacheStub.failureSets) != 1 || !errors.Is(cacheStub.failureSets[0], utils.ErrVideoPrivate) {
+		t.Fatalf("expected terminal error to be negatively cached, got %v", cacheStub.failureSets)
+	}
 }
 
 func TestGetParseAccessContextWithoutAssetClientUsesDirectConnection(t *testing.T) {
@@ -362,8 +414,278 @@ func TestGetParseAccessContextWithoutAssetClientUsesDirectConnection(t *testing.
 	}
 }
 
-func detectorForTests() *detector.PlatformDetector {
-	return detector.NewPlatformDetector()
+type fakeShortLinkExpander struct {
+	targets map[string]string
+}
+
+func (f *fakeShortLinkExpander) Expand(_ context.Context, shortURL string) (string, error) {
+	if target, ok := f.targets[shortURL]; ok {
+		return target, nil
+	}
+	return "", fmt.Errorf("unresolved short link: %s", shortURL)
+}
+
+func TestParseURLSharesCacheAcrossURLVariants(t *testing.T) {
+	t.Parallel()
+
+	cacheStub := &fakeParserCache{getErr: utils.ErrCacheMiss}
+	svc := &ParserService{
+		platforms: platformsForTests(),
+		shortLinks: &fakeShortLinkExpander{targets: map[string]string{
+			"https://b23.tv/abc123": "https://m.bilibili.com/video/BV1xx411c7mD?share_source=copy_web&p=2",
+		}},
+		cache: cacheStub,
+		adapters: map[string]adapter.Adapter{
+			"generic": &fakeAdapter{parseResponse: &ytdlp.VideoInfo{ID: "dQw4w9WgXcQ", Title: "Title"}},
+		},
+		limiter:     utils.NewConcurrencyLimiter(1),
+		logger:      zap.NewNop(),
+		assetClient: &fakeParserAssetClient{},
+	}
+
+	for _, url := range []string{
+		"https://youtu.be/dQw4w9WgXcQ?t=30",
+		"https://www.youtube.com/watch?v=dQw4w9WgXcQ&t=30&utm_source=share",
+		"https://M.YouTube.com/watch?feature=share&v=dQw4w9WgXcQ#comments",
+		"https://www.youtube.com/shorts/dQw4w9WgXcQ",
+	} {
+		if _, err := svc.ParseURL(context.Background(), "task-1", url, false); err != nil {
+			t.Fatalf("ParseURL(%q) returned error: %v", url, err)
+		}
+	}
+	for _, key := range append(cacheStub.getKeys, cacheStub.setKeys...) {
+		if key != "youtube:dQw4w9WgXcQ" {
+			t.Fatalf("expected all variants to share one cache identity, got get=%v set=%v", cacheStub.getKeys, cacheStub.setKeys)
+		}
+	}
+
+	result := svc.ValidateURL(context.Background(), "https://b23.tv/abc123")
+	if !result.Valid || result.Platform != "bilibili" || result.VideoID != "BV1xx411c7mD" {
+		t.Fatalf("unexpected validation result: %+v", result)
+	}
+	if result.CanonicalURL != "https://www.bilibili.com/video/BV1xx411c7mD?p=2" {
+		t.Fatalf("unexpected canonical url: %s", result.CanonicalURL)
+	}
+
+	// 短链无法展开时按原地址处理
+	if result := svc.ValidateURL(context.Background(), "https://b23.tv/unknown"); !result.Valid || result.CanonicalURL != "https://b23.tv/unknown" {
+		t.Fatalf("expected unresolved short link to validate as-is, got %+v", result)
+	}
+}
+
+// blockingAdapter 解析阻塞到 release 关闭，用于构造并发解析；每次开始解析向 started 发送信号
+type blockingAdapter struct {
+	fakeAdapter
+	calls   atomic.Int32
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingAdapter) ParseWithProxyAndCookie(context.Context, string, string, string) (*ytdlp.VideoInfo, error) {
+	b.calls.Add(1)
+	if b.started != nil {
+		b.started <- struct{}{}
+	}
+	<-b.release
+	return &ytdlp.VideoInfo{ID: "vid-1", Title: "Title"}, nil
+}
+
+func TestParseURLCoalescesConcurrentParses(t *testing.T) {
+	t.Parallel()
+
+	const callers = 5
+	cacheStub := &fakeParserCache{getErr: utils.ErrCacheMiss}
+	assetStub := &fakeParserAssetClient{}
+	for i := 0; i < callers; i++ {
+		assetStub.proxyLeases = append(assetStub.proxyLeases, &client.ProxyLease{
+			URL:     fmt.Sprintf("http://proxy-%d:8080", i),
+			LeaseID: fmt.Sprintf("lease-%d", i),
+		})
+	}
+	adapterStub := &blockingAdapter{started: make(chan struct{}, callers), release: make(chan struct{})}
+	svc := &ParserService{
+		platforms: platformsForTests(),
+		cache:     cacheStub,
+		adapters: map[string]adapter.Adapter{
+			"generic": adapterStub,
+		},
+		limiter:     utils.NewConcurrencyLimiter(callers),
+		logger:      zap.NewNop(),
+		assetClient: assetStub,
+	}
+
+	joined := make(chan struct{}, callers)
+	svc.flights.onJoin = func(string) { joined <- struct{}{} }
+
+	results := make([]*cache.ParseResult, callers)
+	errs := make([]error, callers)
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = svc.ParseURL(context.Background(), fmt.Sprintf("task-%d", i), "https://example.com/video?utm_source=share", false)
+		}(i)
+	}
+
+	// 首个调用开始解析、其余调用全部加入进行中的解析后再放行
+	awaitSignals(t, adapterStub.started, 1)
+	awaitSignals(t, joined, callers-1)
+	close(adapterStub.release)
+	wg.Wait()
+
+	if calls := adapterStub.calls.Load(); calls != 1 {
+		t.Fatalf("expected one yt-dlp parse for concurrent callers, got %d", calls)
+	}
+	leases := map[string]bool{}
+	for i := range results {
+		if errs[i] != nil {
+			t.Fatalf("caller %d returned error: %v", i, errs[i])
+		}
+		if results[i].VideoID != "vid-1" {
+			t.Fatalf("caller %d got unexpected result: %+v", i, results[i])
+		}
+		leases[results[i].ProxyLeaseID] = true
+	}
+	// 每个任务仍持有自己的代理租约
+	if len(leases) != callers {
+		t.Fatalf("expected %d distinct proxy leases, got %v", callers, leases)
+	}
+	if cacheStub.setCalls != 1 {
+		t.Fatalf("expected cache set once, got %d", cacheStub.setCalls)
+	}
+}
+
+func TestParseURLRefreshDoesNotJoinCachedParse(t *testing.T) {
+	t.Parallel()
+
+	adapterStub := &blockingAdapter{started: make(chan struct{}, 2), release: make(chan struct{})}
+	svc := &ParserService{
+		platforms: platformsForTests(),
+		cache:     &fakeParserCache{getErr: utils.ErrCacheMiss},
+		adapters: map[string]adapter.Adapter{
+			"generic": adapterStub,
+		},
+		limiter:     utils.NewConcurrencyLimiter(2),
+		logger:      zap.NewNop(),
+		assetClient: &fakeParserAssetClient{},
+	}
+	svc.flights.onJoin = func(string) { t.Error("refresh parse joined a regular in-flight parse") }
+
+	var wg sync.WaitGroup
+	for i, skipCache := range []bool{false, true} {
+		wg.Add(1)
+		go func(i int, skipCache bool) {
+			defer wg.Done()
+			if _, err := svc.ParseURL(context.Background(), fmt.Sprintf("task-%d", i), "https://example.com/video", skipCache); err != nil {
+				t.Errorf("ParseURL(skipCache=%v) returned error: %v", skipCache, err)
+			}
+		}(i, skipCache)
+	}
+
+	// 两个请求都开始解析说明强制刷新没有等待普通解析的结果
+	awaitSignals(t, adapterStub.started, 2)
+	close(adapterStub.release)
+	wg.Wait()
+}
+
+// awaitSignals 等待 ch 收到 n 个信号
+func awaitSignals(t *testing.T, ch <-chan struct{}, n int) {
+	t.Helper()
+	timeout := time.After(2 * time.Second)
+	for i := 0; i < n; i++ {
+		select {
+		case <-ch:
+		case <-timeout:
+			t.Fatalf("timed out after %d of %d signals", i, n)
+		}
+	}
+}
+
+func TestParseURLReturnsCachedTerminalFailure(t *testing.T) {
+	t.Parallel()
+
+	cacheStub := &fakeParserCache{getErr: utils.ErrCacheMiss, failure: utils.ErrVideoDeleted}
+	adapterStub := &fakeAdapter{}
+	assetStub := &fakeParserAssetClient{}
+	svc := &ParserService{
+		platforms: platformsForTests(),
+		cache:     cacheStub,
+		adapters: map[string]adapter.Adapter{
+			"generic": adapterStub,
+		},
+		limiter:     utils.NewConcurrencyLimiter(1),
+		logger:      zap.NewNop(),
+		assetClient: assetStub,
+	}
+
+	_, err := svc.ParseURL(context.Background(), "task-1", "https://example.com/video", false)
+	if !errors.Is(err, utils.ErrVideoDeleted) {
+		t.Fatalf("expected cached terminal error, got %v", err)
+	}
+	if adapterStub.parseCalls != 0 || assetStub.acquireCalls != 0 {
+		t.Fatalf("expected no parse or proxy acquisition, got parse=%d acquire=%d", adapterStub.parseCalls, assetStub.acquireCalls)
+	}
+}
+
+type fakeParseLocker struct {
+	mu       sync.Mutex
+	attempts int
+}
+
+func (f *fakeParseLocker) AcquireParseLock(context.Context, string, time.Duration) (func(), bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.attempts++
+	return nil, false
+}
+
+func TestParseURLWaitsForParseLockedByAnotherInstance(t *testing.T) {
+	t.Parallel()
+
+	// 首次检查缓存未命中，持有锁的实例随后写入结果
+	cacheStub := &fakeParserCache{
+		getErr:    utils.ErrCacheMiss,
+		getResult: &cache.ParseResult{VideoID: "vid-1", Platform: "generic"},
+		hitAfter:  2,
+	}
+	adapterStub := &fakeAdapter{}
+	lockStub := &fakeParseLocker{}
+	svc := &ParserService{
+		platforms: platformsForTests(),
+		cache:     cacheStub,
+		adapters: map[string]adapter.Adapter{
+			"generic": adapterStub,
+		},
+		limiter:       utils.NewConcurrencyLimiter(1),
+		logger:        zap.NewNop(),
+		assetClient:   &fakeParserAssetClient{proxyLease: &client.ProxyLease{URL: "http://proxy-a:8080", LeaseID: "lease-a"}},
+		parseLock:     lockStub,
+		parseLockTTL:  time.Minute,
+		parseLockWait: 5 * time.Second,
+	}
+
+	result, err := svc.ParseURL(context.Background(), "task-1", "https://example.com/video", false)
+	if err != nil {
+		t.Fatalf("ParseURL returned error: %v", err)
+	}
+	if adapterStub.parseCalls != 0 {
+		t.Fatalf("expected result from the lock holder, got %d local parses", adapterStub.parseCalls)
+	}
+	if result.VideoID != "vid-1" || result.ProxyLeaseID != "lease-a" {
+		t.Fatalf("expected shared result with own proxy lease, got %+v", result)
+	}
+	if lockStub.attempts == 0 {
+		t.Fatal("expected parse lock to be attempted")
+	}
+}
+
+func platformsForTests() *platform.Registry {
+	registry, err := platform.NewRegistry(platform.DefaultDefinitions())
+	if err != nil {
+		panic(err)
+	}
+	return registry
 }
 
 var _ parserCache = (*fakeParserCache)(nil)
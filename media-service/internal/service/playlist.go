@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"youdlp/media-service/internal/ratelimit"
+	"youdlp/media-service/internal/utils"
+	"youdlp/media-service/internal/ytdlp"
+)
+
+const (
+	defaultPlaylistPageSize = 50
+	maxPlaylistPageSize     = 200
+)
+
+// PlaylistResult 播放列表/频道分页解析结果
+type PlaylistResult struct {
+	PlaylistID string
+	Platform   string
+	Title      string
+	Author     string
+	TotalCount int64 // 平台未返回总数时为 0
+	Page       int
+	PageSize   int
+	HasMore    bool
+	Entries    []PlaylistEntry
+}
+
+// PlaylistEntry 播放列表条目
+type PlaylistEntry struct {
+	Index     int // 在播放列表中的序号，从 1 开始
+	VideoID   string
+	URL       string
+	Title     string
+	Duration  int64
+	Thumbnail string
+}
+
+// ParsePlaylist 扁平解析播放列表或频道，按页返回条目（不解析格式）
+func (s *ParserService) ParsePlaylist(ctx context.Context, taskID, url string, page, pageSize int) (*PlaylistResult, error) {
+	url = utils.NormalizeURL(url)
+	if !utils.IsValidURL(url) {
+		return nil, utils.ErrInvalidURL
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPlaylistPageSize
+	}
+	if pageSize > maxPlaylistPageSize {
+		pageSize = maxPlaylistPageSize
+	}
+
+	platform, adpt, err := s.resolvePlatform(url)
+	if err != nil {
+		return nil, err
+	}
+
+	if allowed, limitErr := s.platformLimiter.Allow(ctx, platform, ratelimit.StageParse); limitErr != nil {
+		s.logger.Warn("platform parse limiter failed open", zap.String("platform", platform), zap.Error(limitErr))
+	} else if !allowed {
+		return nil, fmt.Errorf("%w: %s", utils.ErrPlatformRateLimited, platform)
+	}
+
+	s.limiter.Acquire()
+	defer s.limiter.Release()
+
+	// 多取一条用于判断是否还有下一页（部分平台不返回总数）
+	start := (page-1)*pageSize + 1
+	end := start + pageSize
+
+	var info *ytdlp.PlaylistInfo
+	accessCtx, err := s.runWithProxyRetry(ctx, taskID, url, platform, adpt, func(proxyURL, cookieFile string) error {
+		result, err := adpt.ParsePlaylistWithProxyAndCookie(ctx, url, proxyURL, cookieFile, start, end)
+		info = result
+		return err
+	})
+	if err != nil {
+		s.logger.Error("playlist parse failed",
+			zap.String("url", url),
+			zap.String("platform", platform),
+			zap.Error(err))
+		return nil, err
+	}
+	if accessCtx != nil && accessCtx.cookieFile != "" {
+		defer s.cleanupCookieFile(accessCtx.cookieFile)
+	}
+	if info == nil {
+		return nil, utils.ErrVideoNotFound
+	}
+
+	entries := make([]PlaylistEntry, 0, pageSize)
+	for i, entry := range info.Entries {
+		if i >= pageSize {
+			break
+		}
+		entries = append(entries, PlaylistEntry{
+			Index:     start + i,
+			VideoID:   entry.ID,
+			URL:       s.playlistEntryURL(platform, &entry),
+			Title:     utils.SanitizeString(entry.Title),
+			Duration:  entry.Duration,
+			Thumbnail: entry.BestThumbnail(),
+		})
+	}
+
+	hasMore := len(info.Entries) > pageSize
+	if info.PlaylistCount > 0 {
+		hasMore = int64(start-1+len(entries)) < info.PlaylistCount
+	}
+
+	author := info.Uploader
+	if author == "" {
+		author = info.Channel
+	}
+
+	s.logger.Info("playlist parse success",
+		zap.String("url", url),
+		zap.String("playlist_id", info.ID),
+		zap.Int("page", page),
+		zap.Int("entry_count", len(entries)),
+		zap.Int64("total_count", info.PlaylistCount))
+
+	return &PlaylistResult{
+		PlaylistID: info.ID,
+		Platform:   platform,
+		Title:      utils.SanitizeString(info.Title),
+		Author:     utils.SanitizeString(author),
+		TotalCount: info.PlaylistCount,
+		Page:       page,
+		PageSize:   pageSize,
+		HasMore:    hasMore,
+		Entries:    entries,
+	}, nil
+}
+
+// playlistEntryURL 扁平提取的条目 URL 可能只是 ID，这里按平台的条目地址模板补全为可直接提交下载的地址
+func (s *ParserService) playlistEntryURL(platform string, entry *ytdlp.PlaylistEntry) string {
+	if strings.HasPrefix(entry.URL, "http://") || strings.HasPrefix(entry.URL, "https://") {
+		return entry.URL
+	}
+	if policy := s.platformPolicy(platform); policy != nil {
+		if url := policy.EntryURLFor(entry.ID); url != "" {
+			return url
+		}
+	}
+	return entry.URL
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"youdlp/media-service/internal/adapter"
+	"youdlp/media-service/internal/platformpolicy"
+	"youdlp/media-service/internal/utils"
+	"youdlp/media-service/internal/ytdlp"
+)
+
+func TestParsePlaylistPaginatesFlatEntries(t *testing.T) {
+	t.Parallel()
+
+	adapterStub := &fakeAdapter{
+		playlist: &ytdlp.PlaylistInfo{
+			ID:            "PL1",
+			Title:         "  Course  ",
+			Uploader:      "Teacher",
+			PlaylistCount: 5,
+			Entries: []ytdlp.PlaylistEntry{
+				{ID: "v3", Title: "Lesson 3", Duration: 60},
+				{ID: "v4", URL: "https://www.youtube.com/watch?v=v4", Title: "Lesson 4"},
+			},
+		},
+	}
+
+	svc := &ParserService{
+		platforms:     platformsForTests(),
+		adapters:      map[string]adapter.Adapter{"youtube": adapterStub},
+		limiter:       utils.NewConcurrencyLimiter(1),
+		logger:        zap.NewNop(),
+		assetClient:   &fakeParserAssetClient{},
+		youtubePolicy: platformpolicy.YouTubePolicy{},
+	}
+
+	result, err := svc.ParsePlaylist(context.Background(), "", "https://www.youtube.com/playlist?list=PL1", 2, 2)
+	if err != nil {
+		t.Fatalf("ParsePlaylist returned error: %v", err)
+	}
+
+	if adapterStub.playlistStart != 3 || adapterStub.playlistEnd != 5 {
+		t.Fatalf("expected items 3:5, got %d:%d", adapterStub.playlistStart, adapterStub.playlistEnd)
+	}
+	if result.Title != "Course" || result.Author != "Teacher" {
+		t.Fatalf("unexpected playlist metadata: %+v", result)
+	}
+	if len(result.Entries) != 2 || result.Entries[0].Index != 3 {
+		t.Fatalf("unexpected entries: %+v", result.Entries)
+	}
+	if result.Entries[0].URL != "https://www.youtube.com/watch?v=v3" {
+		t.Fatalf("expected entry URL to be completed from ID, got %q", result.Entries[0].URL)
+	}
+	if !result.HasMore {
+		t.Fatalf("expected another page for 5 total entries")
+	}
+}
+
+func TestParsePlaylistDetectsLastPageWithoutTotal(t *testing.T) {
+	t.Parallel()
+
+	adapterStub := &fakeAdapter{
+		playlist: &ytdlp.PlaylistInfo{
+			ID:      "ch",
+			Entries: []ytdlp.PlaylistEntry{{ID: "a"}, {ID: "b"}},
+		},
+	}
+
+	svc := &ParserService{
+		platforms:   platformsForTests(),
+		adapters:    map[string]adapter.Adapter{"generic": adapterStub},
+		limiter:     utils.NewConcurrencyLimiter(1),
+		logger:      zap.NewNop(),
+		assetClient: &fakeParserAssetClient{},
+	}
+
+	result, err := svc.ParsePlaylist(context.Background(), "", "https://example.com/channel", 1, 2)
+	if err != nil {
+		t.Fatalf("ParsePlaylist returned error: %v", err)
+	}
+	if result.HasMore {
+		t.Fatalf("expected last page when adapter returned no extra entry")
+	}
+}
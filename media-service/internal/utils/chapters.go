@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Chapter yt-dlp返回的章节信息（秒）
+type Chapter struct {
+	StartTime float64 `json:"start_time"`
+	EndTime   float64 `json:"end_time"`
+	Title     string  `json:"title"`
+}
+
+// NormalizeChapters 按起始时间排序并过滤无效章节，缺失标题时按序号补齐
+func NormalizeChapters(chapters []Chapter) []Chapter {
+	result := make([]Chapter, 0, len(chapters))
+	for _, ch := range chapters {
+		if ch.StartTime < 0 || ch.EndTime <= ch.StartTime {
+			continue
+		}
+		result = append(result, ch)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].StartTime < result[j].StartTime
+	})
+
+	for i := range result {
+		result[i].Title = SanitizeString(result[i].Title)
+		if result[i].Title == "" {
+			result[i].Title = fmt.Sprintf("Chapter %d", i+1)
+		}
+	}
+	return result
+}
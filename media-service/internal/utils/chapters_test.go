@@ -0,0 +1,21 @@
+package utils
+
+import "testing"
+
+func TestNormalizeChapters(t *testing.T) {
+	chapters := NormalizeChapters([]Chapter{
+		{StartTime: 60, EndTime: 120, Title: "Second"},
+		{StartTime: 0, EndTime: 60, Title: ""},
+		{StartTime: 120, EndTime: 120, Title: "Empty"},
+	})
+
+	if len(chapters) != 2 {
+		t.Fatalf("expected 2 chapters, got %d", len(chapters))
+	}
+	if chapters[0].StartTime != 0 || chapters[0].Title != "Chapter 1" {
+		t.Fatalf("unexpected first chapter: %+v", chapters[0])
+	}
+	if chapters[1].Title != "Second" {
+		t.Fatalf("unexpected second chapter: %+v", chapters[1])
+	}
+}
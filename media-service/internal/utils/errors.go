@@ -9,6 +9,12 @@ var (
 	// URL相关错误
 	ErrInvalidURL          = errors.New("invalid URL")
 	ErrUnsupportedPlatform = errors.New("unsupported platform")
+	ErrPlatformDisabled    = errors.New("platform is disabled")
+	ErrPlatformRateLimited = errors.New("platform parse rate limited")
+
+	// 平台访问策略相关错误
+	ErrCookieRequired = errors.New("platform requires a cookie but none is available")
+	ErrProxyRequired  = errors.New("platform requires a proxy but none is available")
 
 	// 视频相关错误
 	ErrVideoNotFound  = errors.New("video not found")
@@ -102,13 +108,38 @@ func ClassifyAccessError(err error) string {
 	}
 }
 
+// terminalVideoErrors 视频本身不可用的错误，重试或更换代理都不会改变结果
+var terminalVideoErrors = []error{
+	ErrVideoNotFound,
+	ErrVideoPrivate,
+	ErrVideoDeleted,
+	ErrGeoRestricted,
+	ErrAgeRestricted,
+	ErrCopyrightClaim,
+}
+
+// TerminalVideoError 返回 err 对应的终态视频错误，非终态错误返回 nil
+func TerminalVideoError(err error) error {
+	for _, terminal := range terminalVideoErrors {
+		if errors.Is(err, terminal) {
+			return terminal
+		}
+	}
+	return nil
+}
+
+// TerminalVideoErrorFromMessage 按错误信息还原终态视频错误，用于读取缓存的失败结果
+func TerminalVideoErrorFromMessage(message string) error {
+	for _, terminal := range terminalVideoErrors {
+		if terminal.Error() == message {
+			return terminal
+		}
+	}
+	return nil
+}
+
 func isTerminalVideoError(err error) bool {
-	return errors.Is(err, ErrVideoNotFound) ||
-		errors.Is(err, ErrVideoPrivate) ||
-		errors.Is(err, ErrVideoDeleted) ||
-		errors.Is(err, ErrGeoRestricted) ||
-		errors.Is(err, ErrAgeRestricted) ||
-		errors.Is(err, ErrCopyrightClaim)
+	return TerminalVideoError(err) != nil
 }
 
 func normalizeRetryableErrorText(text string) string {
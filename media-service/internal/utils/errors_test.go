@@ -22,3 +22,19 @@ func TestIsProxyOrBotRetryableErrorSkipsTerminalVideoErrors(t *testing.T) {
 		t.Fatalf("expected terminal video error to be non-retryable")
 	}
 }
+
+func TestTerminalVideoErrorRoundTripsThroughMessage(t *testing.T) {
+	t.Parallel()
+
+	err := fmt.Errorf("%w: ERROR: [youtube] abc: Video unavailable", ErrGeoRestricted)
+	terminal := TerminalVideoError(err)
+	if terminal != ErrGeoRestricted {
+		t.Fatalf("expected geo-restricted error, got %v", terminal)
+	}
+	if restored := TerminalVideoErrorFromMessage(terminal.Error()); restored != ErrGeoRestricted {
+		t.Fatalf("expected message to restore the same error, got %v", restored)
+	}
+	if TerminalVideoError(fmt.Errorf("%w: timed out", ErrTimeout)) != nil {
+		t.Fatal("expected retryable errors not to be terminal")
+	}
+}
@@ -0,0 +1,51 @@
+package utils
+
+import "strings"
+
+// 直播状态，取值与 yt-dlp 的 live_status 一致
+const (
+	LiveStatusNotLive    = "not_live"
+	LiveStatusIsLive     = "is_live"
+	LiveStatusWasLive    = "was_live"
+	LiveStatusIsUpcoming = "is_upcoming"
+	LiveStatusPostLive   = "post_live" // 直播刚结束，回放尚在处理
+)
+
+// maxMetadataTerms 标签、分类的保留上限，部分平台会返回上百个标签
+const maxMetadataTerms = 50
+
+// NormalizeLiveStatus 规范直播状态。旧版本 yt-dlp 或部分提取器不返回 live_status，
+// 此时按 is_live / was_live 推断；无法判断时返回空
+func NormalizeLiveStatus(liveStatus string, isLive, wasLive bool) string {
+	switch status := strings.ToLower(strings.TrimSpace(liveStatus)); status {
+	case LiveStatusNotLive, LiveStatusIsLive, LiveStatusWasLive, LiveStatusIsUpcoming, LiveStatusPostLive:
+		return status
+	}
+	switch {
+	case isLive:
+		return LiveStatusIsLive
+	case wasLive:
+		return LiveStatusWasLive
+	default:
+		return ""
+	}
+}
+
+// NormalizeTerms 清理标签、分类列表：去除空白与重复项并限制数量
+func NormalizeTerms(terms []string) []string {
+	seen := make(map[string]bool, len(terms))
+	result := make([]string, 0, len(terms))
+	for _, term := range terms {
+		term = SanitizeString(term)
+		key := strings.ToLower(term)
+		if term == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, term)
+		if len(result) == maxMetadataTerms {
+			break
+		}
+	}
+	return result
+}
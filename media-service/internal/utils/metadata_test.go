@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeLiveStatusFallsBackToFlags(t *testing.T) {
+	cases := []struct {
+		status  string
+		isLive  bool
+		wasLive bool
+		want    string
+	}{
+		{status: "is_upcoming", want: LiveStatusIsUpcoming},
+		{status: "Was_Live", want: LiveStatusWasLive},
+		{isLive: true, want: LiveStatusIsLive},
+		{status: "unknown", wasLive: true, want: LiveStatusWasLive},
+		{want: ""},
+	}
+	for _, tc := range cases {
+		if got := NormalizeLiveStatus(tc.status, tc.isLive, tc.wasLive); got != tc.want {
+			t.Fatalf("NormalizeLiveStatus(%q, %v, %v) = %q, want %q", tc.status, tc.isLive, tc.wasLive, got, tc.want)
+		}
+	}
+}
+
+func TestNormalizeTermsDeduplicates(t *testing.T) {
+	got := NormalizeTerms([]string{" Music ", "music", "", "Live"})
+	if want := []string{"Music", "Live"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestNormalizeFormatsCarriesLanguageAndDynamicRange(t *testing.T) {
+	formats := NormalizeFormats([]VideoFormat{
+		{FormatID: "337", Ext: "webm", VCodec: "vp9.2", ACodec: "none", Height: 2160, DynamicRange: "hdr10"},
+		{FormatID: "137", Ext: "mp4", VCodec: "avc1", ACodec: "none", Height: 1080},
+		{FormatID: "140-0", Ext: "m4a", VCodec: "none", ACodec: "mp4a", Language: "en"},
+		{FormatID: "140-1", Ext: "m4a", VCodec: "none", ACodec: "mp4a", Language: "es"},
+		{FormatID: "139", Ext: "m4a", VCodec: "none", ACodec: "mp4a", Language: "und"},
+	})
+
+	byID := make(map[string]NormalizedFormat, len(formats))
+	for _, f := range formats {
+		byID[f.FormatID] = f
+	}
+	if byID["337"].DynamicRange != "HDR10" || byID["137"].DynamicRange != "SDR" || byID["140-0"].DynamicRange != "" {
+		t.Fatalf("unexpected dynamic ranges: %+v", byID)
+	}
+	if byID["139"].Language != "" {
+		t.Fatalf("expected undetermined language to be dropped, got %q", byID["139"].Language)
+	}
+
+	languages := AudioLanguages(formats)
+	if len(languages) != 2 || !contains(languages, "en") || !contains(languages, "es") {
+		t.Fatalf("expected en and es audio languages, got %v", languages)
+	}
+}
+
+func contains(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
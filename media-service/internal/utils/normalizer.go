@@ -20,26 +20,30 @@ type VideoFormat struct {
 	ACodec         string  `json:"acodec"`
 	Height         int     `json:"height"`
 	Width          int     `json:"width"`
-	VBR            float64 `json:"vbr"` // 视频码率 kbps
-	ABR            float64 `json:"abr"` // 音频码率 kbps
-	ASR            int     `json:"asr"` // 音频采样率 Hz
+	VBR            float64 `json:"vbr"`           // 视频码率 kbps
+	ABR            float64 `json:"abr"`           // 音频码率 kbps
+	ASR            int     `json:"asr"`           // 音频采样率 Hz
+	Language       string  `json:"language"`      // 音轨语言，多音轨（配音）视频按格式区分
+	DynamicRange   string  `json:"dynamic_range"` // SDR、HDR10、HLG、DV 等
 }
 
 // NormalizedFormat 标准化后的格式信息
 type NormalizedFormat struct {
-	FormatID   string
-	Quality    string // 1080p, 720p, etc.
-	Extension  string
-	Filesize   int64
-	Height     int
-	Width      int
-	FPS        float64
-	VideoCodec string
-	AudioCodec string
-	VBR        float64 // 视频码率 kbps
-	ABR        float64 // 音频码率 kbps
-	ASR        int     // 音频采样率 Hz
-	Score      int     // 优先级分数
+	FormatID     string
+	Quality      string // 1080p, 720p, etc.
+	Extension    string
+	Filesize     int64
+	Height       int
+	Width        int
+	FPS          float64
+	VideoCodec   string
+	AudioCodec   string
+	VBR          float64 // 视频码率 kbps
+	ABR          float64 // 音频码率 kbps
+	ASR          int     // 音频采样率 Hz
+	Language     string  // 音轨语言，未知时为空
+	DynamicRange string  // 视频动态范围，纯音频格式为空
+	Score        int     // 优先级分数
 }
 
 // NormalizeFormats 标准化视频格式列表
@@ -95,19 +99,21 @@ func NormalizeFormats(rawFormats []VideoFormat) []NormalizedFormat {
 		}
 
 		result = append(result, NormalizedFormat{
-			FormatID:   f.FormatID,
-			Quality:    quality,
-			Extension:  f.Ext,
-			Filesize:   filesize,
-			Height:     height,
-			Width:      f.Width,
-			FPS:        f.FPS,
-			VideoCodec: f.VCodec,
-			AudioCodec: f.ACodec,
-			VBR:        f.VBR,
-			ABR:        f.ABR,
-			ASR:        f.ASR,
-			Score:      score,
+			FormatID:     f.FormatID,
+			Quality:      quality,
+			Extension:    f.Ext,
+			Filesize:     filesize,
+			Height:       height,
+			Width:        f.Width,
+			FPS:          f.FPS,
+			VideoCodec:   f.VCodec,
+			AudioCodec:   f.ACodec,
+			VBR:          f.VBR,
+			ABR:          f.ABR,
+			ASR:          f.ASR,
+			Language:     normalizeLanguage(f.Language),
+			DynamicRange: normalizeDynamicRange(f),
+			Score:        score,
 		})
 	}
 
@@ -122,6 +128,41 @@ func NormalizeFormats(rawFormats []VideoFormat) []NormalizedFormat {
 	return result
 }
 
+// AudioLanguages 返回格式列表中出现的音轨语言（按首次出现顺序去重），供前端选择配音音轨
+func AudioLanguages(formats []NormalizedFormat) []string {
+	seen := make(map[string]bool)
+	var languages []string
+	for _, f := range formats {
+		if f.Language == "" || f.AudioCodec == "" || f.AudioCodec == "none" || seen[f.Language] {
+			continue
+		}
+		seen[f.Language] = true
+		languages = append(languages, f.Language)
+	}
+	return languages
+}
+
+// normalizeLanguage 清理 yt-dlp 返回的语言代码，未知语言（und、none）视为空
+func normalizeLanguage(language string) string {
+	language = strings.TrimSpace(language)
+	switch strings.ToLower(language) {
+	case "", "und", "none", "null":
+		return ""
+	}
+	return language
+}
+
+// normalizeDynamicRange 视频格式缺少动态范围时按 SDR 处理，纯音频格式返回空
+func normalizeDynamicRange(f VideoFormat) string {
+	if f.VCodec == "none" || (f.VCodec == "" && f.Height == 0) {
+		return ""
+	}
+	if dynamicRange := strings.TrimSpace(f.DynamicRange); dynamicRange != "" {
+		return strings.ToUpper(dynamicRange)
+	}
+	return "SDR"
+}
+
 // extractHeight 从分辨率字符串提取高度
 func extractHeight(resolution string) int {
 	if resolution == "" {
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+)
+
+// SubtitleFormat yt-dlp返回的字幕格式信息
+type SubtitleFormat struct {
+	Ext  string `json:"ext"`
+	URL  string `json:"url"`
+	Name string `json:"name"`
+}
+
+// SubtitleTrack 标准化后的字幕轨道
+type SubtitleTrack struct {
+	Language  string   // 语言代码，如 en、zh-Hans
+	Name      string   // 平台提供的语言名称
+	Automatic bool     // 是否为自动生成字幕
+	Formats   []string // 可用的字幕格式，如 vtt、srt
+}
+
+// NormalizeSubtitles 合并人工字幕与自动字幕
+// 同一语言同时存在人工字幕和自动字幕时只保留人工字幕；人工字幕排在前面
+func NormalizeSubtitles(manual, automatic map[string][]SubtitleFormat) []SubtitleTrack {
+	result := make([]SubtitleTrack, 0, len(manual))
+	result = append(result, buildSubtitleTracks(manual, false, nil)...)
+	result = append(result, buildSubtitleTracks(automatic, true, manual)...)
+	return result
+}
+
+func buildSubtitleTracks(subtitles map[string][]SubtitleFormat, automatic bool, exclude map[string][]SubtitleFormat) []SubtitleTrack {
+	languages := make([]string, 0, len(subtitles))
+	for lang := range subtitles {
+		// live_chat 是直播弹幕回放，不是字幕
+		if lang == "" || lang == "live_chat" {
+			continue
+		}
+		if _, ok := exclude[lang]; ok {
+			continue
+		}
+		languages = append(languages, lang)
+	}
+	sort.Strings(languages)
+
+	tracks := make([]SubtitleTrack, 0, len(languages))
+	for _, lang := range languages {
+		track := SubtitleTrack{
+			Language:  lang,
+			Automatic: automatic,
+		}
+		seen := make(map[string]bool)
+		for _, f := range subtitles[lang] {
+			if track.Name == "" && f.Name != "" {
+				track.Name = f.Name
+			}
+			ext := strings.ToLower(strings.TrimSpace(f.Ext))
+			if ext == "" || seen[ext] {
+				continue
+			}
+			seen[ext] = true
+			track.Formats = append(track.Formats, ext)
+		}
+		if len(track.Formats) == 0 {
+			continue
+		}
+		tracks = append(tracks, track)
+	}
+	return tracks
+}
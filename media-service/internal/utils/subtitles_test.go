@@ -0,0 +1,32 @@
+package utils
+
+import "testing"
+
+func TestNormalizeSubtitlesPrefersManualTracks(t *testing.T) {
+	t.Parallel()
+
+	tracks := NormalizeSubtitles(
+		map[string][]SubtitleFormat{
+			"en":        {{Ext: "vtt", Name: "English"}, {Ext: "srt", Name: "English"}, {Ext: "VTT"}},
+			"live_chat": {{Ext: "json"}},
+		},
+		map[string][]SubtitleFormat{
+			"en": {{Ext: "vtt", Name: "English (auto)"}},
+			"fr": {{Ext: "vtt", Name: "French"}},
+			"de": {},
+		},
+	)
+
+	if len(tracks) != 2 {
+		t.Fatalf("expected two tracks, got %+v", tracks)
+	}
+	if tracks[0].Language != "en" || tracks[0].Automatic || tracks[0].Name != "English" {
+		t.Fatalf("expected manual english track first, got %+v", tracks[0])
+	}
+	if len(tracks[0].Formats) != 2 || tracks[0].Formats[0] != "vtt" || tracks[0].Formats[1] != "srt" {
+		t.Fatalf("expected deduplicated formats, got %v", tracks[0].Formats)
+	}
+	if tracks[1].Language != "fr" || !tracks[1].Automatic {
+		t.Fatalf("expected automatic french track, got %+v", tracks[1])
+	}
+}
@@ -30,6 +30,19 @@ type VideoInfo struct {
 	UploadDate  string              `json:"upload_date"`
 	ViewCount   int64               `json:"view_count"`
 	Formats     []utils.VideoFormat `json:"formats"`
+
+	Subtitles         map[string][]utils.SubtitleFormat `json:"subtitles"`
+	AutomaticCaptions map[string][]utils.SubtitleFormat `json:"automatic_captions"`
+	Chapters          []utils.Chapter                   `json:"chapters"`
+
+	LiveStatus string   `json:"live_status"` // not_live、is_live、was_live、is_upcoming、post_live
+	IsLive     bool     `json:"is_live"`
+	WasLive    bool     `json:"was_live"`
+	AgeLimit   int      `json:"age_limit"` // 观看年龄下限，0 表示无限制
+	Tags       []string `json:"tags"`
+	Categories []string `json:"categories"`
+	ChannelID  string   `json:"channel_id"`
+	ChannelURL string   `json:"channel_url"`
 }
 
 func (v *VideoInfo) UnmarshalJSON(data []byte) error {
@@ -49,6 +62,73 @@ func (v *VideoInfo) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// PlaylistInfo yt-dlp 扁平提取的播放列表/频道信息
+type PlaylistInfo struct {
+	ID            string          `json:"id"`
+	Title         string          `json:"title"`
+	Uploader      string          `json:"uploader"`
+	Channel       string          `json:"channel"`
+	WebpageURL    string          `json:"webpage_url"`
+	PlaylistCount int64           `json:"playlist_count"`
+	Entries       []PlaylistEntry `json:"entries"`
+}
+
+// PlaylistEntry 播放列表条目（扁平提取，不包含格式信息）
+type PlaylistEntry struct {
+	ID         string              `json:"id"`
+	URL        string              `json:"url"`
+	Title      string              `json:"title"`
+	Duration   int64               `json:"duration"`
+	Thumbnail  string              `json:"thumbnail"`
+	Thumbnails []PlaylistThumbnail `json:"thumbnails"`
+	Uploader   string              `json:"uploader"`
+	Type       string              `json:"_type"`
+}
+
+func (e *PlaylistEntry) UnmarshalJSON(data []byte) error {
+	type alias PlaylistEntry
+	aux := &struct {
+		Duration flexibleInt64 `json:"duration"`
+		*alias
+	}{
+		alias: (*alias)(e),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	e.Duration = int64(aux.Duration)
+	return nil
+}
+
+// PlaylistThumbnail 条目缩略图
+type PlaylistThumbnail struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// BestThumbnail 返回条目缩略图，未直接提供时取尺寸最大的候选项
+func (e *PlaylistEntry) BestThumbnail() string {
+	if e.Thumbnail != "" {
+		return e.Thumbnail
+	}
+	best := ""
+	bestArea := -1
+	for _, thumb := range e.Thumbnails {
+		if thumb.URL == "" {
+			continue
+		}
+		area := thumb.Width * thumb.Height
+		if area > bestArea {
+			best = thumb.URL
+			bestArea = area
+		}
+	}
+	return best
+}
+
 type flexibleInt64 int64
 
 func (d *flexibleInt64) UnmarshalJSON(data []byte) error {
@@ -313,6 +393,60 @@ func (w *Wrapper) ExtractInfoWithProxy(ctx context.Context, url, proxyURL, cooki
 	return &info, nil
 }
 
+// ExtractPlaylist 扁平提取播放列表/频道条目，start/end 为 1 起始的闭区间
+func (w *Wrapper) ExtractPlaylist(ctx context.Context, url, proxyURL, cookieFile string, start, end int, extraArgs ...string) (*PlaylistInfo, error) {
+	args := []string{
+		"--flat-playlist",
+		"--dump-single-json",
+		"--yes-playlist",
+		"--playlist-items", fmt.Sprintf("%d:%d", start, end),
+	}
+
+	// 添加默认参数 (排除代理与单视频限制)
+	for i := 0; i < len(w.defaultArgs); i++ {
+		if w.defaultArgs[i] == "--proxy" && i+1 < len(w.defaultArgs) {
+			i++
+			continue
+		}
+		if w.defaultArgs[i] == "--no-playlist" {
+			continue
+		}
+		args = append(args, w.defaultArgs[i])
+	}
+
+	if proxyURL == "" {
+		proxyURL = w.proxy
+	}
+	if proxyURL != "" {
+		args = append(args, "--proxy", proxyURL)
+	}
+
+	if cookieFile != "" {
+		if _, err := os.Stat(cookieFile); err == nil {
+			args = append(args, "--cookies", cookieFile)
+		}
+	}
+
+	args = append(args, extraArgs...)
+	args = append(args, url)
+
+	log.Printf("[YT-DLP-PLAYLIST] Executing command: %s %s", w.binaryPath, strings.Join(redact.ProxyArgs(args), " "))
+
+	output, err := w.executeJSONCommand(ctx, "YT-DLP-PLAYLIST", args)
+	if err != nil {
+		return nil, err
+	}
+
+	var info PlaylistInfo
+	if err := json.Unmarshal(output, &info); err != nil {
+		log.Printf("[YT-DLP-PLAYLIST] ERROR: Failed to parse JSON: %v", err)
+		return nil, fmt.Errorf("failed to parse yt-dlp output: %w", err)
+	}
+
+	log.Printf("[YT-DLP-PLAYLIST] Parsed playlist: ID=%s, Title=%s, Entries=%d, Total=%d", info.ID, info.Title, len(info.Entries), info.PlaylistCount)
+	return &info, nil
+}
+
 // Validate 验证URL是否可以解析
 func (w *Wrapper) Validate(url string) error {
 	args := []string{"--simulate", "--no-download"}
@@ -24,3 +24,36 @@ func TestVideoInfoUnmarshalAcceptsFractionalDuration(t *testing.T) {
 		t.Fatalf("Duration = %d, want 212", info.Duration)
 	}
 }
+
+func TestPlaylistInfoUnmarshalFlatEntries(t *testing.T) {
+	t.Parallel()
+
+	raw := []byte(`{
+		"id": "PL123",
+		"title": "Course",
+		"playlist_count": 42,
+		"entries": [
+			{"_type": "url", "id": "a1", "url": "https://www.youtube.com/watch?v=a1", "title": "Lesson 1", "duration": 61.9,
+			 "thumbnails": [{"url": "small.jpg", "width": 120, "height": 90}, {"url": "large.jpg", "width": 480, "height": 360}]},
+			{"_type": "url", "id": "a2", "title": "Lesson 2", "duration": null, "thumbnail": "direct.jpg"}
+		]
+	}`)
+
+	var info PlaylistInfo
+	if err := json.Unmarshal(raw, &info); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	if info.PlaylistCount != 42 || len(info.Entries) != 2 {
+		t.Fatalf("unexpected playlist: count=%d entries=%d", info.PlaylistCount, len(info.Entries))
+	}
+	if info.Entries[0].Duration != 61 {
+		t.Fatalf("Duration = %d, want 61", info.Entries[0].Duration)
+	}
+	if got := info.Entries[0].BestThumbnail(); got != "large.jpg" {
+		t.Fatalf("BestThumbnail = %q, want large.jpg", got)
+	}
+	if got := info.Entries[1].BestThumbnail(); got != "direct.jpg" {
+		t.Fatalf("BestThumbnail = %q, want direct.jpg", got)
+	}
+}
@@ -0,0 +1,2 @@
+-- 回滚：删除任务附加产物表
+DROP TABLE IF EXISTS download_files;
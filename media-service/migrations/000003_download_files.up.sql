@@ -0,0 +1,13 @@
+-- 任务附加产物（字幕等），主文件仍记录在 download_history
+CREATE TABLE IF NOT EXISTS download_files (
+    id          BIGSERIAL PRIMARY KEY,
+    task_id     VARCHAR(64) NOT NULL REFERENCES download_history(task_id) ON DELETE CASCADE,
+    kind        VARCHAR(20) NOT NULL,     -- subtitle
+    language    VARCHAR(32),              -- 字幕语言代码
+    file_path   VARCHAR(1000) NOT NULL,
+    file_name   VARCHAR(500) NOT NULL,
+    file_size   BIGINT NOT NULL DEFAULT 0,
+    created_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_download_files_task_id ON download_files(task_id);
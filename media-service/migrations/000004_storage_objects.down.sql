@@ -0,0 +1,5 @@
+-- 回滚：删除去重存储对象
+ALTER TABLE download_history
+DROP COLUMN IF EXISTS object_id;
+
+DROP TABLE IF EXISTS storage_objects;
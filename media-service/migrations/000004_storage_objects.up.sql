@@ -0,0 +1,20 @@
+-- 内容寻址的去重存储对象，多个下载记录通过硬链接共享同一物理文件
+CREATE TABLE IF NOT EXISTS storage_objects (
+    id           BIGSERIAL PRIMARY KEY,
+    object_key   VARCHAR(64) NOT NULL UNIQUE,   -- sha256(平台 + 视频 ID + 格式 + 后处理配置)
+    platform     VARCHAR(50) NOT NULL,
+    video_id     VARCHAR(255) NOT NULL,
+    format_key   VARCHAR(255) NOT NULL,
+    profile      VARCHAR(100) NOT NULL DEFAULT '',
+    file_path    VARCHAR(1000) NOT NULL,        -- 对象目录中的规范路径
+    file_size    BIGINT NOT NULL DEFAULT 0,
+    file_hash    VARCHAR(64) NOT NULL,          -- MD5，复用前校验
+    ref_count    INT NOT NULL DEFAULT 0,        -- 引用该对象的下载记录数，归零后删除
+    created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_used_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE download_history
+ADD COLUMN IF NOT EXISTS object_id BIGINT REFERENCES storage_objects(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_download_history_object_id ON download_history(object_id);
@@ -0,0 +1,2 @@
+-- 回滚：删除待删除存储对象队列
+DROP TABLE IF EXISTS storage_deletions;
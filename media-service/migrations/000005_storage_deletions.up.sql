@@ -0,0 +1,10 @@
+-- 待删除的存储对象队列：无法直接访问存储后端的服务（如 asset-service）在此登记，由 media-service 清理任务异步删除
+CREATE TABLE IF NOT EXISTS storage_deletions (
+    id          BIGSERIAL PRIMARY KEY,
+    storage_key VARCHAR(1000) NOT NULL,    -- 存储后端中的对象键（兼容旧记录的绝对路径）
+    attempts    INT NOT NULL DEFAULT 0,
+    last_error  TEXT,
+    created_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_storage_deletions_created_at ON storage_deletions(created_at);
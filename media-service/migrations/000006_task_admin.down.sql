@@ -0,0 +1,8 @@
+-- 回滚：删除任务运维信息
+DROP INDEX IF EXISTS idx_download_history_processing;
+DROP INDEX IF EXISTS idx_download_history_failed;
+
+ALTER TABLE download_history
+DROP COLUMN IF EXISTS task_payload,
+DROP COLUMN IF EXISTS dead_lettered_at,
+DROP COLUMN IF EXISTS error_category;
@@ -0,0 +1,11 @@
+-- 任务运维信息：最后一次失败的错误分类、转入死信队列的时间，以及用于重放的原始任务消息
+ALTER TABLE download_history
+ADD COLUMN IF NOT EXISTS error_category VARCHAR(32),
+ADD COLUMN IF NOT EXISTS dead_lettered_at TIMESTAMP,
+ADD COLUMN IF NOT EXISTS task_payload JSONB;
+
+-- 部分索引: 管理端失败/死信任务查询
+CREATE INDEX IF NOT EXISTS idx_download_history_failed ON download_history(created_at DESC) WHERE status = 3;
+
+-- 部分索引: 管理端长时间运行任务查询
+CREATE INDEX IF NOT EXISTS idx_download_history_processing ON download_history(started_at) WHERE status = 1;
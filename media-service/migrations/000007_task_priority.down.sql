@@ -0,0 +1,6 @@
+-- 回滚：删除任务优先级
+DROP INDEX IF EXISTS idx_download_history_started_at;
+DROP INDEX IF EXISTS idx_download_history_queue;
+
+ALTER TABLE download_history
+DROP COLUMN IF EXISTS priority;
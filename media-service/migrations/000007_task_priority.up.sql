@@ -0,0 +1,9 @@
+-- 任务优先级：由 api-gateway 按用户优先级类别写入，用于计算排队位置
+ALTER TABLE download_history
+ADD COLUMN IF NOT EXISTS priority SMALLINT NOT NULL DEFAULT 0;
+
+-- 部分索引: 待处理任务排队位置查询
+CREATE INDEX IF NOT EXISTS idx_download_history_queue ON download_history(priority DESC, created_at) WHERE status = 0;
+
+-- 出队速率统计索引
+CREATE INDEX IF NOT EXISTS idx_download_history_started_at ON download_history(started_at);
@@ -0,0 +1,2 @@
+-- 回滚：删除平台启用状态
+DROP TABLE IF EXISTS platform_settings;
@@ -0,0 +1,7 @@
+-- 管理端切换的平台启用状态，覆盖配置文件中的默认值，各实例定期同步
+CREATE TABLE IF NOT EXISTS platform_settings (
+    platform VARCHAR(50) PRIMARY KEY,
+    enabled BOOLEAN NOT NULL,
+    updated_by VARCHAR(64) NOT NULL DEFAULT '',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
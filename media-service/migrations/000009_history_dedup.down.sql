@@ -0,0 +1,5 @@
+-- 回滚：删除重复提交检测
+DROP INDEX IF EXISTS idx_download_history_active_dedup;
+
+ALTER TABLE download_history
+DROP COLUMN IF EXISTS dedup_key;
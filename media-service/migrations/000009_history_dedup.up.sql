@@ -0,0 +1,8 @@
+-- 重复提交检测：api-gateway 按规范视频地址与下载选项计算 dedup_key，
+-- 同一用户同一 dedup_key 只允许存在一个待处理或下载中的任务
+ALTER TABLE download_history
+ADD COLUMN IF NOT EXISTS dedup_key VARCHAR(64);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_download_history_active_dedup
+ON download_history(user_id, dedup_key)
+WHERE dedup_key IS NOT NULL AND status IN (0, 1);
@@ -1437,6 +1437,7 @@ type GetFileInfoResponse struct {
 	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
 	FileSize      int64                  `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
 	FileHash      string                 `protobuf:"bytes,4,opt,name=file_hash,json=fileHash,proto3" json:"file_hash,omitempty"`
+	Attachments   []*AttachedFile        `protobuf:"bytes,5,rep,name=attachments,proto3" json:"attachments,omitempty"` // 附加产物（字幕等）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1499,6 +1500,98 @@ func (x *GetFileInfoResponse) GetFileHash() string {
 	return ""
 }
 
+func (x *GetFileInfoResponse) GetAttachments() []*AttachedFile {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// 任务附加产物
+type AttachedFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`         // subtitle
+	Language      string                 `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"` // 字幕语言代码
+	FilePath      string                 `protobuf:"bytes,4,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	FileName      string                 `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileSize      int64                  `protobuf:"varint,6,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachedFile) Reset() {
+	*x = AttachedFile{}
+	mi := &file_proto_asset_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachedFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachedFile) ProtoMessage() {}
+
+func (x *AttachedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachedFile.ProtoReflect.Descriptor instead.
+func (*AttachedFile) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AttachedFile) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttachedFile) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AttachedFile) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *AttachedFile) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *AttachedFile) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *AttachedFile) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
 // 创建历史请求
 type CreateHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1512,13 +1605,15 @@ type CreateHistoryRequest struct {
 	Thumbnail     string                 `protobuf:"bytes,8,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
 	Duration      int64                  `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`
 	Author        string                 `protobuf:"bytes,10,opt,name=author,proto3" json:"author,omitempty"`
+	Priority      int32                  `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`                // 下载任务的消息优先级，用于计算排队位置
+	DedupKey      string                 `protobuf:"bytes,12,opt,name=dedup_key,json=dedupKey,proto3" json:"dedup_key,omitempty"` // 重复提交检测键，同一用户相同键的任务未结束时不再创建
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateHistoryRequest) Reset() {
 	*x = CreateHistoryRequest{}
-	mi := &file_proto_asset_proto_msgTypes[24]
+	mi := &file_proto_asset_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1530,7 +1625,7 @@ func (x *CreateHistoryRequest) String() string {
 func (*CreateHistoryRequest) ProtoMessage() {}
 
 func (x *CreateHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[24]
+	mi := &file_proto_asset_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1543,7 +1638,7 @@ func (x *CreateHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateHistoryRequest.ProtoReflect.Descriptor instead.
 func (*CreateHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{24}
+	return file_proto_asset_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CreateHistoryRequest) GetUserId() string {
@@ -1616,16 +1711,32 @@ func (x *CreateHistoryRequest) GetAuthor() string {
 	return ""
 }
 
+func (x *CreateHistoryRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreateHistoryRequest) GetDedupKey() string {
+	if x != nil {
+		return x.DedupKey
+	}
+	return ""
+}
+
 type CreateHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HistoryId     int64                  `protobuf:"varint,1,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"`
+	Duplicate     bool                   `protobuf:"varint,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"` // 已有相同 dedup_key 的未结束任务，history_id 与 task_id 为该任务
+	TaskId        string                 `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateHistoryResponse) Reset() {
 	*x = CreateHistoryResponse{}
-	mi := &file_proto_asset_proto_msgTypes[25]
+	mi := &file_proto_asset_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1637,7 +1748,7 @@ func (x *CreateHistoryResponse) String() string {
 func (*CreateHistoryResponse) ProtoMessage() {}
 
 func (x *CreateHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[25]
+	mi := &file_proto_asset_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1650,7 +1761,7 @@ func (x *CreateHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateHistoryResponse.ProtoReflect.Descriptor instead.
 func (*CreateHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{25}
+	return file_proto_asset_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CreateHistoryResponse) GetHistoryId() int64 {
@@ -1660,6 +1771,20 @@ func (x *CreateHistoryResponse) GetHistoryId() int64 {
 	return 0
 }
 
+func (x *CreateHistoryResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *CreateHistoryResponse) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
 type UpdateHistoryStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -1675,7 +1800,7 @@ type UpdateHistoryStatusRequest struct {
 
 func (x *UpdateHistoryStatusRequest) Reset() {
 	*x = UpdateHistoryStatusRequest{}
-	mi := &file_proto_asset_proto_msgTypes[26]
+	mi := &file_proto_asset_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1687,7 +1812,7 @@ func (x *UpdateHistoryStatusRequest) String() string {
 func (*UpdateHistoryStatusRequest) ProtoMessage() {}
 
 func (x *UpdateHistoryStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[26]
+	mi := &file_proto_asset_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1700,7 +1825,7 @@ func (x *UpdateHistoryStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateHistoryStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateHistoryStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{26}
+	return file_proto_asset_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *UpdateHistoryStatusRequest) GetTaskId() string {
@@ -1761,7 +1886,7 @@ type UpdateHistoryStatusResponse struct {
 
 func (x *UpdateHistoryStatusResponse) Reset() {
 	*x = UpdateHistoryStatusResponse{}
-	mi := &file_proto_asset_proto_msgTypes[27]
+	mi := &file_proto_asset_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1773,7 +1898,7 @@ func (x *UpdateHistoryStatusResponse) String() string {
 func (*UpdateHistoryStatusResponse) ProtoMessage() {}
 
 func (x *UpdateHistoryStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[27]
+	mi := &file_proto_asset_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1786,7 +1911,7 @@ func (x *UpdateHistoryStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateHistoryStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdateHistoryStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{27}
+	return file_proto_asset_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *UpdateHistoryStatusResponse) GetSuccess() bool {
@@ -1815,7 +1940,7 @@ type BillingAccountSnapshot struct {
 
 func (x *BillingAccountSnapshot) Reset() {
 	*x = BillingAccountSnapshot{}
-	mi := &file_proto_asset_proto_msgTypes[28]
+	mi := &file_proto_asset_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1827,7 +1952,7 @@ func (x *BillingAccountSnapshot) String() string {
 func (*BillingAccountSnapshot) ProtoMessage() {}
 
 func (x *BillingAccountSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[28]
+	mi := &file_proto_asset_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1840,7 +1965,7 @@ func (x *BillingAccountSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BillingAccountSnapshot.ProtoReflect.Descriptor instead.
 func (*BillingAccountSnapshot) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{28}
+	return file_proto_asset_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *BillingAccountSnapshot) GetUserId() string {
@@ -1930,7 +2055,7 @@ type GetBillingAccountRequest struct {
 
 func (x *GetBillingAccountRequest) Reset() {
 	*x = GetBillingAccountRequest{}
-	mi := &file_proto_asset_proto_msgTypes[29]
+	mi := &file_proto_asset_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1942,7 +2067,7 @@ func (x *GetBillingAccountRequest) String() string {
 func (*GetBillingAccountRequest) ProtoMessage() {}
 
 func (x *GetBillingAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[29]
+	mi := &file_proto_asset_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1955,7 +2080,7 @@ func (x *GetBillingAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBillingAccountRequest.ProtoReflect.Descriptor instead.
 func (*GetBillingAccountRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{29}
+	return file_proto_asset_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetBillingAccountRequest) GetUserId() string {
@@ -1981,7 +2106,7 @@ type GetBillingAccountResponse struct {
 
 func (x *GetBillingAccountResponse) Reset() {
 	*x = GetBillingAccountResponse{}
-	mi := &file_proto_asset_proto_msgTypes[30]
+	mi := &file_proto_asset_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1993,7 +2118,7 @@ func (x *GetBillingAccountResponse) String() string {
 func (*GetBillingAccountResponse) ProtoMessage() {}
 
 func (x *GetBillingAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[30]
+	mi := &file_proto_asset_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2006,7 +2131,7 @@ func (x *GetBillingAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBillingAccountResponse.ProtoReflect.Descriptor instead.
 func (*GetBillingAccountResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{30}
+	return file_proto_asset_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetBillingAccountResponse) GetAccount() *BillingAccountSnapshot {
@@ -2032,7 +2157,7 @@ type BillingStatementItem struct {
 
 func (x *BillingStatementItem) Reset() {
 	*x = BillingStatementItem{}
-	mi := &file_proto_asset_proto_msgTypes[31]
+	mi := &file_proto_asset_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2044,7 +2169,7 @@ func (x *BillingStatementItem) String() string {
 func (*BillingStatementItem) ProtoMessage() {}
 
 func (x *BillingStatementItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[31]
+	mi := &file_proto_asset_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2057,7 +2182,7 @@ func (x *BillingStatementItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BillingStatementItem.ProtoReflect.Descriptor instead.
 func (*BillingStatementItem) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{31}
+	return file_proto_asset_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *BillingStatementItem) GetStatementId() string {
@@ -2129,7 +2254,7 @@ type ListBillingStatementsRequest struct {
 
 func (x *ListBillingStatementsRequest) Reset() {
 	*x = ListBillingStatementsRequest{}
-	mi := &file_proto_asset_proto_msgTypes[32]
+	mi := &file_proto_asset_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2141,7 +2266,7 @@ func (x *ListBillingStatementsRequest) String() string {
 func (*ListBillingStatementsRequest) ProtoMessage() {}
 
 func (x *ListBillingStatementsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[32]
+	mi := &file_proto_asset_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2154,7 +2279,7 @@ func (x *ListBillingStatementsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBillingStatementsRequest.ProtoReflect.Descriptor instead.
 func (*ListBillingStatementsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{32}
+	return file_proto_asset_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ListBillingStatementsRequest) GetUserId() string {
@@ -2204,7 +2329,7 @@ type ListBillingStatementsResponse struct {
 
 func (x *ListBillingStatementsResponse) Reset() {
 	*x = ListBillingStatementsResponse{}
-	mi := &file_proto_asset_proto_msgTypes[33]
+	mi := &file_proto_asset_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2216,7 +2341,7 @@ func (x *ListBillingStatementsResponse) String() string {
 func (*ListBillingStatementsResponse) ProtoMessage() {}
 
 func (x *ListBillingStatementsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[33]
+	mi := &file_proto_asset_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2229,7 +2354,7 @@ func (x *ListBillingStatementsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBillingStatementsResponse.ProtoReflect.Descriptor instead.
 func (*ListBillingStatementsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{33}
+	return file_proto_asset_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ListBillingStatementsResponse) GetTotal() int64 {
@@ -2280,7 +2405,7 @@ type BillingSelectedFormat struct {
 
 func (x *BillingSelectedFormat) Reset() {
 	*x = BillingSelectedFormat{}
-	mi := &file_proto_asset_proto_msgTypes[34]
+	mi := &file_proto_asset_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2292,7 +2417,7 @@ func (x *BillingSelectedFormat) String() string {
 func (*BillingSelectedFormat) ProtoMessage() {}
 
 func (x *BillingSelectedFormat) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[34]
+	mi := &file_proto_asset_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2305,7 +2430,7 @@ func (x *BillingSelectedFormat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BillingSelectedFormat.ProtoReflect.Descriptor instead.
 func (*BillingSelectedFormat) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{34}
+	return file_proto_asset_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *BillingSelectedFormat) GetFormatId() string {
@@ -2393,19 +2518,22 @@ func (x *BillingSelectedFormat) GetAsr() int32 {
 }
 
 type EstimateDownloadBillingRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Url            string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Platform       string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
-	Mode           string                 `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
-	SelectedFormat *BillingSelectedFormat `protobuf:"bytes,5,opt,name=selected_format,json=selectedFormat,proto3" json:"selected_format,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Url              string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Platform         string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	Mode             string                 `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	SelectedFormat   *BillingSelectedFormat `protobuf:"bytes,5,opt,name=selected_format,json=selectedFormat,proto3" json:"selected_format,omitempty"`
+	DurationSeconds  int64                  `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`       // 视频总时长，用于按片段比例折算
+	ClipStartSeconds float64                `protobuf:"fixed64,7,opt,name=clip_start_seconds,json=clipStartSeconds,proto3" json:"clip_start_seconds,omitempty"` // 片段起点，0 表示从头
+	ClipEndSeconds   float64                `protobuf:"fixed64,8,opt,name=clip_end_seconds,json=clipEndSeconds,proto3" json:"clip_end_seconds,omitempty"`       // 片段终点，0 表示到结尾
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *EstimateDownloadBillingRequest) Reset() {
 	*x = EstimateDownloadBillingRequest{}
-	mi := &file_proto_asset_proto_msgTypes[35]
+	mi := &file_proto_asset_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2417,7 +2545,7 @@ func (x *EstimateDownloadBillingRequest) String() string {
 func (*EstimateDownloadBillingRequest) ProtoMessage() {}
 
 func (x *EstimateDownloadBillingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[35]
+	mi := &file_proto_asset_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2430,7 +2558,7 @@ func (x *EstimateDownloadBillingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EstimateDownloadBillingRequest.ProtoReflect.Descriptor instead.
 func (*EstimateDownloadBillingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{35}
+	return file_proto_asset_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *EstimateDownloadBillingRequest) GetUserId() string {
@@ -2468,6 +2596,27 @@ func (x *EstimateDownloadBillingRequest) GetSelectedFormat() *BillingSelectedFor
 	return nil
 }
 
+func (x *EstimateDownloadBillingRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *EstimateDownloadBillingRequest) GetClipStartSeconds() float64 {
+	if x != nil {
+		return x.ClipStartSeconds
+	}
+	return 0
+}
+
+func (x *EstimateDownloadBillingRequest) GetClipEndSeconds() float64 {
+	if x != nil {
+		return x.ClipEndSeconds
+	}
+	return 0
+}
+
 type EstimateDownloadBillingResponse struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	EstimatedIngressBytes int64                  `protobuf:"varint,1,opt,name=estimated_ingress_bytes,json=estimatedIngressBytes,proto3" json:"estimated_ingress_bytes,omitempty"`
@@ -2483,7 +2632,7 @@ type EstimateDownloadBillingResponse struct {
 
 func (x *EstimateDownloadBillingResponse) Reset() {
 	*x = EstimateDownloadBillingResponse{}
-	mi := &file_proto_asset_proto_msgTypes[36]
+	mi := &file_proto_asset_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2495,7 +2644,7 @@ func (x *EstimateDownloadBillingResponse) String() string {
 func (*EstimateDownloadBillingResponse) ProtoMessage() {}
 
 func (x *EstimateDownloadBillingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[36]
+	mi := &file_proto_asset_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2508,7 +2657,7 @@ func (x *EstimateDownloadBillingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EstimateDownloadBillingResponse.ProtoReflect.Descriptor instead.
 func (*EstimateDownloadBillingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{36}
+	return file_proto_asset_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *EstimateDownloadBillingResponse) GetEstimatedIngressBytes() int64 {
@@ -2576,7 +2725,7 @@ type HoldInitialDownloadRequest struct {
 
 func (x *HoldInitialDownloadRequest) Reset() {
 	*x = HoldInitialDownloadRequest{}
-	mi := &file_proto_asset_proto_msgTypes[37]
+	mi := &file_proto_asset_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2588,7 +2737,7 @@ func (x *HoldInitialDownloadRequest) String() string {
 func (*HoldInitialDownloadRequest) ProtoMessage() {}
 
 func (x *HoldInitialDownloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[37]
+	mi := &file_proto_asset_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2601,7 +2750,7 @@ func (x *HoldInitialDownloadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HoldInitialDownloadRequest.ProtoReflect.Descriptor instead.
 func (*HoldInitialDownloadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{37}
+	return file_proto_asset_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *HoldInitialDownloadRequest) GetUserId() string {
@@ -2673,7 +2822,7 @@ type HoldInitialDownloadResponse struct {
 
 func (x *HoldInitialDownloadResponse) Reset() {
 	*x = HoldInitialDownloadResponse{}
-	mi := &file_proto_asset_proto_msgTypes[38]
+	mi := &file_proto_asset_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2685,7 +2834,7 @@ func (x *HoldInitialDownloadResponse) String() string {
 func (*HoldInitialDownloadResponse) ProtoMessage() {}
 
 func (x *HoldInitialDownloadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[38]
+	mi := &file_proto_asset_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2698,7 +2847,7 @@ func (x *HoldInitialDownloadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HoldInitialDownloadResponse.ProtoReflect.Descriptor instead.
 func (*HoldInitialDownloadResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{38}
+	return file_proto_asset_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *HoldInitialDownloadResponse) GetOrderNo() string {
@@ -2746,7 +2895,7 @@ type CaptureIngressUsageRequest struct {
 
 func (x *CaptureIngressUsageRequest) Reset() {
 	*x = CaptureIngressUsageRequest{}
-	mi := &file_proto_asset_proto_msgTypes[39]
+	mi := &file_proto_asset_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2758,7 +2907,7 @@ func (x *CaptureIngressUsageRequest) String() string {
 func (*CaptureIngressUsageRequest) ProtoMessage() {}
 
 func (x *CaptureIngressUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[39]
+	mi := &file_proto_asset_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2771,7 +2920,7 @@ func (x *CaptureIngressUsageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CaptureIngressUsageRequest.ProtoReflect.Descriptor instead.
 func (*CaptureIngressUsageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{39}
+	return file_proto_asset_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *CaptureIngressUsageRequest) GetTaskId() string {
@@ -2802,7 +2951,7 @@ type CaptureIngressUsageResponse struct {
 
 func (x *CaptureIngressUsageResponse) Reset() {
 	*x = CaptureIngressUsageResponse{}
-	mi := &file_proto_asset_proto_msgTypes[40]
+	mi := &file_proto_asset_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2814,7 +2963,7 @@ func (x *CaptureIngressUsageResponse) String() string {
 func (*CaptureIngressUsageResponse) ProtoMessage() {}
 
 func (x *CaptureIngressUsageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[40]
+	mi := &file_proto_asset_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2827,7 +2976,7 @@ func (x *CaptureIngressUsageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CaptureIngressUsageResponse.ProtoReflect.Descriptor instead.
 func (*CaptureIngressUsageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{40}
+	return file_proto_asset_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *CaptureIngressUsageResponse) GetOrderNo() string {
@@ -2882,7 +3031,7 @@ type ReleaseInitialDownloadRequest struct {
 
 func (x *ReleaseInitialDownloadRequest) Reset() {
 	*x = ReleaseInitialDownloadRequest{}
-	mi := &file_proto_asset_proto_msgTypes[41]
+	mi := &file_proto_asset_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2894,7 +3043,7 @@ func (x *ReleaseInitialDownloadRequest) String() string {
 func (*ReleaseInitialDownloadRequest) ProtoMessage() {}
 
 func (x *ReleaseInitialDownloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[41]
+	mi := &file_proto_asset_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2907,7 +3056,7 @@ func (x *ReleaseInitialDownloadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseInitialDownloadRequest.ProtoReflect.Descriptor instead.
 func (*ReleaseInitialDownloadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{41}
+	return file_proto_asset_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ReleaseInitialDownloadRequest) GetTaskId() string {
@@ -2935,7 +3084,7 @@ type ReleaseInitialDownloadResponse struct {
 
 func (x *ReleaseInitialDownloadResponse) Reset() {
 	*x = ReleaseInitialDownloadResponse{}
-	mi := &file_proto_asset_proto_msgTypes[42]
+	mi := &file_proto_asset_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2947,7 +3096,7 @@ func (x *ReleaseInitialDownloadResponse) String() string {
 func (*ReleaseInitialDownloadResponse) ProtoMessage() {}
 
 func (x *ReleaseInitialDownloadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[42]
+	mi := &file_proto_asset_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2960,7 +3109,7 @@ func (x *ReleaseInitialDownloadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseInitialDownloadResponse.ProtoReflect.Descriptor instead.
 func (*ReleaseInitialDownloadResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{42}
+	return file_proto_asset_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ReleaseInitialDownloadResponse) GetSuccess() bool {
@@ -2989,13 +3138,16 @@ type PrepareFileTransferBillingRequest struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	HistoryId     int64                  `protobuf:"varint,2,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty"`
 	FileSizeBytes int64                  `protobuf:"varint,3,opt,name=file_size_bytes,json=fileSizeBytes,proto3" json:"file_size_bytes,omitempty"`
+	FileId        int64                  `protobuf:"varint,4,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`             // 0 表示主文件，否则为附加产物 ID
+	RangeStart    int64                  `protobuf:"varint,5,opt,name=range_start,json=rangeStart,proto3" json:"range_start,omitempty"` // 本次传输的起始偏移
+	RangeEnd      int64                  `protobuf:"varint,6,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`       // 结束偏移（不含），0 表示到文件末尾
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PrepareFileTransferBillingRequest) Reset() {
 	*x = PrepareFileTransferBillingRequest{}
-	mi := &file_proto_asset_proto_msgTypes[43]
+	mi := &file_proto_asset_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3007,7 +3159,7 @@ func (x *PrepareFileTransferBillingRequest) String() string {
 func (*PrepareFileTransferBillingRequest) ProtoMessage() {}
 
 func (x *PrepareFileTransferBillingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[43]
+	mi := &file_proto_asset_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3020,7 +3172,7 @@ func (x *PrepareFileTransferBillingRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use PrepareFileTransferBillingRequest.ProtoReflect.Descriptor instead.
 func (*PrepareFileTransferBillingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{43}
+	return file_proto_asset_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *PrepareFileTransferBillingRequest) GetUserId() string {
@@ -3044,6 +3196,27 @@ func (x *PrepareFileTransferBillingRequest) GetFileSizeBytes() int64 {
 	return 0
 }
 
+func (x *PrepareFileTransferBillingRequest) GetFileId() int64 {
+	if x != nil {
+		return x.FileId
+	}
+	return 0
+}
+
+func (x *PrepareFileTransferBillingRequest) GetRangeStart() int64 {
+	if x != nil {
+		return x.RangeStart
+	}
+	return 0
+}
+
+func (x *PrepareFileTransferBillingRequest) GetRangeEnd() int64 {
+	if x != nil {
+		return x.RangeEnd
+	}
+	return 0
+}
+
 type PrepareFileTransferBillingResponse struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	TransferId           string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
@@ -3060,7 +3233,7 @@ type PrepareFileTransferBillingResponse struct {
 
 func (x *PrepareFileTransferBillingResponse) Reset() {
 	*x = PrepareFileTransferBillingResponse{}
-	mi := &file_proto_asset_proto_msgTypes[44]
+	mi := &file_proto_asset_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3072,7 +3245,7 @@ func (x *PrepareFileTransferBillingResponse) String() string {
 func (*PrepareFileTransferBillingResponse) ProtoMessage() {}
 
 func (x *PrepareFileTransferBillingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[44]
+	mi := &file_proto_asset_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3085,7 +3258,7 @@ func (x *PrepareFileTransferBillingResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use PrepareFileTransferBillingResponse.ProtoReflect.Descriptor instead.
 func (*PrepareFileTransferBillingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{44}
+	return file_proto_asset_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *PrepareFileTransferBillingResponse) GetTransferId() string {
@@ -3154,7 +3327,7 @@ type CompleteFileTransferBillingRequest struct {
 
 func (x *CompleteFileTransferBillingRequest) Reset() {
 	*x = CompleteFileTransferBillingRequest{}
-	mi := &file_proto_asset_proto_msgTypes[45]
+	mi := &file_proto_asset_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3166,7 +3339,7 @@ func (x *CompleteFileTransferBillingRequest) String() string {
 func (*CompleteFileTransferBillingRequest) ProtoMessage() {}
 
 func (x *CompleteFileTransferBillingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[45]
+	mi := &file_proto_asset_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3179,7 +3352,7 @@ func (x *CompleteFileTransferBillingRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CompleteFileTransferBillingRequest.ProtoReflect.Descriptor instead.
 func (*CompleteFileTransferBillingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{45}
+	return file_proto_asset_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *CompleteFileTransferBillingRequest) GetTransferId() string {
@@ -3209,7 +3382,7 @@ type CompleteFileTransferBillingResponse struct {
 
 func (x *CompleteFileTransferBillingResponse) Reset() {
 	*x = CompleteFileTransferBillingResponse{}
-	mi := &file_proto_asset_proto_msgTypes[46]
+	mi := &file_proto_asset_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3221,7 +3394,7 @@ func (x *CompleteFileTransferBillingResponse) String() string {
 func (*CompleteFileTransferBillingResponse) ProtoMessage() {}
 
 func (x *CompleteFileTransferBillingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[46]
+	mi := &file_proto_asset_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3234,7 +3407,7 @@ func (x *CompleteFileTransferBillingResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use CompleteFileTransferBillingResponse.ProtoReflect.Descriptor instead.
 func (*CompleteFileTransferBillingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{46}
+	return file_proto_asset_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *CompleteFileTransferBillingResponse) GetOrderNo() string {
@@ -3282,7 +3455,7 @@ type AbortFileTransferBillingRequest struct {
 
 func (x *AbortFileTransferBillingRequest) Reset() {
 	*x = AbortFileTransferBillingRequest{}
-	mi := &file_proto_asset_proto_msgTypes[47]
+	mi := &file_proto_asset_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3294,7 +3467,7 @@ func (x *AbortFileTransferBillingRequest) String() string {
 func (*AbortFileTransferBillingRequest) ProtoMessage() {}
 
 func (x *AbortFileTransferBillingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[47]
+	mi := &file_proto_asset_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3307,7 +3480,7 @@ func (x *AbortFileTransferBillingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbortFileTransferBillingRequest.ProtoReflect.Descriptor instead.
 func (*AbortFileTransferBillingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{47}
+	return file_proto_asset_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *AbortFileTransferBillingRequest) GetTransferId() string {
@@ -3335,7 +3508,7 @@ type AbortFileTransferBillingResponse struct {
 
 func (x *AbortFileTransferBillingResponse) Reset() {
 	*x = AbortFileTransferBillingResponse{}
-	mi := &file_proto_asset_proto_msgTypes[48]
+	mi := &file_proto_asset_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3347,7 +3520,7 @@ func (x *AbortFileTransferBillingResponse) String() string {
 func (*AbortFileTransferBillingResponse) ProtoMessage() {}
 
 func (x *AbortFileTransferBillingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[48]
+	mi := &file_proto_asset_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3360,7 +3533,7 @@ func (x *AbortFileTransferBillingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbortFileTransferBillingResponse.ProtoReflect.Descriptor instead.
 func (*AbortFileTransferBillingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{48}
+	return file_proto_asset_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *AbortFileTransferBillingResponse) GetSuccess() bool {
@@ -3396,7 +3569,7 @@ type ListBillingAccountsRequest struct {
 
 func (x *ListBillingAccountsRequest) Reset() {
 	*x = ListBillingAccountsRequest{}
-	mi := &file_proto_asset_proto_msgTypes[49]
+	mi := &file_proto_asset_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3408,7 +3581,7 @@ func (x *ListBillingAccountsRequest) String() string {
 func (*ListBillingAccountsRequest) ProtoMessage() {}
 
 func (x *ListBillingAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[49]
+	mi := &file_proto_asset_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3421,7 +3594,7 @@ func (x *ListBillingAccountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBillingAccountsRequest.ProtoReflect.Descriptor instead.
 func (*ListBillingAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{49}
+	return file_proto_asset_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ListBillingAccountsRequest) GetUserIds() []string {
@@ -3464,7 +3637,7 @@ type ListBillingAccountsResponse struct {
 
 func (x *ListBillingAccountsResponse) Reset() {
 	*x = ListBillingAccountsResponse{}
-	mi := &file_proto_asset_proto_msgTypes[50]
+	mi := &file_proto_asset_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3476,7 +3649,7 @@ func (x *ListBillingAccountsResponse) String() string {
 func (*ListBillingAccountsResponse) ProtoMessage() {}
 
 func (x *ListBillingAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[50]
+	mi := &file_proto_asset_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3489,7 +3662,7 @@ func (x *ListBillingAccountsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBillingAccountsResponse.ProtoReflect.Descriptor instead.
 func (*ListBillingAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{50}
+	return file_proto_asset_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ListBillingAccountsResponse) GetTotal() int64 {
@@ -3529,7 +3702,7 @@ type GetBillingAccountDetailRequest struct {
 
 func (x *GetBillingAccountDetailRequest) Reset() {
 	*x = GetBillingAccountDetailRequest{}
-	mi := &file_proto_asset_proto_msgTypes[51]
+	mi := &file_proto_asset_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3541,7 +3714,7 @@ func (x *GetBillingAccountDetailRequest) String() string {
 func (*GetBillingAccountDetailRequest) ProtoMessage() {}
 
 func (x *GetBillingAccountDetailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[51]
+	mi := &file_proto_asset_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3554,7 +3727,7 @@ func (x *GetBillingAccountDetailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBillingAccountDetailRequest.ProtoReflect.Descriptor instead.
 func (*GetBillingAccountDetailRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{51}
+	return file_proto_asset_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetBillingAccountDetailRequest) GetUserId() string {
@@ -3573,7 +3746,7 @@ type GetBillingAccountDetailResponse struct {
 
 func (x *GetBillingAccountDetailResponse) Reset() {
 	*x = GetBillingAccountDetailResponse{}
-	mi := &file_proto_asset_proto_msgTypes[52]
+	mi := &file_proto_asset_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3585,7 +3758,7 @@ func (x *GetBillingAccountDetailResponse) String() string {
 func (*GetBillingAccountDetailResponse) ProtoMessage() {}
 
 func (x *GetBillingAccountDetailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[52]
+	mi := &file_proto_asset_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3598,7 +3771,7 @@ func (x *GetBillingAccountDetailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBillingAccountDetailResponse.ProtoReflect.Descriptor instead.
 func (*GetBillingAccountDetailResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{52}
+	return file_proto_asset_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetBillingAccountDetailResponse) GetAccount() *BillingAccountSnapshot {
@@ -3621,7 +3794,7 @@ type AdjustBillingBalanceRequest struct {
 
 func (x *AdjustBillingBalanceRequest) Reset() {
 	*x = AdjustBillingBalanceRequest{}
-	mi := &file_proto_asset_proto_msgTypes[53]
+	mi := &file_proto_asset_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3633,7 +3806,7 @@ func (x *AdjustBillingBalanceRequest) String() string {
 func (*AdjustBillingBalanceRequest) ProtoMessage() {}
 
 func (x *AdjustBillingBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[53]
+	mi := &file_proto_asset_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3646,7 +3819,7 @@ func (x *AdjustBillingBalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdjustBillingBalanceRequest.ProtoReflect.Descriptor instead.
 func (*AdjustBillingBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{53}
+	return file_proto_asset_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *AdjustBillingBalanceRequest) GetUserId() string {
@@ -3695,7 +3868,7 @@ type AdjustBillingBalanceResponse struct {
 
 func (x *AdjustBillingBalanceResponse) Reset() {
 	*x = AdjustBillingBalanceResponse{}
-	mi := &file_proto_asset_proto_msgTypes[54]
+	mi := &file_proto_asset_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3707,7 +3880,7 @@ func (x *AdjustBillingBalanceResponse) String() string {
 func (*AdjustBillingBalanceResponse) ProtoMessage() {}
 
 func (x *AdjustBillingBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[54]
+	mi := &file_proto_asset_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3720,7 +3893,7 @@ func (x *AdjustBillingBalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdjustBillingBalanceResponse.ProtoReflect.Descriptor instead.
 func (*AdjustBillingBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{54}
+	return file_proto_asset_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *AdjustBillingBalanceResponse) GetSuccess() bool {
@@ -3770,7 +3943,7 @@ type LedgerEntryItem struct {
 
 func (x *LedgerEntryItem) Reset() {
 	*x = LedgerEntryItem{}
-	mi := &file_proto_asset_proto_msgTypes[55]
+	mi := &file_proto_asset_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3782,7 +3955,7 @@ func (x *LedgerEntryItem) String() string {
 func (*LedgerEntryItem) ProtoMessage() {}
 
 func (x *LedgerEntryItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[55]
+	mi := &file_proto_asset_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3795,7 +3968,7 @@ func (x *LedgerEntryItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LedgerEntryItem.ProtoReflect.Descriptor instead.
 func (*LedgerEntryItem) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{55}
+	return file_proto_asset_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *LedgerEntryItem) GetEntryNo() string {
@@ -3936,7 +4109,7 @@ type ListBillingLedgerRequest struct {
 
 func (x *ListBillingLedgerRequest) Reset() {
 	*x = ListBillingLedgerRequest{}
-	mi := &file_proto_asset_proto_msgTypes[56]
+	mi := &file_proto_asset_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3948,7 +4121,7 @@ func (x *ListBillingLedgerRequest) String() string {
 func (*ListBillingLedgerRequest) ProtoMessage() {}
 
 func (x *ListBillingLedgerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[56]
+	mi := &file_proto_asset_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3961,7 +4134,7 @@ func (x *ListBillingLedgerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBillingLedgerRequest.ProtoReflect.Descriptor instead.
 func (*ListBillingLedgerRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{56}
+	return file_proto_asset_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *ListBillingLedgerRequest) GetUserId() string {
@@ -4004,7 +4177,7 @@ type ListBillingLedgerResponse struct {
 
 func (x *ListBillingLedgerResponse) Reset() {
 	*x = ListBillingLedgerResponse{}
-	mi := &file_proto_asset_proto_msgTypes[57]
+	mi := &file_proto_asset_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4016,7 +4189,7 @@ func (x *ListBillingLedgerResponse) String() string {
 func (*ListBillingLedgerResponse) ProtoMessage() {}
 
 func (x *ListBillingLedgerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[57]
+	mi := &file_proto_asset_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4029,7 +4202,7 @@ func (x *ListBillingLedgerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBillingLedgerResponse.ProtoReflect.Descriptor instead.
 func (*ListBillingLedgerResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{57}
+	return file_proto_asset_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *ListBillingLedgerResponse) GetTotal() int64 {
@@ -4083,7 +4256,7 @@ type TrafficUsageRecordItem struct {
 
 func (x *TrafficUsageRecordItem) Reset() {
 	*x = TrafficUsageRecordItem{}
-	mi := &file_proto_asset_proto_msgTypes[58]
+	mi := &file_proto_asset_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4095,7 +4268,7 @@ func (x *TrafficUsageRecordItem) String() string {
 func (*TrafficUsageRecordItem) ProtoMessage() {}
 
 func (x *TrafficUsageRecordItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[58]
+	mi := &file_proto_asset_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4108,7 +4281,7 @@ func (x *TrafficUsageRecordItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrafficUsageRecordItem.ProtoReflect.Descriptor instead.
 func (*TrafficUsageRecordItem) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{58}
+	return file_proto_asset_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *TrafficUsageRecordItem) GetUsageNo() string {
@@ -4228,7 +4401,7 @@ type ListTrafficUsageRecordsRequest struct {
 
 func (x *ListTrafficUsageRecordsRequest) Reset() {
 	*x = ListTrafficUsageRecordsRequest{}
-	mi := &file_proto_asset_proto_msgTypes[59]
+	mi := &file_proto_asset_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4240,7 +4413,7 @@ func (x *ListTrafficUsageRecordsRequest) String() string {
 func (*ListTrafficUsageRecordsRequest) ProtoMessage() {}
 
 func (x *ListTrafficUsageRecordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[59]
+	mi := &file_proto_asset_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4253,7 +4426,7 @@ func (x *ListTrafficUsageRecordsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTrafficUsageRecordsRequest.ProtoReflect.Descriptor instead.
 func (*ListTrafficUsageRecordsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{59}
+	return file_proto_asset_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *ListTrafficUsageRecordsRequest) GetUserId() string {
@@ -4296,7 +4469,7 @@ type ListTrafficUsageRecordsResponse struct {
 
 func (x *ListTrafficUsageRecordsResponse) Reset() {
 	*x = ListTrafficUsageRecordsResponse{}
-	mi := &file_proto_asset_proto_msgTypes[60]
+	mi := &file_proto_asset_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4308,7 +4481,7 @@ func (x *ListTrafficUsageRecordsResponse) String() string {
 func (*ListTrafficUsageRecordsResponse) ProtoMessage() {}
 
 func (x *ListTrafficUsageRecordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[60]
+	mi := &file_proto_asset_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4321,7 +4494,7 @@ func (x *ListTrafficUsageRecordsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTrafficUsageRecordsResponse.ProtoReflect.Descriptor instead.
 func (*ListTrafficUsageRecordsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{60}
+	return file_proto_asset_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *ListTrafficUsageRecordsResponse) GetTotal() int64 {
@@ -4368,7 +4541,7 @@ type BillingPricing struct {
 
 func (x *BillingPricing) Reset() {
 	*x = BillingPricing{}
-	mi := &file_proto_asset_proto_msgTypes[61]
+	mi := &file_proto_asset_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4380,7 +4553,7 @@ func (x *BillingPricing) String() string {
 func (*BillingPricing) ProtoMessage() {}
 
 func (x *BillingPricing) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[61]
+	mi := &file_proto_asset_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4393,7 +4566,7 @@ func (x *BillingPricing) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BillingPricing.ProtoReflect.Descriptor instead.
 func (*BillingPricing) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{61}
+	return file_proto_asset_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *BillingPricing) GetVersion() int32 {
@@ -4460,7 +4633,7 @@ type GetBillingPricingRequest struct {
 
 func (x *GetBillingPricingRequest) Reset() {
 	*x = GetBillingPricingRequest{}
-	mi := &file_proto_asset_proto_msgTypes[62]
+	mi := &file_proto_asset_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4472,7 +4645,7 @@ func (x *GetBillingPricingRequest) String() string {
 func (*GetBillingPricingRequest) ProtoMessage() {}
 
 func (x *GetBillingPricingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[62]
+	mi := &file_proto_asset_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4485,7 +4658,7 @@ func (x *GetBillingPricingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBillingPricingRequest.ProtoReflect.Descriptor instead.
 func (*GetBillingPricingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{62}
+	return file_proto_asset_proto_rawDescGZIP(), []int{63}
 }
 
 type GetBillingPricingResponse struct {
@@ -4497,7 +4670,7 @@ type GetBillingPricingResponse struct {
 
 func (x *GetBillingPricingResponse) Reset() {
 	*x = GetBillingPricingResponse{}
-	mi := &file_proto_asset_proto_msgTypes[63]
+	mi := &file_proto_asset_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4509,7 +4682,7 @@ func (x *GetBillingPricingResponse) String() string {
 func (*GetBillingPricingResponse) ProtoMessage() {}
 
 func (x *GetBillingPricingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[63]
+	mi := &file_proto_asset_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4522,7 +4695,7 @@ func (x *GetBillingPricingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBillingPricingResponse.ProtoReflect.Descriptor instead.
 func (*GetBillingPricingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{63}
+	return file_proto_asset_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *GetBillingPricingResponse) GetPricing() *BillingPricing {
@@ -4544,7 +4717,7 @@ type UpdateBillingPricingRequest struct {
 
 func (x *UpdateBillingPricingRequest) Reset() {
 	*x = UpdateBillingPricingRequest{}
-	mi := &file_proto_asset_proto_msgTypes[64]
+	mi := &file_proto_asset_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4556,7 +4729,7 @@ func (x *UpdateBillingPricingRequest) String() string {
 func (*UpdateBillingPricingRequest) ProtoMessage() {}
 
 func (x *UpdateBillingPricingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[64]
+	mi := &file_proto_asset_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4569,7 +4742,7 @@ func (x *UpdateBillingPricingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBillingPricingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateBillingPricingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{64}
+	return file_proto_asset_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *UpdateBillingPricingRequest) GetIngressPriceYuanPerGb() string {
@@ -4610,7 +4783,7 @@ type UpdateBillingPricingResponse struct {
 
 func (x *UpdateBillingPricingResponse) Reset() {
 	*x = UpdateBillingPricingResponse{}
-	mi := &file_proto_asset_proto_msgTypes[65]
+	mi := &file_proto_asset_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4622,7 +4795,7 @@ func (x *UpdateBillingPricingResponse) String() string {
 func (*UpdateBillingPricingResponse) ProtoMessage() {}
 
 func (x *UpdateBillingPricingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[65]
+	mi := &file_proto_asset_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4635,7 +4808,7 @@ func (x *UpdateBillingPricingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBillingPricingResponse.ProtoReflect.Descriptor instead.
 func (*UpdateBillingPricingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{65}
+	return file_proto_asset_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *UpdateBillingPricingResponse) GetSuccess() bool {
@@ -4677,7 +4850,7 @@ type BillingShortfallOrderItem struct {
 
 func (x *BillingShortfallOrderItem) Reset() {
 	*x = BillingShortfallOrderItem{}
-	mi := &file_proto_asset_proto_msgTypes[66]
+	mi := &file_proto_asset_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4689,7 +4862,7 @@ func (x *BillingShortfallOrderItem) String() string {
 func (*BillingShortfallOrderItem) ProtoMessage() {}
 
 func (x *BillingShortfallOrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[66]
+	mi := &file_proto_asset_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4702,7 +4875,7 @@ func (x *BillingShortfallOrderItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BillingShortfallOrderItem.ProtoReflect.Descriptor instead.
 func (*BillingShortfallOrderItem) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{66}
+	return file_proto_asset_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *BillingShortfallOrderItem) GetOrderNo() string {
@@ -4835,7 +5008,7 @@ type ListBillingShortfallsRequest struct {
 
 func (x *ListBillingShortfallsRequest) Reset() {
 	*x = ListBillingShortfallsRequest{}
-	mi := &file_proto_asset_proto_msgTypes[67]
+	mi := &file_proto_asset_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4847,7 +5020,7 @@ func (x *ListBillingShortfallsRequest) String() string {
 func (*ListBillingShortfallsRequest) ProtoMessage() {}
 
 func (x *ListBillingShortfallsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[67]
+	mi := &file_proto_asset_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4860,7 +5033,7 @@ func (x *ListBillingShortfallsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBillingShortfallsRequest.ProtoReflect.Descriptor instead.
 func (*ListBillingShortfallsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{67}
+	return file_proto_asset_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *ListBillingShortfallsRequest) GetUserId() string {
@@ -4896,7 +5069,7 @@ type ListBillingShortfallsResponse struct {
 
 func (x *ListBillingShortfallsResponse) Reset() {
 	*x = ListBillingShortfallsResponse{}
-	mi := &file_proto_asset_proto_msgTypes[68]
+	mi := &file_proto_asset_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4908,7 +5081,7 @@ func (x *ListBillingShortfallsResponse) String() string {
 func (*ListBillingShortfallsResponse) ProtoMessage() {}
 
 func (x *ListBillingShortfallsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[68]
+	mi := &file_proto_asset_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4921,7 +5094,7 @@ func (x *ListBillingShortfallsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBillingShortfallsResponse.ProtoReflect.Descriptor instead.
 func (*ListBillingShortfallsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{68}
+	return file_proto_asset_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *ListBillingShortfallsResponse) GetTotal() int64 {
@@ -4963,7 +5136,7 @@ type ReconcileBillingShortfallRequest struct {
 
 func (x *ReconcileBillingShortfallRequest) Reset() {
 	*x = ReconcileBillingShortfallRequest{}
-	mi := &file_proto_asset_proto_msgTypes[69]
+	mi := &file_proto_asset_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4975,7 +5148,7 @@ func (x *ReconcileBillingShortfallRequest) String() string {
 func (*ReconcileBillingShortfallRequest) ProtoMessage() {}
 
 func (x *ReconcileBillingShortfallRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[69]
+	mi := &file_proto_asset_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4988,7 +5161,7 @@ func (x *ReconcileBillingShortfallRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReconcileBillingShortfallRequest.ProtoReflect.Descriptor instead.
 func (*ReconcileBillingShortfallRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{69}
+	return file_proto_asset_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *ReconcileBillingShortfallRequest) GetOrderNo() string {
@@ -5024,7 +5197,7 @@ type ReconcileBillingShortfallResponse struct {
 
 func (x *ReconcileBillingShortfallResponse) Reset() {
 	*x = ReconcileBillingShortfallResponse{}
-	mi := &file_proto_asset_proto_msgTypes[70]
+	mi := &file_proto_asset_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5036,7 +5209,7 @@ func (x *ReconcileBillingShortfallResponse) String() string {
 func (*ReconcileBillingShortfallResponse) ProtoMessage() {}
 
 func (x *ReconcileBillingShortfallResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[70]
+	mi := &file_proto_asset_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5049,7 +5222,7 @@ func (x *ReconcileBillingShortfallResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ReconcileBillingShortfallResponse.ProtoReflect.Descriptor instead.
 func (*ReconcileBillingShortfallResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{70}
+	return file_proto_asset_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *ReconcileBillingShortfallResponse) GetSuccess() bool {
@@ -5092,7 +5265,7 @@ type AcquireProxyForTaskRequest struct {
 
 func (x *AcquireProxyForTaskRequest) Reset() {
 	*x = AcquireProxyForTaskRequest{}
-	mi := &file_proto_asset_proto_msgTypes[71]
+	mi := &file_proto_asset_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5104,7 +5277,7 @@ func (x *AcquireProxyForTaskRequest) String() string {
 func (*AcquireProxyForTaskRequest) ProtoMessage() {}
 
 func (x *AcquireProxyForTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[71]
+	mi := &file_proto_asset_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5117,7 +5290,7 @@ func (x *AcquireProxyForTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcquireProxyForTaskRequest.ProtoReflect.Descriptor instead.
 func (*AcquireProxyForTaskRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{71}
+	return file_proto_asset_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *AcquireProxyForTaskRequest) GetTaskId() string {
@@ -5163,7 +5336,7 @@ type AcquireProxyForTaskResponse struct {
 
 func (x *AcquireProxyForTaskResponse) Reset() {
 	*x = AcquireProxyForTaskResponse{}
-	mi := &file_proto_asset_proto_msgTypes[72]
+	mi := &file_proto_asset_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5175,7 +5348,7 @@ func (x *AcquireProxyForTaskResponse) String() string {
 func (*AcquireProxyForTaskResponse) ProtoMessage() {}
 
 func (x *AcquireProxyForTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[72]
+	mi := &file_proto_asset_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5188,7 +5361,7 @@ func (x *AcquireProxyForTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcquireProxyForTaskResponse.ProtoReflect.Descriptor instead.
 func (*AcquireProxyForTaskResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{72}
+	return file_proto_asset_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *AcquireProxyForTaskResponse) GetProxyUrl() string {
@@ -5251,7 +5424,7 @@ type GetAvailableProxyRequest struct {
 
 func (x *GetAvailableProxyRequest) Reset() {
 	*x = GetAvailableProxyRequest{}
-	mi := &file_proto_asset_proto_msgTypes[73]
+	mi := &file_proto_asset_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5263,7 +5436,7 @@ func (x *GetAvailableProxyRequest) String() string {
 func (*GetAvailableProxyRequest) ProtoMessage() {}
 
 func (x *GetAvailableProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[73]
+	mi := &file_proto_asset_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5276,7 +5449,7 @@ func (x *GetAvailableProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAvailableProxyRequest.ProtoReflect.Descriptor instead.
 func (*GetAvailableProxyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{73}
+	return file_proto_asset_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *GetAvailableProxyRequest) GetProtocol() string {
@@ -5304,7 +5477,7 @@ type GetAvailableProxyResponse struct {
 
 func (x *GetAvailableProxyResponse) Reset() {
 	*x = GetAvailableProxyResponse{}
-	mi := &file_proto_asset_proto_msgTypes[74]
+	mi := &file_proto_asset_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5316,7 +5489,7 @@ func (x *GetAvailableProxyResponse) String() string {
 func (*GetAvailableProxyResponse) ProtoMessage() {}
 
 func (x *GetAvailableProxyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[74]
+	mi := &file_proto_asset_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5329,7 +5502,7 @@ func (x *GetAvailableProxyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAvailableProxyResponse.ProtoReflect.Descriptor instead.
 func (*GetAvailableProxyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{74}
+	return file_proto_asset_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *GetAvailableProxyResponse) GetProxyUrl() string {
@@ -5363,7 +5536,7 @@ type CheckProxySourceStatusRequest struct {
 
 func (x *CheckProxySourceStatusRequest) Reset() {
 	*x = CheckProxySourceStatusRequest{}
-	mi := &file_proto_asset_proto_msgTypes[75]
+	mi := &file_proto_asset_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5375,7 +5548,7 @@ func (x *CheckProxySourceStatusRequest) String() string {
 func (*CheckProxySourceStatusRequest) ProtoMessage() {}
 
 func (x *CheckProxySourceStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[75]
+	mi := &file_proto_asset_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5388,7 +5561,7 @@ func (x *CheckProxySourceStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckProxySourceStatusRequest.ProtoReflect.Descriptor instead.
 func (*CheckProxySourceStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{75}
+	return file_proto_asset_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *CheckProxySourceStatusRequest) GetProtocol() string {
@@ -5419,7 +5592,7 @@ type CheckProxySourceStatusResponse struct {
 
 func (x *CheckProxySourceStatusResponse) Reset() {
 	*x = CheckProxySourceStatusResponse{}
-	mi := &file_proto_asset_proto_msgTypes[76]
+	mi := &file_proto_asset_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5431,7 +5604,7 @@ func (x *CheckProxySourceStatusResponse) String() string {
 func (*CheckProxySourceStatusResponse) ProtoMessage() {}
 
 func (x *CheckProxySourceStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[76]
+	mi := &file_proto_asset_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5444,7 +5617,7 @@ func (x *CheckProxySourceStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckProxySourceStatusResponse.ProtoReflect.Descriptor instead.
 func (*CheckProxySourceStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{76}
+	return file_proto_asset_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *CheckProxySourceStatusResponse) GetHealthy() bool {
@@ -5506,7 +5679,7 @@ type ReportProxyUsageRequest struct {
 
 func (x *ReportProxyUsageRequest) Reset() {
 	*x = ReportProxyUsageRequest{}
-	mi := &file_proto_asset_proto_msgTypes[77]
+	mi := &file_proto_asset_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5518,7 +5691,7 @@ func (x *ReportProxyUsageRequest) String() string {
 func (*ReportProxyUsageRequest) ProtoMessage() {}
 
 func (x *ReportProxyUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[77]
+	mi := &file_proto_asset_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5531,7 +5704,7 @@ func (x *ReportProxyUsageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportProxyUsageRequest.ProtoReflect.Descriptor instead.
 func (*ReportProxyUsageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{77}
+	return file_proto_asset_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *ReportProxyUsageRequest) GetProxyLeaseId() string {
@@ -5599,7 +5772,7 @@ type ReportProxyUsageResponse struct {
 
 func (x *ReportProxyUsageResponse) Reset() {
 	*x = ReportProxyUsageResponse{}
-	mi := &file_proto_asset_proto_msgTypes[78]
+	mi := &file_proto_asset_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5611,7 +5784,7 @@ func (x *ReportProxyUsageResponse) String() string {
 func (*ReportProxyUsageResponse) ProtoMessage() {}
 
 func (x *ReportProxyUsageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[78]
+	mi := &file_proto_asset_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5624,7 +5797,7 @@ func (x *ReportProxyUsageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportProxyUsageResponse.ProtoReflect.Descriptor instead.
 func (*ReportProxyUsageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{78}
+	return file_proto_asset_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *ReportProxyUsageResponse) GetSuccess() bool {
@@ -5644,7 +5817,7 @@ type ReleaseProxyForTaskRequest struct {
 
 func (x *ReleaseProxyForTaskRequest) Reset() {
 	*x = ReleaseProxyForTaskRequest{}
-	mi := &file_proto_asset_proto_msgTypes[79]
+	mi := &file_proto_asset_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5656,7 +5829,7 @@ func (x *ReleaseProxyForTaskRequest) String() string {
 func (*ReleaseProxyForTaskRequest) ProtoMessage() {}
 
 func (x *ReleaseProxyForTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[79]
+	mi := &file_proto_asset_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5669,7 +5842,7 @@ func (x *ReleaseProxyForTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseProxyForTaskRequest.ProtoReflect.Descriptor instead.
 func (*ReleaseProxyForTaskRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{79}
+	return file_proto_asset_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *ReleaseProxyForTaskRequest) GetTaskId() string {
@@ -5695,7 +5868,7 @@ type ReleaseProxyForTaskResponse struct {
 
 func (x *ReleaseProxyForTaskResponse) Reset() {
 	*x = ReleaseProxyForTaskResponse{}
-	mi := &file_proto_asset_proto_msgTypes[80]
+	mi := &file_proto_asset_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5707,7 +5880,7 @@ func (x *ReleaseProxyForTaskResponse) String() string {
 func (*ReleaseProxyForTaskResponse) ProtoMessage() {}
 
 func (x *ReleaseProxyForTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[80]
+	mi := &file_proto_asset_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5720,7 +5893,7 @@ func (x *ReleaseProxyForTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseProxyForTaskResponse.ProtoReflect.Descriptor instead.
 func (*ReleaseProxyForTaskResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{80}
+	return file_proto_asset_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *ReleaseProxyForTaskResponse) GetSuccess() bool {
@@ -5738,7 +5911,7 @@ type GetProxySourcePolicyRequest struct {
 
 func (x *GetProxySourcePolicyRequest) Reset() {
 	*x = GetProxySourcePolicyRequest{}
-	mi := &file_proto_asset_proto_msgTypes[81]
+	mi := &file_proto_asset_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5750,7 +5923,7 @@ func (x *GetProxySourcePolicyRequest) String() string {
 func (*GetProxySourcePolicyRequest) ProtoMessage() {}
 
 func (x *GetProxySourcePolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[81]
+	mi := &file_proto_asset_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5763,7 +5936,7 @@ func (x *GetProxySourcePolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProxySourcePolicyRequest.ProtoReflect.Descriptor instead.
 func (*GetProxySourcePolicyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{81}
+	return file_proto_asset_proto_rawDescGZIP(), []int{82}
 }
 
 type GetProxySourcePolicyResponse struct {
@@ -5785,7 +5958,7 @@ type GetProxySourcePolicyResponse struct {
 
 func (x *GetProxySourcePolicyResponse) Reset() {
 	*x = GetProxySourcePolicyResponse{}
-	mi := &file_proto_asset_proto_msgTypes[82]
+	mi := &file_proto_asset_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5797,7 +5970,7 @@ func (x *GetProxySourcePolicyResponse) String() string {
 func (*GetProxySourcePolicyResponse) ProtoMessage() {}
 
 func (x *GetProxySourcePolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[82]
+	mi := &file_proto_asset_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5810,7 +5983,7 @@ func (x *GetProxySourcePolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProxySourcePolicyResponse.ProtoReflect.Descriptor instead.
 func (*GetProxySourcePolicyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{82}
+	return file_proto_asset_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *GetProxySourcePolicyResponse) GetId() int64 {
@@ -5907,7 +6080,7 @@ type UpdateProxySourcePolicyRequest struct {
 
 func (x *UpdateProxySourcePolicyRequest) Reset() {
 	*x = UpdateProxySourcePolicyRequest{}
-	mi := &file_proto_asset_proto_msgTypes[83]
+	mi := &file_proto_asset_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5919,7 +6092,7 @@ func (x *UpdateProxySourcePolicyRequest) String() string {
 func (*UpdateProxySourcePolicyRequest) ProtoMessage() {}
 
 func (x *UpdateProxySourcePolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[83]
+	mi := &file_proto_asset_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5932,7 +6105,7 @@ func (x *UpdateProxySourcePolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProxySourcePolicyRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProxySourcePolicyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{83}
+	return file_proto_asset_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *UpdateProxySourcePolicyRequest) GetId() int64 {
@@ -6007,7 +6180,7 @@ type UpdateProxySourcePolicyResponse struct {
 
 func (x *UpdateProxySourcePolicyResponse) Reset() {
 	*x = UpdateProxySourcePolicyResponse{}
-	mi := &file_proto_asset_proto_msgTypes[84]
+	mi := &file_proto_asset_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6019,7 +6192,7 @@ func (x *UpdateProxySourcePolicyResponse) String() string {
 func (*UpdateProxySourcePolicyResponse) ProtoMessage() {}
 
 func (x *UpdateProxySourcePolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[84]
+	mi := &file_proto_asset_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6032,7 +6205,7 @@ func (x *UpdateProxySourcePolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProxySourcePolicyResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProxySourcePolicyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{84}
+	return file_proto_asset_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *UpdateProxySourcePolicyResponse) GetSuccess() bool {
@@ -6072,7 +6245,7 @@ type ProxyInfo struct {
 
 func (x *ProxyInfo) Reset() {
 	*x = ProxyInfo{}
-	mi := &file_proto_asset_proto_msgTypes[85]
+	mi := &file_proto_asset_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6084,7 +6257,7 @@ func (x *ProxyInfo) String() string {
 func (*ProxyInfo) ProtoMessage() {}
 
 func (x *ProxyInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[85]
+	mi := &file_proto_asset_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6097,7 +6270,7 @@ func (x *ProxyInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProxyInfo.ProtoReflect.Descriptor instead.
 func (*ProxyInfo) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{85}
+	return file_proto_asset_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *ProxyInfo) GetId() int64 {
@@ -6266,7 +6439,7 @@ type ListProxiesRequest struct {
 
 func (x *ListProxiesRequest) Reset() {
 	*x = ListProxiesRequest{}
-	mi := &file_proto_asset_proto_msgTypes[86]
+	mi := &file_proto_asset_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6278,7 +6451,7 @@ func (x *ListProxiesRequest) String() string {
 func (*ListProxiesRequest) ProtoMessage() {}
 
 func (x *ListProxiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[86]
+	mi := &file_proto_asset_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6291,7 +6464,7 @@ func (x *ListProxiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProxiesRequest.ProtoReflect.Descriptor instead.
 func (*ListProxiesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{86}
+	return file_proto_asset_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *ListProxiesRequest) GetSearch() string {
@@ -6331,7 +6504,7 @@ type ListProxiesResponse struct {
 
 func (x *ListProxiesResponse) Reset() {
 	*x = ListProxiesResponse{}
-	mi := &file_proto_asset_proto_msgTypes[87]
+	mi := &file_proto_asset_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6343,7 +6516,7 @@ func (x *ListProxiesResponse) String() string {
 func (*ListProxiesResponse) ProtoMessage() {}
 
 func (x *ListProxiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[87]
+	mi := &file_proto_asset_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6356,7 +6529,7 @@ func (x *ListProxiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProxiesResponse.ProtoReflect.Descriptor instead.
 func (*ListProxiesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{87}
+	return file_proto_asset_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *ListProxiesResponse) GetItems() []*ProxyInfo {
@@ -6384,7 +6557,7 @@ type CreateProxyRequest struct {
 
 func (x *CreateProxyRequest) Reset() {
 	*x = CreateProxyRequest{}
-	mi := &file_proto_asset_proto_msgTypes[88]
+	mi := &file_proto_asset_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6396,7 +6569,7 @@ func (x *CreateProxyRequest) String() string {
 func (*CreateProxyRequest) ProtoMessage() {}
 
 func (x *CreateProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[88]
+	mi := &file_proto_asset_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6409,7 +6582,7 @@ func (x *CreateProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProxyRequest.ProtoReflect.Descriptor instead.
 func (*CreateProxyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{88}
+	return file_proto_asset_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *CreateProxyRequest) GetHost() string {
@@ -6491,7 +6664,7 @@ type CreateProxyResponse struct {
 
 func (x *CreateProxyResponse) Reset() {
 	*x = CreateProxyResponse{}
-	mi := &file_proto_asset_proto_msgTypes[89]
+	mi := &file_proto_asset_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6503,7 +6676,7 @@ func (x *CreateProxyResponse) String() string {
 func (*CreateProxyResponse) ProtoMessage() {}
 
 func (x *CreateProxyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[89]
+	mi := &file_proto_asset_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6516,7 +6689,7 @@ func (x *CreateProxyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProxyResponse.ProtoReflect.Descriptor instead.
 func (*CreateProxyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{89}
+	return file_proto_asset_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *CreateProxyResponse) GetId() int64 {
@@ -6544,7 +6717,7 @@ type UpdateProxyRequest struct {
 
 func (x *UpdateProxyRequest) Reset() {
 	*x = UpdateProxyRequest{}
-	mi := &file_proto_asset_proto_msgTypes[90]
+	mi := &file_proto_asset_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6556,7 +6729,7 @@ func (x *UpdateProxyRequest) String() string {
 func (*UpdateProxyRequest) ProtoMessage() {}
 
 func (x *UpdateProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[90]
+	mi := &file_proto_asset_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6569,7 +6742,7 @@ func (x *UpdateProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProxyRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProxyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{90}
+	return file_proto_asset_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *UpdateProxyRequest) GetId() int64 {
@@ -6651,7 +6824,7 @@ type UpdateProxyResponse struct {
 
 func (x *UpdateProxyResponse) Reset() {
 	*x = UpdateProxyResponse{}
-	mi := &file_proto_asset_proto_msgTypes[91]
+	mi := &file_proto_asset_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6663,7 +6836,7 @@ func (x *UpdateProxyResponse) String() string {
 func (*UpdateProxyResponse) ProtoMessage() {}
 
 func (x *UpdateProxyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[91]
+	mi := &file_proto_asset_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6676,7 +6849,7 @@ func (x *UpdateProxyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProxyResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProxyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{91}
+	return file_proto_asset_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *UpdateProxyResponse) GetSuccess() bool {
@@ -6696,7 +6869,7 @@ type UpdateProxyStatusRequest struct {
 
 func (x *UpdateProxyStatusRequest) Reset() {
 	*x = UpdateProxyStatusRequest{}
-	mi := &file_proto_asset_proto_msgTypes[92]
+	mi := &file_proto_asset_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6708,7 +6881,7 @@ func (x *UpdateProxyStatusRequest) String() string {
 func (*UpdateProxyStatusRequest) ProtoMessage() {}
 
 func (x *UpdateProxyStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[92]
+	mi := &file_proto_asset_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6721,7 +6894,7 @@ func (x *UpdateProxyStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProxyStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProxyStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{92}
+	return file_proto_asset_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *UpdateProxyStatusRequest) GetId() int64 {
@@ -6747,7 +6920,7 @@ type UpdateProxyStatusResponse struct {
 
 func (x *UpdateProxyStatusResponse) Reset() {
 	*x = UpdateProxyStatusResponse{}
-	mi := &file_proto_asset_proto_msgTypes[93]
+	mi := &file_proto_asset_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6759,7 +6932,7 @@ func (x *UpdateProxyStatusResponse) String() string {
 func (*UpdateProxyStatusResponse) ProtoMessage() {}
 
 func (x *UpdateProxyStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[93]
+	mi := &file_proto_asset_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6772,7 +6945,7 @@ func (x *UpdateProxyStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProxyStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProxyStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{93}
+	return file_proto_asset_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *UpdateProxyStatusResponse) GetSuccess() bool {
@@ -6791,7 +6964,7 @@ type DeleteProxyRequest struct {
 
 func (x *DeleteProxyRequest) Reset() {
 	*x = DeleteProxyRequest{}
-	mi := &file_proto_asset_proto_msgTypes[94]
+	mi := &file_proto_asset_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6803,7 +6976,7 @@ func (x *DeleteProxyRequest) String() string {
 func (*DeleteProxyRequest) ProtoMessage() {}
 
 func (x *DeleteProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[94]
+	mi := &file_proto_asset_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6816,7 +6989,7 @@ func (x *DeleteProxyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProxyRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProxyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{94}
+	return file_proto_asset_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *DeleteProxyRequest) GetId() int64 {
@@ -6835,7 +7008,7 @@ type DeleteProxyResponse struct {
 
 func (x *DeleteProxyResponse) Reset() {
 	*x = DeleteProxyResponse{}
-	mi := &file_proto_asset_proto_msgTypes[95]
+	mi := &file_proto_asset_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6847,7 +7020,7 @@ func (x *DeleteProxyResponse) String() string {
 func (*DeleteProxyResponse) ProtoMessage() {}
 
 func (x *DeleteProxyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[95]
+	mi := &file_proto_asset_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6860,7 +7033,7 @@ func (x *DeleteProxyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProxyResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProxyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{95}
+	return file_proto_asset_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *DeleteProxyResponse) GetSuccess() bool {
@@ -6893,7 +7066,7 @@ type CookieInfo struct {
 
 func (x *CookieInfo) Reset() {
 	*x = CookieInfo{}
-	mi := &file_proto_asset_proto_msgTypes[96]
+	mi := &file_proto_asset_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6905,7 +7078,7 @@ func (x *CookieInfo) String() string {
 func (*CookieInfo) ProtoMessage() {}
 
 func (x *CookieInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[96]
+	mi := &file_proto_asset_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6918,7 +7091,7 @@ func (x *CookieInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CookieInfo.ProtoReflect.Descriptor instead.
 func (*CookieInfo) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{96}
+	return file_proto_asset_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *CookieInfo) GetId() int64 {
@@ -7033,7 +7206,7 @@ type CreateCookieRequest struct {
 
 func (x *CreateCookieRequest) Reset() {
 	*x = CreateCookieRequest{}
-	mi := &file_proto_asset_proto_msgTypes[97]
+	mi := &file_proto_asset_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7045,7 +7218,7 @@ func (x *CreateCookieRequest) String() string {
 func (*CreateCookieRequest) ProtoMessage() {}
 
 func (x *CreateCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[97]
+	mi := &file_proto_asset_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7058,7 +7231,7 @@ func (x *CreateCookieRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCookieRequest.ProtoReflect.Descriptor instead.
 func (*CreateCookieRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{97}
+	return file_proto_asset_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *CreateCookieRequest) GetPlatform() string {
@@ -7105,7 +7278,7 @@ type CreateCookieResponse struct {
 
 func (x *CreateCookieResponse) Reset() {
 	*x = CreateCookieResponse{}
-	mi := &file_proto_asset_proto_msgTypes[98]
+	mi := &file_proto_asset_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7117,7 +7290,7 @@ func (x *CreateCookieResponse) String() string {
 func (*CreateCookieResponse) ProtoMessage() {}
 
 func (x *CreateCookieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[98]
+	mi := &file_proto_asset_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7130,7 +7303,7 @@ func (x *CreateCookieResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCookieResponse.ProtoReflect.Descriptor instead.
 func (*CreateCookieResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{98}
+	return file_proto_asset_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *CreateCookieResponse) GetId() int64 {
@@ -7154,7 +7327,7 @@ type UpdateCookieRequest struct {
 
 func (x *UpdateCookieRequest) Reset() {
 	*x = UpdateCookieRequest{}
-	mi := &file_proto_asset_proto_msgTypes[99]
+	mi := &file_proto_asset_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7166,7 +7339,7 @@ func (x *UpdateCookieRequest) String() string {
 func (*UpdateCookieRequest) ProtoMessage() {}
 
 func (x *UpdateCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[99]
+	mi := &file_proto_asset_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7179,7 +7352,7 @@ func (x *UpdateCookieRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCookieRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCookieRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{99}
+	return file_proto_asset_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *UpdateCookieRequest) GetId() int64 {
@@ -7226,7 +7399,7 @@ type UpdateCookieResponse struct {
 
 func (x *UpdateCookieResponse) Reset() {
 	*x = UpdateCookieResponse{}
-	mi := &file_proto_asset_proto_msgTypes[100]
+	mi := &file_proto_asset_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7238,7 +7411,7 @@ func (x *UpdateCookieResponse) String() string {
 func (*UpdateCookieResponse) ProtoMessage() {}
 
 func (x *UpdateCookieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[100]
+	mi := &file_proto_asset_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7251,7 +7424,7 @@ func (x *UpdateCookieResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCookieResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCookieResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{100}
+	return file_proto_asset_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *UpdateCookieResponse) GetSuccess() bool {
@@ -7271,7 +7444,7 @@ type DeleteCookieRequest struct {
 
 func (x *DeleteCookieRequest) Reset() {
 	*x = DeleteCookieRequest{}
-	mi := &file_proto_asset_proto_msgTypes[101]
+	mi := &file_proto_asset_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7283,7 +7456,7 @@ func (x *DeleteCookieRequest) String() string {
 func (*DeleteCookieRequest) ProtoMessage() {}
 
 func (x *DeleteCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[101]
+	mi := &file_proto_asset_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7296,7 +7469,7 @@ func (x *DeleteCookieRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCookieRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCookieRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{101}
+	return file_proto_asset_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *DeleteCookieRequest) GetId() int64 {
@@ -7315,7 +7488,7 @@ type DeleteCookieResponse struct {
 
 func (x *DeleteCookieResponse) Reset() {
 	*x = DeleteCookieResponse{}
-	mi := &file_proto_asset_proto_msgTypes[102]
+	mi := &file_proto_asset_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7327,7 +7500,7 @@ func (x *DeleteCookieResponse) String() string {
 func (*DeleteCookieResponse) ProtoMessage() {}
 
 func (x *DeleteCookieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[102]
+	mi := &file_proto_asset_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7340,7 +7513,7 @@ func (x *DeleteCookieResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCookieResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCookieResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{102}
+	return file_proto_asset_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *DeleteCookieResponse) GetSuccess() bool {
@@ -7360,7 +7533,7 @@ type GetCookieRequest struct {
 
 func (x *GetCookieRequest) Reset() {
 	*x = GetCookieRequest{}
-	mi := &file_proto_asset_proto_msgTypes[103]
+	mi := &file_proto_asset_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7372,7 +7545,7 @@ func (x *GetCookieRequest) String() string {
 func (*GetCookieRequest) ProtoMessage() {}
 
 func (x *GetCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[103]
+	mi := &file_proto_asset_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7385,7 +7558,7 @@ func (x *GetCookieRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCookieRequest.ProtoReflect.Descriptor instead.
 func (*GetCookieRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{103}
+	return file_proto_asset_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *GetCookieRequest) GetId() int64 {
@@ -7404,7 +7577,7 @@ type GetCookieResponse struct {
 
 func (x *GetCookieResponse) Reset() {
 	*x = GetCookieResponse{}
-	mi := &file_proto_asset_proto_msgTypes[104]
+	mi := &file_proto_asset_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7416,7 +7589,7 @@ func (x *GetCookieResponse) String() string {
 func (*GetCookieResponse) ProtoMessage() {}
 
 func (x *GetCookieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[104]
+	mi := &file_proto_asset_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7429,7 +7602,7 @@ func (x *GetCookieResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCookieResponse.ProtoReflect.Descriptor instead.
 func (*GetCookieResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{104}
+	return file_proto_asset_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *GetCookieResponse) GetCookie() *CookieInfo {
@@ -7452,7 +7625,7 @@ type ListCookiesRequest struct {
 
 func (x *ListCookiesRequest) Reset() {
 	*x = ListCookiesRequest{}
-	mi := &file_proto_asset_proto_msgTypes[105]
+	mi := &file_proto_asset_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7464,7 +7637,7 @@ func (x *ListCookiesRequest) String() string {
 func (*ListCookiesRequest) ProtoMessage() {}
 
 func (x *ListCookiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[105]
+	mi := &file_proto_asset_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7477,7 +7650,7 @@ func (x *ListCookiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCookiesRequest.ProtoReflect.Descriptor instead.
 func (*ListCookiesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{105}
+	return file_proto_asset_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *ListCookiesRequest) GetPlatform() string {
@@ -7520,7 +7693,7 @@ type ListCookiesResponse struct {
 
 func (x *ListCookiesResponse) Reset() {
 	*x = ListCookiesResponse{}
-	mi := &file_proto_asset_proto_msgTypes[106]
+	mi := &file_proto_asset_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7532,7 +7705,7 @@ func (x *ListCookiesResponse) String() string {
 func (*ListCookiesResponse) ProtoMessage() {}
 
 func (x *ListCookiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[106]
+	mi := &file_proto_asset_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7545,7 +7718,7 @@ func (x *ListCookiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCookiesResponse.ProtoReflect.Descriptor instead.
 func (*ListCookiesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{106}
+	return file_proto_asset_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *ListCookiesResponse) GetTotal() int64 {
@@ -7586,7 +7759,7 @@ type GetAvailableCookieRequest struct {
 
 func (x *GetAvailableCookieRequest) Reset() {
 	*x = GetAvailableCookieRequest{}
-	mi := &file_proto_asset_proto_msgTypes[107]
+	mi := &file_proto_asset_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7598,7 +7771,7 @@ func (x *GetAvailableCookieRequest) String() string {
 func (*GetAvailableCookieRequest) ProtoMessage() {}
 
 func (x *GetAvailableCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[107]
+	mi := &file_proto_asset_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7611,7 +7784,7 @@ func (x *GetAvailableCookieRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAvailableCookieRequest.ProtoReflect.Descriptor instead.
 func (*GetAvailableCookieRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{107}
+	return file_proto_asset_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *GetAvailableCookieRequest) GetPlatform() string {
@@ -7631,7 +7804,7 @@ type GetAvailableCookieResponse struct {
 
 func (x *GetAvailableCookieResponse) Reset() {
 	*x = GetAvailableCookieResponse{}
-	mi := &file_proto_asset_proto_msgTypes[108]
+	mi := &file_proto_asset_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7643,7 +7816,7 @@ func (x *GetAvailableCookieResponse) String() string {
 func (*GetAvailableCookieResponse) ProtoMessage() {}
 
 func (x *GetAvailableCookieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[108]
+	mi := &file_proto_asset_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7656,7 +7829,7 @@ func (x *GetAvailableCookieResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAvailableCookieResponse.ProtoReflect.Descriptor instead.
 func (*GetAvailableCookieResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{108}
+	return file_proto_asset_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *GetAvailableCookieResponse) GetCookieId() int64 {
@@ -7687,7 +7860,7 @@ type ReportCookieUsageRequest struct {
 
 func (x *ReportCookieUsageRequest) Reset() {
 	*x = ReportCookieUsageRequest{}
-	mi := &file_proto_asset_proto_msgTypes[109]
+	mi := &file_proto_asset_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7699,7 +7872,7 @@ func (x *ReportCookieUsageRequest) String() string {
 func (*ReportCookieUsageRequest) ProtoMessage() {}
 
 func (x *ReportCookieUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[109]
+	mi := &file_proto_asset_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7712,7 +7885,7 @@ func (x *ReportCookieUsageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportCookieUsageRequest.ProtoReflect.Descriptor instead.
 func (*ReportCookieUsageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{109}
+	return file_proto_asset_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *ReportCookieUsageRequest) GetCookieId() int64 {
@@ -7759,7 +7932,7 @@ type ReportCookieUsageResponse struct {
 
 func (x *ReportCookieUsageResponse) Reset() {
 	*x = ReportCookieUsageResponse{}
-	mi := &file_proto_asset_proto_msgTypes[110]
+	mi := &file_proto_asset_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7771,7 +7944,7 @@ func (x *ReportCookieUsageResponse) String() string {
 func (*ReportCookieUsageResponse) ProtoMessage() {}
 
 func (x *ReportCookieUsageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[110]
+	mi := &file_proto_asset_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7784,7 +7957,7 @@ func (x *ReportCookieUsageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportCookieUsageResponse.ProtoReflect.Descriptor instead.
 func (*ReportCookieUsageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{110}
+	return file_proto_asset_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *ReportCookieUsageResponse) GetSuccess() bool {
@@ -7805,7 +7978,7 @@ type FreezeCookieRequest struct {
 
 func (x *FreezeCookieRequest) Reset() {
 	*x = FreezeCookieRequest{}
-	mi := &file_proto_asset_proto_msgTypes[111]
+	mi := &file_proto_asset_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7817,7 +7990,7 @@ func (x *FreezeCookieRequest) String() string {
 func (*FreezeCookieRequest) ProtoMessage() {}
 
 func (x *FreezeCookieRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[111]
+	mi := &file_proto_asset_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7830,7 +8003,7 @@ func (x *FreezeCookieRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FreezeCookieRequest.ProtoReflect.Descriptor instead.
 func (*FreezeCookieRequest) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{111}
+	return file_proto_asset_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *FreezeCookieRequest) GetCookieId() int64 {
@@ -7857,7 +8030,7 @@ type FreezeCookieResponse struct {
 
 func (x *FreezeCookieResponse) Reset() {
 	*x = FreezeCookieResponse{}
-	mi := &file_proto_asset_proto_msgTypes[112]
+	mi := &file_proto_asset_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7869,7 +8042,7 @@ func (x *FreezeCookieResponse) String() string {
 func (*FreezeCookieResponse) ProtoMessage() {}
 
 func (x *FreezeCookieResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_asset_proto_msgTypes[112]
+	mi := &file_proto_asset_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7882,7 +8055,7 @@ func (x *FreezeCookieResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FreezeCookieResponse.ProtoReflect.Descriptor instead.
 func (*FreezeCookieResponse) Descriptor() ([]byte, []int) {
-	return file_proto_asset_proto_rawDescGZIP(), []int{112}
+	return file_proto_asset_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *FreezeCookieResponse) GetSuccess() bool {
@@ -7899,6 +8072,111 @@ func (x *FreezeCookieResponse) GetFrozenUntil() string {
 	return ""
 }
 
+// 发布业务事件
+type EmitWebhookEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // task.completed / task.failed / billing.captured / balance.low
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DataJson      string                 `protobuf:"bytes,3,opt,name=data_json,json=dataJson,proto3" json:"data_json,omitempty"` // 事件数据（JSON 对象）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmitWebhookEventRequest) Reset() {
+	*x = EmitWebhookEventRequest{}
+	mi := &file_proto_asset_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmitWebhookEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmitWebhookEventRequest) ProtoMessage() {}
+
+func (x *EmitWebhookEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmitWebhookEventRequest.ProtoReflect.Descriptor instead.
+func (*EmitWebhookEventRequest) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *EmitWebhookEventRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *EmitWebhookEventRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EmitWebhookEventRequest) GetDataJson() string {
+	if x != nil {
+		return x.DataJson
+	}
+	return ""
+}
+
+type EmitWebhookEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    int32                  `protobuf:"varint,1,opt,name=deliveries,proto3" json:"deliveries,omitempty"` // 生成的投递数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmitWebhookEventResponse) Reset() {
+	*x = EmitWebhookEventResponse{}
+	mi := &file_proto_asset_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmitWebhookEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmitWebhookEventResponse) ProtoMessage() {}
+
+func (x *EmitWebhookEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_asset_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmitWebhookEventResponse.ProtoReflect.Descriptor instead.
+func (*EmitWebhookEventResponse) Descriptor() ([]byte, []int) {
+	return file_proto_asset_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *EmitWebhookEventResponse) GetDeliveries() int32 {
+	if x != nil {
+		return x.Deliveries
+	}
+	return 0
+}
+
 var File_proto_asset_proto protoreflect.FileDescriptor
 
 const file_proto_asset_proto_rawDesc = "" +
@@ -8009,12 +8287,20 @@ const file_proto_asset_proto_rawDesc = "" +
 	"\x12GetFileInfoRequest\x12\x1d\n" +
 	"\n" +
 	"history_id\x18\x01 \x01(\x03R\thistoryId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x89\x01\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xc0\x01\n" +
 	"\x13GetFileInfoResponse\x12\x1b\n" +
 	"\tfile_path\x18\x01 \x01(\tR\bfilePath\x12\x1b\n" +
 	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x1b\n" +
 	"\tfile_size\x18\x03 \x01(\x03R\bfileSize\x12\x1b\n" +
-	"\tfile_hash\x18\x04 \x01(\tR\bfileHash\"\x8c\x02\n" +
+	"\tfile_hash\x18\x04 \x01(\tR\bfileHash\x125\n" +
+	"\vattachments\x18\x05 \x03(\v2\x13.asset.AttachedFileR\vattachments\"\xa5\x01\n" +
+	"\fAttachedFile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\x12\x1b\n" +
+	"\tfile_path\x18\x04 \x01(\tR\bfilePath\x12\x1b\n" +
+	"\tfile_name\x18\x05 \x01(\tR\bfileName\x12\x1b\n" +
+	"\tfile_size\x18\x06 \x01(\x03R\bfileSize\"\xc5\x02\n" +
 	"\x14CreateHistoryRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x10\n" +
@@ -8026,10 +8312,14 @@ const file_proto_asset_proto_rawDesc = "" +
 	"\tthumbnail\x18\b \x01(\tR\tthumbnail\x12\x1a\n" +
 	"\bduration\x18\t \x01(\x03R\bduration\x12\x16\n" +
 	"\x06author\x18\n" +
-	" \x01(\tR\x06author\"6\n" +
+	" \x01(\tR\x06author\x12\x1a\n" +
+	"\bpriority\x18\v \x01(\x05R\bpriority\x12\x1b\n" +
+	"\tdedup_key\x18\f \x01(\tR\bdedupKey\"m\n" +
 	"\x15CreateHistoryResponse\x12\x1d\n" +
 	"\n" +
-	"history_id\x18\x01 \x01(\x03R\thistoryId\"\xe6\x01\n" +
+	"history_id\x18\x01 \x01(\x03R\thistoryId\x12\x1c\n" +
+	"\tduplicate\x18\x02 \x01(\bR\tduplicate\x12\x17\n" +
+	"\atask_id\x18\x03 \x01(\tR\x06taskId\"\xe6\x01\n" +
 	"\x1aUpdateHistoryStatusRequest\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x1b\n" +
@@ -8099,13 +8389,16 @@ const file_proto_asset_proto_rawDesc = "" +
 	"\x03vbr\x18\n" +
 	" \x01(\x01R\x03vbr\x12\x10\n" +
 	"\x03abr\x18\v \x01(\x01R\x03abr\x12\x10\n" +
-	"\x03asr\x18\f \x01(\x05R\x03asr\"\xc2\x01\n" +
+	"\x03asr\x18\f \x01(\x05R\x03asr\"\xc5\x02\n" +
 	"\x1eEstimateDownloadBillingRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1a\n" +
 	"\bplatform\x18\x03 \x01(\tR\bplatform\x12\x12\n" +
 	"\x04mode\x18\x04 \x01(\tR\x04mode\x12E\n" +
-	"\x0fselected_format\x18\x05 \x01(\v2\x1c.asset.BillingSelectedFormatR\x0eselectedFormat\"\xec\x02\n" +
+	"\x0fselected_format\x18\x05 \x01(\v2\x1c.asset.BillingSelectedFormatR\x0eselectedFormat\x12)\n" +
+	"\x10duration_seconds\x18\x06 \x01(\x03R\x0fdurationSeconds\x12,\n" +
+	"\x12clip_start_seconds\x18\a \x01(\x01R\x10clipStartSeconds\x12(\n" +
+	"\x10clip_end_seconds\x18\b \x01(\x01R\x0eclipEndSeconds\"\xec\x02\n" +
 	"\x1fEstimateDownloadBillingResponse\x126\n" +
 	"\x17estimated_ingress_bytes\x18\x01 \x01(\x03R\x15estimatedIngressBytes\x124\n" +
 	"\x16estimated_egress_bytes\x18\x02 \x01(\x03R\x14estimatedEgressBytes\x126\n" +
@@ -8146,12 +8439,16 @@ const file_proto_asset_proto_rawDesc = "" +
 	"\x1eReleaseInitialDownloadResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x19\n" +
 	"\border_no\x18\x02 \x01(\tR\aorderNo\x120\n" +
-	"\x14released_amount_yuan\x18\x03 \x01(\tR\x12releasedAmountYuan\"\x83\x01\n" +
+	"\x14released_amount_yuan\x18\x03 \x01(\tR\x12releasedAmountYuan\"\xda\x01\n" +
 	"!PrepareFileTransferBillingRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
 	"history_id\x18\x02 \x01(\x03R\thistoryId\x12&\n" +
-	"\x0ffile_size_bytes\x18\x03 \x01(\x03R\rfileSizeBytes\"\xcc\x02\n" +
+	"\x0ffile_size_bytes\x18\x03 \x01(\x03R\rfileSizeBytes\x12\x17\n" +
+	"\afile_id\x18\x04 \x01(\x03R\x06fileId\x12\x1f\n" +
+	"\vrange_start\x18\x05 \x01(\x03R\n" +
+	"rangeStart\x12\x1b\n" +
+	"\trange_end\x18\x06 \x01(\x03R\brangeEnd\"\xcc\x02\n" +
 	"\"PrepareFileTransferBillingResponse\x12\x1f\n" +
 	"\vtransfer_id\x18\x01 \x01(\tR\n" +
 	"transferId\x12\x19\n" +
@@ -8557,7 +8854,16 @@ const file_proto_asset_proto_rawDesc = "" +
 	"\x0efreeze_seconds\x18\x02 \x01(\x05R\rfreezeSeconds\"S\n" +
 	"\x14FreezeCookieResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12!\n" +
-	"\ffrozen_until\x18\x02 \x01(\tR\vfrozenUntil2\xa3\"\n" +
+	"\ffrozen_until\x18\x02 \x01(\tR\vfrozenUntil\"n\n" +
+	"\x17EmitWebhookEventRequest\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tR\teventType\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tdata_json\x18\x03 \x01(\tR\bdataJson\":\n" +
+	"\x18EmitWebhookEventResponse\x12\x1e\n" +
+	"\n" +
+	"deliveries\x18\x01 \x01(\x05R\n" +
+	"deliveries2\xf8\"\n" +
 	"\fAssetService\x12A\n" +
 	"\n" +
 	"GetHistory\x12\x18.asset.GetHistoryRequest\x1a\x19.asset.GetHistoryResponse\x12J\n" +
@@ -8610,7 +8916,8 @@ const file_proto_asset_proto_rawDesc = "" +
 	"\vListCookies\x12\x19.asset.ListCookiesRequest\x1a\x1a.asset.ListCookiesResponse\x12Y\n" +
 	"\x12GetAvailableCookie\x12 .asset.GetAvailableCookieRequest\x1a!.asset.GetAvailableCookieResponse\x12V\n" +
 	"\x11ReportCookieUsage\x12\x1f.asset.ReportCookieUsageRequest\x1a .asset.ReportCookieUsageResponse\x12G\n" +
-	"\fFreezeCookie\x12\x1a.asset.FreezeCookieRequest\x1a\x1b.asset.FreezeCookieResponseB\x1fZ\x1dyoudlp/asset-service/proto;pbb\x06proto3"
+	"\fFreezeCookie\x12\x1a.asset.FreezeCookieRequest\x1a\x1b.asset.FreezeCookieResponse\x12S\n" +
+	"\x10EmitWebhookEvent\x12\x1e.asset.EmitWebhookEventRequest\x1a\x1f.asset.EmitWebhookEventResponseB\x1fZ\x1dyoudlp/asset-service/proto;pbb\x06proto3"
 
 var (
 	file_proto_asset_proto_rawDescOnce sync.Once
@@ -8624,7 +8931,7 @@ func file_proto_asset_proto_rawDescGZIP() []byte {
 	return file_proto_asset_proto_rawDescData
 }
 
-var file_proto_asset_proto_msgTypes = make([]protoimpl.MessageInfo, 113)
+var file_proto_asset_proto_msgTypes = make([]protoimpl.MessageInfo, 116)
 var file_proto_asset_proto_goTypes = []any{
 	(*GetHistoryRequest)(nil),                   // 0: asset.GetHistoryRequest
 	(*GetHistoryResponse)(nil),                  // 1: asset.GetHistoryResponse
@@ -8650,222 +8957,228 @@ var file_proto_asset_proto_goTypes = []any{
 	(*GetRequestTrendResponse)(nil),             // 21: asset.GetRequestTrendResponse
 	(*GetFileInfoRequest)(nil),                  // 22: asset.GetFileInfoRequest
 	(*GetFileInfoResponse)(nil),                 // 23: asset.GetFileInfoResponse
-	(*CreateHistoryRequest)(nil),                // 24: asset.CreateHistoryRequest
-	(*CreateHistoryResponse)(nil),               // 25: asset.CreateHistoryResponse
-	(*UpdateHistoryStatusRequest)(nil),          // 26: asset.UpdateHistoryStatusRequest
-	(*UpdateHistoryStatusResponse)(nil),         // 27: asset.UpdateHistoryStatusResponse
-	(*BillingAccountSnapshot)(nil),              // 28: asset.BillingAccountSnapshot
-	(*GetBillingAccountRequest)(nil),            // 29: asset.GetBillingAccountRequest
-	(*GetBillingAccountResponse)(nil),           // 30: asset.GetBillingAccountResponse
-	(*BillingStatementItem)(nil),                // 31: asset.BillingStatementItem
-	(*ListBillingStatementsRequest)(nil),        // 32: asset.ListBillingStatementsRequest
-	(*ListBillingStatementsResponse)(nil),       // 33: asset.ListBillingStatementsResponse
-	(*BillingSelectedFormat)(nil),               // 34: asset.BillingSelectedFormat
-	(*EstimateDownloadBillingRequest)(nil),      // 35: asset.EstimateDownloadBillingRequest
-	(*EstimateDownloadBillingResponse)(nil),     // 36: asset.EstimateDownloadBillingResponse
-	(*HoldInitialDownloadRequest)(nil),          // 37: asset.HoldInitialDownloadRequest
-	(*HoldInitialDownloadResponse)(nil),         // 38: asset.HoldInitialDownloadResponse
-	(*CaptureIngressUsageRequest)(nil),          // 39: asset.CaptureIngressUsageRequest
-	(*CaptureIngressUsageResponse)(nil),         // 40: asset.CaptureIngressUsageResponse
-	(*ReleaseInitialDownloadRequest)(nil),       // 41: asset.ReleaseInitialDownloadRequest
-	(*ReleaseInitialDownloadResponse)(nil),      // 42: asset.ReleaseInitialDownloadResponse
-	(*PrepareFileTransferBillingRequest)(nil),   // 43: asset.PrepareFileTransferBillingRequest
-	(*PrepareFileTransferBillingResponse)(nil),  // 44: asset.PrepareFileTransferBillingResponse
-	(*CompleteFileTransferBillingRequest)(nil),  // 45: asset.CompleteFileTransferBillingRequest
-	(*CompleteFileTransferBillingResponse)(nil), // 46: asset.CompleteFileTransferBillingResponse
-	(*AbortFileTransferBillingRequest)(nil),     // 47: asset.AbortFileTransferBillingRequest
-	(*AbortFileTransferBillingResponse)(nil),    // 48: asset.AbortFileTransferBillingResponse
-	(*ListBillingAccountsRequest)(nil),          // 49: asset.ListBillingAccountsRequest
-	(*ListBillingAccountsResponse)(nil),         // 50: asset.ListBillingAccountsResponse
-	(*GetBillingAccountDetailRequest)(nil),      // 51: asset.GetBillingAccountDetailRequest
-	(*GetBillingAccountDetailResponse)(nil),     // 52: asset.GetBillingAccountDetailResponse
-	(*AdjustBillingBalanceRequest)(nil),         // 53: asset.AdjustBillingBalanceRequest
-	(*AdjustBillingBalanceResponse)(nil),        // 54: asset.AdjustBillingBalanceResponse
-	(*LedgerEntryItem)(nil),                     // 55: asset.LedgerEntryItem
-	(*ListBillingLedgerRequest)(nil),            // 56: asset.ListBillingLedgerRequest
-	(*ListBillingLedgerResponse)(nil),           // 57: asset.ListBillingLedgerResponse
-	(*TrafficUsageRecordItem)(nil),              // 58: asset.TrafficUsageRecordItem
-	(*ListTrafficUsageRecordsRequest)(nil),      // 59: asset.ListTrafficUsageRecordsRequest
-	(*ListTrafficUsageRecordsResponse)(nil),     // 60: asset.ListTrafficUsageRecordsResponse
-	(*BillingPricing)(nil),                      // 61: asset.BillingPricing
-	(*GetBillingPricingRequest)(nil),            // 62: asset.GetBillingPricingRequest
-	(*GetBillingPricingResponse)(nil),           // 63: asset.GetBillingPricingResponse
-	(*UpdateBillingPricingRequest)(nil),         // 64: asset.UpdateBillingPricingRequest
-	(*UpdateBillingPricingResponse)(nil),        // 65: asset.UpdateBillingPricingResponse
-	(*BillingShortfallOrderItem)(nil),           // 66: asset.BillingShortfallOrderItem
-	(*ListBillingShortfallsRequest)(nil),        // 67: asset.ListBillingShortfallsRequest
-	(*ListBillingShortfallsResponse)(nil),       // 68: asset.ListBillingShortfallsResponse
-	(*ReconcileBillingShortfallRequest)(nil),    // 69: asset.ReconcileBillingShortfallRequest
-	(*ReconcileBillingShortfallResponse)(nil),   // 70: asset.ReconcileBillingShortfallResponse
-	(*AcquireProxyForTaskRequest)(nil),          // 71: asset.AcquireProxyForTaskRequest
-	(*AcquireProxyForTaskResponse)(nil),         // 72: asset.AcquireProxyForTaskResponse
-	(*GetAvailableProxyRequest)(nil),            // 73: asset.GetAvailableProxyRequest
-	(*GetAvailableProxyResponse)(nil),           // 74: asset.GetAvailableProxyResponse
-	(*CheckProxySourceStatusRequest)(nil),       // 75: asset.CheckProxySourceStatusRequest
-	(*CheckProxySourceStatusResponse)(nil),      // 76: asset.CheckProxySourceStatusResponse
-	(*ReportProxyUsageRequest)(nil),             // 77: asset.ReportProxyUsageRequest
-	(*ReportProxyUsageResponse)(nil),            // 78: asset.ReportProxyUsageResponse
-	(*ReleaseProxyForTaskRequest)(nil),          // 79: asset.ReleaseProxyForTaskRequest
-	(*ReleaseProxyForTaskResponse)(nil),         // 80: asset.ReleaseProxyForTaskResponse
-	(*GetProxySourcePolicyRequest)(nil),         // 81: asset.GetProxySourcePolicyRequest
-	(*GetProxySourcePolicyResponse)(nil),        // 82: asset.GetProxySourcePolicyResponse
-	(*UpdateProxySourcePolicyRequest)(nil),      // 83: asset.UpdateProxySourcePolicyRequest
-	(*UpdateProxySourcePolicyResponse)(nil),     // 84: asset.UpdateProxySourcePolicyResponse
-	(*ProxyInfo)(nil),                           // 85: asset.ProxyInfo
-	(*ListProxiesRequest)(nil),                  // 86: asset.ListProxiesRequest
-	(*ListProxiesResponse)(nil),                 // 87: asset.ListProxiesResponse
-	(*CreateProxyRequest)(nil),                  // 88: asset.CreateProxyRequest
-	(*CreateProxyResponse)(nil),                 // 89: asset.CreateProxyResponse
-	(*UpdateProxyRequest)(nil),                  // 90: asset.UpdateProxyRequest
-	(*UpdateProxyResponse)(nil),                 // 91: asset.UpdateProxyResponse
-	(*UpdateProxyStatusRequest)(nil),            // 92: asset.UpdateProxyStatusRequest
-	(*UpdateProxyStatusResponse)(nil),           // 93: asset.UpdateProxyStatusResponse
-	(*DeleteProxyRequest)(nil),                  // 94: asset.DeleteProxyRequest
-	(*DeleteProxyResponse)(nil),                 // 95: asset.DeleteProxyResponse
-	(*CookieInfo)(nil),                          // 96: asset.CookieInfo
-	(*CreateCookieRequest)(nil),                 // 97: asset.CreateCookieRequest
-	(*CreateCookieResponse)(nil),                // 98: asset.CreateCookieResponse
-	(*UpdateCookieRequest)(nil),                 // 99: asset.UpdateCookieRequest
-	(*UpdateCookieResponse)(nil),                // 100: asset.UpdateCookieResponse
-	(*DeleteCookieRequest)(nil),                 // 101: asset.DeleteCookieRequest
-	(*DeleteCookieResponse)(nil),                // 102: asset.DeleteCookieResponse
-	(*GetCookieRequest)(nil),                    // 103: asset.GetCookieRequest
-	(*GetCookieResponse)(nil),                   // 104: asset.GetCookieResponse
-	(*ListCookiesRequest)(nil),                  // 105: asset.ListCookiesRequest
-	(*ListCookiesResponse)(nil),                 // 106: asset.ListCookiesResponse
-	(*GetAvailableCookieRequest)(nil),           // 107: asset.GetAvailableCookieRequest
-	(*GetAvailableCookieResponse)(nil),          // 108: asset.GetAvailableCookieResponse
-	(*ReportCookieUsageRequest)(nil),            // 109: asset.ReportCookieUsageRequest
-	(*ReportCookieUsageResponse)(nil),           // 110: asset.ReportCookieUsageResponse
-	(*FreezeCookieRequest)(nil),                 // 111: asset.FreezeCookieRequest
-	(*FreezeCookieResponse)(nil),                // 112: asset.FreezeCookieResponse
+	(*AttachedFile)(nil),                        // 24: asset.AttachedFile
+	(*CreateHistoryRequest)(nil),                // 25: asset.CreateHistoryRequest
+	(*CreateHistoryResponse)(nil),               // 26: asset.CreateHistoryResponse
+	(*UpdateHistoryStatusRequest)(nil),          // 27: asset.UpdateHistoryStatusRequest
+	(*UpdateHistoryStatusResponse)(nil),         // 28: asset.UpdateHistoryStatusResponse
+	(*BillingAccountSnapshot)(nil),              // 29: asset.BillingAccountSnapshot
+	(*GetBillingAccountRequest)(nil),            // 30: asset.GetBillingAccountRequest
+	(*GetBillingAccountResponse)(nil),           // 31: asset.GetBillingAccountResponse
+	(*BillingStatementItem)(nil),                // 32: asset.BillingStatementItem
+	(*ListBillingStatementsRequest)(nil),        // 33: asset.ListBillingStatementsRequest
+	(*ListBillingStatementsResponse)(nil),       // 34: asset.ListBillingStatementsResponse
+	(*BillingSelectedFormat)(nil),               // 35: asset.BillingSelectedFormat
+	(*EstimateDownloadBillingRequest)(nil),      // 36: asset.EstimateDownloadBillingRequest
+	(*EstimateDownloadBillingResponse)(nil),     // 37: asset.EstimateDownloadBillingResponse
+	(*HoldInitialDownloadRequest)(nil),          // 38: asset.HoldInitialDownloadRequest
+	(*HoldInitialDownloadResponse)(nil),         // 39: asset.HoldInitialDownloadResponse
+	(*CaptureIngressUsageRequest)(nil),          // 40: asset.CaptureIngressUsageRequest
+	(*CaptureIngressUsageResponse)(nil),         // 41: asset.CaptureIngressUsageResponse
+	(*ReleaseInitialDownloadRequest)(nil),       // 42: asset.ReleaseInitialDownloadRequest
+	(*ReleaseInitialDownloadResponse)(nil),      // 43: asset.ReleaseInitialDownloadResponse
+	(*PrepareFileTransferBillingRequest)(nil),   // 44: asset.PrepareFileTransferBillingRequest
+	(*PrepareFileTransferBillingResponse)(nil),  // 45: asset.PrepareFileTransferBillingResponse
+	(*CompleteFileTransferBillingRequest)(nil),  // 46: asset.CompleteFileTransferBillingRequest
+	(*CompleteFileTransferBillingResponse)(nil), // 47: asset.CompleteFileTransferBillingResponse
+	(*AbortFileTransferBillingRequest)(nil),     // 48: asset.AbortFileTransferBillingRequest
+	(*AbortFileTransferBillingResponse)(nil),    // 49: asset.AbortFileTransferBillingResponse
+	(*ListBillingAccountsRequest)(nil),          // 50: asset.ListBillingAccountsRequest
+	(*ListBillingAccountsResponse)(nil),         // 51: asset.ListBillingAccountsResponse
+	(*GetBillingAccountDetailRequest)(nil),      // 52: asset.GetBillingAccountDetailRequest
+	(*GetBillingAccountDetailResponse)(nil),     // 53: asset.GetBillingAccountDetailResponse
+	(*AdjustBillingBalanceRequest)(nil),         // 54: asset.AdjustBillingBalanceRequest
+	(*AdjustBillingBalanceResponse)(nil),        // 55: asset.AdjustBillingBalanceResponse
+	(*LedgerEntryItem)(nil),                     // 56: asset.LedgerEntryItem
+	(*ListBillingLedgerRequest)(nil),            // 57: asset.ListBillingLedgerRequest
+	(*ListBillingLedgerResponse)(nil),           // 58: asset.ListBillingLedgerResponse
+	(*TrafficUsageRecordItem)(nil),              // 59: asset.TrafficUsageRecordItem
+	(*ListTrafficUsageRecordsRequest)(nil),      // 60: asset.ListTrafficUsageRecordsRequest
+	(*ListTrafficUsageRecordsResponse)(nil),     // 61: asset.ListTrafficUsageRecordsResponse
+	(*BillingPricing)(nil),                      // 62: asset.BillingPricing
+	(*GetBillingPricingRequest)(nil),            // 63: asset.GetBillingPricingRequest
+	(*GetBillingPricingResponse)(nil),           // 64: asset.GetBillingPricingResponse
+	(*UpdateBillingPricingRequest)(nil),         // 65: asset.UpdateBillingPricingRequest
+	(*UpdateBillingPricingResponse)(nil),        // 66: asset.UpdateBillingPricingResponse
+	(*BillingShortfallOrderItem)(nil),           // 67: asset.BillingShortfallOrderItem
+	(*ListBillingShortfallsRequest)(nil),        // 68: asset.ListBillingShortfallsRequest
+	(*ListBillingShortfallsResponse)(nil),       // 69: asset.ListBillingShortfallsResponse
+	(*ReconcileBillingShortfallRequest)(nil),    // 70: asset.ReconcileBillingShortfallRequest
+	(*ReconcileBillingShortfallResponse)(nil),   // 71: asset.ReconcileBillingShortfallResponse
+	(*AcquireProxyForTaskRequest)(nil),          // 72: asset.AcquireProxyForTaskRequest
+	(*AcquireProxyForTaskResponse)(nil),         // 73: asset.AcquireProxyForTaskResponse
+	(*GetAvailableProxyRequest)(nil),            // 74: asset.GetAvailableProxyRequest
+	(*GetAvailableProxyResponse)(nil),           // 75: asset.GetAvailableProxyResponse
+	(*CheckProxySourceStatusRequest)(nil),       // 76: asset.CheckProxySourceStatusRequest
+	(*CheckProxySourceStatusResponse)(nil),      // 77: asset.CheckProxySourceStatusResponse
+	(*ReportProxyUsageRequest)(nil),             // 78: asset.ReportProxyUsageRequest
+	(*ReportProxyUsageResponse)(nil),            // 79: asset.ReportProxyUsageResponse
+	(*ReleaseProxyForTaskRequest)(nil),          // 80: asset.ReleaseProxyForTaskRequest
+	(*ReleaseProxyForTaskResponse)(nil),         // 81: asset.ReleaseProxyForTaskResponse
+	(*GetProxySourcePolicyRequest)(nil),         // 82: asset.GetProxySourcePolicyRequest
+	(*GetProxySourcePolicyResponse)(nil),        // 83: asset.GetProxySourcePolicyResponse
+	(*UpdateProxySourcePolicyRequest)(nil),      // 84: asset.UpdateProxySourcePolicyRequest
+	(*UpdateProxySourcePolicyResponse)(nil),     // 85: asset.UpdateProxySourcePolicyResponse
+	(*ProxyInfo)(nil),                           // 86: asset.ProxyInfo
+	(*ListProxiesRequest)(nil),                  // 87: asset.ListProxiesRequest
+	(*ListProxiesResponse)(nil),                 // 88: asset.ListProxiesResponse
+	(*CreateProxyRequest)(nil),                  // 89: asset.CreateProxyRequest
+	(*CreateProxyResponse)(nil),                 // 90: asset.CreateProxyResponse
+	(*UpdateProxyRequest)(nil),                  // 91: asset.UpdateProxyRequest
+	(*UpdateProxyResponse)(nil),                 // 92: asset.UpdateProxyResponse
+	(*UpdateProxyStatusRequest)(nil),            // 93: asset.UpdateProxyStatusRequest
+	(*UpdateProxyStatusResponse)(nil),           // 94: asset.UpdateProxyStatusResponse
+	(*DeleteProxyRequest)(nil),                  // 95: asset.DeleteProxyRequest
+	(*DeleteProxyResponse)(nil),                 // 96: asset.DeleteProxyResponse
+	(*CookieInfo)(nil),                          // 97: asset.CookieInfo
+	(*CreateCookieRequest)(nil),                 // 98: asset.CreateCookieRequest
+	(*CreateCookieResponse)(nil),                // 99: asset.CreateCookieResponse
+	(*UpdateCookieRequest)(nil),                 // 100: asset.UpdateCookieRequest
+	(*UpdateCookieResponse)(nil),                // 101: asset.UpdateCookieResponse
+	(*DeleteCookieRequest)(nil),                 // 102: asset.DeleteCookieRequest
+	(*DeleteCookieResponse)(nil),                // 103: asset.DeleteCookieResponse
+	(*GetCookieRequest)(nil),                    // 104: asset.GetCookieRequest
+	(*GetCookieResponse)(nil),                   // 105: asset.GetCookieResponse
+	(*ListCookiesRequest)(nil),                  // 106: asset.ListCookiesRequest
+	(*ListCookiesResponse)(nil),                 // 107: asset.ListCookiesResponse
+	(*GetAvailableCookieRequest)(nil),           // 108: asset.GetAvailableCookieRequest
+	(*GetAvailableCookieResponse)(nil),          // 109: asset.GetAvailableCookieResponse
+	(*ReportCookieUsageRequest)(nil),            // 110: asset.ReportCookieUsageRequest
+	(*ReportCookieUsageResponse)(nil),           // 111: asset.ReportCookieUsageResponse
+	(*FreezeCookieRequest)(nil),                 // 112: asset.FreezeCookieRequest
+	(*FreezeCookieResponse)(nil),                // 113: asset.FreezeCookieResponse
+	(*EmitWebhookEventRequest)(nil),             // 114: asset.EmitWebhookEventRequest
+	(*EmitWebhookEventResponse)(nil),            // 115: asset.EmitWebhookEventResponse
 }
 var file_proto_asset_proto_depIdxs = []int32{
 	2,   // 0: asset.GetHistoryResponse.items:type_name -> asset.HistoryItem
 	15,  // 1: asset.GetUserStatsResponse.top_platforms:type_name -> asset.PlatformStat
 	16,  // 2: asset.GetUserStatsResponse.recent_activity:type_name -> asset.DailyActivity
 	20,  // 3: asset.GetRequestTrendResponse.points:type_name -> asset.TrendPoint
-	28,  // 4: asset.GetBillingAccountResponse.account:type_name -> asset.BillingAccountSnapshot
-	31,  // 5: asset.ListBillingStatementsResponse.items:type_name -> asset.BillingStatementItem
-	34,  // 6: asset.EstimateDownloadBillingRequest.selected_format:type_name -> asset.BillingSelectedFormat
-	28,  // 7: asset.ListBillingAccountsResponse.items:type_name -> asset.BillingAccountSnapshot
-	28,  // 8: asset.GetBillingAccountDetailResponse.account:type_name -> asset.BillingAccountSnapshot
-	28,  // 9: asset.AdjustBillingBalanceResponse.account:type_name -> asset.BillingAccountSnapshot
-	55,  // 10: asset.ListBillingLedgerResponse.items:type_name -> asset.LedgerEntryItem
-	58,  // 11: asset.ListTrafficUsageRecordsResponse.items:type_name -> asset.TrafficUsageRecordItem
-	61,  // 12: asset.GetBillingPricingResponse.pricing:type_name -> asset.BillingPricing
-	61,  // 13: asset.UpdateBillingPricingResponse.pricing:type_name -> asset.BillingPricing
-	66,  // 14: asset.ListBillingShortfallsResponse.items:type_name -> asset.BillingShortfallOrderItem
-	66,  // 15: asset.ReconcileBillingShortfallResponse.order:type_name -> asset.BillingShortfallOrderItem
-	28,  // 16: asset.ReconcileBillingShortfallResponse.account:type_name -> asset.BillingAccountSnapshot
-	85,  // 17: asset.ListProxiesResponse.items:type_name -> asset.ProxyInfo
-	96,  // 18: asset.GetCookieResponse.cookie:type_name -> asset.CookieInfo
-	96,  // 19: asset.ListCookiesResponse.items:type_name -> asset.CookieInfo
-	0,   // 20: asset.AssetService.GetHistory:input_type -> asset.GetHistoryRequest
-	3,   // 21: asset.AssetService.DeleteHistory:input_type -> asset.DeleteHistoryRequest
-	5,   // 22: asset.AssetService.GetHistoryByTask:input_type -> asset.GetHistoryByTaskRequest
-	7,   // 23: asset.AssetService.CheckQuota:input_type -> asset.CheckQuotaRequest
-	9,   // 24: asset.AssetService.ConsumeQuota:input_type -> asset.ConsumeQuotaRequest
-	11,  // 25: asset.AssetService.RefundQuota:input_type -> asset.RefundQuotaRequest
-	13,  // 26: asset.AssetService.GetUserStats:input_type -> asset.GetUserStatsRequest
-	17,  // 27: asset.AssetService.GetPlatformStats:input_type -> asset.GetPlatformStatsRequest
-	19,  // 28: asset.AssetService.GetRequestTrend:input_type -> asset.GetRequestTrendRequest
-	22,  // 29: asset.AssetService.GetFileInfo:input_type -> asset.GetFileInfoRequest
-	24,  // 30: asset.AssetService.CreateHistory:input_type -> asset.CreateHistoryRequest
-	26,  // 31: asset.AssetService.UpdateHistoryStatus:input_type -> asset.UpdateHistoryStatusRequest
-	29,  // 32: asset.AssetService.GetBillingAccount:input_type -> asset.GetBillingAccountRequest
-	32,  // 33: asset.AssetService.ListBillingStatements:input_type -> asset.ListBillingStatementsRequest
-	35,  // 34: asset.AssetService.EstimateDownloadBilling:input_type -> asset.EstimateDownloadBillingRequest
-	37,  // 35: asset.AssetService.HoldInitialDownload:input_type -> asset.HoldInitialDownloadRequest
-	39,  // 36: asset.AssetService.CaptureIngressUsage:input_type -> asset.CaptureIngressUsageRequest
-	41,  // 37: asset.AssetService.ReleaseInitialDownload:input_type -> asset.ReleaseInitialDownloadRequest
-	43,  // 38: asset.AssetService.PrepareFileTransferBilling:input_type -> asset.PrepareFileTransferBillingRequest
-	45,  // 39: asset.AssetService.CompleteFileTransferBilling:input_type -> asset.CompleteFileTransferBillingRequest
-	47,  // 40: asset.AssetService.AbortFileTransferBilling:input_type -> asset.AbortFileTransferBillingRequest
-	49,  // 41: asset.AssetService.ListBillingAccounts:input_type -> asset.ListBillingAccountsRequest
-	51,  // 42: asset.AssetService.GetBillingAccountDetail:input_type -> asset.GetBillingAccountDetailRequest
-	53,  // 43: asset.AssetService.AdjustBillingBalance:input_type -> asset.AdjustBillingBalanceRequest
-	56,  // 44: asset.AssetService.ListBillingLedger:input_type -> asset.ListBillingLedgerRequest
-	59,  // 45: asset.AssetService.ListTrafficUsageRecords:input_type -> asset.ListTrafficUsageRecordsRequest
-	62,  // 46: asset.AssetService.GetBillingPricing:input_type -> asset.GetBillingPricingRequest
-	64,  // 47: asset.AssetService.UpdateBillingPricing:input_type -> asset.UpdateBillingPricingRequest
-	67,  // 48: asset.AssetService.ListBillingShortfalls:input_type -> asset.ListBillingShortfallsRequest
-	69,  // 49: asset.AssetService.ReconcileBillingShortfall:input_type -> asset.ReconcileBillingShortfallRequest
-	71,  // 50: asset.AssetService.AcquireProxyForTask:input_type -> asset.AcquireProxyForTaskRequest
-	73,  // 51: asset.AssetService.GetAvailableProxy:input_type -> asset.GetAvailableProxyRequest
-	75,  // 52: asset.AssetService.CheckProxySourceStatus:input_type -> asset.CheckProxySourceStatusRequest
-	77,  // 53: asset.AssetService.ReportProxyUsage:input_type -> asset.ReportProxyUsageRequest
-	79,  // 54: asset.AssetService.ReleaseProxyForTask:input_type -> asset.ReleaseProxyForTaskRequest
-	81,  // 55: asset.AssetService.GetProxySourcePolicy:input_type -> asset.GetProxySourcePolicyRequest
-	83,  // 56: asset.AssetService.UpdateProxySourcePolicy:input_type -> asset.UpdateProxySourcePolicyRequest
-	86,  // 57: asset.AssetService.ListProxies:input_type -> asset.ListProxiesRequest
-	88,  // 58: asset.AssetService.CreateProxy:input_type -> asset.CreateProxyRequest
-	90,  // 59: asset.AssetService.UpdateProxy:input_type -> asset.UpdateProxyRequest
-	92,  // 60: asset.AssetService.UpdateProxyStatus:input_type -> asset.UpdateProxyStatusRequest
-	94,  // 61: asset.AssetService.DeleteProxy:input_type -> asset.DeleteProxyRequest
-	97,  // 62: asset.AssetService.CreateCookie:input_type -> asset.CreateCookieRequest
-	99,  // 63: asset.AssetService.UpdateCookie:input_type -> asset.UpdateCookieRequest
-	101, // 64: asset.AssetService.DeleteCookie:input_type -> asset.DeleteCookieRequest
-	103, // 65: asset.AssetService.GetCookie:input_type -> asset.GetCookieRequest
-	105, // 66: asset.AssetService.ListCookies:input_type -> asset.ListCookiesRequest
-	107, // 67: asset.AssetService.GetAvailableCookie:input_type -> asset.GetAvailableCookieRequest
-	109, // 68: asset.AssetService.ReportCookieUsage:input_type -> asset.ReportCookieUsageRequest
-	111, // 69: asset.AssetService.FreezeCookie:input_type -> asset.FreezeCookieRequest
-	1,   // 70: asset.AssetService.GetHistory:output_type -> asset.GetHistoryResponse
-	4,   // 71: asset.AssetService.DeleteHistory:output_type -> asset.DeleteHistoryResponse
-	6,   // 72: asset.AssetService.GetHistoryByTask:output_type -> asset.GetHistoryByTaskResponse
-	8,   // 73: asset.AssetService.CheckQuota:output_type -> asset.CheckQuotaResponse
-	10,  // 74: asset.AssetService.ConsumeQuota:output_type -> asset.ConsumeQuotaResponse
-	12,  // 75: asset.AssetService.RefundQuota:output_type -> asset.RefundQuotaResponse
-	14,  // 76: asset.AssetService.GetUserStats:output_type -> asset.GetUserStatsResponse
-	18,  // 77: asset.AssetService.GetPlatformStats:output_type -> asset.GetPlatformStatsResponse
-	21,  // 78: asset.AssetService.GetRequestTrend:output_type -> asset.GetRequestTrendResponse
-	23,  // 79: asset.AssetService.GetFileInfo:output_type -> asset.GetFileInfoResponse
-	25,  // 80: asset.AssetService.CreateHistory:output_type -> asset.CreateHistoryResponse
-	27,  // 81: asset.AssetService.UpdateHistoryStatus:output_type -> asset.UpdateHistoryStatusResponse
-	30,  // 82: asset.AssetService.GetBillingAccount:output_type -> asset.GetBillingAccountResponse
-	33,  // 83: asset.AssetService.ListBillingStatements:output_type -> asset.ListBillingStatementsResponse
-	36,  // 84: asset.AssetService.EstimateDownloadBilling:output_type -> asset.EstimateDownloadBillingResponse
-	38,  // 85: asset.AssetService.HoldInitialDownload:output_type -> asset.HoldInitialDownloadResponse
-	40,  // 86: asset.AssetService.CaptureIngressUsage:output_type -> asset.CaptureIngressUsageResponse
-	42,  // 87: asset.AssetService.ReleaseInitialDownload:output_type -> asset.ReleaseInitialDownloadResponse
-	44,  // 88: asset.AssetService.PrepareFileTransferBilling:output_type -> asset.PrepareFileTransferBillingResponse
-	46,  // 89: asset.AssetService.CompleteFileTransferBilling:output_type -> asset.CompleteFileTransferBillingResponse
-	48,  // 90: asset.AssetService.AbortFileTransferBilling:output_type -> asset.AbortFileTransferBillingResponse
-	50,  // 91: asset.AssetService.ListBillingAccounts:output_type -> asset.ListBillingAccountsResponse
-	52,  // 92: asset.AssetService.GetBillingAccountDetail:output_type -> asset.GetBillingAccountDetailResponse
-	54,  // 93: asset.AssetService.AdjustBillingBalance:output_type -> asset.AdjustBillingBalanceResponse
-	57,  // 94: asset.AssetService.ListBillingLedger:output_type -> asset.ListBillingLedgerResponse
-	60,  // 95: asset.AssetService.ListTrafficUsageRecords:output_type -> asset.ListTrafficUsageRecordsResponse
-	63,  // 96: asset.AssetService.GetBillingPricing:output_type -> asset.GetBillingPricingResponse
-	65,  // 97: asset.AssetService.UpdateBillingPricing:output_type -> asset.UpdateBillingPricingResponse
-	68,  // 98: asset.AssetService.ListBillingShortfalls:output_type -> asset.ListBillingShortfallsResponse
-	70,  // 99: asset.AssetService.ReconcileBillingShortfall:output_type -> asset.ReconcileBillingShortfallResponse
-	72,  // 100: asset.AssetService.AcquireProxyForTask:output_type -> asset.AcquireProxyForTaskResponse
-	74,  // 101: asset.AssetService.GetAvailableProxy:output_type -> asset.GetAvailableProxyResponse
-	76,  // 102: asset.AssetService.CheckProxySourceStatus:output_type -> asset.CheckProxySourceStatusResponse
-	78,  // 103: asset.AssetService.ReportProxyUsage:output_type -> asset.ReportProxyUsageResponse
-	80,  // 104: asset.AssetService.ReleaseProxyForTask:output_type -> asset.ReleaseProxyForTaskResponse
-	82,  // 105: asset.AssetService.GetProxySourcePolicy:output_type -> asset.GetProxySourcePolicyResponse
-	84,  // 106: asset.AssetService.UpdateProxySourcePolicy:output_type -> asset.UpdateProxySourcePolicyResponse
-	87,  // 107: asset.AssetService.ListProxies:output_type -> asset.ListProxiesResponse
-	89,  // 108: asset.AssetService.CreateProxy:output_type -> asset.CreateProxyResponse
-	91,  // 109: asset.AssetService.UpdateProxy:output_type -> asset.UpdateProxyResponse
-	93,  // 110: asset.AssetService.UpdateProxyStatus:output_type -> asset.UpdateProxyStatusResponse
-	95,  // 111: asset.AssetService.DeleteProxy:output_type -> asset.DeleteProxyResponse
-	98,  // 112: asset.AssetService.CreateCookie:output_type -> asset.CreateCookieResponse
-	100, // 113: asset.AssetService.UpdateCookie:output_type -> asset.UpdateCookieResponse
-	102, // 114: asset.AssetService.DeleteCookie:output_type -> asset.DeleteCookieResponse
-	104, // 115: asset.AssetService.GetCookie:output_type -> asset.GetCookieResponse
-	106, // 116: asset.AssetService.ListCookies:output_type -> asset.ListCookiesResponse
-	108, // 117: asset.AssetService.GetAvailableCookie:output_type -> asset.GetAvailableCookieResponse
-	110, // 118: asset.AssetService.ReportCookieUsage:output_type -> asset.ReportCookieUsageResponse
-	112, // 119: asset.AssetService.FreezeCookie:output_type -> asset.FreezeCookieResponse
-	70,  // [70:120] is the sub-list for method output_type
-	20,  // [20:70] is the sub-list for method input_type
-	20,  // [20:20] is the sub-list for extension type_name
-	20,  // [20:20] is the sub-list for extension extendee
-	0,   // [0:20] is the sub-list for field type_name
+	24,  // 4: asset.GetFileInfoResponse.attachments:type_name -> asset.AttachedFile
+	29,  // 5: asset.GetBillingAccountResponse.account:type_name -> asset.BillingAccountSnapshot
+	32,  // 6: asset.ListBillingStatementsResponse.items:type_name -> asset.BillingStatementItem
+	35,  // 7: asset.EstimateDownloadBillingRequest.selected_format:type_name -> asset.BillingSelectedFormat
+	29,  // 8: asset.ListBillingAccountsResponse.items:type_name -> asset.BillingAccountSnapshot
+	29,  // 9: asset.GetBillingAccountDetailResponse.account:type_name -> asset.BillingAccountSnapshot
+	29,  // 10: asset.AdjustBillingBalanceResponse.account:type_name -> asset.BillingAccountSnapshot
+	56,  // 11: asset.ListBillingLedgerResponse.items:type_name -> asset.LedgerEntryItem
+	59,  // 12: asset.ListTrafficUsageRecordsResponse.items:type_name -> asset.TrafficUsageRecordItem
+	62,  // 13: asset.GetBillingPricingResponse.pricing:type_name -> asset.BillingPricing
+	62,  // 14: asset.UpdateBillingPricingResponse.pricing:type_name -> asset.BillingPricing
+	67,  // 15: asset.ListBillingShortfallsResponse.items:type_name -> asset.BillingShortfallOrderItem
+	67,  // 16: asset.ReconcileBillingShortfallResponse.order:type_name -> asset.BillingShortfallOrderItem
+	29,  // 17: asset.ReconcileBillingShortfallResponse.account:type_name -> asset.BillingAccountSnapshot
+	86,  // 18: asset.ListProxiesResponse.items:type_name -> asset.ProxyInfo
+	97,  // 19: asset.GetCookieResponse.cookie:type_name -> asset.CookieInfo
+	97,  // 20: asset.ListCookiesResponse.items:type_name -> asset.CookieInfo
+	0,   // 21: asset.AssetService.GetHistory:input_type -> asset.GetHistoryRequest
+	3,   // 22: asset.AssetService.DeleteHistory:input_type -> asset.DeleteHistoryRequest
+	5,   // 23: asset.AssetService.GetHistoryByTask:input_type -> asset.GetHistoryByTaskRequest
+	7,   // 24: asset.AssetService.CheckQuota:input_type -> asset.CheckQuotaRequest
+	9,   // 25: asset.AssetService.ConsumeQuota:input_type -> asset.ConsumeQuotaRequest
+	11,  // 26: asset.AssetService.RefundQuota:input_type -> asset.RefundQuotaRequest
+	13,  // 27: asset.AssetService.GetUserStats:input_type -> asset.GetUserStatsRequest
+	17,  // 28: asset.AssetService.GetPlatformStats:input_type -> asset.GetPlatformStatsRequest
+	19,  // 29: asset.AssetService.GetRequestTrend:input_type -> asset.GetRequestTrendRequest
+	22,  // 30: asset.AssetService.GetFileInfo:input_type -> asset.GetFileInfoRequest
+	25,  // 31: asset.AssetService.CreateHistory:input_type -> asset.CreateHistoryRequest
+	27,  // 32: asset.AssetService.UpdateHistoryStatus:input_type -> asset.UpdateHistoryStatusRequest
+	30,  // 33: asset.AssetService.GetBillingAccount:input_type -> asset.GetBillingAccountRequest
+	33,  // 34: asset.AssetService.ListBillingStatements:input_type -> asset.ListBillingStatementsRequest
+	36,  // 35: asset.AssetService.EstimateDownloadBilling:input_type -> asset.EstimateDownloadBillingRequest
+	38,  // 36: asset.AssetService.HoldInitialDownload:input_type -> asset.HoldInitialDownloadRequest
+	40,  // 37: asset.AssetService.CaptureIngressUsage:input_type -> asset.CaptureIngressUsageRequest
+	42,  // 38: asset.AssetService.ReleaseInitialDownload:input_type -> asset.ReleaseInitialDownloadRequest
+	44,  // 39: asset.AssetService.PrepareFileTransferBilling:input_type -> asset.PrepareFileTransferBillingRequest
+	46,  // 40: asset.AssetService.CompleteFileTransferBilling:input_type -> asset.CompleteFileTransferBillingRequest
+	48,  // 41: asset.AssetService.AbortFileTransferBilling:input_type -> asset.AbortFileTransferBillingRequest
+	50,  // 42: asset.AssetService.ListBillingAccounts:input_type -> asset.ListBillingAccountsRequest
+	52,  // 43: asset.AssetService.GetBillingAccountDetail:input_type -> asset.GetBillingAccountDetailRequest
+	54,  // 44: asset.AssetService.AdjustBillingBalance:input_type -> asset.AdjustBillingBalanceRequest
+	57,  // 45: asset.AssetService.ListBillingLedger:input_type -> asset.ListBillingLedgerRequest
+	60,  // 46: asset.AssetService.ListTrafficUsageRecords:input_type -> asset.ListTrafficUsageRecordsRequest
+	63,  // 47: asset.AssetService.GetBillingPricing:input_type -> asset.GetBillingPricingRequest
+	65,  // 48: asset.AssetService.UpdateBillingPricing:input_type -> asset.UpdateBillingPricingRequest
+	68,  // 49: asset.AssetService.ListBillingShortfalls:input_type -> asset.ListBillingShortfallsRequest
+	70,  // 50: asset.AssetService.ReconcileBillingShortfall:input_type -> asset.ReconcileBillingShortfallRequest
+	72,  // 51: asset.AssetService.AcquireProxyForTask:input_type -> asset.AcquireProxyForTaskRequest
+	74,  // 52: asset.AssetService.GetAvailableProxy:input_type -> asset.GetAvailableProxyRequest
+	76,  // 53: asset.AssetService.CheckProxySourceStatus:input_type -> asset.CheckProxySourceStatusRequest
+	78,  // 54: asset.AssetService.ReportProxyUsage:input_type -> asset.ReportProxyUsageRequest
+	80,  // 55: asset.AssetService.ReleaseProxyForTask:input_type -> asset.ReleaseProxyForTaskRequest
+	82,  // 56: asset.AssetService.GetProxySourcePolicy:input_type -> asset.GetProxySourcePolicyRequest
+	84,  // 57: asset.AssetService.UpdateProxySourcePolicy:input_type -> asset.UpdateProxySourcePolicyRequest
+	87,  // 58: asset.AssetService.ListProxies:input_type -> asset.ListProxiesRequest
+	89,  // 59: asset.AssetService.CreateProxy:input_type -> asset.CreateProxyRequest
+	91,  // 60: asset.AssetService.UpdateProxy:input_type -> asset.UpdateProxyRequest
+	93,  // 61: asset.AssetService.UpdateProxyStatus:input_type -> asset.UpdateProxyStatusRequest
+	95,  // 62: asset.AssetService.DeleteProxy:input_type -> asset.DeleteProxyRequest
+	98,  // 63: asset.AssetService.CreateCookie:input_type -> asset.CreateCookieRequest
+	100, // 64: asset.AssetService.UpdateCookie:input_type -> asset.UpdateCookieRequest
+	102, // 65: asset.AssetService.DeleteCookie:input_type -> asset.DeleteCookieRequest
+	104, // 66: asset.AssetService.GetCookie:input_type -> asset.GetCookieRequest
+	106, // 67: asset.AssetService.ListCookies:input_type -> asset.ListCookiesRequest
+	108, // 68: asset.AssetService.GetAvailableCookie:input_type -> asset.GetAvailableCookieRequest
+	110, // 69: asset.AssetService.ReportCookieUsage:input_type -> asset.ReportCookieUsageRequest
+	112, // 70: asset.AssetService.FreezeCookie:input_type -> asset.FreezeCookieRequest
+	114, // 71: asset.AssetService.EmitWebhookEvent:input_type -> asset.EmitWebhookEventRequest
+	1,   // 72: asset.AssetService.GetHistory:output_type -> asset.GetHistoryResponse
+	4,   // 73: asset.AssetService.DeleteHistory:output_type -> asset.DeleteHistoryResponse
+	6,   // 74: asset.AssetService.GetHistoryByTask:output_type -> asset.GetHistoryByTaskResponse
+	8,   // 75: asset.AssetService.CheckQuota:output_type -> asset.CheckQuotaResponse
+	10,  // 76: asset.AssetService.ConsumeQuota:output_type -> asset.ConsumeQuotaResponse
+	12,  // 77: asset.AssetService.RefundQuota:output_type -> asset.RefundQuotaResponse
+	14,  // 78: asset.AssetService.GetUserStats:output_type -> asset.GetUserStatsResponse
+	18,  // 79: asset.AssetService.GetPlatformStats:output_type -> asset.GetPlatformStatsResponse
+	21,  // 80: asset.AssetService.GetRequestTrend:output_type -> asset.GetRequestTrendResponse
+	23,  // 81: asset.AssetService.GetFileInfo:output_type -> asset.GetFileInfoResponse
+	26,  // 82: asset.AssetService.CreateHistory:output_type -> asset.CreateHistoryResponse
+	28,  // 83: asset.AssetService.UpdateHistoryStatus:output_type -> asset.UpdateHistoryStatusResponse
+	31,  // 84: asset.AssetService.GetBillingAccount:output_type -> asset.GetBillingAccountResponse
+	34,  // 85: asset.AssetService.ListBillingStatements:output_type -> asset.ListBillingStatementsResponse
+	37,  // 86: asset.AssetService.EstimateDownloadBilling:output_type -> asset.EstimateDownloadBillingResponse
+	39,  // 87: asset.AssetService.HoldInitialDownload:output_type -> asset.HoldInitialDownloadResponse
+	41,  // 88: asset.AssetService.CaptureIngressUsage:output_type -> asset.CaptureIngressUsageResponse
+	43,  // 89: asset.AssetService.ReleaseInitialDownload:output_type -> asset.ReleaseInitialDownloadResponse
+	45,  // 90: asset.AssetService.PrepareFileTransferBilling:output_type -> asset.PrepareFileTransferBillingResponse
+	47,  // 91: asset.AssetService.CompleteFileTransferBilling:output_type -> asset.CompleteFileTransferBillingResponse
+	49,  // 92: asset.AssetService.AbortFileTransferBilling:output_type -> asset.AbortFileTransferBillingResponse
+	51,  // 93: asset.AssetService.ListBillingAccounts:output_type -> asset.ListBillingAccountsResponse
+	53,  // 94: asset.AssetService.GetBillingAccountDetail:output_type -> asset.GetBillingAccountDetailResponse
+	55,  // 95: asset.AssetService.AdjustBillingBalance:output_type -> asset.AdjustBillingBalanceResponse
+	58,  // 96: asset.AssetService.ListBillingLedger:output_type -> asset.ListBillingLedgerResponse
+	61,  // 97: asset.AssetService.ListTrafficUsageRecords:output_type -> asset.ListTrafficUsageRecordsResponse
+	64,  // 98: asset.AssetService.GetBillingPricing:output_type -> asset.GetBillingPricingResponse
+	66,  // 99: asset.AssetService.UpdateBillingPricing:output_type -> asset.UpdateBillingPricingResponse
+	69,  // 100: asset.AssetService.ListBillingShortfalls:output_type -> asset.ListBillingShortfallsResponse
+	71,  // 101: asset.AssetService.ReconcileBillingShortfall:output_type -> asset.ReconcileBillingShortfallResponse
+	73,  // 102: asset.AssetService.AcquireProxyForTask:output_type -> asset.AcquireProxyForTaskResponse
+	75,  // 103: asset.AssetService.GetAvailableProxy:output_type -> asset.GetAvailableProxyResponse
+	77,  // 104: asset.AssetService.CheckProxySourceStatus:output_type -> asset.CheckProxySourceStatusResponse
+	79,  // 105: asset.AssetService.ReportProxyUsage:output_type -> asset.ReportProxyUsageResponse
+	81,  // 106: asset.AssetService.ReleaseProxyForTask:output_type -> asset.ReleaseProxyForTaskResponse
+	83,  // 107: asset.AssetService.GetProxySourcePolicy:output_type -> asset.GetProxySourcePolicyResponse
+	85,  // 108: asset.AssetService.UpdateProxySourcePolicy:output_type -> asset.UpdateProxySourcePolicyResponse
+	88,  // 109: asset.AssetService.ListProxies:output_type -> asset.ListProxiesResponse
+	90,  // 110: asset.AssetService.CreateProxy:output_type -> asset.CreateProxyResponse
+	92,  // 111: asset.AssetService.UpdateProxy:output_type -> asset.UpdateProxyResponse
+	94,  // 112: asset.AssetService.UpdateProxyStatus:output_type -> asset.UpdateProxyStatusResponse
+	96,  // 113: asset.AssetService.DeleteProxy:output_type -> asset.DeleteProxyResponse
+	99,  // 114: asset.AssetService.CreateCookie:output_type -> asset.CreateCookieResponse
+	101, // 115: asset.AssetService.UpdateCookie:output_type -> asset.UpdateCookieResponse
+	103, // 116: asset.AssetService.DeleteCookie:output_type -> asset.DeleteCookieResponse
+	105, // 117: asset.AssetService.GetCookie:output_type -> asset.GetCookieResponse
+	107, // 118: asset.AssetService.ListCookies:output_type -> asset.ListCookiesResponse
+	109, // 119: asset.AssetService.GetAvailableCookie:output_type -> asset.GetAvailableCookieResponse
+	111, // 120: asset.AssetService.ReportCookieUsage:output_type -> asset.ReportCookieUsageResponse
+	113, // 121: asset.AssetService.FreezeCookie:output_type -> asset.FreezeCookieResponse
+	115, // 122: asset.AssetService.EmitWebhookEvent:output_type -> asset.EmitWebhookEventResponse
+	72,  // [72:123] is the sub-list for method output_type
+	21,  // [21:72] is the sub-list for method input_type
+	21,  // [21:21] is the sub-list for extension type_name
+	21,  // [21:21] is the sub-list for extension extendee
+	0,   // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_proto_asset_proto_init() }
@@ -8879,7 +9192,7 @@ func file_proto_asset_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_asset_proto_rawDesc), len(file_proto_asset_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   113,
+			NumMessages:   116,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -69,6 +69,7 @@ const (
 	AssetService_GetAvailableCookie_FullMethodName          = "/asset.AssetService/GetAvailableCookie"
 	AssetService_ReportCookieUsage_FullMethodName           = "/asset.AssetService/ReportCookieUsage"
 	AssetService_FreezeCookie_FullMethodName                = "/asset.AssetService/FreezeCookie"
+	AssetService_EmitWebhookEvent_FullMethodName            = "/asset.AssetService/EmitWebhookEvent"
 )
 
 // AssetServiceClient is the client API for AssetService service.
@@ -178,6 +179,9 @@ type AssetServiceClient interface {
 	ReportCookieUsage(ctx context.Context, in *ReportCookieUsageRequest, opts ...grpc.CallOption) (*ReportCookieUsageResponse, error)
 	// 手动冷冻 Cookie
 	FreezeCookie(ctx context.Context, in *FreezeCookieRequest, opts ...grpc.CallOption) (*FreezeCookieResponse, error)
+	// ========== Webhook ==========
+	// 发布业务事件（供其他服务调用）
+	EmitWebhookEvent(ctx context.Context, in *EmitWebhookEventRequest, opts ...grpc.CallOption) (*EmitWebhookEventResponse, error)
 }
 
 type assetServiceClient struct {
@@ -688,6 +692,16 @@ func (c *assetServiceClient) FreezeCookie(ctx context.Context, in *FreezeCookieR
 	return out, nil
 }
 
+func (c *assetServiceClient) EmitWebhookEvent(ctx context.Context, in *EmitWebhookEventRequest, opts ...grpc.CallOption) (*EmitWebhookEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmitWebhookEventResponse)
+	err := c.cc.Invoke(ctx, AssetService_EmitWebhookEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AssetServiceServer is the server API for AssetService service.
 // All implementations must embed UnimplementedAssetServiceServer
 // for forward compatibility.
@@ -795,6 +809,9 @@ type AssetServiceServer interface {
 	ReportCookieUsage(context.Context, *ReportCookieUsageRequest) (*ReportCookieUsageResponse, error)
 	// 手动冷冻 Cookie
 	FreezeCookie(context.Context, *FreezeCookieRequest) (*FreezeCookieResponse, error)
+	// ========== Webhook ==========
+	// 发布业务事件（供其他服务调用）
+	EmitWebhookEvent(context.Context, *EmitWebhookEventRequest) (*EmitWebhookEventResponse, error)
 	mustEmbedUnimplementedAssetServiceServer()
 }
 
@@ -955,6 +972,9 @@ func (UnimplementedAssetServiceServer) ReportCookieUsage(context.Context, *Repor
 func (UnimplementedAssetServiceServer) FreezeCookie(context.Context, *FreezeCookieRequest) (*FreezeCookieResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method FreezeCookie not implemented")
 }
+func (UnimplementedAssetServiceServer) EmitWebhookEvent(context.Context, *EmitWebhookEventRequest) (*EmitWebhookEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EmitWebhookEvent not implemented")
+}
 func (UnimplementedAssetServiceServer) mustEmbedUnimplementedAssetServiceServer() {}
 func (UnimplementedAssetServiceServer) testEmbeddedByValue()                      {}
 
@@ -1876,6 +1896,24 @@ func _AssetService_FreezeCookie_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssetService_EmitWebhookEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmitWebhookEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetServiceServer).EmitWebhookEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetService_EmitWebhookEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetServiceServer).EmitWebhookEvent(ctx, req.(*EmitWebhookEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AssetService_ServiceDesc is the grpc.ServiceDesc for AssetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2083,6 +2121,10 @@ var AssetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FreezeCookie",
 			Handler:    _AssetService_FreezeCookie_Handler,
 		},
+		{
+			MethodName: "EmitWebhookEvent",
+			Handler:    _AssetService_EmitWebhookEvent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/asset.proto",
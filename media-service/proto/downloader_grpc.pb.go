@@ -22,6 +22,14 @@ const (
 	DownloaderService_GetTaskStatus_FullMethodName      = "/downloader.DownloaderService/GetTaskStatus"
 	DownloaderService_GetDownloadHistory_FullMethodName = "/downloader.DownloaderService/GetDownloadHistory"
 	DownloaderService_CancelTask_FullMethodName         = "/downloader.DownloaderService/CancelTask"
+	DownloaderService_ListTasks_FullMethodName          = "/downloader.DownloaderService/ListTasks"
+	DownloaderService_ReplayTask_FullMethodName         = "/downloader.DownloaderService/ReplayTask"
+	DownloaderService_ForceFailTask_FullMethodName      = "/downloader.DownloaderService/ForceFailTask"
+	DownloaderService_PurgeTask_FullMethodName          = "/downloader.DownloaderService/PurgeTask"
+	DownloaderService_GetWorkerPools_FullMethodName     = "/downloader.DownloaderService/GetWorkerPools"
+	DownloaderService_UpdateWorkerPool_FullMethodName   = "/downloader.DownloaderService/UpdateWorkerPool"
+	DownloaderService_ListPlatforms_FullMethodName      = "/downloader.DownloaderService/ListPlatforms"
+	DownloaderService_UpdatePlatform_FullMethodName     = "/downloader.DownloaderService/UpdatePlatform"
 )
 
 // DownloaderServiceClient is the client API for DownloaderService service.
@@ -34,6 +42,22 @@ type DownloaderServiceClient interface {
 	GetDownloadHistory(ctx context.Context, in *GetDownloadHistoryRequest, opts ...grpc.CallOption) (*GetDownloadHistoryResponse, error)
 	// 取消下载任务
 	CancelTask(ctx context.Context, in *CancelTaskRequest, opts ...grpc.CallOption) (*CancelTaskResponse, error)
+	// 管理端: 跨用户查询失败、死信与长时间运行的任务
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	// 管理端: 使用新的代理租约重新投递任务
+	ReplayTask(ctx context.Context, in *ReplayTaskRequest, opts ...grpc.CallOption) (*TaskActionResponse, error)
+	// 管理端: 强制将任务标记为失败并释放计费预占
+	ForceFailTask(ctx context.Context, in *ForceFailTaskRequest, opts ...grpc.CallOption) (*TaskActionResponse, error)
+	// 管理端: 删除失败任务记录
+	PurgeTask(ctx context.Context, in *PurgeTaskRequest, opts ...grpc.CallOption) (*TaskActionResponse, error)
+	// 管理端: 查询所有 media-service 实例的 Worker 池状态
+	GetWorkerPools(ctx context.Context, in *GetWorkerPoolsRequest, opts ...grpc.CallOption) (*GetWorkerPoolsResponse, error)
+	// 管理端: 运行时调整 Worker 并发，或暂停、恢复消费
+	UpdateWorkerPool(ctx context.Context, in *UpdateWorkerPoolRequest, opts ...grpc.CallOption) (*TaskActionResponse, error)
+	// 管理端: 查询平台注册表及各平台启用状态
+	ListPlatforms(ctx context.Context, in *ListPlatformsRequest, opts ...grpc.CallOption) (*ListPlatformsResponse, error)
+	// 管理端: 启用或禁用平台，所有实例定期同步
+	UpdatePlatform(ctx context.Context, in *UpdatePlatformRequest, opts ...grpc.CallOption) (*UpdatePlatformResponse, error)
 }
 
 type downloaderServiceClient struct {
@@ -74,6 +98,86 @@ func (c *downloaderServiceClient) CancelTask(ctx context.Context, in *CancelTask
 	return out, nil
 }
 
+func (c *downloaderServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTasksResponse)
+	err := c.cc.Invoke(ctx, DownloaderService_ListTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloaderServiceClient) ReplayTask(ctx context.Context, in *ReplayTaskRequest, opts ...grpc.CallOption) (*TaskActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskActionResponse)
+	err := c.cc.Invoke(ctx, DownloaderService_ReplayTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloaderServiceClient) ForceFailTask(ctx context.Context, in *ForceFailTaskRequest, opts ...grpc.CallOption) (*TaskActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskActionResponse)
+	err := c.cc.Invoke(ctx, DownloaderService_ForceFailTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloaderServiceClient) PurgeTask(ctx context.Context, in *PurgeTaskRequest, opts ...grpc.CallOption) (*TaskActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskActionResponse)
+	err := c.cc.Invoke(ctx, DownloaderService_PurgeTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloaderServiceClient) GetWorkerPools(ctx context.Context, in *GetWorkerPoolsRequest, opts ...grpc.CallOption) (*GetWorkerPoolsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkerPoolsResponse)
+	err := c.cc.Invoke(ctx, DownloaderService_GetWorkerPools_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloaderServiceClient) UpdateWorkerPool(ctx context.Context, in *UpdateWorkerPoolRequest, opts ...grpc.CallOption) (*TaskActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskActionResponse)
+	err := c.cc.Invoke(ctx, DownloaderService_UpdateWorkerPool_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloaderServiceClient) ListPlatforms(ctx context.Context, in *ListPlatformsRequest, opts ...grpc.CallOption) (*ListPlatformsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlatformsResponse)
+	err := c.cc.Invoke(ctx, DownloaderService_ListPlatforms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downloaderServiceClient) UpdatePlatform(ctx context.Context, in *UpdatePlatformRequest, opts ...grpc.CallOption) (*UpdatePlatformResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePlatformResponse)
+	err := c.cc.Invoke(ctx, DownloaderService_UpdatePlatform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DownloaderServiceServer is the server API for DownloaderService service.
 // All implementations must embed UnimplementedDownloaderServiceServer
 // for forward compatibility.
@@ -84,6 +188,22 @@ type DownloaderServiceServer interface {
 	GetDownloadHistory(context.Context, *GetDownloadHistoryRequest) (*GetDownloadHistoryResponse, error)
 	// 取消下载任务
 	CancelTask(context.Context, *CancelTaskRequest) (*CancelTaskResponse, error)
+	// 管理端: 跨用户查询失败、死信与长时间运行的任务
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// 管理端: 使用新的代理租约重新投递任务
+	ReplayTask(context.Context, *ReplayTaskRequest) (*TaskActionResponse, error)
+	// 管理端: 强制将任务标记为失败并释放计费预占
+	ForceFailTask(context.Context, *ForceFailTaskRequest) (*TaskActionResponse, error)
+	// 管理端: 删除失败任务记录
+	PurgeTask(context.Context, *PurgeTaskRequest) (*TaskActionResponse, error)
+	// 管理端: 查询所有 media-service 实例的 Worker 池状态
+	GetWorkerPools(context.Context, *GetWorkerPoolsRequest) (*GetWorkerPoolsResponse, error)
+	// 管理端: 运行时调整 Worker 并发，或暂停、恢复消费
+	UpdateWorkerPool(context.Context, *UpdateWorkerPoolRequest) (*TaskActionResponse, error)
+	// 管理端: 查询平台注册表及各平台启用状态
+	ListPlatforms(context.Context, *ListPlatformsRequest) (*ListPlatformsResponse, error)
+	// 管理端: 启用或禁用平台，所有实例定期同步
+	UpdatePlatform(context.Context, *UpdatePlatformRequest) (*UpdatePlatformResponse, error)
 	mustEmbedUnimplementedDownloaderServiceServer()
 }
 
@@ -103,6 +223,30 @@ func (UnimplementedDownloaderServiceServer) GetDownloadHistory(context.Context,
 func (UnimplementedDownloaderServiceServer) CancelTask(context.Context, *CancelTaskRequest) (*CancelTaskResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelTask not implemented")
 }
+func (UnimplementedDownloaderServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedDownloaderServiceServer) ReplayTask(context.Context, *ReplayTaskRequest) (*TaskActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplayTask not implemented")
+}
+func (UnimplementedDownloaderServiceServer) ForceFailTask(context.Context, *ForceFailTaskRequest) (*TaskActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ForceFailTask not implemented")
+}
+func (UnimplementedDownloaderServiceServer) PurgeTask(context.Context, *PurgeTaskRequest) (*TaskActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeTask not implemented")
+}
+func (UnimplementedDownloaderServiceServer) GetWorkerPools(context.Context, *GetWorkerPoolsRequest) (*GetWorkerPoolsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWorkerPools not implemented")
+}
+func (UnimplementedDownloaderServiceServer) UpdateWorkerPool(context.Context, *UpdateWorkerPoolRequest) (*TaskActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateWorkerPool not implemented")
+}
+func (UnimplementedDownloaderServiceServer) ListPlatforms(context.Context, *ListPlatformsRequest) (*ListPlatformsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPlatforms not implemented")
+}
+func (UnimplementedDownloaderServiceServer) UpdatePlatform(context.Context, *UpdatePlatformRequest) (*UpdatePlatformResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePlatform not implemented")
+}
 func (UnimplementedDownloaderServiceServer) mustEmbedUnimplementedDownloaderServiceServer() {}
 func (UnimplementedDownloaderServiceServer) testEmbeddedByValue()                           {}
 
@@ -178,6 +322,150 @@ func _DownloaderService_CancelTask_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DownloaderService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloaderServiceServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloaderService_ListTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloaderServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloaderService_ReplayTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloaderServiceServer).ReplayTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloaderService_ReplayTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloaderServiceServer).ReplayTask(ctx, req.(*ReplayTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloaderService_ForceFailTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceFailTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloaderServiceServer).ForceFailTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloaderService_ForceFailTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloaderServiceServer).ForceFailTask(ctx, req.(*ForceFailTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloaderService_PurgeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloaderServiceServer).PurgeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloaderService_PurgeTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloaderServiceServer).PurgeTask(ctx, req.(*PurgeTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloaderService_GetWorkerPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkerPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloaderServiceServer).GetWorkerPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloaderService_GetWorkerPools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloaderServiceServer).GetWorkerPools(ctx, req.(*GetWorkerPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloaderService_UpdateWorkerPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkerPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloaderServiceServer).UpdateWorkerPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloaderService_UpdateWorkerPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloaderServiceServer).UpdateWorkerPool(ctx, req.(*UpdateWorkerPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloaderService_ListPlatforms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlatformsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloaderServiceServer).ListPlatforms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloaderService_ListPlatforms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloaderServiceServer).ListPlatforms(ctx, req.(*ListPlatformsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownloaderService_UpdatePlatform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlatformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownloaderServiceServer).UpdatePlatform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DownloaderService_UpdatePlatform_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownloaderServiceServer).UpdatePlatform(ctx, req.(*UpdatePlatformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DownloaderService_ServiceDesc is the grpc.ServiceDesc for DownloaderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -197,6 +485,38 @@ var DownloaderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelTask",
 			Handler:    _DownloaderService_CancelTask_Handler,
 		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _DownloaderService_ListTasks_Handler,
+		},
+		{
+			MethodName: "ReplayTask",
+			Handler:    _DownloaderService_ReplayTask_Handler,
+		},
+		{
+			MethodName: "ForceFailTask",
+			Handler:    _DownloaderService_ForceFailTask_Handler,
+		},
+		{
+			MethodName: "PurgeTask",
+			Handler:    _DownloaderService_PurgeTask_Handler,
+		},
+		{
+			MethodName: "GetWorkerPools",
+			Handler:    _DownloaderService_GetWorkerPools_Handler,
+		},
+		{
+			MethodName: "UpdateWorkerPool",
+			Handler:    _DownloaderService_UpdateWorkerPool_Handler,
+		},
+		{
+			MethodName: "ListPlatforms",
+			Handler:    _DownloaderService_ListPlatforms_Handler,
+		},
+		{
+			MethodName: "UpdatePlatform",
+			Handler:    _DownloaderService_UpdatePlatform_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/downloader.proto",
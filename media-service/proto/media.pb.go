@@ -82,23 +82,32 @@ func (x *ParseURLRequest) GetTaskId() string {
 }
 
 type ParseURLResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
-	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Duration      int64                  `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
-	Thumbnail     string                 `protobuf:"bytes,6,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
-	Author        string                 `protobuf:"bytes,7,opt,name=author,proto3" json:"author,omitempty"`
-	UploadDate    string                 `protobuf:"bytes,8,opt,name=upload_date,json=uploadDate,proto3" json:"upload_date,omitempty"`
-	ViewCount     int64                  `protobuf:"varint,9,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
-	Formats       []*VideoFormat         `protobuf:"bytes,10,rep,name=formats,proto3" json:"formats,omitempty"`
-	CookieId      int64                  `protobuf:"varint,11,opt,name=cookie_id,json=cookieId,proto3" json:"cookie_id,omitempty"`
-	ProxyUrl      string                 `protobuf:"bytes,12,opt,name=proxy_url,json=proxyUrl,proto3" json:"proxy_url,omitempty"`
-	ProxyLeaseId  string                 `protobuf:"bytes,13,opt,name=proxy_lease_id,json=proxyLeaseId,proto3" json:"proxy_lease_id,omitempty"`
-	ProxyExpireAt string                 `protobuf:"bytes,14,opt,name=proxy_expire_at,json=proxyExpireAt,proto3" json:"proxy_expire_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VideoId        string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Platform       string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Duration       int64                  `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	Thumbnail      string                 `protobuf:"bytes,6,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	Author         string                 `protobuf:"bytes,7,opt,name=author,proto3" json:"author,omitempty"`
+	UploadDate     string                 `protobuf:"bytes,8,opt,name=upload_date,json=uploadDate,proto3" json:"upload_date,omitempty"`
+	ViewCount      int64                  `protobuf:"varint,9,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`
+	Formats        []*VideoFormat         `protobuf:"bytes,10,rep,name=formats,proto3" json:"formats,omitempty"`
+	CookieId       int64                  `protobuf:"varint,11,opt,name=cookie_id,json=cookieId,proto3" json:"cookie_id,omitempty"`
+	ProxyUrl       string                 `protobuf:"bytes,12,opt,name=proxy_url,json=proxyUrl,proto3" json:"proxy_url,omitempty"`
+	ProxyLeaseId   string                 `protobuf:"bytes,13,opt,name=proxy_lease_id,json=proxyLeaseId,proto3" json:"proxy_lease_id,omitempty"`
+	ProxyExpireAt  string                 `protobuf:"bytes,14,opt,name=proxy_expire_at,json=proxyExpireAt,proto3" json:"proxy_expire_at,omitempty"`
+	Subtitles      []*SubtitleTrack       `protobuf:"bytes,15,rep,name=subtitles,proto3" json:"subtitles,omitempty"`
+	Chapters       []*Chapter             `protobuf:"bytes,16,rep,name=chapters,proto3" json:"chapters,omitempty"`
+	AudioLanguages []string               `protobuf:"bytes,17,rep,name=audio_languages,json=audioLanguages,proto3" json:"audio_languages,omitempty"` // 格式中出现的音轨语言，多于一个时可按 audio_language 选择配音
+	LiveStatus     string                 `protobuf:"bytes,18,opt,name=live_status,json=liveStatus,proto3" json:"live_status,omitempty"`             // not_live、is_live、was_live、is_upcoming、post_live，未知时为空
+	AgeLimit       int32                  `protobuf:"varint,19,opt,name=age_limit,json=ageLimit,proto3" json:"age_limit,omitempty"`                  // 观看年龄下限，0 表示无限制
+	Tags           []string               `protobuf:"bytes,20,rep,name=tags,proto3" json:"tags,omitempty"`
+	Categories     []string               `protobuf:"bytes,21,rep,name=categories,proto3" json:"categories,omitempty"`
+	ChannelId      string                 `protobuf:"bytes,22,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ChannelUrl     string                 `protobuf:"bytes,23,opt,name=channel_url,json=channelUrl,proto3" json:"channel_url,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ParseURLResponse) Reset() {
@@ -229,6 +238,199 @@ func (x *ParseURLResponse) GetProxyExpireAt() string {
 	return ""
 }
 
+func (x *ParseURLResponse) GetSubtitles() []*SubtitleTrack {
+	if x != nil {
+		return x.Subtitles
+	}
+	return nil
+}
+
+func (x *ParseURLResponse) GetChapters() []*Chapter {
+	if x != nil {
+		return x.Chapters
+	}
+	return nil
+}
+
+func (x *ParseURLResponse) GetAudioLanguages() []string {
+	if x != nil {
+		return x.AudioLanguages
+	}
+	return nil
+}
+
+func (x *ParseURLResponse) GetLiveStatus() string {
+	if x != nil {
+		return x.LiveStatus
+	}
+	return ""
+}
+
+func (x *ParseURLResponse) GetAgeLimit() int32 {
+	if x != nil {
+		return x.AgeLimit
+	}
+	return 0
+}
+
+func (x *ParseURLResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ParseURLResponse) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ParseURLResponse) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ParseURLResponse) GetChannelUrl() string {
+	if x != nil {
+		return x.ChannelUrl
+	}
+	return ""
+}
+
+// 视频章节（秒）
+type Chapter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	StartTime     float64                `protobuf:"fixed64,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       float64                `protobuf:"fixed64,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Chapter) Reset() {
+	*x = Chapter{}
+	mi := &file_proto_media_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chapter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chapter) ProtoMessage() {}
+
+func (x *Chapter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_media_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chapter.ProtoReflect.Descriptor instead.
+func (*Chapter) Descriptor() ([]byte, []int) {
+	return file_proto_media_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Chapter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Chapter) GetStartTime() float64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *Chapter) GetEndTime() float64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+// 字幕轨道
+type SubtitleTrack struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Language      string                 `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`    // 语言代码，如 en、zh-Hans
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`            // 语言名称
+	Automatic     bool                   `protobuf:"varint,3,opt,name=automatic,proto3" json:"automatic,omitempty"` // 是否为自动生成字幕
+	Formats       []string               `protobuf:"bytes,4,rep,name=formats,proto3" json:"formats,omitempty"`      // 可用格式，如 vtt、srt
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubtitleTrack) Reset() {
+	*x = SubtitleTrack{}
+	mi := &file_proto_media_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubtitleTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubtitleTrack) ProtoMessage() {}
+
+func (x *SubtitleTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_media_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubtitleTrack.ProtoReflect.Descriptor instead.
+func (*SubtitleTrack) Descriptor() ([]byte, []int) {
+	return file_proto_media_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubtitleTrack) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SubtitleTrack) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubtitleTrack) GetAutomatic() bool {
+	if x != nil {
+		return x.Automatic
+	}
+	return false
+}
+
+func (x *SubtitleTrack) GetFormats() []string {
+	if x != nil {
+		return x.Formats
+	}
+	return nil
+}
+
 type VideoFormat struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FormatId      string                 `protobuf:"bytes,1,opt,name=format_id,json=formatId,proto3" json:"format_id,omitempty"`
@@ -243,13 +445,15 @@ type VideoFormat struct {
 	Vbr           float64                `protobuf:"fixed64,10,opt,name=vbr,proto3" json:"vbr,omitempty"`
 	Abr           float64                `protobuf:"fixed64,11,opt,name=abr,proto3" json:"abr,omitempty"`
 	Asr           int32                  `protobuf:"varint,12,opt,name=asr,proto3" json:"asr,omitempty"`
+	Language      string                 `protobuf:"bytes,13,opt,name=language,proto3" json:"language,omitempty"`                             // 音轨语言，未知时为空
+	DynamicRange  string                 `protobuf:"bytes,14,opt,name=dynamic_range,json=dynamicRange,proto3" json:"dynamic_range,omitempty"` // SDR、HDR10、HLG、DV 等，纯音频格式为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VideoFormat) Reset() {
 	*x = VideoFormat{}
-	mi := &file_proto_media_proto_msgTypes[2]
+	mi := &file_proto_media_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -261,7 +465,7 @@ func (x *VideoFormat) String() string {
 func (*VideoFormat) ProtoMessage() {}
 
 func (x *VideoFormat) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_media_proto_msgTypes[2]
+	mi := &file_proto_media_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -274,7 +478,7 @@ func (x *VideoFormat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VideoFormat.ProtoReflect.Descriptor instead.
 func (*VideoFormat) Descriptor() ([]byte, []int) {
-	return file_proto_media_proto_rawDescGZIP(), []int{2}
+	return file_proto_media_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *VideoFormat) GetFormatId() string {
@@ -361,6 +565,20 @@ func (x *VideoFormat) GetAsr() int32 {
 	return 0
 }
 
+func (x *VideoFormat) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *VideoFormat) GetDynamicRange() string {
+	if x != nil {
+		return x.DynamicRange
+	}
+	return ""
+}
+
 type ValidateURLRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
@@ -370,7 +588,7 @@ type ValidateURLRequest struct {
 
 func (x *ValidateURLRequest) Reset() {
 	*x = ValidateURLRequest{}
-	mi := &file_proto_media_proto_msgTypes[3]
+	mi := &file_proto_media_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -382,7 +600,7 @@ func (x *ValidateURLRequest) String() string {
 func (*ValidateURLRequest) ProtoMessage() {}
 
 func (x *ValidateURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_media_proto_msgTypes[3]
+	mi := &file_proto_media_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -395,7 +613,7 @@ func (x *ValidateURLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateURLRequest.ProtoReflect.Descriptor instead.
 func (*ValidateURLRequest) Descriptor() ([]byte, []int) {
-	return file_proto_media_proto_rawDescGZIP(), []int{3}
+	return file_proto_media_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ValidateURLRequest) GetUrl() string {
@@ -410,13 +628,15 @@ type ValidateURLResponse struct {
 	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
 	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
 	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	CanonicalUrl  string                 `protobuf:"bytes,4,opt,name=canonical_url,json=canonicalUrl,proto3" json:"canonical_url,omitempty"` // 规范地址：展开短链、去除追踪参数，同一视频的不同写法相同
+	VideoId       string                 `protobuf:"bytes,5,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`                // 平台内视频 ID，无法从 URL 提取时为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ValidateURLResponse) Reset() {
 	*x = ValidateURLResponse{}
-	mi := &file_proto_media_proto_msgTypes[4]
+	mi := &file_proto_media_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -428,7 +648,7 @@ func (x *ValidateURLResponse) String() string {
 func (*ValidateURLResponse) ProtoMessage() {}
 
 func (x *ValidateURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_media_proto_msgTypes[4]
+	mi := &file_proto_media_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,7 +661,7 @@ func (x *ValidateURLResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateURLResponse.ProtoReflect.Descriptor instead.
 func (*ValidateURLResponse) Descriptor() ([]byte, []int) {
-	return file_proto_media_proto_rawDescGZIP(), []int{4}
+	return file_proto_media_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ValidateURLResponse) GetValid() bool {
@@ -465,6 +685,409 @@ func (x *ValidateURLResponse) GetMessage() string {
 	return ""
 }
 
+func (x *ValidateURLResponse) GetCanonicalUrl() string {
+	if x != nil {
+		return x.CanonicalUrl
+	}
+	return ""
+}
+
+func (x *ValidateURLResponse) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type ParsePlaylistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParsePlaylistRequest) Reset() {
+	*x = ParsePlaylistRequest{}
+	mi := &file_proto_media_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParsePlaylistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParsePlaylistRequest) ProtoMessage() {}
+
+func (x *ParsePlaylistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_media_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParsePlaylistRequest.ProtoReflect.Descriptor instead.
+func (*ParsePlaylistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_media_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ParsePlaylistRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ParsePlaylistRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ParsePlaylistRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ParsePlaylistRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ParsePlaylistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlaylistId    string                 `protobuf:"bytes,1,opt,name=playlist_id,json=playlistId,proto3" json:"playlist_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Author        string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,5,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // 平台未返回总数时为 0
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	HasMore       bool                   `protobuf:"varint,8,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	Entries       []*PlaylistEntry       `protobuf:"bytes,9,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParsePlaylistResponse) Reset() {
+	*x = ParsePlaylistResponse{}
+	mi := &file_proto_media_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParsePlaylistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParsePlaylistResponse) ProtoMessage() {}
+
+func (x *ParsePlaylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_media_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParsePlaylistResponse.ProtoReflect.Descriptor instead.
+func (*ParsePlaylistResponse) Descriptor() ([]byte, []int) {
+	return file_proto_media_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ParsePlaylistResponse) GetPlaylistId() string {
+	if x != nil {
+		return x.PlaylistId
+	}
+	return ""
+}
+
+func (x *ParsePlaylistResponse) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *ParsePlaylistResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ParsePlaylistResponse) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ParsePlaylistResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ParsePlaylistResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ParsePlaylistResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ParsePlaylistResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ParsePlaylistResponse) GetEntries() []*PlaylistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type PlaylistEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Duration      int64                  `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	Thumbnail     string                 `protobuf:"bytes,6,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaylistEntry) Reset() {
+	*x = PlaylistEntry{}
+	mi := &file_proto_media_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaylistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaylistEntry) ProtoMessage() {}
+
+func (x *PlaylistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_media_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaylistEntry.ProtoReflect.Descriptor instead.
+func (*PlaylistEntry) Descriptor() ([]byte, []int) {
+	return file_proto_media_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PlaylistEntry) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PlaylistEntry) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *PlaylistEntry) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PlaylistEntry) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PlaylistEntry) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *PlaylistEntry) GetThumbnail() string {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return ""
+}
+
+type ParseURLsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Urls          []string               `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	SkipCache     bool                   `protobuf:"varint,2,opt,name=skip_cache,json=skipCache,proto3" json:"skip_cache,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseURLsRequest) Reset() {
+	*x = ParseURLsRequest{}
+	mi := &file_proto_media_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseURLsRequest) ProtoMessage() {}
+
+func (x *ParseURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_media_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseURLsRequest.ProtoReflect.Descriptor instead.
+func (*ParseURLsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_media_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ParseURLsRequest) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *ParseURLsRequest) GetSkipCache() bool {
+	if x != nil {
+		return x.SkipCache
+	}
+	return false
+}
+
+// 批量解析中一个去重后的条目，成功时 result 非空，失败时 error_code 非空
+type ParseURLsResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Indexes       []int32                `protobuf:"varint,1,rep,packed,name=indexes,proto3" json:"indexes,omitempty"` // 请求 urls 中解析到同一视频的下标（从 0 开始）
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                 // 首次出现的原始地址
+	Result        *ParseURLResponse      `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	ErrorCode     string                 `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"` // 如 invalid_url、video_private、rate_limited
+	ErrorMessage  string                 `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseURLsResult) Reset() {
+	*x = ParseURLsResult{}
+	mi := &file_proto_media_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseURLsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseURLsResult) ProtoMessage() {}
+
+func (x *ParseURLsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_media_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseURLsResult.ProtoReflect.Descriptor instead.
+func (*ParseURLsResult) Descriptor() ([]byte, []int) {
+	return file_proto_media_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ParseURLsResult) GetIndexes() []int32 {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+func (x *ParseURLsResult) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ParseURLsResult) GetResult() *ParseURLResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ParseURLsResult) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *ParseURLsResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
 var File_proto_media_proto protoreflect.FileDescriptor
 
 const file_proto_media_proto_rawDesc = "" +
@@ -474,7 +1097,7 @@ const file_proto_media_proto_rawDesc = "" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1d\n" +
 	"\n" +
 	"skip_cache\x18\x02 \x01(\bR\tskipCache\x12\x17\n" +
-	"\atask_id\x18\x03 \x01(\tR\x06taskId\"\xc9\x03\n" +
+	"\atask_id\x18\x03 \x01(\tR\x06taskId\"\x84\x06\n" +
 	"\x10ParseURLResponse\x12\x19\n" +
 	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x1a\n" +
 	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x14\n" +
@@ -492,7 +1115,31 @@ const file_proto_media_proto_rawDesc = "" +
 	"\tcookie_id\x18\v \x01(\x03R\bcookieId\x12\x1b\n" +
 	"\tproxy_url\x18\f \x01(\tR\bproxyUrl\x12$\n" +
 	"\x0eproxy_lease_id\x18\r \x01(\tR\fproxyLeaseId\x12&\n" +
-	"\x0fproxy_expire_at\x18\x0e \x01(\tR\rproxyExpireAt\"\xb6\x02\n" +
+	"\x0fproxy_expire_at\x18\x0e \x01(\tR\rproxyExpireAt\x122\n" +
+	"\tsubtitles\x18\x0f \x03(\v2\x14.media.SubtitleTrackR\tsubtitles\x12*\n" +
+	"\bchapters\x18\x10 \x03(\v2\x0e.media.ChapterR\bchapters\x12'\n" +
+	"\x0faudio_languages\x18\x11 \x03(\tR\x0eaudioLanguages\x12\x1f\n" +
+	"\vlive_status\x18\x12 \x01(\tR\n" +
+	"liveStatus\x12\x1b\n" +
+	"\tage_limit\x18\x13 \x01(\x05R\bageLimit\x12\x12\n" +
+	"\x04tags\x18\x14 \x03(\tR\x04tags\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x15 \x03(\tR\n" +
+	"categories\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x16 \x01(\tR\tchannelId\x12\x1f\n" +
+	"\vchannel_url\x18\x17 \x01(\tR\n" +
+	"channelUrl\"Y\n" +
+	"\aChapter\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\x01R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x03 \x01(\x01R\aendTime\"w\n" +
+	"\rSubtitleTrack\x12\x1a\n" +
+	"\blanguage\x18\x01 \x01(\tR\blanguage\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tautomatic\x18\x03 \x01(\bR\tautomatic\x12\x18\n" +
+	"\aformats\x18\x04 \x03(\tR\aformats\"\xf7\x02\n" +
 	"\vVideoFormat\x12\x1b\n" +
 	"\tformat_id\x18\x01 \x01(\tR\bformatId\x12\x18\n" +
 	"\aquality\x18\x02 \x01(\tR\aquality\x12\x1c\n" +
@@ -508,16 +1155,57 @@ const file_proto_media_proto_rawDesc = "" +
 	"\x03vbr\x18\n" +
 	" \x01(\x01R\x03vbr\x12\x10\n" +
 	"\x03abr\x18\v \x01(\x01R\x03abr\x12\x10\n" +
-	"\x03asr\x18\f \x01(\x05R\x03asr\"&\n" +
+	"\x03asr\x18\f \x01(\x05R\x03asr\x12\x1a\n" +
+	"\blanguage\x18\r \x01(\tR\blanguage\x12#\n" +
+	"\rdynamic_range\x18\x0e \x01(\tR\fdynamicRange\"&\n" +
 	"\x12ValidateURLRequest\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\"a\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xa1\x01\n" +
 	"\x13ValidateURLResponse\x12\x14\n" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x1a\n" +
 	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage2\x91\x01\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12#\n" +
+	"\rcanonical_url\x18\x04 \x01(\tR\fcanonicalUrl\x12\x19\n" +
+	"\bvideo_id\x18\x05 \x01(\tR\avideoId\"r\n" +
+	"\x14ParsePlaylistRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x9f\x02\n" +
+	"\x15ParsePlaylistResponse\x12\x1f\n" +
+	"\vplaylist_id\x18\x01 \x01(\tR\n" +
+	"playlistId\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\x12\x1f\n" +
+	"\vtotal_count\x18\x05 \x01(\x03R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x19\n" +
+	"\bhas_more\x18\b \x01(\bR\ahasMore\x12.\n" +
+	"\aentries\x18\t \x03(\v2\x14.media.PlaylistEntryR\aentries\"\xa2\x01\n" +
+	"\rPlaylistEntry\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x1a\n" +
+	"\bduration\x18\x05 \x01(\x03R\bduration\x12\x1c\n" +
+	"\tthumbnail\x18\x06 \x01(\tR\tthumbnail\"E\n" +
+	"\x10ParseURLsRequest\x12\x12\n" +
+	"\x04urls\x18\x01 \x03(\tR\x04urls\x12\x1d\n" +
+	"\n" +
+	"skip_cache\x18\x02 \x01(\bR\tskipCache\"\xb2\x01\n" +
+	"\x0fParseURLsResult\x12\x18\n" +
+	"\aindexes\x18\x01 \x03(\x05R\aindexes\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12/\n" +
+	"\x06result\x18\x03 \x01(\v2\x17.media.ParseURLResponseR\x06result\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x04 \x01(\tR\terrorCode\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage2\x9d\x02\n" +
 	"\fMediaService\x12;\n" +
 	"\bParseURL\x12\x16.media.ParseURLRequest\x1a\x17.media.ParseURLResponse\x12D\n" +
-	"\vValidateURL\x12\x19.media.ValidateURLRequest\x1a\x1a.media.ValidateURLResponseB\x1fZ\x1dyoudlp/media-service/proto;pbb\x06proto3"
+	"\vValidateURL\x12\x19.media.ValidateURLRequest\x1a\x1a.media.ValidateURLResponse\x12J\n" +
+	"\rParsePlaylist\x12\x1b.media.ParsePlaylistRequest\x1a\x1c.media.ParsePlaylistResponse\x12>\n" +
+	"\tParseURLs\x12\x17.media.ParseURLsRequest\x1a\x16.media.ParseURLsResult0\x01B\x1fZ\x1dyoudlp/media-service/proto;pbb\x06proto3"
 
 var (
 	file_proto_media_proto_rawDescOnce sync.Once
@@ -531,25 +1219,40 @@ func file_proto_media_proto_rawDescGZIP() []byte {
 	return file_proto_media_proto_rawDescData
 }
 
-var file_proto_media_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_media_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_proto_media_proto_goTypes = []any{
-	(*ParseURLRequest)(nil),     // 0: media.ParseURLRequest
-	(*ParseURLResponse)(nil),    // 1: media.ParseURLResponse
-	(*VideoFormat)(nil),         // 2: media.VideoFormat
-	(*ValidateURLRequest)(nil),  // 3: media.ValidateURLRequest
-	(*ValidateURLResponse)(nil), // 4: media.ValidateURLResponse
+	(*ParseURLRequest)(nil),       // 0: media.ParseURLRequest
+	(*ParseURLResponse)(nil),      // 1: media.ParseURLResponse
+	(*Chapter)(nil),               // 2: media.Chapter
+	(*SubtitleTrack)(nil),         // 3: media.SubtitleTrack
+	(*VideoFormat)(nil),           // 4: media.VideoFormat
+	(*ValidateURLRequest)(nil),    // 5: media.ValidateURLRequest
+	(*ValidateURLResponse)(nil),   // 6: media.ValidateURLResponse
+	(*ParsePlaylistRequest)(nil),  // 7: media.ParsePlaylistRequest
+	(*ParsePlaylistResponse)(nil), // 8: media.ParsePlaylistResponse
+	(*PlaylistEntry)(nil),         // 9: media.PlaylistEntry
+	(*ParseURLsRequest)(nil),      // 10: media.ParseURLsRequest
+	(*ParseURLsResult)(nil),       // 11: media.ParseURLsResult
 }
 var file_proto_media_proto_depIdxs = []int32{
-	2, // 0: media.ParseURLResponse.formats:type_name -> media.VideoFormat
-	0, // 1: media.MediaService.ParseURL:input_type -> media.ParseURLRequest
-	3, // 2: media.MediaService.ValidateURL:input_type -> media.ValidateURLRequest
-	1, // 3: media.MediaService.ParseURL:output_type -> media.ParseURLResponse
-	4, // 4: media.MediaService.ValidateURL:output_type -> media.ValidateURLResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	4,  // 0: media.ParseURLResponse.formats:type_name -> media.VideoFormat
+	3,  // 1: media.ParseURLResponse.subtitles:type_name -> media.SubtitleTrack
+	2,  // 2: media.ParseURLResponse.chapters:type_name -> media.Chapter
+	9,  // 3: media.ParsePlaylistResponse.entries:type_name -> media.PlaylistEntry
+	1,  // 4: media.ParseURLsResult.result:type_name -> media.ParseURLResponse
+	0,  // 5: media.MediaService.ParseURL:input_type -> media.ParseURLRequest
+	5,  // 6: media.MediaService.ValidateURL:input_type -> media.ValidateURLRequest
+	7,  // 7: media.MediaService.ParsePlaylist:input_type -> media.ParsePlaylistRequest
+	10, // 8: media.MediaService.ParseURLs:input_type -> media.ParseURLsRequest
+	1,  // 9: media.MediaService.ParseURL:output_type -> media.ParseURLResponse
+	6,  // 10: media.MediaService.ValidateURL:output_type -> media.ValidateURLResponse
+	8,  // 11: media.MediaService.ParsePlaylist:output_type -> media.ParsePlaylistResponse
+	11, // 12: media.MediaService.ParseURLs:output_type -> media.ParseURLsResult
+	9,  // [9:13] is the sub-list for method output_type
+	5,  // [5:9] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_proto_media_proto_init() }
@@ -563,7 +1266,7 @@ func file_proto_media_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_media_proto_rawDesc), len(file_proto_media_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,3 @@
+module youdlp/objectstore
+
+go 1.24.0
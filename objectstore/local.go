@@ -0,0 +1,221 @@
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+)
+
+// LocalStorage 本地文件系统存储，对象键映射为根目录下的相对路径
+type LocalStorage struct {
+	root string
+}
+
+// NewLocalStorage 创建本地文件系统存储
+func NewLocalStorage(root string) *LocalStorage {
+	return &LocalStorage{root: filepath.Clean(root)}
+}
+
+// resolve 将对象键转换为本地路径。
+// 兼容历史记录中的绝对路径，但要求位于根目录之内，防止越权访问
+func (s *LocalStorage) resolve(key string) (string, error) {
+	if key == "" {
+		return "", fmt.Errorf("empty storage key")
+	}
+
+	var path string
+	if filepath.IsAbs(key) {
+		path = filepath.Clean(key)
+	} else {
+		path = filepath.Join(s.root, filepath.FromSlash(key))
+	}
+
+	rel, err := filepath.Rel(s.root, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("storage key outside root: %s", key)
+	}
+	return path, nil
+}
+
+// Put 写入对象，先写临时文件再重命名，避免读到半截文件
+func (s *LocalStorage) Put(_ context.Context, key string, r io.Reader, _ int64) error {
+	path, err := s.resolve(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to commit object: %w", err)
+	}
+	return nil
+}
+
+// Move 将本地文件移动为对象，跨文件系统时回退为拷贝
+func (s *LocalStorage) Move(localPath, key string) error {
+	path, err := s.resolve(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	err = os.Rename(localPath, path)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, syscall.EXDEV) {
+		return fmt.Errorf("failed to move file: %w", err)
+	}
+
+	file, err := os.Open(localPath)
+	if err != nil {
+		return fmt.Errorf("failed to open local file: %w", err)
+	}
+	err = s.Put(context.Background(), key, file, -1)
+	file.Close()
+	if err != nil {
+		return err
+	}
+	return os.Remove(localPath)
+}
+
+// Open 读取对象的指定区间
+func (s *LocalStorage) Open(_ context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	path, err := s.resolve(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotExist
+		}
+		return nil, fmt.Errorf("failed to open object: %w", err)
+	}
+	if offset > 0 {
+		if _, err := file.Seek(offset, io.SeekStart); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("failed to seek object: %w", err)
+		}
+	}
+	if length < 0 {
+		return file, nil
+	}
+	return &limitedReadCloser{Reader: io.LimitReader(file, length), Closer: file}, nil
+}
+
+// Stat 查询对象信息
+func (s *LocalStorage) Stat(_ context.Context, key string) (*ObjectInfo, error) {
+	path, err := s.resolve(key)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotExist
+		}
+		return nil, fmt.Errorf("failed to stat object: %w", err)
+	}
+	if info.IsDir() {
+		return nil, ErrNotExist
+	}
+	return &ObjectInfo{Key: key, Size: info.Size(), ModTime: info.ModTime()}, nil
+}
+
+// Delete 删除对象，并清理因此变空的父目录（不超过根目录）
+func (s *LocalStorage) Delete(_ context.Context, key string) error {
+	path, err := s.resolve(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	for dir := filepath.Dir(path); dir != s.root && strings.HasPrefix(dir, s.root); dir = filepath.Dir(dir) {
+		if os.Remove(dir) != nil {
+			break
+		}
+	}
+	return nil
+}
+
+// List 列出指定前缀下的对象
+func (s *LocalStorage) List(_ context.Context, prefix string) ([]ObjectInfo, error) {
+	// 从前缀所在目录开始遍历，再按完整前缀过滤
+	dir := s.root
+	if idx := strings.LastIndex(prefix, "/"); idx >= 0 {
+		dir = filepath.Join(s.root, filepath.FromSlash(prefix[:idx]))
+	}
+
+	var objects []ObjectInfo
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.root, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, ObjectInfo{Key: key, Size: info.Size(), ModTime: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list objects: %w", err)
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Key < objects[j].Key
+	})
+	return objects, nil
+}
+
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,129 @@
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalStorageRoundTripAndRange(t *testing.T) {
+	ctx := context.Background()
+	store := NewLocalStorage(t.TempDir())
+
+	if err := store.Put(ctx, "archive/user-1/video.mp4", strings.NewReader("0123456789"), 10); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+
+	info, err := store.Stat(ctx, "archive/user-1/video.mp4")
+	if err != nil {
+		t.Fatalf("stat: %v", err)
+	}
+	if info.Size != 10 {
+		t.Fatalf("expected size 10, got %d", info.Size)
+	}
+
+	reader, err := store.Open(ctx, "archive/user-1/video.mp4", 2, 5)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	data, _ := io.ReadAll(reader)
+	reader.Close()
+	if string(data) != "23456" {
+		t.Fatalf("expected range 23456, got %q", data)
+	}
+
+	reader, err = store.Open(ctx, "archive/user-1/video.mp4", 7, -1)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	data, _ = io.ReadAll(reader)
+	reader.Close()
+	if string(data) != "789" {
+		t.Fatalf("expected tail 789, got %q", data)
+	}
+
+	if err := store.Delete(ctx, "archive/user-1/video.mp4"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if _, err := store.Stat(ctx, "archive/user-1/video.mp4"); !errors.Is(err, ErrNotExist) {
+		t.Fatalf("expected ErrNotExist after delete, got %v", err)
+	}
+	if err := store.Delete(ctx, "archive/user-1/video.mp4"); err != nil {
+		t.Fatalf("expected deleting missing object to succeed, got %v", err)
+	}
+}
+
+func TestLocalStorageRejectsKeysOutsideRoot(t *testing.T) {
+	root := t.TempDir()
+	store := NewLocalStorage(filepath.Join(root, "data"))
+
+	outside := filepath.Join(root, "secret.txt")
+	if err := os.WriteFile(outside, []byte("secret"), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	for _, key := range []string{"../secret.txt", outside, "tmp/../../secret.txt"} {
+		if _, err := store.Open(context.Background(), key, 0, -1); err == nil {
+			t.Fatalf("expected key %q to be rejected", key)
+		}
+	}
+}
+
+func TestLocalStorageAcceptsLegacyAbsolutePaths(t *testing.T) {
+	root := t.TempDir()
+	store := NewLocalStorage(root)
+
+	legacy := filepath.Join(root, "archive", "user-1", "old.mp4")
+	if err := os.MkdirAll(filepath.Dir(legacy), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(legacy, []byte("old"), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	info, err := store.Stat(context.Background(), legacy)
+	if err != nil {
+		t.Fatalf("stat legacy path: %v", err)
+	}
+	if info.Size != 3 {
+		t.Fatalf("expected size 3, got %d", info.Size)
+	}
+}
+
+func TestLocalStorageListAndMove(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	store := NewLocalStorage(root)
+
+	work := filepath.Join(root, "work", "task-1")
+	if err := os.MkdirAll(work, 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	for _, name := range []string{"a.mp4", "a.en.srt"} {
+		path := filepath.Join(work, name)
+		if err := os.WriteFile(path, []byte(name), 0644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+		if err := store.Move(path, "tmp/task-1/"+name); err != nil {
+			t.Fatalf("move %s: %v", name, err)
+		}
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Fatalf("expected local file %s to be moved", name)
+		}
+	}
+	if err := store.Put(ctx, "tmp/task-2/b.mp4", strings.NewReader("b"), 1); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+
+	objects, err := store.List(ctx, "tmp/task-1/")
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(objects) != 2 || objects[0].Key != "tmp/task-1/a.en.srt" || objects[1].Key != "tmp/task-1/a.mp4" {
+		t.Fatalf("unexpected listing: %+v", objects)
+	}
+}
@@ -0,0 +1,40 @@
+// Package objectstore 文件存储后端（本地文件系统与 S3 兼容对象存储），
+// 由 api-gateway 与 media-service 共用，保证两端读写同一存储时行为一致
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// 存储后端类型
+const (
+	BackendLocal = "local"
+	BackendS3    = "s3"
+)
+
+// ErrNotExist 对象不存在
+var ErrNotExist = errors.New("storage: object not found")
+
+// ObjectInfo 存储对象信息
+type ObjectInfo struct {
+	Key     string
+	Size    int64
+	ModTime time.Time
+}
+
+// Storage 文件存储后端。key 为相对存储根的对象键，如 archive/{user_id}/{date}/video.mp4
+type Storage interface {
+	// Put 写入对象，size 未知时传 -1
+	Put(ctx context.Context, key string, r io.Reader, size int64) error
+	// Open 从 offset 开始读取 length 字节，length < 0 表示读到末尾
+	Open(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error)
+	// Stat 查询对象信息，不存在时返回 ErrNotExist
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	// Delete 删除对象，对象不存在时不报错
+	Delete(ctx context.Context, key string) error
+	// List 列出指定前缀下的对象
+	List(ctx context.Context, prefix string) ([]ObjectInfo, error)
+}